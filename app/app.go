@@ -551,11 +551,21 @@ func NewExocoreApp(
 		app.BankKeeper, &app.DelegationKeeper, authAddrString,
 	)
 
+	// tracks the frozen operators, which can't accept delegations or opt into AVSs. it needs
+	// the avs keeper to authorize the slash contracts, and the operator keeper to check that
+	// the operator has opted into the AVS of the slash contract.
+	app.ExoSlashKeeper = slashKeeper.NewKeeper(
+		appCodec, keys[exoslashTypes.StoreKey], app.AssetsKeeper,
+		&app.AVSManagerKeeper, // intentionally a pointer, since not yet initialized.
+		&app.OperatorKeeper,   // intentionally a pointer, since not yet initialized.
+		authAddrString,
+	)
+
 	// handles delegations by stakers, and must know if the delegatee operator is registered.
 	app.DelegationKeeper = delegationKeeper.NewKeeper(
 		keys[delegationTypes.StoreKey], appCodec,
 		app.AssetsKeeper,
-		delegationTypes.VirtualSlashKeeper{},
+		app.ExoSlashKeeper,
 		&app.OperatorKeeper,
 		app.AccountKeeper,
 		app.BankKeeper,
@@ -574,20 +584,17 @@ func NewExocoreApp(
 		authAddrString,        // authority to edit params
 	)
 
-	// these two modules aren't finalized yet.
+	// this module isn't finalized yet.
 	app.RewardKeeper = rewardKeeper.NewKeeper(
 		appCodec, keys[rewardTypes.StoreKey], app.AssetsKeeper,
 		app.AVSManagerKeeper, authAddrString,
 	)
-	app.ExoSlashKeeper = slashKeeper.NewKeeper(
-		appCodec, keys[exoslashTypes.StoreKey], app.AssetsKeeper, authAddrString,
-	)
 
 	// x/oracle is not fully integrated (or enabled) but allows for exchange rates to be added.
 	app.OracleKeeper = oracleKeeper.NewKeeper(
 		appCodec, keys[oracleTypes.StoreKey], memKeys[oracleTypes.MemStoreKey],
 		app.GetSubspace(oracleTypes.ModuleName), app.StakingKeeper,
-		&app.DelegationKeeper, &app.AssetsKeeper, authAddrString,
+		&app.DelegationKeeper, &app.AssetsKeeper, &app.SlashingKeeper, authAddrString,
 	)
 
 	// the SDK slashing module is used to slash validators in the case of downtime. it tracks
@@ -697,7 +704,8 @@ func NewExocoreApp(
 		&app.DelegationKeeper, // intentionally a pointer, since not yet initialized.
 		&app.OracleKeeper,
 		&app.AVSManagerKeeper,
-		delegationTypes.VirtualSlashKeeper{},
+		app.ExoSlashKeeper,
+		authAddrString,
 	)
 	// the fee distribution keeper is used to allocate reward to exocore validators on epoch-basis,
 	// and it'll interact with other modules, like delegation for voting power, mint and inflation and etc.
@@ -723,6 +731,7 @@ func NewExocoreApp(
 			app.ExoSlashKeeper,
 			app.RewardKeeper,
 			app.AVSManagerKeeper,
+			app.OracleKeeper,
 		),
 	)
 
@@ -796,6 +805,15 @@ func NewExocoreApp(
 		app.StakingKeeper.DelegationHooks(),
 	)
 
+	// the avs module isn't subscribed, since it stores no USD values of its own: it reads the
+	// values of the operators and AVSs from the operator module, which is updated here.
+	(&app.OracleKeeper).SetHooks(
+		oracleTypes.NewMultiOracleHooks(
+			app.OracleKeeper.NSTHooks(),      // updates the balances of the native-restaking stakers
+			app.OperatorKeeper.OracleHooks(), // recalculates the USD values when prices move
+		),
+	)
+
 	(&app.EpochsKeeper).SetHooks(
 		epochstypes.NewMultiEpochHooks(
 			app.DistrKeeper.EpochsHooks(),      // come first for using the voting power of last epoch
@@ -803,6 +821,7 @@ func NewExocoreApp(
 			app.StakingKeeper.EpochsHooks(),    // at this point, the order is irrelevant.
 			app.ExomintKeeper.EpochsHooks(),    // however, this may change once we have distribution
 			app.AVSManagerKeeper.EpochsHooks(), // no-op for now
+			app.DelegationKeeper.EpochsHooks(), // completes the undelegations matured by epoch
 		),
 	)
 
@@ -1011,9 +1030,10 @@ func NewExocoreApp(
 		paramstypes.ModuleName,
 		vestingtypes.ModuleName,
 		consensusparamtypes.ModuleName,
-		upgradetypes.ModuleName,  // no-op since we don't call SetInitVersionMap
-		rewardTypes.ModuleName,   // not fully implemented yet
-		exoslashTypes.ModuleName, // not fully implemented yet
+		upgradetypes.ModuleName, // no-op since we don't call SetInitVersionMap
+		rewardTypes.ModuleName,  // not fully implemented yet
+		// after dogfood, so the frozen operators don't block the genesis opt-ins.
+		exoslashTypes.ModuleName,
 		distrtypes.ModuleName,
 		// must be the last module after others have been set up, so that it can check
 		// the invariants (if configured to do so).
@@ -1027,6 +1047,7 @@ func NewExocoreApp(
 		app.GRPCQueryRouter(),
 	)
 	app.mm.RegisterServices(app.configurator)
+	app.setupUpgradeHandlers()
 
 	// add test gRPC service for testing gRPC queries in isolation
 	// testdata.RegisterTestServiceServer(app.GRPCQueryRouter(), testdata.TestServiceImpl{})
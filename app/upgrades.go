@@ -0,0 +1,14 @@
+package app
+
+import (
+	v110 "github.com/ExocoreNetwork/exocore/app/upgrades/v1_1_0"
+)
+
+// setupUpgradeHandlers registers the handlers of the upgrade plans. It must be called after
+// the module services are registered, so that the configurator knows the module migrations.
+func (app *ExocoreApp) setupUpgradeHandlers() {
+	app.UpgradeKeeper.SetUpgradeHandler(
+		v110.UpgradeName,
+		v110.CreateUpgradeHandler(app.mm, app.configurator),
+	)
+}
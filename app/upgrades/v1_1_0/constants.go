@@ -0,0 +1,5 @@
+package v110
+
+// UpgradeName is the name of the upgrade plan which introduces the module migrations of
+// this release.
+const UpgradeName = "v1.1.0"
@@ -0,0 +1,36 @@
+package v110
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+
+	avstypes "github.com/ExocoreNetwork/exocore/x/avs/types"
+	operatortypes "github.com/ExocoreNetwork/exocore/x/operator/types"
+)
+
+// modulesWithoutVersion are the modules which didn't report a consensus version before this
+// upgrade. The chains running them have 0 in their version map, from which the SDK can't run
+// a migration, so their version is set to 1 before running the migrations.
+var modulesWithoutVersion = []string{
+	operatortypes.ModuleName,
+	avstypes.ModuleName,
+}
+
+// CreateUpgradeHandler creates an SDK upgrade handler for v1.1.0
+func CreateUpgradeHandler(
+	mm *module.Manager,
+	configurator module.Configurator,
+) upgradetypes.UpgradeHandler {
+	return func(ctx sdk.Context, _ upgradetypes.Plan, vm module.VersionMap) (module.VersionMap, error) {
+		logger := ctx.Logger().With("upgrade", UpgradeName)
+		for _, moduleName := range modulesWithoutVersion {
+			// a module missing from the version map is initialized from its genesis instead.
+			if version, found := vm[moduleName]; found && version == 0 {
+				vm[moduleName] = 1
+			}
+		}
+		logger.Debug("running module migrations ...")
+		return mm.RunMigrations(ctx, configurator, vm)
+	}
+}
@@ -461,7 +461,7 @@ func getTestExocoreGenesis(
 				},
 			}, depositsByStaker, nil,
 		), operatortypes.NewGenesisState(
-			operatorInfos, nil, nil, nil, nil, nil, nil, nil,
+			operatortypes.DefaultParams(), operatorInfos, nil, nil, nil, nil, nil, nil, nil,
 		), delegationtypes.NewGenesis(associations, delegationStates, stakersByOperator, nil), dogfoodtypes.NewGenesis(
 			dogfoodtypes.NewParams(
 				dogfoodtypes.DefaultEpochsUntilUnbonded,
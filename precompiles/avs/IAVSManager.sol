@@ -22,6 +22,9 @@ interface IAVSManager {
     );
     event ChallengeInitiated(address indexed sender, bytes taskHash, uint64 taskID, bytes taskResponseHash,
         string operatorAddress);
+    event OperatorEdited(address indexed sender);
+    event ChallengeResolved(address indexed sender, address indexed taskContractAddress, uint64 taskID,
+        string operatorAddress, bool confirmed);
     event PublicKeyRegistered(address indexed sender, string name);
     event TaskSubmittedByOperator(address indexed sender, uint64 taskID, bytes taskResponse,
         bytes blsSignature, address indexed taskContractAddress, uint8 phase);
@@ -109,6 +112,21 @@ interface IAVSManager {
         address sender
     ) external returns (bool success);
 
+    /// @dev editOperator , this function enables the calling operator to edit its information.
+    /// The empty parameters are left unchanged.
+    /// @param operatorMetaInfo The new meta info of the operator.
+    /// @param approveAddr The new approve address of the operator.
+    /// @param clientChainIDs The client chain ids of the earning addresses to be appended.
+    /// @param clientChainEarningAddrs The earning addresses to be appended.
+    /// @param commissionRate The new commission rate, as a decimal string like "0.1".
+    function editOperator(
+        string memory operatorMetaInfo,
+        string memory approveAddr,
+        uint64[] memory clientChainIDs,
+        string[] memory clientChainEarningAddrs,
+        string memory commissionRate
+    ) external returns (bool success);
+
 
     /// @dev CreateTask , avs owner create a new task
     /// @param sender The external address for calling this method.
@@ -142,6 +160,18 @@ interface IAVSManager {
         string memory operatorAddress
     ) external returns (bool success);
 
+    /// @dev resolveChallenge , this function enables the slash contract or an owner of the AVS to
+    /// adjudicate a raised challenge. A confirmed challenge slashes the operator.
+    /// @param taskContractAddress The address of the task contract.
+    /// @param taskID The id of task.
+    /// @param operatorAddress operator address.
+    /// @param confirmed Whether the challenge is upheld.
+    function resolveChallenge(
+        address taskContractAddress,
+        uint64 taskID,
+        string memory operatorAddress,
+        bool confirmed
+    ) external returns (bool success);
 
     /// @dev Called by the avs manager service register an operator as the owner of a BLS public key.
     /// @param sender The external address for calling this method.
@@ -56,6 +56,11 @@ func (suite *AVSManagerPrecompileSuite) TestIsTransaction() {
 			suite.precompile.Methods[avs.MethodDeregisterOperatorFromAVS].Name,
 			true,
 		},
+		{
+			avs.MethodEditOperator,
+			suite.precompile.Methods[avs.MethodEditOperator].Name,
+			true,
+		},
 		{
 			avs.MethodCreateAVSTask,
 			suite.precompile.Methods[avs.MethodCreateAVSTask].Name,
@@ -713,6 +718,134 @@ func (suite *AVSManagerPrecompileSuite) TestDeregisterOperatorFromAVS() {
 	}
 }
 
+func (suite *AVSManagerPrecompileSuite) TestEditOperator() {
+	operatorAddress := sdk.AccAddress(suite.Address.Bytes())
+	registerOperator := func() {
+		registerReq := &operatortypes.RegisterOperatorReq{
+			FromAddress: operatorAddress.String(),
+			Info: &operatortypes.OperatorInfo{
+				EarningsAddr:     operatorAddress.String(),
+				OperatorMetaInfo: "test operator",
+			},
+		}
+		_, err := suite.OperatorMsgServer.RegisterOperator(sdk.WrapSDKContext(suite.Ctx), registerReq)
+		suite.NoError(err)
+	}
+	commonMalleate := func() (common.Address, []byte) {
+		input, err := suite.precompile.Pack(
+			avs.MethodEditOperator,
+			"new operator",
+			"",
+			[]uint64{suite.ClientChains[0].LayerZeroChainID},
+			[]string{"0x1f9840a85d5af5bf1d1762f925bdaddc4201f984"},
+			"",
+		)
+		suite.Require().NoError(err, "failed to pack input")
+		return suite.Address, input
+	}
+	successRet, err := suite.precompile.Methods[avs.MethodEditOperator].Outputs.Pack(true)
+	suite.Require().NoError(err)
+	failureRet, err := suite.precompile.Methods[avs.MethodEditOperator].Outputs.Pack(false)
+	suite.Require().NoError(err)
+
+	testcases := []struct {
+		name        string
+		malleate    func() (common.Address, []byte)
+		readOnly    bool
+		expPass     bool
+		errContains string
+		returnBytes []byte
+	}{
+		{
+			name:        "fail for the unregistered operator",
+			malleate:    commonMalleate,
+			readOnly:    false,
+			expPass:     true,
+			returnBytes: failureRet,
+		},
+		{
+			name: "pass for the operator editing its information",
+			malleate: func() (common.Address, []byte) {
+				registerOperator()
+				return commonMalleate()
+			},
+			readOnly:    false,
+			expPass:     true,
+			returnBytes: successRet,
+		},
+	}
+
+	for _, tc := range testcases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			baseFee := suite.App.FeeMarketKeeper.GetBaseFee(suite.Ctx)
+
+			// malleate testcase
+			caller, input := tc.malleate()
+			contract := vm.NewPrecompile(vm.AccountRef(caller), suite.precompile, big.NewInt(0), uint64(1e6))
+			contract.Input = input
+			contract.CallerAddress = caller
+
+			contractAddr := contract.Address()
+			// Build and sign Ethereum transaction
+			txArgs := evmtypes.EvmTxArgs{
+				ChainID:   suite.App.EvmKeeper.ChainID(),
+				Nonce:     0,
+				To:        &contractAddr,
+				Amount:    nil,
+				GasLimit:  100000,
+				GasPrice:  app.MainnetMinGasPrices.BigInt(),
+				GasFeeCap: baseFee,
+				GasTipCap: big.NewInt(1),
+				Accesses:  &ethtypes.AccessList{},
+			}
+			msgEthereumTx := evmtypes.NewTx(&txArgs)
+
+			msgEthereumTx.From = suite.Address.String()
+			err := msgEthereumTx.Sign(suite.EthSigner, suite.Signer)
+			suite.Require().NoError(err, "failed to sign Ethereum message")
+
+			// Instantiate config
+			proposerAddress := suite.Ctx.BlockHeader().ProposerAddress
+			cfg, err := suite.App.EvmKeeper.EVMConfig(suite.Ctx, proposerAddress, suite.App.EvmKeeper.ChainID())
+			suite.Require().NoError(err, "failed to instantiate EVM config")
+
+			msg, err := msgEthereumTx.AsMessage(suite.EthSigner, baseFee)
+			suite.Require().NoError(err, "failed to instantiate Ethereum message")
+
+			// Instantiate EVM
+			evm := suite.App.EvmKeeper.NewEVM(
+				suite.Ctx, msg, cfg, nil, suite.StateDB,
+			)
+
+			params := suite.App.EvmKeeper.GetParams(suite.Ctx)
+			activePrecompiles := params.GetActivePrecompilesAddrs()
+			precompileMap := suite.App.EvmKeeper.Precompiles(activePrecompiles...)
+			err = vm.ValidatePrecompiles(precompileMap, activePrecompiles)
+			suite.Require().NoError(err, "invalid precompiles", activePrecompiles)
+			evm.WithPrecompiles(precompileMap, activePrecompiles)
+
+			// Run precompiled contract
+			bz, err := suite.precompile.Run(evm, contract, tc.readOnly)
+
+			// Check results
+			if tc.expPass {
+				suite.Require().NoError(err, "expected no error when running the precompile")
+				suite.Require().Equal(tc.returnBytes, bz, "the return doesn't match the expected result")
+			} else {
+				suite.Require().Error(err, "expected error to be returned when running the precompile")
+				suite.Require().Nil(bz, "expected returned bytes to be nil")
+				suite.Require().ErrorContains(err, tc.errContains)
+			}
+		})
+	}
+
+	info, err := suite.App.OperatorKeeper.OperatorInfo(suite.Ctx, operatorAddress.String())
+	suite.Require().NoError(err)
+	suite.Equal("new operator", info.OperatorMetaInfo)
+	suite.Equal(1, len(info.ClientChainEarningsAddr.EarningInfoList))
+}
+
 // TestRun tests the precompiles Run method reg avstask.
 func (suite *AVSManagerPrecompileSuite) TestRunRegTaskInfo() {
 	taskAddr := utiltx.GenerateAddress()
@@ -16,8 +16,10 @@ const (
 	EventTypeAVSDeregistered         = "AVSDeregistered"
 	EventTypeOperatorJoined          = "OperatorJoined"
 	EventTypeOperatorOuted           = "OperatorOuted"
+	EventTypeOperatorEdited          = "OperatorEdited"
 	EventTypeTaskCreated             = "TaskCreated"
 	EventTypeChallengeInitiated      = "ChallengeInitiated"
+	EventTypeChallengeResolved       = "ChallengeResolved"
 	EventTypePublicKeyRegistered     = "PublicKeyRegistered"
 	EventTypeTaskSubmittedByOperator = "TaskSubmittedByOperator"
 )
@@ -108,6 +110,42 @@ func (p Precompile) EmitChallengeInitiated(ctx sdk.Context, stateDB vm.StateDB,
 		params.OperatorAddress.String())
 }
 
+// EmitOperatorEdited emits an Ethereum event when an operator edits its information.
+func (p Precompile) EmitOperatorEdited(ctx sdk.Context, stateDB vm.StateDB, operator common.Address) error {
+	event := p.ABI.Events[EventTypeOperatorEdited]
+	// the only argument is indexed, so the data is empty.
+	stateDB.AddLog(&ethtypes.Log{
+		Address:     p.Address(),
+		Topics:      []common.Hash{event.ID, common.BytesToHash(operator.Bytes())},
+		BlockNumber: uint64(ctx.BlockHeight()),
+	})
+	return nil
+}
+
+func (p Precompile) EmitChallengeResolved(ctx sdk.Context, stateDB vm.StateDB, caller common.Address, params *avstypes.ResolveChallengeParams) error {
+	event := p.ABI.Events[EventTypeChallengeResolved]
+	// sender and taskContractAddress are indexed, so they are put into the topics.
+	topics := []common.Hash{
+		event.ID,
+		common.BytesToHash(caller.Bytes()),
+		common.BytesToHash(params.TaskContractAddress.Bytes()),
+	}
+	packed, err := event.Inputs.NonIndexed().Pack(
+		params.TaskID,
+		params.OperatorAddress.String(),
+		params.Confirmed)
+	if err != nil {
+		return err
+	}
+	stateDB.AddLog(&ethtypes.Log{
+		Address:     p.Address(),
+		Topics:      topics,
+		Data:        packed,
+		BlockNumber: uint64(ctx.BlockHeight()),
+	})
+	return nil
+}
+
 func (p Precompile) EmitPublicKeyRegistered(ctx sdk.Context, stateDB vm.StateDB, params *avstypes.BlsParams) error {
 	arguments := p.ABI.Events[EventTypePublicKeyRegistered].Inputs
 	return p.emitEvent(ctx, stateDB, EventTypePublicKeyRegistered, arguments,
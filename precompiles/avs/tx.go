@@ -9,6 +9,7 @@ import (
 
 	exocmn "github.com/ExocoreNetwork/exocore/precompiles/common"
 	avstypes "github.com/ExocoreNetwork/exocore/x/avs/types"
+	operatortypes "github.com/ExocoreNetwork/exocore/x/operator/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/common"
@@ -22,9 +23,11 @@ const (
 	MethodDeregisterAVS             = "deregisterAVS"
 	MethodRegisterOperatorToAVS     = "registerOperatorToAVS"
 	MethodDeregisterOperatorFromAVS = "deregisterOperatorFromAVS"
+	MethodEditOperator              = "editOperator"
 	MethodCreateAVSTask             = "createTask"
 	MethodRegisterBLSPublicKey      = "registerBLSPublicKey"
 	MethodChallenge                 = "challenge"
+	MethodResolveChallenge          = "resolveChallenge"
 	MethodOperatorSubmitTask        = "operatorSubmitTask"
 )
 
@@ -192,6 +195,73 @@ func (p Precompile) UnbindOperatorToAVS(
 	return method.Outputs.Pack(true)
 }
 
+// EditOperator edits the information of the calling operator.
+func (p Precompile) EditOperator(
+	ctx sdk.Context,
+	_ common.Address,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	if len(args) != len(p.ABI.Methods[MethodEditOperator].Inputs) {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, len(p.ABI.Methods[MethodEditOperator].Inputs), len(args))
+	}
+	req := &operatortypes.EditOperatorReq{}
+	// only the operator itself can edit its information.
+	req.FromAddress = sdk.AccAddress(contract.CallerAddress[:]).String()
+
+	operatorMetaInfo, ok := args[0].(string)
+	if !ok {
+		return nil, fmt.Errorf(exocmn.ErrContractInputParaOrType, 0, "string", operatorMetaInfo)
+	}
+	req.OperatorMetaInfo = operatorMetaInfo
+
+	approveAddr, ok := args[1].(string)
+	if !ok {
+		return nil, fmt.Errorf(exocmn.ErrContractInputParaOrType, 1, "string", approveAddr)
+	}
+	req.ApproveAddr = approveAddr
+
+	clientChainIDs, ok := args[2].([]uint64)
+	if !ok {
+		return nil, fmt.Errorf(exocmn.ErrContractInputParaOrType, 2, "[]uint64", clientChainIDs)
+	}
+	clientChainEarningAddrs, ok := args[3].([]string)
+	if !ok || len(clientChainEarningAddrs) != len(clientChainIDs) {
+		return nil, fmt.Errorf(exocmn.ErrContractInputParaOrType, 3, "[]string", clientChainEarningAddrs)
+	}
+	for i, clientChainID := range clientChainIDs {
+		req.ClientChainEarningsAddr = append(req.ClientChainEarningsAddr, &operatortypes.ClientChainEarningAddrInfo{
+			LzClientChainID:        clientChainID,
+			ClientChainEarningAddr: clientChainEarningAddrs[i],
+		})
+	}
+
+	commissionRate, ok := args[4].(string)
+	if !ok {
+		return nil, fmt.Errorf(exocmn.ErrContractInputParaOrType, 4, "string", commissionRate)
+	}
+	if commissionRate != "" {
+		rate, err := sdk.NewDecFromStr(commissionRate)
+		if err != nil {
+			return nil, errorsmod.Wrap(err, "invalid commission rate")
+		}
+		req.CommissionRate = &rate
+	}
+
+	if err := req.ValidateBasic(); err != nil {
+		return nil, err
+	}
+	if err := p.avsKeeper.EditOperator(ctx, req); err != nil {
+		return nil, err
+	}
+	if err := p.EmitOperatorEdited(ctx, stateDB, contract.CallerAddress); err != nil {
+		return nil, err
+	}
+	return method.Outputs.Pack(true)
+}
+
 // CreateAVSTask Middleware uses exocore's default avstask template to create tasks in avstask module.
 func (p Precompile) CreateAVSTask(
 	ctx sdk.Context,
@@ -275,6 +345,61 @@ func (p Precompile) Challenge(
 	return method.Outputs.Pack(true)
 }
 
+// ResolveChallenge adjudicates a pending challenge. The caller must be the slash contract or one
+// of the owners of the AVS, and a confirmed challenge slashes the operator.
+func (p Precompile) ResolveChallenge(
+	ctx sdk.Context,
+	_ common.Address,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	if len(args) != len(p.ABI.Methods[MethodResolveChallenge].Inputs) {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, len(p.ABI.Methods[MethodResolveChallenge].Inputs), len(args))
+	}
+	resolveParams := &avstypes.ResolveChallengeParams{}
+	resolveParams.CallerAddress = sdk.AccAddress(contract.CallerAddress[:]).String()
+
+	taskContractAddress, ok := args[0].(common.Address)
+	if !ok || (taskContractAddress == common.Address{}) {
+		return nil, fmt.Errorf(exocmn.ErrContractInputParaOrType, 0, "common.Address", taskContractAddress)
+	}
+	resolveParams.TaskContractAddress = taskContractAddress
+
+	taskID, ok := args[1].(uint64)
+	if !ok {
+		return nil, fmt.Errorf(exocmn.ErrContractInputParaOrType, 1, "uint64", taskID)
+	}
+	resolveParams.TaskID = taskID
+
+	operatorAddress, ok := args[2].(string)
+	if !ok || operatorAddress == "" {
+		return nil, fmt.Errorf(exocmn.ErrContractInputParaOrType, 2, "string", operatorAddress)
+	}
+	operator, err := sdk.AccAddressFromBech32(operatorAddress)
+	if err != nil {
+		return nil, err
+	}
+	resolveParams.OperatorAddress = operator
+
+	confirmed, ok := args[3].(bool)
+	if !ok {
+		return nil, fmt.Errorf(exocmn.ErrContractInputParaOrType, 3, "bool", confirmed)
+	}
+	resolveParams.Confirmed = confirmed
+
+	err = p.avsKeeper.ResolveChallenge(ctx, resolveParams)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = p.EmitChallengeResolved(ctx, stateDB, contract.CallerAddress, resolveParams); err != nil {
+		return nil, err
+	}
+	return method.Outputs.Pack(true)
+}
+
 // RegisterBLSPublicKey
 func (p Precompile) RegisterBLSPublicKey(
 	ctx sdk.Context,
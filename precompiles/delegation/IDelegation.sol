@@ -52,6 +52,27 @@ interface IDelegation {
         uint256 opAmount
     ) external returns (bool success);
 
+/// @dev redelegate the client chain assets from one operator to another through client chain without
+/// unbonding, the redelegated assets remain slashable for the source operator until its unbonding period ends.
+/// @param clientChainID is the layerZero chainID if it is supported.
+//  It might be allocated by Exocore when the client chain isn't supported
+//  by layerZero
+/// @param lzNonce The cross chain tx layerZero nonce
+/// @param assetsAddress The client chain asset Address
+/// @param stakerAddress The staker address
+/// @param srcOperatorAddr The operator address that the assets are redelegated from
+/// @param dstOperatorAddr The operator address that the assets are redelegated to
+/// @param opAmount The redelegation amount
+    function redelegate(
+        uint32 clientChainID,
+        uint64 lzNonce,
+        bytes calldata assetsAddress,
+        bytes calldata stakerAddress,
+        bytes calldata srcOperatorAddr,
+        bytes calldata dstOperatorAddr,
+        uint256 opAmount
+    ) external returns (bool success);
+
 /// @dev associate the staker as being owned by the specified operator
 /// @param clientChainID is the layerZero chainID if it is supported.
 //  It might be allocated by Exocore when the client chain isn't supported
@@ -22,6 +22,10 @@ const (
 	// Undelegate transaction.
 	MethodUndelegate = "undelegate"
 
+	// MethodRedelegate defines the ABI method name for the
+	// Redelegate transaction.
+	MethodRedelegate = "redelegate"
+
 	// MethodAssociateOperatorWithStaker defines the ABI method name for the
 	// associateOperatorWithStaker transaction.
 	MethodAssociateOperatorWithStaker = "associateOperatorWithStaker"
@@ -93,6 +97,39 @@ func (p Precompile) Undelegate(
 	return method.Outputs.Pack(true)
 }
 
+// Redelegate the client chain assets from one operator to another through client chain, that will change the states in delegation and assets module
+func (p Precompile) Redelegate(
+	ctx sdk.Context,
+	_ common.Address,
+	contract *vm.Contract,
+	_ vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	// check the invalidation of caller contract
+	err := p.assetsKeeper.CheckExocoreGatewayAddr(ctx, contract.CallerAddress)
+	if err != nil {
+		return nil, fmt.Errorf(exocmn.ErrContractCaller, err.Error())
+	}
+
+	redelegationParams, err := p.GetRedelegationParamsFromInputs(ctx, args)
+	if err != nil {
+		return nil, err
+	}
+
+	txHash, ok := ctx.Value(CtxKeyTxHash).(common.Hash)
+	if !ok || txHash.Bytes() == nil {
+		return nil, fmt.Errorf(ErrCtxTxHash, reflect.TypeOf(ctx.Value(CtxKeyTxHash)), txHash)
+	}
+	redelegationParams.TxHash = txHash
+
+	err = p.delegationKeeper.Redelegate(ctx, redelegationParams)
+	if err != nil {
+		return nil, err
+	}
+	return method.Outputs.Pack(true)
+}
+
 func (p Precompile) AssociateOperatorWithStaker(
 	ctx sdk.Context,
 	_ common.Address,
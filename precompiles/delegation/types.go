@@ -60,19 +60,10 @@ func (p Precompile) GetDelegationParamsFromInputs(ctx sdk.Context, args []interf
 	delegationParams.StakerAddress = stakerAddr[:clientChainAddrLength]
 
 	// the input operator address is cosmos accAddress type,so we need to check the length and decode it through Bench32
-	operatorAddr, ok := args[4].([]byte)
-	if !ok || operatorAddr == nil {
-		return nil, fmt.Errorf(exocmn.ErrContractInputParaOrType, 4, "[]byte", args[4])
-	}
-	if len(operatorAddr) != types.ExoCoreOperatorAddrLength {
-		return nil, fmt.Errorf(exocmn.ErrInputOperatorAddrLength, len(operatorAddr), types.ExoCoreOperatorAddrLength)
-	}
-
-	opAccAddr, err := sdk.AccAddressFromBech32(string(operatorAddr))
+	delegationParams.OperatorAddress, err = operatorFromInput(args[4], 4)
 	if err != nil {
-		return nil, fmt.Errorf("error occurred when parse acc address from Bech32,the addr is:%s, error:%s", string(operatorAddr), err.Error())
+		return nil, err
 	}
-	delegationParams.OperatorAddress = opAccAddr
 
 	opAmount, ok := args[5].(*big.Int)
 	if !ok || opAmount == nil || !(opAmount.Cmp(big.NewInt(0)) == 1) {
@@ -81,3 +72,82 @@ func (p Precompile) GetDelegationParamsFromInputs(ctx sdk.Context, args []interf
 	delegationParams.OpAmount = sdkmath.NewIntFromBigInt(opAmount)
 	return delegationParams, nil
 }
+
+func (p Precompile) GetRedelegationParamsFromInputs(ctx sdk.Context, args []interface{}) (*delegationtypes.RedelegationParams, error) {
+	inputsLen := len(p.ABI.Methods[MethodRedelegate].Inputs)
+	if len(args) != inputsLen {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, inputsLen, len(args))
+	}
+
+	redelegationParams := &delegationtypes.RedelegationParams{}
+	clientChainID, ok := args[0].(uint32)
+	if !ok {
+		return nil, fmt.Errorf(exocmn.ErrContractInputParaOrType, 0, "uint32", args[0])
+	}
+	redelegationParams.ClientChainID = uint64(clientChainID)
+
+	info, err := p.assetsKeeper.GetClientChainInfoByIndex(ctx, redelegationParams.ClientChainID)
+	if err != nil {
+		return nil, err
+	}
+	clientChainAddrLength := info.AddressLength
+
+	txLzNonce, ok := args[1].(uint64)
+	if !ok {
+		return nil, fmt.Errorf(exocmn.ErrContractInputParaOrType, 1, "uint64", args[1])
+	}
+	redelegationParams.LzNonce = txLzNonce
+
+	assetAddr, ok := args[2].([]byte)
+	if !ok || assetAddr == nil {
+		return nil, fmt.Errorf(exocmn.ErrContractInputParaOrType, 2, "[]byte", args[2])
+	}
+	// #nosec G115
+	if uint32(len(assetAddr)) < clientChainAddrLength {
+		return nil, fmt.Errorf(exocmn.ErrInvalidAddrLength, len(assetAddr), clientChainAddrLength)
+	}
+	redelegationParams.AssetsAddress = assetAddr[:clientChainAddrLength]
+
+	stakerAddr, ok := args[3].([]byte)
+	if !ok || stakerAddr == nil {
+		return nil, fmt.Errorf(exocmn.ErrContractInputParaOrType, 3, "[]byte", args[3])
+	}
+	// #nosec G115
+	if uint32(len(stakerAddr)) < clientChainAddrLength {
+		return nil, fmt.Errorf(exocmn.ErrInvalidAddrLength, len(stakerAddr), clientChainAddrLength)
+	}
+	redelegationParams.StakerAddress = stakerAddr[:clientChainAddrLength]
+
+	redelegationParams.SrcOperatorAddress, err = operatorFromInput(args[4], 4)
+	if err != nil {
+		return nil, err
+	}
+	redelegationParams.DstOperatorAddress, err = operatorFromInput(args[5], 5)
+	if err != nil {
+		return nil, err
+	}
+
+	opAmount, ok := args[6].(*big.Int)
+	if !ok || opAmount == nil || !(opAmount.Cmp(big.NewInt(0)) == 1) {
+		return nil, fmt.Errorf(exocmn.ErrContractInputParaOrType, 6, "*big.Int", args[6])
+	}
+	redelegationParams.OpAmount = sdkmath.NewIntFromBigInt(opAmount)
+	return redelegationParams, nil
+}
+
+// operatorFromInput parses the operator address at the index of the inputs. The input operator
+// address is the Bech32 string of the cosmos AccAddress.
+func operatorFromInput(arg interface{}, index int) (sdk.AccAddress, error) {
+	operatorAddr, ok := arg.([]byte)
+	if !ok || operatorAddr == nil {
+		return nil, fmt.Errorf(exocmn.ErrContractInputParaOrType, index, "[]byte", arg)
+	}
+	if len(operatorAddr) != types.ExoCoreOperatorAddrLength {
+		return nil, fmt.Errorf(exocmn.ErrInputOperatorAddrLength, len(operatorAddr), types.ExoCoreOperatorAddrLength)
+	}
+	opAccAddr, err := sdk.AccAddressFromBech32(string(operatorAddr))
+	if err != nil {
+		return nil, fmt.Errorf("error occurred when parse acc address from Bech32,the addr is:%s, error:%s", string(operatorAddr), err.Error())
+	}
+	return opAccAddr, nil
+}
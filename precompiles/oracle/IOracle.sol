@@ -0,0 +1,54 @@
+// SPDX-License-Identifier: MIT
+pragma solidity >=0.8.17;
+
+/// @dev The Oracle contract's address.
+address constant ORACLE_PRECOMPILE_ADDRESS = 0x0000000000000000000000000000000000000902;
+
+/// @dev The Oracle contract's instance.
+IOracle constant ORACLE_CONTRACT = IOracle(ORACLE_PRECOMPILE_ADDRESS);
+
+/// @author Exocore Team
+/// @title Oracle Precompile Contract
+/// @dev The interface through which solidity contracts will read the prices of oracle module
+/// @custom:address 0x0000000000000000000000000000000000000902
+interface IOracle {
+
+    /// QUERIES
+    /// @dev Returns the latest price of a token, the price is the USD price scaled by 10^decimal.
+    /// @param tokenID is the index of the token in the oracle params, as returned by getTokens
+    /// @return success true if the token has a price
+    /// @return price the latest price of the token
+    /// @return decimal the decimal of the price
+    /// @return roundID the round in which the price was finalized
+    /// @return timestamp the time of the price, formatted as "2006-01-02 15:04:05" in UTC
+    function getLatestPrice(uint64 tokenID)
+        external
+        view
+        returns (bool success, uint256 price, uint8 decimal, uint64 roundID, string memory timestamp);
+
+    /// @dev Returns the price of a token finalized in a specified round.
+    /// @param tokenID is the index of the token in the oracle params, as returned by getTokens
+    /// @param roundID is the round of the price
+    /// @return success true if the token has a price in the round
+    /// @return price the price of the token in the round
+    /// @return decimal the decimal of the price
+    /// @return timestamp the time of the price, formatted as "2006-01-02 15:04:05" in UTC
+    function getPriceByRound(uint64 tokenID, uint64 roundID)
+        external
+        view
+        returns (bool success, uint256 price, uint8 decimal, string memory timestamp);
+
+    /// @dev Returns the tokens registered in the oracle, the index of a token in the lists is its tokenID,
+    /// and the index 0 is reserved.
+    /// @return success true if the query is successful
+    /// @return names the names of the tokens
+    /// @return assetIDs the assetIDs of the tokens in assets module, empty if the token is not an asset
+    function getTokens() external view returns (bool success, string[] memory names, string[] memory assetIDs);
+
+    /// @dev Returns the decimal of the prices of a token.
+    /// @param tokenID is the index of the token in the oracle params, as returned by getTokens
+    /// @return success true if the token exists
+    /// @return decimal the decimal of the prices
+    function getDecimal(uint64 tokenID) external view returns (bool success, uint8 decimal);
+
+}
@@ -0,0 +1,137 @@
+package oracle
+
+import (
+	"bytes"
+	"embed"
+	"fmt"
+	"math/big"
+
+	oracleKeeper "github.com/ExocoreNetwork/exocore/x/oracle/keeper"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+	cmn "github.com/evmos/evmos/v16/precompiles/common"
+)
+
+var _ vm.PrecompiledContract = &Precompile{}
+
+// Embed abi json file to the executable binary. Needed when importing as dependency.
+//
+//go:embed abi.json
+var f embed.FS
+
+// Precompile defines the precompiled contract for oracle.
+type Precompile struct {
+	cmn.Precompile
+	oracleKeeper oracleKeeper.Keeper
+}
+
+// NewPrecompile creates a new oracle Precompile instance as a
+// PrecompiledContract interface.
+func NewPrecompile(
+	oracleKeeper oracleKeeper.Keeper,
+) (*Precompile, error) {
+	abiBz, err := f.ReadFile("abi.json")
+	if err != nil {
+		return nil, fmt.Errorf("error loading the oracle ABI %s", err)
+	}
+
+	newAbi, err := abi.JSON(bytes.NewReader(abiBz))
+	if err != nil {
+		return nil, fmt.Errorf(cmn.ErrInvalidABI, err)
+	}
+
+	return &Precompile{
+		Precompile: cmn.Precompile{
+			ABI:                  newAbi,
+			KvGasConfig:          storetypes.KVGasConfig(),
+			TransientKVGasConfig: storetypes.TransientGasConfig(),
+			ApprovalExpiration:   cmn.DefaultExpirationDuration,
+		},
+		oracleKeeper: oracleKeeper,
+	}, nil
+}
+
+// Address defines the address of the oracle compile contract.
+// address: 0x0000000000000000000000000000000000000902
+func (p Precompile) Address() common.Address {
+	return common.HexToAddress("0x0000000000000000000000000000000000000902")
+}
+
+// RequiredGas calculates the precompiled contract's base gas rate.
+func (p Precompile) RequiredGas(input []byte) uint64 {
+	methodID := input[:4]
+
+	method, err := p.MethodById(methodID)
+	if err != nil {
+		// This should never happen since this method is going to fail during Run
+		return 0
+	}
+	return p.Precompile.RequiredGas(input, p.IsTransaction(method.Name))
+}
+
+// Run executes the precompiled contract oracle methods defined in the ABI.
+func (p Precompile) Run(evm *vm.EVM, contract *vm.Contract, readOnly bool) (bz []byte, err error) {
+	ctx, stateDB, method, initialGas, args, err := p.RunSetup(evm, contract, readOnly, p.IsTransaction)
+	if err != nil {
+		return nil, err
+	}
+
+	// This handles any out of gas errors that may occur during the execution of a precompile tx or query.
+	// It avoids panics and returns the out of gas error so the EVM can continue gracefully.
+	defer cmn.HandleGasError(ctx, contract, initialGas, &err)()
+
+	if err := stateDB.Commit(); err != nil {
+		return nil, err
+	}
+
+	switch method.Name {
+	// queries
+	case MethodGetLatestPrice:
+		bz, err = p.GetLatestPrice(ctx, method, args)
+		if err != nil {
+			ctx.Logger().Error("internal error when calling oracle precompile", "module", "oracle precompile", "method", method.Name, "err", err)
+			bz, err = method.Outputs.Pack(false, new(big.Int), uint8(0), uint64(0), "")
+		}
+	case MethodGetPriceByRound:
+		bz, err = p.GetPriceByRound(ctx, method, args)
+		if err != nil {
+			ctx.Logger().Error("internal error when calling oracle precompile", "module", "oracle precompile", "method", method.Name, "err", err)
+			bz, err = method.Outputs.Pack(false, new(big.Int), uint8(0), "")
+		}
+	case MethodGetTokens:
+		bz, err = p.GetTokens(ctx, method, args)
+		if err != nil {
+			ctx.Logger().Error("internal error when calling oracle precompile", "module", "oracle precompile", "method", method.Name, "err", err)
+			bz, err = method.Outputs.Pack(false, []string{}, []string{})
+		}
+	case MethodGetDecimal:
+		bz, err = p.GetDecimal(ctx, method, args)
+		if err != nil {
+			ctx.Logger().Error("internal error when calling oracle precompile", "module", "oracle precompile", "method", method.Name, "err", err)
+			bz, err = method.Outputs.Pack(false, uint8(0))
+		}
+	default:
+		return nil, fmt.Errorf(cmn.ErrUnknownMethod, method.Name)
+	}
+
+	if err != nil {
+		ctx.Logger().Error("return error when calling oracle precompile", "module", "oracle precompile", "method", method.Name, "err", err)
+		return nil, err
+	}
+
+	cost := ctx.GasMeter().GasConsumed() - initialGas
+
+	if !contract.UseGas(cost) {
+		return nil, vm.ErrOutOfGas
+	}
+
+	return bz, nil
+}
+
+// IsTransaction checks if the given methodID corresponds to a transaction or query.
+// The oracle precompile is query-only, so none of its methods is a transaction.
+func (Precompile) IsTransaction(string) bool {
+	return false
+}
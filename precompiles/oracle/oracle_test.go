@@ -0,0 +1,172 @@
+package oracle_test
+
+import (
+	"math/big"
+
+	"github.com/ExocoreNetwork/exocore/app"
+	"github.com/ExocoreNetwork/exocore/precompiles/oracle"
+	oracletypes "github.com/ExocoreNetwork/exocore/x/oracle/types"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+	evmtypes "github.com/evmos/evmos/v16/x/evm/types"
+)
+
+// run calls the precompile with the input in a read-only EVM call
+func (s *OraclePrecompileSuite) run(input []byte) ([]byte, error) {
+	baseFee := s.App.FeeMarketKeeper.GetBaseFee(s.Ctx)
+	contract := vm.NewPrecompile(
+		vm.AccountRef(s.Address),
+		s.precompile,
+		big.NewInt(0),
+		uint64(1e6),
+	)
+	contract.Input = input
+	contractAddr := contract.Address()
+	txArgs := evmtypes.EvmTxArgs{
+		ChainID:   s.App.EvmKeeper.ChainID(),
+		Nonce:     0,
+		To:        &contractAddr,
+		Amount:    nil,
+		GasLimit:  100000,
+		GasPrice:  app.MainnetMinGasPrices.BigInt(),
+		GasFeeCap: baseFee,
+		GasTipCap: big.NewInt(1),
+		Accesses:  &ethtypes.AccessList{},
+	}
+	msgEthereumTx := evmtypes.NewTx(&txArgs)
+	msgEthereumTx.From = s.Address.String()
+	err := msgEthereumTx.Sign(s.EthSigner, s.Signer)
+	s.Require().NoError(err, "failed to sign Ethereum message")
+	cfg, err := s.App.EvmKeeper.EVMConfig(
+		s.Ctx, s.Ctx.BlockHeader().ProposerAddress, s.App.EvmKeeper.ChainID(),
+	)
+	s.Require().NoError(err, "failed to instantiate EVM config")
+	msg, err := msgEthereumTx.AsMessage(s.EthSigner, baseFee)
+	s.Require().NoError(err, "failed to instantiate Ethereum message")
+	evm := s.App.EvmKeeper.NewEVM(s.Ctx, msg, cfg, nil, s.StateDB)
+	params := s.App.EvmKeeper.GetParams(s.Ctx)
+	activePrecompiles := params.GetActivePrecompilesAddrs()
+	precompileMap := s.App.EvmKeeper.Precompiles(activePrecompiles...)
+	s.Require().NoError(vm.ValidatePrecompiles(precompileMap, activePrecompiles))
+	evm.WithPrecompiles(precompileMap, activePrecompiles)
+	return s.precompile.Run(evm, contract, true)
+}
+
+func (s *OraclePrecompileSuite) TestActivePrecompile() {
+	s.Require().Contains(s.App.EvmKeeper.GetParams(s.Ctx).ActivePrecompiles, s.precompile.Address().String())
+	s.Require().True(s.App.EvmKeeper.IsAvailablePrecompile(s.precompile.Address()))
+}
+
+func (s *OraclePrecompileSuite) TestQueries() {
+	tokenID := uint64(1)
+	token := s.App.OracleKeeper.GetParams(s.Ctx).Tokens[tokenID]
+	appendPrice := func(price string) oracletypes.PriceTimeRound {
+		priceTR := oracletypes.PriceTimeRound{
+			Price:     price,
+			Decimal:   token.Decimal,
+			Timestamp: "2024-01-02 15:04:05",
+			RoundID:   s.App.OracleKeeper.GetNextRoundID(s.Ctx, tokenID),
+		}
+		s.Require().True(s.App.OracleKeeper.AppendPriceTR(s.Ctx, tokenID, priceTR))
+		return priceTR
+	}
+	pack := func(method string, args ...interface{}) []byte {
+		bz, err := s.precompile.Methods[method].Outputs.Pack(args...)
+		s.Require().NoError(err)
+		return bz
+	}
+	bigPrice := func(price string) *big.Int {
+		ret, ok := new(big.Int).SetString(price, 10)
+		s.Require().True(ok)
+		return ret
+	}
+	// #nosec G701 // the decimal of the test token fits in uint8
+	decimal := uint8(token.Decimal)
+
+	testCases := []struct {
+		name     string
+		malleate func() (method string, args []interface{}, expected []byte)
+	}{
+		{
+			name: "latest price",
+			malleate: func() (string, []interface{}, []byte) {
+				appendPrice("300000000000")
+				latest := appendPrice("312345000000")
+				return oracle.MethodGetLatestPrice, []interface{}{tokenID},
+					pack(oracle.MethodGetLatestPrice, true, bigPrice(latest.Price), decimal, latest.RoundID, latest.Timestamp)
+			},
+		},
+		{
+			name: "price by round",
+			malleate: func() (string, []interface{}, []byte) {
+				first := appendPrice("300000000000")
+				appendPrice("312345000000")
+				return oracle.MethodGetPriceByRound, []interface{}{tokenID, first.RoundID},
+					pack(oracle.MethodGetPriceByRound, true, bigPrice(first.Price), decimal, first.Timestamp)
+			},
+		},
+		{
+			name: "fail - price of a future round",
+			malleate: func() (string, []interface{}, []byte) {
+				latest := appendPrice("300000000000")
+				return oracle.MethodGetPriceByRound, []interface{}{tokenID, latest.RoundID + 1},
+					pack(oracle.MethodGetPriceByRound, false, new(big.Int), uint8(0), "")
+			},
+		},
+		{
+			name: "fail - stale latest price",
+			malleate: func() (string, []interface{}, []byte) {
+				p := s.App.OracleKeeper.GetParams(s.Ctx)
+				p.Tokens[tokenID].MaxPriceAge = 1
+				s.App.OracleKeeper.SetParams(s.Ctx, p)
+				appendPrice("300000000000")
+				s.App.OracleKeeper.SetPriceUpdatedHeight(s.Ctx, tokenID, s.Ctx.BlockHeight()-2)
+				return oracle.MethodGetLatestPrice, []interface{}{tokenID},
+					pack(oracle.MethodGetLatestPrice, false, new(big.Int), uint8(0), uint64(0), "")
+			},
+		},
+		{
+			name: "fail - token not registered",
+			malleate: func() (string, []interface{}, []byte) {
+				return oracle.MethodGetLatestPrice, []interface{}{uint64(100)},
+					pack(oracle.MethodGetLatestPrice, false, new(big.Int), uint8(0), uint64(0), "")
+			},
+		},
+		{
+			name: "fail - reserved token index",
+			malleate: func() (string, []interface{}, []byte) {
+				return oracle.MethodGetDecimal, []interface{}{uint64(0)},
+					pack(oracle.MethodGetDecimal, false, uint8(0))
+			},
+		},
+		{
+			name: "tokens",
+			malleate: func() (string, []interface{}, []byte) {
+				var names, assetIDs []string
+				for _, token := range s.App.OracleKeeper.GetParams(s.Ctx).Tokens {
+					names = append(names, token.Name)
+					assetIDs = append(assetIDs, token.AssetID)
+				}
+				return oracle.MethodGetTokens, nil, pack(oracle.MethodGetTokens, true, names, assetIDs)
+			},
+		},
+		{
+			name: "decimal",
+			malleate: func() (string, []interface{}, []byte) {
+				return oracle.MethodGetDecimal, []interface{}{tokenID}, pack(oracle.MethodGetDecimal, true, decimal)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			method, args, expected := tc.malleate()
+			input, err := s.precompile.Pack(method, args...)
+			s.Require().NoError(err, "failed to pack input")
+			bz, err := s.run(input)
+			s.Require().NoError(err)
+			s.Require().Equal(expected, bz)
+		})
+	}
+}
@@ -0,0 +1,150 @@
+package oracle
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+
+	exocmn "github.com/ExocoreNetwork/exocore/precompiles/common"
+	oracletypes "github.com/ExocoreNetwork/exocore/x/oracle/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	cmn "github.com/evmos/evmos/v16/precompiles/common"
+)
+
+const (
+	MethodGetLatestPrice  = "getLatestPrice"
+	MethodGetPriceByRound = "getPriceByRound"
+	MethodGetTokens       = "getTokens"
+	MethodGetDecimal      = "getDecimal"
+)
+
+// GetLatestPrice returns the latest price of a token
+func (p Precompile) GetLatestPrice(
+	ctx sdk.Context,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	if len(args) != len(p.ABI.Methods[MethodGetLatestPrice].Inputs) {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, len(p.ABI.Methods[MethodGetLatestPrice].Inputs), len(args))
+	}
+	tokenID, err := p.tokenIDFromInput(ctx, args[0], 0)
+	if err != nil {
+		return nil, err
+	}
+	priceTR, found := p.oracleKeeper.GetPriceTRLatest(ctx, tokenID)
+	if !found {
+		return nil, oracletypes.ErrGetPriceRoundNotFound.Wrapf("no valid price for tokenID=%d", tokenID)
+	}
+	// the stale price is treated as missing, as it is for the valuation of the assets
+	if p.oracleKeeper.IsPriceStale(ctx, p.oracleKeeper.GetParams(ctx), tokenID) {
+		return nil, oracletypes.ErrGetPriceRoundNotFound.Wrapf("stale price for tokenID=%d", tokenID)
+	}
+	price, decimal, err := parsePriceTR(priceTR)
+	if err != nil {
+		return nil, err
+	}
+	return method.Outputs.Pack(true, price, decimal, priceTR.RoundID, priceTR.Timestamp)
+}
+
+// GetPriceByRound returns the price of a token finalized in the specified round
+func (p Precompile) GetPriceByRound(
+	ctx sdk.Context,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	if len(args) != len(p.ABI.Methods[MethodGetPriceByRound].Inputs) {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, len(p.ABI.Methods[MethodGetPriceByRound].Inputs), len(args))
+	}
+	tokenID, err := p.tokenIDFromInput(ctx, args[0], 0)
+	if err != nil {
+		return nil, err
+	}
+	roundID, ok := args[1].(uint64)
+	if !ok || roundID == 0 {
+		return nil, fmt.Errorf(exocmn.ErrContractInputParaOrType, 1, "uint64", args[1])
+	}
+	priceTR, found := p.oracleKeeper.GetPriceTRRoundID(ctx, tokenID, roundID)
+	if !found {
+		return nil, oracletypes.ErrGetPriceRoundNotFound.Wrapf("no valid price for tokenID=%d, roundID=%d", tokenID, roundID)
+	}
+	price, decimal, err := parsePriceTR(priceTR)
+	if err != nil {
+		return nil, err
+	}
+	return method.Outputs.Pack(true, price, decimal, priceTR.Timestamp)
+}
+
+// GetTokens returns the names and assetIDs of the tokens registered in the oracle, indexed by
+// the tokenIDs
+func (p Precompile) GetTokens(
+	ctx sdk.Context,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	if len(args) != len(p.ABI.Methods[MethodGetTokens].Inputs) {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, len(p.ABI.Methods[MethodGetTokens].Inputs), len(args))
+	}
+	tokens := p.oracleKeeper.GetParams(ctx).Tokens
+	names := make([]string, 0, len(tokens))
+	assetIDs := make([]string, 0, len(tokens))
+	for _, token := range tokens {
+		names = append(names, token.Name)
+		assetIDs = append(assetIDs, token.AssetID)
+	}
+	return method.Outputs.Pack(true, names, assetIDs)
+}
+
+// GetDecimal returns the decimal of the prices of a token
+func (p Precompile) GetDecimal(
+	ctx sdk.Context,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	if len(args) != len(p.ABI.Methods[MethodGetDecimal].Inputs) {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, len(p.ABI.Methods[MethodGetDecimal].Inputs), len(args))
+	}
+	tokenID, err := p.tokenIDFromInput(ctx, args[0], 0)
+	if err != nil {
+		return nil, err
+	}
+	decimal, err := decimalToUint8(p.oracleKeeper.GetParams(ctx).Tokens[tokenID].Decimal)
+	if err != nil {
+		return nil, err
+	}
+	return method.Outputs.Pack(true, decimal)
+}
+
+// tokenIDFromInput parses the tokenID at the index of the inputs, the tokenID must refer to a
+// token registered in the oracle params
+func (p Precompile) tokenIDFromInput(ctx sdk.Context, arg interface{}, index int) (uint64, error) {
+	tokenID, ok := arg.(uint64)
+	if !ok {
+		return 0, fmt.Errorf(exocmn.ErrContractInputParaOrType, index, "uint64", arg)
+	}
+	// the index 0 of the tokens is reserved
+	if tokenID == 0 || tokenID >= uint64(len(p.oracleKeeper.GetParams(ctx).Tokens)) {
+		return 0, oracletypes.ErrInvalidParams.Wrapf("tokenID %d does not exist in oracle", tokenID)
+	}
+	return tokenID, nil
+}
+
+// parsePriceTR converts the price and decimal of a PriceTimeRound to the types of the ABI outputs
+func parsePriceTR(priceTR oracletypes.PriceTimeRound) (*big.Int, uint8, error) {
+	price, ok := new(big.Int).SetString(priceTR.Price, 10)
+	if !ok || price.Sign() < 0 {
+		return nil, 0, fmt.Errorf("invalid price %s in round %d", priceTR.Price, priceTR.RoundID)
+	}
+	decimal, err := decimalToUint8(priceTR.Decimal)
+	if err != nil {
+		return nil, 0, err
+	}
+	return price, decimal, nil
+}
+
+func decimalToUint8(decimal int32) (uint8, error) {
+	if decimal < 0 || decimal > math.MaxUint8 {
+		return 0, fmt.Errorf("decimal %d out of the range of uint8", decimal)
+	}
+	return uint8(decimal), nil
+}
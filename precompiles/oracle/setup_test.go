@@ -0,0 +1,29 @@
+package oracle_test
+
+import (
+	"testing"
+
+	"github.com/ExocoreNetwork/exocore/precompiles/oracle"
+	"github.com/ExocoreNetwork/exocore/testutil"
+	"github.com/stretchr/testify/suite"
+)
+
+var s *OraclePrecompileSuite
+
+type OraclePrecompileSuite struct {
+	testutil.BaseTestSuite
+
+	precompile *oracle.Precompile
+}
+
+func TestPrecompileTestSuite(t *testing.T) {
+	s = new(OraclePrecompileSuite)
+	suite.Run(t, s)
+}
+
+func (s *OraclePrecompileSuite) SetupTest() {
+	s.DoSetupTest()
+	precompile, err := oracle.NewPrecompile(s.App.OracleKeeper)
+	s.Require().NoError(err)
+	s.precompile = precompile
+}
@@ -0,0 +1,387 @@
+package keeper
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	keytypes "github.com/ExocoreNetwork/exocore/types/keys"
+	commontypes "github.com/ExocoreNetwork/exocore/x/appchain/common/types"
+	coordinatorkeeper "github.com/ExocoreNetwork/exocore/x/appchain/coordinator/keeper"
+	coordinatortypes "github.com/ExocoreNetwork/exocore/x/appchain/coordinator/types"
+	subscriberkeeper "github.com/ExocoreNetwork/exocore/x/appchain/subscriber/keeper"
+	subscribertypes "github.com/ExocoreNetwork/exocore/x/appchain/subscriber/types"
+	avstypes "github.com/ExocoreNetwork/exocore/x/avs/types"
+	epochstypes "github.com/ExocoreNetwork/exocore/x/epochs/types"
+	tmdb "github.com/cometbft/cometbft-db"
+	"github.com/cometbft/cometbft/libs/log"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/store"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
+	clienttypes "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
+	conntypes "github.com/cosmos/ibc-go/v7/modules/core/03-connection/types"
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
+	commitmenttypes "github.com/cosmos/ibc-go/v7/modules/core/23-commitment/types"
+	host "github.com/cosmos/ibc-go/v7/modules/core/24-host"
+	ibcexported "github.com/cosmos/ibc-go/v7/modules/core/exported"
+	ibctmtypes "github.com/cosmos/ibc-go/v7/modules/light-clients/07-tendermint"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+)
+
+// CoordinatorKeeper returns a coordinator keeper backed by an in-memory store, along with the
+// mocks of the keepers it depends on. The default params are set and the port is bound.
+func CoordinatorKeeper(t testing.TB) (coordinatorkeeper.Keeper, sdk.Context, *AppchainMocks) {
+	ctx, cdc, storeKey := appchainStore(t, coordinatortypes.StoreKey)
+	mocks := NewAppchainMocks()
+	k := coordinatorkeeper.NewKeeper(
+		cdc,
+		storeKey,
+		mocks.AVS,
+		mocks.Epochs,
+		mocks.Operator,
+		mocks.Delegation,
+		mocks.Staking,
+		mocks.IBC,
+		mocks.IBC,
+		mocks.IBC,
+		mocks.IBC,
+		mocks.IBC,
+	)
+	k.InitGenesis(ctx, *coordinatortypes.DefaultGenesis())
+	return k, ctx, mocks
+}
+
+// SubscriberKeeper returns a subscriber keeper backed by an in-memory store, along with the
+// mocks of the keepers it depends on. The default params are set and the port is bound.
+func SubscriberKeeper(t testing.TB) (subscriberkeeper.Keeper, sdk.Context, *AppchainMocks) {
+	ctx, cdc, storeKey := appchainStore(t, subscribertypes.StoreKey)
+	mocks := NewAppchainMocks()
+	k := subscriberkeeper.NewKeeper(
+		cdc,
+		storeKey,
+		mocks.IBC,
+		mocks.IBC,
+		mocks.IBC,
+		mocks.IBC,
+		mocks.IBC,
+	)
+	k.SetParams(ctx, commontypes.DefaultSubscriberParams())
+	require.NoError(t, k.BindPort(ctx, subscribertypes.PortID))
+	return k, ctx, mocks
+}
+
+// appchainStore mounts an in-memory store for the module and returns a context over it.
+func appchainStore(t testing.TB, name string) (sdk.Context, codec.Codec, storetypes.StoreKey) {
+	storeKey := sdk.NewKVStoreKey(name)
+	db := tmdb.NewMemDB()
+	stateStore := store.NewCommitMultiStore(db)
+	stateStore.MountStoreWithDB(storeKey, storetypes.StoreTypeIAVL, db)
+	require.NoError(t, stateStore.LoadLatestVersion())
+
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+	ctx := sdk.NewContext(stateStore, tmproto.Header{
+		Height: 1,
+		Time:   time.Now().UTC(),
+	}, false, log.NewNopLogger())
+	return ctx, cdc, storeKey
+}
+
+// AppchainMocks groups the mocks of the keepers used by the coordinator and subscriber
+// modules.
+type AppchainMocks struct {
+	AVS        *MockAVSKeeper
+	Epochs     *MockEpochsKeeper
+	Operator   *MockOperatorKeeper
+	Delegation *MockDelegationKeeper
+	Staking    *MockStakingKeeper
+	IBC        *MockIBCKeeper
+}
+
+// NewAppchainMocks returns empty mocks.
+func NewAppchainMocks() *AppchainMocks {
+	return &AppchainMocks{
+		AVS:    &MockAVSKeeper{},
+		Epochs: &MockEpochsKeeper{Epochs: make(map[string]epochstypes.EpochInfo)},
+		Operator: &MockOperatorKeeper{
+			consKeys:     make(map[string]keytypes.WrappedConsKey),
+			prevConsKeys: make(map[string]keytypes.WrappedConsKey),
+			powers:       make(map[string]int64),
+		},
+		Delegation: &MockDelegationKeeper{HoldCounts: make(map[string]uint64)},
+		Staking:    &MockStakingKeeper{},
+		IBC: &MockIBCKeeper{
+			Clients:      make(map[string]ibcexported.ClientState),
+			Connections:  make(map[string]conntypes.ConnectionEnd),
+			Channels:     make(map[string]channeltypes.Channel),
+			capabilities: make(map[string]*capabilitytypes.Capability),
+		},
+	}
+}
+
+// MockAVSKeeper accepts the registration and deletion of any AVS.
+type MockAVSKeeper struct{}
+
+func (*MockAVSKeeper) RegisterAVSWithChainID(
+	sdk.Context, *avstypes.AVSRegisterOrDeregisterParams,
+) (common.Address, error) {
+	return common.Address{}, nil
+}
+
+func (*MockAVSKeeper) IsAVSByChainID(sdk.Context, string) (bool, common.Address) {
+	return true, common.Address{}
+}
+
+func (*MockAVSKeeper) DeleteAVSInfo(sdk.Context, common.Address) error {
+	return nil
+}
+
+// MockEpochsKeeper returns the epochs stored in it.
+type MockEpochsKeeper struct {
+	Epochs map[string]epochstypes.EpochInfo
+}
+
+func (m *MockEpochsKeeper) GetEpochInfo(_ sdk.Context, identifier string) (epochstypes.EpochInfo, bool) {
+	info, found := m.Epochs[identifier]
+	return info, found
+}
+
+// MockStakingKeeper has a fixed unbonding time.
+type MockStakingKeeper struct{}
+
+func (*MockStakingKeeper) UnbondingTime(sdk.Context) time.Duration {
+	return 7 * 24 * time.Hour
+}
+
+// MockOperatorKeeper tracks the consensus keys and the vote power of the operators opted into
+// each chain. The chain IDs are without the revision.
+type MockOperatorKeeper struct {
+	operators    []sdk.AccAddress
+	consKeys     map[string]keytypes.WrappedConsKey
+	prevConsKeys map[string]keytypes.WrappedConsKey
+	powers       map[string]int64
+	// RemovedKeys lists the key removals completed, as chainID/operator.
+	RemovedKeys []string
+}
+
+func operatorChainKey(operator sdk.AccAddress, chainID string) string {
+	return fmt.Sprintf("%s/%s", chainID, operator)
+}
+
+// SetOperator opts the operator into the chain with the key and the power. If the operator
+// already had a key, it becomes the previous key.
+func (m *MockOperatorKeeper) SetOperator(
+	operator sdk.AccAddress, chainID string, key keytypes.WrappedConsKey, power int64,
+) {
+	k := operatorChainKey(operator, chainID)
+	if prev, found := m.consKeys[k]; found && !prev.EqualsWrapped(key) {
+		m.prevConsKeys[k] = prev
+	}
+	m.consKeys[k] = key
+	m.powers[k] = power
+	for _, op := range m.operators {
+		if op.Equals(operator) {
+			return
+		}
+	}
+	m.operators = append(m.operators, operator)
+}
+
+// RemoveOperator opts the operator out of the chain, keeping its key as the previous key.
+func (m *MockOperatorKeeper) RemoveOperator(operator sdk.AccAddress, chainID string) {
+	k := operatorChainKey(operator, chainID)
+	if key, found := m.consKeys[k]; found {
+		m.prevConsKeys[k] = key
+	}
+	delete(m.consKeys, k)
+	delete(m.powers, k)
+}
+
+func (m *MockOperatorKeeper) GetActiveOperatorsForChainID(
+	_ sdk.Context, chainID string,
+) ([]sdk.AccAddress, []keytypes.WrappedConsKey) {
+	operators := make([]sdk.AccAddress, 0)
+	keys := make([]keytypes.WrappedConsKey, 0)
+	for _, operator := range m.operators {
+		if key, found := m.consKeys[operatorChainKey(operator, chainID)]; found {
+			operators = append(operators, operator)
+			keys = append(keys, key)
+		}
+	}
+	return operators, keys
+}
+
+func (m *MockOperatorKeeper) GetVotePowerForChainID(
+	_ sdk.Context, operators []sdk.AccAddress, chainID string,
+) ([]int64, error) {
+	powers := make([]int64, len(operators))
+	for i, operator := range operators {
+		powers[i] = m.powers[operatorChainKey(operator, chainID)]
+	}
+	return powers, nil
+}
+
+func (m *MockOperatorKeeper) GetOperatorConsKeyForChainID(
+	_ sdk.Context, operator sdk.AccAddress, chainID string,
+) (bool, keytypes.WrappedConsKey, error) {
+	key, found := m.consKeys[operatorChainKey(operator, chainID)]
+	return found, key, nil
+}
+
+func (m *MockOperatorKeeper) GetOperatorPrevConsKeyForChainID(
+	_ sdk.Context, operator sdk.AccAddress, chainID string,
+) (bool, keytypes.WrappedConsKey, error) {
+	key, found := m.prevConsKeys[operatorChainKey(operator, chainID)]
+	return found, key, nil
+}
+
+func (m *MockOperatorKeeper) CompleteOperatorKeyRemovalForChainID(
+	_ sdk.Context, operator sdk.AccAddress, chainID string,
+) error {
+	k := operatorChainKey(operator, chainID)
+	delete(m.prevConsKeys, k)
+	m.RemovedKeys = append(m.RemovedKeys, k)
+	return nil
+}
+
+// MockDelegationKeeper tracks the hold count of each undelegation record.
+type MockDelegationKeeper struct {
+	HoldCounts map[string]uint64
+}
+
+func (m *MockDelegationKeeper) IncrementUndelegationHoldCount(_ sdk.Context, recordKey []byte) error {
+	m.HoldCounts[string(recordKey)]++
+	return nil
+}
+
+func (m *MockDelegationKeeper) DecrementUndelegationHoldCount(_ sdk.Context, recordKey []byte) error {
+	if m.HoldCounts[string(recordKey)] == 0 {
+		return fmt.Errorf("cannot decrement the hold count of %x below zero", recordKey)
+	}
+	m.HoldCounts[string(recordKey)]--
+	return nil
+}
+
+// SentPacket is a packet sent through the MockIBCKeeper.
+type SentPacket struct {
+	PortID    string
+	ChannelID string
+	Data      []byte
+}
+
+// MockIBCKeeper implements the client, scoped, port, channel and connection keepers over
+// in-memory maps. The packets sent are recorded instead of being relayed.
+type MockIBCKeeper struct {
+	Clients     map[string]ibcexported.ClientState
+	Connections map[string]conntypes.ConnectionEnd
+	// Channels are indexed by the port ID and the channel ID.
+	Channels     map[string]channeltypes.Channel
+	capabilities map[string]*capabilitytypes.Capability
+	nextCapIndex uint64
+	SentPackets  []SentPacket
+	// SendErr, if set, is returned by SendPacket.
+	SendErr error
+}
+
+// AddConnection stores a connection built on top of a new tendermint client of the chain,
+// and returns the client ID.
+func (m *MockIBCKeeper) AddConnection(connectionID string, chainID string) string {
+	clientID := fmt.Sprintf("%s-%d", ibcexported.Tendermint, len(m.Clients))
+	m.Clients[clientID] = &ibctmtypes.ClientState{ChainId: chainID}
+	m.Connections[connectionID] = conntypes.ConnectionEnd{ClientId: clientID}
+	return clientID
+}
+
+// OpenChannel stores an open channel over the connection, and gives its capability to the
+// module bound to the port.
+func (m *MockIBCKeeper) OpenChannel(
+	portID, channelID, connectionID string, counterparty channeltypes.Counterparty,
+) *capabilitytypes.Capability {
+	m.Channels[host.ChannelPath(portID, channelID)] = channeltypes.NewChannel(
+		channeltypes.OPEN, channeltypes.ORDERED, counterparty,
+		[]string{connectionID}, commontypes.Version,
+	)
+	capability := m.newCapability()
+	m.capabilities[host.ChannelCapabilityPath(portID, channelID)] = capability
+	return capability
+}
+
+func (m *MockIBCKeeper) newCapability() *capabilitytypes.Capability {
+	m.nextCapIndex++
+	return capabilitytypes.NewCapability(m.nextCapIndex)
+}
+
+func (m *MockIBCKeeper) CreateClient(
+	_ sdk.Context, clientState ibcexported.ClientState, _ ibcexported.ConsensusState,
+) (string, error) {
+	clientID := fmt.Sprintf("%s-%d", ibcexported.Tendermint, len(m.Clients))
+	m.Clients[clientID] = clientState
+	return clientID, nil
+}
+
+func (m *MockIBCKeeper) GetClientState(_ sdk.Context, clientID string) (ibcexported.ClientState, bool) {
+	clientState, found := m.Clients[clientID]
+	return clientState, found
+}
+
+func (*MockIBCKeeper) GetLatestClientConsensusState(sdk.Context, string) (ibcexported.ConsensusState, bool) {
+	return nil, false
+}
+
+func (*MockIBCKeeper) GetSelfConsensusState(ctx sdk.Context, _ ibcexported.Height) (ibcexported.ConsensusState, error) {
+	return ibctmtypes.NewConsensusState(
+		ctx.BlockTime(), commitmenttypes.NewMerkleRoot([]byte(ibctmtypes.SentinelRoot)), nil,
+	), nil
+}
+
+func (m *MockIBCKeeper) GetCapability(_ sdk.Context, name string) (*capabilitytypes.Capability, bool) {
+	capability, found := m.capabilities[name]
+	return capability, found
+}
+
+func (m *MockIBCKeeper) AuthenticateCapability(_ sdk.Context, capability *capabilitytypes.Capability, name string) bool {
+	return m.capabilities[name] == capability
+}
+
+func (m *MockIBCKeeper) ClaimCapability(_ sdk.Context, capability *capabilitytypes.Capability, name string) error {
+	m.capabilities[name] = capability
+	return nil
+}
+
+func (m *MockIBCKeeper) BindPort(sdk.Context, string) *capabilitytypes.Capability {
+	return m.newCapability()
+}
+
+func (m *MockIBCKeeper) GetChannel(_ sdk.Context, portID, channelID string) (channeltypes.Channel, bool) {
+	channel, found := m.Channels[host.ChannelPath(portID, channelID)]
+	return channel, found
+}
+
+func (m *MockIBCKeeper) SendPacket(
+	_ sdk.Context,
+	_ *capabilitytypes.Capability,
+	sourcePort string,
+	sourceChannel string,
+	_ clienttypes.Height,
+	_ uint64,
+	data []byte,
+) (uint64, error) {
+	if m.SendErr != nil {
+		return 0, m.SendErr
+	}
+	m.SentPackets = append(m.SentPackets, SentPacket{
+		PortID:    sourcePort,
+		ChannelID: sourceChannel,
+		Data:      data,
+	})
+	return uint64(len(m.SentPackets)), nil
+}
+
+func (m *MockIBCKeeper) GetConnection(_ sdk.Context, connectionID string) (conntypes.ConnectionEnd, bool) {
+	connection, found := m.Connections[connectionID]
+	return connection, found
+}
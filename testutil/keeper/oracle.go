@@ -17,6 +17,7 @@ import (
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	typesparams "github.com/cosmos/cosmos-sdk/x/params/types"
+	slashingkeeper "github.com/cosmos/cosmos-sdk/x/slashing/keeper"
 
 	assetskeeper "github.com/ExocoreNetwork/exocore/x/assets/keeper"
 	delegationkeeper "github.com/ExocoreNetwork/exocore/x/delegation/keeper"
@@ -51,6 +52,7 @@ func OracleKeeper(t testing.TB) (*keeper.Keeper, sdk.Context) {
 		dogfoodkeeper.Keeper{},
 		delegationkeeper.Keeper{},
 		assetskeeper.Keeper{},
+		slashingkeeper.Keeper{},
 		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
 
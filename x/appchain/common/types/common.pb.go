@@ -317,10 +317,254 @@ func (m *CoordinatorInfo) GetInitialValSet() []types.ValidatorUpdate {
 	return nil
 }
 
+// HandshakeMetadata is the metadata exchanged by the coordinator and the subscriber
+// during the channel handshake. It is sent by the coordinator as its version in
+// OnChanOpenTry and received by the subscriber in OnChanOpenAck.
+type HandshakeMetadata struct {
+	// coordinator_fee_pool_addr is the address on the coordinator chain to which the
+	// subscriber chain sends its rewards.
+	CoordinatorFeePoolAddr string `protobuf:"bytes,1,opt,name=coordinator_fee_pool_addr,json=coordinatorFeePoolAddr,proto3" json:"coordinator_fee_pool_addr,omitempty"`
+	// version is the version of the appchain protocol.
+	Version string `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`
+}
+
+func (m *HandshakeMetadata) Reset()         { *m = HandshakeMetadata{} }
+func (m *HandshakeMetadata) String() string { return proto.CompactTextString(m) }
+func (*HandshakeMetadata) ProtoMessage()    {}
+func (*HandshakeMetadata) Descriptor() ([]byte, []int) {
+	return fileDescriptor_71cb7b22d050d7a3, []int{3}
+}
+func (m *HandshakeMetadata) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HandshakeMetadata) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_HandshakeMetadata.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *HandshakeMetadata) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HandshakeMetadata.Merge(m, src)
+}
+func (m *HandshakeMetadata) XXX_Size() int {
+	return m.Size()
+}
+func (m *HandshakeMetadata) XXX_DiscardUnknown() {
+	xxx_messageInfo_HandshakeMetadata.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HandshakeMetadata proto.InternalMessageInfo
+
+func (m *HandshakeMetadata) GetCoordinatorFeePoolAddr() string {
+	if m != nil {
+		return m.CoordinatorFeePoolAddr
+	}
+	return ""
+}
+
+func (m *HandshakeMetadata) GetVersion() string {
+	if m != nil {
+		return m.Version
+	}
+	return ""
+}
+
+// ValidatorSetChangePacketData is the packet data sent by the coordinator to the
+// subscriber at the end of each epoch of the subscriber, if its validator set has
+// changed.
+type ValidatorSetChangePacketData struct {
+	// validator_updates is the diff of the validator set of the subscriber. A power
+	// of 0 indicates the removal of the validator.
+	ValidatorUpdates []types.ValidatorUpdate `protobuf:"bytes,1,rep,name=validator_updates,json=validatorUpdates,proto3" json:"validator_updates"`
+	// valset_update_id is the monotonically increasing ID of the validator set change,
+	// unique per subscriber chain. The initial validator set has an ID of 0.
+	ValsetUpdateID uint64 `protobuf:"varint,2,opt,name=valset_update_id,json=valsetUpdateId,proto3" json:"valset_update_id,omitempty"`
+}
+
+func (m *ValidatorSetChangePacketData) Reset()         { *m = ValidatorSetChangePacketData{} }
+func (m *ValidatorSetChangePacketData) String() string { return proto.CompactTextString(m) }
+func (*ValidatorSetChangePacketData) ProtoMessage()    {}
+func (*ValidatorSetChangePacketData) Descriptor() ([]byte, []int) {
+	return fileDescriptor_71cb7b22d050d7a3, []int{4}
+}
+func (m *ValidatorSetChangePacketData) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorSetChangePacketData) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorSetChangePacketData.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ValidatorSetChangePacketData) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorSetChangePacketData.Merge(m, src)
+}
+func (m *ValidatorSetChangePacketData) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorSetChangePacketData) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorSetChangePacketData.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorSetChangePacketData proto.InternalMessageInfo
+
+func (m *ValidatorSetChangePacketData) GetValidatorUpdates() []types.ValidatorUpdate {
+	if m != nil {
+		return m.ValidatorUpdates
+	}
+	return nil
+}
+
+func (m *ValidatorSetChangePacketData) GetValsetUpdateID() uint64 {
+	if m != nil {
+		return m.ValsetUpdateID
+	}
+	return 0
+}
+
+// VscMaturedPacketData is the packet data sent by the subscriber to the coordinator
+// once the unbonding period of the subscriber has elapsed since the receipt of a
+// validator set change packet.
+type VscMaturedPacketData struct {
+	// valset_update_id is the ID of the validator set change that has matured.
+	ValsetUpdateID uint64 `protobuf:"varint,1,opt,name=valset_update_id,json=valsetUpdateId,proto3" json:"valset_update_id,omitempty"`
+}
+
+func (m *VscMaturedPacketData) Reset()         { *m = VscMaturedPacketData{} }
+func (m *VscMaturedPacketData) String() string { return proto.CompactTextString(m) }
+func (*VscMaturedPacketData) ProtoMessage()    {}
+func (*VscMaturedPacketData) Descriptor() ([]byte, []int) {
+	return fileDescriptor_71cb7b22d050d7a3, []int{5}
+}
+func (m *VscMaturedPacketData) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VscMaturedPacketData) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VscMaturedPacketData.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *VscMaturedPacketData) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VscMaturedPacketData.Merge(m, src)
+}
+func (m *VscMaturedPacketData) XXX_Size() int {
+	return m.Size()
+}
+func (m *VscMaturedPacketData) XXX_DiscardUnknown() {
+	xxx_messageInfo_VscMaturedPacketData.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VscMaturedPacketData proto.InternalMessageInfo
+
+func (m *VscMaturedPacketData) GetValsetUpdateID() uint64 {
+	if m != nil {
+		return m.ValsetUpdateID
+	}
+	return 0
+}
+
+// SubscriberPacketData is the packet data sent by the subscriber to the coordinator.
+type SubscriberPacketData struct {
+	// data is the packet data, one of the supported types.
+	//
+	// Types that are valid to be assigned to Data:
+	//	*SubscriberPacketData_VscMaturedPacketData
+	Data isSubscriberPacketData_Data `protobuf_oneof:"data"`
+}
+
+func (m *SubscriberPacketData) Reset()         { *m = SubscriberPacketData{} }
+func (m *SubscriberPacketData) String() string { return proto.CompactTextString(m) }
+func (*SubscriberPacketData) ProtoMessage()    {}
+func (*SubscriberPacketData) Descriptor() ([]byte, []int) {
+	return fileDescriptor_71cb7b22d050d7a3, []int{6}
+}
+func (m *SubscriberPacketData) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SubscriberPacketData) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SubscriberPacketData.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SubscriberPacketData) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SubscriberPacketData.Merge(m, src)
+}
+func (m *SubscriberPacketData) XXX_Size() int {
+	return m.Size()
+}
+func (m *SubscriberPacketData) XXX_DiscardUnknown() {
+	xxx_messageInfo_SubscriberPacketData.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SubscriberPacketData proto.InternalMessageInfo
+
+type isSubscriberPacketData_Data interface {
+	isSubscriberPacketData_Data()
+	MarshalTo([]byte) (int, error)
+	Size() int
+}
+
+type SubscriberPacketData_VscMaturedPacketData struct {
+	VscMaturedPacketData *VscMaturedPacketData `protobuf:"bytes,1,opt,name=vsc_matured_packet_data,json=vscMaturedPacketData,proto3,oneof" json:"vsc_matured_packet_data,omitempty"`
+}
+
+func (*SubscriberPacketData_VscMaturedPacketData) isSubscriberPacketData_Data() {}
+
+func (m *SubscriberPacketData) GetData() isSubscriberPacketData_Data {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+func (m *SubscriberPacketData) GetVscMaturedPacketData() *VscMaturedPacketData {
+	if x, ok := m.GetData().(*SubscriberPacketData_VscMaturedPacketData); ok {
+		return x.VscMaturedPacketData
+	}
+	return nil
+}
+
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*SubscriberPacketData) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*SubscriberPacketData_VscMaturedPacketData)(nil),
+	}
+}
+
 func init() {
 	proto.RegisterType((*SubscriberParams)(nil), "exocore.appchain.common.v1.SubscriberParams")
 	proto.RegisterType((*SubscriberGenesisState)(nil), "exocore.appchain.common.v1.SubscriberGenesisState")
 	proto.RegisterType((*CoordinatorInfo)(nil), "exocore.appchain.common.v1.CoordinatorInfo")
+	proto.RegisterType((*HandshakeMetadata)(nil), "exocore.appchain.common.v1.HandshakeMetadata")
+	proto.RegisterType((*ValidatorSetChangePacketData)(nil), "exocore.appchain.common.v1.ValidatorSetChangePacketData")
+	proto.RegisterType((*VscMaturedPacketData)(nil), "exocore.appchain.common.v1.VscMaturedPacketData")
+	proto.RegisterType((*SubscriberPacketData)(nil), "exocore.appchain.common.v1.SubscriberPacketData")
 }
 
 func init() {
@@ -328,59 +572,69 @@ func init() {
 }
 
 var fileDescriptor_71cb7b22d050d7a3 = []byte{
-	// 819 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x55, 0xc1, 0x6e, 0x1c, 0x45,
-	0x10, 0xf5, 0xc4, 0xc1, 0x24, 0xbd, 0x09, 0xbb, 0x6e, 0x25, 0xf1, 0x64, 0x81, 0xf5, 0xda, 0x42,
-	0xc2, 0x22, 0x30, 0xa3, 0x18, 0x09, 0x09, 0x71, 0x01, 0xdb, 0x09, 0x8a, 0x85, 0x8c, 0xb5, 0x1b,
-	0x82, 0x04, 0x12, 0xad, 0x9e, 0x9e, 0xda, 0xd9, 0x22, 0xb3, 0xdd, 0xa3, 0xee, 0x1e, 0x3b, 0xfc,
-	0x02, 0x27, 0x8e, 0x7c, 0x02, 0x47, 0xae, 0xfc, 0x41, 0x8e, 0x39, 0x72, 0x0a, 0xc8, 0x3e, 0xf0,
-	0x0f, 0x9c, 0x50, 0xf7, 0xcc, 0x78, 0x67, 0x1d, 0x19, 0xe7, 0xb2, 0xea, 0xee, 0x7a, 0xf5, 0xea,
-	0x55, 0xd5, 0x56, 0x0d, 0x79, 0x1f, 0x9e, 0x29, 0xa1, 0x34, 0xc4, 0xbc, 0x28, 0xc4, 0x94, 0xa3,
-	0x8c, 0x85, 0x9a, 0xcd, 0x94, 0x8c, 0x8f, 0xee, 0xd7, 0xa7, 0xa8, 0xd0, 0xca, 0x2a, 0xda, 0xaf,
-	0x81, 0x51, 0x03, 0x8c, 0x6a, 0xf3, 0xd1, 0xfd, 0xfe, 0x2a, 0x9f, 0xa1, 0x54, 0xb1, 0xff, 0xad,
-	0xe0, 0xfd, 0x5b, 0x99, 0xca, 0x94, 0x3f, 0xc6, 0xee, 0x54, 0xbf, 0x0e, 0x32, 0xa5, 0xb2, 0x1c,
-	0x62, 0x7f, 0x4b, 0xca, 0x49, 0x9c, 0x96, 0x9a, 0x5b, 0x6c, 0x82, 0xf4, 0x63, 0x4c, 0x44, 0x9c,
-	0x63, 0x36, 0xb5, 0x22, 0x47, 0x90, 0xd6, 0xc4, 0x16, 0x64, 0x0a, 0x7a, 0x86, 0xd2, 0x3a, 0x45,
-	0xf3, 0x5b, 0xed, 0xf0, 0x76, 0xcb, 0xce, 0x13, 0x81, 0xb1, 0xfd, 0xa9, 0x00, 0x53, 0x19, 0x37,
-	0xff, 0x5d, 0x21, 0xbd, 0x71, 0x99, 0x18, 0xa1, 0x31, 0x01, 0x7d, 0xc8, 0x35, 0x9f, 0x19, 0xfa,
-	0x39, 0x79, 0x57, 0x28, 0xa5, 0x53, 0x94, 0xdc, 0x2a, 0xcd, 0x26, 0x00, 0xac, 0x50, 0x2a, 0x67,
-	0x3c, 0x4d, 0x35, 0x33, 0x56, 0x87, 0xc1, 0x30, 0xd8, 0xba, 0x3e, 0xba, 0xdb, 0x02, 0x3d, 0x04,
-	0x38, 0x54, 0x2a, 0xff, 0x22, 0x4d, 0xf5, 0xd8, 0x6a, 0xba, 0x4f, 0x36, 0x52, 0x34, 0x56, 0x63,
-	0x52, 0x3a, 0xe9, 0xcc, 0x6a, 0x2e, 0xcd, 0x0c, 0x8d, 0x71, 0x17, 0x31, 0xe5, 0x52, 0x42, 0x1e,
-	0x5e, 0xf1, 0x2c, 0xeb, 0x6d, 0xe0, 0xe3, 0x16, 0x6e, 0xb7, 0x82, 0xd1, 0xaf, 0xc9, 0x7b, 0x49,
-	0xae, 0xc4, 0x53, 0xc3, 0x0a, 0xd0, 0xec, 0x42, 0xda, 0x70, 0x79, 0x18, 0x6c, 0x2d, 0x8f, 0x36,
-	0x2a, 0xec, 0x21, 0xe8, 0xbd, 0x0b, 0x78, 0xe9, 0x57, 0x64, 0xd3, 0x9c, 0xa5, 0xcc, 0x34, 0x2c,
-	0x50, 0x4e, 0x34, 0x17, 0xee, 0x10, 0x5e, 0xf5, 0xea, 0x86, 0x73, 0xe4, 0x68, 0x01, 0xf8, 0xb0,
-	0xc6, 0xd1, 0x0d, 0x72, 0x43, 0xc3, 0x31, 0xd7, 0x29, 0x4b, 0x41, 0xaa, 0x59, 0xf8, 0x86, 0xf7,
-	0xeb, 0x54, 0x6f, 0x7b, 0xee, 0x89, 0x02, 0xa1, 0x98, 0x08, 0x66, 0x71, 0x06, 0xaa, 0xb4, 0x2e,
-	0x0d, 0x54, 0x69, 0xb8, 0x32, 0x0c, 0xb6, 0x3a, 0xdb, 0x77, 0xa3, 0xaa, 0xdf, 0x51, 0xd3, 0xef,
-	0x68, 0xaf, 0xee, 0xf7, 0xce, 0x3b, 0xcf, 0x5f, 0xae, 0x2f, 0x9d, 0xbc, 0x5c, 0xef, 0x3d, 0xda,
-	0xd9, 0x7d, 0x5c, 0xf9, 0x1e, 0x7a, 0xd7, 0x5f, 0xff, 0x5a, 0x0f, 0x46, 0x3d, 0x4c, 0xc4, 0xc2,
-	0x2b, 0xfd, 0x9e, 0xac, 0xf9, 0x82, 0x4c, 0x40, 0x9f, 0x8f, 0xf5, 0xe6, 0x65, 0xb1, 0xae, 0xb9,
-	0x58, 0x9e, 0xf7, 0x76, 0xc3, 0xb1, 0x48, 0x7e, 0x40, 0x7a, 0xa5, 0x4c, 0x94, 0x4c, 0x51, 0x66,
-	0x0d, 0xeb, 0xb5, 0xd7, 0x67, 0xed, 0x9e, 0x39, 0xd7, 0x7c, 0x1f, 0x11, 0x3a, 0x45, 0x63, 0x95,
-	0x46, 0xc1, 0x73, 0x06, 0xd2, 0x6a, 0x04, 0x13, 0x5e, 0xf7, 0x3d, 0x5c, 0x9d, 0x5b, 0x1e, 0x54,
-	0x06, 0xfa, 0x09, 0x59, 0x33, 0x39, 0x37, 0xd3, 0xb3, 0xfe, 0xb0, 0x54, 0x1d, 0x4b, 0x97, 0x65,
-	0xd8, 0xf5, 0x05, 0xbf, 0xed, 0xcd, 0x4d, 0x57, 0xf6, 0x6a, 0x23, 0xfd, 0x81, 0xdc, 0x69, 0x80,
-	0xec, 0x47, 0x8e, 0x39, 0x6b, 0xa6, 0x29, 0xec, 0x5d, 0x26, 0xfe, 0x66, 0x23, 0xfe, 0xb7, 0x7f,
-	0x7e, 0xff, 0x20, 0x18, 0xdd, 0x6a, 0x78, 0xf6, 0x39, 0xe6, 0x0d, 0x88, 0x7e, 0x46, 0xfa, 0xaf,
-	0xe8, 0x2a, 0x93, 0x1c, 0x98, 0xc1, 0x4c, 0x86, 0xab, 0x5e, 0xda, 0xda, 0x39, 0x69, 0xce, 0x3e,
-	0xc6, 0x4c, 0x6e, 0xfe, 0x11, 0x90, 0x3b, 0xf3, 0xe1, 0xfb, 0x12, 0x24, 0x18, 0x34, 0x63, 0xcb,
-	0x2d, 0xd0, 0x7d, 0xb2, 0x52, 0xf8, 0x61, 0xf4, 0xb3, 0xd6, 0xd9, 0xfe, 0x30, 0xba, 0x78, 0xb7,
-	0x44, 0xe7, 0x07, 0x78, 0xe7, 0xaa, 0x93, 0x3e, 0xaa, 0x19, 0xe8, 0x98, 0x74, 0x5a, 0x93, 0xea,
-	0xc7, 0xae, 0xb3, 0x7d, 0xef, 0xff, 0x08, 0x77, 0xe7, 0xf0, 0x47, 0x72, 0xa2, 0x6a, 0xbe, 0x36,
-	0xcb, 0xe6, 0xcf, 0x57, 0x48, 0xf7, 0x1c, 0x8c, 0x1e, 0x90, 0x1b, 0xd5, 0x4e, 0x62, 0xc6, 0x25,
-	0x51, 0x4b, 0xbf, 0x17, 0x61, 0x22, 0xa2, 0xf6, 0xc6, 0x8a, 0x5a, 0x3b, 0xca, 0x45, 0xf3, 0xaf,
-	0x3e, 0xef, 0x51, 0x47, 0xcc, 0x2f, 0xf4, 0x5b, 0xd2, 0x15, 0x4a, 0x1a, 0x90, 0xa6, 0x34, 0x35,
-	0x65, 0x25, 0x3e, 0xba, 0x94, 0xb2, 0x71, 0xab, 0x58, 0xdf, 0x12, 0x0b, 0x77, 0x7a, 0x40, 0xba,
-	0x28, 0xd1, 0x22, 0xcf, 0xd9, 0x11, 0xcf, 0x99, 0x01, 0x1b, 0x2e, 0x0f, 0x97, 0xb7, 0x3a, 0xdb,
-	0xc3, 0x36, 0x8f, 0x5b, 0x96, 0xd1, 0x13, 0x9e, 0x63, 0xea, 0x32, 0xfc, 0xa6, 0x48, 0xb9, 0x85,
-	0xba, 0x14, 0x37, 0x6b, 0xf7, 0x27, 0x3c, 0x1f, 0x83, 0xdd, 0x19, 0x3f, 0x3f, 0x19, 0x04, 0x2f,
-	0x4e, 0x06, 0xc1, 0xdf, 0x27, 0x83, 0xe0, 0x97, 0xd3, 0xc1, 0xd2, 0x8b, 0xd3, 0xc1, 0xd2, 0x9f,
-	0xa7, 0x83, 0xa5, 0xef, 0x3e, 0xcd, 0xd0, 0x4e, 0xcb, 0xc4, 0xd5, 0x36, 0x7e, 0x50, 0x15, 0xfc,
-	0x00, 0xec, 0xb1, 0xd2, 0x4f, 0xe3, 0xe6, 0xab, 0xf2, 0xec, 0x95, 0xef, 0x8a, 0x5f, 0xd0, 0xc9,
-	0x8a, 0xff, 0x4b, 0x7e, 0xfc, 0x5f, 0x00, 0x00, 0x00, 0xff, 0xff, 0xb7, 0xc6, 0x07, 0xf5, 0x7f,
-	0x06, 0x00, 0x00,
+	// 989 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x56, 0x41, 0x6f, 0x1b, 0x45,
+	0x14, 0xce, 0x36, 0x21, 0x6d, 0xc7, 0x6d, 0xe3, 0x8c, 0xd2, 0x64, 0x13, 0x8a, 0xe3, 0x58, 0x48,
+	0x44, 0x14, 0x76, 0x69, 0x90, 0x90, 0x2a, 0x38, 0x80, 0xe3, 0x96, 0x26, 0xa2, 0x21, 0xb2, 0x43,
+	0x90, 0x40, 0x62, 0x34, 0x3b, 0xfb, 0xb2, 0x1e, 0xb2, 0x9e, 0xb1, 0x66, 0x66, 0x9d, 0xf2, 0x13,
+	0xe0, 0xc4, 0x91, 0x9f, 0xc0, 0x11, 0x8e, 0xfc, 0x83, 0x1e, 0x7b, 0xe4, 0x14, 0x50, 0x72, 0xe0,
+	0x3f, 0x70, 0x42, 0x33, 0xbb, 0x1b, 0xaf, 0xd3, 0xa4, 0x29, 0x97, 0x68, 0xe6, 0xbd, 0xef, 0x7d,
+	0xef, 0x7b, 0xfb, 0xe6, 0xbd, 0x18, 0xbd, 0x03, 0xcf, 0x24, 0x93, 0x0a, 0x42, 0x3a, 0x1c, 0xb2,
+	0x3e, 0xe5, 0x22, 0x64, 0x72, 0x30, 0x90, 0x22, 0x1c, 0x3d, 0x28, 0x4e, 0xc1, 0x50, 0x49, 0x23,
+	0xf1, 0x4a, 0x01, 0x0c, 0x4a, 0x60, 0x50, 0xb8, 0x47, 0x0f, 0x56, 0xe6, 0xe9, 0x80, 0x0b, 0x19,
+	0xba, 0xbf, 0x39, 0x7c, 0x65, 0x21, 0x91, 0x89, 0x74, 0xc7, 0xd0, 0x9e, 0x0a, 0x6b, 0x23, 0x91,
+	0x32, 0x49, 0x21, 0x74, 0xb7, 0x28, 0x3b, 0x08, 0xe3, 0x4c, 0x51, 0xc3, 0xcb, 0x24, 0x2b, 0x21,
+	0x8f, 0x58, 0x98, 0xf2, 0xa4, 0x6f, 0x58, 0xca, 0x41, 0x18, 0x1d, 0x1a, 0x10, 0x31, 0xa8, 0x01,
+	0x17, 0xc6, 0x2a, 0x1a, 0xdf, 0x8a, 0x80, 0x37, 0x2b, 0x7e, 0x1a, 0x31, 0x1e, 0x9a, 0x1f, 0x86,
+	0xa0, 0x73, 0x67, 0xeb, 0xdf, 0x59, 0x54, 0xef, 0x65, 0x91, 0x66, 0x8a, 0x47, 0xa0, 0x76, 0xa9,
+	0xa2, 0x03, 0x8d, 0x3f, 0x45, 0x6f, 0x31, 0x29, 0x55, 0xcc, 0x05, 0x35, 0x52, 0x91, 0x03, 0x00,
+	0x32, 0x94, 0x32, 0x25, 0x34, 0x8e, 0x15, 0xd1, 0x46, 0xf9, 0x5e, 0xd3, 0x5b, 0xbf, 0xd9, 0x5d,
+	0xae, 0x80, 0x1e, 0x03, 0xec, 0x4a, 0x99, 0x7e, 0x16, 0xc7, 0xaa, 0x67, 0x14, 0xde, 0x46, 0x6b,
+	0x31, 0xd7, 0x46, 0xf1, 0x28, 0xb3, 0xd2, 0x89, 0x51, 0x54, 0xe8, 0x01, 0xd7, 0xda, 0x5e, 0x58,
+	0x9f, 0x0a, 0x01, 0xa9, 0x7f, 0xcd, 0xb1, 0xac, 0x56, 0x81, 0x7b, 0x15, 0xdc, 0x66, 0x0e, 0xc3,
+	0x5f, 0xa2, 0xb7, 0xa3, 0x54, 0xb2, 0x43, 0x4d, 0x86, 0xa0, 0xc8, 0xa5, 0xb4, 0xfe, 0x74, 0xd3,
+	0x5b, 0x9f, 0xee, 0xae, 0xe5, 0xd8, 0x5d, 0x50, 0x9d, 0x4b, 0x78, 0xf1, 0x17, 0xa8, 0xa5, 0xcf,
+	0x4a, 0x26, 0x0a, 0x26, 0x28, 0x0f, 0x14, 0x65, 0xf6, 0xe0, 0xcf, 0x38, 0x75, 0xcd, 0x31, 0xb2,
+	0x3b, 0x01, 0x7c, 0x5c, 0xe0, 0xf0, 0x1a, 0xba, 0xa5, 0xe0, 0x88, 0xaa, 0x98, 0xc4, 0x20, 0xe4,
+	0xc0, 0x7f, 0xc3, 0xc5, 0xd5, 0x72, 0x5b, 0xc7, 0x9a, 0x30, 0x20, 0xcc, 0x23, 0x46, 0x0c, 0x1f,
+	0x80, 0xcc, 0x8c, 0x2d, 0x83, 0xcb, 0xd8, 0x9f, 0x6d, 0x7a, 0xeb, 0xb5, 0x8d, 0xe5, 0x20, 0xef,
+	0x77, 0x50, 0xf6, 0x3b, 0xe8, 0x14, 0xfd, 0x6e, 0xdf, 0x7b, 0x7e, 0xbc, 0x3a, 0x75, 0x72, 0xbc,
+	0x5a, 0xdf, 0x6a, 0x6f, 0xee, 0xe5, 0xb1, 0xbb, 0x2e, 0xf4, 0x97, 0xbf, 0x56, 0xbd, 0x6e, 0x9d,
+	0x47, 0x6c, 0xc2, 0x8a, 0xbf, 0x45, 0x4b, 0xee, 0x83, 0x1c, 0x80, 0x3a, 0x9f, 0xeb, 0xfa, 0x55,
+	0xb9, 0x6e, 0xd8, 0x5c, 0x8e, 0xf7, 0x6e, 0xc9, 0x31, 0x49, 0xbe, 0x83, 0xea, 0x99, 0x88, 0xa4,
+	0x88, 0xb9, 0x48, 0x4a, 0xd6, 0x1b, 0xaf, 0xcf, 0x3a, 0x77, 0x16, 0x5c, 0xf0, 0xbd, 0x8f, 0x70,
+	0x9f, 0x6b, 0x23, 0x15, 0x67, 0x34, 0x25, 0x20, 0x8c, 0xe2, 0xa0, 0xfd, 0x9b, 0xae, 0x87, 0xf3,
+	0x63, 0xcf, 0xa3, 0xdc, 0x81, 0x3f, 0x42, 0x4b, 0x3a, 0xa5, 0xba, 0x7f, 0xd6, 0x1f, 0x12, 0xcb,
+	0x23, 0x61, 0xab, 0xf4, 0xe7, 0xdc, 0x07, 0xbf, 0xeb, 0xdc, 0x65, 0x57, 0x3a, 0x85, 0x13, 0x7f,
+	0x87, 0x16, 0x4b, 0x20, 0xf9, 0x9e, 0xf2, 0x94, 0x94, 0xd3, 0xe4, 0xd7, 0xaf, 0x12, 0x7f, 0xbb,
+	0x14, 0xff, 0xeb, 0x3f, 0xbf, 0xbd, 0xeb, 0x75, 0x17, 0x4a, 0x9e, 0x6d, 0xca, 0xd3, 0x12, 0x84,
+	0x3f, 0x46, 0x2b, 0x2f, 0xe9, 0xca, 0xa2, 0x14, 0x88, 0xe6, 0x89, 0xf0, 0xe7, 0x9d, 0xb4, 0xa5,
+	0x73, 0xd2, 0xac, 0xbf, 0xc7, 0x13, 0xd1, 0xfa, 0xc3, 0x43, 0x8b, 0xe3, 0xe1, 0xfb, 0x1c, 0x04,
+	0x68, 0xae, 0x7b, 0x86, 0x1a, 0xc0, 0xdb, 0x68, 0x76, 0xe8, 0x86, 0xd1, 0xcd, 0x5a, 0x6d, 0xe3,
+	0xbd, 0xe0, 0xf2, 0xdd, 0x12, 0x9c, 0x1f, 0xe0, 0xf6, 0x8c, 0x95, 0xde, 0x2d, 0x18, 0x70, 0x0f,
+	0xd5, 0x2a, 0x93, 0xea, 0xc6, 0xae, 0xb6, 0x71, 0xff, 0x55, 0x84, 0x9b, 0x63, 0xf8, 0x96, 0x38,
+	0x90, 0x05, 0x5f, 0x95, 0xa5, 0xf5, 0xd3, 0x35, 0x34, 0x77, 0x0e, 0x86, 0x77, 0xd0, 0xad, 0x7c,
+	0x27, 0x11, 0x6d, 0x8b, 0x28, 0xa4, 0xdf, 0x0f, 0x78, 0xc4, 0x82, 0xea, 0xc6, 0x0a, 0x2a, 0x3b,
+	0xca, 0x66, 0x73, 0x56, 0x57, 0x77, 0xb7, 0xc6, 0xc6, 0x17, 0xfc, 0x35, 0x9a, 0x63, 0x52, 0x68,
+	0x10, 0x3a, 0xd3, 0x05, 0x65, 0x2e, 0x3e, 0xb8, 0x92, 0xb2, 0x0c, 0xcb, 0x59, 0xef, 0xb0, 0x89,
+	0x3b, 0xde, 0x41, 0x73, 0x5c, 0x70, 0xc3, 0x69, 0x4a, 0x46, 0x34, 0x25, 0x1a, 0x8c, 0x3f, 0xdd,
+	0x9c, 0x5e, 0xaf, 0x6d, 0x34, 0xab, 0x3c, 0x76, 0x59, 0x06, 0xfb, 0x34, 0xe5, 0xb1, 0xad, 0xf0,
+	0xab, 0x61, 0x4c, 0x0d, 0x14, 0x9f, 0xe2, 0x76, 0x11, 0xbe, 0x4f, 0xd3, 0x1e, 0x98, 0x56, 0x1f,
+	0xcd, 0x3f, 0xa1, 0x22, 0xd6, 0x7d, 0x7a, 0x08, 0x4f, 0xc1, 0xd0, 0x98, 0x1a, 0x8a, 0x1f, 0xa2,
+	0xe5, 0x4b, 0xb7, 0x68, 0xb1, 0x41, 0x17, 0x2f, 0xde, 0xa0, 0xd8, 0x47, 0xd7, 0x47, 0xa0, 0xdc,
+	0x56, 0xcb, 0x97, 0x64, 0x79, 0x6d, 0xfd, 0xee, 0xa1, 0x7b, 0x67, 0x92, 0x7a, 0x60, 0xec, 0x92,
+	0x4c, 0x60, 0x97, 0xb2, 0x43, 0x30, 0x1d, 0x9b, 0xb5, 0x87, 0xe6, 0x47, 0xa5, 0x9f, 0x64, 0x4e,
+	0xb3, 0x7d, 0x43, 0xff, 0xa7, 0xb8, 0xfa, 0x68, 0xd2, 0xac, 0xf1, 0x27, 0xc8, 0xda, 0x34, 0x98,
+	0x82, 0x91, 0xf0, 0xd8, 0x09, 0x9b, 0x69, 0xe3, 0x93, 0xe3, 0xd5, 0x3b, 0xfb, 0xce, 0x97, 0x83,
+	0xb7, 0x3a, 0xdd, 0x3b, 0xa3, 0xea, 0x3d, 0x6e, 0xed, 0xa1, 0x85, 0x7d, 0xcd, 0x9e, 0x52, 0x93,
+	0x29, 0x88, 0x2b, 0x52, 0x2f, 0x62, 0xf5, 0x5e, 0x9b, 0xf5, 0x47, 0x0f, 0x2d, 0x54, 0x1f, 0xfe,
+	0x19, 0x2d, 0x47, 0x4b, 0x23, 0xcd, 0xc8, 0x20, 0xcf, 0x47, 0x86, 0xce, 0x43, 0x6c, 0x4b, 0x8a,
+	0x07, 0xf9, 0xc1, 0xab, 0x9e, 0xfe, 0x45, 0x4a, 0x9f, 0x4c, 0x75, 0x17, 0x46, 0x17, 0xd8, 0xdb,
+	0xb3, 0x68, 0xc6, 0xf2, 0xb6, 0x7b, 0xcf, 0x4f, 0x1a, 0xde, 0x8b, 0x93, 0x86, 0xf7, 0xf7, 0x49,
+	0xc3, 0xfb, 0xf9, 0xb4, 0x31, 0xf5, 0xe2, 0xb4, 0x31, 0xf5, 0xe7, 0x69, 0x63, 0xea, 0x9b, 0x87,
+	0x09, 0x37, 0xfd, 0x2c, 0xb2, 0x09, 0xc2, 0x47, 0x79, 0xd6, 0x1d, 0x30, 0x47, 0x52, 0x1d, 0x86,
+	0xe5, 0xaf, 0x8a, 0x67, 0x2f, 0xfd, 0xae, 0x70, 0xff, 0xa0, 0xa3, 0x59, 0xb7, 0x92, 0x3e, 0xfc,
+	0x2f, 0x00, 0x00, 0xff, 0xff, 0x21, 0x61, 0xb7, 0x2a, 0x7f, 0x08, 0x00, 0x00,
 }
 
 func (m *SubscriberParams) Marshal() (dAtA []byte, err error) {
@@ -598,6 +852,166 @@ func (m *CoordinatorInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *HandshakeMetadata) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HandshakeMetadata) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HandshakeMetadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Version) > 0 {
+		i -= len(m.Version)
+		copy(dAtA[i:], m.Version)
+		i = encodeVarintCommon(dAtA, i, uint64(len(m.Version)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.CoordinatorFeePoolAddr) > 0 {
+		i -= len(m.CoordinatorFeePoolAddr)
+		copy(dAtA[i:], m.CoordinatorFeePoolAddr)
+		i = encodeVarintCommon(dAtA, i, uint64(len(m.CoordinatorFeePoolAddr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorSetChangePacketData) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorSetChangePacketData) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorSetChangePacketData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ValsetUpdateID != 0 {
+		i = encodeVarintCommon(dAtA, i, uint64(m.ValsetUpdateID))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ValidatorUpdates) > 0 {
+		for iNdEx := len(m.ValidatorUpdates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ValidatorUpdates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintCommon(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *VscMaturedPacketData) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VscMaturedPacketData) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VscMaturedPacketData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ValsetUpdateID != 0 {
+		i = encodeVarintCommon(dAtA, i, uint64(m.ValsetUpdateID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SubscriberPacketData) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SubscriberPacketData) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SubscriberPacketData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Data != nil {
+		{
+			size := m.Data.Size()
+			i -= size
+			if _, err := m.Data.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SubscriberPacketData_VscMaturedPacketData) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SubscriberPacketData_VscMaturedPacketData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.VscMaturedPacketData != nil {
+		{
+			size, err := m.VscMaturedPacketData.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCommon(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
 func encodeVarintCommon(dAtA []byte, offset int, v uint64) int {
 	offset -= sovCommon(v)
 	base := offset
@@ -692,13 +1106,85 @@ func (m *CoordinatorInfo) Size() (n int) {
 	return n
 }
 
-func sovCommon(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozCommon(x uint64) (n int) {
-	return sovCommon(uint64((x << 1) ^ uint64((int64(x) >> 63))))
-}
-func (m *SubscriberParams) Unmarshal(dAtA []byte) error {
+func (m *HandshakeMetadata) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CoordinatorFeePoolAddr)
+	if l > 0 {
+		n += 1 + l + sovCommon(uint64(l))
+	}
+	l = len(m.Version)
+	if l > 0 {
+		n += 1 + l + sovCommon(uint64(l))
+	}
+	return n
+}
+
+func (m *ValidatorSetChangePacketData) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ValidatorUpdates) > 0 {
+		for _, e := range m.ValidatorUpdates {
+			l = e.Size()
+			n += 1 + l + sovCommon(uint64(l))
+		}
+	}
+	if m.ValsetUpdateID != 0 {
+		n += 1 + sovCommon(uint64(m.ValsetUpdateID))
+	}
+	return n
+}
+
+func (m *VscMaturedPacketData) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ValsetUpdateID != 0 {
+		n += 1 + sovCommon(uint64(m.ValsetUpdateID))
+	}
+	return n
+}
+
+func (m *SubscriberPacketData) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Data != nil {
+		n += m.Data.Size()
+	}
+	return n
+}
+
+func (m *SubscriberPacketData_VscMaturedPacketData) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.VscMaturedPacketData != nil {
+		l = m.VscMaturedPacketData.Size()
+		n += 1 + l + sovCommon(uint64(l))
+	}
+	return n
+}
+
+func sovCommon(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozCommon(x uint64) (n int) {
+	return sovCommon(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *SubscriberParams) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -876,7 +1362,388 @@ func (m *SubscriberParams) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IBCTimeoutPeriod", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IBCTimeoutPeriod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCommon
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCommon
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.IBCTimeoutPeriod, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TransferTimeoutPeriod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCommon
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCommon
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.TransferTimeoutPeriod, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingPeriod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCommon
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCommon
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.UnbondingPeriod, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HistoricalEntries", wireType)
+			}
+			m.HistoricalEntries = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HistoricalEntries |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashFractionDowntime", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCommon
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCommon
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SlashFractionDowntime = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DowntimeJailDuration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCommon
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCommon
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.DowntimeJailDuration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashFractionDoubleSign", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCommon
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCommon
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SlashFractionDoubleSign = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCommon(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCommon
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SubscriberGenesisState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCommon
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SubscriberGenesisState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SubscriberGenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCommon
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCommon
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Coordinator", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCommon
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCommon
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Coordinator.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCommon(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCommon
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CoordinatorInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCommon
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CoordinatorInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CoordinatorInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientState", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -903,13 +1770,16 @@ func (m *SubscriberParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.IBCTimeoutPeriod, dAtA[iNdEx:postIndex]); err != nil {
+			if m.ClientState == nil {
+				m.ClientState = &_07_tendermint.ClientState{}
+			}
+			if err := m.ClientState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TransferTimeoutPeriod", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsensusState", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -936,13 +1806,16 @@ func (m *SubscriberParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.TransferTimeoutPeriod, dAtA[iNdEx:postIndex]); err != nil {
+			if m.ConsensusState == nil {
+				m.ConsensusState = &_07_tendermint.ConsensusState{}
+			}
+			if err := m.ConsensusState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 8:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingPeriod", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field InitialValSet", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -969,32 +1842,64 @@ func (m *SubscriberParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.UnbondingPeriod, dAtA[iNdEx:postIndex]); err != nil {
+			m.InitialValSet = append(m.InitialValSet, types.ValidatorUpdate{})
+			if err := m.InitialValSet[len(m.InitialValSet)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 9:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HistoricalEntries", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCommon(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			m.HistoricalEntries = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowCommon
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.HistoricalEntries |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCommon
 			}
-		case 15:
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HandshakeMetadata) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCommon
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HandshakeMetadata: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HandshakeMetadata: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SlashFractionDowntime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CoordinatorFeePoolAddr", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1022,44 +1927,11 @@ func (m *SubscriberParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SlashFractionDowntime = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 16:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DowntimeJailDuration", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowCommon
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthCommon
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthCommon
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.DowntimeJailDuration, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.CoordinatorFeePoolAddr = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 17:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SlashFractionDoubleSign", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1087,7 +1959,7 @@ func (m *SubscriberParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SlashFractionDoubleSign = string(dAtA[iNdEx:postIndex])
+			m.Version = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1110,7 +1982,7 @@ func (m *SubscriberParams) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SubscriberGenesisState) Unmarshal(dAtA []byte) error {
+func (m *ValidatorSetChangePacketData) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1133,15 +2005,15 @@ func (m *SubscriberGenesisState) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SubscriberGenesisState: wiretype end group for non-group")
+			return fmt.Errorf("proto: ValidatorSetChangePacketData: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SubscriberGenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ValidatorSetChangePacketData: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorUpdates", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1168,15 +2040,16 @@ func (m *SubscriberGenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ValidatorUpdates = append(m.ValidatorUpdates, types.ValidatorUpdate{})
+			if err := m.ValidatorUpdates[len(m.ValidatorUpdates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Coordinator", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValsetUpdateID", wireType)
 			}
-			var msglen int
+			m.ValsetUpdateID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCommon
@@ -1186,25 +2059,11 @@ func (m *SubscriberGenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.ValsetUpdateID |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthCommon
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthCommon
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Coordinator.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCommon(dAtA[iNdEx:])
@@ -1226,7 +2085,7 @@ func (m *SubscriberGenesisState) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CoordinatorInfo) Unmarshal(dAtA []byte) error {
+func (m *VscMaturedPacketData) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1249,17 +2108,17 @@ func (m *CoordinatorInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CoordinatorInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: VscMaturedPacketData: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CoordinatorInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: VscMaturedPacketData: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClientState", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValsetUpdateID", wireType)
 			}
-			var msglen int
+			m.ValsetUpdateID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCommon
@@ -1269,67 +2128,64 @@ func (m *CoordinatorInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.ValsetUpdateID |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthCommon
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCommon(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthCommon
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ClientState == nil {
-				m.ClientState = &_07_tendermint.ClientState{}
-			}
-			if err := m.ClientState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConsensusState", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowCommon
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthCommon
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthCommon
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SubscriberPacketData) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCommon
 			}
-			if postIndex > l {
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ConsensusState == nil {
-				m.ConsensusState = &_07_tendermint.ConsensusState{}
-			}
-			if err := m.ConsensusState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 3:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SubscriberPacketData: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SubscriberPacketData: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InitialValSet", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VscMaturedPacketData", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1356,10 +2212,11 @@ func (m *CoordinatorInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.InitialValSet = append(m.InitialValSet, types.ValidatorUpdate{})
-			if err := m.InitialValSet[len(m.InitialValSet)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			v := &VscMaturedPacketData{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
+			m.Data = &SubscriberPacketData_VscMaturedPacketData{v}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -0,0 +1,33 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+)
+
+const (
+	errCodeInvalidChannelFlow = iota + 2
+	errCodeInvalidVersion
+	errCodeInvalidHandshakeMetadata
+	errCodeDuplicateChannel
+	errCodeClientNotFound
+	errCodeInvalidGenesis
+	errCodeInvalidPacketData
+)
+
+var (
+	// ErrInvalidChannelFlow is the error returned when a channel handshake step is not
+	// allowed on this side of the channel.
+	ErrInvalidChannelFlow = errorsmod.Register(ModuleName, errCodeInvalidChannelFlow, "invalid message sent to channel end")
+	// ErrInvalidVersion is the error returned when the counterparty version is not supported.
+	ErrInvalidVersion = errorsmod.Register(ModuleName, errCodeInvalidVersion, "invalid appchain version")
+	// ErrInvalidHandshakeMetadata is the error returned when the handshake metadata is invalid.
+	ErrInvalidHandshakeMetadata = errorsmod.Register(ModuleName, errCodeInvalidHandshakeMetadata, "invalid handshake metadata")
+	// ErrDuplicateChannel is the error returned when a channel already exists for the chain.
+	ErrDuplicateChannel = errorsmod.Register(ModuleName, errCodeDuplicateChannel, "channel already exists")
+	// ErrClientNotFound is the error returned when the expected client is not found.
+	ErrClientNotFound = errorsmod.Register(ModuleName, errCodeClientNotFound, "client not found")
+	// ErrInvalidGenesis is the error returned when the genesis state is invalid.
+	ErrInvalidGenesis = errorsmod.Register(ModuleName, errCodeInvalidGenesis, "invalid genesis state")
+	// ErrInvalidPacketData is the error returned when the packet data is invalid.
+	ErrInvalidPacketData = errorsmod.Register(ModuleName, errCodeInvalidPacketData, "invalid packet data")
+)
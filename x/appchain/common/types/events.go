@@ -1,5 +1,9 @@
 package types
 
 const (
-	AttributeChainID = "chain_id"
+	EventTypeChannelEstablished = "channel_established"
+	EventTypeValidatorSetChange = "validator_set_change"
+	EventTypeVscMatured         = "vsc_matured"
+	AttributeChainID            = "chain_id"
+	AttributeValsetUpdateID     = "valset_update_id"
 )
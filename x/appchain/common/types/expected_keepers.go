@@ -2,6 +2,10 @@ package types
 
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
+	clienttypes "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
+	conntypes "github.com/cosmos/ibc-go/v7/modules/core/03-connection/types"
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
 	ibcexported "github.com/cosmos/ibc-go/v7/modules/core/exported"
 )
 
@@ -17,3 +21,34 @@ type ClientKeeper interface {
 		sdk.Context, ibcexported.Height,
 	) (ibcexported.ConsensusState, error)
 }
+
+// ScopedKeeper represents the expected keeper interface for the scoped capability keeper.
+type ScopedKeeper interface {
+	GetCapability(sdk.Context, string) (*capabilitytypes.Capability, bool)
+	AuthenticateCapability(sdk.Context, *capabilitytypes.Capability, string) bool
+	ClaimCapability(sdk.Context, *capabilitytypes.Capability, string) error
+}
+
+// PortKeeper represents the expected keeper interface for the IBC port keeper.
+type PortKeeper interface {
+	BindPort(sdk.Context, string) *capabilitytypes.Capability
+}
+
+// ChannelKeeper represents the expected keeper interface for the IBC channel keeper.
+type ChannelKeeper interface {
+	GetChannel(sdk.Context, string, string) (channeltypes.Channel, bool)
+	SendPacket(
+		ctx sdk.Context,
+		channelCap *capabilitytypes.Capability,
+		sourcePort string,
+		sourceChannel string,
+		timeoutHeight clienttypes.Height,
+		timeoutTimestamp uint64,
+		data []byte,
+	) (uint64, error)
+}
+
+// ConnectionKeeper represents the expected keeper interface for the IBC connection keeper.
+type ConnectionKeeper interface {
+	GetConnection(sdk.Context, string) (conntypes.ConnectionEnd, bool)
+}
@@ -0,0 +1,16 @@
+package types
+
+const (
+	// ModuleName is the codespace of the errors shared by the appchain modules.
+	ModuleName = "appchain"
+
+	// Version is the version of the appchain protocol, which is negotiated during the
+	// channel handshake between the coordinator and the subscriber.
+	Version = "1"
+
+	// CoordinatorPortID is the port that the coordinator module binds to.
+	CoordinatorPortID = "coordinator"
+
+	// SubscriberPortID is the port that the subscriber module binds to.
+	SubscriberPortID = "subscriber"
+)
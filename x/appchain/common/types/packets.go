@@ -0,0 +1,44 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+)
+
+// Validate performs stateless validation of the validator set change packet data.
+func (vsc ValidatorSetChangePacketData) Validate() error {
+	// the initial validator set has an ID of 0, so packets start from 1.
+	if vsc.ValsetUpdateID == 0 {
+		return errorsmod.Wrap(ErrInvalidPacketData, "valset update ID cannot be 0")
+	}
+	// the validator updates may be empty, if the packet is sent only to track the maturity
+	// of the held operations.
+	for _, update := range vsc.ValidatorUpdates {
+		if update.Power < 0 {
+			return errorsmod.Wrapf(
+				ErrInvalidPacketData, "negative power %d in validator update", update.Power,
+			)
+		}
+	}
+	return nil
+}
+
+// Validate performs stateless validation of the VSC matured packet data.
+func (mat VscMaturedPacketData) Validate() error {
+	if mat.ValsetUpdateID == 0 {
+		return errorsmod.Wrap(ErrInvalidPacketData, "valset update ID cannot be 0")
+	}
+	return nil
+}
+
+// Validate performs stateless validation of the subscriber packet data.
+func (data SubscriberPacketData) Validate() error {
+	switch packet := data.Data.(type) {
+	case *SubscriberPacketData_VscMaturedPacketData:
+		if packet.VscMaturedPacketData == nil {
+			return errorsmod.Wrap(ErrInvalidPacketData, "nil VSC matured packet data")
+		}
+		return packet.VscMaturedPacketData.Validate()
+	default:
+		return errorsmod.Wrapf(ErrInvalidPacketData, "unknown packet data type: %T", packet)
+	}
+}
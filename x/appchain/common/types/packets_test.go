@@ -0,0 +1,128 @@
+package types_test
+
+import (
+	"strings"
+	"testing"
+
+	keytypes "github.com/ExocoreNetwork/exocore/types/keys"
+	"github.com/ExocoreNetwork/exocore/x/appchain/common/types"
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cometbft/cometbft/crypto/ed25519"
+)
+
+func TestValidateValidatorSetChangePacketData(t *testing.T) {
+	pubKey := *keytypes.NewWrappedConsKeyFromTmKey(ed25519.GenPrivKey().PubKey()).ToTmProtoKey()
+	cases := []struct {
+		name     string
+		data     types.ValidatorSetChangePacketData
+		expError string
+	}{
+		{
+			name: "valid packet",
+			data: types.ValidatorSetChangePacketData{
+				ValidatorUpdates: []abci.ValidatorUpdate{{PubKey: pubKey, Power: 10}},
+				ValsetUpdateID:   1,
+			},
+		},
+		{
+			name: "removal",
+			data: types.ValidatorSetChangePacketData{
+				ValidatorUpdates: []abci.ValidatorUpdate{{PubKey: pubKey, Power: 0}},
+				ValsetUpdateID:   2,
+			},
+		},
+		{
+			name: "zero valset update ID",
+			data: types.ValidatorSetChangePacketData{
+				ValidatorUpdates: []abci.ValidatorUpdate{{PubKey: pubKey, Power: 10}},
+			},
+			expError: "valset update ID cannot be 0",
+		},
+		{
+			name: "no updates",
+			data: types.ValidatorSetChangePacketData{
+				ValsetUpdateID: 3,
+			},
+		},
+		{
+			name: "negative power",
+			data: types.ValidatorSetChangePacketData{
+				ValidatorUpdates: []abci.ValidatorUpdate{{PubKey: pubKey, Power: -1}},
+				ValsetUpdateID:   1,
+			},
+			expError: "negative power",
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.data.Validate()
+			if tc.expError == "" {
+				if err != nil {
+					t.Fatalf("expected no error, got %s", err)
+				}
+				return
+			}
+			if err == nil {
+				t.Fatal("expected error, got none")
+			}
+			if !strings.Contains(err.Error(), tc.expError) {
+				t.Fatalf("expected error %q, got %q", tc.expError, err.Error())
+			}
+		})
+	}
+}
+
+func TestValidateSubscriberPacketData(t *testing.T) {
+	cases := []struct {
+		name     string
+		data     types.SubscriberPacketData
+		expError string
+	}{
+		{
+			name: "valid VSC matured packet",
+			data: types.SubscriberPacketData{
+				Data: &types.SubscriberPacketData_VscMaturedPacketData{
+					VscMaturedPacketData: &types.VscMaturedPacketData{ValsetUpdateID: 1},
+				},
+			},
+		},
+		{
+			name: "zero valset update ID",
+			data: types.SubscriberPacketData{
+				Data: &types.SubscriberPacketData_VscMaturedPacketData{
+					VscMaturedPacketData: &types.VscMaturedPacketData{},
+				},
+			},
+			expError: "valset update ID cannot be 0",
+		},
+		{
+			name: "nil VSC matured packet",
+			data: types.SubscriberPacketData{
+				Data: &types.SubscriberPacketData_VscMaturedPacketData{},
+			},
+			expError: "nil VSC matured packet data",
+		},
+		{
+			name:     "no data",
+			data:     types.SubscriberPacketData{},
+			expError: "unknown packet data type",
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.data.Validate()
+			if tc.expError == "" {
+				if err != nil {
+					t.Fatalf("expected no error, got %s", err)
+				}
+				return
+			}
+			if err == nil {
+				t.Fatal("expected error, got none")
+			}
+			if !strings.Contains(err.Error(), tc.expError) {
+				t.Fatalf("expected error %q, got %q", tc.expError, err.Error())
+			}
+		})
+	}
+}
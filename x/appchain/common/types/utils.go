@@ -0,0 +1,51 @@
+package types
+
+import (
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	clienttypes "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
+	host "github.com/cosmos/ibc-go/v7/modules/core/24-host"
+)
+
+// SendIBCPacket sends the packet data over the channel bound to the port, with a timeout of
+// `timeoutPeriod` from the current block time. It is used by both the coordinator and the
+// subscriber modules.
+func SendIBCPacket(
+	ctx sdk.Context,
+	scopedKeeper ScopedKeeper,
+	channelKeeper ChannelKeeper,
+	channelID string,
+	portID string,
+	packetData []byte,
+	timeoutPeriod time.Duration,
+) error {
+	if _, found := channelKeeper.GetChannel(ctx, portID, channelID); !found {
+		return errorsmod.Wrapf(
+			channeltypes.ErrChannelNotFound, "channel not found for channel ID: %s", channelID,
+		)
+	}
+	channelCap, ok := scopedKeeper.GetCapability(
+		ctx, host.ChannelCapabilityPath(portID, channelID),
+	)
+	if !ok {
+		return errorsmod.Wrap(
+			channeltypes.ErrChannelCapabilityNotFound,
+			"module does not own channel capability",
+		)
+	}
+	// #nosec G115 // block time is always positive
+	timeoutTimestamp := uint64(ctx.BlockTime().Add(timeoutPeriod).UnixNano())
+	_, err := channelKeeper.SendPacket(
+		ctx,
+		channelCap,
+		portID,
+		channelID,
+		clienttypes.Height{}, // timeout height is disabled
+		timeoutTimestamp,
+		packetData,
+	)
+	return err
+}
@@ -1,6 +1,8 @@
 package keeper
 
 import (
+	"fmt"
+
 	"github.com/ExocoreNetwork/exocore/x/appchain/coordinator/types"
 	abci "github.com/cometbft/cometbft/abci/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -8,11 +10,69 @@ import (
 
 func (k Keeper) InitGenesis(ctx sdk.Context, gs types.GenesisState) []abci.ValidatorUpdate {
 	k.SetParams(ctx, gs.Params)
+	// bind to the port only if it is not already bound, which is the case during a restart
+	if !k.IsBound(ctx, types.PortID) {
+		if err := k.BindPort(ctx, types.PortID); err != nil {
+			panic(fmt.Sprintf("could not claim port capability: %v", err))
+		}
+	}
+	for _, chainChannel := range gs.ChainChannels {
+		k.SetChannelForChain(ctx, chainChannel.ChainID, chainChannel.ChannelID)
+		k.SetChainForChannel(ctx, chainChannel.ChannelID, chainChannel.ChainID)
+	}
+	for _, chain := range gs.SubscriberChains {
+		k.SetClientForChain(ctx, chain.ChainID, chain.ClientID)
+		if chain.EpochIdentifier != "" {
+			k.AppendActiveChainToEpoch(ctx, chain.EpochIdentifier, chain.ChainID)
+		}
+		k.SetMaxValidatorsForChain(ctx, chain.ChainID, chain.MaxValidators)
+		k.SetVscIDForChain(ctx, chain.ChainID, chain.VscID)
+		for _, validator := range chain.Validators {
+			k.SetSubscriberValidator(ctx, chain.ChainID, validator)
+		}
+		for _, holds := range chain.Holds {
+			// the hold counts aren't exported by the delegation module, so each hold is
+			// restored by its holder.
+			for _, recordKey := range holds.UndelegationRecordKeys {
+				k.AppendUndelegationToRelease(ctx, chain.ChainID, holds.VscID, recordKey)
+				if err := k.delegationKeeper.IncrementUndelegationHoldCount(ctx, recordKey); err != nil {
+					panic(fmt.Sprintf("could not hold undelegation: %v", err))
+				}
+			}
+			for _, operator := range holds.Operators {
+				// #nosec G703 // already validated
+				operatorAddr, _ := sdk.AccAddressFromBech32(operator)
+				k.AppendOptOutToFinish(ctx, chain.ChainID, holds.VscID, operatorAddr)
+			}
+		}
+	}
 	return []abci.ValidatorUpdate{}
 }
 
 func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
-	return &types.GenesisState{
-		Params: k.GetParams(ctx),
+	chainIDs := k.GetAllChainsWithChannel(ctx)
+	chainChannels := make([]types.ChainChannel, 0, len(chainIDs))
+	for _, chainID := range chainIDs {
+		channelID, _ := k.GetChannelForChain(ctx, chainID)
+		chainChannels = append(chainChannels, types.ChainChannel{
+			ChainID:   chainID,
+			ChannelID: channelID,
+		})
+	}
+	chainIDs = k.GetAllChainsWithClient(ctx)
+	subscriberChains := make([]types.SubscriberChain, 0, len(chainIDs))
+	for _, chainID := range chainIDs {
+		clientID, _ := k.GetClientForChain(ctx, chainID)
+		epochIdentifier, _ := k.getActiveChainEpoch(ctx, chainID)
+		subscriberChains = append(subscriberChains, types.SubscriberChain{
+			ChainID:         chainID,
+			ClientID:        clientID,
+			EpochIdentifier: epochIdentifier,
+			MaxValidators:   k.GetMaxValidatorsForChain(ctx, chainID),
+			VscID:           k.GetVscIDForChain(ctx, chainID),
+			Validators:      k.GetAllSubscriberValidators(ctx, chainID),
+			Holds:           k.getAllHolds(ctx, chainID),
+		})
 	}
+	return types.NewGenesis(k.GetParams(ctx), chainChannels, subscriberChains)
 }
@@ -0,0 +1,114 @@
+package keeper_test
+
+import (
+	"testing"
+
+	keepertest "github.com/ExocoreNetwork/exocore/testutil/keeper"
+	"github.com/ExocoreNetwork/exocore/x/appchain/coordinator/types"
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenesisChainChannels(t *testing.T) {
+	k, ctx, _ := keepertest.CoordinatorKeeper(t)
+	for chainID, channelID := range map[string]string{
+		"appchain-1": "channel-0",
+		"other-1":    "channel-1",
+	} {
+		k.SetChannelForChain(ctx, chainID, channelID)
+		k.SetChainForChannel(ctx, channelID, chainID)
+	}
+
+	exported := k.ExportGenesis(ctx)
+	require.NoError(t, exported.Validate())
+	require.Equal(t, []types.ChainChannel{
+		{ChainID: "appchain-1", ChannelID: "channel-0"},
+		{ChainID: "other-1", ChannelID: "channel-1"},
+	}, exported.ChainChannels)
+
+	// the indices survive a re-import.
+	imported, importedCtx, _ := keepertest.CoordinatorKeeper(t)
+	imported.InitGenesis(importedCtx, *exported)
+	for _, chainChannel := range exported.ChainChannels {
+		channelID, found := imported.GetChannelForChain(importedCtx, chainChannel.ChainID)
+		require.True(t, found)
+		require.Equal(t, chainChannel.ChannelID, channelID)
+		chainID, found := imported.GetChainForChannel(importedCtx, chainChannel.ChannelID)
+		require.True(t, found)
+		require.Equal(t, chainChannel.ChainID, chainID)
+	}
+	require.Equal(t, exported, imported.ExportGenesis(importedCtx))
+}
+
+func TestGenesisSubscriberChains(t *testing.T) {
+	k, ctx, mocks := keepertest.CoordinatorKeeper(t)
+	chainID := "appchain-1"
+	setupSubscriberChain(k, ctx, mocks, chainID, "connection-0", "channel-0")
+	firstKey, secondKey := newConsKey(), newConsKey()
+	mocks.Operator.SetOperator(newOperator(), "appchain", firstKey, 300)
+	mocks.Operator.SetOperator(newOperator(), "appchain", secondKey, 200)
+	k.QueueValidatorSetUpdates(ctx, testEpochIdentifier)
+	// a chain whose channel timed out no longer receives the validator set updates.
+	timedOutChainID := "other-1"
+	setupSubscriberChain(k, ctx, mocks, timedOutChainID, "connection-1", "channel-1")
+	k.RemoveActiveChain(ctx, timedOutChainID)
+
+	exported := k.ExportGenesis(ctx)
+	require.NoError(t, exported.Validate())
+	require.Len(t, exported.SubscriberChains, 2)
+	chain := exported.SubscriberChains[0]
+	require.Equal(t, chainID, chain.ChainID)
+	require.NotEmpty(t, chain.ClientID)
+	require.Equal(t, testEpochIdentifier, chain.EpochIdentifier)
+	require.Equal(t, uint32(testMaxValidators), chain.MaxValidators)
+	require.Equal(t, uint64(1), chain.VscID)
+	require.ElementsMatch(t, []abci.ValidatorUpdate{
+		validatorUpdate(firstKey, 300), validatorUpdate(secondKey, 200),
+	}, chain.Validators)
+	require.Equal(t, timedOutChainID, exported.SubscriberChains[1].ChainID)
+	require.Empty(t, exported.SubscriberChains[1].EpochIdentifier)
+
+	// the state survives a re-import.
+	imported, importedCtx, _ := keepertest.CoordinatorKeeper(t)
+	imported.InitGenesis(importedCtx, *exported)
+	clientID, found := imported.GetClientForChain(importedCtx, chainID)
+	require.True(t, found)
+	require.Equal(t, chain.ClientID, clientID)
+	require.Equal(t, []string{chainID}, imported.GetActiveChainsByEpoch(importedCtx, testEpochIdentifier).List)
+	require.Equal(t, uint64(1), imported.GetVscIDForChain(importedCtx, chainID))
+	require.Equal(t, k.GetAllSubscriberValidators(ctx, chainID), imported.GetAllSubscriberValidators(importedCtx, chainID))
+	require.Equal(t, exported, imported.ExportGenesis(importedCtx))
+}
+
+func TestGenesisHolds(t *testing.T) {
+	k, ctx, mocks := keepertest.CoordinatorKeeper(t)
+	chainID := "appchain-1"
+	setupSubscriberChain(k, ctx, mocks, chainID, "connection-0", "channel-0")
+	operator, optedOut := newOperator(), newOperator()
+	mocks.Operator.SetOperator(operator, "appchain", newConsKey(), 100)
+	k.QueueValidatorSetUpdates(ctx, testEpochIdentifier)
+	recordKey := []byte("undelegation")
+	require.NoError(t, k.DelegationHooks().AfterUndelegationStarted(ctx, operator, recordKey))
+	k.AppendOptOutToFinish(ctx, chainID, 2, optedOut)
+
+	exported := k.ExportGenesis(ctx)
+	require.NoError(t, exported.Validate())
+	require.Len(t, exported.SubscriberChains, 1)
+	require.Equal(t, []types.VscHolds{{
+		VscID:                  2,
+		UndelegationRecordKeys: [][]byte{recordKey},
+		Operators:              []string{optedOut.String()},
+	}}, exported.SubscriberChains[0].Holds)
+
+	// the holds and their counts survive a re-import.
+	imported, importedCtx, importedMocks := keepertest.CoordinatorKeeper(t)
+	imported.InitGenesis(importedCtx, *exported)
+	require.True(t, imported.HasHoldsForVscID(importedCtx, chainID, 2))
+	require.Equal(t, uint64(1), importedMocks.Delegation.HoldCounts[string(recordKey)])
+	require.Equal(t, exported, imported.ExportGenesis(importedCtx))
+
+	// and they are released once the validator set change matures.
+	imported.ReleaseHolds(importedCtx, chainID, 2)
+	require.Zero(t, importedMocks.Delegation.HoldCounts[string(recordKey)])
+	require.False(t, imported.HasHoldsForVscID(importedCtx, chainID, 2))
+}
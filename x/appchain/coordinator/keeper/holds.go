@@ -0,0 +1,173 @@
+package keeper
+
+import (
+	"sort"
+
+	"github.com/ExocoreNetwork/exocore/x/appchain/coordinator/types"
+	avstypes "github.com/ExocoreNetwork/exocore/x/avs/types"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// AppendUndelegationToRelease appends an undelegation record key to the list of undelegations
+// which are held until the validator set change with the vscID of the chain matures.
+func (k Keeper) AppendUndelegationToRelease(
+	ctx sdk.Context, chainID string, vscID uint64, recordKey []byte,
+) {
+	prev := k.GetUndelegationsToRelease(ctx, chainID, vscID)
+	prev.List = append(prev.List, recordKey)
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.UndelegationsToReleaseKey(chainID, vscID), k.cdc.MustMarshal(&prev))
+}
+
+// GetUndelegationsToRelease returns the list of undelegations which are held until the
+// validator set change with the vscID of the chain matures.
+func (k Keeper) GetUndelegationsToRelease(
+	ctx sdk.Context, chainID string, vscID uint64,
+) (res types.UndelegationRecordKeys) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.UndelegationsToReleaseKey(chainID, vscID))
+	k.cdc.MustUnmarshal(bz, &res)
+	return res
+}
+
+// ClearUndelegationsToRelease clears the list of undelegations which are held until the
+// validator set change with the vscID of the chain matures.
+func (k Keeper) ClearUndelegationsToRelease(
+	ctx sdk.Context, chainID string, vscID uint64,
+) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.UndelegationsToReleaseKey(chainID, vscID))
+}
+
+// AppendOptOutToFinish appends an operator to the list of operators whose key removal from
+// the chain is held until the validator set change with the vscID of the chain matures.
+func (k Keeper) AppendOptOutToFinish(
+	ctx sdk.Context, chainID string, vscID uint64, operator sdk.AccAddress,
+) {
+	prev := k.GetOptOutsToFinish(ctx, chainID, vscID)
+	prev.List = append(prev.List, operator)
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.OptOutsToFinishKey(chainID, vscID), k.cdc.MustMarshal(&prev))
+}
+
+// GetOptOutsToFinish returns the list of operators whose key removal from the chain is held
+// until the validator set change with the vscID of the chain matures.
+func (k Keeper) GetOptOutsToFinish(
+	ctx sdk.Context, chainID string, vscID uint64,
+) (res types.AccountAddresses) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.OptOutsToFinishKey(chainID, vscID))
+	k.cdc.MustUnmarshal(bz, &res)
+	return res
+}
+
+// ClearOptOutsToFinish clears the list of operators whose key removal from the chain is held
+// until the validator set change with the vscID of the chain matures.
+func (k Keeper) ClearOptOutsToFinish(
+	ctx sdk.Context, chainID string, vscID uint64,
+) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.OptOutsToFinishKey(chainID, vscID))
+}
+
+// HasHoldsForVscID returns true if any operation is held until the validator set change with
+// the vscID of the chain matures.
+func (k Keeper) HasHoldsForVscID(ctx sdk.Context, chainID string, vscID uint64) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(types.UndelegationsToReleaseKey(chainID, vscID)) ||
+		store.Has(types.OptOutsToFinishKey(chainID, vscID))
+}
+
+// ReleaseHolds releases the operations held until the validator set change with the vscID of
+// the chain matures. The hold on each undelegation is removed, and each operator key removal
+// is completed.
+func (k Keeper) ReleaseHolds(ctx sdk.Context, chainID string, vscID uint64) {
+	undelegations := k.GetUndelegationsToRelease(ctx, chainID, vscID)
+	for _, recordKey := range undelegations.GetList() {
+		if err := k.delegationKeeper.DecrementUndelegationHoldCount(ctx, recordKey); err != nil {
+			k.Logger(ctx).Error(
+				"error decrementing undelegation hold count",
+				"chainID", chainID,
+				"vscID", vscID,
+				"error", err,
+			)
+		}
+	}
+	k.ClearUndelegationsToRelease(ctx, chainID, vscID)
+	optOuts := k.GetOptOutsToFinish(ctx, chainID, vscID)
+	for _, operator := range optOuts.GetList() {
+		if err := k.completeOptOut(ctx, chainID, operator); err != nil {
+			k.Logger(ctx).Error(
+				"error completing operator key removal",
+				"chainID", chainID,
+				"vscID", vscID,
+				"error", err,
+			)
+		}
+	}
+	k.ClearOptOutsToFinish(ctx, chainID, vscID)
+}
+
+// ReleaseAllHolds releases all the operations held for the chain, regardless of the validator
+// set change they are waiting for. It is used when the chain can no longer send packets to the
+// coordinator (and hence can no longer request slashing).
+func (k Keeper) ReleaseAllHolds(ctx sdk.Context, chainID string) {
+	for _, vscID := range k.getHeldVscIDs(ctx, chainID) {
+		k.ReleaseHolds(ctx, chainID, vscID)
+	}
+}
+
+// getHeldVscIDs returns the IDs of the validator set changes of the chain with held
+// operations, in ascending order and without duplicates.
+func (k Keeper) getHeldVscIDs(ctx sdk.Context, chainID string) []uint64 {
+	seen := make(map[uint64]struct{})
+	res := make([]uint64, 0)
+	for _, prefixByte := range []byte{
+		types.UndelegationsToReleaseBytePrefix, types.OptOutsToFinishBytePrefix,
+	} {
+		store := prefix.NewStore(
+			ctx.KVStore(k.storeKey), types.ChainIDWithLenKey(prefixByte, chainID),
+		)
+		iterator := store.Iterator(nil, nil)
+		for ; iterator.Valid(); iterator.Next() {
+			vscID := sdk.BigEndianToUint64(iterator.Key())
+			if _, found := seen[vscID]; !found {
+				seen[vscID] = struct{}{}
+				res = append(res, vscID)
+			}
+		}
+		iterator.Close()
+	}
+	sort.Slice(res, func(i, j int) bool { return res[i] < res[j] })
+	return res
+}
+
+// getAllHolds returns the operations held for the chain, grouped by the validator set change
+// they are waiting for, in ascending order of the vscID.
+func (k Keeper) getAllHolds(ctx sdk.Context, chainID string) []types.VscHolds {
+	vscIDs := k.getHeldVscIDs(ctx, chainID)
+	res := make([]types.VscHolds, 0, len(vscIDs))
+	for _, vscID := range vscIDs {
+		optOuts := k.GetOptOutsToFinish(ctx, chainID, vscID)
+		operators := make([]string, 0, len(optOuts.List))
+		for _, operator := range optOuts.List {
+			operators = append(operators, sdk.AccAddress(operator).String())
+		}
+		res = append(res, types.VscHolds{
+			VscID:                  vscID,
+			UndelegationRecordKeys: k.GetUndelegationsToRelease(ctx, chainID, vscID).List,
+			Operators:              operators,
+		})
+	}
+	return res
+}
+
+// completeOptOut completes the key removal of the operator from the chain.
+func (k Keeper) completeOptOut(
+	ctx sdk.Context, chainID string, operator sdk.AccAddress,
+) error {
+	return k.operatorKeeper.CompleteOperatorKeyRemovalForChainID(
+		ctx, operator, avstypes.ChainIDWithoutRevision(chainID),
+	)
+}
@@ -0,0 +1,152 @@
+package keeper_test
+
+import (
+	"testing"
+
+	keepertest "github.com/ExocoreNetwork/exocore/testutil/keeper"
+	commontypes "github.com/ExocoreNetwork/exocore/x/appchain/common/types"
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUndelegationHeldOnlyForSubscriberChains(t *testing.T) {
+	k, ctx, mocks := keepertest.CoordinatorKeeper(t)
+	validatedChain, otherChain := "validated-1", "other-1"
+	setupSubscriberChain(k, ctx, mocks, validatedChain, "connection-0", "channel-0")
+	setupSubscriberChain(k, ctx, mocks, otherChain, "connection-1", "channel-1")
+
+	// the operator validates only the first chain; another operator validates the second.
+	operator, other := newOperator(), newOperator()
+	mocks.Operator.SetOperator(operator, "validated", newConsKey(), 100)
+	mocks.Operator.SetOperator(other, "other", newConsKey(), 100)
+	k.QueueValidatorSetUpdates(ctx, testEpochIdentifier)
+	require.Len(t, mocks.IBC.SentPackets, 2)
+
+	recordKey := []byte("undelegation")
+	require.NoError(t, k.DelegationHooks().AfterUndelegationStarted(ctx, operator, recordKey))
+	require.Equal(t, uint64(1), mocks.Delegation.HoldCounts[string(recordKey)])
+	require.Equal(
+		t, [][]byte{recordKey}, k.GetUndelegationsToRelease(ctx, validatedChain, 2).List,
+	)
+	require.True(t, k.HasHoldsForVscID(ctx, validatedChain, 2))
+	require.False(t, k.HasHoldsForVscID(ctx, otherChain, 2))
+
+	// an operator that is opted in but not yet in the validator set sent is not held.
+	newcomer := newOperator()
+	mocks.Operator.SetOperator(newcomer, "validated", newConsKey(), 100)
+	require.NoError(t, k.DelegationHooks().AfterUndelegationStarted(ctx, newcomer, []byte("newcomer")))
+	require.Zero(t, mocks.Delegation.HoldCounts["newcomer"])
+}
+
+func TestEmptyValidatorSetChangeSentForHolds(t *testing.T) {
+	k, ctx, mocks := keepertest.CoordinatorKeeper(t)
+	chainID := "appchain-1"
+	setupSubscriberChain(k, ctx, mocks, chainID, "connection-0", "channel-0")
+	operator := newOperator()
+	mocks.Operator.SetOperator(operator, "appchain", newConsKey(), 100)
+	k.QueueValidatorSetUpdates(ctx, testEpochIdentifier)
+	require.Len(t, mocks.IBC.SentPackets, 1)
+	require.Equal(t, uint64(1), k.GetVscIDForChain(ctx, chainID))
+
+	// without any change or hold, nothing is sent.
+	k.QueueValidatorSetUpdates(ctx, testEpochIdentifier)
+	require.Len(t, mocks.IBC.SentPackets, 1)
+	require.Equal(t, uint64(1), k.GetVscIDForChain(ctx, chainID))
+
+	// with a hold, an empty update is sent so that its maturity can be tracked.
+	require.NoError(t, k.DelegationHooks().AfterUndelegationStarted(ctx, operator, []byte("record")))
+	k.QueueValidatorSetUpdates(ctx, testEpochIdentifier)
+	require.Len(t, mocks.IBC.SentPackets, 2)
+	data := lastSentPacket(t, mocks)
+	require.Equal(t, uint64(2), data.ValsetUpdateID)
+	require.Empty(t, data.ValidatorUpdates)
+}
+
+func TestVscMaturedReleasesHolds(t *testing.T) {
+	k, ctx, mocks := keepertest.CoordinatorKeeper(t)
+	chainID := "appchain-1"
+	setupSubscriberChain(k, ctx, mocks, chainID, "connection-0", "channel-0")
+	operator, leaving := newOperator(), newOperator()
+	leavingKey := newConsKey()
+	mocks.Operator.SetOperator(operator, "appchain", newConsKey(), 100)
+	mocks.Operator.SetOperator(leaving, "appchain", leavingKey, 50)
+	k.QueueValidatorSetUpdates(ctx, testEpochIdentifier)
+
+	recordKey := []byte("record")
+	require.NoError(t, k.DelegationHooks().AfterUndelegationStarted(ctx, operator, recordKey))
+	mocks.Operator.RemoveOperator(leaving, "appchain")
+	k.OperatorHooks().AfterOperatorKeyRemovalInitiated(ctx, leaving, "appchain", leavingKey)
+	// the key removal is held since the operator is still a validator on the chain.
+	require.Empty(t, mocks.Operator.RemovedKeys)
+	k.QueueValidatorSetUpdates(ctx, testEpochIdentifier)
+	require.Equal(t, uint64(2), k.GetVscIDForChain(ctx, chainID))
+
+	// a packet for a vscID that was never sent is rejected.
+	packet := channeltypes.Packet{DestinationChannel: "channel-0"}
+	ack := k.OnRecvVscMaturedPacket(ctx, packet, commontypes.VscMaturedPacketData{ValsetUpdateID: 3})
+	require.False(t, ack.Success())
+	// so is a packet on an unknown channel.
+	ack = k.OnRecvVscMaturedPacket(
+		ctx, channeltypes.Packet{DestinationChannel: "channel-9"},
+		commontypes.VscMaturedPacketData{ValsetUpdateID: 2},
+	)
+	require.False(t, ack.Success())
+	require.Equal(t, uint64(1), mocks.Delegation.HoldCounts[string(recordKey)])
+
+	// the maturity of an earlier vscID does not release the holds of a later one.
+	ack = k.OnRecvVscMaturedPacket(ctx, packet, commontypes.VscMaturedPacketData{ValsetUpdateID: 1})
+	require.True(t, ack.Success())
+	require.Equal(t, uint64(1), mocks.Delegation.HoldCounts[string(recordKey)])
+	require.Empty(t, mocks.Operator.RemovedKeys)
+
+	ack = k.OnRecvVscMaturedPacket(ctx, packet, commontypes.VscMaturedPacketData{ValsetUpdateID: 2})
+	require.True(t, ack.Success())
+	require.Zero(t, mocks.Delegation.HoldCounts[string(recordKey)])
+	require.Equal(t, []string{"appchain/" + leaving.String()}, mocks.Operator.RemovedKeys)
+	require.False(t, k.HasHoldsForVscID(ctx, chainID, 2))
+}
+
+func TestOptOutCompletedForNonValidator(t *testing.T) {
+	k, ctx, mocks := keepertest.CoordinatorKeeper(t)
+	chainID := "appchain-1"
+	setupSubscriberChain(k, ctx, mocks, chainID, "connection-0", "channel-0")
+	operator := newOperator()
+	key := newConsKey()
+	mocks.Operator.SetOperator(operator, "appchain", key, 100)
+	// the operator opts out before any validator set is sent to the chain.
+	mocks.Operator.RemoveOperator(operator, "appchain")
+	k.OperatorHooks().AfterOperatorKeyRemovalInitiated(ctx, operator, "appchain", key)
+	require.Equal(t, []string{"appchain/" + operator.String()}, mocks.Operator.RemovedKeys)
+	require.False(t, k.HasHoldsForVscID(ctx, chainID, 1))
+}
+
+func TestTimeoutReleasesAllHolds(t *testing.T) {
+	k, ctx, mocks := keepertest.CoordinatorKeeper(t)
+	chainID := "appchain-1"
+	setupSubscriberChain(k, ctx, mocks, chainID, "connection-0", "channel-0")
+	operator, leaving := newOperator(), newOperator()
+	leavingKey := newConsKey()
+	mocks.Operator.SetOperator(operator, "appchain", newConsKey(), 100)
+	mocks.Operator.SetOperator(leaving, "appchain", leavingKey, 50)
+	k.QueueValidatorSetUpdates(ctx, testEpochIdentifier)
+
+	// holds for two different validator set changes.
+	require.NoError(t, k.DelegationHooks().AfterUndelegationStarted(ctx, operator, []byte("first")))
+	k.QueueValidatorSetUpdates(ctx, testEpochIdentifier)
+	require.NoError(t, k.DelegationHooks().AfterUndelegationStarted(ctx, operator, []byte("second")))
+	mocks.Operator.RemoveOperator(leaving, "appchain")
+	k.OperatorHooks().AfterOperatorKeyRemovalInitiated(ctx, leaving, "appchain", leavingKey)
+	require.True(t, k.HasHoldsForVscID(ctx, chainID, 2))
+	require.True(t, k.HasHoldsForVscID(ctx, chainID, 3))
+
+	require.NoError(t, k.OnTimeoutPacket(ctx, channeltypes.Packet{SourceChannel: "channel-0"}))
+	require.Zero(t, mocks.Delegation.HoldCounts["first"])
+	require.Zero(t, mocks.Delegation.HoldCounts["second"])
+	require.Equal(t, []string{"appchain/" + leaving.String()}, mocks.Operator.RemovedKeys)
+	require.False(t, k.HasHoldsForVscID(ctx, chainID, 2))
+	require.False(t, k.HasHoldsForVscID(ctx, chainID, 3))
+	_, found := k.GetChannelForChain(ctx, chainID)
+	require.False(t, found)
+	_, found = k.GetChainForChannel(ctx, "channel-0")
+	require.False(t, found)
+}
@@ -0,0 +1,156 @@
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	commontypes "github.com/ExocoreNetwork/exocore/x/appchain/common/types"
+	"github.com/ExocoreNetwork/exocore/x/appchain/coordinator/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
+	clienttypes "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
+	conntypes "github.com/cosmos/ibc-go/v7/modules/core/03-connection/types"
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
+	host "github.com/cosmos/ibc-go/v7/modules/core/24-host"
+	ibctmtypes "github.com/cosmos/ibc-go/v7/modules/light-clients/07-tendermint"
+)
+
+// IsBound checks if the coordinator module is already bound to the desired port.
+func (k Keeper) IsBound(ctx sdk.Context, portID string) bool {
+	_, ok := k.scopedKeeper.GetCapability(ctx, host.PortPath(portID))
+	return ok
+}
+
+// BindPort defines a wrapper function for the port Keeper's function in
+// order to expose it to the module's InitGenesis function.
+func (k Keeper) BindPort(ctx sdk.Context, portID string) error {
+	capability := k.portKeeper.BindPort(ctx, portID)
+	return k.ClaimCapability(ctx, capability, host.PortPath(portID))
+}
+
+// GetPort returns the port ID that the coordinator module binds to.
+func (k Keeper) GetPort(sdk.Context) string {
+	return types.PortID
+}
+
+// ClaimCapability allows the coordinator module to claim a capability that the IBC module
+// passes to it.
+func (k Keeper) ClaimCapability(
+	ctx sdk.Context, capability *capabilitytypes.Capability, name string,
+) error {
+	return k.scopedKeeper.ClaimCapability(ctx, capability, name)
+}
+
+// VerifySubscriberChain verifies that the channel being opened is with a subscriber chain
+// registered by this module. The connection underlying the channel must be built on top of the
+// client created for the subscriber, the subscriber genesis must still be stored (that is, the
+// chain has not been initialized or timed out) and no channel must exist for the chain.
+func (k Keeper) VerifySubscriberChain(
+	ctx sdk.Context, connectionHops []string,
+) (chainID string, err error) {
+	if len(connectionHops) != 1 {
+		return "", errorsmod.Wrap(
+			channeltypes.ErrTooManyConnectionHops,
+			"must have direct connection to subscriber chain",
+		)
+	}
+	clientID, chainID, err := k.getUnderlyingClient(ctx, connectionHops[0])
+	if err != nil {
+		return "", err
+	}
+	expectedClientID, found := k.GetClientForChain(ctx, chainID)
+	if !found {
+		return "", errorsmod.Wrapf(
+			commontypes.ErrClientNotFound, "no client registered for chain %s", chainID,
+		)
+	}
+	if clientID != expectedClientID {
+		return "", errorsmod.Wrapf(
+			commontypes.ErrClientNotFound,
+			"client %s does not match the client %s created for chain %s",
+			clientID, expectedClientID, chainID,
+		)
+	}
+	if _, found := k.GetSubscriberGenesis(ctx, chainID); !found {
+		return "", errorsmod.Wrapf(
+			types.ErrNoSubscriberGenesis, "no subscriber genesis stored for chain %s", chainID,
+		)
+	}
+	if channelID, found := k.GetChannelForChain(ctx, chainID); found {
+		return "", errorsmod.Wrapf(
+			commontypes.ErrDuplicateChannel,
+			"channel %s already exists for chain %s", channelID, chainID,
+		)
+	}
+	return chainID, nil
+}
+
+// SetSubscriberChain stores the mapping between the subscriber chain and the channel once
+// the channel handshake is confirmed. The chain is removed from the init timeout queue and
+// its genesis state, which is no longer needed, is pruned.
+func (k Keeper) SetSubscriberChain(ctx sdk.Context, channelID string) error {
+	channel, found := k.channelKeeper.GetChannel(ctx, types.PortID, channelID)
+	if !found {
+		return errorsmod.Wrapf(
+			channeltypes.ErrChannelNotFound, "channel not found for channel ID: %s", channelID,
+		)
+	}
+	chainID, err := k.VerifySubscriberChain(ctx, channel.ConnectionHops)
+	if err != nil {
+		return err
+	}
+	clientID, _ := k.GetClientForChain(ctx, chainID)
+	k.SetChannelForChain(ctx, chainID, channelID)
+	k.SetChainForChannel(ctx, channelID, chainID)
+	if epoch, found := k.GetChainInitTimeout(ctx, chainID); found {
+		k.RemoveChainFromInitTimeout(ctx, epoch, chainID)
+		k.DeleteChainInitTimeout(ctx, chainID)
+	}
+	k.DeleteSubscriberGenesis(ctx, chainID)
+
+	k.Logger(ctx).Info(
+		"subscriber chain channel established",
+		"chainID", chainID,
+		"channelID", channelID,
+	)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			commontypes.EventTypeChannelEstablished,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(commontypes.AttributeChainID, chainID),
+			sdk.NewAttribute(clienttypes.AttributeKeyClientID, clientID),
+			sdk.NewAttribute(channeltypes.AttributeKeyChannelID, channelID),
+			sdk.NewAttribute(conntypes.AttributeKeyConnectionID, channel.ConnectionHops[0]),
+		),
+	)
+	return nil
+}
+
+// getUnderlyingClient returns the client ID and the chain ID of the tendermint client that
+// the connection is built on.
+func (k Keeper) getUnderlyingClient(
+	ctx sdk.Context, connectionID string,
+) (clientID string, chainID string, err error) {
+	conn, found := k.connectionKeeper.GetConnection(ctx, connectionID)
+	if !found {
+		return "", "", errorsmod.Wrapf(
+			conntypes.ErrConnectionNotFound, "connection not found for connection ID: %s",
+			connectionID,
+		)
+	}
+	clientID = conn.ClientId
+	clientState, found := k.clientKeeper.GetClientState(ctx, clientID)
+	if !found {
+		return "", "", errorsmod.Wrapf(
+			clienttypes.ErrClientNotFound, "client not found for client ID: %s", clientID,
+		)
+	}
+	tmClient, ok := clientState.(*ibctmtypes.ClientState)
+	if !ok {
+		return "", "", errorsmod.Wrapf(
+			clienttypes.ErrInvalidClientType,
+			"invalid client type. expected %s, got %s",
+			ibctmtypes.ModuleName, clientState.ClientType(),
+		)
+	}
+	return clientID, tmClient.ChainId, nil
+}
@@ -4,11 +4,8 @@ import (
 	"fmt"
 
 	errorsmod "cosmossdk.io/errors"
-	"github.com/ExocoreNetwork/exocore/utils"
 	commontypes "github.com/ExocoreNetwork/exocore/x/appchain/common/types"
 	"github.com/ExocoreNetwork/exocore/x/appchain/coordinator/types"
-	avstypes "github.com/ExocoreNetwork/exocore/x/avs/types"
-	abci "github.com/cometbft/cometbft/abci/types"
 	tmtypes "github.com/cometbft/cometbft/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -74,6 +71,13 @@ func (k Keeper) CreateClientForSubscriber(
 	k.SetSubSlashFractionDowntime(ctx, chainID, subscriberParams.SlashFractionDowntime)
 	k.SetSubSlashFractionDoubleSign(ctx, chainID, subscriberParams.SlashFractionDoubleSign)
 	k.SetSubDowntimeJailDuration(ctx, chainID, subscriberParams.DowntimeJailDuration)
+	// the validator set updates are sent at the end of each epoch of the chain, starting
+	// from the initial validator set.
+	k.SetMaxValidatorsForChain(ctx, chainID, req.MaxValidators)
+	for _, validator := range subscriberGenesis.Coordinator.InitialValSet {
+		k.SetSubscriberValidator(ctx, chainID, validator)
+	}
+	k.AppendActiveChainToEpoch(ctx, req.EpochIdentifier, chainID)
 	consensusState := ibctmtypes.NewConsensusState(
 		ctx.BlockTime(),
 		commitmenttypes.NewMerkleRoot([]byte(ibctmtypes.SentinelRoot)),
@@ -93,6 +97,7 @@ func (k Keeper) CreateClientForSubscriber(
 	// so when epoch 6 ends, the timeout ends.
 	initTimeoutPeriod.EpochNumber += uint64(epochInfo.CurrentEpoch) + 1
 	k.AppendChainToInitTimeout(ctx, initTimeoutPeriod, chainID)
+	k.SetChainInitTimeout(ctx, chainID, initTimeoutPeriod)
 
 	k.Logger(ctx).Info(
 		"subscriber chain registered (client created)",
@@ -131,7 +136,6 @@ func (k Keeper) MakeSubscriberGenesis(
 	params := k.GetParams(ctx)
 	chainID := req.ChainID
 	k.Logger(ctx).Info("Creating genesis state for subscriber chain", "chainID", chainID)
-	chainIDWithoutRevision := avstypes.ChainIDWithoutRevision(chainID)
 	coordinatorUnbondingPeriod := k.stakingKeeper.UnbondingTime(ctx)
 	// client state
 	clientState := params.TemplateClient
@@ -158,41 +162,10 @@ func (k Keeper) MakeSubscriberGenesis(
 			err, chainID,
 		)
 	}
-	operators, keys := k.operatorKeeper.GetActiveOperatorsForChainID(ctx, chainIDWithoutRevision)
-	powers, err := k.operatorKeeper.GetVotePowerForChainID(
-		ctx, operators, chainIDWithoutRevision,
-	)
+	validatorUpdates, err := k.GetValidatorSetForChain(ctx, chainID, req.MaxValidators)
 	if err != nil {
-		// the `err` includes the `chainID` and hence no need to log it again
-		k.Logger(ctx).Error("error getting vote power for chain", "error", err)
 		return nil, nil, err
 	}
-	operators, keys, powers = utils.SortByPower(operators, keys, powers)
-	maxVals := req.MaxValidators
-	validatorUpdates := make(
-		[]abci.ValidatorUpdate, 0,
-		min(
-			// the maximum size of the validator set is the minimum of the number of operators
-			// and the max validators allowed by the subscriber chain. the capacity is not
-			// multiplied by 2 here because this is the initial validator set and there are no
-			// previous validators whose power will be set to zero.
-			int(maxVals), len(operators),
-		),
-	)
-	for i := range operators {
-		if i >= int(maxVals) {
-			break
-		}
-		power := powers[i]
-		if power < 1 {
-			break
-		}
-		wrappedKey := keys[i]
-		validatorUpdates = append(validatorUpdates, abci.ValidatorUpdate{
-			PubKey: *wrappedKey.ToTmProtoKey(),
-			Power:  power,
-		})
-	}
 	if len(validatorUpdates) == 0 {
 		return nil, nil, errorsmod.Wrapf(
 			types.ErrNoOperators, "no operators with stake found for chainID: %s", chainID,
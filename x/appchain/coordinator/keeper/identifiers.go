@@ -2,6 +2,8 @@ package keeper
 
 import (
 	"github.com/ExocoreNetwork/exocore/x/appchain/coordinator/types"
+	avstypes "github.com/ExocoreNetwork/exocore/x/avs/types"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -30,3 +32,100 @@ func (k Keeper) DeleteClientForChain(ctx sdk.Context, chainID string) {
 	store := ctx.KVStore(k.storeKey)
 	store.Delete(types.ClientForChainKey(chainID))
 }
+
+// GetAllChainsWithClient returns the chain ids of all the chains with a client.
+func (k Keeper) GetAllChainsWithClient(ctx sdk.Context) []string {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte{types.ClientForChainBytePrefix})
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	res := make([]string, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		res = append(res, string(iterator.Key()))
+	}
+	return res
+}
+
+// SetChannelForChain sets the ibc channel id for a given chain id.
+func (k Keeper) SetChannelForChain(
+	ctx sdk.Context, chainID string, channelID string,
+) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.ChainToChannelKey(chainID), []byte(channelID))
+}
+
+// GetChannelForChain gets the ibc channel id for a given chain id.
+func (k Keeper) GetChannelForChain(
+	ctx sdk.Context, chainID string,
+) (string, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bytes := store.Get(types.ChainToChannelKey(chainID))
+	if bytes == nil {
+		return "", false
+	}
+	return string(bytes), true
+}
+
+// DeleteChannelForChain deletes the ibc channel id for a given chain id.
+func (k Keeper) DeleteChannelForChain(ctx sdk.Context, chainID string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.ChainToChannelKey(chainID))
+}
+
+// SetChainForChannel sets the chain id for a given ibc channel id.
+func (k Keeper) SetChainForChannel(
+	ctx sdk.Context, channelID string, chainID string,
+) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.ChannelToChainKey(channelID), []byte(chainID))
+}
+
+// GetChainForChannel gets the chain id for a given ibc channel id.
+func (k Keeper) GetChainForChannel(
+	ctx sdk.Context, channelID string,
+) (string, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bytes := store.Get(types.ChannelToChainKey(channelID))
+	if bytes == nil {
+		return "", false
+	}
+	return string(bytes), true
+}
+
+// DeleteChainForChannel deletes the chain id for a given ibc channel id.
+func (k Keeper) DeleteChainForChannel(ctx sdk.Context, channelID string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.ChannelToChainKey(channelID))
+}
+
+// GetAllChainsWithChannel returns the chain ids of all the chains with an established channel.
+func (k Keeper) GetAllChainsWithChannel(ctx sdk.Context) []string {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte{types.ChainToChannelBytePrefix})
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	res := make([]string, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		res = append(res, string(iterator.Key()))
+	}
+	return res
+}
+
+// GetSubscriberChainID returns the chain id (with the revision) of the subscriber chain
+// registered by this module, given the chain id without the revision. It returns false if
+// no client was created by this module for such a chain.
+func (k Keeper) GetSubscriberChainID(
+	ctx sdk.Context, chainIDWithoutRevision string,
+) (string, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte{types.ClientForChainBytePrefix})
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		chainID := string(iterator.Key())
+		if avstypes.ChainIDWithoutRevision(chainID) == chainIDWithoutRevision {
+			return chainID, true
+		}
+	}
+	return "", false
+}
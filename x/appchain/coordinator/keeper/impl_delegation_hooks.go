@@ -0,0 +1,82 @@
+package keeper
+
+import (
+	"fmt"
+
+	avstypes "github.com/ExocoreNetwork/exocore/x/avs/types"
+	delegationtypes "github.com/ExocoreNetwork/exocore/x/delegation/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// DelegationHooksWrapper is the wrapper structure that implements the delegation hooks for the
+// coordinator keeper.
+type DelegationHooksWrapper struct {
+	keeper *Keeper
+}
+
+// Interface guard
+var _ delegationtypes.DelegationHooks = DelegationHooksWrapper{}
+
+// DelegationHooks returns the delegation hooks wrapper. It follows the "accept interfaces,
+// return concretes" pattern.
+func (k *Keeper) DelegationHooks() DelegationHooksWrapper {
+	return DelegationHooksWrapper{k}
+}
+
+// AfterDelegation is called after a delegation is made.
+func (wrapper DelegationHooksWrapper) AfterDelegation(
+	sdk.Context, sdk.AccAddress,
+) {
+	// we do nothing here, since the vote power for all operators is calculated
+	// at the end of the epoch of each subscriber separately.
+}
+
+// AfterUndelegationStarted is called after an undelegation is started. For each subscriber
+// chain on which the operator is a validator, the undelegation is held until the next
+// validator set change of the chain (which reflects the reduced vote power) matures on it.
+func (wrapper DelegationHooksWrapper) AfterUndelegationStarted(
+	ctx sdk.Context, operator sdk.AccAddress, recordKey []byte,
+) error {
+	for _, chainID := range wrapper.keeper.GetAllChainsWithChannel(ctx) {
+		if !wrapper.keeper.isSubscriberValidator(ctx, operator, chainID) {
+			continue
+		}
+		// the next validator set change is sent at the end of the epoch of the chain, even
+		// if there are no changes in the validator set, since an operation is held for it.
+		vscID := wrapper.keeper.GetVscIDForChain(ctx, chainID) + 1
+		wrapper.keeper.Logger(ctx).Debug(
+			"AfterUndelegationStarted: holding undelegation",
+			"operator", operator,
+			"chainID", chainID,
+			"vscID", vscID,
+			"recordKey", fmt.Sprintf("%x", recordKey),
+		)
+		wrapper.keeper.AppendUndelegationToRelease(ctx, chainID, vscID, recordKey)
+		if err := wrapper.keeper.delegationKeeper.IncrementUndelegationHoldCount(
+			ctx, recordKey,
+		); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// isSubscriberValidator returns true if the current or the previous consensus key of the
+// operator for the chain is in the validator set last sent to the chain.
+func (k Keeper) isSubscriberValidator(
+	ctx sdk.Context, operator sdk.AccAddress, chainID string,
+) bool {
+	chainIDWithoutRevision := avstypes.ChainIDWithoutRevision(chainID)
+	if found, key, _ := k.operatorKeeper.GetOperatorConsKeyForChainID(
+		ctx, operator, chainIDWithoutRevision,
+	); found && k.HasSubscriberValidator(ctx, chainID, key.ToConsAddr()) {
+		return true
+	}
+	// maybe they changed the key. check the previous key.
+	if found, prevKey, _ := k.operatorKeeper.GetOperatorPrevConsKeyForChainID(
+		ctx, operator, chainIDWithoutRevision,
+	); found && k.HasSubscriberValidator(ctx, chainID, prevKey.ToConsAddr()) {
+		return true
+	}
+	return false
+}
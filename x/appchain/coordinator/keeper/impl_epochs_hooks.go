@@ -69,6 +69,7 @@ func (wrapper EpochsHooksWrapper) AfterEpochEnd(
 	// delete those that were executed (including those that failed)
 	wrapper.keeper.ClearPendingSubChains(ctx, identifier, uint64(epoch))
 	// next, we iterate over the active list and queue the validator set update for them.
+	wrapper.keeper.QueueValidatorSetUpdates(ctx, identifier)
 }
 
 // BeforeEpochStart is called before an epoch starts.
@@ -0,0 +1,67 @@
+package keeper
+
+import (
+	keytypes "github.com/ExocoreNetwork/exocore/types/keys"
+	operatortypes "github.com/ExocoreNetwork/exocore/x/operator/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// OperatorHooksWrapper is the wrapper structure that implements the operator hooks for the
+// coordinator keeper.
+type OperatorHooksWrapper struct {
+	keeper *Keeper
+}
+
+// Interface guards
+var _ operatortypes.OperatorHooks = OperatorHooksWrapper{}
+
+func (k *Keeper) OperatorHooks() OperatorHooksWrapper {
+	return OperatorHooksWrapper{k}
+}
+
+// AfterOperatorKeySet is the implementation of the operator hooks.
+func (h OperatorHooksWrapper) AfterOperatorKeySet(
+	sdk.Context, sdk.AccAddress, string, keytypes.WrappedConsKey,
+) {
+	// an operator opting in does not meaningfully affect this module, since
+	// this information will be fetched at the end of the epoch of the subscriber
+	// and the operator's vote power will be calculated then.
+}
+
+// AfterOperatorKeyReplaced is the implementation of the operator hooks.
+func (h OperatorHooksWrapper) AfterOperatorKeyReplaced(
+	sdk.Context, sdk.AccAddress, keytypes.WrappedConsKey, keytypes.WrappedConsKey, string,
+) {
+	// the vote power of the old key is set to 0 and that of the new key is calculated at the
+	// end of the epoch of the subscriber, as part of the validator set change.
+}
+
+// AfterOperatorKeyRemovalInitiated is the implementation of the operator hooks. If the
+// operator is a validator on the subscriber chain, the key removal is held until the next
+// validator set change of the chain (which removes the operator) matures on it. Otherwise,
+// the key removal is completed immediately.
+func (h OperatorHooksWrapper) AfterOperatorKeyRemovalInitiated(
+	ctx sdk.Context, operator sdk.AccAddress, chainID string, key keytypes.WrappedConsKey,
+) {
+	// the chainID received here is without the revision
+	subscriberChainID, found := h.keeper.GetSubscriberChainID(ctx, chainID)
+	if !found {
+		// not a subscriber chain, so some other module is responsible for it.
+		return
+	}
+	_, hasChannel := h.keeper.GetChannelForChain(ctx, subscriberChainID)
+	if hasChannel && h.keeper.HasSubscriberValidator(ctx, subscriberChainID, key.ToConsAddr()) {
+		vscID := h.keeper.GetVscIDForChain(ctx, subscriberChainID) + 1
+		h.keeper.AppendOptOutToFinish(ctx, subscriberChainID, vscID, operator)
+		return
+	}
+	// the operator is not a validator on the chain, or the chain cannot request slashing
+	// since it has no channel to the coordinator.
+	if err := h.keeper.completeOptOut(ctx, subscriberChainID, operator); err != nil {
+		h.keeper.Logger(ctx).Error(
+			"error completing operator key removal",
+			"chainID", subscriberChainID,
+			"error", err,
+		)
+	}
+}
@@ -13,13 +13,19 @@ import (
 )
 
 type Keeper struct {
-	cdc            codec.BinaryCodec
-	storeKey       storetypes.StoreKey
-	avsKeeper      types.AVSKeeper
-	epochsKeeper   types.EpochsKeeper
-	operatorKeeper types.OperatorKeeper
-	stakingKeeper  types.StakingKeeper
-	clientKeeper   commontypes.ClientKeeper
+	cdc              codec.BinaryCodec
+	storeKey         storetypes.StoreKey
+	avsKeeper        types.AVSKeeper
+	epochsKeeper     types.EpochsKeeper
+	operatorKeeper   types.OperatorKeeper
+	delegationKeeper types.DelegationKeeper
+	stakingKeeper    types.StakingKeeper
+	clientKeeper     commontypes.ClientKeeper
+	// IBC keepers for the channel handshake and the packets
+	scopedKeeper     commontypes.ScopedKeeper
+	portKeeper       commontypes.PortKeeper
+	channelKeeper    commontypes.ChannelKeeper
+	connectionKeeper commontypes.ConnectionKeeper
 }
 
 // NewKeeper creates a new coordinator keeper.
@@ -29,17 +35,27 @@ func NewKeeper(
 	avsKeeper types.AVSKeeper,
 	epochsKeeper types.EpochsKeeper,
 	operatorKeeper types.OperatorKeeper,
+	delegationKeeper types.DelegationKeeper,
 	stakingKeeper types.StakingKeeper,
 	clientKeeper commontypes.ClientKeeper,
+	scopedKeeper commontypes.ScopedKeeper,
+	portKeeper commontypes.PortKeeper,
+	channelKeeper commontypes.ChannelKeeper,
+	connectionKeeper commontypes.ConnectionKeeper,
 ) Keeper {
 	return Keeper{
-		cdc:            cdc,
-		storeKey:       storeKey,
-		avsKeeper:      avsKeeper,
-		epochsKeeper:   epochsKeeper,
-		operatorKeeper: operatorKeeper,
-		stakingKeeper:  stakingKeeper,
-		clientKeeper:   clientKeeper,
+		cdc:              cdc,
+		storeKey:         storeKey,
+		avsKeeper:        avsKeeper,
+		epochsKeeper:     epochsKeeper,
+		operatorKeeper:   operatorKeeper,
+		delegationKeeper: delegationKeeper,
+		stakingKeeper:    stakingKeeper,
+		clientKeeper:     clientKeeper,
+		scopedKeeper:     scopedKeeper,
+		portKeeper:       portKeeper,
+		channelKeeper:    channelKeeper,
+		connectionKeeper: connectionKeeper,
 	}
 }
 
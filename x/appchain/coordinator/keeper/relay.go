@@ -0,0 +1,100 @@
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	commontypes "github.com/ExocoreNetwork/exocore/x/appchain/common/types"
+	"github.com/ExocoreNetwork/exocore/x/appchain/coordinator/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
+	ibcexported "github.com/cosmos/ibc-go/v7/modules/core/exported"
+)
+
+// OnRecvVscMaturedPacket handles a VSC matured packet received from a subscriber. The
+// operations held until the validator set change matures are released.
+func (k Keeper) OnRecvVscMaturedPacket(
+	ctx sdk.Context, packet channeltypes.Packet, data commontypes.VscMaturedPacketData,
+) ibcexported.Acknowledgement {
+	chainID, found := k.GetChainForChannel(ctx, packet.DestinationChannel)
+	if !found {
+		return channeltypes.NewErrorAcknowledgement(
+			errorsmod.Wrapf(
+				commontypes.ErrInvalidChannelFlow,
+				"packet received on unknown channel %s", packet.DestinationChannel,
+			),
+		)
+	}
+	if data.ValsetUpdateID > k.GetVscIDForChain(ctx, chainID) {
+		return channeltypes.NewErrorAcknowledgement(
+			errorsmod.Wrapf(
+				commontypes.ErrInvalidPacketData,
+				"valset update ID %d was never sent to chain %s",
+				data.ValsetUpdateID, chainID,
+			),
+		)
+	}
+	k.ReleaseHolds(ctx, chainID, data.ValsetUpdateID)
+
+	k.Logger(ctx).Info(
+		"VSC matured packet received",
+		"chainID", chainID,
+		"vscID", data.ValsetUpdateID,
+	)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			commontypes.EventTypeVscMatured,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(commontypes.AttributeChainID, chainID),
+			sdk.NewAttribute(
+				commontypes.AttributeValsetUpdateID,
+				sdk.NewIntFromUint64(data.ValsetUpdateID).String(),
+			),
+		),
+	)
+	return channeltypes.NewResultAcknowledgement([]byte{byte(1)})
+}
+
+// OnAcknowledgementPacket handles the acknowledgement of a validator set change packet. A
+// subscriber responds with an error acknowledgement if it could not apply the packet.
+func (k Keeper) OnAcknowledgementPacket(
+	ctx sdk.Context, packet channeltypes.Packet, ack channeltypes.Acknowledgement,
+) error {
+	if res, ok := ack.Response.(*channeltypes.Acknowledgement_Error); ok {
+		chainID, _ := k.GetChainForChannel(ctx, packet.SourceChannel)
+		k.Logger(ctx).Error(
+			"validator set change packet rejected by subscriber",
+			"chainID", chainID,
+			"channelID", packet.SourceChannel,
+			"sequence", packet.Sequence,
+			"error", res.Error,
+		)
+	}
+	return nil
+}
+
+// OnTimeoutPacket handles the timeout of a validator set change packet. Since the channel is
+// ordered, IBC closes it on timeout, and the chain cannot reconnect since its genesis was
+// pruned once the channel was established. Hence, the chain is stopped: the channel mappings
+// are removed, the chain no longer receives validator set updates, and the operations held
+// for the chain are released since it can no longer request slashing.
+func (k Keeper) OnTimeoutPacket(ctx sdk.Context, packet channeltypes.Packet) error {
+	chainID, found := k.GetChainForChannel(ctx, packet.SourceChannel)
+	if !found {
+		k.Logger(ctx).Error(
+			"packet timeout on unknown channel",
+			"channelID", packet.SourceChannel,
+		)
+		return nil
+	}
+	k.DeleteChannelForChain(ctx, chainID)
+	k.DeleteChainForChannel(ctx, packet.SourceChannel)
+	k.RemoveActiveChain(ctx, chainID)
+	k.ReleaseAllHolds(ctx, chainID)
+	k.Logger(ctx).Error(
+		"validator set change packet timed out, channel closed",
+		"chainID", chainID,
+		"channelID", packet.SourceChannel,
+		"sequence", packet.Sequence,
+	)
+	return nil
+}
@@ -60,3 +60,34 @@ func (k Keeper) ClearChainsToInitTimeout(
 	store := ctx.KVStore(k.storeKey)
 	store.Delete(types.InitTimeoutEpochKey(epoch))
 }
+
+// SetChainInitTimeout stores the epoch at the end of which the chain will timeout (if not
+// initialized by then). It is used to remove the chain from the timeout list once the channel
+// is established.
+func (k Keeper) SetChainInitTimeout(
+	ctx sdk.Context, chainID string, epoch epochstypes.Epoch,
+) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&epoch)
+	store.Set(types.ChainInitTimeoutKey(chainID), bz)
+}
+
+// GetChainInitTimeout returns the epoch at the end of which the chain will timeout (if not
+// initialized by then).
+func (k Keeper) GetChainInitTimeout(
+	ctx sdk.Context, chainID string,
+) (epoch epochstypes.Epoch, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.ChainInitTimeoutKey(chainID))
+	if bz == nil {
+		return epoch, false
+	}
+	k.cdc.MustUnmarshal(bz, &epoch)
+	return epoch, true
+}
+
+// DeleteChainInitTimeout deletes the init timeout epoch of the chain.
+func (k Keeper) DeleteChainInitTimeout(ctx sdk.Context, chainID string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.ChainInitTimeoutKey(chainID))
+}
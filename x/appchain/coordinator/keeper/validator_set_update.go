@@ -0,0 +1,324 @@
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	keytypes "github.com/ExocoreNetwork/exocore/types/keys"
+	"github.com/ExocoreNetwork/exocore/utils"
+	commontypes "github.com/ExocoreNetwork/exocore/x/appchain/common/types"
+	"github.com/ExocoreNetwork/exocore/x/appchain/coordinator/types"
+	avstypes "github.com/ExocoreNetwork/exocore/x/avs/types"
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// GetValidatorSetForChain returns the validator set of the chain, as determined by the vote
+// power of the operators opted into it. It is sorted by power (with the operator address as
+// the tie breaker) and capped at `maxVals` validators.
+func (k Keeper) GetValidatorSetForChain(
+	ctx sdk.Context, chainID string, maxVals uint32,
+) ([]abci.ValidatorUpdate, error) {
+	chainIDWithoutRevision := avstypes.ChainIDWithoutRevision(chainID)
+	operators, keys := k.operatorKeeper.GetActiveOperatorsForChainID(ctx, chainIDWithoutRevision)
+	powers, err := k.operatorKeeper.GetVotePowerForChainID(
+		ctx, operators, chainIDWithoutRevision,
+	)
+	if err != nil {
+		// the `err` includes the `chainID` and hence no need to log it again
+		k.Logger(ctx).Error("error getting vote power for chain", "error", err)
+		return nil, err
+	}
+	operators, keys, powers = utils.SortByPower(operators, keys, powers)
+	validatorUpdates := make(
+		[]abci.ValidatorUpdate, 0,
+		min(
+			// the maximum size of the validator set is the minimum of the number of operators
+			// and the max validators allowed by the subscriber chain.
+			int(maxVals), len(operators),
+		),
+	)
+	for i := range operators {
+		if i >= int(maxVals) {
+			break
+		}
+		power := powers[i]
+		if power < 1 {
+			break
+		}
+		wrappedKey := keys[i]
+		validatorUpdates = append(validatorUpdates, abci.ValidatorUpdate{
+			PubKey: *wrappedKey.ToTmProtoKey(),
+			Power:  power,
+		})
+	}
+	return validatorUpdates, nil
+}
+
+// QueueValidatorSetUpdates sends the validator set change packets to the chains whose epoch,
+// marked by the identifier, has ended. Chains without an established channel are skipped;
+// their validator set is that of their genesis until the channel is established.
+func (k Keeper) QueueValidatorSetUpdates(ctx sdk.Context, epochIdentifier string) {
+	chainIDs := k.GetActiveChainsByEpoch(ctx, epochIdentifier)
+	for _, chainID := range chainIDs.List {
+		channelID, found := k.GetChannelForChain(ctx, chainID)
+		if !found {
+			continue
+		}
+		cctx, writeFn := ctx.CacheContext()
+		if err := k.sendValidatorSetUpdate(cctx, chainID, channelID); err != nil {
+			// the cached context is discarded, and the update will be sent at the end of the
+			// next epoch instead.
+			k.Logger(ctx).Error(
+				"validator set update not sent",
+				"chainID", chainID,
+				"error", err,
+			)
+			continue
+		}
+		ctx.EventManager().EmitEvents(cctx.EventManager().Events())
+		writeFn()
+	}
+}
+
+// sendValidatorSetUpdate computes the diff between the validator set last sent to the chain
+// and its current validator set, and sends it to the chain with a new validator set change ID.
+// Nothing is sent if the validator set has not changed and no operation is held for it.
+func (k Keeper) sendValidatorSetUpdate(
+	ctx sdk.Context, chainID string, channelID string,
+) error {
+	next, err := k.GetValidatorSetForChain(ctx, chainID, k.GetMaxValidatorsForChain(ctx, chainID))
+	if err != nil {
+		return err
+	}
+	if len(next) == 0 {
+		// the consensus engine of the subscriber will halt with an empty validator set, so
+		// we retain the previous set instead.
+		return errorsmod.Wrapf(
+			types.ErrNoOperators, "no operators with stake found for chainID: %s", chainID,
+		)
+	}
+	prevList := k.GetAllSubscriberValidators(ctx, chainID)
+	// prevMap is a map of the previous validators, indexed by the consensus address
+	// and the value being the vote power.
+	prevMap := make(map[string]int64, len(prevList))
+	for _, validator := range prevList {
+		addressString := consAddrFromUpdate(validator).String()
+		prevMap[addressString] = validator.Power
+	}
+	// the capacity is the sum of the sizes since all the previous validators may be removed.
+	updates := make([]abci.ValidatorUpdate, 0, len(next)+len(prevList))
+	for _, validator := range next {
+		addressString := consAddrFromUpdate(validator).String()
+		prevPower, found := prevMap[addressString]
+		// if the power has not changed, skip it.
+		if !found || prevPower != validator.Power {
+			updates = append(updates, validator)
+		}
+		// remove the validator from the previous map, so that 0 power is not queued for it.
+		delete(prevMap, addressString)
+	}
+	// the remaining validators in prevMap have been removed.
+	for _, validator := range prevList {
+		addressString := consAddrFromUpdate(validator).String()
+		if _, exists := prevMap[addressString]; exists {
+			updates = append(updates, abci.ValidatorUpdate{
+				PubKey: validator.PubKey,
+				Power:  0,
+			})
+		}
+	}
+	// if operations are held for the next validator set change, it must be sent (even if
+	// empty) so that its maturity can be tracked.
+	if len(updates) == 0 && !k.HasHoldsForVscID(ctx, chainID, k.GetVscIDForChain(ctx, chainID)+1) {
+		return nil
+	}
+	vscID := k.IncrementVscIDForChain(ctx, chainID)
+	data := commontypes.ValidatorSetChangePacketData{
+		ValidatorUpdates: updates,
+		ValsetUpdateID:   vscID,
+	}
+	bz, err := data.Marshal()
+	if err != nil {
+		return err
+	}
+	if err := commontypes.SendIBCPacket(
+		ctx, k.scopedKeeper, k.channelKeeper, channelID, types.PortID, bz,
+		k.GetParams(ctx).IBCTimeoutPeriod,
+	); err != nil {
+		return err
+	}
+	// the subscriber's validator set is now the one sent.
+	for _, update := range updates {
+		if update.Power == 0 {
+			k.DeleteSubscriberValidator(ctx, chainID, consAddrFromUpdate(update))
+		} else {
+			k.SetSubscriberValidator(ctx, chainID, update)
+		}
+	}
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			commontypes.EventTypeValidatorSetChange,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(commontypes.AttributeChainID, chainID),
+			sdk.NewAttribute(commontypes.AttributeValsetUpdateID, sdk.NewIntFromUint64(vscID).String()),
+		),
+	)
+	return nil
+}
+
+// AppendActiveChainToEpoch appends a chain to the list of chains which receive validator set
+// updates at the end of each epoch with the identifier.
+func (k Keeper) AppendActiveChainToEpoch(
+	ctx sdk.Context, epochIdentifier string, chainID string,
+) {
+	prev := k.GetActiveChainsByEpoch(ctx, epochIdentifier)
+	prev.List = append(prev.List, chainID)
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.ActiveChainsByEpochKey(epochIdentifier), k.cdc.MustMarshal(&prev))
+}
+
+// GetActiveChainsByEpoch returns the list of chains which receive validator set updates at the
+// end of each epoch with the identifier.
+func (k Keeper) GetActiveChainsByEpoch(
+	ctx sdk.Context, epochIdentifier string,
+) (res types.ChainIDs) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.ActiveChainsByEpochKey(epochIdentifier))
+	k.cdc.MustUnmarshal(bz, &res)
+	return res
+}
+
+// RemoveActiveChain removes a chain from the list of chains which receive validator set
+// updates, regardless of the epoch identifier it was registered with.
+func (k Keeper) RemoveActiveChain(ctx sdk.Context, chainID string) {
+	epochIdentifier, found := k.getActiveChainEpoch(ctx, chainID)
+	if !found {
+		return
+	}
+	prev := k.GetActiveChainsByEpoch(ctx, epochIdentifier)
+	for i, id := range prev.List {
+		if id == chainID {
+			prev.List = append(prev.List[:i], prev.List[i+1:]...)
+			break
+		}
+	}
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.ActiveChainsByEpochKey(epochIdentifier), k.cdc.MustMarshal(&prev))
+}
+
+// getActiveChainEpoch returns the identifier of the epoch at the end of which the chain
+// receives validator set updates.
+func (k Keeper) getActiveChainEpoch(ctx sdk.Context, chainID string) (string, bool) {
+	store := prefix.NewStore(
+		ctx.KVStore(k.storeKey), []byte{types.ActiveChainsByEpochBytePrefix},
+	)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var chainIDs types.ChainIDs
+		k.cdc.MustUnmarshal(iterator.Value(), &chainIDs)
+		for _, id := range chainIDs.List {
+			if id == chainID {
+				return string(iterator.Key()), true
+			}
+		}
+	}
+	return "", false
+}
+
+// SetMaxValidatorsForChain sets the maximum number of validators of a chain.
+func (k Keeper) SetMaxValidatorsForChain(
+	ctx sdk.Context, chainID string, maxVals uint32,
+) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.MaxValidatorsKey(chainID), sdk.Uint64ToBigEndian(uint64(maxVals)))
+}
+
+// GetMaxValidatorsForChain gets the maximum number of validators of a chain.
+func (k Keeper) GetMaxValidatorsForChain(
+	ctx sdk.Context, chainID string,
+) uint32 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.MaxValidatorsKey(chainID))
+	if bz == nil {
+		return 0
+	}
+	// #nosec G115 // stored from a uint32
+	return uint32(sdk.BigEndianToUint64(bz))
+}
+
+// SetSubscriberValidator stores the validator of a chain, as sent to it.
+func (k Keeper) SetSubscriberValidator(
+	ctx sdk.Context, chainID string, validator abci.ValidatorUpdate,
+) {
+	store := ctx.KVStore(k.storeKey)
+	key := types.SubscriberValidatorKey(chainID, consAddrFromUpdate(validator))
+	store.Set(key, k.cdc.MustMarshal(&validator))
+}
+
+// HasSubscriberValidator returns true if the validator with the consensus address is in the
+// validator set last sent to the chain.
+func (k Keeper) HasSubscriberValidator(
+	ctx sdk.Context, chainID string, consAddr sdk.ConsAddress,
+) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(types.SubscriberValidatorKey(chainID, consAddr))
+}
+
+// DeleteSubscriberValidator deletes the validator of a chain.
+func (k Keeper) DeleteSubscriberValidator(
+	ctx sdk.Context, chainID string, consAddr sdk.ConsAddress,
+) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.SubscriberValidatorKey(chainID, consAddr))
+}
+
+// GetAllSubscriberValidators returns the validators of a chain, as last sent to it. They are
+// ordered by the consensus address.
+func (k Keeper) GetAllSubscriberValidators(
+	ctx sdk.Context, chainID string,
+) []abci.ValidatorUpdate {
+	store := prefix.NewStore(
+		ctx.KVStore(k.storeKey), types.SubscriberValidatorPrefix(chainID),
+	)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	res := make([]abci.ValidatorUpdate, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		var validator abci.ValidatorUpdate
+		k.cdc.MustUnmarshal(iterator.Value(), &validator)
+		res = append(res, validator)
+	}
+	return res
+}
+
+// SetVscIDForChain sets the latest validator set change ID of a chain.
+func (k Keeper) SetVscIDForChain(ctx sdk.Context, chainID string, vscID uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.VscIDForChainKey(chainID), sdk.Uint64ToBigEndian(vscID))
+}
+
+// IncrementVscIDForChain increments the validator set change ID of a chain and returns the
+// new value. The initial validator set has an ID of 0.
+func (k Keeper) IncrementVscIDForChain(ctx sdk.Context, chainID string) uint64 {
+	vscID := k.GetVscIDForChain(ctx, chainID) + 1
+	k.SetVscIDForChain(ctx, chainID, vscID)
+	return vscID
+}
+
+// GetVscIDForChain gets the latest validator set change ID of a chain.
+func (k Keeper) GetVscIDForChain(ctx sdk.Context, chainID string) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.VscIDForChainKey(chainID))
+	if bz == nil {
+		return 0
+	}
+	return sdk.BigEndianToUint64(bz)
+}
+
+// consAddrFromUpdate returns the consensus address of the validator update.
+func consAddrFromUpdate(update abci.ValidatorUpdate) sdk.ConsAddress {
+	return keytypes.NewWrappedConsKeyFromTmProtoKey(&update.PubKey).ToConsAddr()
+}
@@ -0,0 +1,166 @@
+package keeper_test
+
+import (
+	"testing"
+
+	keepertest "github.com/ExocoreNetwork/exocore/testutil/keeper"
+	keytypes "github.com/ExocoreNetwork/exocore/types/keys"
+	commontypes "github.com/ExocoreNetwork/exocore/x/appchain/common/types"
+	"github.com/ExocoreNetwork/exocore/x/appchain/coordinator/keeper"
+	"github.com/ExocoreNetwork/exocore/x/appchain/coordinator/types"
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testEpochIdentifier = "hour"
+	testMaxValidators   = 10
+)
+
+// setupSubscriberChain registers the chain with an open channel, as if its handshake had been
+// confirmed.
+func setupSubscriberChain(
+	k keeper.Keeper, ctx sdk.Context, mocks *keepertest.AppchainMocks,
+	chainID string, connectionID string, channelID string,
+) {
+	clientID := mocks.IBC.AddConnection(connectionID, chainID)
+	mocks.IBC.OpenChannel(
+		types.PortID, channelID, connectionID,
+		channeltypes.NewCounterparty(commontypes.SubscriberPortID, "channel-0"),
+	)
+	k.SetClientForChain(ctx, chainID, clientID)
+	k.SetChannelForChain(ctx, chainID, channelID)
+	k.SetChainForChannel(ctx, channelID, chainID)
+	k.AppendActiveChainToEpoch(ctx, testEpochIdentifier, chainID)
+	k.SetMaxValidatorsForChain(ctx, chainID, testMaxValidators)
+}
+
+func newConsKey() keytypes.WrappedConsKey {
+	return keytypes.NewWrappedConsKeyFromSdkKey(ed25519.GenPrivKey().PubKey())
+}
+
+func newOperator() sdk.AccAddress {
+	return sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+}
+
+// lastSentPacket decodes the validator set change packet last sent through the mocks.
+func lastSentPacket(t *testing.T, mocks *keepertest.AppchainMocks) commontypes.ValidatorSetChangePacketData {
+	require.NotEmpty(t, mocks.IBC.SentPackets)
+	var data commontypes.ValidatorSetChangePacketData
+	require.NoError(t, data.Unmarshal(mocks.IBC.SentPackets[len(mocks.IBC.SentPackets)-1].Data))
+	return data
+}
+
+func validatorUpdate(key keytypes.WrappedConsKey, power int64) abci.ValidatorUpdate {
+	return abci.ValidatorUpdate{PubKey: *key.ToTmProtoKey(), Power: power}
+}
+
+func TestValidatorSetUpdateDiff(t *testing.T) {
+	k, ctx, mocks := keepertest.CoordinatorKeeper(t)
+	chainID := "appchain-1"
+	setupSubscriberChain(k, ctx, mocks, chainID, "connection-0", "channel-0")
+	first, second, third := newOperator(), newOperator(), newOperator()
+	firstKey, secondKey, thirdKey := newConsKey(), newConsKey(), newConsKey()
+
+	// no validator set is sent without operators.
+	k.QueueValidatorSetUpdates(ctx, testEpochIdentifier)
+	require.Empty(t, mocks.IBC.SentPackets)
+
+	// new keys are added with their power.
+	mocks.Operator.SetOperator(first, "appchain", firstKey, 300)
+	mocks.Operator.SetOperator(second, "appchain", secondKey, 200)
+	k.QueueValidatorSetUpdates(ctx, testEpochIdentifier)
+	data := lastSentPacket(t, mocks)
+	require.Equal(t, uint64(1), data.ValsetUpdateID)
+	require.Equal(t, []abci.ValidatorUpdate{
+		validatorUpdate(firstKey, 300), validatorUpdate(secondKey, 200),
+	}, data.ValidatorUpdates)
+	require.Len(t, k.GetAllSubscriberValidators(ctx, chainID), 2)
+
+	// only the validators with a changed power are sent.
+	mocks.Operator.SetOperator(second, "appchain", secondKey, 250)
+	mocks.Operator.SetOperator(third, "appchain", thirdKey, 100)
+	k.QueueValidatorSetUpdates(ctx, testEpochIdentifier)
+	data = lastSentPacket(t, mocks)
+	require.Equal(t, uint64(2), data.ValsetUpdateID)
+	require.Equal(t, []abci.ValidatorUpdate{
+		validatorUpdate(secondKey, 250), validatorUpdate(thirdKey, 100),
+	}, data.ValidatorUpdates)
+
+	// a removed validator is sent with a power of 0.
+	mocks.Operator.RemoveOperator(third, "appchain")
+	k.QueueValidatorSetUpdates(ctx, testEpochIdentifier)
+	data = lastSentPacket(t, mocks)
+	require.Equal(t, uint64(3), data.ValsetUpdateID)
+	require.Equal(t, []abci.ValidatorUpdate{validatorUpdate(thirdKey, 0)}, data.ValidatorUpdates)
+	require.ElementsMatch(t, []abci.ValidatorUpdate{
+		validatorUpdate(firstKey, 300), validatorUpdate(secondKey, 250),
+	}, k.GetAllSubscriberValidators(ctx, chainID))
+
+	// a rotated key replaces the old key, which is sent with a power of 0.
+	rotatedKey := newConsKey()
+	mocks.Operator.SetOperator(first, "appchain", rotatedKey, 300)
+	k.QueueValidatorSetUpdates(ctx, testEpochIdentifier)
+	data = lastSentPacket(t, mocks)
+	require.Equal(t, uint64(4), data.ValsetUpdateID)
+	require.Equal(t, []abci.ValidatorUpdate{
+		validatorUpdate(rotatedKey, 300), validatorUpdate(firstKey, 0),
+	}, data.ValidatorUpdates)
+	require.ElementsMatch(t, []abci.ValidatorUpdate{
+		validatorUpdate(rotatedKey, 300), validatorUpdate(secondKey, 250),
+	}, k.GetAllSubscriberValidators(ctx, chainID))
+
+	// the validator set is retained if all the operators leave.
+	mocks.Operator.RemoveOperator(first, "appchain")
+	mocks.Operator.RemoveOperator(second, "appchain")
+	k.QueueValidatorSetUpdates(ctx, testEpochIdentifier)
+	require.Len(t, mocks.IBC.SentPackets, 4)
+	require.Len(t, k.GetAllSubscriberValidators(ctx, chainID), 2)
+}
+
+func TestValidatorSetUpdateNotSentOnError(t *testing.T) {
+	k, ctx, mocks := keepertest.CoordinatorKeeper(t)
+	chainID := "appchain-1"
+	setupSubscriberChain(k, ctx, mocks, chainID, "connection-0", "channel-0")
+	key := newConsKey()
+	mocks.Operator.SetOperator(newOperator(), "appchain", key, 100)
+
+	// the state is not changed if the packet cannot be sent.
+	mocks.IBC.SendErr = channeltypes.ErrInvalidChannelState
+	k.QueueValidatorSetUpdates(ctx, testEpochIdentifier)
+	require.Zero(t, k.GetVscIDForChain(ctx, chainID))
+	require.Empty(t, k.GetAllSubscriberValidators(ctx, chainID))
+
+	// and the update is sent at the end of the next epoch.
+	mocks.IBC.SendErr = nil
+	k.QueueValidatorSetUpdates(ctx, testEpochIdentifier)
+	data := lastSentPacket(t, mocks)
+	require.Equal(t, uint64(1), data.ValsetUpdateID)
+	require.Equal(t, []abci.ValidatorUpdate{validatorUpdate(key, 100)}, data.ValidatorUpdates)
+}
+
+func TestTimeoutStopsChain(t *testing.T) {
+	k, ctx, mocks := keepertest.CoordinatorKeeper(t)
+	chainID, otherChain := "appchain-1", "other-1"
+	setupSubscriberChain(k, ctx, mocks, chainID, "connection-0", "channel-0")
+	setupSubscriberChain(k, ctx, mocks, otherChain, "connection-1", "channel-1")
+	mocks.Operator.SetOperator(newOperator(), "appchain", newConsKey(), 100)
+	mocks.Operator.SetOperator(newOperator(), "other", newConsKey(), 100)
+
+	require.NoError(t, k.OnTimeoutPacket(ctx, channeltypes.Packet{SourceChannel: "channel-0"}))
+	require.Equal(t, []string{otherChain}, k.GetActiveChainsByEpoch(ctx, testEpochIdentifier).List)
+	require.Equal(t, []string{otherChain}, k.GetAllChainsWithChannel(ctx))
+
+	// no more updates are sent to the chain.
+	k.QueueValidatorSetUpdates(ctx, testEpochIdentifier)
+	require.Len(t, mocks.IBC.SentPackets, 1)
+	require.Equal(t, "channel-1", mocks.IBC.SentPackets[0].ChannelID)
+	require.Zero(t, k.GetVscIDForChain(ctx, chainID))
+
+	// a timeout on an unknown channel is ignored.
+	require.NoError(t, k.OnTimeoutPacket(ctx, channeltypes.Packet{SourceChannel: "channel-9"}))
+	require.Equal(t, []string{otherChain}, k.GetActiveChainsByEpoch(ctx, testEpochIdentifier).List)
+}
@@ -0,0 +1,217 @@
+package dogfood
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	commontypes "github.com/ExocoreNetwork/exocore/x/appchain/common/types"
+	"github.com/ExocoreNetwork/exocore/x/appchain/coordinator/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/v7/modules/core/05-port/types"
+	host "github.com/cosmos/ibc-go/v7/modules/core/24-host"
+	ibcexported "github.com/cosmos/ibc-go/v7/modules/core/exported"
+)
+
+// interface guard
+var _ porttypes.IBCModule = AppModule{}
+
+// OnChanOpenInit implements the IBCModule interface. The channel handshake must be
+// initiated by the subscriber chain, so it is rejected here.
+func (am AppModule) OnChanOpenInit(
+	sdk.Context,
+	channeltypes.Order,
+	[]string,
+	string,
+	string,
+	*capabilitytypes.Capability,
+	channeltypes.Counterparty,
+	string,
+) (string, error) {
+	return "", errorsmod.Wrap(
+		commontypes.ErrInvalidChannelFlow,
+		"channel handshake must be initiated by the subscriber chain",
+	)
+}
+
+// OnChanOpenTry implements the IBCModule interface. It validates the channel parameters,
+// claims the channel capability and verifies that the counterparty is a subscriber chain
+// registered by this module. The returned version is the marshaled handshake metadata.
+func (am AppModule) OnChanOpenTry(
+	ctx sdk.Context,
+	order channeltypes.Order,
+	connectionHops []string,
+	portID,
+	channelID string,
+	chanCap *capabilitytypes.Capability,
+	counterparty channeltypes.Counterparty,
+	counterpartyVersion string,
+) (string, error) {
+	if err := validateChannelParams(ctx, am.keeper.GetPort(ctx), order, portID); err != nil {
+		return "", err
+	}
+	if counterparty.PortId != commontypes.SubscriberPortID {
+		return "", errorsmod.Wrapf(
+			porttypes.ErrInvalidPort,
+			"invalid counterparty port: %s, expected %s",
+			counterparty.PortId, commontypes.SubscriberPortID,
+		)
+	}
+	if counterpartyVersion != commontypes.Version {
+		return "", errorsmod.Wrapf(
+			commontypes.ErrInvalidVersion,
+			"invalid counterparty version: got: %s, expected %s",
+			counterpartyVersion, commontypes.Version,
+		)
+	}
+	if _, err := am.keeper.VerifySubscriberChain(ctx, connectionHops); err != nil {
+		return "", err
+	}
+	if err := am.keeper.ClaimCapability(
+		ctx, chanCap, host.ChannelCapabilityPath(portID, channelID),
+	); err != nil {
+		return "", err
+	}
+	md := commontypes.HandshakeMetadata{
+		// the subscriber chain sends its rewards to this address
+		CoordinatorFeePoolAddr: authtypes.NewModuleAddress(types.SubscriberRewardsPool).String(),
+		Version:                commontypes.Version,
+	}
+	mdBz, err := (&md).Marshal()
+	if err != nil {
+		return "", errorsmod.Wrapf(
+			commontypes.ErrInvalidHandshakeMetadata,
+			"error marshalling handshake metadata: %v", err,
+		)
+	}
+	return string(mdBz), nil
+}
+
+// OnChanOpenAck implements the IBCModule interface. It is only called on the channel end
+// which initiated the handshake, that is, the subscriber.
+func (am AppModule) OnChanOpenAck(
+	sdk.Context,
+	string,
+	string,
+	string,
+	string,
+) error {
+	return errorsmod.Wrap(
+		commontypes.ErrInvalidChannelFlow,
+		"channel handshake must be initiated by the subscriber chain",
+	)
+}
+
+// OnChanOpenConfirm implements the IBCModule interface. It records the channel as the one
+// to the subscriber chain.
+func (am AppModule) OnChanOpenConfirm(
+	ctx sdk.Context,
+	_ string,
+	channelID string,
+) error {
+	return am.keeper.SetSubscriberChain(ctx, channelID)
+}
+
+// OnChanCloseInit implements the IBCModule interface. Users are not allowed to close the
+// channel to a subscriber chain.
+func (am AppModule) OnChanCloseInit(
+	sdk.Context,
+	string,
+	string,
+) error {
+	return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "user cannot close channel")
+}
+
+// OnChanCloseConfirm implements the IBCModule interface.
+func (am AppModule) OnChanCloseConfirm(
+	sdk.Context,
+	string,
+	string,
+) error {
+	return nil
+}
+
+// OnRecvPacket implements the IBCModule interface. It handles the packets sent by the
+// subscriber chains.
+func (am AppModule) OnRecvPacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	_ sdk.AccAddress,
+) ibcexported.Acknowledgement {
+	var data commontypes.SubscriberPacketData
+	if err := (&data).Unmarshal(packet.GetData()); err != nil {
+		return channeltypes.NewErrorAcknowledgement(
+			errorsmod.Wrapf(
+				commontypes.ErrInvalidPacketData,
+				"cannot unmarshal subscriber packet data: %v", err,
+			),
+		)
+	}
+	if err := data.Validate(); err != nil {
+		return channeltypes.NewErrorAcknowledgement(err)
+	}
+	switch packetData := data.Data.(type) {
+	case *commontypes.SubscriberPacketData_VscMaturedPacketData:
+		return am.keeper.OnRecvVscMaturedPacket(ctx, packet, *packetData.VscMaturedPacketData)
+	default:
+		// unreachable, since the packet data has been validated.
+		return channeltypes.NewErrorAcknowledgement(
+			errorsmod.Wrapf(
+				commontypes.ErrInvalidPacketData,
+				"unknown packet data type: %T", packetData,
+			),
+		)
+	}
+}
+
+// OnAcknowledgementPacket implements the IBCModule interface. It handles the acknowledgement
+// of the validator set change packets sent to the subscriber chains.
+func (am AppModule) OnAcknowledgementPacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	acknowledgement []byte,
+	_ sdk.AccAddress,
+) error {
+	var ack channeltypes.Acknowledgement
+	if err := channeltypes.SubModuleCdc.UnmarshalJSON(acknowledgement, &ack); err != nil {
+		return errorsmod.Wrapf(
+			sdkerrors.ErrUnknownRequest,
+			"cannot unmarshal acknowledgement: %v", err,
+		)
+	}
+	return am.keeper.OnAcknowledgementPacket(ctx, packet, ack)
+}
+
+// OnTimeoutPacket implements the IBCModule interface. It handles the timeout of the
+// validator set change packets sent to the subscriber chains.
+func (am AppModule) OnTimeoutPacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	_ sdk.AccAddress,
+) error {
+	return am.keeper.OnTimeoutPacket(ctx, packet)
+}
+
+// validateChannelParams validates the parameters of the channel being opened.
+func validateChannelParams(
+	_ sdk.Context,
+	boundPort string,
+	order channeltypes.Order,
+	portID string,
+) error {
+	// only ordered channels are allowed, so that the validator set changes are applied in
+	// the order they are sent.
+	if order != channeltypes.ORDERED {
+		return errorsmod.Wrapf(
+			channeltypes.ErrInvalidChannelOrdering,
+			"expected %s channel, got %s ", channeltypes.ORDERED, order,
+		)
+	}
+	if portID != boundPort {
+		return errorsmod.Wrapf(
+			porttypes.ErrInvalidPort, "invalid port: %s, expected %s", portID, boundPort,
+		)
+	}
+	return nil
+}
@@ -0,0 +1,219 @@
+package dogfood_test
+
+import (
+	"testing"
+
+	keepertest "github.com/ExocoreNetwork/exocore/testutil/keeper"
+	commontypes "github.com/ExocoreNetwork/exocore/x/appchain/common/types"
+	coordinator "github.com/ExocoreNetwork/exocore/x/appchain/coordinator"
+	"github.com/ExocoreNetwork/exocore/x/appchain/coordinator/keeper"
+	"github.com/ExocoreNetwork/exocore/x/appchain/coordinator/types"
+	epochstypes "github.com/ExocoreNetwork/exocore/x/epochs/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
+	conntypes "github.com/cosmos/ibc-go/v7/modules/core/03-connection/types"
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/v7/modules/core/05-port/types"
+	host "github.com/cosmos/ibc-go/v7/modules/core/24-host"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testChainID      = "appchain-1"
+	testConnectionID = "connection-0"
+	testChannelID    = "channel-0"
+)
+
+var testInitTimeout = epochstypes.NewEpoch(10, "hour")
+
+// setupPendingChain returns the module along with a registered subscriber chain whose channel
+// handshake has not been started yet.
+func setupPendingChain(t *testing.T) (coordinator.AppModule, keeper.Keeper, sdk.Context, *keepertest.AppchainMocks) {
+	k, ctx, mocks := keepertest.CoordinatorKeeper(t)
+	clientID := mocks.IBC.AddConnection(testConnectionID, testChainID)
+	k.SetClientForChain(ctx, testChainID, clientID)
+	k.SetSubscriberGenesis(ctx, testChainID, &commontypes.SubscriberGenesisState{
+		Params: commontypes.DefaultSubscriberParams(),
+	})
+	k.AppendChainToInitTimeout(ctx, testInitTimeout, testChainID)
+	k.SetChainInitTimeout(ctx, testChainID, testInitTimeout)
+	am := coordinator.NewAppModule(codec.NewProtoCodec(codectypes.NewInterfaceRegistry()), k)
+	return am, k, ctx, mocks
+}
+
+func subscriberCounterparty() channeltypes.Counterparty {
+	return channeltypes.NewCounterparty(commontypes.SubscriberPortID, "channel-0")
+}
+
+func TestOnChanOpenTry(t *testing.T) {
+	cases := []struct {
+		name           string
+		order          channeltypes.Order
+		connectionHops []string
+		portID         string
+		counterparty   channeltypes.Counterparty
+		version        string
+		malleate       func(k keeper.Keeper, ctx sdk.Context, mocks *keepertest.AppchainMocks)
+		expError       error
+	}{
+		{
+			name: "valid",
+		},
+		{
+			name:     "unordered channel",
+			order:    channeltypes.UNORDERED,
+			expError: channeltypes.ErrInvalidChannelOrdering,
+		},
+		{
+			name:     "wrong port",
+			portID:   "transfer",
+			expError: porttypes.ErrInvalidPort,
+		},
+		{
+			name:         "wrong counterparty port",
+			counterparty: channeltypes.NewCounterparty("transfer", "channel-0"),
+			expError:     porttypes.ErrInvalidPort,
+		},
+		{
+			name:     "wrong version",
+			version:  "2",
+			expError: commontypes.ErrInvalidVersion,
+		},
+		{
+			name:           "multiple connection hops",
+			connectionHops: []string{testConnectionID, "connection-1"},
+			expError:       channeltypes.ErrTooManyConnectionHops,
+		},
+		{
+			name:           "unknown connection",
+			connectionHops: []string{"connection-9"},
+			expError:       conntypes.ErrConnectionNotFound,
+		},
+		{
+			name:           "client mismatch",
+			connectionHops: []string{"connection-1"},
+			malleate: func(_ keeper.Keeper, _ sdk.Context, mocks *keepertest.AppchainMocks) {
+				// another client of the same chain, which was not created by the module.
+				mocks.IBC.AddConnection("connection-1", testChainID)
+			},
+			expError: commontypes.ErrClientNotFound,
+		},
+		{
+			name:           "unregistered chain",
+			connectionHops: []string{"connection-1"},
+			malleate: func(_ keeper.Keeper, _ sdk.Context, mocks *keepertest.AppchainMocks) {
+				mocks.IBC.AddConnection("connection-1", "unknown-1")
+			},
+			expError: commontypes.ErrClientNotFound,
+		},
+		{
+			name: "no subscriber genesis",
+			malleate: func(k keeper.Keeper, ctx sdk.Context, _ *keepertest.AppchainMocks) {
+				k.DeleteSubscriberGenesis(ctx, testChainID)
+			},
+			expError: types.ErrNoSubscriberGenesis,
+		},
+		{
+			name: "duplicate channel",
+			malleate: func(k keeper.Keeper, ctx sdk.Context, _ *keepertest.AppchainMocks) {
+				k.SetChannelForChain(ctx, testChainID, "channel-1")
+			},
+			expError: commontypes.ErrDuplicateChannel,
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			am, k, ctx, mocks := setupPendingChain(t)
+			if tc.malleate != nil {
+				tc.malleate(k, ctx, mocks)
+			}
+			order := channeltypes.ORDERED
+			if tc.order != channeltypes.NONE {
+				order = tc.order
+			}
+			connectionHops := []string{testConnectionID}
+			if tc.connectionHops != nil {
+				connectionHops = tc.connectionHops
+			}
+			portID := types.PortID
+			if tc.portID != "" {
+				portID = tc.portID
+			}
+			counterparty := subscriberCounterparty()
+			if tc.counterparty.PortId != "" {
+				counterparty = tc.counterparty
+			}
+			version := commontypes.Version
+			if tc.version != "" {
+				version = tc.version
+			}
+			chanCap := capabilitytypes.NewCapability(100)
+			mdString, err := am.OnChanOpenTry(
+				ctx, order, connectionHops, portID, testChannelID, chanCap, counterparty, version,
+			)
+			if tc.expError != nil {
+				require.ErrorIs(t, err, tc.expError)
+				_, found := mocks.IBC.GetCapability(ctx, host.ChannelCapabilityPath(portID, testChannelID))
+				require.False(t, found)
+				return
+			}
+			require.NoError(t, err)
+			var md commontypes.HandshakeMetadata
+			require.NoError(t, (&md).Unmarshal([]byte(mdString)))
+			require.Equal(t, commontypes.Version, md.Version)
+			require.NotEmpty(t, md.CoordinatorFeePoolAddr)
+			_, found := mocks.IBC.GetCapability(ctx, host.ChannelCapabilityPath(portID, testChannelID))
+			require.True(t, found)
+			// the indices are set only on confirm.
+			_, found = k.GetChannelForChain(ctx, testChainID)
+			require.False(t, found)
+		})
+	}
+}
+
+func TestOnChanOpenConfirm(t *testing.T) {
+	am, k, ctx, mocks := setupPendingChain(t)
+	_, err := am.OnChanOpenTry(
+		ctx, channeltypes.ORDERED, []string{testConnectionID}, types.PortID, testChannelID,
+		capabilitytypes.NewCapability(100), subscriberCounterparty(), commontypes.Version,
+	)
+	require.NoError(t, err)
+
+	// the channel must exist.
+	require.ErrorIs(
+		t, am.OnChanOpenConfirm(ctx, types.PortID, testChannelID), channeltypes.ErrChannelNotFound,
+	)
+	mocks.IBC.OpenChannel(types.PortID, testChannelID, testConnectionID, subscriberCounterparty())
+	require.NoError(t, am.OnChanOpenConfirm(ctx, types.PortID, testChannelID))
+
+	channelID, found := k.GetChannelForChain(ctx, testChainID)
+	require.True(t, found)
+	require.Equal(t, testChannelID, channelID)
+	chainID, found := k.GetChainForChannel(ctx, testChannelID)
+	require.True(t, found)
+	require.Equal(t, testChainID, chainID)
+	_, found = k.GetSubscriberGenesis(ctx, testChainID)
+	require.False(t, found)
+	_, found = k.GetChainInitTimeout(ctx, testChainID)
+	require.False(t, found)
+	require.Empty(t, k.GetChainsToInitTimeout(ctx, testInitTimeout).List)
+
+	// a second channel to the same chain is rejected.
+	mocks.IBC.OpenChannel(types.PortID, "channel-1", testConnectionID, subscriberCounterparty())
+	require.Error(t, am.OnChanOpenConfirm(ctx, types.PortID, "channel-1"))
+	_, found = k.GetChainForChannel(ctx, "channel-1")
+	require.False(t, found)
+}
+
+func TestHandshakeInitiatedByCoordinatorRejected(t *testing.T) {
+	am, _, ctx, _ := setupPendingChain(t)
+	_, err := am.OnChanOpenInit(
+		ctx, channeltypes.ORDERED, []string{testConnectionID}, types.PortID, testChannelID,
+		capabilitytypes.NewCapability(100), subscriberCounterparty(), commontypes.Version,
+	)
+	require.ErrorIs(t, err, commontypes.ErrInvalidChannelFlow)
+	err = am.OnChanOpenAck(ctx, types.PortID, testChannelID, "channel-0", commontypes.Version)
+	require.ErrorIs(t, err, commontypes.ErrInvalidChannelFlow)
+}
@@ -116,9 +116,105 @@ func (m *ChainIDs) GetList() []string {
 	return nil
 }
 
+// UndelegationRecordKeys is a collection of undelegation record keys. It is used to store
+// the undelegations which are held until a validator set change matures on a subscriber.
+type UndelegationRecordKeys struct {
+	// list is the list of undelegation record keys.
+	List [][]byte `protobuf:"bytes,1,rep,name=list,proto3" json:"list,omitempty"`
+}
+
+func (m *UndelegationRecordKeys) Reset()         { *m = UndelegationRecordKeys{} }
+func (m *UndelegationRecordKeys) String() string { return proto.CompactTextString(m) }
+func (*UndelegationRecordKeys) ProtoMessage()    {}
+func (*UndelegationRecordKeys) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fb7bb04617dc0e61, []int{2}
+}
+func (m *UndelegationRecordKeys) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UndelegationRecordKeys) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UndelegationRecordKeys.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *UndelegationRecordKeys) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UndelegationRecordKeys.Merge(m, src)
+}
+func (m *UndelegationRecordKeys) XXX_Size() int {
+	return m.Size()
+}
+func (m *UndelegationRecordKeys) XXX_DiscardUnknown() {
+	xxx_messageInfo_UndelegationRecordKeys.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UndelegationRecordKeys proto.InternalMessageInfo
+
+func (m *UndelegationRecordKeys) GetList() [][]byte {
+	if m != nil {
+		return m.List
+	}
+	return nil
+}
+
+// AccountAddresses represents a list of account addresses. It is used to store the operators
+// whose key removal is held until a validator set change matures on a subscriber.
+type AccountAddresses struct {
+	// list is the list of account addresses.
+	List [][]byte `protobuf:"bytes,1,rep,name=list,proto3" json:"list,omitempty"`
+}
+
+func (m *AccountAddresses) Reset()         { *m = AccountAddresses{} }
+func (m *AccountAddresses) String() string { return proto.CompactTextString(m) }
+func (*AccountAddresses) ProtoMessage()    {}
+func (*AccountAddresses) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fb7bb04617dc0e61, []int{3}
+}
+func (m *AccountAddresses) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AccountAddresses) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AccountAddresses.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AccountAddresses) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AccountAddresses.Merge(m, src)
+}
+func (m *AccountAddresses) XXX_Size() int {
+	return m.Size()
+}
+func (m *AccountAddresses) XXX_DiscardUnknown() {
+	xxx_messageInfo_AccountAddresses.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AccountAddresses proto.InternalMessageInfo
+
+func (m *AccountAddresses) GetList() [][]byte {
+	if m != nil {
+		return m.List
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*PendingSubscriberChainRequests)(nil), "exocore.appchain.coordinator.v1.PendingSubscriberChainRequests")
 	proto.RegisterType((*ChainIDs)(nil), "exocore.appchain.coordinator.v1.ChainIDs")
+	proto.RegisterType((*UndelegationRecordKeys)(nil), "exocore.appchain.coordinator.v1.UndelegationRecordKeys")
+	proto.RegisterType((*AccountAddresses)(nil), "exocore.appchain.coordinator.v1.AccountAddresses")
 }
 
 func init() {
@@ -126,23 +222,26 @@ func init() {
 }
 
 var fileDescriptor_fb7bb04617dc0e61 = []byte{
-	// 254 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x32, 0x4c, 0xad, 0xc8, 0x4f,
-	0xce, 0x2f, 0x4a, 0xd5, 0x4f, 0x2c, 0x28, 0x48, 0xce, 0x48, 0xcc, 0xcc, 0xd3, 0x4f, 0xce, 0xcf,
-	0x2f, 0x4a, 0xc9, 0xcc, 0x4b, 0x2c, 0xc9, 0x2f, 0xd2, 0x2f, 0x33, 0x44, 0xe6, 0xea, 0x15, 0x14,
-	0xe5, 0x97, 0xe4, 0x0b, 0xc9, 0x43, 0xb5, 0xe8, 0xc1, 0xb4, 0xe8, 0x21, 0xab, 0x29, 0x33, 0x94,
-	0xd2, 0x20, 0x64, 0x66, 0x49, 0x05, 0xc4, 0x28, 0x29, 0x91, 0xf4, 0xfc, 0xf4, 0x7c, 0x30, 0x53,
-	0x1f, 0xc4, 0x82, 0x88, 0x2a, 0x55, 0x73, 0xc9, 0x05, 0xa4, 0xe6, 0xa5, 0x64, 0xe6, 0xa5, 0x07,
-	0x97, 0x26, 0x15, 0x27, 0x17, 0x65, 0x26, 0xa5, 0x16, 0x39, 0x83, 0xcc, 0x09, 0x4a, 0x2d, 0x2c,
-	0x4d, 0x2d, 0x2e, 0x29, 0x16, 0x8a, 0xe4, 0x62, 0xc9, 0xc9, 0x2c, 0x2e, 0x91, 0x60, 0x54, 0x60,
-	0xd6, 0xe0, 0x36, 0xb2, 0xd7, 0x23, 0xe0, 0x22, 0xbd, 0xa0, 0xd4, 0xf4, 0xcc, 0xe2, 0x92, 0xd4,
-	0x22, 0xec, 0xe6, 0x39, 0xb1, 0x9c, 0xb8, 0x27, 0xcf, 0x10, 0x04, 0x36, 0x52, 0x49, 0x8e, 0x8b,
-	0x03, 0x2c, 0xe7, 0xe9, 0x52, 0x2c, 0x24, 0x84, 0x64, 0x0d, 0x27, 0x44, 0xde, 0x29, 0xe2, 0xc4,
-	0x23, 0x39, 0xc6, 0x0b, 0x8f, 0xe4, 0x18, 0x1f, 0x3c, 0x92, 0x63, 0x9c, 0xf0, 0x58, 0x8e, 0xe1,
-	0xc2, 0x63, 0x39, 0x86, 0x1b, 0x8f, 0xe5, 0x18, 0xa2, 0xec, 0xd2, 0x33, 0x4b, 0x32, 0x4a, 0x93,
-	0xf4, 0x92, 0xf3, 0x73, 0xf5, 0x5d, 0x21, 0x0e, 0xf2, 0x4b, 0x2d, 0x29, 0xcf, 0x2f, 0xca, 0xd6,
-	0x87, 0x05, 0x48, 0x05, 0xf6, 0x20, 0x29, 0xa9, 0x2c, 0x48, 0x2d, 0x4e, 0x62, 0x03, 0xfb, 0xde,
-	0x18, 0x10, 0x00, 0x00, 0xff, 0xff, 0x4d, 0xb2, 0xe2, 0x02, 0x93, 0x01, 0x00, 0x00,
+	// 299 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x90, 0xc1, 0x4a, 0x03, 0x31,
+	0x10, 0x86, 0x77, 0xb1, 0x88, 0x46, 0x0f, 0xb2, 0x88, 0x48, 0x0f, 0xa9, 0xf4, 0x20, 0x3d, 0x48,
+	0x42, 0xf5, 0xae, 0xb4, 0xea, 0x41, 0x04, 0x91, 0x15, 0x41, 0xbd, 0xed, 0x26, 0x43, 0x1a, 0xac,
+	0x99, 0x35, 0xc9, 0xd6, 0x16, 0x5f, 0xc2, 0xc7, 0xea, 0xb1, 0x47, 0x4f, 0x22, 0xed, 0x8b, 0x48,
+	0xb7, 0x2d, 0xac, 0xb0, 0xd0, 0xdb, 0x24, 0xf3, 0xcd, 0x97, 0xcc, 0x4f, 0xda, 0x30, 0x44, 0x81,
+	0x16, 0x78, 0x92, 0x65, 0xa2, 0x97, 0x68, 0xc3, 0x05, 0xa2, 0x95, 0xda, 0x24, 0x1e, 0x2d, 0x1f,
+	0xb4, 0xcb, 0x47, 0x96, 0x59, 0xf4, 0x18, 0x35, 0x96, 0x23, 0x6c, 0x35, 0xc2, 0xca, 0xcc, 0xa0,
+	0x5d, 0x6f, 0xad, 0x73, 0xfa, 0xe1, 0x42, 0x55, 0xdf, 0x57, 0xa8, 0xb0, 0x28, 0xf9, 0xbc, 0x5a,
+	0xdc, 0x36, 0x3f, 0x09, 0xbd, 0x07, 0x23, 0xb5, 0x51, 0x0f, 0x79, 0xea, 0x84, 0xd5, 0x29, 0xd8,
+	0xcb, 0xb9, 0x27, 0x86, 0xf7, 0x1c, 0x9c, 0x77, 0xd1, 0x33, 0xa9, 0xf5, 0xb5, 0xf3, 0x87, 0xe1,
+	0xd1, 0x46, 0x6b, 0xe7, 0xf4, 0x82, 0xad, 0xf9, 0x11, 0x8b, 0x41, 0x69, 0xe7, 0xc1, 0x56, 0xfb,
+	0xba, 0xb5, 0xf1, 0x4f, 0x23, 0x88, 0x0b, 0x65, 0x93, 0x92, 0xad, 0xa2, 0x77, 0x73, 0xe5, 0xa2,
+	0xa8, 0xf4, 0xcc, 0xf6, 0xb2, 0x7f, 0x42, 0x0e, 0x1e, 0x8d, 0x84, 0x3e, 0xa8, 0xc4, 0x6b, 0x34,
+	0x31, 0x08, 0xb4, 0xf2, 0x16, 0x46, 0xff, 0xe9, 0xdd, 0x25, 0x7d, 0x4c, 0xf6, 0x3a, 0x42, 0x60,
+	0x6e, 0x7c, 0x47, 0x4a, 0x0b, 0xce, 0x41, 0x25, 0xd7, 0x7d, 0x1a, 0x4f, 0x69, 0x38, 0x99, 0xd2,
+	0xf0, 0x77, 0x4a, 0xc3, 0xaf, 0x19, 0x0d, 0x26, 0x33, 0x1a, 0x7c, 0xcf, 0x68, 0xf0, 0x72, 0xae,
+	0xb4, 0xef, 0xe5, 0x29, 0x13, 0xf8, 0xc6, 0xaf, 0x17, 0x6b, 0xde, 0x81, 0xff, 0x40, 0xfb, 0xca,
+	0x57, 0x31, 0x0f, 0xab, 0x83, 0xf6, 0xa3, 0x0c, 0x5c, 0xba, 0x59, 0x64, 0x7a, 0xf6, 0x17, 0x00,
+	0x00, 0xff, 0xff, 0x00, 0x35, 0x71, 0x52, 0xe9, 0x01, 0x00, 0x00,
 }
 
 func (m *PendingSubscriberChainRequests) Marshal() (dAtA []byte, err error) {
@@ -214,6 +313,70 @@ func (m *ChainIDs) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *UndelegationRecordKeys) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UndelegationRecordKeys) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UndelegationRecordKeys) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.List) > 0 {
+		for iNdEx := len(m.List) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.List[iNdEx])
+			copy(dAtA[i:], m.List[iNdEx])
+			i = encodeVarintCoordinator(dAtA, i, uint64(len(m.List[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AccountAddresses) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AccountAddresses) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AccountAddresses) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.List) > 0 {
+		for iNdEx := len(m.List) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.List[iNdEx])
+			copy(dAtA[i:], m.List[iNdEx])
+			i = encodeVarintCoordinator(dAtA, i, uint64(len(m.List[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintCoordinator(dAtA []byte, offset int, v uint64) int {
 	offset -= sovCoordinator(v)
 	base := offset
@@ -255,6 +418,36 @@ func (m *ChainIDs) Size() (n int) {
 	return n
 }
 
+func (m *UndelegationRecordKeys) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.List) > 0 {
+		for _, b := range m.List {
+			l = len(b)
+			n += 1 + l + sovCoordinator(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *AccountAddresses) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.List) > 0 {
+		for _, b := range m.List {
+			l = len(b)
+			n += 1 + l + sovCoordinator(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovCoordinator(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -427,6 +620,170 @@ func (m *ChainIDs) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *UndelegationRecordKeys) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCoordinator
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UndelegationRecordKeys: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UndelegationRecordKeys: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field List", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCoordinator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCoordinator
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCoordinator
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.List = append(m.List, make([]byte, postIndex-iNdEx))
+			copy(m.List[len(m.List)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCoordinator(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCoordinator
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AccountAddresses) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCoordinator
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AccountAddresses: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AccountAddresses: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field List", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCoordinator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCoordinator
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCoordinator
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.List = append(m.List, make([]byte, postIndex-iNdEx))
+			copy(m.List[len(m.List)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCoordinator(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCoordinator
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipCoordinator(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -9,6 +9,7 @@ const (
 	errCodeNilRequest
 	errCodeDuplicateSubChain
 	errCodeNoOperators
+	errCodeNoSubscriberGenesis
 )
 
 var (
@@ -20,4 +21,6 @@ var (
 	ErrDuplicateSubChain = errorsmod.Register(ModuleName, errCodeDuplicateSubChain, "subscriber chain already exists")
 	// ErrNoOperators is the error returned when no qualified operators are available
 	ErrNoOperators = errorsmod.Register(ModuleName, errCodeNoOperators, "no operators available")
+	// ErrNoSubscriberGenesis is the error returned when the genesis state of a subscriber is not found
+	ErrNoSubscriberGenesis = errorsmod.Register(ModuleName, errCodeNoSubscriberGenesis, "subscriber genesis not found")
 )
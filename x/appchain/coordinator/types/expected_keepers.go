@@ -31,4 +31,13 @@ type StakingKeeper interface {
 type OperatorKeeper interface {
 	GetActiveOperatorsForChainID(sdk.Context, string) ([]sdk.AccAddress, []keytypes.WrappedConsKey)
 	GetVotePowerForChainID(sdk.Context, []sdk.AccAddress, string) ([]int64, error)
+	GetOperatorConsKeyForChainID(sdk.Context, sdk.AccAddress, string) (bool, keytypes.WrappedConsKey, error)
+	GetOperatorPrevConsKeyForChainID(sdk.Context, sdk.AccAddress, string) (bool, keytypes.WrappedConsKey, error)
+	CompleteOperatorKeyRemovalForChainID(sdk.Context, sdk.AccAddress, string) error
+}
+
+// DelegationKeeper represents the expected keeper interface for the delegation module.
+type DelegationKeeper interface {
+	IncrementUndelegationHoldCount(sdk.Context, []byte) error
+	DecrementUndelegationHoldCount(sdk.Context, []byte) error
 }
@@ -1,14 +1,30 @@
 package types
 
+import (
+	errorsmod "cosmossdk.io/errors"
+	keytypes "github.com/ExocoreNetwork/exocore/types/keys"
+	commontypes "github.com/ExocoreNetwork/exocore/x/appchain/common/types"
+	epochstypes "github.com/ExocoreNetwork/exocore/x/epochs/types"
+	"github.com/cometbft/cometbft/crypto/ed25519"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	host "github.com/cosmos/ibc-go/v7/modules/core/24-host"
+)
+
 // DefaultGenesis returns the default genesis state.
 func DefaultGenesis() *GenesisState {
-	return NewGenesis(DefaultParams())
+	return NewGenesis(DefaultParams(), nil, nil)
 }
 
 // NewGenesis creates a new genesis state with the provided parameters and
 // data.
-func NewGenesis(params Params) *GenesisState {
-	return &GenesisState{Params: params}
+func NewGenesis(
+	params Params, chainChannels []ChainChannel, subscriberChains []SubscriberChain,
+) *GenesisState {
+	return &GenesisState{
+		Params:           params,
+		ChainChannels:    chainChannels,
+		SubscriberChains: subscriberChains,
+	}
 }
 
 // Validate performs basic genesis state validation returning an error upon any
@@ -17,6 +33,152 @@ func (gs GenesisState) Validate() error {
 	if err := gs.Params.Validate(); err != nil {
 		return err
 	}
-	// TODO: validate anything else added here
+	chainIDs := make(map[string]struct{}, len(gs.ChainChannels))
+	channelIDs := make(map[string]struct{}, len(gs.ChainChannels))
+	for _, chainChannel := range gs.ChainChannels {
+		if chainChannel.ChainID == "" {
+			return errorsmod.Wrap(commontypes.ErrInvalidGenesis, "chain ID cannot be empty")
+		}
+		if err := host.ChannelIdentifierValidator(chainChannel.ChannelID); err != nil {
+			return errorsmod.Wrapf(
+				commontypes.ErrInvalidGenesis,
+				"invalid channel ID for chain %s: %s", chainChannel.ChainID, err,
+			)
+		}
+		if _, found := chainIDs[chainChannel.ChainID]; found {
+			return errorsmod.Wrapf(
+				commontypes.ErrInvalidGenesis, "duplicate chain ID %s", chainChannel.ChainID,
+			)
+		}
+		if _, found := channelIDs[chainChannel.ChannelID]; found {
+			return errorsmod.Wrapf(
+				commontypes.ErrInvalidGenesis, "duplicate channel ID %s", chainChannel.ChannelID,
+			)
+		}
+		chainIDs[chainChannel.ChainID] = struct{}{}
+		channelIDs[chainChannel.ChannelID] = struct{}{}
+	}
+	return validateSubscriberChains(gs.SubscriberChains)
+}
+
+// validateSubscriberChains validates the state of the subscriber chains.
+func validateSubscriberChains(subscriberChains []SubscriberChain) error {
+	chainIDs := make(map[string]struct{}, len(subscriberChains))
+	clientIDs := make(map[string]struct{}, len(subscriberChains))
+	for _, chain := range subscriberChains {
+		if chain.ChainID == "" {
+			return errorsmod.Wrap(commontypes.ErrInvalidGenesis, "chain ID cannot be empty")
+		}
+		if _, found := chainIDs[chain.ChainID]; found {
+			return errorsmod.Wrapf(
+				commontypes.ErrInvalidGenesis, "duplicate subscriber chain %s", chain.ChainID,
+			)
+		}
+		chainIDs[chain.ChainID] = struct{}{}
+		if err := host.ClientIdentifierValidator(chain.ClientID); err != nil {
+			return errorsmod.Wrapf(
+				commontypes.ErrInvalidGenesis,
+				"invalid client ID for chain %s: %s", chain.ChainID, err,
+			)
+		}
+		if _, found := clientIDs[chain.ClientID]; found {
+			return errorsmod.Wrapf(
+				commontypes.ErrInvalidGenesis, "duplicate client ID %s", chain.ClientID,
+			)
+		}
+		clientIDs[chain.ClientID] = struct{}{}
+		if chain.EpochIdentifier != "" {
+			if err := epochstypes.ValidateEpochIdentifierString(chain.EpochIdentifier); err != nil {
+				return errorsmod.Wrapf(
+					commontypes.ErrInvalidGenesis,
+					"invalid epoch identifier for chain %s: %s", chain.ChainID, err,
+				)
+			}
+		}
+		if chain.MaxValidators == 0 {
+			return errorsmod.Wrapf(
+				commontypes.ErrInvalidGenesis,
+				"max validators for chain %s cannot be 0", chain.ChainID,
+			)
+		}
+		if len(chain.Validators) > int(chain.MaxValidators) {
+			return errorsmod.Wrapf(
+				commontypes.ErrInvalidGenesis,
+				"too many validators for chain %s: %d > %d",
+				chain.ChainID, len(chain.Validators), chain.MaxValidators,
+			)
+		}
+		consAddrs := make(map[string]struct{}, len(chain.Validators))
+		for _, validator := range chain.Validators {
+			wrappedKey := keytypes.NewWrappedConsKeyFromTmProtoKey(&validator.PubKey)
+			if wrappedKey == nil || len(validator.PubKey.GetEd25519()) != ed25519.PubKeySize {
+				return errorsmod.Wrapf(
+					commontypes.ErrInvalidGenesis,
+					"invalid validator key for chain %s", chain.ChainID,
+				)
+			}
+			if validator.Power <= 0 {
+				return errorsmod.Wrapf(
+					commontypes.ErrInvalidGenesis,
+					"non-positive validator power for chain %s: %d", chain.ChainID, validator.Power,
+				)
+			}
+			consAddr := wrappedKey.ToConsAddr().String()
+			if _, found := consAddrs[consAddr]; found {
+				return errorsmod.Wrapf(
+					commontypes.ErrInvalidGenesis,
+					"duplicate validator %s for chain %s", consAddr, chain.ChainID,
+				)
+			}
+			consAddrs[consAddr] = struct{}{}
+		}
+		if err := validateHolds(chain); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// validateHolds validates the operations held for the chain. They can only wait for the
+// validator set changes already sent to the chain, or the next one.
+func validateHolds(chain SubscriberChain) error {
+	vscIDs := make(map[uint64]struct{}, len(chain.Holds))
+	for _, holds := range chain.Holds {
+		if holds.VscID == 0 || holds.VscID > chain.VscID+1 {
+			return errorsmod.Wrapf(
+				commontypes.ErrInvalidGenesis,
+				"invalid vscID %d of the holds for chain %s", holds.VscID, chain.ChainID,
+			)
+		}
+		if _, found := vscIDs[holds.VscID]; found {
+			return errorsmod.Wrapf(
+				commontypes.ErrInvalidGenesis,
+				"duplicate holds for vscID %d of chain %s", holds.VscID, chain.ChainID,
+			)
+		}
+		vscIDs[holds.VscID] = struct{}{}
+		if len(holds.UndelegationRecordKeys) == 0 && len(holds.Operators) == 0 {
+			return errorsmod.Wrapf(
+				commontypes.ErrInvalidGenesis,
+				"empty holds for vscID %d of chain %s", holds.VscID, chain.ChainID,
+			)
+		}
+		for _, recordKey := range holds.UndelegationRecordKeys {
+			if len(recordKey) == 0 {
+				return errorsmod.Wrapf(
+					commontypes.ErrInvalidGenesis,
+					"empty undelegation record key for chain %s", chain.ChainID,
+				)
+			}
+		}
+		for _, operator := range holds.Operators {
+			if _, err := sdk.AccAddressFromBech32(operator); err != nil {
+				return errorsmod.Wrapf(
+					commontypes.ErrInvalidGenesis,
+					"invalid operator %s for chain %s: %s", operator, chain.ChainID, err,
+				)
+			}
+		}
+	}
 	return nil
 }
@@ -5,6 +5,7 @@ package types
 
 import (
 	fmt "fmt"
+	types "github.com/cometbft/cometbft/abci/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	io "io"
@@ -27,6 +28,13 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 type GenesisState struct {
 	// Params is the parameters for the appchain coordinator module.
 	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	// chain_channels is the list of the subscriber chains with an established channel,
+	// along with the channel ID. It is used to restore the mappings between the chains
+	// and the channels.
+	ChainChannels []ChainChannel `protobuf:"bytes,2,rep,name=chain_channels,json=chainChannels,proto3" json:"chain_channels"`
+	// subscriber_chains is the list of the subscriber chains with a client, along with
+	// the state required to continue sending them the validator set updates.
+	SubscriberChains []SubscriberChain `protobuf:"bytes,3,rep,name=subscriber_chains,json=subscriberChains,proto3" json:"subscriber_chains"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -69,8 +77,248 @@ func (m *GenesisState) GetParams() Params {
 	return Params{}
 }
 
+func (m *GenesisState) GetChainChannels() []ChainChannel {
+	if m != nil {
+		return m.ChainChannels
+	}
+	return nil
+}
+
+func (m *GenesisState) GetSubscriberChains() []SubscriberChain {
+	if m != nil {
+		return m.SubscriberChains
+	}
+	return nil
+}
+
+// ChainChannel is the mapping between a subscriber chain and its channel.
+type ChainChannel struct {
+	// chain_id is the chain ID of the subscriber chain, with the revision.
+	ChainID string `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	// channel_id is the ID of the channel to the subscriber chain.
+	ChannelID string `protobuf:"bytes,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+}
+
+func (m *ChainChannel) Reset()         { *m = ChainChannel{} }
+func (m *ChainChannel) String() string { return proto.CompactTextString(m) }
+func (*ChainChannel) ProtoMessage()    {}
+func (*ChainChannel) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5e26c64c99ed3693, []int{1}
+}
+func (m *ChainChannel) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ChainChannel) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ChainChannel.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ChainChannel) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChainChannel.Merge(m, src)
+}
+func (m *ChainChannel) XXX_Size() int {
+	return m.Size()
+}
+func (m *ChainChannel) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChainChannel.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChainChannel proto.InternalMessageInfo
+
+func (m *ChainChannel) GetChainID() string {
+	if m != nil {
+		return m.ChainID
+	}
+	return ""
+}
+
+func (m *ChainChannel) GetChannelID() string {
+	if m != nil {
+		return m.ChannelID
+	}
+	return ""
+}
+
+// SubscriberChain is the state of a subscriber chain with a client.
+type SubscriberChain struct {
+	// chain_id is the chain ID of the subscriber chain, with the revision.
+	ChainID string `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	// client_id is the ID of the client of the subscriber chain.
+	ClientID string `protobuf:"bytes,2,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	// epoch_identifier is the identifier of the epoch at the end of which the chain receives
+	// the validator set updates. It is empty if the chain no longer receives them.
+	EpochIdentifier string `protobuf:"bytes,3,opt,name=epoch_identifier,json=epochIdentifier,proto3" json:"epoch_identifier,omitempty"`
+	// max_validators is the maximum number of validators of the chain.
+	MaxValidators uint32 `protobuf:"varint,4,opt,name=max_validators,json=maxValidators,proto3" json:"max_validators,omitempty"`
+	// vsc_id is the ID of the latest validator set change sent to the chain.
+	VscID uint64 `protobuf:"varint,5,opt,name=vsc_id,json=vscId,proto3" json:"vsc_id,omitempty"`
+	// validators is the validator set of the chain, as last sent to it.
+	Validators []types.ValidatorUpdate `protobuf:"bytes,6,rep,name=validators,proto3" json:"validators"`
+	// holds is the list of the operations held until the validator set changes of the
+	// chain mature.
+	Holds []VscHolds `protobuf:"bytes,7,rep,name=holds,proto3" json:"holds"`
+}
+
+func (m *SubscriberChain) Reset()         { *m = SubscriberChain{} }
+func (m *SubscriberChain) String() string { return proto.CompactTextString(m) }
+func (*SubscriberChain) ProtoMessage()    {}
+func (*SubscriberChain) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5e26c64c99ed3693, []int{2}
+}
+func (m *SubscriberChain) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SubscriberChain) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SubscriberChain.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SubscriberChain) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SubscriberChain.Merge(m, src)
+}
+func (m *SubscriberChain) XXX_Size() int {
+	return m.Size()
+}
+func (m *SubscriberChain) XXX_DiscardUnknown() {
+	xxx_messageInfo_SubscriberChain.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SubscriberChain proto.InternalMessageInfo
+
+func (m *SubscriberChain) GetChainID() string {
+	if m != nil {
+		return m.ChainID
+	}
+	return ""
+}
+
+func (m *SubscriberChain) GetClientID() string {
+	if m != nil {
+		return m.ClientID
+	}
+	return ""
+}
+
+func (m *SubscriberChain) GetEpochIdentifier() string {
+	if m != nil {
+		return m.EpochIdentifier
+	}
+	return ""
+}
+
+func (m *SubscriberChain) GetMaxValidators() uint32 {
+	if m != nil {
+		return m.MaxValidators
+	}
+	return 0
+}
+
+func (m *SubscriberChain) GetVscID() uint64 {
+	if m != nil {
+		return m.VscID
+	}
+	return 0
+}
+
+func (m *SubscriberChain) GetValidators() []types.ValidatorUpdate {
+	if m != nil {
+		return m.Validators
+	}
+	return nil
+}
+
+func (m *SubscriberChain) GetHolds() []VscHolds {
+	if m != nil {
+		return m.Holds
+	}
+	return nil
+}
+
+// VscHolds is the list of the operations held until a validator set change of a
+// subscriber chain matures.
+type VscHolds struct {
+	// vsc_id is the ID of the validator set change.
+	VscID uint64 `protobuf:"varint,1,opt,name=vsc_id,json=vscId,proto3" json:"vsc_id,omitempty"`
+	// undelegation_record_keys is the list of the keys of the undelegations to release.
+	UndelegationRecordKeys [][]byte `protobuf:"bytes,2,rep,name=undelegation_record_keys,json=undelegationRecordKeys,proto3" json:"undelegation_record_keys,omitempty"`
+	// operators is the list of the bech32 addresses of the operators whose key removal
+	// is finished.
+	Operators []string `protobuf:"bytes,3,rep,name=operators,proto3" json:"operators,omitempty"`
+}
+
+func (m *VscHolds) Reset()         { *m = VscHolds{} }
+func (m *VscHolds) String() string { return proto.CompactTextString(m) }
+func (*VscHolds) ProtoMessage()    {}
+func (*VscHolds) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5e26c64c99ed3693, []int{3}
+}
+func (m *VscHolds) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VscHolds) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VscHolds.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *VscHolds) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VscHolds.Merge(m, src)
+}
+func (m *VscHolds) XXX_Size() int {
+	return m.Size()
+}
+func (m *VscHolds) XXX_DiscardUnknown() {
+	xxx_messageInfo_VscHolds.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VscHolds proto.InternalMessageInfo
+
+func (m *VscHolds) GetVscID() uint64 {
+	if m != nil {
+		return m.VscID
+	}
+	return 0
+}
+
+func (m *VscHolds) GetUndelegationRecordKeys() [][]byte {
+	if m != nil {
+		return m.UndelegationRecordKeys
+	}
+	return nil
+}
+
+func (m *VscHolds) GetOperators() []string {
+	if m != nil {
+		return m.Operators
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "exocore.appchain.coordinator.v1.GenesisState")
+	proto.RegisterType((*ChainChannel)(nil), "exocore.appchain.coordinator.v1.ChainChannel")
+	proto.RegisterType((*SubscriberChain)(nil), "exocore.appchain.coordinator.v1.SubscriberChain")
+	proto.RegisterType((*VscHolds)(nil), "exocore.appchain.coordinator.v1.VscHolds")
 }
 
 func init() {
@@ -78,21 +326,44 @@ func init() {
 }
 
 var fileDescriptor_5e26c64c99ed3693 = []byte{
-	// 219 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xd2, 0x4d, 0xad, 0xc8, 0x4f,
-	0xce, 0x2f, 0x4a, 0xd5, 0x4f, 0x2c, 0x28, 0x48, 0xce, 0x48, 0xcc, 0xcc, 0xd3, 0x4f, 0xce, 0xcf,
-	0x2f, 0x4a, 0xc9, 0xcc, 0x4b, 0x2c, 0xc9, 0x2f, 0xd2, 0x2f, 0x33, 0xd4, 0x4f, 0x4f, 0xcd, 0x4b,
-	0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x92, 0x87, 0x2a, 0xd7, 0x83, 0x29,
-	0xd7, 0x43, 0x52, 0xae, 0x57, 0x66, 0x28, 0xa5, 0x43, 0xc8, 0xbc, 0x82, 0xc4, 0xa2, 0xc4, 0x5c,
-	0xa8, 0x71, 0x52, 0x22, 0xe9, 0xf9, 0xe9, 0xf9, 0x60, 0xa6, 0x3e, 0x88, 0x05, 0x11, 0x55, 0x0a,
-	0xe5, 0xe2, 0x71, 0x87, 0xd8, 0x1a, 0x5c, 0x92, 0x58, 0x92, 0x2a, 0xe4, 0xca, 0xc5, 0x06, 0xd1,
-	0x25, 0xc1, 0xa8, 0xc0, 0xa8, 0xc1, 0x6d, 0xa4, 0xae, 0x47, 0xc0, 0x15, 0x7a, 0x01, 0x60, 0xe5,
-	0x4e, 0x2c, 0x27, 0xee, 0xc9, 0x33, 0x04, 0x41, 0x35, 0x3b, 0x45, 0x9c, 0x78, 0x24, 0xc7, 0x78,
-	0xe1, 0x91, 0x1c, 0xe3, 0x83, 0x47, 0x72, 0x8c, 0x13, 0x1e, 0xcb, 0x31, 0x5c, 0x78, 0x2c, 0xc7,
-	0x70, 0xe3, 0xb1, 0x1c, 0x43, 0x94, 0x5d, 0x7a, 0x66, 0x49, 0x46, 0x69, 0x92, 0x5e, 0x72, 0x7e,
-	0xae, 0xbe, 0x2b, 0xc4, 0x68, 0xbf, 0xd4, 0x92, 0xf2, 0xfc, 0xa2, 0x6c, 0x7d, 0x98, 0x77, 0x2a,
-	0xb0, 0x7b, 0xa8, 0xa4, 0xb2, 0x20, 0xb5, 0x38, 0x89, 0x0d, 0xec, 0x6e, 0x63, 0x40, 0x00, 0x00,
-	0x00, 0xff, 0xff, 0x84, 0xc9, 0x8b, 0x50, 0x4d, 0x01, 0x00, 0x00,
+	// 590 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x54, 0x41, 0x6f, 0xd3, 0x30,
+	0x14, 0x6e, 0xba, 0xb5, 0x6b, 0xbc, 0x76, 0x1b, 0x11, 0x42, 0xd1, 0x40, 0x49, 0x54, 0x09, 0xe8,
+	0xa4, 0x2d, 0x61, 0xe3, 0xc2, 0x89, 0x43, 0xd7, 0x01, 0x11, 0x12, 0x42, 0x99, 0x98, 0xd0, 0x2e,
+	0x91, 0x6b, 0x9b, 0xd6, 0x5a, 0x1b, 0x47, 0xb6, 0x57, 0xba, 0x3b, 0x3f, 0x80, 0x13, 0xbf, 0x69,
+	0xc7, 0x1d, 0x39, 0x55, 0x28, 0x3d, 0xf0, 0x37, 0x50, 0x1c, 0xb7, 0x0d, 0x08, 0x54, 0x6e, 0xd6,
+	0xf7, 0xbe, 0xef, 0x7b, 0xef, 0xf9, 0x3d, 0x1b, 0x1c, 0x91, 0x29, 0x43, 0x8c, 0x93, 0x00, 0xa6,
+	0x29, 0x1a, 0x42, 0x9a, 0x04, 0x88, 0x31, 0x8e, 0x69, 0x02, 0x25, 0xe3, 0xc1, 0xe4, 0x38, 0x18,
+	0x90, 0x84, 0x08, 0x2a, 0xfc, 0x94, 0x33, 0xc9, 0x2c, 0x57, 0xd3, 0xfd, 0x05, 0xdd, 0x2f, 0xd1,
+	0xfd, 0xc9, 0xf1, 0xfe, 0xe1, 0x3a, 0xbf, 0x14, 0x72, 0x38, 0xd6, 0x76, 0xfb, 0xf7, 0x07, 0x6c,
+	0xc0, 0xd4, 0x31, 0xc8, 0x4f, 0x1a, 0x7d, 0x28, 0x49, 0x82, 0x09, 0x1f, 0xd3, 0x44, 0x06, 0xb0,
+	0x8f, 0x68, 0x20, 0x6f, 0x52, 0xa2, 0x25, 0xed, 0x6f, 0x55, 0xd0, 0x7c, 0x5d, 0xd4, 0x74, 0x2e,
+	0xa1, 0x24, 0xd6, 0x19, 0xa8, 0x17, 0x9e, 0xb6, 0xe1, 0x19, 0x9d, 0xed, 0x93, 0xa7, 0xfe, 0x9a,
+	0x1a, 0xfd, 0xf7, 0x8a, 0xde, 0xdd, 0xbc, 0x9d, 0xb9, 0x95, 0x48, 0x8b, 0xad, 0x4b, 0xb0, 0xa3,
+	0xc8, 0x31, 0x1a, 0xc2, 0x24, 0x21, 0x23, 0x61, 0x57, 0xbd, 0x8d, 0xce, 0xf6, 0xc9, 0xd1, 0x5a,
+	0xbb, 0xd3, 0x1c, 0x3c, 0x2d, 0x54, 0xda, 0xb4, 0x85, 0x4a, 0x98, 0xb0, 0x10, 0xb8, 0x27, 0xae,
+	0xfb, 0x02, 0x71, 0xda, 0x27, 0x3c, 0x56, 0x31, 0x61, 0x6f, 0x28, 0xfb, 0x67, 0x6b, 0xed, 0xcf,
+	0x97, 0x4a, 0x95, 0x48, 0x67, 0xd8, 0x13, 0xbf, 0xc3, 0xa2, 0x8d, 0x41, 0xb3, 0x5c, 0x89, 0xf5,
+	0x04, 0x34, 0x8a, 0x86, 0x28, 0x56, 0x37, 0x63, 0x76, 0xb7, 0xb3, 0x99, 0xbb, 0xa5, 0x38, 0x61,
+	0x2f, 0xda, 0x52, 0xc1, 0x10, 0x5b, 0x87, 0x00, 0xe8, 0x96, 0x73, 0x66, 0x55, 0x31, 0x5b, 0xd9,
+	0xcc, 0x35, 0xb5, 0x51, 0xd8, 0x8b, 0x4c, 0x4d, 0x08, 0x71, 0xfb, 0x67, 0x15, 0xec, 0xfe, 0x51,
+	0xd1, 0x7f, 0x67, 0x3a, 0x00, 0x26, 0x1a, 0x51, 0x92, 0xc8, 0x55, 0xa2, 0x66, 0x36, 0x73, 0x1b,
+	0xa7, 0x0a, 0x0c, 0x7b, 0x51, 0xa3, 0x08, 0x2b, 0xea, 0x1e, 0x49, 0x19, 0x1a, 0xc6, 0x14, 0x93,
+	0x44, 0xd2, 0x4f, 0x94, 0x70, 0x7b, 0x23, 0x57, 0x44, 0xbb, 0x0a, 0x0f, 0x97, 0xb0, 0xf5, 0x18,
+	0xec, 0x8c, 0xe1, 0x34, 0x9e, 0xc0, 0x11, 0xc5, 0xf9, 0x9d, 0x09, 0x7b, 0xd3, 0x33, 0x3a, 0xad,
+	0xa8, 0x35, 0x86, 0xd3, 0x8b, 0x25, 0x68, 0x79, 0xa0, 0x3e, 0x11, 0x28, 0xcf, 0x5c, 0xf3, 0x8c,
+	0xce, 0x66, 0xd7, 0xcc, 0x66, 0x6e, 0xed, 0x42, 0xa0, 0xb0, 0x17, 0xd5, 0x26, 0x02, 0x85, 0xd8,
+	0x7a, 0x05, 0x40, 0xc9, 0xa4, 0xae, 0xc6, 0xe3, 0xf9, 0xab, 0x5d, 0xf4, 0xf3, 0x5d, 0xf4, 0x97,
+	0x96, 0x1f, 0x52, 0x0c, 0x25, 0xd1, 0xe3, 0x28, 0x29, 0xad, 0x33, 0x50, 0x1b, 0xb2, 0x11, 0x16,
+	0xf6, 0x96, 0xb2, 0x38, 0x58, 0x3b, 0xe1, 0x0b, 0x81, 0xde, 0xe4, 0x02, 0xed, 0x55, 0xa8, 0xdb,
+	0x5f, 0x0c, 0xd0, 0x58, 0x44, 0x4a, 0xd5, 0x1b, 0xff, 0xa8, 0xfe, 0x05, 0xb0, 0xaf, 0x13, 0x4c,
+	0x46, 0x64, 0x00, 0x25, 0x65, 0x49, 0xcc, 0x09, 0x62, 0x1c, 0xc7, 0x57, 0xe4, 0xa6, 0xd8, 0xe4,
+	0x66, 0xf4, 0xa0, 0x1c, 0x8f, 0x54, 0xf8, 0x2d, 0xb9, 0x11, 0xd6, 0x23, 0x60, 0xb2, 0x94, 0xf0,
+	0xa2, 0xed, 0x7c, 0x2b, 0xcd, 0x68, 0x05, 0x74, 0x3f, 0xde, 0x66, 0x8e, 0x71, 0x97, 0x39, 0xc6,
+	0x8f, 0xcc, 0x31, 0xbe, 0xce, 0x9d, 0xca, 0xdd, 0xdc, 0xa9, 0x7c, 0x9f, 0x3b, 0x95, 0xcb, 0x97,
+	0x03, 0x2a, 0x87, 0xd7, 0x7d, 0x1f, 0xb1, 0x71, 0x70, 0x56, 0xb4, 0xf8, 0x8e, 0xc8, 0xcf, 0x8c,
+	0x5f, 0x05, 0x8b, 0x4f, 0x60, 0xfa, 0xf7, 0x6f, 0x40, 0xbd, 0xe7, 0x7e, 0x5d, 0x3d, 0xe8, 0xe7,
+	0xbf, 0x02, 0x00, 0x00, 0xff, 0xff, 0xe6, 0xd3, 0xfc, 0x4b, 0x83, 0x04, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -115,6 +386,34 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.SubscriberChains) > 0 {
+		for iNdEx := len(m.SubscriberChains) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SubscriberChains[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.ChainChannels) > 0 {
+		for iNdEx := len(m.ChainChannels) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ChainChannels[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
 	{
 		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -128,6 +427,171 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ChainChannel) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ChainChannel) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ChainChannel) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChannelID) > 0 {
+		i -= len(m.ChannelID)
+		copy(dAtA[i:], m.ChannelID)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ChannelID)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ChainID) > 0 {
+		i -= len(m.ChainID)
+		copy(dAtA[i:], m.ChainID)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ChainID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SubscriberChain) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SubscriberChain) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SubscriberChain) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Holds) > 0 {
+		for iNdEx := len(m.Holds) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Holds[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.Validators) > 0 {
+		for iNdEx := len(m.Validators) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Validators[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.VscID != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.VscID))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.MaxValidators != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.MaxValidators))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.EpochIdentifier) > 0 {
+		i -= len(m.EpochIdentifier)
+		copy(dAtA[i:], m.EpochIdentifier)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.EpochIdentifier)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ClientID) > 0 {
+		i -= len(m.ClientID)
+		copy(dAtA[i:], m.ClientID)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ClientID)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ChainID) > 0 {
+		i -= len(m.ChainID)
+		copy(dAtA[i:], m.ChainID)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ChainID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *VscHolds) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VscHolds) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VscHolds) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Operators) > 0 {
+		for iNdEx := len(m.Operators) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Operators[iNdEx])
+			copy(dAtA[i:], m.Operators[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.Operators[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.UndelegationRecordKeys) > 0 {
+		for iNdEx := len(m.UndelegationRecordKeys) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.UndelegationRecordKeys[iNdEx])
+			copy(dAtA[i:], m.UndelegationRecordKeys[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.UndelegationRecordKeys[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.VscID != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.VscID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
 	offset -= sovGenesis(v)
 	base := offset
@@ -147,26 +611,118 @@ func (m *GenesisState) Size() (n int) {
 	_ = l
 	l = m.Params.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.ChainChannels) > 0 {
+		for _, e := range m.ChainChannels {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.SubscriberChains) > 0 {
+		for _, e := range m.SubscriberChains {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
-func sovGenesis(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *ChainChannel) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainID)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.ChannelID)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	return n
 }
-func sozGenesis(x uint64) (n int) {
-	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *SubscriberChain) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainID)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.ClientID)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.EpochIdentifier)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.MaxValidators != 0 {
+		n += 1 + sovGenesis(uint64(m.MaxValidators))
+	}
+	if m.VscID != 0 {
+		n += 1 + sovGenesis(uint64(m.VscID))
+	}
+	if len(m.Validators) > 0 {
+		for _, e := range m.Validators {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Holds) > 0 {
+		for _, e := range m.Holds {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
 }
-func (m *GenesisState) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenesis
-			}
-			if iNdEx >= l {
+
+func (m *VscHolds) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.VscID != 0 {
+		n += 1 + sovGenesis(uint64(m.VscID))
+	}
+	if len(m.UndelegationRecordKeys) > 0 {
+		for _, b := range m.UndelegationRecordKeys {
+			l = len(b)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Operators) > 0 {
+		for _, s := range m.Operators {
+			l = len(s)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovGenesis(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozGenesis(x uint64) (n int) {
+	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *GenesisState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
 			b := dAtA[iNdEx]
@@ -218,6 +774,573 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainChannels", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainChannels = append(m.ChainChannels, ChainChannel{})
+			if err := m.ChainChannels[len(m.ChainChannels)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubscriberChains", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SubscriberChains = append(m.SubscriberChains, SubscriberChain{})
+			if err := m.SubscriberChains[len(m.SubscriberChains)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ChainChannel) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ChainChannel: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ChainChannel: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SubscriberChain) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SubscriberChain: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SubscriberChain: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochIdentifier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EpochIdentifier = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxValidators", wireType)
+			}
+			m.MaxValidators = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxValidators |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VscID", wireType)
+			}
+			m.VscID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VscID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validators", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validators = append(m.Validators, types.ValidatorUpdate{})
+			if err := m.Validators[len(m.Validators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Holds", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Holds = append(m.Holds, VscHolds{})
+			if err := m.Holds[len(m.Holds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *VscHolds) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VscHolds: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VscHolds: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VscID", wireType)
+			}
+			m.VscID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VscID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UndelegationRecordKeys", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UndelegationRecordKeys = append(m.UndelegationRecordKeys, make([]byte, postIndex-iNdEx))
+			copy(m.UndelegationRecordKeys[len(m.UndelegationRecordKeys)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Operators", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Operators = append(m.Operators, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
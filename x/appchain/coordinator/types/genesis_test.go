@@ -0,0 +1,259 @@
+package types_test
+
+import (
+	"strings"
+	"testing"
+
+	keytypes "github.com/ExocoreNetwork/exocore/types/keys"
+	"github.com/ExocoreNetwork/exocore/x/appchain/coordinator/types"
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestValidateGenesis(t *testing.T) {
+	validator := abci.ValidatorUpdate{
+		PubKey: *keytypes.NewWrappedConsKeyFromSdkKey(ed25519.GenPrivKey().PubKey()).ToTmProtoKey(),
+		Power:  100,
+	}
+	subscriberChain := func(
+		chainID string, clientID string, validators ...abci.ValidatorUpdate,
+	) types.SubscriberChain {
+		return types.SubscriberChain{
+			ChainID:         chainID,
+			ClientID:        clientID,
+			EpochIdentifier: "hour",
+			MaxValidators:   1,
+			VscID:           1,
+			Validators:      validators,
+		}
+	}
+	withHolds := func(chain types.SubscriberChain, holds ...types.VscHolds) types.SubscriberChain {
+		chain.Holds = holds
+		return chain
+	}
+	operator := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address()).String()
+	cases := []struct {
+		name      string
+		genesis   *types.GenesisState
+		expResult bool
+		expError  string
+	}{
+		{
+			name:      "default genesis",
+			genesis:   types.DefaultGenesis(),
+			expResult: true,
+		},
+		{
+			name: "chains with channels",
+			genesis: types.NewGenesis(types.DefaultParams(), []types.ChainChannel{
+				{ChainID: "appchain-1", ChannelID: "channel-0"},
+				{ChainID: "other-1", ChannelID: "channel-1"},
+			}, nil),
+			expResult: true,
+		},
+		{
+			name: "empty chain ID",
+			genesis: types.NewGenesis(types.DefaultParams(), []types.ChainChannel{
+				{ChainID: "", ChannelID: "channel-0"},
+			}, nil),
+			expResult: false,
+			expError:  "chain ID cannot be empty",
+		},
+		{
+			name: "invalid channel ID",
+			genesis: types.NewGenesis(types.DefaultParams(), []types.ChainChannel{
+				{ChainID: "appchain-1", ChannelID: "a"},
+			}, nil),
+			expResult: false,
+			expError:  "invalid channel ID for chain appchain-1",
+		},
+		{
+			name: "duplicate chain ID",
+			genesis: types.NewGenesis(types.DefaultParams(), []types.ChainChannel{
+				{ChainID: "appchain-1", ChannelID: "channel-0"},
+				{ChainID: "appchain-1", ChannelID: "channel-1"},
+			}, nil),
+			expResult: false,
+			expError:  "duplicate chain ID appchain-1",
+		},
+		{
+			name: "duplicate channel ID",
+			genesis: types.NewGenesis(types.DefaultParams(), []types.ChainChannel{
+				{ChainID: "appchain-1", ChannelID: "channel-0"},
+				{ChainID: "other-1", ChannelID: "channel-0"},
+			}, nil),
+			expResult: false,
+			expError:  "duplicate channel ID channel-0",
+		},
+		{
+			name: "subscriber chains",
+			genesis: types.NewGenesis(types.DefaultParams(), nil, []types.SubscriberChain{
+				subscriberChain("appchain-1", "07-tendermint-0", validator),
+				{ChainID: "other-1", ClientID: "07-tendermint-1", MaxValidators: 1},
+			}),
+			expResult: true,
+		},
+		{
+			name: "duplicate subscriber chain",
+			genesis: types.NewGenesis(types.DefaultParams(), nil, []types.SubscriberChain{
+				subscriberChain("appchain-1", "07-tendermint-0"),
+				subscriberChain("appchain-1", "07-tendermint-1"),
+			}),
+			expResult: false,
+			expError:  "duplicate subscriber chain appchain-1",
+		},
+		{
+			name: "invalid client ID",
+			genesis: types.NewGenesis(types.DefaultParams(), nil, []types.SubscriberChain{
+				subscriberChain("appchain-1", "a"),
+			}),
+			expResult: false,
+			expError:  "invalid client ID for chain appchain-1",
+		},
+		{
+			name: "duplicate client ID",
+			genesis: types.NewGenesis(types.DefaultParams(), nil, []types.SubscriberChain{
+				subscriberChain("appchain-1", "07-tendermint-0"),
+				subscriberChain("other-1", "07-tendermint-0"),
+			}),
+			expResult: false,
+			expError:  "duplicate client ID 07-tendermint-0",
+		},
+		{
+			name: "invalid epoch identifier",
+			genesis: types.NewGenesis(types.DefaultParams(), nil, []types.SubscriberChain{
+				{ChainID: "appchain-1", ClientID: "07-tendermint-0", EpochIdentifier: " ", MaxValidators: 1},
+			}),
+			expResult: false,
+			expError:  "invalid epoch identifier for chain appchain-1",
+		},
+		{
+			name: "zero max validators",
+			genesis: types.NewGenesis(types.DefaultParams(), nil, []types.SubscriberChain{
+				{ChainID: "appchain-1", ClientID: "07-tendermint-0"},
+			}),
+			expResult: false,
+			expError:  "max validators for chain appchain-1 cannot be 0",
+		},
+		{
+			name: "too many validators",
+			genesis: types.NewGenesis(types.DefaultParams(), nil, []types.SubscriberChain{
+				subscriberChain("appchain-1", "07-tendermint-0", validator, validator),
+			}),
+			expResult: false,
+			expError:  "too many validators for chain appchain-1",
+		},
+		{
+			name: "invalid validator key",
+			genesis: types.NewGenesis(types.DefaultParams(), nil, []types.SubscriberChain{
+				subscriberChain("appchain-1", "07-tendermint-0", abci.ValidatorUpdate{Power: 100}),
+			}),
+			expResult: false,
+			expError:  "invalid validator key for chain appchain-1",
+		},
+		{
+			name: "non-positive validator power",
+			genesis: types.NewGenesis(types.DefaultParams(), nil, []types.SubscriberChain{
+				subscriberChain("appchain-1", "07-tendermint-0", abci.ValidatorUpdate{PubKey: validator.PubKey}),
+			}),
+			expResult: false,
+			expError:  "non-positive validator power for chain appchain-1",
+		},
+		{
+			name: "duplicate validator",
+			genesis: types.NewGenesis(types.DefaultParams(), nil, []types.SubscriberChain{
+				{
+					ChainID: "appchain-1", ClientID: "07-tendermint-0", MaxValidators: 2,
+					Validators: []abci.ValidatorUpdate{validator, validator},
+				},
+			}),
+			expResult: false,
+			expError:  "duplicate validator",
+		},
+		{
+			name: "holds",
+			genesis: types.NewGenesis(types.DefaultParams(), nil, []types.SubscriberChain{
+				withHolds(subscriberChain("appchain-1", "07-tendermint-0"), types.VscHolds{
+					VscID: 2, UndelegationRecordKeys: [][]byte{[]byte("undelegation")},
+					Operators: []string{operator},
+				}),
+			}),
+			expResult: true,
+		},
+		{
+			name: "holds for a future vscID",
+			genesis: types.NewGenesis(types.DefaultParams(), nil, []types.SubscriberChain{
+				withHolds(subscriberChain("appchain-1", "07-tendermint-0"), types.VscHolds{
+					VscID: 3, Operators: []string{operator},
+				}),
+			}),
+			expResult: false,
+			expError:  "invalid vscID 3 of the holds for chain appchain-1",
+		},
+		{
+			name: "duplicate holds",
+			genesis: types.NewGenesis(types.DefaultParams(), nil, []types.SubscriberChain{
+				withHolds(
+					subscriberChain("appchain-1", "07-tendermint-0"),
+					types.VscHolds{VscID: 1, Operators: []string{operator}},
+					types.VscHolds{VscID: 1, Operators: []string{operator}},
+				),
+			}),
+			expResult: false,
+			expError:  "duplicate holds for vscID 1 of chain appchain-1",
+		},
+		{
+			name: "empty holds",
+			genesis: types.NewGenesis(types.DefaultParams(), nil, []types.SubscriberChain{
+				withHolds(subscriberChain("appchain-1", "07-tendermint-0"), types.VscHolds{VscID: 1}),
+			}),
+			expResult: false,
+			expError:  "empty holds for vscID 1 of chain appchain-1",
+		},
+		{
+			name: "empty undelegation record key",
+			genesis: types.NewGenesis(types.DefaultParams(), nil, []types.SubscriberChain{
+				withHolds(subscriberChain("appchain-1", "07-tendermint-0"), types.VscHolds{
+					VscID: 1, UndelegationRecordKeys: [][]byte{{}},
+				}),
+			}),
+			expResult: false,
+			expError:  "empty undelegation record key for chain appchain-1",
+		},
+		{
+			name: "invalid operator",
+			genesis: types.NewGenesis(types.DefaultParams(), nil, []types.SubscriberChain{
+				withHolds(subscriberChain("appchain-1", "07-tendermint-0"), types.VscHolds{
+					VscID: 1, Operators: []string{"operator"},
+				}),
+			}),
+			expResult: false,
+			expError:  "invalid operator operator for chain appchain-1",
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			// first, validate the test case itself
+			if tc.expResult && tc.expError != "" {
+				t.Fatal("invalid test case: expected success but got error")
+			} else if !tc.expResult && tc.expError == "" {
+				t.Fatal("invalid test case: expected error but got success")
+			}
+			// then run the test case
+			err := tc.genesis.Validate()
+			if tc.expResult && err != nil {
+				t.Fatalf("expected no error, got %s", err)
+			}
+			if !tc.expResult {
+				if err == nil {
+					t.Fatal("expected error, got none")
+				} else {
+					if !strings.Contains(err.Error(), tc.expError) {
+						t.Fatalf("expected error %q, got %q", tc.expError, err.Error())
+					}
+				}
+			}
+		})
+	}
+}
@@ -1,6 +1,7 @@
 package types
 
 import (
+	commontypes "github.com/ExocoreNetwork/exocore/x/appchain/common/types"
 	epochstypes "github.com/ExocoreNetwork/exocore/x/epochs/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
@@ -19,7 +20,7 @@ const (
 	MemStoreKey = "mem_coordinator"
 
 	// PortID is the default port id that the module binds to
-	PortID = "coordinator"
+	PortID = commontypes.CoordinatorPortID
 
 	// SubscriberRewardsPool is the address that receives the rewards from the subscriber
 	// chains. Technically, it is possible for the subscriber chain to send these rewards
@@ -47,6 +48,28 @@ const (
 	SubscriberGenesisBytePrefix
 	// InitTimeoutBytePrefix is the prefix for the init timeout key
 	InitTimeoutBytePrefix
+	// ChainInitTimeoutBytePrefix is the prefix for the key to store the init timeout epoch of a chain
+	ChainInitTimeoutBytePrefix
+	// ChainToChannelBytePrefix is the prefix to store mapping from chain to channel
+	ChainToChannelBytePrefix
+	// ChannelToChainBytePrefix is the prefix to store mapping from channel to chain
+	ChannelToChainBytePrefix
+	// ActiveChainsByEpochBytePrefix is the prefix to store the list of chains, which receive
+	// validator set updates at the end of each epoch with the identifier
+	ActiveChainsByEpochBytePrefix
+	// MaxValidatorsBytePrefix is the prefix to store the maximum number of validators of a chain
+	MaxValidatorsBytePrefix
+	// SubscriberValidatorBytePrefix is the prefix to store the validators of a chain, as last
+	// sent to it
+	SubscriberValidatorBytePrefix
+	// VscIDForChainBytePrefix is the prefix to store the latest validator set change ID of a chain
+	VscIDForChainBytePrefix
+	// UndelegationsToReleaseBytePrefix is the prefix to store the undelegations which are held
+	// until a validator set change of a chain matures
+	UndelegationsToReleaseBytePrefix
+	// OptOutsToFinishBytePrefix is the prefix to store the operator key removals which are held
+	// until a validator set change of a chain matures
+	OptOutsToFinishBytePrefix
 )
 
 // AppendMany appends a variable number of byte slices together
@@ -109,3 +132,75 @@ func InitTimeoutEpochKey(epoch epochstypes.Epoch) []byte {
 		sdk.Uint64ToBigEndian(epoch.EpochNumber),
 	)
 }
+
+// ChainInitTimeoutKey returns the key under which the init timeout epoch of a chain is stored.
+func ChainInitTimeoutKey(chainID string) []byte {
+	return append([]byte{ChainInitTimeoutBytePrefix}, []byte(chainID)...)
+}
+
+// ChainToChannelKey returns the key under which the channelID for the given chainID is stored.
+func ChainToChannelKey(chainID string) []byte {
+	return append([]byte{ChainToChannelBytePrefix}, []byte(chainID)...)
+}
+
+// ChannelToChainKey returns the key under which the chainID for the given channelID is stored.
+func ChannelToChainKey(channelID string) []byte {
+	return append([]byte{ChannelToChainBytePrefix}, []byte(channelID)...)
+}
+
+// ActiveChainsByEpochKey returns the key under which the list of chains, which receive validator
+// set updates at the end of each epoch with the identifier, is stored.
+func ActiveChainsByEpochKey(epochIdentifier string) []byte {
+	return append([]byte{ActiveChainsByEpochBytePrefix}, []byte(epochIdentifier)...)
+}
+
+// MaxValidatorsKey returns the key under which the maximum number of validators of a chain is
+// stored.
+func MaxValidatorsKey(chainID string) []byte {
+	return append([]byte{MaxValidatorsBytePrefix}, []byte(chainID)...)
+}
+
+// ChainIDWithLenKey returns the prefix followed by the length-prefixed chainID, so that the
+// keys of one chain do not match the prefix of another.
+func ChainIDWithLenKey(prefix byte, chainID string) []byte {
+	return AppendMany(
+		[]byte{prefix},
+		sdk.Uint64ToBigEndian(uint64(len(chainID))),
+		[]byte(chainID),
+	)
+}
+
+// SubscriberValidatorPrefix returns the prefix under which the validators of a chain are stored.
+func SubscriberValidatorPrefix(chainID string) []byte {
+	return ChainIDWithLenKey(SubscriberValidatorBytePrefix, chainID)
+}
+
+// SubscriberValidatorKey returns the key under which the validator of a chain with the
+// consensus address is stored.
+func SubscriberValidatorKey(chainID string, consAddr sdk.ConsAddress) []byte {
+	return append(SubscriberValidatorPrefix(chainID), consAddr...)
+}
+
+// VscIDForChainKey returns the key under which the latest validator set change ID of a chain
+// is stored.
+func VscIDForChainKey(chainID string) []byte {
+	return append([]byte{VscIDForChainBytePrefix}, []byte(chainID)...)
+}
+
+// UndelegationsToReleaseKey returns the key under which the undelegations, which are held
+// until the validator set change with the vscID of a chain matures, are stored.
+func UndelegationsToReleaseKey(chainID string, vscID uint64) []byte {
+	return append(
+		ChainIDWithLenKey(UndelegationsToReleaseBytePrefix, chainID),
+		sdk.Uint64ToBigEndian(vscID)...,
+	)
+}
+
+// OptOutsToFinishKey returns the key under which the operators, whose key removal is held
+// until the validator set change with the vscID of a chain matures, are stored.
+func OptOutsToFinishKey(chainID string, vscID uint64) []byte {
+	return append(
+		ChainIDWithLenKey(OptOutsToFinishBytePrefix, chainID),
+		sdk.Uint64ToBigEndian(vscID)...,
+	)
+}
@@ -7,6 +7,19 @@ import (
 
 func (k Keeper) BeginBlock(sdk.Context) {}
 
-func (k Keeper) EndBlock(sdk.Context) []abci.ValidatorUpdate {
-	return []abci.ValidatorUpdate{}
+// EndBlock notifies the coordinator of the validator set changes which have matured, and
+// applies the validator set changes received from the coordinator since the last block.
+func (k Keeper) EndBlock(ctx sdk.Context) []abci.ValidatorUpdate {
+	k.SendVscMaturedPackets(ctx)
+	changes, found := k.GetPendingChanges(ctx)
+	if !found {
+		return []abci.ValidatorUpdate{}
+	}
+	k.DeletePendingChanges(ctx)
+	k.Logger(ctx).Info(
+		"applying validator set changes",
+		"vscID", changes.ValsetUpdateID,
+		"len updates", len(changes.ValidatorUpdates),
+	)
+	return changes.ValidatorUpdates
 }
@@ -1,6 +1,8 @@
 package keeper
 
 import (
+	"fmt"
+
 	"github.com/ExocoreNetwork/exocore/x/appchain/subscriber/types"
 	abci "github.com/cometbft/cometbft/abci/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -8,11 +10,53 @@ import (
 
 func (k Keeper) InitGenesis(ctx sdk.Context, gs types.GenesisState) []abci.ValidatorUpdate {
 	k.SetParams(ctx, gs.Params)
+	// bind to the port only if it is not already bound, which is the case during a restart
+	if !k.IsBound(ctx, types.PortID) {
+		if err := k.BindPort(ctx, types.PortID); err != nil {
+			panic(fmt.Sprintf("could not claim port capability: %v", err))
+		}
+	}
+	if gs.CoordinatorClientID == "" {
+		// a new chain, so we create the client of the coordinator chain from the genesis
+		// state generated by the coordinator.
+		if gs.Coordinator.ClientState != nil {
+			clientID, err := k.clientKeeper.CreateClient(
+				ctx, gs.Coordinator.ClientState, gs.Coordinator.ConsensusState,
+			)
+			if err != nil {
+				panic(fmt.Sprintf("could not create coordinator client: %v", err))
+			}
+			k.SetCoordinatorClientID(ctx, clientID)
+			// the initial validator set is the one generated by the coordinator.
+			return gs.Coordinator.InitialValSet
+		}
+	} else {
+		// a restarted chain, so the client (and possibly the channel) already exist.
+		k.SetCoordinatorClientID(ctx, gs.CoordinatorClientID)
+		if gs.CoordinatorChannelID != "" {
+			k.SetCoordinatorChannel(ctx, gs.CoordinatorChannelID)
+		}
+		if gs.PendingChanges != nil {
+			k.SetPendingChanges(ctx, *gs.PendingChanges)
+		}
+		for _, packet := range gs.MaturingVscPackets {
+			k.SetPacketMaturityTime(ctx, packet.VscID, packet.MaturityTime)
+		}
+	}
 	return []abci.ValidatorUpdate{}
 }
 
 func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
-	return &types.GenesisState{
-		Params: k.GetParams(ctx),
+	clientID, _ := k.GetCoordinatorClientID(ctx)
+	channelID, _ := k.GetCoordinatorChannel(ctx)
+	gs := &types.GenesisState{
+		Params:               k.GetParams(ctx),
+		CoordinatorClientID:  clientID,
+		CoordinatorChannelID: channelID,
+		MaturingVscPackets:   k.GetAllPacketMaturityTimes(ctx),
+	}
+	if changes, found := k.GetPendingChanges(ctx); found {
+		gs.PendingChanges = &changes
 	}
+	return gs
 }
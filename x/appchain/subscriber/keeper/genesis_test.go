@@ -0,0 +1,65 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	keepertest "github.com/ExocoreNetwork/exocore/testutil/keeper"
+	commontypes "github.com/ExocoreNetwork/exocore/x/appchain/common/types"
+	"github.com/ExocoreNetwork/exocore/x/appchain/subscriber/types"
+	abci "github.com/cometbft/cometbft/abci/types"
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenesisValidatorSetChanges(t *testing.T) {
+	k, ctx, mocks := keepertest.SubscriberKeeper(t)
+	setupCoordinatorChannel(k, ctx, mocks)
+	k.SetCoordinatorClientID(ctx, "07-tendermint-0")
+	// the default params leave the reward denomination empty, which is invalid.
+	params := k.GetParams(ctx)
+	params.RewardDenom = "stake"
+	k.SetParams(ctx, params)
+	unbondingPeriod := k.GetParams(ctx).UnbondingPeriod
+	start := ctx.BlockTime()
+
+	// two changes received an hour apart, of which the first is applied.
+	packet := channeltypes.Packet{DestinationChannel: testChannelID}
+	update := newValidatorUpdate(t, 100)
+	ack := k.OnRecvVSCPacket(ctx, packet, commontypes.ValidatorSetChangePacketData{
+		ValsetUpdateID: 1,
+	})
+	require.True(t, ack.Success())
+	k.EndBlock(ctx)
+	ctx = ctx.WithBlockTime(start.Add(time.Hour))
+	ack = k.OnRecvVSCPacket(ctx, packet, commontypes.ValidatorSetChangePacketData{
+		ValidatorUpdates: []abci.ValidatorUpdate{update},
+		ValsetUpdateID:   2,
+	})
+	require.True(t, ack.Success())
+
+	exported := k.ExportGenesis(ctx)
+	require.NoError(t, exported.Validate())
+	require.Equal(t, &commontypes.ValidatorSetChangePacketData{
+		ValidatorUpdates: []abci.ValidatorUpdate{update},
+		ValsetUpdateID:   2,
+	}, exported.PendingChanges)
+	require.Len(t, exported.MaturingVscPackets, 2)
+	require.Equal(t, types.MaturingVscPacket{
+		VscID:        1,
+		MaturityTime: start.Add(unbondingPeriod).UTC(),
+	}, exported.MaturingVscPackets[0])
+	require.Equal(t, uint64(2), exported.MaturingVscPackets[1].VscID)
+
+	// the state survives a re-import.
+	imported, importedCtx, importedMocks := keepertest.SubscriberKeeper(t)
+	setupCoordinatorChannel(imported, importedCtx, importedMocks)
+	imported.InitGenesis(importedCtx, *exported)
+	require.Equal(t, exported, imported.ExportGenesis(importedCtx))
+
+	// and the changes are applied and notified as before.
+	require.Equal(t, []abci.ValidatorUpdate{update}, imported.EndBlock(importedCtx))
+	importedCtx = importedCtx.WithBlockTime(start.Add(unbondingPeriod))
+	imported.EndBlock(importedCtx)
+	require.Equal(t, []uint64{1}, sentMaturedIDs(t, importedMocks))
+}
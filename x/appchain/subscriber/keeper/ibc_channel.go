@@ -0,0 +1,120 @@
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	commontypes "github.com/ExocoreNetwork/exocore/x/appchain/common/types"
+	"github.com/ExocoreNetwork/exocore/x/appchain/subscriber/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
+	clienttypes "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
+	conntypes "github.com/cosmos/ibc-go/v7/modules/core/03-connection/types"
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
+	host "github.com/cosmos/ibc-go/v7/modules/core/24-host"
+)
+
+// IsBound checks if the subscriber module is already bound to the desired port.
+func (k Keeper) IsBound(ctx sdk.Context, portID string) bool {
+	_, ok := k.scopedKeeper.GetCapability(ctx, host.PortPath(portID))
+	return ok
+}
+
+// BindPort defines a wrapper function for the port Keeper's function in
+// order to expose it to the module's InitGenesis function.
+func (k Keeper) BindPort(ctx sdk.Context, portID string) error {
+	capability := k.portKeeper.BindPort(ctx, portID)
+	return k.ClaimCapability(ctx, capability, host.PortPath(portID))
+}
+
+// GetPort returns the port ID that the subscriber module binds to.
+func (k Keeper) GetPort(sdk.Context) string {
+	return types.PortID
+}
+
+// ClaimCapability allows the subscriber module to claim a capability that the IBC module
+// passes to it.
+func (k Keeper) ClaimCapability(
+	ctx sdk.Context, capability *capabilitytypes.Capability, name string,
+) error {
+	return k.scopedKeeper.ClaimCapability(ctx, capability, name)
+}
+
+// VerifyCoordinatorChain verifies that the channel being opened is with the coordinator chain.
+// The connection underlying the channel must be built on top of the client created from the
+// coordinator information in the genesis state, and no channel must exist to the coordinator.
+func (k Keeper) VerifyCoordinatorChain(ctx sdk.Context, connectionHops []string) error {
+	if len(connectionHops) != 1 {
+		return errorsmod.Wrap(
+			channeltypes.ErrTooManyConnectionHops,
+			"must have direct connection to coordinator chain",
+		)
+	}
+	connectionID := connectionHops[0]
+	conn, found := k.connectionKeeper.GetConnection(ctx, connectionID)
+	if !found {
+		return errorsmod.Wrapf(
+			conntypes.ErrConnectionNotFound, "connection not found for connection ID: %s",
+			connectionID,
+		)
+	}
+	expectedClientID, found := k.GetCoordinatorClientID(ctx)
+	if !found {
+		return errorsmod.Wrap(
+			commontypes.ErrClientNotFound, "coordinator client not found",
+		)
+	}
+	if conn.ClientId != expectedClientID {
+		return errorsmod.Wrapf(
+			clienttypes.ErrInvalidClient,
+			"invalid client: %s, channel must be built on top of the coordinator client: %s",
+			conn.ClientId, expectedClientID,
+		)
+	}
+	if channelID, found := k.GetCoordinatorChannel(ctx); found {
+		return errorsmod.Wrapf(
+			commontypes.ErrDuplicateChannel,
+			"channel %s to the coordinator already exists", channelID,
+		)
+	}
+	return nil
+}
+
+// SetCoordinatorChain stores the channel to the coordinator chain once the handshake is
+// acknowledged by the coordinator. The coordinator's fee pool address, received as part of
+// the handshake metadata, is stored in the params.
+func (k Keeper) SetCoordinatorChain(
+	ctx sdk.Context, channelID string, md commontypes.HandshakeMetadata,
+) error {
+	if prevChannelID, found := k.GetCoordinatorChannel(ctx); found {
+		return errorsmod.Wrapf(
+			commontypes.ErrDuplicateChannel,
+			"channel %s to the coordinator already exists", prevChannelID,
+		)
+	}
+	channel, found := k.channelKeeper.GetChannel(ctx, types.PortID, channelID)
+	if !found {
+		return errorsmod.Wrapf(
+			channeltypes.ErrChannelNotFound, "channel not found for channel ID: %s", channelID,
+		)
+	}
+	clientID, _ := k.GetCoordinatorClientID(ctx)
+	k.SetCoordinatorChannel(ctx, channelID)
+	params := k.GetParams(ctx)
+	params.CoordinatorFeePoolAddrStr = md.CoordinatorFeePoolAddr
+	k.SetParams(ctx, params)
+
+	k.Logger(ctx).Info(
+		"coordinator chain channel established",
+		"channelID", channelID,
+	)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			commontypes.EventTypeChannelEstablished,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(clienttypes.AttributeKeyClientID, clientID),
+			sdk.NewAttribute(channeltypes.AttributeKeyChannelID, channelID),
+			sdk.NewAttribute(conntypes.AttributeKeyConnectionID, channel.ConnectionHops[0]),
+		),
+	)
+	return nil
+}
@@ -0,0 +1,44 @@
+package keeper
+
+import (
+	"github.com/ExocoreNetwork/exocore/x/appchain/subscriber/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// SetCoordinatorClientID sets the ibc client id of the coordinator chain.
+func (k Keeper) SetCoordinatorClientID(ctx sdk.Context, clientID string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.CoordinatorClientIDKey(), []byte(clientID))
+}
+
+// GetCoordinatorClientID gets the ibc client id of the coordinator chain.
+func (k Keeper) GetCoordinatorClientID(ctx sdk.Context) (string, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bytes := store.Get(types.CoordinatorClientIDKey())
+	if bytes == nil {
+		return "", false
+	}
+	return string(bytes), true
+}
+
+// SetCoordinatorChannel sets the ibc channel id to the coordinator chain.
+func (k Keeper) SetCoordinatorChannel(ctx sdk.Context, channelID string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.CoordinatorChannelKey(), []byte(channelID))
+}
+
+// GetCoordinatorChannel gets the ibc channel id to the coordinator chain.
+func (k Keeper) GetCoordinatorChannel(ctx sdk.Context) (string, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bytes := store.Get(types.CoordinatorChannelKey())
+	if bytes == nil {
+		return "", false
+	}
+	return string(bytes), true
+}
+
+// DeleteCoordinatorChannel deletes the ibc channel id to the coordinator chain.
+func (k Keeper) DeleteCoordinatorChannel(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.CoordinatorChannelKey())
+}
@@ -1,19 +1,49 @@
 package keeper
 
 import (
+	"fmt"
+
+	"github.com/cometbft/cometbft/libs/log"
+
+	commontypes "github.com/ExocoreNetwork/exocore/x/appchain/common/types"
+	"github.com/ExocoreNetwork/exocore/x/appchain/subscriber/types"
 	"github.com/cosmos/cosmos-sdk/codec"
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 type Keeper struct {
-	cdc      codec.BinaryCodec
-	storeKey storetypes.StoreKey
+	cdc              codec.BinaryCodec
+	storeKey         storetypes.StoreKey
+	clientKeeper     commontypes.ClientKeeper
+	scopedKeeper     commontypes.ScopedKeeper
+	portKeeper       commontypes.PortKeeper
+	channelKeeper    commontypes.ChannelKeeper
+	connectionKeeper commontypes.ConnectionKeeper
 }
 
-// NewKeeper creates a new coordinator keeper.
-func NewKeeper(cdc codec.BinaryCodec, storeKey storetypes.StoreKey) Keeper {
+// NewKeeper creates a new subscriber keeper.
+func NewKeeper(
+	cdc codec.BinaryCodec,
+	storeKey storetypes.StoreKey,
+	clientKeeper commontypes.ClientKeeper,
+	scopedKeeper commontypes.ScopedKeeper,
+	portKeeper commontypes.PortKeeper,
+	channelKeeper commontypes.ChannelKeeper,
+	connectionKeeper commontypes.ConnectionKeeper,
+) Keeper {
 	return Keeper{
-		cdc:      cdc,
-		storeKey: storeKey,
+		cdc:              cdc,
+		storeKey:         storeKey,
+		clientKeeper:     clientKeeper,
+		scopedKeeper:     scopedKeeper,
+		portKeeper:       portKeeper,
+		channelKeeper:    channelKeeper,
+		connectionKeeper: connectionKeeper,
 	}
 }
+
+// Logger returns a logger object for use within the module.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
+}
@@ -0,0 +1,102 @@
+package keeper
+
+import (
+	"time"
+
+	commontypes "github.com/ExocoreNetwork/exocore/x/appchain/common/types"
+	"github.com/ExocoreNetwork/exocore/x/appchain/subscriber/types"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// SetPacketMaturityTime stores the time at which the validator set change with the vscID
+// matures, that is, the time after which the subscriber can no longer request slashing for
+// infractions committed before the change.
+func (k Keeper) SetPacketMaturityTime(
+	ctx sdk.Context, vscID uint64, maturityTime time.Time,
+) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.PacketMaturityTimeKey(maturityTime, vscID), sdk.Uint64ToBigEndian(vscID))
+}
+
+// DeletePacketMaturityTime deletes the maturity time of the validator set change with the
+// vscID.
+func (k Keeper) DeletePacketMaturityTime(
+	ctx sdk.Context, vscID uint64, maturityTime time.Time,
+) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.PacketMaturityTimeKey(maturityTime, vscID))
+}
+
+// GetAllPacketMaturityTimes returns the validator set changes whose maturity is yet to be
+// notified to the coordinator, in the order of maturity.
+func (k Keeper) GetAllPacketMaturityTimes(ctx sdk.Context) []types.MaturingVscPacket {
+	store := prefix.NewStore(
+		ctx.KVStore(k.storeKey), []byte{types.PacketMaturityTimeBytePrefix},
+	)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	res := make([]types.MaturingVscPacket, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		// the key is the maturity time followed by the vscID, which is also the value.
+		// #nosec G115 // stored from a positive time
+		maturityTime := time.Unix(0, int64(sdk.BigEndianToUint64(iterator.Key()[:8]))).UTC()
+		res = append(res, types.MaturingVscPacket{
+			VscID:        sdk.BigEndianToUint64(iterator.Value()),
+			MaturityTime: maturityTime,
+		})
+	}
+	return res
+}
+
+// SendVscMaturedPackets sends a VSC matured packet to the coordinator for each validator set
+// change that has matured by the current block time, in the order of maturity. If a packet
+// cannot be sent, it (and the ones after it) are retried in the next block.
+func (k Keeper) SendVscMaturedPackets(ctx sdk.Context) {
+	channelID, found := k.GetCoordinatorChannel(ctx)
+	if !found {
+		return
+	}
+	timeoutPeriod := k.GetParams(ctx).IBCTimeoutPeriod
+	store := prefix.NewStore(
+		ctx.KVStore(k.storeKey), []byte{types.PacketMaturityTimeBytePrefix},
+	)
+	// the end is exclusive, so the entries maturing exactly now are included by adding 1.
+	// #nosec G115 // the block time is positive
+	end := sdk.Uint64ToBigEndian(uint64(ctx.BlockTime().UnixNano()) + 1)
+	iterator := store.Iterator(nil, end)
+	defer iterator.Close()
+
+	matured := make([][]byte, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		vscID := sdk.BigEndianToUint64(iterator.Value())
+		data := commontypes.SubscriberPacketData{
+			Data: &commontypes.SubscriberPacketData_VscMaturedPacketData{
+				VscMaturedPacketData: &commontypes.VscMaturedPacketData{
+					ValsetUpdateID: vscID,
+				},
+			},
+		}
+		bz, err := data.Marshal()
+		if err == nil {
+			err = commontypes.SendIBCPacket(
+				ctx, k.scopedKeeper, k.channelKeeper, channelID, types.PortID, bz,
+				timeoutPeriod,
+			)
+		}
+		if err != nil {
+			k.Logger(ctx).Error(
+				"VSC matured packet not sent, retrying in the next block",
+				"vscID", vscID,
+				"error", err,
+			)
+			break
+		}
+		matured = append(matured, iterator.Key())
+		k.Logger(ctx).Info("VSC matured packet sent", "vscID", vscID)
+	}
+	for _, key := range matured {
+		store.Delete(key)
+	}
+}
@@ -0,0 +1,76 @@
+package keeper_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	keepertest "github.com/ExocoreNetwork/exocore/testutil/keeper"
+	commontypes "github.com/ExocoreNetwork/exocore/x/appchain/common/types"
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
+	"github.com/stretchr/testify/require"
+)
+
+// sentMaturedIDs returns the vscIDs of the VSC matured packets sent through the mocks.
+func sentMaturedIDs(t *testing.T, mocks *keepertest.AppchainMocks) []uint64 {
+	res := make([]uint64, 0, len(mocks.IBC.SentPackets))
+	for _, packet := range mocks.IBC.SentPackets {
+		var data commontypes.SubscriberPacketData
+		require.NoError(t, data.Unmarshal(packet.Data))
+		res = append(res, data.GetVscMaturedPacketData().ValsetUpdateID)
+	}
+	return res
+}
+
+func TestSendVscMaturedPacketsInOrder(t *testing.T) {
+	k, ctx, mocks := keepertest.SubscriberKeeper(t)
+	setupCoordinatorChannel(k, ctx, mocks)
+	unbondingPeriod := k.GetParams(ctx).UnbondingPeriod
+	start := ctx.BlockTime()
+
+	// three changes received an hour apart.
+	packet := channeltypes.Packet{DestinationChannel: testChannelID}
+	for vscID := uint64(1); vscID <= 3; vscID++ {
+		ctx = ctx.WithBlockTime(start.Add(time.Duration(vscID-1) * time.Hour))
+		ack := k.OnRecvVSCPacket(ctx, packet, commontypes.ValidatorSetChangePacketData{
+			ValsetUpdateID: vscID,
+		})
+		require.True(t, ack.Success())
+	}
+
+	// nothing has matured before the unbonding period.
+	ctx = ctx.WithBlockTime(start.Add(unbondingPeriod - time.Nanosecond))
+	k.SendVscMaturedPackets(ctx)
+	require.Empty(t, mocks.IBC.SentPackets)
+
+	// the first change matures exactly at the end of the unbonding period.
+	ctx = ctx.WithBlockTime(start.Add(unbondingPeriod))
+	k.SendVscMaturedPackets(ctx)
+	require.Equal(t, []uint64{1}, sentMaturedIDs(t, mocks))
+
+	// the packets that cannot be sent are retried in the next block.
+	ctx = ctx.WithBlockTime(start.Add(unbondingPeriod + 2*time.Hour))
+	mocks.IBC.SendErr = errors.New("send failed")
+	k.SendVscMaturedPackets(ctx)
+	require.Equal(t, []uint64{1}, sentMaturedIDs(t, mocks))
+
+	mocks.IBC.SendErr = nil
+	k.SendVscMaturedPackets(ctx)
+	require.Equal(t, []uint64{1, 2, 3}, sentMaturedIDs(t, mocks))
+
+	// each packet is sent only once.
+	k.SendVscMaturedPackets(ctx)
+	require.Equal(t, []uint64{1, 2, 3}, sentMaturedIDs(t, mocks))
+}
+
+func TestSendVscMaturedPacketsWithoutChannel(t *testing.T) {
+	k, ctx, mocks := keepertest.SubscriberKeeper(t)
+	k.SetPacketMaturityTime(ctx, 1, ctx.BlockTime())
+	k.SendVscMaturedPackets(ctx)
+	require.Empty(t, mocks.IBC.SentPackets)
+
+	// the packet is sent once the channel is established.
+	setupCoordinatorChannel(k, ctx, mocks)
+	k.SendVscMaturedPackets(ctx)
+	require.Equal(t, []uint64{1}, sentMaturedIDs(t, mocks))
+}
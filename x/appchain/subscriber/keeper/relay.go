@@ -0,0 +1,35 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
+)
+
+// OnAcknowledgementPacket handles the acknowledgement of a packet sent to the coordinator.
+// The coordinator responds with an error acknowledgement if it could not process the packet.
+func (k Keeper) OnAcknowledgementPacket(
+	ctx sdk.Context, packet channeltypes.Packet, ack channeltypes.Acknowledgement,
+) error {
+	if res, ok := ack.Response.(*channeltypes.Acknowledgement_Error); ok {
+		k.Logger(ctx).Error(
+			"packet rejected by coordinator",
+			"channelID", packet.SourceChannel,
+			"sequence", packet.Sequence,
+			"error", res.Error,
+		)
+	}
+	return nil
+}
+
+// OnTimeoutPacket handles the timeout of a packet sent to the coordinator. Since the channel
+// is ordered, IBC closes it on timeout, and the channel is forgotten so that no more packets
+// are sent over it.
+func (k Keeper) OnTimeoutPacket(ctx sdk.Context, packet channeltypes.Packet) error {
+	k.Logger(ctx).Error(
+		"packet to coordinator timed out",
+		"channelID", packet.SourceChannel,
+		"sequence", packet.Sequence,
+	)
+	k.DeleteCoordinatorChannel(ctx)
+	return nil
+}
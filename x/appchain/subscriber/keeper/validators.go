@@ -0,0 +1,102 @@
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	commontypes "github.com/ExocoreNetwork/exocore/x/appchain/common/types"
+	"github.com/ExocoreNetwork/exocore/x/appchain/subscriber/types"
+	abci "github.com/cometbft/cometbft/abci/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
+	ibcexported "github.com/cosmos/ibc-go/v7/modules/core/exported"
+)
+
+// OnRecvVSCPacket handles a validator set change packet received from the coordinator. The
+// changes are accumulated and applied in the EndBlock.
+func (k Keeper) OnRecvVSCPacket(
+	ctx sdk.Context, packet channeltypes.Packet, data commontypes.ValidatorSetChangePacketData,
+) ibcexported.Acknowledgement {
+	coordinatorChannel, found := k.GetCoordinatorChannel(ctx)
+	if !found || coordinatorChannel != packet.DestinationChannel {
+		return channeltypes.NewErrorAcknowledgement(
+			errorsmod.Wrapf(
+				commontypes.ErrInvalidChannelFlow,
+				"packet received on unknown channel %s", packet.DestinationChannel,
+			),
+		)
+	}
+	pending, _ := k.GetPendingChanges(ctx)
+	pending.ValidatorUpdates = accumulateChanges(pending.ValidatorUpdates, data.ValidatorUpdates)
+	pending.ValsetUpdateID = data.ValsetUpdateID
+	k.SetPendingChanges(ctx, pending)
+	// the coordinator is notified once the unbonding period has elapsed since the receipt.
+	k.SetPacketMaturityTime(
+		ctx, data.ValsetUpdateID, ctx.BlockTime().Add(k.GetParams(ctx).UnbondingPeriod),
+	)
+
+	k.Logger(ctx).Info(
+		"validator set change packet received",
+		"vscID", data.ValsetUpdateID,
+		"len updates", len(data.ValidatorUpdates),
+	)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			commontypes.EventTypeValidatorSetChange,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(
+				commontypes.AttributeValsetUpdateID,
+				sdk.NewIntFromUint64(data.ValsetUpdateID).String(),
+			),
+		),
+	)
+	return channeltypes.NewResultAcknowledgement([]byte{byte(1)})
+}
+
+// SetPendingChanges sets the validator set changes which are yet to be applied.
+func (k Keeper) SetPendingChanges(
+	ctx sdk.Context, changes commontypes.ValidatorSetChangePacketData,
+) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.PendingChangesKey(), k.cdc.MustMarshal(&changes))
+}
+
+// GetPendingChanges gets the validator set changes which are yet to be applied.
+func (k Keeper) GetPendingChanges(
+	ctx sdk.Context,
+) (changes commontypes.ValidatorSetChangePacketData, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.PendingChangesKey())
+	if bz == nil {
+		return changes, false
+	}
+	k.cdc.MustUnmarshal(bz, &changes)
+	return changes, true
+}
+
+// DeletePendingChanges deletes the validator set changes which are yet to be applied.
+func (k Keeper) DeletePendingChanges(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.PendingChangesKey())
+}
+
+// accumulateChanges merges the new changes into the previous changes. If a validator is
+// present in both, the new change overrides the previous one. The order of first appearance
+// is retained, so that the result is deterministic.
+func accumulateChanges(
+	prev, next []abci.ValidatorUpdate,
+) []abci.ValidatorUpdate {
+	res := make([]abci.ValidatorUpdate, 0, len(prev)+len(next))
+	indices := make(map[string]int, len(prev)+len(next))
+	for _, updates := range [][]abci.ValidatorUpdate{prev, next} {
+		for _, update := range updates {
+			key := update.PubKey.String()
+			if i, found := indices[key]; found {
+				res[i] = update
+				continue
+			}
+			indices[key] = len(res)
+			res = append(res, update)
+		}
+	}
+	return res
+}
@@ -0,0 +1,97 @@
+package keeper_test
+
+import (
+	"testing"
+
+	keepertest "github.com/ExocoreNetwork/exocore/testutil/keeper"
+	commontypes "github.com/ExocoreNetwork/exocore/x/appchain/common/types"
+	"github.com/ExocoreNetwork/exocore/x/appchain/subscriber/keeper"
+	"github.com/ExocoreNetwork/exocore/x/appchain/subscriber/types"
+	abci "github.com/cometbft/cometbft/abci/types"
+	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
+	"github.com/stretchr/testify/require"
+)
+
+const testChannelID = "channel-0"
+
+// setupCoordinatorChannel opens the channel to the coordinator, as if its handshake had been
+// acknowledged.
+func setupCoordinatorChannel(k keeper.Keeper, ctx sdk.Context, mocks *keepertest.AppchainMocks) {
+	mocks.IBC.AddConnection("connection-0", "exocoretestnet_233-1")
+	mocks.IBC.OpenChannel(
+		types.PortID, testChannelID, "connection-0",
+		channeltypes.NewCounterparty(commontypes.CoordinatorPortID, "channel-0"),
+	)
+	k.SetCoordinatorChannel(ctx, testChannelID)
+}
+
+func newValidatorUpdate(t *testing.T, power int64) abci.ValidatorUpdate {
+	pubKey, err := cryptocodec.ToTmProtoPublicKey(ed25519.GenPrivKey().PubKey())
+	require.NoError(t, err)
+	return abci.ValidatorUpdate{PubKey: pubKey, Power: power}
+}
+
+func withPower(update abci.ValidatorUpdate, power int64) abci.ValidatorUpdate {
+	update.Power = power
+	return update
+}
+
+func TestOnRecvVSCPacketAccumulatesChanges(t *testing.T) {
+	k, ctx, mocks := keepertest.SubscriberKeeper(t)
+	setupCoordinatorChannel(k, ctx, mocks)
+	packet := channeltypes.Packet{DestinationChannel: testChannelID}
+	first, second, third := newValidatorUpdate(t, 100), newValidatorUpdate(t, 200), newValidatorUpdate(t, 300)
+
+	ack := k.OnRecvVSCPacket(ctx, packet, commontypes.ValidatorSetChangePacketData{
+		ValidatorUpdates: []abci.ValidatorUpdate{first, second},
+		ValsetUpdateID:   1,
+	})
+	require.True(t, ack.Success())
+	// a later change to the same key overrides the earlier one in place, while new keys are
+	// appended in the order they appear.
+	ack = k.OnRecvVSCPacket(ctx, packet, commontypes.ValidatorSetChangePacketData{
+		ValidatorUpdates: []abci.ValidatorUpdate{third, withPower(first, 0)},
+		ValsetUpdateID:   2,
+	})
+	require.True(t, ack.Success())
+	// an empty change retains the pending changes, but updates the vscID.
+	ack = k.OnRecvVSCPacket(ctx, packet, commontypes.ValidatorSetChangePacketData{
+		ValsetUpdateID: 3,
+	})
+	require.True(t, ack.Success())
+
+	pending, found := k.GetPendingChanges(ctx)
+	require.True(t, found)
+	require.Equal(t, uint64(3), pending.ValsetUpdateID)
+	require.Equal(
+		t, []abci.ValidatorUpdate{withPower(first, 0), second, third}, pending.ValidatorUpdates,
+	)
+
+	// the pending changes are applied and cleared at the end of the block.
+	require.Equal(
+		t, []abci.ValidatorUpdate{withPower(first, 0), second, third}, k.EndBlock(ctx),
+	)
+	_, found = k.GetPendingChanges(ctx)
+	require.False(t, found)
+}
+
+func TestOnRecvVSCPacketUnknownChannel(t *testing.T) {
+	k, ctx, mocks := keepertest.SubscriberKeeper(t)
+	data := commontypes.ValidatorSetChangePacketData{
+		ValidatorUpdates: []abci.ValidatorUpdate{newValidatorUpdate(t, 100)},
+		ValsetUpdateID:   1,
+	}
+	// without a channel to the coordinator.
+	ack := k.OnRecvVSCPacket(ctx, channeltypes.Packet{DestinationChannel: testChannelID}, data)
+	require.False(t, ack.Success())
+
+	// on a channel other than the one to the coordinator.
+	setupCoordinatorChannel(k, ctx, mocks)
+	ack = k.OnRecvVSCPacket(ctx, channeltypes.Packet{DestinationChannel: "channel-9"}, data)
+	require.False(t, ack.Success())
+	_, found := k.GetPendingChanges(ctx)
+	require.False(t, found)
+}
@@ -0,0 +1,220 @@
+package dogfood
+
+import (
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+	commontypes "github.com/ExocoreNetwork/exocore/x/appchain/common/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/v7/modules/core/05-port/types"
+	host "github.com/cosmos/ibc-go/v7/modules/core/24-host"
+	ibcexported "github.com/cosmos/ibc-go/v7/modules/core/exported"
+)
+
+// interface guard
+var _ porttypes.IBCModule = AppModule{}
+
+// OnChanOpenInit implements the IBCModule interface. It validates the channel parameters,
+// verifies that the channel is built on top of the coordinator client and claims the
+// channel capability.
+func (am AppModule) OnChanOpenInit(
+	ctx sdk.Context,
+	order channeltypes.Order,
+	connectionHops []string,
+	portID string,
+	channelID string,
+	chanCap *capabilitytypes.Capability,
+	counterparty channeltypes.Counterparty,
+	version string,
+) (string, error) {
+	// an empty version is allowed by IBC; it means that the default version is proposed
+	if strings.TrimSpace(version) == "" {
+		version = commontypes.Version
+	}
+	if err := validateChannelParams(
+		ctx, am.keeper.GetPort(ctx), order, portID, version,
+	); err != nil {
+		return "", err
+	}
+	if counterparty.PortId != commontypes.CoordinatorPortID {
+		return "", errorsmod.Wrapf(
+			porttypes.ErrInvalidPort,
+			"invalid counterparty port: %s, expected %s",
+			counterparty.PortId, commontypes.CoordinatorPortID,
+		)
+	}
+	if err := am.keeper.VerifyCoordinatorChain(ctx, connectionHops); err != nil {
+		return "", err
+	}
+	if err := am.keeper.ClaimCapability(
+		ctx, chanCap, host.ChannelCapabilityPath(portID, channelID),
+	); err != nil {
+		return "", err
+	}
+	return version, nil
+}
+
+// OnChanOpenTry implements the IBCModule interface. The channel handshake must be
+// initiated by the subscriber chain, so it is rejected here.
+func (am AppModule) OnChanOpenTry(
+	sdk.Context,
+	channeltypes.Order,
+	[]string,
+	string,
+	string,
+	*capabilitytypes.Capability,
+	channeltypes.Counterparty,
+	string,
+) (string, error) {
+	return "", errorsmod.Wrap(
+		commontypes.ErrInvalidChannelFlow,
+		"channel handshake must be initiated by the subscriber chain",
+	)
+}
+
+// OnChanOpenAck implements the IBCModule interface. It parses the handshake metadata sent
+// by the coordinator and records the channel as the one to the coordinator chain.
+func (am AppModule) OnChanOpenAck(
+	ctx sdk.Context,
+	_ string,
+	channelID string,
+	_ string,
+	counterpartyMetadata string,
+) error {
+	var md commontypes.HandshakeMetadata
+	if err := (&md).Unmarshal([]byte(counterpartyMetadata)); err != nil {
+		return errorsmod.Wrapf(
+			commontypes.ErrInvalidHandshakeMetadata,
+			"error unmarshalling handshake metadata: %v", err,
+		)
+	}
+	if md.Version != commontypes.Version {
+		return errorsmod.Wrapf(
+			commontypes.ErrInvalidVersion,
+			"invalid counterparty version: %s, expected %s",
+			md.Version, commontypes.Version,
+		)
+	}
+	if strings.TrimSpace(md.CoordinatorFeePoolAddr) == "" {
+		return errorsmod.Wrap(
+			commontypes.ErrInvalidHandshakeMetadata,
+			"coordinator fee pool address cannot be empty",
+		)
+	}
+	return am.keeper.SetCoordinatorChain(ctx, channelID, md)
+}
+
+// OnChanOpenConfirm implements the IBCModule interface. It is only called on the channel
+// end which did not initiate the handshake, that is, the coordinator.
+func (am AppModule) OnChanOpenConfirm(
+	sdk.Context,
+	string,
+	string,
+) error {
+	return errorsmod.Wrap(
+		commontypes.ErrInvalidChannelFlow,
+		"channel handshake must be initiated by the subscriber chain",
+	)
+}
+
+// OnChanCloseInit implements the IBCModule interface. Users are not allowed to close the
+// channel to the coordinator chain.
+func (am AppModule) OnChanCloseInit(
+	sdk.Context,
+	string,
+	string,
+) error {
+	return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "user cannot close channel")
+}
+
+// OnChanCloseConfirm implements the IBCModule interface.
+func (am AppModule) OnChanCloseConfirm(
+	sdk.Context,
+	string,
+	string,
+) error {
+	return nil
+}
+
+// OnRecvPacket implements the IBCModule interface. It handles the validator set change
+// packets sent by the coordinator chain.
+func (am AppModule) OnRecvPacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	_ sdk.AccAddress,
+) ibcexported.Acknowledgement {
+	var data commontypes.ValidatorSetChangePacketData
+	if err := (&data).Unmarshal(packet.GetData()); err != nil {
+		return channeltypes.NewErrorAcknowledgement(
+			errorsmod.Wrapf(
+				commontypes.ErrInvalidPacketData,
+				"cannot unmarshal validator set change packet data: %v", err,
+			),
+		)
+	}
+	if err := data.Validate(); err != nil {
+		return channeltypes.NewErrorAcknowledgement(err)
+	}
+	return am.keeper.OnRecvVSCPacket(ctx, packet, data)
+}
+
+// OnAcknowledgementPacket implements the IBCModule interface. It handles the acknowledgement
+// of the VSC matured packets sent to the coordinator chain.
+func (am AppModule) OnAcknowledgementPacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	acknowledgement []byte,
+	_ sdk.AccAddress,
+) error {
+	var ack channeltypes.Acknowledgement
+	if err := channeltypes.SubModuleCdc.UnmarshalJSON(acknowledgement, &ack); err != nil {
+		return errorsmod.Wrapf(
+			sdkerrors.ErrUnknownRequest,
+			"cannot unmarshal acknowledgement: %v", err,
+		)
+	}
+	return am.keeper.OnAcknowledgementPacket(ctx, packet, ack)
+}
+
+// OnTimeoutPacket implements the IBCModule interface. It handles the timeout of the VSC
+// matured packets sent to the coordinator chain.
+func (am AppModule) OnTimeoutPacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	_ sdk.AccAddress,
+) error {
+	return am.keeper.OnTimeoutPacket(ctx, packet)
+}
+
+// validateChannelParams validates the parameters of the channel being opened.
+func validateChannelParams(
+	_ sdk.Context,
+	boundPort string,
+	order channeltypes.Order,
+	portID string,
+	version string,
+) error {
+	// only ordered channels are allowed, so that the validator set changes are applied in
+	// the order they are sent.
+	if order != channeltypes.ORDERED {
+		return errorsmod.Wrapf(
+			channeltypes.ErrInvalidChannelOrdering,
+			"expected %s channel, got %s ", channeltypes.ORDERED, order,
+		)
+	}
+	if portID != boundPort {
+		return errorsmod.Wrapf(
+			porttypes.ErrInvalidPort, "invalid port: %s, expected %s", portID, boundPort,
+		)
+	}
+	if version != commontypes.Version {
+		return errorsmod.Wrapf(
+			commontypes.ErrInvalidVersion,
+			"invalid version: %s, expected %s", version, commontypes.Version,
+		)
+	}
+	return nil
+}
@@ -0,0 +1,228 @@
+package dogfood_test
+
+import (
+	"testing"
+
+	keepertest "github.com/ExocoreNetwork/exocore/testutil/keeper"
+	commontypes "github.com/ExocoreNetwork/exocore/x/appchain/common/types"
+	subscriber "github.com/ExocoreNetwork/exocore/x/appchain/subscriber"
+	"github.com/ExocoreNetwork/exocore/x/appchain/subscriber/keeper"
+	"github.com/ExocoreNetwork/exocore/x/appchain/subscriber/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
+	clienttypes "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
+	conntypes "github.com/cosmos/ibc-go/v7/modules/core/03-connection/types"
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/v7/modules/core/05-port/types"
+	host "github.com/cosmos/ibc-go/v7/modules/core/24-host"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testCoordinatorChainID = "exocoretestnet_233-1"
+	testConnectionID       = "connection-0"
+	testChannelID          = "channel-0"
+	testFeePoolAddr        = "exo1feepool"
+)
+
+// setupSubscriber returns the module of a subscriber whose coordinator client exists but whose
+// channel handshake has not been started yet.
+func setupSubscriber(t *testing.T) (subscriber.AppModule, keeper.Keeper, sdk.Context, *keepertest.AppchainMocks) {
+	k, ctx, mocks := keepertest.SubscriberKeeper(t)
+	clientID := mocks.IBC.AddConnection(testConnectionID, testCoordinatorChainID)
+	k.SetCoordinatorClientID(ctx, clientID)
+	am := subscriber.NewAppModule(codec.NewProtoCodec(codectypes.NewInterfaceRegistry()), k)
+	return am, k, ctx, mocks
+}
+
+func coordinatorCounterparty() channeltypes.Counterparty {
+	return channeltypes.NewCounterparty(commontypes.CoordinatorPortID, "")
+}
+
+func handshakeMetadata(t *testing.T, md commontypes.HandshakeMetadata) string {
+	bz, err := (&md).Marshal()
+	require.NoError(t, err)
+	return string(bz)
+}
+
+func TestOnChanOpenInit(t *testing.T) {
+	cases := []struct {
+		name           string
+		order          channeltypes.Order
+		connectionHops []string
+		portID         string
+		counterparty   channeltypes.Counterparty
+		version        string
+		malleate       func(k keeper.Keeper, ctx sdk.Context, mocks *keepertest.AppchainMocks)
+		expError       error
+	}{
+		{
+			name:    "valid",
+			version: commontypes.Version,
+		},
+		{
+			name: "empty version defaults",
+		},
+		{
+			name:     "unordered channel",
+			order:    channeltypes.UNORDERED,
+			expError: channeltypes.ErrInvalidChannelOrdering,
+		},
+		{
+			name:     "wrong port",
+			portID:   "transfer",
+			expError: porttypes.ErrInvalidPort,
+		},
+		{
+			name:     "wrong version",
+			version:  "2",
+			expError: commontypes.ErrInvalidVersion,
+		},
+		{
+			name:         "wrong counterparty port",
+			counterparty: channeltypes.NewCounterparty("transfer", ""),
+			expError:     porttypes.ErrInvalidPort,
+		},
+		{
+			name:           "multiple connection hops",
+			connectionHops: []string{testConnectionID, "connection-1"},
+			expError:       channeltypes.ErrTooManyConnectionHops,
+		},
+		{
+			name:           "unknown connection",
+			connectionHops: []string{"connection-9"},
+			expError:       conntypes.ErrConnectionNotFound,
+		},
+		{
+			name:           "client mismatch",
+			connectionHops: []string{"connection-1"},
+			malleate: func(_ keeper.Keeper, _ sdk.Context, mocks *keepertest.AppchainMocks) {
+				// another client of the coordinator, which is not the one from the genesis.
+				mocks.IBC.AddConnection("connection-1", testCoordinatorChainID)
+			},
+			expError: clienttypes.ErrInvalidClient,
+		},
+		{
+			name: "duplicate channel",
+			malleate: func(k keeper.Keeper, ctx sdk.Context, _ *keepertest.AppchainMocks) {
+				k.SetCoordinatorChannel(ctx, "channel-1")
+			},
+			expError: commontypes.ErrDuplicateChannel,
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			am, k, ctx, mocks := setupSubscriber(t)
+			if tc.malleate != nil {
+				tc.malleate(k, ctx, mocks)
+			}
+			order := channeltypes.ORDERED
+			if tc.order != channeltypes.NONE {
+				order = tc.order
+			}
+			connectionHops := []string{testConnectionID}
+			if tc.connectionHops != nil {
+				connectionHops = tc.connectionHops
+			}
+			portID := types.PortID
+			if tc.portID != "" {
+				portID = tc.portID
+			}
+			counterparty := coordinatorCounterparty()
+			if tc.counterparty.PortId != "" {
+				counterparty = tc.counterparty
+			}
+			version, err := am.OnChanOpenInit(
+				ctx, order, connectionHops, portID, testChannelID,
+				capabilitytypes.NewCapability(100), counterparty, tc.version,
+			)
+			_, found := mocks.IBC.GetCapability(ctx, host.ChannelCapabilityPath(portID, testChannelID))
+			if tc.expError != nil {
+				require.ErrorIs(t, err, tc.expError)
+				require.False(t, found)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, commontypes.Version, version)
+			require.True(t, found)
+			// the channel is recorded only on ack.
+			_, found = k.GetCoordinatorChannel(ctx)
+			require.False(t, found)
+		})
+	}
+}
+
+func TestOnChanOpenAck(t *testing.T) {
+	cases := []struct {
+		name     string
+		metadata string
+		expError error
+	}{
+		{
+			name: "valid",
+			metadata: handshakeMetadata(t, commontypes.HandshakeMetadata{
+				CoordinatorFeePoolAddr: testFeePoolAddr,
+				Version:                commontypes.Version,
+			}),
+		},
+		{
+			name:     "invalid metadata",
+			metadata: "invalid",
+			expError: commontypes.ErrInvalidHandshakeMetadata,
+		},
+		{
+			name: "wrong version",
+			metadata: handshakeMetadata(t, commontypes.HandshakeMetadata{
+				CoordinatorFeePoolAddr: testFeePoolAddr,
+				Version:                "2",
+			}),
+			expError: commontypes.ErrInvalidVersion,
+		},
+		{
+			name: "empty fee pool address",
+			metadata: handshakeMetadata(t, commontypes.HandshakeMetadata{
+				Version: commontypes.Version,
+			}),
+			expError: commontypes.ErrInvalidHandshakeMetadata,
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			am, k, ctx, mocks := setupSubscriber(t)
+			mocks.IBC.OpenChannel(types.PortID, testChannelID, testConnectionID, coordinatorCounterparty())
+			err := am.OnChanOpenAck(ctx, types.PortID, testChannelID, "channel-0", tc.metadata)
+			channelID, found := k.GetCoordinatorChannel(ctx)
+			if tc.expError != nil {
+				require.ErrorIs(t, err, tc.expError)
+				require.False(t, found)
+				require.Empty(t, k.GetParams(ctx).CoordinatorFeePoolAddrStr)
+				return
+			}
+			require.NoError(t, err)
+			require.True(t, found)
+			require.Equal(t, testChannelID, channelID)
+			require.Equal(t, testFeePoolAddr, k.GetParams(ctx).CoordinatorFeePoolAddrStr)
+
+			// a second channel to the coordinator is rejected.
+			mocks.IBC.OpenChannel(types.PortID, "channel-1", testConnectionID, coordinatorCounterparty())
+			err = am.OnChanOpenAck(ctx, types.PortID, "channel-1", "channel-1", tc.metadata)
+			require.ErrorIs(t, err, commontypes.ErrDuplicateChannel)
+			channelID, _ = k.GetCoordinatorChannel(ctx)
+			require.Equal(t, testChannelID, channelID)
+		})
+	}
+}
+
+func TestHandshakeInitiatedByCoordinatorRejected(t *testing.T) {
+	am, _, ctx, _ := setupSubscriber(t)
+	_, err := am.OnChanOpenTry(
+		ctx, channeltypes.ORDERED, []string{testConnectionID}, types.PortID, testChannelID,
+		capabilitytypes.NewCapability(100), coordinatorCounterparty(), commontypes.Version,
+	)
+	require.ErrorIs(t, err, commontypes.ErrInvalidChannelFlow)
+	require.ErrorIs(
+		t, am.OnChanOpenConfirm(ctx, types.PortID, testChannelID), commontypes.ErrInvalidChannelFlow,
+	)
+}
@@ -1,7 +1,9 @@
 package types
 
 import (
+	errorsmod "cosmossdk.io/errors"
 	commontypes "github.com/ExocoreNetwork/exocore/x/appchain/common/types"
+	host "github.com/cosmos/ibc-go/v7/modules/core/24-host"
 )
 
 // DefaultGenesis returns the default genesis state.
@@ -21,5 +23,85 @@ func (gs GenesisState) Validate() error {
 	if err := gs.Params.Validate(); err != nil {
 		return err
 	}
+	if gs.CoordinatorChannelID == "" {
+		// the validator set changes are only received over the channel
+		if gs.PendingChanges != nil || len(gs.MaturingVscPackets) > 0 {
+			return errorsmod.Wrap(
+				commontypes.ErrInvalidGenesis,
+				"validator set changes cannot be received without a coordinator channel",
+			)
+		}
+	}
+	if gs.CoordinatorClientID == "" {
+		// a new chain, whose client to the coordinator is created from the coordinator info
+		if gs.CoordinatorChannelID != "" {
+			return errorsmod.Wrap(
+				commontypes.ErrInvalidGenesis,
+				"coordinator channel ID must be empty when the client ID is empty",
+			)
+		}
+		if gs.Coordinator.ClientState == nil {
+			return nil
+		}
+		if err := gs.Coordinator.ClientState.Validate(); err != nil {
+			return errorsmod.Wrapf(
+				commontypes.ErrInvalidGenesis, "invalid coordinator client state: %s", err,
+			)
+		}
+		if gs.Coordinator.ConsensusState == nil {
+			return errorsmod.Wrap(
+				commontypes.ErrInvalidGenesis, "coordinator consensus state must be provided",
+			)
+		}
+		if err := gs.Coordinator.ConsensusState.ValidateBasic(); err != nil {
+			return errorsmod.Wrapf(
+				commontypes.ErrInvalidGenesis, "invalid coordinator consensus state: %s", err,
+			)
+		}
+		if len(gs.Coordinator.InitialValSet) == 0 {
+			return errorsmod.Wrap(
+				commontypes.ErrInvalidGenesis, "initial validator set cannot be empty",
+			)
+		}
+		return nil
+	}
+	// a restarted chain, whose client to the coordinator already exists
+	if err := host.ClientIdentifierValidator(gs.CoordinatorClientID); err != nil {
+		return errorsmod.Wrapf(
+			commontypes.ErrInvalidGenesis, "invalid coordinator client ID: %s", err,
+		)
+	}
+	if gs.CoordinatorChannelID != "" {
+		if err := host.ChannelIdentifierValidator(gs.CoordinatorChannelID); err != nil {
+			return errorsmod.Wrapf(
+				commontypes.ErrInvalidGenesis, "invalid coordinator channel ID: %s", err,
+			)
+		}
+	}
+	if gs.PendingChanges != nil && gs.PendingChanges.ValsetUpdateID == 0 {
+		return errorsmod.Wrap(
+			commontypes.ErrInvalidGenesis, "pending changes cannot have a vscID of 0",
+		)
+	}
+	vscIDs := make(map[uint64]struct{}, len(gs.MaturingVscPackets))
+	for _, packet := range gs.MaturingVscPackets {
+		if packet.VscID == 0 {
+			return errorsmod.Wrap(
+				commontypes.ErrInvalidGenesis, "maturing packet cannot have a vscID of 0",
+			)
+		}
+		if _, found := vscIDs[packet.VscID]; found {
+			return errorsmod.Wrapf(
+				commontypes.ErrInvalidGenesis, "duplicate maturing packet %d", packet.VscID,
+			)
+		}
+		vscIDs[packet.VscID] = struct{}{}
+		if packet.MaturityTime.UnixNano() <= 0 {
+			return errorsmod.Wrapf(
+				commontypes.ErrInvalidGenesis,
+				"invalid maturity time of packet %d", packet.VscID,
+			)
+		}
+	}
 	return nil
 }
@@ -8,15 +8,19 @@ import (
 	types "github.com/ExocoreNetwork/exocore/x/appchain/common/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -28,6 +32,21 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 type GenesisState struct {
 	// Params is the parameters for the appchain subscriber module.
 	Params types.SubscriberParams `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	// coordinator is the coordinator information, as generated by the coordinator
+	// module. It is used to create the client of the coordinator chain.
+	Coordinator types.CoordinatorInfo `protobuf:"bytes,2,opt,name=coordinator,proto3" json:"coordinator"`
+	// coordinator_client_id is the client ID of the coordinator chain. It is empty
+	// for a new chain, in which case the client is created from `coordinator`.
+	CoordinatorClientID string `protobuf:"bytes,3,opt,name=coordinator_client_id,json=coordinatorClientId,proto3" json:"coordinator_client_id,omitempty"`
+	// coordinator_channel_id is the channel ID to the coordinator chain. It is
+	// empty until the channel handshake completes.
+	CoordinatorChannelID string `protobuf:"bytes,4,opt,name=coordinator_channel_id,json=coordinatorChannelId,proto3" json:"coordinator_channel_id,omitempty"`
+	// pending_changes is the validator set changes, received from the coordinator and
+	// yet to be applied. It is nil if there are none.
+	PendingChanges *types.ValidatorSetChangePacketData `protobuf:"bytes,5,opt,name=pending_changes,json=pendingChanges,proto3" json:"pending_changes,omitempty"`
+	// maturing_vsc_packets is the list of the validator set changes received from the
+	// coordinator, whose maturity is yet to be notified to it.
+	MaturingVscPackets []MaturingVscPacket `protobuf:"bytes,6,rep,name=maturing_vsc_packets,json=maturingVscPackets,proto3" json:"maturing_vsc_packets"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -70,8 +89,100 @@ func (m *GenesisState) GetParams() types.SubscriberParams {
 	return types.SubscriberParams{}
 }
 
+func (m *GenesisState) GetCoordinator() types.CoordinatorInfo {
+	if m != nil {
+		return m.Coordinator
+	}
+	return types.CoordinatorInfo{}
+}
+
+func (m *GenesisState) GetCoordinatorClientID() string {
+	if m != nil {
+		return m.CoordinatorClientID
+	}
+	return ""
+}
+
+func (m *GenesisState) GetCoordinatorChannelID() string {
+	if m != nil {
+		return m.CoordinatorChannelID
+	}
+	return ""
+}
+
+func (m *GenesisState) GetPendingChanges() *types.ValidatorSetChangePacketData {
+	if m != nil {
+		return m.PendingChanges
+	}
+	return nil
+}
+
+func (m *GenesisState) GetMaturingVscPackets() []MaturingVscPacket {
+	if m != nil {
+		return m.MaturingVscPackets
+	}
+	return nil
+}
+
+// MaturingVscPacket is a validator set change received from the coordinator, along with
+// the time at which it matures.
+type MaturingVscPacket struct {
+	// vsc_id is the ID of the validator set change.
+	VscID uint64 `protobuf:"varint,1,opt,name=vsc_id,json=vscId,proto3" json:"vsc_id,omitempty"`
+	// maturity_time is the time at which the validator set change matures.
+	MaturityTime time.Time `protobuf:"bytes,2,opt,name=maturity_time,json=maturityTime,proto3,stdtime" json:"maturity_time"`
+}
+
+func (m *MaturingVscPacket) Reset()         { *m = MaturingVscPacket{} }
+func (m *MaturingVscPacket) String() string { return proto.CompactTextString(m) }
+func (*MaturingVscPacket) ProtoMessage()    {}
+func (*MaturingVscPacket) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f608de439fd2c5db, []int{1}
+}
+func (m *MaturingVscPacket) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MaturingVscPacket) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MaturingVscPacket.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MaturingVscPacket) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MaturingVscPacket.Merge(m, src)
+}
+func (m *MaturingVscPacket) XXX_Size() int {
+	return m.Size()
+}
+func (m *MaturingVscPacket) XXX_DiscardUnknown() {
+	xxx_messageInfo_MaturingVscPacket.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MaturingVscPacket proto.InternalMessageInfo
+
+func (m *MaturingVscPacket) GetVscID() uint64 {
+	if m != nil {
+		return m.VscID
+	}
+	return 0
+}
+
+func (m *MaturingVscPacket) GetMaturityTime() time.Time {
+	if m != nil {
+		return m.MaturityTime
+	}
+	return time.Time{}
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "exocore.appchain.subscriber.v1.GenesisState")
+	proto.RegisterType((*MaturingVscPacket)(nil), "exocore.appchain.subscriber.v1.MaturingVscPacket")
 }
 
 func init() {
@@ -79,22 +190,40 @@ func init() {
 }
 
 var fileDescriptor_f608de439fd2c5db = []byte{
-	// 232 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xd2, 0x49, 0xad, 0xc8, 0x4f,
-	0xce, 0x2f, 0x4a, 0xd5, 0x4f, 0x2c, 0x28, 0x48, 0xce, 0x48, 0xcc, 0xcc, 0xd3, 0x2f, 0x2e, 0x4d,
-	0x2a, 0x4e, 0x2e, 0xca, 0x4c, 0x4a, 0x2d, 0xd2, 0x2f, 0x33, 0xd4, 0x4f, 0x4f, 0xcd, 0x4b, 0x2d,
-	0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x92, 0x83, 0xaa, 0xd6, 0x83, 0xa9, 0xd6,
-	0x43, 0xa8, 0xd6, 0x2b, 0x33, 0x94, 0x52, 0xc7, 0x30, 0x2d, 0x39, 0x3f, 0x37, 0x37, 0x3f, 0x0f,
-	0x64, 0x12, 0x84, 0x05, 0x31, 0x48, 0x4a, 0x24, 0x3d, 0x3f, 0x3d, 0x1f, 0xcc, 0xd4, 0x07, 0xb1,
-	0x20, 0xa2, 0x4a, 0x51, 0x5c, 0x3c, 0xee, 0x10, 0xfb, 0x82, 0x4b, 0x12, 0x4b, 0x52, 0x85, 0xbc,
-	0xb8, 0xd8, 0x0a, 0x12, 0x8b, 0x12, 0x73, 0x8b, 0x25, 0x18, 0x15, 0x18, 0x35, 0xb8, 0x8d, 0x74,
-	0xf4, 0x30, 0xec, 0x87, 0x9a, 0x5a, 0x66, 0xa8, 0x17, 0x0c, 0x77, 0x49, 0x00, 0x58, 0x8f, 0x13,
-	0xcb, 0x89, 0x7b, 0xf2, 0x0c, 0x41, 0x50, 0x13, 0x9c, 0xc2, 0x4f, 0x3c, 0x92, 0x63, 0xbc, 0xf0,
-	0x48, 0x8e, 0xf1, 0xc1, 0x23, 0x39, 0xc6, 0x09, 0x8f, 0xe5, 0x18, 0x2e, 0x3c, 0x96, 0x63, 0xb8,
-	0xf1, 0x58, 0x8e, 0x21, 0xca, 0x36, 0x3d, 0xb3, 0x24, 0xa3, 0x34, 0x09, 0x64, 0x94, 0xbe, 0x2b,
-	0xc4, 0x7c, 0xbf, 0xd4, 0x92, 0xf2, 0xfc, 0xa2, 0x6c, 0x7d, 0x98, 0x77, 0x2a, 0xb0, 0x06, 0x4f,
-	0x49, 0x65, 0x41, 0x6a, 0x71, 0x12, 0x1b, 0xd8, 0xed, 0xc6, 0x80, 0x00, 0x00, 0x00, 0xff, 0xff,
-	0x3c, 0xba, 0x2f, 0x3b, 0x4a, 0x01, 0x00, 0x00,
+	// 516 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x93, 0x41, 0x6f, 0xd3, 0x30,
+	0x14, 0xc7, 0x1b, 0xd6, 0x56, 0xcc, 0x1d, 0x20, 0xbc, 0x02, 0x51, 0x0f, 0x49, 0xb5, 0x0b, 0x95,
+	0x98, 0x1c, 0x75, 0x5c, 0xb8, 0x70, 0x69, 0x8b, 0x50, 0x40, 0x4c, 0x53, 0x8a, 0x8a, 0xc4, 0xa5,
+	0x72, 0x1d, 0x2f, 0xb5, 0xd6, 0xd8, 0x51, 0xec, 0x96, 0xed, 0xc6, 0x47, 0xd8, 0x99, 0x4f, 0xb4,
+	0xe3, 0x8e, 0x9c, 0x0a, 0x4a, 0xbf, 0x08, 0xb2, 0x9d, 0xb2, 0x42, 0xa7, 0xde, 0x9c, 0xf7, 0xfe,
+	0xef, 0xf7, 0xde, 0x73, 0xfe, 0x06, 0xc7, 0xf4, 0x52, 0x10, 0x91, 0xd3, 0x00, 0x67, 0x19, 0x99,
+	0x62, 0xc6, 0x03, 0x39, 0x9f, 0x48, 0x92, 0xb3, 0x09, 0xcd, 0x83, 0x45, 0x37, 0x48, 0x28, 0xa7,
+	0x92, 0x49, 0x94, 0xe5, 0x42, 0x09, 0xe8, 0x95, 0x6a, 0xb4, 0x56, 0xa3, 0x3b, 0x35, 0x5a, 0x74,
+	0x5b, 0x2f, 0xb7, 0x68, 0x44, 0xa4, 0xa9, 0xe0, 0x9a, 0x64, 0x4f, 0x16, 0xd4, 0x6a, 0x26, 0x22,
+	0x11, 0xe6, 0x18, 0xe8, 0x53, 0x19, 0xf5, 0x13, 0x21, 0x92, 0x19, 0x0d, 0xcc, 0xd7, 0x64, 0x7e,
+	0x1e, 0x28, 0x96, 0x52, 0xa9, 0x70, 0x9a, 0x59, 0xc1, 0xd1, 0x8f, 0x2a, 0x38, 0x78, 0x6f, 0x27,
+	0x1a, 0x2a, 0xac, 0x28, 0xfc, 0x00, 0xea, 0x19, 0xce, 0x71, 0x2a, 0x5d, 0xa7, 0xed, 0x74, 0x1a,
+	0x27, 0xc7, 0x68, 0x6b, 0xc2, 0xb2, 0xef, 0xa2, 0x8b, 0x86, 0x7f, 0x67, 0x3d, 0x33, 0x35, 0xbd,
+	0xea, 0xcd, 0xd2, 0xaf, 0x44, 0x25, 0x01, 0x0e, 0x41, 0x83, 0x08, 0x91, 0xc7, 0x8c, 0x63, 0x25,
+	0x72, 0xf7, 0x81, 0x01, 0xbe, 0xda, 0x05, 0xec, 0xdf, 0xc9, 0x43, 0x7e, 0x2e, 0x4a, 0xde, 0x26,
+	0x05, 0x7e, 0x04, 0xcf, 0x36, 0x3e, 0xc7, 0x64, 0xc6, 0x28, 0x57, 0x63, 0x16, 0xbb, 0x7b, 0x6d,
+	0xa7, 0xb3, 0xdf, 0x7b, 0x51, 0x2c, 0xfd, 0xc3, 0x0d, 0x4c, 0xdf, 0xe4, 0xc3, 0x41, 0x74, 0x48,
+	0xb6, 0x82, 0x31, 0x3c, 0x05, 0xcf, 0xff, 0x81, 0x4d, 0x31, 0xe7, 0x74, 0xa6, 0x69, 0x55, 0x43,
+	0x73, 0x8b, 0xa5, 0xdf, 0xdc, 0xa4, 0x59, 0x41, 0x38, 0x88, 0x9a, 0x64, 0x3b, 0x1a, 0x43, 0x0c,
+	0x9e, 0x64, 0x94, 0xc7, 0x8c, 0x27, 0x86, 0x95, 0x50, 0xe9, 0xd6, 0xcc, 0xd6, 0x6f, 0x76, 0x6d,
+	0x3d, 0xc2, 0x33, 0x16, 0x6b, 0xd0, 0x90, 0xaa, 0xbe, 0x29, 0x3b, 0xc3, 0xe4, 0x82, 0xaa, 0x01,
+	0x56, 0x38, 0x7a, 0x5c, 0x02, 0x6d, 0x42, 0x42, 0x06, 0x9a, 0x29, 0x56, 0xf3, 0x5c, 0xf7, 0x58,
+	0x48, 0x32, 0xce, 0x8c, 0x54, 0xba, 0xf5, 0xf6, 0x5e, 0xa7, 0x71, 0xd2, 0x45, 0xbb, 0x0d, 0x85,
+	0x3e, 0x95, 0xb5, 0x23, 0x49, 0x6c, 0x93, 0xf2, 0x8e, 0x61, 0xfa, 0x7f, 0x42, 0x1e, 0x7d, 0x77,
+	0xc0, 0xd3, 0x2d, 0x3d, 0x6c, 0x83, 0xba, 0xee, 0xcb, 0x62, 0xe3, 0x90, 0x6a, 0x6f, 0xbf, 0x58,
+	0xfa, 0xb5, 0x91, 0x24, 0xe1, 0x20, 0xaa, 0x2d, 0x24, 0x09, 0x63, 0x18, 0x82, 0x47, 0x96, 0xa6,
+	0xae, 0xc6, 0xda, 0x70, 0xe5, 0x9f, 0x6f, 0x21, 0xeb, 0x46, 0xb4, 0x76, 0x23, 0xfa, 0xbc, 0x76,
+	0x63, 0xef, 0xa1, 0x1e, 0xe2, 0xfa, 0x97, 0xef, 0x44, 0x07, 0xeb, 0x52, 0x9d, 0xec, 0x7d, 0xb9,
+	0x29, 0x3c, 0xe7, 0xb6, 0xf0, 0x9c, 0xdf, 0x85, 0xe7, 0x5c, 0xaf, 0xbc, 0xca, 0xed, 0xca, 0xab,
+	0xfc, 0x5c, 0x79, 0x95, 0xaf, 0x6f, 0x13, 0xa6, 0xa6, 0xf3, 0x89, 0xbe, 0xc6, 0xe0, 0x9d, 0xdd,
+	0xf9, 0x94, 0xaa, 0x6f, 0x22, 0xbf, 0x08, 0xd6, 0x6f, 0xe6, 0xf2, 0xde, 0x37, 0xa8, 0xae, 0x32,
+	0x2a, 0x27, 0x75, 0x33, 0xc4, 0xeb, 0x3f, 0x01, 0x00, 0x00, 0xff, 0xff, 0xf1, 0x3b, 0x4f, 0x5c,
+	0xaf, 0x03, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -117,6 +246,56 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.MaturingVscPackets) > 0 {
+		for iNdEx := len(m.MaturingVscPackets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MaturingVscPackets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.PendingChanges != nil {
+		{
+			size, err := m.PendingChanges.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.CoordinatorChannelID) > 0 {
+		i -= len(m.CoordinatorChannelID)
+		copy(dAtA[i:], m.CoordinatorChannelID)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.CoordinatorChannelID)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.CoordinatorClientID) > 0 {
+		i -= len(m.CoordinatorClientID)
+		copy(dAtA[i:], m.CoordinatorClientID)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.CoordinatorClientID)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	{
+		size, err := m.Coordinator.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
 	{
 		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -130,6 +309,42 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *MaturingVscPacket) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MaturingVscPacket) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MaturingVscPacket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n4, err4 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.MaturityTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.MaturityTime):])
+	if err4 != nil {
+		return 0, err4
+	}
+	i -= n4
+	i = encodeVarintGenesis(dAtA, i, uint64(n4))
+	i--
+	dAtA[i] = 0x12
+	if m.VscID != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.VscID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
 	offset -= sovGenesis(v)
 	base := offset
@@ -149,6 +364,40 @@ func (m *GenesisState) Size() (n int) {
 	_ = l
 	l = m.Params.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	l = m.Coordinator.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = len(m.CoordinatorClientID)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.CoordinatorChannelID)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.PendingChanges != nil {
+		l = m.PendingChanges.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if len(m.MaturingVscPackets) > 0 {
+		for _, e := range m.MaturingVscPackets {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MaturingVscPacket) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.VscID != 0 {
+		n += 1 + sovGenesis(uint64(m.VscID))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.MaturityTime)
+	n += 1 + l + sovGenesis(uint64(l))
 	return n
 }
 
@@ -220,6 +469,275 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Coordinator", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Coordinator.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CoordinatorClientID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CoordinatorClientID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CoordinatorChannelID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CoordinatorChannelID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingChanges", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PendingChanges == nil {
+				m.PendingChanges = &types.ValidatorSetChangePacketData{}
+			}
+			if err := m.PendingChanges.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaturingVscPackets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MaturingVscPackets = append(m.MaturingVscPackets, MaturingVscPacket{})
+			if err := m.MaturingVscPackets[len(m.MaturingVscPackets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MaturingVscPacket) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MaturingVscPacket: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MaturingVscPacket: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VscID", wireType)
+			}
+			m.VscID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VscID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaturityTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.MaturityTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -0,0 +1,189 @@
+package types_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	commontypes "github.com/ExocoreNetwork/exocore/x/appchain/common/types"
+	coordinatortypes "github.com/ExocoreNetwork/exocore/x/appchain/coordinator/types"
+	"github.com/ExocoreNetwork/exocore/x/appchain/subscriber/types"
+	clienttypes "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
+)
+
+func TestValidateGenesis(t *testing.T) {
+	cases := []struct {
+		name      string
+		genesis   *types.GenesisState
+		expResult bool
+		expError  string
+		malleate  func(genesis *types.GenesisState)
+	}{
+		{
+			name:      "valid genesis",
+			genesis:   validGenesis(),
+			expResult: true,
+		},
+		{
+			name:    "channel without client",
+			genesis: validGenesis(),
+			malleate: func(genesis *types.GenesisState) {
+				genesis.CoordinatorChannelID = "channel-0"
+			},
+			expResult: false,
+			expError:  "coordinator channel ID must be empty",
+		},
+		{
+			name:    "client state without consensus state",
+			genesis: validGenesis(),
+			malleate: func(genesis *types.GenesisState) {
+				clientState := coordinatortypes.DefaultParams().TemplateClient
+				clientState.ChainId = "exocoretestnet_233-1"
+				clientState.LatestHeight = clienttypes.NewHeight(1, 1)
+				clientState.TrustingPeriod = genesis.Params.UnbondingPeriod / 2
+				clientState.UnbondingPeriod = genesis.Params.UnbondingPeriod
+				genesis.Coordinator.ClientState = clientState
+			},
+			expResult: false,
+			expError:  "coordinator consensus state must be provided",
+		},
+		{
+			name:    "restarted chain",
+			genesis: validGenesis(),
+			malleate: func(genesis *types.GenesisState) {
+				genesis.CoordinatorClientID = "07-tendermint-0"
+				genesis.CoordinatorChannelID = "channel-0"
+			},
+			expResult: true,
+		},
+		{
+			name:    "invalid client ID",
+			genesis: validGenesis(),
+			malleate: func(genesis *types.GenesisState) {
+				genesis.CoordinatorClientID = "a"
+			},
+			expResult: false,
+			expError:  "invalid coordinator client ID",
+		},
+		{
+			name:    "invalid channel ID",
+			genesis: validGenesis(),
+			malleate: func(genesis *types.GenesisState) {
+				genesis.CoordinatorClientID = "07-tendermint-0"
+				genesis.CoordinatorChannelID = "a"
+			},
+			expResult: false,
+			expError:  "invalid coordinator channel ID",
+		},
+		{
+			name:    "restarted chain with validator set changes",
+			genesis: validGenesis(),
+			malleate: func(genesis *types.GenesisState) {
+				genesis.CoordinatorClientID = "07-tendermint-0"
+				genesis.CoordinatorChannelID = "channel-0"
+				genesis.PendingChanges = &commontypes.ValidatorSetChangePacketData{ValsetUpdateID: 2}
+				genesis.MaturingVscPackets = []types.MaturingVscPacket{
+					{VscID: 1, MaturityTime: time.Unix(1, 0)},
+					{VscID: 2, MaturityTime: time.Unix(2, 0)},
+				}
+			},
+			expResult: true,
+		},
+		{
+			name:    "validator set changes without channel",
+			genesis: validGenesis(),
+			malleate: func(genesis *types.GenesisState) {
+				genesis.CoordinatorClientID = "07-tendermint-0"
+				genesis.MaturingVscPackets = []types.MaturingVscPacket{
+					{VscID: 1, MaturityTime: time.Unix(1, 0)},
+				}
+			},
+			expResult: false,
+			expError:  "validator set changes cannot be received without a coordinator channel",
+		},
+		{
+			name:    "pending changes with a zero vscID",
+			genesis: validGenesis(),
+			malleate: func(genesis *types.GenesisState) {
+				genesis.CoordinatorClientID = "07-tendermint-0"
+				genesis.CoordinatorChannelID = "channel-0"
+				genesis.PendingChanges = &commontypes.ValidatorSetChangePacketData{}
+			},
+			expResult: false,
+			expError:  "pending changes cannot have a vscID of 0",
+		},
+		{
+			name:    "maturing packet with a zero vscID",
+			genesis: validGenesis(),
+			malleate: func(genesis *types.GenesisState) {
+				genesis.CoordinatorClientID = "07-tendermint-0"
+				genesis.CoordinatorChannelID = "channel-0"
+				genesis.MaturingVscPackets = []types.MaturingVscPacket{
+					{MaturityTime: time.Unix(1, 0)},
+				}
+			},
+			expResult: false,
+			expError:  "maturing packet cannot have a vscID of 0",
+		},
+		{
+			name:    "duplicate maturing packet",
+			genesis: validGenesis(),
+			malleate: func(genesis *types.GenesisState) {
+				genesis.CoordinatorClientID = "07-tendermint-0"
+				genesis.CoordinatorChannelID = "channel-0"
+				genesis.MaturingVscPackets = []types.MaturingVscPacket{
+					{VscID: 1, MaturityTime: time.Unix(1, 0)},
+					{VscID: 1, MaturityTime: time.Unix(2, 0)},
+				}
+			},
+			expResult: false,
+			expError:  "duplicate maturing packet 1",
+		},
+		{
+			name:    "invalid maturity time",
+			genesis: validGenesis(),
+			malleate: func(genesis *types.GenesisState) {
+				genesis.CoordinatorClientID = "07-tendermint-0"
+				genesis.CoordinatorChannelID = "channel-0"
+				genesis.MaturingVscPackets = []types.MaturingVscPacket{{VscID: 1}}
+			},
+			expResult: false,
+			expError:  "invalid maturity time of packet 1",
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.malleate != nil {
+				tc.malleate(tc.genesis)
+			}
+			// first, validate the test case itself
+			if tc.expResult && tc.expError != "" {
+				t.Fatal("invalid test case: expected success but got error")
+			} else if !tc.expResult && tc.expError == "" {
+				t.Fatal("invalid test case: expected error but got success")
+			}
+			// then run the test case
+			err := tc.genesis.Validate()
+			if tc.expResult && err != nil {
+				t.Fatalf("expected no error, got %s", err)
+			}
+			if !tc.expResult {
+				if err == nil {
+					t.Fatal("expected error, got none")
+				} else {
+					if !strings.Contains(err.Error(), tc.expError) {
+						t.Fatalf("expected error %q, got %q", tc.expError, err.Error())
+					}
+				}
+			}
+		})
+	}
+}
+
+// validGenesis returns the default genesis state with a reward denomination, which is
+// otherwise left empty by the default params.
+func validGenesis() *types.GenesisState {
+	genesis := types.DefaultGenesis()
+	genesis.Params.RewardDenom = "stake"
+	return genesis
+}
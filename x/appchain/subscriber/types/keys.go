@@ -1,5 +1,12 @@
 package types
 
+import (
+	"time"
+
+	commontypes "github.com/ExocoreNetwork/exocore/x/appchain/common/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
 const (
 	// ModuleName defines the module name
 	ModuleName = "subscriber"
@@ -14,7 +21,7 @@ const (
 	MemStoreKey = "mem_subscriber"
 
 	// PortID is the default port id that module binds to
-	PortID = "subscriber"
+	PortID = commontypes.SubscriberPortID
 
 	SubscriberRedistributeName = "subscriber_redistribute"
 
@@ -22,9 +29,45 @@ const (
 )
 
 const (
+	// ParamsBytePrefix is the prefix for the params key
 	ParamsBytePrefix byte = iota + 1
+	// CoordinatorClientIDBytePrefix is the prefix for the coordinator client ID key
+	CoordinatorClientIDBytePrefix
+	// CoordinatorChannelBytePrefix is the prefix for the coordinator channel ID key
+	CoordinatorChannelBytePrefix
+	// PendingChangesBytePrefix is the prefix for the pending validator set changes key
+	PendingChangesBytePrefix
+	// PacketMaturityTimeBytePrefix is the prefix for the validator set change maturity time key
+	PacketMaturityTimeBytePrefix
 )
 
 func ParamsKey() []byte {
 	return []byte{ParamsBytePrefix}
 }
+
+// CoordinatorClientIDKey returns the key under which the client ID of the coordinator is stored.
+func CoordinatorClientIDKey() []byte {
+	return []byte{CoordinatorClientIDBytePrefix}
+}
+
+// CoordinatorChannelKey returns the key under which the channel ID to the coordinator is stored.
+func CoordinatorChannelKey() []byte {
+	return []byte{CoordinatorChannelBytePrefix}
+}
+
+// PendingChangesKey returns the key under which the validator set changes, received from the
+// coordinator and yet to be applied, are stored.
+func PendingChangesKey() []byte {
+	return []byte{PendingChangesBytePrefix}
+}
+
+// PacketMaturityTimeKey returns the key under which the validator set change with the vscID,
+// maturing at the maturity time, is stored. The time is stored first so that the entries
+// are sorted by it.
+func PacketMaturityTimeKey(maturityTime time.Time, vscID uint64) []byte {
+	return append(
+		[]byte{PacketMaturityTimeBytePrefix},
+		// #nosec G115 // the maturity time is after the block time, which is positive
+		append(sdk.Uint64ToBigEndian(uint64(maturityTime.UnixNano())), sdk.Uint64ToBigEndian(vscID)...)...,
+	)
+}
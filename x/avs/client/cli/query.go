@@ -29,9 +29,12 @@ func GetQueryCmd(_ string) *cobra.Command {
 	cmd.AddCommand(
 		QueryAVSInfo(),
 		QueryAVSAddrByChainID(),
+		QueryAVSAddrByTaskAddr(),
 		QueryTaskInfo(),
 		QueryChallengeInfo(),
 		QuerySubmitTaskResult(),
+		QueryTaskStatisticsRetries(),
+		QueryTasksByStatus(),
 	)
 	return cmd
 }
@@ -96,6 +99,38 @@ func QueryAVSAddrByChainID() *cobra.Command {
 	return cmd
 }
 
+// QueryAVSAddrByTaskAddr returns a command to query AVS address by the task contract address
+func QueryAVSAddrByTaskAddr() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "AVSAddrByTaskAddr <taskAddr>",
+		Short:   "AVSAddrByTaskAddr <taskAddr>",
+		Long:    "AVSAddrByTaskAddr query for AVS address by the task contract address",
+		Example: "exocored query avs AVSAddrByTaskAddr 0x598ACcB5e7F83cA6B19D70592Def9E5b25B978CA",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if !common.IsHexAddress(args[0]) {
+				return xerrors.Errorf("invalid task address,err:%s", types.ErrInvalidAddr)
+			}
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			req := &types.QueryAVSAddrByTaskAddrReq{
+				TaskAddr: args[0],
+			}
+			res, err := queryClient.QueryAVSAddrByTaskAddr(context.Background(), req)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
 func QueryTaskInfo() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:     "TaskInfo <task-address-in-hex> <task-id>",
@@ -193,3 +228,77 @@ func QueryChallengeInfo() *cobra.Command {
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
+
+// QueryTaskStatisticsRetries returns a command to query the tasks whose statistics are
+// waiting to be retried
+func QueryTaskStatisticsRetries() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "TaskStatisticsRetries",
+		Short:   "Query the tasks whose statistics are waiting to be retried",
+		Long:    "Query the tasks whose statistics failed to be aggregated at the end of their statistical period and are queued for a retry",
+		Example: "exocored query avs TaskStatisticsRetries",
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			req := &types.QueryTaskStatisticsRetriesReq{
+				Pagination: pageReq,
+			}
+			res, err := queryClient.QueryTaskStatisticsRetries(context.Background(), req)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "task statistics retries")
+	return cmd
+}
+
+// QueryTasksByStatus returns a command to query the tasks by their status
+func QueryTasksByStatus() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "TasksByStatus <status>",
+		Short:   "Query the tasks by their status",
+		Long:    "Query the tasks by their status, which is one of pending, responded, quorum_reached, quorum_failed, challenged and finalized",
+		Example: "exocored query avs TasksByStatus quorum_reached",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			status, err := types.ParseTaskStatus(args[0])
+			if err != nil {
+				return err
+			}
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			req := &types.QueryTasksByStatusReq{
+				Status:     status,
+				Pagination: pageReq,
+			}
+			res, err := queryClient.QueryTasksByStatus(context.Background(), req)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "tasks")
+	return cmd
+}
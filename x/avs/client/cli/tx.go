@@ -3,6 +3,10 @@ package cli
 import (
 	"encoding/hex"
 	"fmt"
+	"os"
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
 
 	"github.com/spf13/pflag"
 
@@ -20,6 +24,12 @@ const (
 	FlagTaskContractAddress = "task-contract-address"
 	FlagTaskID              = "task-id"
 	FlagPhase               = "phase"
+	FlagTaskName            = "name"
+	FlagTaskHash            = "hash"
+	FlagResponsePeriod      = "response-period"
+	FlagStatisticalPeriod   = "statistical-period"
+	FlagChallengePeriod     = "challenge-period"
+	FlagThresholdPercentage = "threshold-percentage"
 )
 
 // GetTxCmd returns the transaction commands for this module
@@ -33,6 +43,9 @@ func GetTxCmd() *cobra.Command {
 	}
 	txCmd.AddCommand(
 		CmdSubmitTaskResult(),
+		CmdRegisterAVS(),
+		CmdDeRegisterAVS(),
+		CmdRegisterAVSTask(),
 	)
 	return txCmd
 }
@@ -123,3 +136,168 @@ func newBuildMsg(
 	}
 	return msg
 }
+
+// CmdRegisterAVS returns a CLI command handler for registering an AVS. The AVS is registered
+// at the hex form of the sender's address.
+func CmdRegisterAVS() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "register-avs [avs-info-file]",
+		Short: "register an avs with the information in the JSON file",
+		Long: `Register an AVS with the information in the JSON file. The AVS is registered at the hex
+form of the sender's address, and the sender must be included in the avs_owner_address list.
+Example file:
+{
+  "name": "example avs",
+  "task_addr": "0x3e108c058e8066da635321dc3018294ca82ddedf",
+  "slash_addr": "0x3e108c058e8066da635321dc3018294ca82ddedf",
+  "avs_owner_address": ["exo18cggcpvwspnd5c6ny8wrqxpffj5zmhklprtnph"],
+  "asset_ids": ["0xdac17f958d2ee523a2206206994597c13d831ec7_0x65"],
+  "epoch_identifier": "hour",
+  "avs_reward": "0.1",
+  "avs_slash": "0.1"
+}`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			bz, err := os.ReadFile(args[0])
+			if err != nil {
+				return err
+			}
+			info := &types.AVSInfo{}
+			if err := clientCtx.Codec.UnmarshalJSON(bz, info); err != nil {
+				return err
+			}
+
+			msg := &types.RegisterAVSReq{
+				FromAddress: clientCtx.GetFromAddress().String(),
+				Info:        info,
+			}
+			// this calls ValidateBasic internally so we don't need to do that.
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	// transaction level flags from the SDK
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdDeRegisterAVS returns a CLI command handler for deregistering an AVS.
+func CmdDeRegisterAVS() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "deregister-avs [avs-address] [avs-name]",
+		Short: "deregister an avs, the sender must be an owner of the avs",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.DeRegisterAVSReq{
+				FromAddress: clientCtx.GetFromAddress().String(),
+				Info: &types.AVSInfo{
+					AvsAddress: args[0],
+					Name:       args[1],
+				},
+			}
+			// this calls ValidateBasic internally so we don't need to do that.
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	// transaction level flags from the SDK
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdRegisterAVSTask returns a CLI command handler for creating a task of an AVS.
+func CmdRegisterAVSTask() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "register-avs-task",
+		Short: "create a task for the avs that the task contract belongs to",
+		Long: "Create a task for the avs that the task contract belongs to. The sender must be " +
+			"an owner of the avs.",
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg, err := newBuildRegisterAVSTaskMsg(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+			// this calls ValidateBasic internally so we don't need to do that.
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	f := cmd.Flags()
+	f.String(
+		FlagTaskContractAddress, "", "The contract address of task",
+	)
+	f.String(
+		FlagTaskName, "", "The name of the task",
+	)
+	f.String(
+		FlagTaskHash, "", "The hex encoded data of the task, usually ABI-encoded",
+	)
+	f.Uint64(
+		FlagResponsePeriod, 0, "The number of epochs to respond to the task",
+	)
+	f.Uint64(
+		FlagStatisticalPeriod, 0, "The number of epochs to collect the statistics of the task",
+	)
+	f.Uint64(
+		FlagChallengePeriod, 0, "The number of epochs to challenge the task",
+	)
+	f.Uint64(
+		FlagThresholdPercentage, 0, "The signature threshold percentage of the task",
+	)
+	// #nosec G703 // this only errors if the flag isn't defined.
+	_ = cmd.MarkFlagRequired(FlagTaskContractAddress)
+	_ = cmd.MarkFlagRequired(FlagTaskName)
+
+	// transaction level flags from the SDK
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func newBuildRegisterAVSTaskMsg(
+	clientCtx client.Context, fs *pflag.FlagSet,
+) (*types.RegisterAVSTaskReq, error) {
+	taskContractAddress, _ := fs.GetString(FlagTaskContractAddress)
+	name, _ := fs.GetString(FlagTaskName)
+	hashStr, _ := fs.GetString(FlagTaskHash)
+	hash, err := hex.DecodeString(strings.TrimPrefix(hashStr, "0x"))
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "invalid task hash")
+	}
+	responsePeriod, _ := fs.GetUint64(FlagResponsePeriod)
+	statisticalPeriod, _ := fs.GetUint64(FlagStatisticalPeriod)
+	challengePeriod, _ := fs.GetUint64(FlagChallengePeriod)
+	thresholdPercentage, _ := fs.GetUint64(FlagThresholdPercentage)
+
+	msg := &types.RegisterAVSTaskReq{
+		FromAddress: clientCtx.GetFromAddress().String(),
+		Task: &types.TaskInfo{
+			TaskContractAddress:   taskContractAddress,
+			Name:                  name,
+			Hash:                  hash,
+			TaskResponsePeriod:    responsePeriod,
+			TaskStatisticalPeriod: statisticalPeriod,
+			TaskChallengePeriod:   challengePeriod,
+			ThresholdPercentage:   thresholdPercentage,
+		},
+	}
+	return msg, nil
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"math/big"
 	"strconv"
+	"time"
 
 	errorsmod "cosmossdk.io/errors"
 	sdkmath "cosmossdk.io/math"
@@ -61,6 +62,37 @@ func (k *Keeper) GetAVSMinimumSelfDelegation(ctx sdk.Context, avsAddr string) (s
 	return sdkmath.LegacyNewDec(int64(avsInfo.Info.MinSelfDelegation)), nil
 }
 
+// GetAVSUnbondingDuration returns the unbonding duration of the AVS, in the number of its epochs.
+// The avsAddr supplied must be hex.
+func (k *Keeper) GetAVSUnbondingDuration(ctx sdk.Context, avsAddr string) (uint64, error) {
+	avsInfo, err := k.GetAVSInfo(ctx, avsAddr)
+	if err != nil {
+		return 0, errorsmod.Wrap(err, fmt.Sprintf("GetAVSUnbondingDuration: key is %s", avsAddr))
+	}
+	return avsInfo.Info.AvsUnbondingPeriod, nil
+}
+
+// GetAVSUnbondingEpoch returns the epoch at the end of which an unbonding from the AVS, started
+// in the current epoch, completes, along with the estimated end time of that epoch. The unbonding
+// lasts for the unbonding period of the AVS, in the number of its epochs after the current one,
+// so it's never shorter than that period. The avsAddr supplied must be hex.
+func (k *Keeper) GetAVSUnbondingEpoch(ctx sdk.Context, avsAddr string) (epochIdentifier string, epochNumber int64, endTime time.Time, err error) {
+	avsInfo, err := k.GetAVSInfo(ctx, avsAddr)
+	if err != nil {
+		return "", 0, time.Time{}, errorsmod.Wrap(err, fmt.Sprintf("GetAVSUnbondingEpoch: key is %s", avsAddr))
+	}
+	epoch, found := k.epochsKeeper.GetEpochInfo(ctx, avsInfo.Info.EpochIdentifier)
+	if !found {
+		return "", 0, time.Time{}, errorsmod.Wrap(types.ErrEpochNotFound, fmt.Sprintf("epoch info not found %s", avsInfo.Info.EpochIdentifier))
+	}
+	// #nosec G115
+	epochs := int64(avsInfo.Info.AvsUnbondingPeriod)
+	// same as the dogfood AVS, which holds the unbonding until the end of the epoch which is
+	// the unbonding period after the current one.
+	endTime = epoch.CurrentEpochStartTime.Add(epoch.Duration * time.Duration(epochs+1))
+	return epoch.Identifier, epoch.CurrentEpoch + epochs, endTime, nil
+}
+
 // GetEpochEndAVSs returns a list of hex AVS addresses for AVSs which are scheduled to start at the end of the
 // current epoch, or the beginning of the next one. The address format returned is hex.
 func (k *Keeper) GetEpochEndAVSs(ctx sdk.Context, epochIdentifier string, endingEpochNumber int64) []string {
@@ -82,20 +114,57 @@ func (k *Keeper) GetEpochEndAVSs(ctx sdk.Context, epochIdentifier string, ending
 
 // GetAVSInfoByTaskAddress returns the AVS  which containing this task address
 // A task contract address can only be used by one avs
-// TODO:this function is frequently used while its implementation iterates over existing avs to find the target avs by task contract address,  we should use a reverse mapping to avoid iteration
+// It uses the reverse lookup from the task address to the AVS address, instead of iterating
+// over all the AVSs, since it's called for each task result at the end of an epoch.
 func (k *Keeper) GetAVSInfoByTaskAddress(ctx sdk.Context, taskAddr string) types.AVSInfo {
 	var avs types.AVSInfo
-	if taskAddr == "" {
+	avsAddr, found := k.GetAVSAddrByTaskAddr(ctx, taskAddr)
+	if !found {
 		return avs
 	}
-	k.IterateAVSInfo(ctx, func(_ int64, avsInfo types.AVSInfo) (stop bool) {
-		if taskAddr == avsInfo.GetTaskAddr() {
-			avs = avsInfo
-			return true // stop because we found the AVS
-		}
-		return false
-	})
-	return avs
+	avsInfo, err := k.GetAVSInfo(ctx, avsAddr)
+	if err != nil {
+		return avs
+	}
+	return *avsInfo.Info
+}
+
+// GetAVSAddrByTaskAddr returns the hex address of the AVS that the task contract belongs to,
+// using the reverse lookup maintained by SetAVSInfo and DeleteAVSInfo.
+func (k *Keeper) GetAVSAddrByTaskAddr(ctx sdk.Context, taskAddr string) (string, bool) {
+	if !common.IsHexAddress(taskAddr) {
+		return "", false
+	}
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixTaskAddrToAVSAddr)
+	value := store.Get(common.HexToAddress(taskAddr).Bytes())
+	if value == nil {
+		return "", false
+	}
+	return common.BytesToAddress(value).String(), true
+}
+
+// setTaskAddrToAVSAddr stores the reverse lookup from the task address to the AVS address.
+// Task addresses that aren't hex can't be used by the precompile, so they aren't indexed.
+func (k *Keeper) setTaskAddrToAVSAddr(ctx sdk.Context, taskAddr, avsAddr string) {
+	if !common.IsHexAddress(taskAddr) {
+		return
+	}
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixTaskAddrToAVSAddr)
+	store.Set(common.HexToAddress(taskAddr).Bytes(), common.HexToAddress(avsAddr).Bytes())
+}
+
+// deleteTaskAddrToAVSAddr removes the reverse lookup of the task address, if it points to the
+// provided AVS. The check avoids removing the lookup of the task address that has been taken
+// over by another AVS.
+func (k *Keeper) deleteTaskAddrToAVSAddr(ctx sdk.Context, taskAddr, avsAddr string) {
+	if !common.IsHexAddress(taskAddr) {
+		return
+	}
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixTaskAddrToAVSAddr)
+	key := common.HexToAddress(taskAddr).Bytes()
+	if common.BytesToAddress(store.Get(key)) == common.HexToAddress(avsAddr) {
+		store.Delete(key)
+	}
 }
 
 // GetTaskStatisticalEpochEndAVSs returns the task list where the current block marks the end of their statistical period.
@@ -4,14 +4,18 @@ import (
 	"cosmossdk.io/math"
 	assetstypes "github.com/ExocoreNetwork/exocore/x/assets/types"
 	"math/big"
+	"strings"
 	"time"
 
+	"github.com/ExocoreNetwork/exocore/x/avs/keeper"
 	"github.com/ExocoreNetwork/exocore/x/avs/types"
 	avstypes "github.com/ExocoreNetwork/exocore/x/avs/types"
 	delegationtypes "github.com/ExocoreNetwork/exocore/x/delegation/types"
 	epochstypes "github.com/ExocoreNetwork/exocore/x/epochs/types"
 	operatorTypes "github.com/ExocoreNetwork/exocore/x/operator/types"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
 	utiltx "github.com/evmos/evmos/v16/testutil/tx"
 )
 
@@ -173,3 +177,67 @@ func (suite *AVSTestSuite) TestUpdateAVSInfoWithOperator_Register() {
 	err = suite.App.AVSManagerKeeper.OperatorOptAction(suite.Ctx, operatorParams)
 	suite.NoError(err)
 }
+
+func (suite *AVSTestSuite) TestTaskAddrToAVSAddrIndex() {
+	avsAddress := utiltx.GenerateAddress().String()
+	taskAddress := utiltx.GenerateAddress().String()
+	avs := &types.AVSInfo{
+		Name:       "avsTest",
+		AvsAddress: avsAddress,
+		TaskAddr:   taskAddress,
+		AvsSlash:   sdk.ZeroDec(),
+		AvsReward:  sdk.ZeroDec(),
+	}
+	err := suite.App.AVSManagerKeeper.SetAVSInfo(suite.Ctx, avs)
+	suite.NoError(err)
+	// the lookup is case-insensitive
+	avsAddr, found := suite.App.AVSManagerKeeper.GetAVSAddrByTaskAddr(suite.Ctx, strings.ToLower(taskAddress))
+	suite.True(found)
+	suite.Equal(avsAddress, avsAddr)
+	suite.Equal(avsAddress, suite.App.AVSManagerKeeper.GetAVSInfoByTaskAddress(suite.Ctx, taskAddress).AvsAddress)
+	res, err := suite.App.AVSManagerKeeper.QueryAVSAddrByTaskAddr(suite.Ctx, &types.QueryAVSAddrByTaskAddrReq{TaskAddr: taskAddress})
+	suite.NoError(err)
+	suite.Equal(avsAddress, res.AVSAddress)
+
+	// changing the task address removes the previous lookup
+	newTaskAddress := utiltx.GenerateAddress().String()
+	avs.TaskAddr = newTaskAddress
+	err = suite.App.AVSManagerKeeper.SetAVSInfo(suite.Ctx, avs)
+	suite.NoError(err)
+	_, found = suite.App.AVSManagerKeeper.GetAVSAddrByTaskAddr(suite.Ctx, taskAddress)
+	suite.False(found)
+	suite.Equal(avsAddress, suite.App.AVSManagerKeeper.GetAVSInfoByTaskAddress(suite.Ctx, newTaskAddress).AvsAddress)
+
+	// the lookup is removed with the AVS
+	err = suite.App.AVSManagerKeeper.DeleteAVSInfo(suite.Ctx, avsAddress)
+	suite.NoError(err)
+	_, found = suite.App.AVSManagerKeeper.GetAVSAddrByTaskAddr(suite.Ctx, newTaskAddress)
+	suite.False(found)
+	_, err = suite.App.AVSManagerKeeper.QueryAVSAddrByTaskAddr(suite.Ctx, &types.QueryAVSAddrByTaskAddrReq{TaskAddr: newTaskAddress})
+	suite.ErrorIs(err, types.ErrNotYetRegistered)
+}
+
+func (suite *AVSTestSuite) TestMigrate1to2() {
+	avsAddress := utiltx.GenerateAddress().String()
+	taskAddress := utiltx.GenerateAddress().String()
+	avs := &types.AVSInfo{
+		Name:       "avsTest",
+		AvsAddress: avsAddress,
+		TaskAddr:   taskAddress,
+		AvsSlash:   sdk.ZeroDec(),
+		AvsReward:  sdk.ZeroDec(),
+	}
+	err := suite.App.AVSManagerKeeper.SetAVSInfo(suite.Ctx, avs)
+	suite.NoError(err)
+	// remove the lookup to simulate the state before the migration
+	store := prefix.NewStore(suite.Ctx.KVStore(suite.App.GetKey(types.StoreKey)), types.KeyPrefixTaskAddrToAVSAddr)
+	store.Delete(common.HexToAddress(taskAddress).Bytes())
+	_, found := suite.App.AVSManagerKeeper.GetAVSAddrByTaskAddr(suite.Ctx, taskAddress)
+	suite.False(found)
+
+	migrator := keeper.NewMigrator(suite.App.AVSManagerKeeper)
+	suite.NoError(migrator.Migrate1to2(suite.Ctx))
+	avsAddr, found := suite.App.AVSManagerKeeper.GetAVSAddrByTaskAddr(suite.Ctx, taskAddress)
+	suite.True(found)
+	suite.Equal(avsAddress, avsAddr)
+}
@@ -0,0 +1,188 @@
+package keeper
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+
+	"github.com/ExocoreNetwork/exocore/utils"
+	assetstype "github.com/ExocoreNetwork/exocore/x/assets/types"
+	"github.com/ExocoreNetwork/exocore/x/avs/types"
+	operatortypes "github.com/ExocoreNetwork/exocore/x/operator/types"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// GetSlashIDForChallenge returns the slash ID used by the operator module for a confirmed
+// challenge. It is derived from the task contract address and the task ID, so that an
+// operator can be slashed at most once per task.
+func GetSlashIDForChallenge(taskContractAddress string, taskID uint64) string {
+	return strings.Join(
+		[]string{strings.ToLower(taskContractAddress), strconv.FormatUint(taskID, 10)},
+		utils.DelimiterForID,
+	)
+}
+
+// SetChallengeRecord stores the lifecycle record of a challenge.
+func (k Keeper) SetChallengeRecord(ctx sdk.Context, record *types.ChallengeRecord) error {
+	if !common.IsHexAddress(record.TaskContractAddress) {
+		return types.ErrInvalidAddr
+	}
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixTaskChallengeRecord)
+	infoKey := assetstype.GetJoinedStoreKey(strings.ToLower(record.OperatorAddress), strings.ToLower(record.TaskContractAddress),
+		strconv.FormatUint(record.TaskId, 10))
+	bz := k.cdc.MustMarshal(record)
+	store.Set(infoKey, bz)
+	return nil
+}
+
+// GetChallengeRecord returns the lifecycle record of the challenge raised against the
+// operator's result for the specified task.
+func (k Keeper) GetChallengeRecord(ctx sdk.Context, operatorAddress, taskContractAddress string, taskID uint64) (*types.ChallengeRecord, error) {
+	if !common.IsHexAddress(taskContractAddress) {
+		return nil, types.ErrInvalidAddr
+	}
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixTaskChallengeRecord)
+	infoKey := assetstype.GetJoinedStoreKey(strings.ToLower(operatorAddress), strings.ToLower(taskContractAddress),
+		strconv.FormatUint(taskID, 10))
+	value := store.Get(infoKey)
+	if value == nil {
+		return nil, errorsmod.Wrap(types.ErrNoKeyInTheStore,
+			fmt.Sprintf("GetChallengeRecord: key is %s", infoKey))
+	}
+	ret := types.ChallengeRecord{}
+	k.cdc.MustUnmarshal(value, &ret)
+	return &ret, nil
+}
+
+// IterateChallengeRecords iterates through all the challenge records.
+func (k Keeper) IterateChallengeRecords(ctx sdk.Context, fn func(index int64, record types.ChallengeRecord) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixTaskChallengeRecord)
+
+	iterator := sdk.KVStorePrefixIterator(store, nil)
+	defer iterator.Close()
+
+	i := int64(0)
+
+	for ; iterator.Valid(); iterator.Next() {
+		record := types.ChallengeRecord{}
+		k.cdc.MustUnmarshal(iterator.Value(), &record)
+
+		stop := fn(i, record)
+
+		if stop {
+			break
+		}
+		i++
+	}
+}
+
+// isChallengeAdjudicator returns true if the caller is allowed to resolve challenges for the
+// AVS. The adjudicator is the slash contract registered by the AVS, or one of the AVS owners.
+func isChallengeAdjudicator(avsInfo types.AVSInfo, callerAddress string) bool {
+	callerAccAddr, err := sdk.AccAddressFromBech32(callerAddress)
+	if err != nil {
+		return false
+	}
+	if common.IsHexAddress(avsInfo.SlashAddr) &&
+		common.HexToAddress(avsInfo.SlashAddr) == common.BytesToAddress(callerAccAddr) {
+		return true
+	}
+	return slices.Contains(avsInfo.AvsOwnerAddress, callerAddress)
+}
+
+// ResolveChallenge adjudicates a pending challenge. A rejected challenge is simply marked as
+// such, while a confirmed challenge slashes the operator through the operator module. The
+// slashed power is the voting power the operator had when the challenge was raised, and
+// the proportion is the `AvsSlash` configured by the AVS.
+func (k Keeper) ResolveChallenge(oCtx sdk.Context, params *types.ResolveChallengeParams) (err error) {
+	// only write the state if both the slash and the record update succeed.
+	ctx, writeFunc := oCtx.CacheContext()
+	defer func() {
+		if err == nil {
+			writeFunc()
+		}
+	}()
+	taskAddr := params.TaskContractAddress.String()
+	operatorAddr := params.OperatorAddress.String()
+	record, err := k.GetChallengeRecord(ctx, operatorAddr, taskAddr, params.TaskID)
+	if err != nil {
+		return err
+	}
+	if record.Status != types.ChallengeStatusPending {
+		return errorsmod.Wrap(
+			types.ErrChallengeAlreadyResolved,
+			fmt.Sprintf("ResolveChallenge: operator: %s, task address: %s, task ID: %d, status: %s",
+				operatorAddr, taskAddr, params.TaskID, record.Status),
+		)
+	}
+	avsInfo := k.GetAVSInfoByTaskAddress(ctx, taskAddr)
+	if avsInfo.AvsAddress == "" {
+		return errorsmod.Wrap(types.ErrUnregisterNonExistent, fmt.Sprintf("the taskaddr is :%s", taskAddr))
+	}
+	if !isChallengeAdjudicator(avsInfo, params.CallerAddress) {
+		return errorsmod.Wrap(
+			types.ErrCallerAddressUnauthorized,
+			fmt.Sprintf("this caller not qualified to resolve the challenge %s", params.CallerAddress),
+		)
+	}
+
+	if params.Confirmed {
+		slashID, err := k.slashForChallenge(ctx, avsInfo, record)
+		if err != nil {
+			return err
+		}
+		record.Status = types.ChallengeStatusConfirmed
+		record.SlashID = slashID
+	} else {
+		record.Status = types.ChallengeStatusRejected
+	}
+	record.ResolvedHeight = ctx.BlockHeight()
+	if err := k.SetChallengeRecord(ctx, record); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeChallengeResolved,
+			sdk.NewAttribute(types.AttributeKeyOperator, operatorAddr),
+			sdk.NewAttribute(types.AttributeKeyTaskContractAddress, taskAddr),
+			sdk.NewAttribute(types.AttributeKeyTaskID, strconv.FormatUint(params.TaskID, 10)),
+			sdk.NewAttribute(types.AttributeKeyChallengeStatus, record.Status.String()),
+			sdk.NewAttribute(types.AttributeKeySlashID, record.SlashID),
+		),
+	)
+	return nil
+}
+
+// slashForChallenge executes the slash for a confirmed challenge and returns the slash ID.
+func (k Keeper) slashForChallenge(ctx sdk.Context, avsInfo types.AVSInfo, record *types.ChallengeRecord) (string, error) {
+	opAccAddr, err := sdk.AccAddressFromBech32(record.OperatorAddress)
+	if err != nil {
+		return "", err
+	}
+	slashID := GetSlashIDForChallenge(record.TaskContractAddress, record.TaskId)
+	slashParam := &operatortypes.SlashInputInfo{
+		IsDogFood: false,
+		// the historical power is retrieved by the operator module from the voting power
+		// snapshot at the infraction height.
+		Power:         0,
+		SlashType:     uint32(operatortypes.SlashType_SLASH_TYPE_INSTANT_SLASH),
+		Operator:      opAccAddr,
+		AVSAddr:       avsInfo.AvsAddress,
+		SlashContract: avsInfo.SlashAddr,
+		SlashID:       slashID,
+		// the results are submitted before the challenge is raised, so using the height at
+		// which the challenge was raised is the latest possible infraction height.
+		SlashEventHeight: record.RaisedHeight,
+		SlashProportion:  avsInfo.AvsSlash,
+	}
+	if err := k.operatorKeeper.Slash(ctx, slashParam); err != nil {
+		return "", errorsmod.Wrap(err, "slashForChallenge: failed to slash the operator")
+	}
+	return slashID, nil
+}
@@ -0,0 +1,136 @@
+package keeper_test
+
+import (
+	"math/big"
+	"strconv"
+
+	avskeeper "github.com/ExocoreNetwork/exocore/x/avs/keeper"
+	avstypes "github.com/ExocoreNetwork/exocore/x/avs/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	utiltx "github.com/evmos/evmos/v16/testutil/tx"
+)
+
+// prepareChallenge raises a challenge against the result submitted by the first operator,
+// and sets the slash contract of the AVS.
+func (suite *AVSTestSuite) prepareChallenge() (slashContract common.Address, operator sdk.AccAddress) {
+	suite.TestSubmitTask_OnlyPhaseTwo_Mul()
+	// the statistics of the task are calculated, and the challenge period starts.
+	suite.CommitAfter(suite.EpochDuration)
+
+	slashContract = utiltx.GenerateAddress()
+	avsInfo, err := suite.App.AVSManagerKeeper.GetAVSInfo(suite.Ctx, suite.avsAddr)
+	suite.Require().NoError(err)
+	avsInfo.Info.SlashAddr = slashContract.String()
+	err = suite.App.AVSManagerKeeper.SetAVSInfo(suite.Ctx, avsInfo.Info)
+	suite.Require().NoError(err)
+
+	operator, err = sdk.AccAddressFromBech32(suite.operatorAddresses[0])
+	suite.Require().NoError(err)
+	taskRes := avstypes.TaskResponse{TaskID: 1, NumberSum: big.NewInt(100)}
+	hash, err := avstypes.GetTaskResponseDigestEncodeByAbi(taskRes)
+	suite.Require().NoError(err)
+	challenger := sdk.AccAddress(utiltx.GenerateAddress().Bytes())
+	err = suite.App.AVSManagerKeeper.RaiseAndResolveChallenge(suite.Ctx, &avstypes.ChallengeParams{
+		TaskContractAddress: suite.taskAddress,
+		TaskHash:            []byte("req-struct"),
+		TaskID:              suite.taskId,
+		OperatorAddress:     operator,
+		TaskResponseHash:    hash[:],
+		CallerAddress:       challenger.String(),
+	})
+	suite.Require().NoError(err)
+
+	record, err := suite.App.AVSManagerKeeper.GetChallengeRecord(suite.Ctx, operator.String(), suite.taskAddress.String(), suite.taskId)
+	suite.Require().NoError(err)
+	suite.Equal(avstypes.ChallengeStatusPending, record.Status)
+	suite.Equal(challenger.String(), record.Challenger)
+	suite.Equal(suite.Ctx.BlockHeight(), record.RaisedHeight)
+	suite.checkTaskStatus(suite.taskAddress.String(), suite.taskId, avstypes.TaskStatusChallenged)
+	suite.Commit()
+	return slashContract, operator
+}
+
+func (suite *AVSTestSuite) TestResolveChallenge_Confirmed() {
+	slashContract, operator := suite.prepareChallenge()
+
+	params := &avstypes.ResolveChallengeParams{
+		TaskContractAddress: suite.taskAddress,
+		TaskID:              suite.taskId,
+		OperatorAddress:     operator,
+		Confirmed:           true,
+		CallerAddress:       sdk.AccAddress(utiltx.GenerateAddress().Bytes()).String(),
+	}
+	// only the slash contract or the owners of the AVS can resolve the challenge
+	err := suite.App.AVSManagerKeeper.ResolveChallenge(suite.Ctx, params)
+	suite.ErrorIs(err, avstypes.ErrCallerAddressUnauthorized)
+
+	params.CallerAddress = sdk.AccAddress(slashContract.Bytes()).String()
+	err = suite.App.AVSManagerKeeper.ResolveChallenge(suite.Ctx, params)
+	suite.NoError(err)
+
+	slashID := avskeeper.GetSlashIDForChallenge(suite.taskAddress.String(), suite.taskId)
+	record, err := suite.App.AVSManagerKeeper.GetChallengeRecord(suite.Ctx, operator.String(), suite.taskAddress.String(), suite.taskId)
+	suite.NoError(err)
+	suite.Equal(avstypes.ChallengeStatusConfirmed, record.Status)
+	suite.Equal(slashID, record.SlashID)
+	suite.Equal(suite.Ctx.BlockHeight(), record.ResolvedHeight)
+
+	slashInfo, err := suite.App.OperatorKeeper.GetOperatorSlashInfo(suite.Ctx, suite.avsAddr, operator.String(), slashID)
+	suite.NoError(err)
+	suite.Equal(slashContract.String(), slashInfo.SlashContract)
+	suite.Equal(record.RaisedHeight, slashInfo.EventHeight)
+	suite.True(slashInfo.ExecutionInfo.SlashValue.IsPositive())
+
+	// a resolved challenge can't be resolved again
+	err = suite.App.AVSManagerKeeper.ResolveChallenge(suite.Ctx, params)
+	suite.ErrorIs(err, avstypes.ErrChallengeAlreadyResolved)
+}
+
+func (suite *AVSTestSuite) TestResolveChallenge_Rejected() {
+	slashContract, operator := suite.prepareChallenge()
+
+	err := suite.App.AVSManagerKeeper.ResolveChallenge(suite.Ctx, &avstypes.ResolveChallengeParams{
+		TaskContractAddress: suite.taskAddress,
+		TaskID:              suite.taskId,
+		OperatorAddress:     operator,
+		Confirmed:           false,
+		CallerAddress:       sdk.AccAddress(slashContract.Bytes()).String(),
+	})
+	suite.NoError(err)
+
+	record, err := suite.App.AVSManagerKeeper.GetChallengeRecord(suite.Ctx, operator.String(), suite.taskAddress.String(), suite.taskId)
+	suite.NoError(err)
+	suite.Equal(avstypes.ChallengeStatusRejected, record.Status)
+	suite.Equal("", record.SlashID)
+
+	_, err = suite.App.OperatorKeeper.GetOperatorSlashInfo(suite.Ctx, suite.avsAddr, operator.String(),
+		avskeeper.GetSlashIDForChallenge(suite.taskAddress.String(), suite.taskId))
+	suite.Error(err)
+
+	res, err := suite.App.AVSManagerKeeper.QueryChallengeInfo(suite.Ctx, &avstypes.QueryChallengeInfoReq{
+		TaskAddress:  suite.taskAddress.String(),
+		OperatorAddr: operator.String(),
+		TaskId:       strconv.FormatUint(suite.taskId, 10),
+	})
+	suite.NoError(err)
+	suite.Equal(avstypes.ChallengeStatusRejected, res.Record.Status)
+}
+
+func (suite *AVSTestSuite) TestQueryLegacyChallengeInfo() {
+	// the challenges raised before the records were kept only stored the challenge address.
+	operator := sdk.AccAddress(utiltx.GenerateAddress().Bytes())
+	challenger := sdk.AccAddress(utiltx.GenerateAddress().Bytes())
+	taskAddr := utiltx.GenerateAddress()
+	err := suite.App.AVSManagerKeeper.SetTaskChallengedInfo(suite.Ctx, 1, operator.String(), challenger.String(), taskAddr)
+	suite.NoError(err)
+
+	res, err := suite.App.AVSManagerKeeper.QueryChallengeInfo(suite.Ctx, &avstypes.QueryChallengeInfoReq{
+		TaskAddress:  taskAddr.String(),
+		OperatorAddr: operator.String(),
+		TaskId:       "1",
+	})
+	suite.NoError(err)
+	suite.Equal(common.Bytes2Hex(challenger), res.ChallengeAddr)
+	suite.Nil(res.Record)
+}
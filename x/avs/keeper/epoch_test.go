@@ -1,10 +1,21 @@
 package keeper_test
 
 import (
+	"bytes"
 	"strconv"
+	"strings"
 
+	assetstype "github.com/ExocoreNetwork/exocore/x/assets/types"
+	"github.com/ExocoreNetwork/exocore/x/avs/keeper"
 	avstypes "github.com/ExocoreNetwork/exocore/x/avs/types"
+	epochstypes "github.com/ExocoreNetwork/exocore/x/epochs/types"
+	operatortypes "github.com/ExocoreNetwork/exocore/x/operator/types"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/ethereum/go-ethereum/common"
+	utiltx "github.com/evmos/evmos/v16/testutil/tx"
+	"github.com/prysmaticlabs/prysm/v4/crypto/bls/blst"
+	blscommon "github.com/prysmaticlabs/prysm/v4/crypto/bls/common"
 )
 
 func (suite *AVSTestSuite) Test_GroupStatistics() {
@@ -38,11 +49,325 @@ func (suite *AVSTestSuite) TestEpochEnd_TaskCalculation() {
 		OptInOperators:        suite.operatorAddresses,
 		NoSignedOperators:     nil,
 		SignedOperators:       suite.operatorAddresses,
-		ActualThreshold:       7766279631452241920,
+		// all the opted-in operators signed, and they hold all the power of the avs.
+		ActualThreshold: 100,
+		// the challenge period is over as well.
+		Status: avstypes.TaskStatusFinalized,
 	}
 	diff := avstypes.Difference(expectInfo.SignedOperators, info.SignedOperators)
 
 	suite.Equal(0, len(diff))
 	suite.Equal(expectInfo.NoSignedOperators, info.NoSignedOperators)
 	suite.Equal(expectInfo.ActualThreshold, info.ActualThreshold)
+	suite.Equal(expectInfo.Status, info.Status)
+}
+
+func (suite *AVSTestSuite) TestTaskStatus_Lifecycle() {
+	suite.TestSubmitTask_OnlyPhaseTwo_Mul()
+	taskAddr := common.Address(suite.taskAddress.Bytes()).String()
+	// another task of the avs without any result.
+	epoch, found := suite.App.EpochsKeeper.GetEpochInfo(suite.Ctx, epochstypes.HourEpochID)
+	suite.True(found)
+	idleTaskID := suite.App.AVSManagerKeeper.GetTaskID(suite.Ctx, suite.taskAddress)
+	err := suite.App.AVSManagerKeeper.SetTaskInfo(suite.Ctx, &avstypes.TaskInfo{
+		TaskContractAddress:   taskAddr,
+		Name:                  "test-idle-task",
+		TaskId:                idleTaskID,
+		TaskResponsePeriod:    1,
+		TaskStatisticalPeriod: 1,
+		TaskChallengePeriod:   5,
+		ThresholdPercentage:   60,
+		// the statistical period ends with the current epoch.
+		StartingEpoch:  uint64(epoch.CurrentEpoch - 2),
+		OptInOperators: suite.operatorAddresses,
+	})
+	suite.NoError(err)
+	suite.checkTaskStatus(taskAddr, suite.taskId, avstypes.TaskStatusResponded)
+	suite.checkTaskStatus(taskAddr, idleTaskID, avstypes.TaskStatusPending)
+
+	suite.CommitAfter(suite.EpochDuration)
+	suite.checkTaskStatus(taskAddr, suite.taskId, avstypes.TaskStatusQuorumReached)
+	suite.checkTaskStatus(taskAddr, idleTaskID, avstypes.TaskStatusQuorumFailed)
+
+	res, err := suite.App.AVSManagerKeeper.QueryTasksByStatus(suite.Ctx, &avstypes.QueryTasksByStatusReq{
+		Status: avstypes.TaskStatusQuorumReached,
+	})
+	suite.NoError(err)
+	suite.Equal(1, len(res.Tasks))
+	suite.Equal(suite.taskId, res.Tasks[0].TaskId)
+
+	// the challenge period of the task is over.
+	suite.CommitAfter(suite.EpochDuration)
+	suite.CommitAfter(suite.EpochDuration)
+	suite.checkTaskStatus(taskAddr, suite.taskId, avstypes.TaskStatusFinalized)
+	suite.checkTaskStatus(taskAddr, idleTaskID, avstypes.TaskStatusQuorumFailed)
+	res, err = suite.App.AVSManagerKeeper.QueryTasksByStatus(suite.Ctx, &avstypes.QueryTasksByStatusReq{
+		Status: avstypes.TaskStatusFinalized,
+	})
+	suite.NoError(err)
+	suite.Equal(1, len(res.Tasks))
+}
+
+func (suite *AVSTestSuite) TestTaskStatus_IndexedOnce() {
+	suite.TestSubmitTask_OnlyPhaseTwo_Mul()
+	taskAddr := common.Address(suite.taskAddress.Bytes()).String()
+	epoch, found := suite.App.EpochsKeeper.GetEpochInfo(suite.Ctx, epochstypes.HourEpochID)
+	suite.True(found)
+	task := &avstypes.TaskInfo{
+		TaskContractAddress:   taskAddr,
+		Name:                  "test-reindexed-task",
+		TaskId:                suite.App.AVSManagerKeeper.GetTaskID(suite.Ctx, suite.taskAddress),
+		TaskResponsePeriod:    1,
+		TaskStatisticalPeriod: 1,
+		TaskChallengePeriod:   5,
+		ThresholdPercentage:   60,
+		StartingEpoch:         uint64(epoch.CurrentEpoch),
+		OptInOperators:        suite.operatorAddresses,
+	}
+	suite.NoError(suite.App.AVSManagerKeeper.SetTaskInfo(suite.Ctx, task))
+	// a task stored again with another deadline replaces its previous entry.
+	task.StartingEpoch++
+	suite.NoError(suite.App.AVSManagerKeeper.SetTaskInfo(suite.Ctx, task))
+	infoKey := assetstype.GetJoinedStoreKey(strings.ToLower(taskAddr), strconv.FormatUint(task.TaskId, 10))
+	checkStore := prefix.NewStore(suite.Ctx.KVStore(suite.App.GetKey(avstypes.StoreKey)), avstypes.KeyPrefixTaskStatusCheck)
+	iterator := checkStore.Iterator(nil, nil)
+	var entries [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		if bytes.Equal(iterator.Value(), infoKey) {
+			entries = append(entries, iterator.Key())
+		}
+	}
+	iterator.Close()
+	suite.Equal([][]byte{avstypes.GetTaskStatusCheckKey(epochstypes.HourEpochID, epoch.CurrentEpoch+3, infoKey)}, entries)
+
+	// a task without an epoch to track its status isn't indexed.
+	unknownAddr := utiltx.GenerateAddress().String()
+	suite.NoError(suite.App.AVSManagerKeeper.SetTaskInfo(suite.Ctx, &avstypes.TaskInfo{
+		TaskContractAddress:   unknownAddr,
+		TaskId:                1,
+		TaskResponsePeriod:    1,
+		TaskStatisticalPeriod: 1,
+	}))
+	unknownKey := assetstype.GetJoinedStoreKey(strings.ToLower(unknownAddr), "1")
+	byTaskStore := prefix.NewStore(suite.Ctx.KVStore(suite.App.GetKey(avstypes.StoreKey)), avstypes.KeyPrefixTaskStatusCheckByTask)
+	suite.False(byTaskStore.Has(unknownKey))
+	suite.False(checkStore.Has(avstypes.GetTaskStatusCheckKey("", 2, unknownKey)))
+}
+
+func (suite *AVSTestSuite) checkTaskStatus(taskAddr string, taskID uint64, status avstypes.TaskStatus) {
+	info, err := suite.App.AVSManagerKeeper.GetTaskInfo(suite.Ctx, strconv.FormatUint(taskID, 10), taskAddr)
+	suite.Require().NoError(err)
+	suite.Equal(status, info.Status, "task %d", taskID)
+}
+
+func (suite *AVSTestSuite) TestEpochEnd_AggregateSignature() {
+	suite.TestSubmitTask_OnlyPhaseTwo_Mul()
+	suite.CommitAfter(suite.EpochDuration)
+	suite.CommitAfter(suite.EpochDuration)
+	suite.CommitAfter(suite.EpochDuration)
+	info, err := suite.App.AVSManagerKeeper.GetTaskInfo(suite.Ctx, strconv.FormatUint(suite.taskId, 10), common.Address(suite.taskAddress.Bytes()).String())
+	suite.NoError(err)
+	suite.NotEmpty(info.AggregateResponseHash)
+	suite.Equal((len(info.OptInOperators)+7)/8, len(info.SignerBitmap))
+
+	// the aggregate can be verified against the public keys marked in the bitmap.
+	pubKeys := make([]blscommon.PublicKey, 0, len(info.OptInOperators))
+	for i := range info.OptInOperators {
+		if info.SignerBitmap[i/8]&(1<<(i%8)) == 0 {
+			continue
+		}
+		for index, operator := range suite.operatorAddresses {
+			if operator == info.OptInOperators[i] {
+				pubKeys = append(pubKeys, suite.blsKeys[index].PublicKey())
+			}
+		}
+	}
+	// all the operators signed the same response.
+	suite.Equal(len(suite.operatorAddresses), len(pubKeys))
+	sig, err := blst.SignatureFromBytes(info.AggregateSignature)
+	suite.NoError(err)
+	suite.True(sig.FastAggregateVerify(pubKeys, common.HexToHash(info.AggregateResponseHash)))
+}
+
+func (suite *AVSTestSuite) TestTaskStatisticsRetry_Succeeded() {
+	suite.TestSubmitTask_OnlyPhaseTwo_Mul()
+	taskAddr := common.Address(suite.taskAddress.Bytes()).String()
+	err := suite.App.AVSManagerKeeper.SetTaskStatisticsRetry(suite.Ctx, &avstypes.TaskStatisticsRetry{
+		TaskContractAddress: taskAddr,
+		TaskId:              suite.taskId,
+		EpochIdentifier:     epochstypes.HourEpochID,
+		FailedEpoch:         1,
+		LastAttemptEpoch:    1,
+		Attempts:            1,
+		LastError:           "mock error",
+	})
+	suite.NoError(err)
+
+	// the retry happens only at the end of the epoch of the avs.
+	suite.App.AVSManagerKeeper.EpochsHooks().AfterEpochEnd(suite.Ctx, epochstypes.DayEpochID, 2)
+	_, found := suite.App.AVSManagerKeeper.GetTaskStatisticsRetry(suite.Ctx, taskAddr, suite.taskId)
+	suite.True(found)
+
+	suite.Ctx = suite.Ctx.WithEventManager(sdk.NewEventManager())
+	suite.App.AVSManagerKeeper.EpochsHooks().AfterEpochEnd(suite.Ctx, epochstypes.HourEpochID, 2)
+	_, found = suite.App.AVSManagerKeeper.GetTaskStatisticsRetry(suite.Ctx, taskAddr, suite.taskId)
+	suite.False(found)
+	suite.True(hasEvent(suite.Ctx.EventManager().Events(), avstypes.EventTypeTaskStatisticsRetried))
+
+	info, err := suite.App.AVSManagerKeeper.GetTaskInfo(suite.Ctx, strconv.FormatUint(suite.taskId, 10), taskAddr)
+	suite.NoError(err)
+	suite.Equal(0, len(avstypes.Difference(suite.operatorAddresses, info.SignedOperators)))
+	suite.Equal(len(suite.operatorAddresses), len(info.OperatorActivePower.OperatorPowerList))
+}
+
+func (suite *AVSTestSuite) TestTaskStatisticsRetry_OperatorWithoutPower() {
+	suite.TestSubmitTask_OnlyPhaseTwo_Mul()
+	taskAddr := common.Address(suite.taskAddress.Bytes()).String()
+	avsAddr, found := suite.App.AVSManagerKeeper.GetAVSAddrByTaskAddr(suite.Ctx, taskAddr)
+	suite.True(found)
+	// the first signer has no stored USD value, so its power can't be fetched.
+	store := prefix.NewStore(
+		suite.Ctx.KVStore(suite.App.GetKey(operatortypes.StoreKey)), operatortypes.KeyPrefixUSDValueForOperator,
+	)
+	store.Delete(assetstype.GetJoinedStoreKey(avsAddr, suite.operatorAddresses[0]))
+	_, err := suite.App.OperatorKeeper.GetOperatorOptedUSDValue(suite.Ctx, avsAddr, suite.operatorAddresses[0])
+	suite.Error(err)
+
+	err = suite.App.AVSManagerKeeper.SetTaskStatisticsRetry(suite.Ctx, &avstypes.TaskStatisticsRetry{
+		TaskContractAddress: taskAddr,
+		TaskId:              suite.taskId,
+		EpochIdentifier:     epochstypes.HourEpochID,
+		FailedEpoch:         1,
+		LastAttemptEpoch:    1,
+		Attempts:            1,
+		LastError:           "mock error",
+	})
+	suite.NoError(err)
+
+	// the signer is recorded with a zero power and the statistics of the task are still computed.
+	suite.App.AVSManagerKeeper.EpochsHooks().AfterEpochEnd(suite.Ctx, epochstypes.HourEpochID, 2)
+	_, found = suite.App.AVSManagerKeeper.GetTaskStatisticsRetry(suite.Ctx, taskAddr, suite.taskId)
+	suite.False(found)
+	info, err := suite.App.AVSManagerKeeper.GetTaskInfo(suite.Ctx, strconv.FormatUint(suite.taskId, 10), taskAddr)
+	suite.NoError(err)
+	suite.Equal(len(suite.operatorAddresses), len(info.SignedOperators))
+	suite.Contains(info.SignedOperators, suite.operatorAddresses[0])
+	suite.Equal(len(suite.operatorAddresses), len(info.OperatorActivePower.OperatorPowerList))
+	for _, power := range info.OperatorActivePower.OperatorPowerList {
+		if power.OperatorAddr == suite.operatorAddresses[0] {
+			suite.True(power.SelfActivePower.IsZero())
+		} else {
+			suite.True(power.SelfActivePower.IsPositive())
+		}
+	}
+}
+
+func (suite *AVSTestSuite) TestTaskStatisticsRetry_Dropped() {
+	taskAddr := utiltx.GenerateAddress().String()
+	err := suite.App.AVSManagerKeeper.SetTaskStatisticsRetry(suite.Ctx, &avstypes.TaskStatisticsRetry{
+		TaskContractAddress: taskAddr,
+		TaskId:              1,
+		EpochIdentifier:     epochstypes.HourEpochID,
+		FailedEpoch:         1,
+		LastAttemptEpoch:    1,
+		Attempts:            1,
+	})
+	suite.NoError(err)
+
+	for epoch := int64(2); epoch < avstypes.MaxTaskStatisticsAttempts; epoch++ {
+		suite.App.AVSManagerKeeper.EpochsHooks().AfterEpochEnd(suite.Ctx, epochstypes.HourEpochID, epoch)
+		// a second call for the same epoch does not count as another attempt.
+		suite.App.AVSManagerKeeper.EpochsHooks().AfterEpochEnd(suite.Ctx, epochstypes.HourEpochID, epoch)
+		retry, found := suite.App.AVSManagerKeeper.GetTaskStatisticsRetry(suite.Ctx, taskAddr, 1)
+		suite.True(found)
+		suite.Equal(uint32(epoch), retry.Attempts)
+		suite.Equal(epoch, retry.LastAttemptEpoch)
+		suite.NotEmpty(retry.LastError)
+	}
+	res, err := suite.App.AVSManagerKeeper.QueryTaskStatisticsRetries(suite.Ctx, &avstypes.QueryTaskStatisticsRetriesReq{})
+	suite.NoError(err)
+	suite.Equal(1, len(res.Retries))
+
+	suite.Ctx = suite.Ctx.WithEventManager(sdk.NewEventManager())
+	suite.App.AVSManagerKeeper.EpochsHooks().AfterEpochEnd(suite.Ctx, epochstypes.HourEpochID, avstypes.MaxTaskStatisticsAttempts)
+	_, found := suite.App.AVSManagerKeeper.GetTaskStatisticsRetry(suite.Ctx, taskAddr, 1)
+	suite.False(found)
+	suite.True(hasEvent(suite.Ctx.EventManager().Events(), avstypes.EventTypeTaskStatisticsDropped))
+	res, err = suite.App.AVSManagerKeeper.QueryTaskStatisticsRetries(suite.Ctx, &avstypes.QueryTaskStatisticsRetriesReq{})
+	suite.NoError(err)
+	suite.Equal(0, len(res.Retries))
+}
+
+func hasEvent(events sdk.Events, eventType string) bool {
+	for _, event := range events {
+		if event.Type == eventType {
+			return true
+		}
+	}
+	return false
+}
+
+func (suite *AVSTestSuite) TestMigrate2to3() {
+	suite.TestSubmitTask_OnlyPhaseTwo_Mul()
+	taskAddr := common.Address(suite.taskAddress.Bytes()).String()
+	epoch, found := suite.App.EpochsKeeper.GetEpochInfo(suite.Ctx, epochstypes.HourEpochID)
+	suite.True(found)
+	newTask := func(startingEpoch int64) uint64 {
+		taskID := suite.App.AVSManagerKeeper.GetTaskID(suite.Ctx, suite.taskAddress)
+		err := suite.App.AVSManagerKeeper.SetTaskInfo(suite.Ctx, &avstypes.TaskInfo{
+			TaskContractAddress:   taskAddr,
+			Name:                  "test-legacy-task",
+			TaskId:                taskID,
+			TaskResponsePeriod:    1,
+			TaskStatisticalPeriod: 1,
+			TaskChallengePeriod:   5,
+			ThresholdPercentage:   60,
+			StartingEpoch:         uint64(startingEpoch),
+			OptInOperators:        suite.operatorAddresses,
+		})
+		suite.NoError(err)
+		return taskID
+	}
+	// the challenge period ended with the previous epoch.
+	finalizedID := newTask(epoch.CurrentEpoch - 8)
+	// the statistical period ended with the previous epoch.
+	failedID := newTask(epoch.CurrentEpoch - 3)
+	// the statistical period ends with the current epoch.
+	pendingID := newTask(epoch.CurrentEpoch - 2)
+
+	// the tasks stored before the status was tracked are pending and not indexed.
+	taskStore := prefix.NewStore(suite.Ctx.KVStore(suite.App.GetKey(avstypes.StoreKey)), avstypes.KeyPrefixAVSTaskInfo)
+	for _, taskID := range []uint64{suite.taskId, finalizedID, failedID, pendingID} {
+		info, err := suite.App.AVSManagerKeeper.GetTaskInfo(suite.Ctx, strconv.FormatUint(taskID, 10), taskAddr)
+		suite.NoError(err)
+		info.Status = avstypes.TaskStatusPending
+		taskStore.Set(
+			assetstype.GetJoinedStoreKey(strings.ToLower(taskAddr), strconv.FormatUint(taskID, 10)),
+			suite.App.AppCodec().MustMarshal(info),
+		)
+	}
+	for _, keyPrefix := range [][]byte{avstypes.KeyPrefixTaskStatusCheck, avstypes.KeyPrefixTaskStatusCheckByTask} {
+		checkStore := prefix.NewStore(suite.Ctx.KVStore(suite.App.GetKey(avstypes.StoreKey)), keyPrefix)
+		iterator := checkStore.Iterator(nil, nil)
+		var keys [][]byte
+		for ; iterator.Valid(); iterator.Next() {
+			keys = append(keys, iterator.Key())
+		}
+		iterator.Close()
+		for _, key := range keys {
+			checkStore.Delete(key)
+		}
+	}
+
+	migrator := keeper.NewMigrator(suite.App.AVSManagerKeeper)
+	suite.NoError(migrator.Migrate2to3(suite.Ctx))
+	suite.checkTaskStatus(taskAddr, suite.taskId, avstypes.TaskStatusResponded)
+	suite.checkTaskStatus(taskAddr, finalizedID, avstypes.TaskStatusFinalized)
+	suite.checkTaskStatus(taskAddr, failedID, avstypes.TaskStatusQuorumFailed)
+	suite.checkTaskStatus(taskAddr, pendingID, avstypes.TaskStatusPending)
+
+	// the migrated tasks are checked at the end of their epochs.
+	suite.CommitAfter(suite.EpochDuration)
+	suite.checkTaskStatus(taskAddr, suite.taskId, avstypes.TaskStatusQuorumReached)
+	suite.checkTaskStatus(taskAddr, pendingID, avstypes.TaskStatusQuorumFailed)
 }
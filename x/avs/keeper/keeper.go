@@ -14,6 +14,7 @@ import (
 	errorsmod "cosmossdk.io/errors"
 
 	delegationtypes "github.com/ExocoreNetwork/exocore/x/delegation/types"
+	operatortypes "github.com/ExocoreNetwork/exocore/x/operator/types"
 	"github.com/cometbft/cometbft/libs/log"
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/store/prefix"
@@ -321,11 +322,23 @@ func (k Keeper) OperatorOptAction(ctx sdk.Context, params *types.OperatorOptPara
 	}
 }
 
-// SetAVSInfo sets the avs info. The caller must ensure that avs.AvsAddress is hex.
+// EditOperator edits the information of an operator through the operator module. It's used by
+// the precompile, where the sender of the request is the calling operator.
+func (k Keeper) EditOperator(ctx sdk.Context, req *operatortypes.EditOperatorReq) error {
+	return k.operatorKeeper.EditOperator(ctx, req)
+}
+
+// SetAVSInfo sets the avs info. The caller must ensure that avs.AvsAddress is hex. The reverse
+// lookup from the task address to the AVS address is updated as well.
 func (k Keeper) SetAVSInfo(ctx sdk.Context, avs *types.AVSInfo) (err error) {
+	if prev, err := k.GetAVSInfo(ctx, avs.AvsAddress); err == nil &&
+		prev.Info.TaskAddr != avs.TaskAddr {
+		k.deleteTaskAddrToAVSAddr(ctx, prev.Info.TaskAddr, avs.AvsAddress)
+	}
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixAVSInfo)
 	bz := k.cdc.MustMarshal(avs)
 	store.Set(common.HexToAddress(avs.AvsAddress).Bytes(), bz)
+	k.setTaskAddrToAVSAddr(ctx, avs.TaskAddr, avs.AvsAddress)
 	return nil
 }
 
@@ -360,9 +373,13 @@ func (k Keeper) IsAVSByChainID(ctx sdk.Context, chainID string) (bool, string) {
 func (k Keeper) DeleteAVSInfo(ctx sdk.Context, addr string) error {
 	hexAddr := common.HexToAddress(addr)
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixAVSInfo)
-	if !store.Has(hexAddr.Bytes()) {
+	value := store.Get(hexAddr.Bytes())
+	if value == nil {
 		return errorsmod.Wrap(types.ErrNoKeyInTheStore, fmt.Sprintf("AVSInfo didn't exist: key is %s", addr))
 	}
+	avs := types.AVSInfo{}
+	k.cdc.MustUnmarshal(value, &avs)
+	k.deleteTaskAddrToAVSAddr(ctx, avs.TaskAddr, addr)
 	store.Delete(hexAddr[:])
 	return nil
 }
@@ -444,6 +461,26 @@ func (k Keeper) RaiseAndResolveChallenge(ctx sdk.Context, params *types.Challeng
 			fmt.Sprintf("SetTaskResultInfo:submit  too late, CurrentEpoch:%d", epoch.CurrentEpoch),
 		)
 	}
-	return k.SetTaskChallengedInfo(ctx, params.TaskID, params.OperatorAddress.String(), params.CallerAddress,
+	err = k.SetTaskChallengedInfo(ctx, params.TaskID, params.OperatorAddress.String(), params.CallerAddress,
 		params.TaskContractAddress)
+	if err != nil {
+		return err
+	}
+	// the challenge stays pending until it is resolved by the adjudicator of the AVS.
+	err = k.SetChallengeRecord(ctx, &types.ChallengeRecord{
+		Challenger:          params.CallerAddress,
+		OperatorAddress:     params.OperatorAddress.String(),
+		TaskContractAddress: params.TaskContractAddress.String(),
+		TaskId:              params.TaskID,
+		RaisedHeight:        ctx.BlockHeight(),
+		Status:              types.ChallengeStatusPending,
+	})
+	if err != nil {
+		return err
+	}
+	// the task may already be challenged by another challenge.
+	if k.updateTaskStatus(ctx, taskInfo, types.TaskStatusChallenged) {
+		return k.SetTaskInfo(ctx, taskInfo)
+	}
+	return nil
 }
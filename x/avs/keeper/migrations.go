@@ -0,0 +1,125 @@
+package keeper
+
+import (
+	sdkmath "cosmossdk.io/math"
+
+	assetstype "github.com/ExocoreNetwork/exocore/x/assets/types"
+	"github.com/ExocoreNetwork/exocore/x/avs/types"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{
+		keeper: keeper,
+	}
+}
+
+// Migrate1to2 migrates the store from consensus version 1 to 2. It back-fills the reverse
+// lookup from the task address to the AVS address for the registered AVSs.
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	// the AVSs are collected first, since the store shouldn't be written while iterating.
+	var avsList []types.AVSInfo
+	m.keeper.IterateAVSInfo(ctx, func(_ int64, avsInfo types.AVSInfo) (stop bool) {
+		avsList = append(avsList, avsInfo)
+		return false
+	})
+	for _, avs := range avsList {
+		if avs.TaskAddr == "" {
+			continue
+		}
+		m.keeper.setTaskAddrToAVSAddr(ctx, avs.TaskAddr, avs.AvsAddress)
+	}
+	return nil
+}
+
+// Migrate2to3 migrates the store from consensus version 2 to 3. The tasks stored before their
+// status was tracked are read as pending, so their status is derived from their results,
+// statistics and challenges, and they are indexed for the status checks at the epoch ends.
+func (m Migrator) Migrate2to3(ctx sdk.Context) error {
+	// the tasks, results and challenges are collected first, since the store shouldn't be
+	// written while iterating.
+	var tasks []types.TaskInfo
+	m.keeper.IterateTaskAVSInfo(ctx, func(_ int64, task types.TaskInfo) (stop bool) {
+		tasks = append(tasks, task)
+		return false
+	})
+	responded := make(map[string]bool)
+	m.keeper.IterateResultInfo(ctx, func(_ int64, info types.TaskResultInfo) (stop bool) {
+		responded[string(taskInfoKey(info.TaskContractAddress, info.TaskId))] = true
+		return false
+	})
+	challenged := make(map[string]bool)
+	store := prefix.NewStore(ctx.KVStore(m.keeper.storeKey), types.KeyPrefixTaskChallengeResult)
+	iterator := sdk.KVStorePrefixIterator(store, nil)
+	for ; iterator.Valid(); iterator.Next() {
+		// operatorAddr + '/' + taskContractAddr + '/' + taskID
+		keys, err := assetstype.ParseJoinedKey(iterator.Key())
+		if err != nil || len(keys) != 3 {
+			continue
+		}
+		challenged[string(assetstype.GetJoinedStoreKey(keys[1], keys[2]))] = true
+	}
+	iterator.Close()
+	m.keeper.IterateChallengeRecords(ctx, func(_ int64, record types.ChallengeRecord) (stop bool) {
+		challenged[string(taskInfoKey(record.TaskContractAddress, record.TaskId))] = true
+		return false
+	})
+
+	for i := range tasks {
+		task := &tasks[i]
+		if task.Status != types.TaskStatusPending {
+			continue
+		}
+		key := string(taskInfoKey(task.TaskContractAddress, task.TaskId))
+		epochIdentifier := m.keeper.GetAVSInfoByTaskAddress(ctx, task.TaskContractAddress).EpochIdentifier
+		epochInfo, found := m.keeper.epochsKeeper.GetEpochInfo(ctx, epochIdentifier)
+		if !found {
+			// the status of a task without an epoch can't be tracked, so it is left as is.
+			ctx.Logger().Error("Migrate2to3: epoch info not found, skipping the task",
+				"task", task.TaskContractAddress, "taskID", task.TaskId, "epochIdentifier", epochIdentifier)
+			continue
+		}
+		// the epochs before the current one have ended.
+		endedEpoch := epochInfo.CurrentEpoch - 1
+		// #nosec G115
+		statisticalEnd := int64(task.StartingEpoch) + int64(task.TaskResponsePeriod) + int64(task.TaskStatisticalPeriod)
+		// #nosec G115
+		challengeEnd := statisticalEnd + int64(task.TaskChallengePeriod)
+		switch {
+		case endedEpoch >= challengeEnd:
+			task.Status = types.TaskStatusFinalized
+		case challenged[key]:
+			task.Status = types.TaskStatusChallenged
+		case task.OperatorActivePower != nil:
+			// the statistics were aggregated, the actual threshold is computed again since it
+			// was previously inverted.
+			task.Status = types.TaskStatusQuorumFailed
+			signedPower := sdkmath.LegacyZeroDec()
+			for _, power := range task.OperatorActivePower.OperatorPowerList {
+				signedPower = signedPower.Add(power.SelfActivePower)
+			}
+			task.ActualThreshold = 0
+			if !task.TaskTotalPower.IsNil() && task.TaskTotalPower.IsPositive() {
+				task.ActualThreshold = signedPower.Quo(task.TaskTotalPower).Mul(sdkmath.LegacyNewDec(100)).TruncateInt().Uint64()
+			}
+			if task.ActualThreshold >= task.ThresholdPercentage {
+				task.Status = types.TaskStatusQuorumReached
+			}
+		case responded[key]:
+			task.Status = types.TaskStatusResponded
+		case endedEpoch >= statisticalEnd:
+			task.Status = types.TaskStatusQuorumFailed
+		}
+		if err := m.keeper.SetTaskInfo(ctx, task); err != nil {
+			return err
+		}
+	}
+	return nil
+}
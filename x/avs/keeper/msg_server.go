@@ -2,9 +2,13 @@ package keeper
 
 import (
 	"context"
+	"fmt"
+	"slices"
 
+	errorsmod "cosmossdk.io/errors"
 	"github.com/ExocoreNetwork/exocore/x/avs/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
 )
 
 type MsgServerImpl struct {
@@ -26,17 +30,117 @@ func (m MsgServerImpl) SubmitTaskResult(goCtx context.Context, req *types.Submit
 	return &types.SubmitTaskResultResponse{}, nil
 }
 
-func (m MsgServerImpl) RegisterAVS(_ context.Context, _ *types.RegisterAVSReq) (*types.RegisterAVSResponse, error) {
-	// TODO implement me
-	panic("implement me")
+// RegisterAVS registers an AVS on behalf of a Cosmos-native caller. The AVS is registered at
+// the hex form of the sender's address, in the same way that the precompile registers the
+// calling contract.
+func (m MsgServerImpl) RegisterAVS(goCtx context.Context, req *types.RegisterAVSReq) (*types.RegisterAVSResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	// #nosec G703 // already validated in `ValidateBasic`
+	fromAddr, _ := sdk.AccAddressFromBech32(req.FromAddress)
+	// the keeper doesn't check the owner upon registration, since the precompile does it
+	// before calling the keeper. so, it's checked here too.
+	if !slices.Contains(req.Info.AvsOwnerAddress, req.FromAddress) {
+		return nil, errorsmod.Wrap(
+			types.ErrCallerAddressUnauthorized,
+			fmt.Sprintf("RegisterAVS: the sender %s isn't an owner of the avs", req.FromAddress),
+		)
+	}
+	info := req.Info
+	params := &types.AVSRegisterOrDeregisterParams{
+		AvsName:             info.Name,
+		AvsAddress:          common.BytesToAddress(fromAddr).String(),
+		MinStakeAmount:      info.MinStakeAmount,
+		TaskAddr:            normalizeHexAddress(info.TaskAddr),
+		SlashContractAddr:   normalizeHexAddress(info.SlashAddr),
+		RewardContractAddr:  normalizeHexAddress(info.RewardAddr),
+		AvsOwnerAddress:     info.AvsOwnerAddress,
+		AssetID:             info.AssetIDs,
+		UnbondingPeriod:     info.AvsUnbondingPeriod,
+		MinSelfDelegation:   info.MinSelfDelegation,
+		EpochIdentifier:     info.EpochIdentifier,
+		MinOptInOperators:   info.MinOptInOperators,
+		MinTotalStakeAmount: info.MinTotalStakeAmount,
+		CallerAddress:       req.FromAddress,
+		ChainID:             info.ChainId,
+		AvsReward:           percentageFromDec(info.AvsReward),
+		AvsSlash:            percentageFromDec(info.AvsSlash),
+		Action:              types.RegisterAction,
+	}
+	if err := m.keeper.UpdateAVSInfo(ctx, params); err != nil {
+		return nil, err
+	}
+	avsInfo, err := m.keeper.GetAVSInfo(ctx, params.AvsAddress)
+	if err != nil {
+		return nil, err
+	}
+	return &types.RegisterAVSResponse{
+		FromAddress: req.FromAddress,
+		Info:        avsInfo.Info,
+	}, nil
+}
+
+// DeRegisterAVS deregisters an AVS. The owner check, the unbonding period check and the name
+// check are all done by the keeper.
+func (m MsgServerImpl) DeRegisterAVS(goCtx context.Context, req *types.DeRegisterAVSReq) (*types.DeRegisterAVSResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	avsAddress := normalizeHexAddress(req.Info.AvsAddress)
+	avsInfo, err := m.keeper.GetAVSInfo(ctx, avsAddress)
+	if err != nil {
+		return nil, errorsmod.Wrap(
+			types.ErrUnregisterNonExistent, fmt.Sprintf("DeRegisterAVS: the avs address is %s", avsAddress),
+		)
+	}
+	params := &types.AVSRegisterOrDeregisterParams{
+		AvsName:       req.Info.Name,
+		AvsAddress:    avsAddress,
+		CallerAddress: req.FromAddress,
+		Action:        types.DeRegisterAction,
+	}
+	if err := m.keeper.UpdateAVSInfo(ctx, params); err != nil {
+		return nil, err
+	}
+	return &types.DeRegisterAVSResponse{
+		FromAddress: req.FromAddress,
+		Info:        avsInfo.Info,
+	}, nil
 }
 
-func (m MsgServerImpl) DeRegisterAVS(_ context.Context, _ *types.DeRegisterAVSReq) (*types.DeRegisterAVSResponse, error) {
-	// TODO implement me
-	panic("implement me")
+// RegisterAVSTask creates a task for the AVS that the task contract belongs to. The sender
+// must be one of the owners of that AVS, which is checked by the keeper.
+func (m MsgServerImpl) RegisterAVSTask(goCtx context.Context, req *types.RegisterAVSTaskReq) (*types.RegisterAVSTaskResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	task := req.Task
+	params := &types.TaskInfoParams{
+		TaskContractAddress:   normalizeHexAddress(task.TaskContractAddress),
+		TaskName:              task.Name,
+		Hash:                  task.Hash,
+		TaskResponsePeriod:    task.TaskResponsePeriod,
+		TaskStatisticalPeriod: task.TaskStatisticalPeriod,
+		TaskChallengePeriod:   task.TaskChallengePeriod,
+		ThresholdPercentage:   task.ThresholdPercentage,
+		CallerAddress:         req.FromAddress,
+	}
+	taskID, err := m.keeper.CreateAVSTask(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	return &types.RegisterAVSTaskResponse{TaskId: taskID}, nil
 }
 
-func (m MsgServerImpl) RegisterAVSTask(_ context.Context, _ *types.RegisterAVSTaskReq) (*types.RegisterAVSTaskResponse, error) {
-	// TODO implement me
-	panic("implement me")
+// normalizeHexAddress converts the hex address into the checksummed form used by the
+// precompile, since the task address is matched as a string. An empty address is kept as is.
+func normalizeHexAddress(addr string) string {
+	if addr == "" {
+		return addr
+	}
+	return common.HexToAddress(addr).String()
+}
+
+// percentageFromDec converts the proportion into the percentage used by the keeper.
+func percentageFromDec(dec sdk.Dec) uint64 {
+	if dec.IsNil() {
+		return 0
+	}
+	// #nosec G115 // the proportion is validated to be within [0, 1]
+	return uint64(dec.MulInt64(100).TruncateInt64())
 }
@@ -0,0 +1,128 @@
+package keeper_test
+
+import (
+	"time"
+
+	avskeeper "github.com/ExocoreNetwork/exocore/x/avs/keeper"
+	avstypes "github.com/ExocoreNetwork/exocore/x/avs/types"
+	epochstypes "github.com/ExocoreNetwork/exocore/x/epochs/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	utiltx "github.com/evmos/evmos/v16/testutil/tx"
+)
+
+func (suite *AVSTestSuite) TestMsgRegisterAndDeRegisterAVS() {
+	msgServer := avskeeper.NewMsgServerImpl(suite.App.AVSManagerKeeper)
+	sender := sdk.AccAddress(utiltx.GenerateAddress().Bytes())
+	other := sdk.AccAddress(utiltx.GenerateAddress().Bytes())
+	taskAddr := utiltx.GenerateAddress()
+	info := &avstypes.AVSInfo{
+		Name:               "avsTest",
+		TaskAddr:           taskAddr.Hex(),
+		SlashAddr:          utiltx.GenerateAddress().Hex(),
+		AvsOwnerAddress:    []string{sender.String()},
+		AssetIDs:           suite.AssetIDs,
+		AvsUnbondingPeriod: 1,
+		EpochIdentifier:    epochstypes.DayEpochID,
+		AvsSlash:           sdk.MustNewDecFromStr("0.1"),
+		AvsReward:          sdk.MustNewDecFromStr("0.2"),
+	}
+
+	// the sender must be an owner of the AVS
+	registerReq := &avstypes.RegisterAVSReq{FromAddress: other.String(), Info: info}
+	suite.ErrorIs(registerReq.ValidateBasic(), avstypes.ErrCallerAddressUnauthorized)
+	_, err := msgServer.RegisterAVS(suite.Ctx, registerReq)
+	suite.ErrorIs(err, avstypes.ErrCallerAddressUnauthorized)
+
+	registerReq = &avstypes.RegisterAVSReq{FromAddress: sender.String(), Info: info}
+	suite.NoError(registerReq.ValidateBasic())
+	// the amino JSON is required by the EIP-712 signing
+	suite.NotEmpty(registerReq.GetSignBytes())
+	res, err := msgServer.RegisterAVS(suite.Ctx, registerReq)
+	suite.NoError(err)
+	avsAddress := common.BytesToAddress(sender).String()
+	suite.Equal(avsAddress, res.Info.AvsAddress)
+	suite.Equal(taskAddr.String(), res.Info.TaskAddr)
+	suite.Equal(sdk.MustNewDecFromStr("0.1"), res.Info.AvsSlash)
+	suite.Equal(sdk.MustNewDecFromStr("0.2"), res.Info.AvsReward)
+	suite.Equal(avsAddress, suite.App.AVSManagerKeeper.GetAVSInfoByTaskAddress(suite.Ctx, taskAddr.String()).AvsAddress)
+
+	_, err = msgServer.RegisterAVS(suite.Ctx, registerReq)
+	suite.ErrorIs(err, avstypes.ErrAlreadyRegistered)
+
+	// a task can only be created by an owner of the AVS
+	taskReq := &avstypes.RegisterAVSTaskReq{
+		FromAddress: other.String(),
+		Task: &avstypes.TaskInfo{
+			TaskContractAddress: taskAddr.Hex(),
+			Name:                "task",
+			ThresholdPercentage: 60,
+		},
+	}
+	suite.NoError(taskReq.ValidateBasic())
+	_, err = msgServer.RegisterAVSTask(suite.Ctx, taskReq)
+	suite.ErrorIs(err, avstypes.ErrCallerAddressUnauthorized)
+
+	// the AVS can't be deregistered by a non-owner, nor within the unbonding period
+	deregisterReq := &avstypes.DeRegisterAVSReq{
+		FromAddress: other.String(),
+		Info:        &avstypes.AVSInfo{AvsAddress: avsAddress, Name: info.Name},
+	}
+	suite.NoError(deregisterReq.ValidateBasic())
+	_, err = msgServer.DeRegisterAVS(suite.Ctx, deregisterReq)
+	suite.ErrorIs(err, avstypes.ErrCallerAddressUnauthorized)
+	deregisterReq.FromAddress = sender.String()
+	_, err = msgServer.DeRegisterAVS(suite.Ctx, deregisterReq)
+	suite.ErrorIs(err, avstypes.ErrUnbondingPeriod)
+
+	suite.CommitAfter(48*time.Hour + time.Nanosecond)
+	suite.CommitAfter(48*time.Hour + time.Nanosecond)
+	suite.CommitAfter(48*time.Hour + time.Nanosecond)
+	_, err = msgServer.DeRegisterAVS(suite.Ctx, deregisterReq)
+	suite.NoError(err)
+	_, err = suite.App.AVSManagerKeeper.GetAVSInfo(suite.Ctx, avsAddress)
+	suite.Error(err)
+}
+
+func (suite *AVSTestSuite) TestRegisterAVSReqValidateBasic() {
+	sender := sdk.AccAddress(utiltx.GenerateAddress().Bytes())
+	validInfo := func() *avstypes.AVSInfo {
+		return &avstypes.AVSInfo{
+			Name:            "avsTest",
+			AvsOwnerAddress: []string{sender.String()},
+		}
+	}
+	testCases := []struct {
+		name     string
+		malleate func(info *avstypes.AVSInfo)
+		expErr   error
+	}{
+		{"valid", func(*avstypes.AVSInfo) {}, nil},
+		{"empty name", func(info *avstypes.AVSInfo) { info.Name = "" }, avstypes.ErrNotNull},
+		{
+			"mismatched avs address",
+			func(info *avstypes.AVSInfo) { info.AvsAddress = utiltx.GenerateAddress().Hex() },
+			avstypes.ErrInvalidAddr,
+		},
+		{
+			"invalid task address",
+			func(info *avstypes.AVSInfo) { info.TaskAddr = "invalid" },
+			avstypes.ErrInvalidAddr,
+		},
+		{
+			"slash proportion greater than 1",
+			func(info *avstypes.AVSInfo) { info.AvsSlash = sdk.NewDec(2) },
+			avstypes.ErrParamError,
+		},
+	}
+	for _, tc := range testCases {
+		info := validInfo()
+		tc.malleate(info)
+		err := (&avstypes.RegisterAVSReq{FromAddress: sender.String(), Info: info}).ValidateBasic()
+		if tc.expErr == nil {
+			suite.NoError(err, tc.name)
+		} else {
+			suite.ErrorIs(err, tc.expErr, tc.name)
+		}
+	}
+}
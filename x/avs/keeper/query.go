@@ -2,10 +2,13 @@ package keeper
 
 import (
 	"context"
+	"errors"
 	"strconv"
 
 	"github.com/ExocoreNetwork/exocore/x/avs/types"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 )
 
 var _ types.QueryServer = &Keeper{}
@@ -30,6 +33,16 @@ func (k Keeper) QueryAVSAddrByChainID(ctx context.Context, req *types.QueryAVSAd
 	return &types.QueryAVSAddrByChainIDResponse{AVSAddress: avsAddr}, nil
 }
 
+// QueryAVSAddrByTaskAddr is an implementation of the QueryAVSAddrByTaskAddr gRPC method
+func (k Keeper) QueryAVSAddrByTaskAddr(ctx context.Context, req *types.QueryAVSAddrByTaskAddrReq) (*types.QueryAVSAddrByTaskAddrResponse, error) {
+	c := sdk.UnwrapSDKContext(ctx)
+	avsAddr, found := k.GetAVSAddrByTaskAddr(c, req.TaskAddr)
+	if !found {
+		return nil, types.ErrNotYetRegistered
+	}
+	return &types.QueryAVSAddrByTaskAddrResponse{AVSAddress: avsAddr}, nil
+}
+
 func (k Keeper) QuerySubmitTaskResult(ctx context.Context, req *types.QuerySubmitTaskResultReq) (*types.QuerySubmitTaskResultResponse, error) {
 	c := sdk.UnwrapSDKContext(ctx)
 	id, err := strconv.ParseUint(req.TaskId, 10, 64)
@@ -51,7 +64,59 @@ func (k Keeper) QueryChallengeInfo(ctx context.Context, req *types.QueryChalleng
 	}
 
 	addr, err := k.GetTaskChallengedInfo(c, req.OperatorAddr, req.TaskAddress, id)
+	if err != nil {
+		return &types.QueryChallengeInfoResponse{}, err
+	}
+	// the challenges raised before the records were kept only have the challenge address.
+	record, err := k.GetChallengeRecord(c, req.OperatorAddr, req.TaskAddress, id)
+	if err != nil && !errors.Is(err, types.ErrNoKeyInTheStore) {
+		return &types.QueryChallengeInfoResponse{}, err
+	}
 	return &types.QueryChallengeInfoResponse{
 		ChallengeAddr: addr,
-	}, err
+		Record:        record,
+	}, nil
+}
+
+// QueryTaskStatisticsRetries is an implementation of the QueryTaskStatisticsRetries gRPC method
+func (k Keeper) QueryTaskStatisticsRetries(ctx context.Context, req *types.QueryTaskStatisticsRetriesReq) (*types.QueryTaskStatisticsRetriesResponse, error) {
+	c := sdk.UnwrapSDKContext(ctx)
+	store := prefix.NewStore(c.KVStore(k.storeKey), types.KeyPrefixTaskStatisticsRetry)
+	retries := make([]types.TaskStatisticsRetry, 0)
+	pageRes, err := query.Paginate(store, req.Pagination, func(_ []byte, value []byte) error {
+		var retry types.TaskStatisticsRetry
+		if err := k.cdc.Unmarshal(value, &retry); err != nil {
+			return err
+		}
+		retries = append(retries, retry)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryTaskStatisticsRetriesResponse{Retries: retries, Pagination: pageRes}, nil
+}
+
+// QueryTasksByStatus is an implementation of the QueryTasksByStatus gRPC method
+func (k Keeper) QueryTasksByStatus(ctx context.Context, req *types.QueryTasksByStatusReq) (*types.QueryTasksByStatusResponse, error) {
+	c := sdk.UnwrapSDKContext(ctx)
+	store := prefix.NewStore(c.KVStore(k.storeKey), types.KeyPrefixAVSTaskInfo)
+	tasks := make([]types.TaskInfo, 0)
+	pageRes, err := query.FilteredPaginate(store, req.Pagination, func(_ []byte, value []byte, accumulate bool) (bool, error) {
+		var task types.TaskInfo
+		if err := k.cdc.Unmarshal(value, &task); err != nil {
+			return false, err
+		}
+		if task.Status != req.Status {
+			return false, nil
+		}
+		if accumulate {
+			tasks = append(tasks, task)
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryTasksByStatusResponse{Tasks: tasks, Pagination: pageRes}, nil
 }
@@ -20,17 +20,42 @@ import (
 	"github.com/prysmaticlabs/prysm/v4/crypto/bls/blst"
 )
 
+// SetTaskInfo stores the task info, and indexes the task by the epoch at whose end its status
+// is checked, unless it is finalized.
 func (k Keeper) SetTaskInfo(ctx sdk.Context, task *types.TaskInfo) (err error) {
+	if err := k.setTaskInfo(ctx, task); err != nil {
+		return err
+	}
+	epochIdentifier := k.GetAVSInfoByTaskAddress(ctx, task.TaskContractAddress).EpochIdentifier
+	epochInfo, found := k.epochsKeeper.GetEpochInfo(ctx, epochIdentifier)
+	if !found {
+		// the status of a task without an epoch can't be tracked, instead of checking it at an
+		// arbitrary epoch, it isn't indexed at all.
+		k.Logger(ctx).Error("SetTaskInfo: epoch info not found, the task status isn't tracked",
+			"task", task.TaskContractAddress, "taskID", task.TaskId, "epochIdentifier", epochIdentifier)
+		k.deleteTaskStatusCheck(ctx, task)
+		return nil
+	}
+	// the status of a task is never checked before the end of the current epoch.
+	k.setTaskStatusCheck(ctx, task, epochIdentifier, epochInfo.CurrentEpoch)
+	return nil
+}
+
+// setTaskInfo stores the task info without indexing it.
+func (k Keeper) setTaskInfo(ctx sdk.Context, task *types.TaskInfo) error {
 	if !common.IsHexAddress(task.TaskContractAddress) {
 		return types.ErrInvalidAddr
 	}
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixAVSTaskInfo)
-	infoKey := assetstype.GetJoinedStoreKey(strings.ToLower(task.TaskContractAddress), strconv.FormatUint(task.TaskId, 10))
-	bz := k.cdc.MustMarshal(task)
-	store.Set(infoKey, bz)
+	store.Set(taskInfoKey(task.TaskContractAddress, task.TaskId), k.cdc.MustMarshal(task))
 	return nil
 }
 
+// taskInfoKey returns the key of the task info.
+func taskInfoKey(taskContractAddress string, taskID uint64) []byte {
+	return assetstype.GetJoinedStoreKey(strings.ToLower(taskContractAddress), strconv.FormatUint(taskID, 10))
+}
+
 func (k *Keeper) GetTaskInfo(ctx sdk.Context, taskID, taskContractAddress string) (info *types.TaskInfo, err error) {
 	if !common.IsHexAddress(taskContractAddress) {
 		return nil, types.ErrInvalidAddr
@@ -219,6 +244,10 @@ func (k *Keeper) SetTaskResultInfo(
 		store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixTaskResult)
 		bz := k.cdc.MustMarshal(info)
 		store.Set(infoKey, bz)
+		// the first result moves the task from pending to responded.
+		if k.updateTaskStatus(ctx, task, types.TaskStatusResponded) {
+			return k.SetTaskInfo(ctx, task)
+		}
 		return nil
 
 	case uint32(types.DoCommitPhase):
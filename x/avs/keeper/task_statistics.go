@@ -0,0 +1,334 @@
+package keeper
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+
+	"github.com/ExocoreNetwork/exocore/x/avs/types"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/prysmaticlabs/prysm/v4/crypto/bls/blst"
+	blscommon "github.com/prysmaticlabs/prysm/v4/crypto/bls/common"
+)
+
+// taskStatisticsRetryKey returns the key of a task in the retry queue.
+func taskStatisticsRetryKey(taskContractAddress string, taskID uint64) []byte {
+	return append(common.HexToAddress(taskContractAddress).Bytes(), sdk.Uint64ToBigEndian(taskID)...)
+}
+
+// SetTaskStatisticsRetry stores the retry queue entry of a task.
+func (k Keeper) SetTaskStatisticsRetry(ctx sdk.Context, retry *types.TaskStatisticsRetry) error {
+	if !common.IsHexAddress(retry.TaskContractAddress) {
+		return types.ErrInvalidAddr
+	}
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixTaskStatisticsRetry)
+	store.Set(taskStatisticsRetryKey(retry.TaskContractAddress, retry.TaskId), k.cdc.MustMarshal(retry))
+	return nil
+}
+
+// GetTaskStatisticsRetry returns the retry queue entry of a task, if any.
+func (k Keeper) GetTaskStatisticsRetry(
+	ctx sdk.Context, taskContractAddress string, taskID uint64,
+) (retry types.TaskStatisticsRetry, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixTaskStatisticsRetry)
+	value := store.Get(taskStatisticsRetryKey(taskContractAddress, taskID))
+	if value == nil {
+		return retry, false
+	}
+	k.cdc.MustUnmarshal(value, &retry)
+	return retry, true
+}
+
+// DeleteTaskStatisticsRetry removes a task from the retry queue.
+func (k Keeper) DeleteTaskStatisticsRetry(ctx sdk.Context, taskContractAddress string, taskID uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixTaskStatisticsRetry)
+	store.Delete(taskStatisticsRetryKey(taskContractAddress, taskID))
+}
+
+// IterateTaskStatisticsRetries iterates over the retry queue in the order of the task
+// contract address and the task ID.
+func (k Keeper) IterateTaskStatisticsRetries(ctx sdk.Context, fn func(retry types.TaskStatisticsRetry) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixTaskStatisticsRetry)
+	iterator := sdk.KVStorePrefixIterator(store, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var retry types.TaskStatisticsRetry
+		k.cdc.MustUnmarshal(iterator.Value(), &retry)
+		if fn(retry) {
+			break
+		}
+	}
+}
+
+// GetTaskResults returns the results submitted for a task, sorted by the operator address.
+func (k Keeper) GetTaskResults(ctx sdk.Context, taskContractAddress string, taskID uint64) []types.TaskResultInfo {
+	var results []types.TaskResultInfo
+	taskAddr := common.HexToAddress(taskContractAddress)
+	k.IterateResultInfo(ctx, func(_ int64, info types.TaskResultInfo) (stop bool) {
+		if info.TaskId == taskID && common.HexToAddress(info.TaskContractAddress) == taskAddr {
+			results = append(results, info)
+		}
+		return false
+	})
+	sort.Slice(results, func(i, j int) bool {
+		return results[i].OperatorAddress < results[j].OperatorAddress
+	})
+	return results
+}
+
+// aggregateTaskStatistics computes the signed and unsigned operators, their powers and the
+// actual threshold of a task from its results, and stores them in the task info. The results
+// must all belong to the same task. The state is only modified if no error is returned.
+func (k Keeper) aggregateTaskStatistics(ctx sdk.Context, results []types.TaskResultInfo) error {
+	if len(results) == 0 {
+		return errorsmod.Wrap(types.ErrNoKeyInTheStore, "aggregateTaskStatistics: no task results")
+	}
+	taskAddr := results[0].TaskContractAddress
+	taskID := results[0].TaskId
+	avsAddr, found := k.GetAVSAddrByTaskAddr(ctx, taskAddr)
+	if !found {
+		return errorsmod.Wrap(types.ErrNotYetRegistered, fmt.Sprintf("aggregateTaskStatistics: no avs for task %s", taskAddr))
+	}
+	taskInfo, err := k.GetTaskInfo(ctx, strconv.FormatUint(taskID, 10), taskAddr)
+	if err != nil {
+		return err
+	}
+
+	var signedOperatorList []string
+	var operatorPowers []*types.OperatorActivePowerInfo
+	powerByResponse := make(map[string]sdkmath.LegacyDec)
+	operatorPowerTotal := sdkmath.LegacyNewDec(0)
+	for _, res := range results {
+		// Find signed operators
+		if res.BlsSignature == nil {
+			continue
+		}
+		// a signer without a usable power is recorded with a zero power instead of failing the
+		// whole task, so that it stays consistent with the aggregated signature and bitmap.
+		activePower := sdkmath.LegacyZeroDec()
+		power, err := k.operatorKeeper.GetOperatorOptedUSDValue(ctx, avsAddr, res.OperatorAddress)
+		switch {
+		case err != nil:
+			k.Logger(ctx).Error("aggregateTaskStatistics: failed to get the power of the operator, using a zero power",
+				"operator", res.OperatorAddress, "error", err)
+		case power.ActiveUSDValue.IsNegative():
+			k.Logger(ctx).Error("aggregateTaskStatistics: the power of the operator is negative, using a zero power",
+				"operator", res.OperatorAddress, "power", power.ActiveUSDValue)
+		default:
+			activePower = power.ActiveUSDValue
+		}
+		signedOperatorList = append(signedOperatorList, res.OperatorAddress)
+		operatorPowers = append(operatorPowers, &types.OperatorActivePowerInfo{
+			OperatorAddr:    res.OperatorAddress,
+			SelfActivePower: activePower,
+		})
+		operatorPowerTotal = operatorPowerTotal.Add(activePower)
+		// the results only submitted in the first phase have no response to verify against.
+		if res.TaskResponseHash != "" {
+			if total, ok := powerByResponse[res.TaskResponseHash]; ok {
+				powerByResponse[res.TaskResponseHash] = total.Add(activePower)
+			} else {
+				powerByResponse[res.TaskResponseHash] = activePower
+			}
+		}
+	}
+	taskPowerTotal, err := k.operatorKeeper.GetAVSUSDValue(ctx, avsAddr)
+	if err != nil {
+		return errorsmod.Wrap(err, "aggregateTaskStatistics: failed to get the power of the avs")
+	}
+
+	taskInfo.SignedOperators = signedOperatorList
+	taskInfo.NoSignedOperators = types.Difference(taskInfo.OptInOperators, signedOperatorList)
+	taskInfo.OperatorActivePower = &types.OperatorActivePowerList{OperatorPowerList: operatorPowers}
+	taskInfo.TaskTotalPower = taskPowerTotal
+	// Calculate actual threshold, which is the percentage of the power of the avs held by the
+	// signers.
+	taskInfo.ActualThreshold = 0
+	if taskPowerTotal.IsPositive() {
+		actualThreshold := operatorPowerTotal.Quo(taskPowerTotal).Mul(sdk.NewDec(100))
+		taskInfo.ActualThreshold = actualThreshold.TruncateInt().Uint64()
+	}
+	// the task may still be pending if its results were stored before the status was tracked.
+	k.updateTaskStatus(ctx, taskInfo, types.TaskStatusResponded)
+	if taskInfo.ActualThreshold >= taskInfo.ThresholdPercentage {
+		k.updateTaskStatus(ctx, taskInfo, types.TaskStatusQuorumReached)
+	} else {
+		k.updateTaskStatus(ctx, taskInfo, types.TaskStatusQuorumFailed)
+	}
+	if err := k.aggregateTaskSignatures(ctx, taskInfo, results, powerByResponse); err != nil {
+		return err
+	}
+	return k.SetTaskInfo(ctx, taskInfo)
+}
+
+// aggregateTaskSignatures aggregates the BLS signatures of the opted-in operators which signed
+// the response backed by the most power, verifies the aggregate against their registered
+// public keys, and records it in the task info along with the signer bitmap.
+func (k Keeper) aggregateTaskSignatures(
+	ctx sdk.Context, taskInfo *types.TaskInfo,
+	results []types.TaskResultInfo, powerByResponse map[string]sdkmath.LegacyDec,
+) error {
+	taskInfo.AggregateResponseHash = ""
+	taskInfo.AggregateSignature = nil
+	taskInfo.SignerBitmap = nil
+	// choose the response backed by the most power, the smaller hash wins a tie.
+	responseHash := ""
+	for hash, power := range powerByResponse {
+		if responseHash == "" || power.GT(powerByResponse[responseHash]) ||
+			(power.Equal(powerByResponse[responseHash]) && hash < responseHash) {
+			responseHash = hash
+		}
+	}
+	if responseHash == "" {
+		return nil
+	}
+
+	indexes := make(map[string]int, len(taskInfo.OptInOperators))
+	for i, operator := range taskInfo.OptInOperators {
+		indexes[operator] = i
+	}
+	bitmap := make([]byte, (len(taskInfo.OptInOperators)+7)/8)
+	var pubKeys []blscommon.PublicKey
+	var sigs []blscommon.Signature
+	for _, res := range results {
+		if res.BlsSignature == nil || res.TaskResponseHash != responseHash {
+			continue
+		}
+		index, ok := indexes[res.OperatorAddress]
+		if !ok {
+			continue
+		}
+		keyInfo, err := k.GetOperatorPubKey(ctx, res.OperatorAddress)
+		if err != nil {
+			return err
+		}
+		pubKey, err := blst.PublicKeyFromBytes(keyInfo.PubKey)
+		if err != nil {
+			return errorsmod.Wrap(types.ErrParsePubKey, fmt.Sprintf("aggregateTaskSignatures: operator %s", res.OperatorAddress))
+		}
+		sig, err := blst.SignatureFromBytes(res.BlsSignature)
+		if err != nil {
+			return errorsmod.Wrap(types.ErrSigVerifyError, fmt.Sprintf("aggregateTaskSignatures: operator %s", res.OperatorAddress))
+		}
+		pubKeys = append(pubKeys, pubKey)
+		sigs = append(sigs, sig)
+		bitmap[index/8] |= 1 << (index % 8)
+	}
+	if len(sigs) == 0 {
+		return nil
+	}
+	aggregateSig := blst.AggregateSignatures(sigs)
+	if !aggregateSig.FastAggregateVerify(pubKeys, common.HexToHash(responseHash)) {
+		return errorsmod.Wrap(
+			types.ErrSigVerifyError,
+			fmt.Sprintf("aggregateTaskSignatures: task address: %s (Task ID: %d)", taskInfo.TaskContractAddress, taskInfo.TaskId),
+		)
+	}
+	taskInfo.AggregateResponseHash = responseHash
+	taskInfo.AggregateSignature = aggregateSig.Marshal()
+	taskInfo.SignerBitmap = bitmap
+	return nil
+}
+
+// tryTaskStatistics aggregates the statistics of a task within a cached context, so that
+// nothing is written if the aggregation fails half-way.
+func (k Keeper) tryTaskStatistics(ctx sdk.Context, results []types.TaskResultInfo) error {
+	cc, writeFunc := ctx.CacheContext()
+	if err := k.aggregateTaskStatistics(cc, results); err != nil {
+		return err
+	}
+	writeFunc()
+	return nil
+}
+
+// queueTaskStatistics adds a task whose statistics failed to be aggregated for the first time
+// to the retry queue.
+func (k Keeper) queueTaskStatistics(
+	ctx sdk.Context, taskAddr string, taskID uint64,
+	epochIdentifier string, epochNumber int64, aggErr error,
+) {
+	retry := &types.TaskStatisticsRetry{
+		TaskContractAddress: taskAddr,
+		TaskId:              taskID,
+		EpochIdentifier:     epochIdentifier,
+		FailedEpoch:         epochNumber,
+		LastAttemptEpoch:    epochNumber,
+		Attempts:            1,
+		LastError:           aggErr.Error(),
+	}
+	if err := k.SetTaskStatisticsRetry(ctx, retry); err != nil {
+		ctx.Logger().Error("Failed to queue the task statistics for a retry", "task", taskAddr, "taskID", taskID, "error", err)
+		return
+	}
+	emitTaskStatisticsEvent(ctx, types.EventTypeTaskStatisticsFailed, retry)
+}
+
+// retryTaskStatistics retries the aggregation of the queued tasks that belong to the epoch
+// identifier. A task leaves the queue once it succeeds or runs out of attempts.
+func (k Keeper) retryTaskStatistics(ctx sdk.Context, epochIdentifier string, epochNumber int64) {
+	// collect first, so that the store is not modified during the iteration.
+	var retries []types.TaskStatisticsRetry
+	k.IterateTaskStatisticsRetries(ctx, func(retry types.TaskStatisticsRetry) bool {
+		if retry.EpochIdentifier == epochIdentifier && retry.LastAttemptEpoch < epochNumber {
+			retries = append(retries, retry)
+		}
+		return false
+	})
+	for i := range retries {
+		retry := &retries[i]
+		retry.Attempts++
+		retry.LastAttemptEpoch = epochNumber
+		err := k.tryTaskStatistics(ctx, k.GetTaskResults(ctx, retry.TaskContractAddress, retry.TaskId))
+		if err == nil {
+			k.DeleteTaskStatisticsRetry(ctx, retry.TaskContractAddress, retry.TaskId)
+			emitTaskStatisticsEvent(ctx, types.EventTypeTaskStatisticsRetried, retry)
+			continue
+		}
+		retry.LastError = err.Error()
+		ctx.Logger().Error(
+			"Failed to retry the task statistics",
+			"task", retry.TaskContractAddress, "taskID", retry.TaskId, "attempts", retry.Attempts, "error", err,
+		)
+		if retry.Attempts >= types.MaxTaskStatisticsAttempts {
+			k.DeleteTaskStatisticsRetry(ctx, retry.TaskContractAddress, retry.TaskId)
+			emitTaskStatisticsEvent(ctx, types.EventTypeTaskStatisticsDropped, retry)
+			k.failDroppedTask(ctx, retry)
+			continue
+		}
+		// the address was validated when the entry was queued.
+		_ = k.SetTaskStatisticsRetry(ctx, retry)
+		emitTaskStatisticsEvent(ctx, types.EventTypeTaskStatisticsFailed, retry)
+	}
+}
+
+// failDroppedTask marks a task whose statistics could never be aggregated as having failed the
+// quorum, so that it can still be finalized.
+func (k Keeper) failDroppedTask(ctx sdk.Context, retry *types.TaskStatisticsRetry) {
+	taskInfo, err := k.GetTaskInfo(ctx, strconv.FormatUint(retry.TaskId, 10), retry.TaskContractAddress)
+	if err != nil {
+		return
+	}
+	k.updateTaskStatus(ctx, taskInfo, types.TaskStatusResponded)
+	if k.updateTaskStatus(ctx, taskInfo, types.TaskStatusQuorumFailed) {
+		// the address was validated when the task was stored.
+		_ = k.SetTaskInfo(ctx, taskInfo)
+	}
+}
+
+func emitTaskStatisticsEvent(ctx sdk.Context, eventType string, retry *types.TaskStatisticsRetry) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			eventType,
+			sdk.NewAttribute(types.AttributeKeyTaskContractAddress, retry.TaskContractAddress),
+			sdk.NewAttribute(types.AttributeKeyTaskID, strconv.FormatUint(retry.TaskId, 10)),
+			sdk.NewAttribute(types.AttributeKeyAttempts, strconv.FormatUint(uint64(retry.Attempts), 10)),
+			sdk.NewAttribute(types.AttributeKeyError, retry.LastError),
+		),
+	)
+}
@@ -0,0 +1,123 @@
+package keeper
+
+import (
+	"strconv"
+
+	"github.com/ExocoreNetwork/exocore/x/avs/types"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// updateTaskStatus moves the task to the next status if the transition is allowed, and emits
+// an event for it. It returns false if the transition is not allowed. The caller is
+// responsible for storing the task.
+func (k Keeper) updateTaskStatus(ctx sdk.Context, task *types.TaskInfo, next types.TaskStatus) bool {
+	if !task.Status.CanTransitionTo(next) {
+		return false
+	}
+	previous := task.Status
+	task.Status = next
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeTaskStatusUpdated,
+			sdk.NewAttribute(types.AttributeKeyTaskContractAddress, task.TaskContractAddress),
+			sdk.NewAttribute(types.AttributeKeyTaskID, strconv.FormatUint(task.TaskId, 10)),
+			sdk.NewAttribute(types.AttributeKeyPreviousStatus, previous.String()),
+			sdk.NewAttribute(types.AttributeKeyStatus, next.String()),
+		),
+	)
+	return true
+}
+
+// taskStatusDeadline returns the epoch at whose end the status of the task is due to change,
+// which is the end of the statistical period for a pending task and the end of the challenge
+// period otherwise. It returns false if the task is finalized.
+func taskStatusDeadline(task *types.TaskInfo) (int64, bool) {
+	// #nosec G115
+	statisticalEnd := int64(task.StartingEpoch) + int64(task.TaskResponsePeriod) + int64(task.TaskStatisticalPeriod)
+	switch task.Status {
+	case types.TaskStatusFinalized:
+		return 0, false
+	case types.TaskStatusPending:
+		return statisticalEnd, true
+	default:
+		// #nosec G115
+		return statisticalEnd + int64(task.TaskChallengePeriod), true
+	}
+}
+
+// setTaskStatusCheck indexes the task by the epoch at whose end its status is checked, which is
+// its deadline but no earlier than minEpoch. The previous entry of the task is replaced, and a
+// finalized task isn't indexed at all.
+func (k Keeper) setTaskStatusCheck(ctx sdk.Context, task *types.TaskInfo, epochIdentifier string, minEpoch int64) {
+	k.deleteTaskStatusCheck(ctx, task)
+	deadline, ok := taskStatusDeadline(task)
+	if !ok {
+		return
+	}
+	if deadline < minEpoch {
+		deadline = minEpoch
+	}
+	infoKey := taskInfoKey(task.TaskContractAddress, task.TaskId)
+	key := types.GetTaskStatusCheckKey(epochIdentifier, deadline, infoKey)
+	prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixTaskStatusCheck).Set(key, infoKey)
+	prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixTaskStatusCheckByTask).Set(infoKey, key)
+}
+
+// deleteTaskStatusCheck removes the entry indexing the task, if any.
+func (k Keeper) deleteTaskStatusCheck(ctx sdk.Context, task *types.TaskInfo) {
+	infoKey := taskInfoKey(task.TaskContractAddress, task.TaskId)
+	byTaskStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixTaskStatusCheckByTask)
+	if previous := byTaskStore.Get(infoKey); previous != nil {
+		prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixTaskStatusCheck).Delete(previous)
+		byTaskStore.Delete(infoKey)
+	}
+}
+
+// advanceTaskStatuses fails the tasks which received no result by the end of their statistical
+// period, and finalizes the tasks whose challenge period is over. Only the tasks indexed for
+// the ending epoch are checked.
+func (k Keeper) advanceTaskStatuses(ctx sdk.Context, epochIdentifier string, epochNumber int64) {
+	// collect first, so that the store is not modified during the iteration.
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixTaskStatusCheck)
+	iterator := sdk.KVStorePrefixIterator(store, types.IteratorPrefixForTaskStatusCheck(epochIdentifier, epochNumber))
+	var keys, infoKeys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+		infoKeys = append(infoKeys, iterator.Value())
+	}
+	iterator.Close()
+
+	taskStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixAVSTaskInfo)
+	byTaskStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixTaskStatusCheckByTask)
+	for i := range keys {
+		store.Delete(keys[i])
+		byTaskStore.Delete(infoKeys[i])
+		bz := taskStore.Get(infoKeys[i])
+		if bz == nil {
+			continue
+		}
+		var task types.TaskInfo
+		k.cdc.MustUnmarshal(bz, &task)
+		// #nosec G115
+		statisticalEnd := int64(task.StartingEpoch) + int64(task.TaskResponsePeriod) + int64(task.TaskStatisticalPeriod)
+		// #nosec G115
+		challengeEnd := statisticalEnd + int64(task.TaskChallengePeriod)
+		updated := false
+		if task.Status == types.TaskStatusPending && epochNumber >= statisticalEnd {
+			updated = k.updateTaskStatus(ctx, &task, types.TaskStatusQuorumFailed)
+		}
+		// the tasks whose statistics are waiting for a retry are finalized once they succeed.
+		if epochNumber >= challengeEnd && k.updateTaskStatus(ctx, &task, types.TaskStatusFinalized) {
+			updated = true
+		}
+		if updated {
+			if err := k.setTaskInfo(ctx, &task); err != nil {
+				ctx.Logger().Error("Failed to update the task status", "task", task.TaskContractAddress, "taskID", task.TaskId, "error", err)
+				continue
+			}
+		}
+		// the tasks which are still not finalized are checked again at a later epoch.
+		k.setTaskStatusCheck(ctx, &task, epochIdentifier, epochNumber+1)
+	}
+}
@@ -101,8 +101,23 @@ func NewAppModule(_ codec.Codec, keeper keeper.Keeper) AppModule {
 func (am AppModule) RegisterServices(cfg module.Configurator) {
 	types.RegisterMsgServer(cfg.MsgServer(), keeper.NewMsgServerImpl(am.keeper))
 	types.RegisterQueryServer(cfg.QueryServer(), am.keeper)
+
+	m := keeper.NewMigrator(am.keeper)
+	if err := cfg.RegisterMigration(types.ModuleName, 1, m.Migrate1to2); err != nil {
+		panic(err)
+	}
+	if err := cfg.RegisterMigration(types.ModuleName, 2, m.Migrate2to3); err != nil {
+		panic(err)
+	}
 }
 
+// ConsensusVersion is a sequence number for state-breaking change of the module. It should be
+// incremented on each consensus-breaking change introduced by the module. To avoid wrong/empty
+// versions, the initial version should be set to 1.
+// The chains running the module before it reported a version have 0 in their version map.
+// The v1.1.0 upgrade handler sets it to 1, so that both the migrations run from there.
+func (AppModule) ConsensusVersion() uint64 { return 3 }
+
 // RegisterStoreDecoder registers a decoder for inflation module's types.
 func (am AppModule) RegisterStoreDecoder(_ sdk.StoreDecoderRegistry) {
 }
@@ -9,19 +9,44 @@ import (
 	"github.com/cosmos/cosmos-sdk/types/msgservice"
 )
 
-func RegisterLegacyAminoCodec(_ *codec.LegacyAmino) {
-}
-
 var (
 	Amino     = codec.NewLegacyAmino()
 	ModuleCdc = codec.NewProtoCodec(cdctypes.NewInterfaceRegistry())
+
+	// AminoCdc is a amino codec created to support amino JSON compatible msgs.
+	AminoCdc = codec.NewAminoCodec(Amino)
+)
+
+const (
+	// Amino names
+	registerAVSName     = "exocore/RegisterAVSReq"
+	deRegisterAVSName   = "exocore/DeRegisterAVSReq"
+	registerAVSTaskName = "exocore/RegisterAVSTaskReq"
 )
 
+// NOTE: This is required for the GetSignBytes function
+func init() {
+	RegisterLegacyAminoCodec(Amino)
+	Amino.Seal()
+}
+
+// RegisterLegacyAminoCodec registers the necessary x/avs interfaces and concrete types on the
+// provided LegacyAmino codec. These types are used for Amino JSON serialization and EIP-712
+// compatibility.
+func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
+	cdc.RegisterConcrete(&RegisterAVSReq{}, registerAVSName, nil)
+	cdc.RegisterConcrete(&DeRegisterAVSReq{}, deRegisterAVSName, nil)
+	cdc.RegisterConcrete(&RegisterAVSTaskReq{}, registerAVSTaskName, nil)
+}
+
 // RegisterInterfaces register implementations
 func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 	registry.RegisterImplementations(
 		(*sdk.Msg)(nil),
 		&SubmitTaskResultReq{},
+		&RegisterAVSReq{},
+		&DeRegisterAVSReq{},
+		&RegisterAVSTaskReq{},
 	)
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
 }
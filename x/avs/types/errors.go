@@ -115,4 +115,8 @@ var (
 		ModuleName, 27,
 		" The response was submitted too soon.",
 	)
+	ErrChallengeAlreadyResolved = errorsmod.Register(
+		ModuleName, 28,
+		"The challenge has already been resolved",
+	)
 )
@@ -0,0 +1,25 @@
+package types
+
+// avs events
+const (
+	EventTypeChallengeResolved = "challenge_resolved"
+	// EventTypeTaskStatisticsFailed is emitted when the statistics of a task fail to be
+	// aggregated and the task is queued for a retry.
+	EventTypeTaskStatisticsFailed = "task_statistics_failed"
+	// EventTypeTaskStatisticsRetried is emitted when a queued task is aggregated successfully.
+	EventTypeTaskStatisticsRetried = "task_statistics_retried"
+	// EventTypeTaskStatisticsDropped is emitted when a queued task runs out of attempts.
+	EventTypeTaskStatisticsDropped = "task_statistics_dropped"
+	// EventTypeTaskStatusUpdated is emitted when a task moves to another status.
+	EventTypeTaskStatusUpdated = "task_status_updated"
+
+	AttributeKeyOperator            = "operator"
+	AttributeKeyTaskContractAddress = "task_contract_address"
+	AttributeKeyTaskID              = "task_id"
+	AttributeKeyChallengeStatus     = "challenge_status"
+	AttributeKeySlashID             = "slash_id"
+	AttributeKeyAttempts            = "attempts"
+	AttributeKeyError               = "error"
+	AttributeKeyPreviousStatus      = "previous_status"
+	AttributeKeyStatus              = "status"
+)
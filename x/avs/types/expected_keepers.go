@@ -43,7 +43,9 @@ type OperatorKeeper interface {
 	GetOperatorOptedUSDValue(ctx sdk.Context, avsAddr, operatorAddr string) (operatortypes.OperatorOptedUSDValue, error)
 	GetAVSUSDValue(ctx sdk.Context, avsAddr string) (sdkmath.LegacyDec, error)
 	SetOperatorInfo(ctx sdk.Context, addr string, info *operatortypes.OperatorInfo) (err error)
+	EditOperator(ctx sdk.Context, req *operatortypes.EditOperatorReq) error
 	OperatorInfo(ctx sdk.Context, addr string) (info *operatortypes.OperatorInfo, err error)
+	Slash(ctx sdk.Context, parameter *operatortypes.SlashInputInfo) error
 }
 
 // AssetsKeeper represents the expected keeper interface for the assets module.
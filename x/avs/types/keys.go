@@ -1,7 +1,10 @@
 package types
 
 import (
+	"strings"
+
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 )
@@ -30,6 +33,11 @@ const (
 	LatestTaskNum
 	TaskResult
 	TaskChallengeResult
+	TaskChallengeRecord
+	prefixTaskAddrToAVSAddr
+	prefixTaskStatisticsRetry
+	prefixTaskStatusCheck
+	prefixTaskStatusCheckByTask
 )
 
 // ModuleAddress is the native module address for EVM
@@ -45,8 +53,42 @@ var (
 	KeyPrefixLatestTaskNum       = []byte{LatestTaskNum}
 	KeyPrefixTaskResult          = []byte{TaskResult}
 	KeyPrefixTaskChallengeResult = []byte{TaskChallengeResult}
+	// KeyPrefixTaskChallengeRecord key-value:
+	// operatorAddr + '/' + taskContractAddr + '/' + taskID -> ChallengeRecord
+	KeyPrefixTaskChallengeRecord = []byte{TaskChallengeRecord}
+	// KeyPrefixTaskAddrToAVSAddr is used to store the reverse lookup from the task contract
+	// address to the AVS address. Both addresses are stored as hex bytes.
+	KeyPrefixTaskAddrToAVSAddr = []byte{prefixTaskAddrToAVSAddr}
+	// KeyPrefixTaskStatisticsRetry key-value:
+	// taskContractAddr (hex bytes) + taskID (big endian) -> TaskStatisticsRetry
+	KeyPrefixTaskStatisticsRetry = []byte{prefixTaskStatisticsRetry}
+	// KeyPrefixTaskStatusCheck key-value:
+	// epochIdentifier + '/' + epochNumber + '/' + taskContractAddr + '/' + taskID -> task info key
+	// It indexes the tasks which aren't finalized by the epoch at whose end their status is checked.
+	KeyPrefixTaskStatusCheck = []byte{prefixTaskStatusCheck}
+	// KeyPrefixTaskStatusCheckByTask key-value:
+	// taskContractAddr + '/' + taskID -> KeyPrefixTaskStatusCheck key of the task
+	// It is the reverse lookup, so that a task is indexed for a single epoch at a time.
+	KeyPrefixTaskStatusCheckByTask = []byte{prefixTaskStatusCheckByTask}
 )
 
+// MaxTaskStatisticsAttempts is the maximum number of times the statistics of a task are
+// aggregated, including the first attempt at the end of its statistical period. Once it is
+// reached, the task is dropped from the retry queue.
+const MaxTaskStatisticsAttempts = 5
+
+// GetTaskStatusCheckKey returns the key of a task whose status is checked at the end of the epoch.
+func GetTaskStatusCheckKey(epochIdentifier string, epochNumber int64, taskInfoKey []byte) []byte {
+	return append(IteratorPrefixForTaskStatusCheck(epochIdentifier, epochNumber), taskInfoKey...)
+}
+
+// IteratorPrefixForTaskStatusCheck returns the prefix to iterate the tasks whose status is
+// checked at the end of the epoch.
+func IteratorPrefixForTaskStatusCheck(epochIdentifier string, epochNumber int64) []byte {
+	// #nosec G115
+	return []byte(strings.Join([]string{epochIdentifier, hexutil.EncodeUint64(uint64(epochNumber)), ""}, "/"))
+}
+
 func init() {
 	ModuleAddress = common.BytesToAddress(authtypes.NewModuleAddress(ModuleName).Bytes())
 }
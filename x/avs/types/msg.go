@@ -1,18 +1,29 @@
 package types
 
 import (
+	"slices"
+
 	errorsmod "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
 )
 
 var (
 	_ sdk.Msg = &RegisterAVSReq{}
 	_ sdk.Msg = &DeRegisterAVSReq{}
+	_ sdk.Msg = &RegisterAVSTaskReq{}
+	_ sdk.Msg = &SubmitTaskResultReq{}
 )
 
 const (
 	// TypeSubmitTaskResultReq is the type for the RegisterOperatorReq message.
 	TypeSubmitTaskResultReq = "register_operator"
+	// TypeRegisterAVSReq is the type for the RegisterAVSReq message.
+	TypeRegisterAVSReq = "register_avs"
+	// TypeDeRegisterAVSReq is the type for the DeRegisterAVSReq message.
+	TypeDeRegisterAVSReq = "deregister_avs"
+	// TypeRegisterAVSTaskReq is the type for the RegisterAVSTaskReq message.
+	TypeRegisterAVSTaskReq = "register_avs_task"
 )
 
 // GetSigners returns the expected signers for the message.
@@ -44,26 +55,71 @@ func (m *SubmitTaskResultReq) GetSignBytes() []byte {
 	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(m))
 }
 
-// GetSigners returns the expected signers for a MsgUpdateParams message.
+// GetSigners returns the expected signers for the message.
 func (m *RegisterAVSReq) GetSigners() []sdk.AccAddress {
 	addr := sdk.MustAccAddressFromBech32(m.FromAddress)
 	return []sdk.AccAddress{addr}
 }
 
-// ValidateBasic does a sanity check of the provided data
+// ValidateBasic does a sanity check of the provided data. The sender must be one of the
+// owners, since the AVS is registered at the hex form of the sender's address.
 func (m *RegisterAVSReq) ValidateBasic() error {
-	if _, err := sdk.AccAddressFromBech32(m.FromAddress); err != nil {
+	fromAddr, err := sdk.AccAddressFromBech32(m.FromAddress)
+	if err != nil {
 		return errorsmod.Wrap(err, "invalid from address")
 	}
+	if m.Info == nil {
+		return errorsmod.Wrap(ErrNotNull, "avs info is nil")
+	}
+	if m.Info.Name == "" {
+		return errorsmod.Wrap(ErrNotNull, "avs name is empty")
+	}
+	if m.Info.AvsAddress != "" &&
+		common.HexToAddress(m.Info.AvsAddress) != common.BytesToAddress(fromAddr) {
+		return errorsmod.Wrapf(
+			ErrInvalidAddr, "avs address %s doesn't match the sender %s", m.Info.AvsAddress, m.FromAddress,
+		)
+	}
+	if !slices.Contains(m.Info.AvsOwnerAddress, m.FromAddress) {
+		return errorsmod.Wrapf(
+			ErrCallerAddressUnauthorized, "the sender %s isn't an owner of the avs", m.FromAddress,
+		)
+	}
+	for _, owner := range m.Info.AvsOwnerAddress {
+		if _, err := sdk.AccAddressFromBech32(owner); err != nil {
+			return errorsmod.Wrapf(ErrInvalidAddr, "invalid owner address %s: %s", owner, err)
+		}
+	}
+	for _, addr := range []string{m.Info.TaskAddr, m.Info.SlashAddr, m.Info.RewardAddr} {
+		if addr != "" && !common.IsHexAddress(addr) {
+			return errorsmod.Wrapf(ErrInvalidAddr, "invalid contract address %s", addr)
+		}
+	}
+	if !m.Info.AvsSlash.IsNil() && (m.Info.AvsSlash.IsNegative() || m.Info.AvsSlash.GT(sdk.OneDec())) {
+		return errorsmod.Wrapf(ErrParamError, "invalid avs slash proportion %s", m.Info.AvsSlash)
+	}
+	if !m.Info.AvsReward.IsNil() && (m.Info.AvsReward.IsNegative() || m.Info.AvsReward.GT(sdk.OneDec())) {
+		return errorsmod.Wrapf(ErrParamError, "invalid avs reward proportion %s", m.Info.AvsReward)
+	}
 	return nil
 }
 
-// GetSignBytes implements the LegacyMsg interface.
+// Route returns the transaction route.
+func (m *RegisterAVSReq) Route() string {
+	return RouterKey
+}
+
+// Type returns the transaction type.
+func (m *RegisterAVSReq) Type() string {
+	return TypeRegisterAVSReq
+}
+
+// GetSignBytes returns the bytes all expected signers must sign over.
 func (m *RegisterAVSReq) GetSignBytes() []byte {
-	return nil
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(m))
 }
 
-// GetSigners returns the expected signers for a MsgUpdateParams message.
+// GetSigners returns the expected signers for the message.
 func (m *DeRegisterAVSReq) GetSigners() []sdk.AccAddress {
 	addr := sdk.MustAccAddressFromBech32(m.FromAddress)
 	return []sdk.AccAddress{addr}
@@ -74,15 +130,34 @@ func (m *DeRegisterAVSReq) ValidateBasic() error {
 	if _, err := sdk.AccAddressFromBech32(m.FromAddress); err != nil {
 		return errorsmod.Wrap(err, "invalid from address")
 	}
+	if m.Info == nil {
+		return errorsmod.Wrap(ErrNotNull, "avs info is nil")
+	}
+	if !common.IsHexAddress(m.Info.AvsAddress) {
+		return errorsmod.Wrapf(ErrInvalidAddr, "invalid avs address %s", m.Info.AvsAddress)
+	}
+	if m.Info.Name == "" {
+		return errorsmod.Wrap(ErrNotNull, "avs name is empty")
+	}
 	return nil
 }
 
-// GetSignBytes implements the LegacyMsg interface.
+// Route returns the transaction route.
+func (m *DeRegisterAVSReq) Route() string {
+	return RouterKey
+}
+
+// Type returns the transaction type.
+func (m *DeRegisterAVSReq) Type() string {
+	return TypeDeRegisterAVSReq
+}
+
+// GetSignBytes returns the bytes all expected signers must sign over.
 func (m *DeRegisterAVSReq) GetSignBytes() []byte {
-	return nil
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(m))
 }
 
-// GetSigners returns the expected signers for a MsgUpdateParams message.
+// GetSigners returns the expected signers for the message.
 func (m *RegisterAVSTaskReq) GetSigners() []sdk.AccAddress {
 	addr := sdk.MustAccAddressFromBech32(m.FromAddress)
 	return []sdk.AccAddress{addr}
@@ -93,10 +168,32 @@ func (m *RegisterAVSTaskReq) ValidateBasic() error {
 	if _, err := sdk.AccAddressFromBech32(m.FromAddress); err != nil {
 		return errorsmod.Wrap(err, "invalid from address")
 	}
+	if m.Task == nil {
+		return errorsmod.Wrap(ErrNotNull, "task info is nil")
+	}
+	if !common.IsHexAddress(m.Task.TaskContractAddress) {
+		return errorsmod.Wrapf(ErrInvalidAddr, "invalid task contract address %s", m.Task.TaskContractAddress)
+	}
+	if m.Task.Name == "" {
+		return errorsmod.Wrap(ErrNotNull, "task name is empty")
+	}
+	if m.Task.ThresholdPercentage > 100 {
+		return errorsmod.Wrapf(ErrParamError, "invalid threshold percentage %d", m.Task.ThresholdPercentage)
+	}
 	return nil
 }
 
-// GetSignBytes implements the LegacyMsg interface.
+// Route returns the transaction route.
+func (m *RegisterAVSTaskReq) Route() string {
+	return RouterKey
+}
+
+// Type returns the transaction type.
+func (m *RegisterAVSTaskReq) Type() string {
+	return TypeRegisterAVSTaskReq
+}
+
+// GetSignBytes returns the bytes all expected signers must sign over.
 func (m *RegisterAVSTaskReq) GetSignBytes() []byte {
-	return nil
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(m))
 }
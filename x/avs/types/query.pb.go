@@ -7,6 +7,7 @@ import (
 	context "context"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
+	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -214,6 +215,98 @@ func (m *QueryAVSAddrByChainIDResponse) GetAVSAddress() string {
 	return ""
 }
 
+// QueryAVSAddrByTaskAddrReq is the request to query avs address by task contract address
+type QueryAVSAddrByTaskAddrReq struct {
+	// task_addr is the hex address of the task contract
+	TaskAddr string `protobuf:"bytes,1,opt,name=task_addr,json=taskAddr,proto3" json:"task_addr,omitempty"`
+}
+
+func (m *QueryAVSAddrByTaskAddrReq) Reset()         { *m = QueryAVSAddrByTaskAddrReq{} }
+func (m *QueryAVSAddrByTaskAddrReq) String() string { return proto.CompactTextString(m) }
+func (*QueryAVSAddrByTaskAddrReq) ProtoMessage()    {}
+func (*QueryAVSAddrByTaskAddrReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fd804655b77429f2, []int{4}
+}
+func (m *QueryAVSAddrByTaskAddrReq) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAVSAddrByTaskAddrReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAVSAddrByTaskAddrReq.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAVSAddrByTaskAddrReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAVSAddrByTaskAddrReq.Merge(m, src)
+}
+func (m *QueryAVSAddrByTaskAddrReq) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAVSAddrByTaskAddrReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAVSAddrByTaskAddrReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAVSAddrByTaskAddrReq proto.InternalMessageInfo
+
+func (m *QueryAVSAddrByTaskAddrReq) GetTaskAddr() string {
+	if m != nil {
+		return m.TaskAddr
+	}
+	return ""
+}
+
+// QueryAVSAddrByTaskAddrResponse is the response of avs address by task contract address
+type QueryAVSAddrByTaskAddrResponse struct {
+	// avs_address is the hex address of avs
+	AVSAddress string `protobuf:"bytes,1,opt,name=avs_address,json=avsAddress,proto3" json:"avs_address,omitempty"`
+}
+
+func (m *QueryAVSAddrByTaskAddrResponse) Reset()         { *m = QueryAVSAddrByTaskAddrResponse{} }
+func (m *QueryAVSAddrByTaskAddrResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAVSAddrByTaskAddrResponse) ProtoMessage()    {}
+func (*QueryAVSAddrByTaskAddrResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fd804655b77429f2, []int{5}
+}
+func (m *QueryAVSAddrByTaskAddrResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAVSAddrByTaskAddrResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAVSAddrByTaskAddrResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAVSAddrByTaskAddrResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAVSAddrByTaskAddrResponse.Merge(m, src)
+}
+func (m *QueryAVSAddrByTaskAddrResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAVSAddrByTaskAddrResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAVSAddrByTaskAddrResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAVSAddrByTaskAddrResponse proto.InternalMessageInfo
+
+func (m *QueryAVSAddrByTaskAddrResponse) GetAVSAddress() string {
+	if m != nil {
+		return m.AVSAddress
+	}
+	return ""
+}
+
 // QueryAVSTaskInfoReq is the request to obtain the task information.
 type QueryAVSTaskInfoReq struct {
 	// task_addr is the task contract address,its type should be a sdk.AccAddress
@@ -226,7 +319,7 @@ func (m *QueryAVSTaskInfoReq) Reset()         { *m = QueryAVSTaskInfoReq{} }
 func (m *QueryAVSTaskInfoReq) String() string { return proto.CompactTextString(m) }
 func (*QueryAVSTaskInfoReq) ProtoMessage()    {}
 func (*QueryAVSTaskInfoReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fd804655b77429f2, []int{4}
+	return fileDescriptor_fd804655b77429f2, []int{6}
 }
 func (m *QueryAVSTaskInfoReq) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -283,7 +376,7 @@ func (m *QuerySubmitTaskResultReq) Reset()         { *m = QuerySubmitTaskResultR
 func (m *QuerySubmitTaskResultReq) String() string { return proto.CompactTextString(m) }
 func (*QuerySubmitTaskResultReq) ProtoMessage()    {}
 func (*QuerySubmitTaskResultReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fd804655b77429f2, []int{5}
+	return fileDescriptor_fd804655b77429f2, []int{7}
 }
 func (m *QuerySubmitTaskResultReq) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -347,7 +440,7 @@ func (m *QueryChallengeInfoReq) Reset()         { *m = QueryChallengeInfoReq{} }
 func (m *QueryChallengeInfoReq) String() string { return proto.CompactTextString(m) }
 func (*QueryChallengeInfoReq) ProtoMessage()    {}
 func (*QueryChallengeInfoReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fd804655b77429f2, []int{6}
+	return fileDescriptor_fd804655b77429f2, []int{8}
 }
 func (m *QueryChallengeInfoReq) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -407,7 +500,7 @@ func (m *QuerySubmitTaskResultResponse) Reset()         { *m = QuerySubmitTaskRe
 func (m *QuerySubmitTaskResultResponse) String() string { return proto.CompactTextString(m) }
 func (*QuerySubmitTaskResultResponse) ProtoMessage()    {}
 func (*QuerySubmitTaskResultResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fd804655b77429f2, []int{7}
+	return fileDescriptor_fd804655b77429f2, []int{9}
 }
 func (m *QuerySubmitTaskResultResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -447,13 +540,16 @@ func (m *QuerySubmitTaskResultResponse) GetInfo() *TaskResultInfo {
 type QueryChallengeInfoResponse struct {
 	// challenge_addr is the challenge address,its type should be a common.HexAddress.
 	ChallengeAddr string `protobuf:"bytes,1,opt,name=challenge_addr,json=challengeAddr,proto3" json:"challenge_addr,omitempty"`
+	// record is the lifecycle record of the challenge, including its adjudication status.
+	// It is empty for the challenges raised before the records were kept.
+	Record *ChallengeRecord `protobuf:"bytes,2,opt,name=record,proto3" json:"record,omitempty"`
 }
 
 func (m *QueryChallengeInfoResponse) Reset()         { *m = QueryChallengeInfoResponse{} }
 func (m *QueryChallengeInfoResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryChallengeInfoResponse) ProtoMessage()    {}
 func (*QueryChallengeInfoResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fd804655b77429f2, []int{8}
+	return fileDescriptor_fd804655b77429f2, []int{10}
 }
 func (m *QueryChallengeInfoResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -489,64 +585,307 @@ func (m *QueryChallengeInfoResponse) GetChallengeAddr() string {
 	return ""
 }
 
+func (m *QueryChallengeInfoResponse) GetRecord() *ChallengeRecord {
+	if m != nil {
+		return m.Record
+	}
+	return nil
+}
+
+// QueryTaskStatisticsRetriesReq is the request to query the task statistics retry queue.
+type QueryTaskStatisticsRetriesReq struct {
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryTaskStatisticsRetriesReq) Reset()         { *m = QueryTaskStatisticsRetriesReq{} }
+func (m *QueryTaskStatisticsRetriesReq) String() string { return proto.CompactTextString(m) }
+func (*QueryTaskStatisticsRetriesReq) ProtoMessage()    {}
+func (*QueryTaskStatisticsRetriesReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fd804655b77429f2, []int{11}
+}
+func (m *QueryTaskStatisticsRetriesReq) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTaskStatisticsRetriesReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTaskStatisticsRetriesReq.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTaskStatisticsRetriesReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTaskStatisticsRetriesReq.Merge(m, src)
+}
+func (m *QueryTaskStatisticsRetriesReq) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTaskStatisticsRetriesReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTaskStatisticsRetriesReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTaskStatisticsRetriesReq proto.InternalMessageInfo
+
+func (m *QueryTaskStatisticsRetriesReq) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryTaskStatisticsRetriesResponse is the response of the task statistics retry queue.
+type QueryTaskStatisticsRetriesResponse struct {
+	// retries is the list of tasks waiting for their statistics to be aggregated again.
+	Retries []TaskStatisticsRetry `protobuf:"bytes,1,rep,name=retries,proto3" json:"retries"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryTaskStatisticsRetriesResponse) Reset()         { *m = QueryTaskStatisticsRetriesResponse{} }
+func (m *QueryTaskStatisticsRetriesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryTaskStatisticsRetriesResponse) ProtoMessage()    {}
+func (*QueryTaskStatisticsRetriesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fd804655b77429f2, []int{12}
+}
+func (m *QueryTaskStatisticsRetriesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTaskStatisticsRetriesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTaskStatisticsRetriesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTaskStatisticsRetriesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTaskStatisticsRetriesResponse.Merge(m, src)
+}
+func (m *QueryTaskStatisticsRetriesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTaskStatisticsRetriesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTaskStatisticsRetriesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTaskStatisticsRetriesResponse proto.InternalMessageInfo
+
+func (m *QueryTaskStatisticsRetriesResponse) GetRetries() []TaskStatisticsRetry {
+	if m != nil {
+		return m.Retries
+	}
+	return nil
+}
+
+func (m *QueryTaskStatisticsRetriesResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryTasksByStatusReq is the request to query the tasks by their status.
+type QueryTasksByStatusReq struct {
+	// status is the status of the tasks to query.
+	Status TaskStatus `protobuf:"varint,1,opt,name=status,proto3,enum=exocore.avs.v1.TaskStatus" json:"status,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryTasksByStatusReq) Reset()         { *m = QueryTasksByStatusReq{} }
+func (m *QueryTasksByStatusReq) String() string { return proto.CompactTextString(m) }
+func (*QueryTasksByStatusReq) ProtoMessage()    {}
+func (*QueryTasksByStatusReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fd804655b77429f2, []int{13}
+}
+func (m *QueryTasksByStatusReq) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTasksByStatusReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTasksByStatusReq.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTasksByStatusReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTasksByStatusReq.Merge(m, src)
+}
+func (m *QueryTasksByStatusReq) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTasksByStatusReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTasksByStatusReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTasksByStatusReq proto.InternalMessageInfo
+
+func (m *QueryTasksByStatusReq) GetStatus() TaskStatus {
+	if m != nil {
+		return m.Status
+	}
+	return TaskStatusPending
+}
+
+func (m *QueryTasksByStatusReq) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryTasksByStatusResponse is the response of the tasks with the queried status.
+type QueryTasksByStatusResponse struct {
+	// tasks is the list of tasks with the queried status.
+	Tasks []TaskInfo `protobuf:"bytes,1,rep,name=tasks,proto3" json:"tasks"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryTasksByStatusResponse) Reset()         { *m = QueryTasksByStatusResponse{} }
+func (m *QueryTasksByStatusResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryTasksByStatusResponse) ProtoMessage()    {}
+func (*QueryTasksByStatusResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fd804655b77429f2, []int{14}
+}
+func (m *QueryTasksByStatusResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTasksByStatusResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTasksByStatusResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTasksByStatusResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTasksByStatusResponse.Merge(m, src)
+}
+func (m *QueryTasksByStatusResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTasksByStatusResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTasksByStatusResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTasksByStatusResponse proto.InternalMessageInfo
+
+func (m *QueryTasksByStatusResponse) GetTasks() []TaskInfo {
+	if m != nil {
+		return m.Tasks
+	}
+	return nil
+}
+
+func (m *QueryTasksByStatusResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryAVSInfoReq)(nil), "exocore.avs.v1.QueryAVSInfoReq")
 	proto.RegisterType((*QueryAVSInfoResponse)(nil), "exocore.avs.v1.QueryAVSInfoResponse")
 	proto.RegisterType((*QueryAVSAddrByChainIDReq)(nil), "exocore.avs.v1.QueryAVSAddrByChainIDReq")
 	proto.RegisterType((*QueryAVSAddrByChainIDResponse)(nil), "exocore.avs.v1.QueryAVSAddrByChainIDResponse")
+	proto.RegisterType((*QueryAVSAddrByTaskAddrReq)(nil), "exocore.avs.v1.QueryAVSAddrByTaskAddrReq")
+	proto.RegisterType((*QueryAVSAddrByTaskAddrResponse)(nil), "exocore.avs.v1.QueryAVSAddrByTaskAddrResponse")
 	proto.RegisterType((*QueryAVSTaskInfoReq)(nil), "exocore.avs.v1.QueryAVSTaskInfoReq")
 	proto.RegisterType((*QuerySubmitTaskResultReq)(nil), "exocore.avs.v1.QuerySubmitTaskResultReq")
 	proto.RegisterType((*QueryChallengeInfoReq)(nil), "exocore.avs.v1.QueryChallengeInfoReq")
 	proto.RegisterType((*QuerySubmitTaskResultResponse)(nil), "exocore.avs.v1.QuerySubmitTaskResultResponse")
 	proto.RegisterType((*QueryChallengeInfoResponse)(nil), "exocore.avs.v1.QueryChallengeInfoResponse")
+	proto.RegisterType((*QueryTaskStatisticsRetriesReq)(nil), "exocore.avs.v1.QueryTaskStatisticsRetriesReq")
+	proto.RegisterType((*QueryTaskStatisticsRetriesResponse)(nil), "exocore.avs.v1.QueryTaskStatisticsRetriesResponse")
+	proto.RegisterType((*QueryTasksByStatusReq)(nil), "exocore.avs.v1.QueryTasksByStatusReq")
+	proto.RegisterType((*QueryTasksByStatusResponse)(nil), "exocore.avs.v1.QueryTasksByStatusResponse")
 }
 
 func init() { proto.RegisterFile("exocore/avs/v1/query.proto", fileDescriptor_fd804655b77429f2) }
 
 var fileDescriptor_fd804655b77429f2 = []byte{
-	// 669 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x95, 0xcd, 0x6e, 0xd3, 0x40,
-	0x10, 0xc7, 0xeb, 0x42, 0xbf, 0x36, 0xfd, 0x40, 0x4b, 0x51, 0x53, 0x03, 0x0e, 0x35, 0x2d, 0xad,
-	0x0a, 0xb1, 0xd5, 0x22, 0x8e, 0x1c, 0x9a, 0x80, 0xaa, 0x5c, 0x10, 0x38, 0x55, 0x0f, 0x5c, 0xaa,
-	0x6d, 0xbc, 0x75, 0xad, 0xa6, 0xde, 0xd4, 0xbb, 0x31, 0xe9, 0x0d, 0xf1, 0x04, 0x95, 0xb8, 0x72,
-	0xe0, 0x21, 0x90, 0x78, 0x05, 0x8e, 0x15, 0x08, 0x89, 0x53, 0x85, 0x1c, 0x1e, 0x04, 0xed, 0x7a,
-	0x1d, 0x6c, 0x27, 0x4e, 0x5b, 0x89, 0x5b, 0x76, 0x3e, 0xfe, 0xf3, 0xdb, 0xf1, 0xcc, 0x06, 0xa8,
-	0xb8, 0x43, 0x1a, 0xc4, 0xc7, 0x26, 0x0a, 0xa8, 0x19, 0x6c, 0x98, 0x27, 0x6d, 0xec, 0x9f, 0x1a,
-	0x2d, 0x9f, 0x30, 0x02, 0x67, 0xa5, 0xcf, 0x40, 0x01, 0x35, 0x82, 0x0d, 0x75, 0xb1, 0x41, 0xe8,
-	0x31, 0xa1, 0x7b, 0xc2, 0x6b, 0x46, 0x87, 0x28, 0x54, 0x5d, 0xc8, 0xc8, 0xb0, 0x8e, 0x74, 0xcc,
-	0x3b, 0xc4, 0x21, 0x51, 0x02, 0xff, 0x25, 0xad, 0xf7, 0x1c, 0x42, 0x9c, 0x26, 0x36, 0x51, 0xcb,
-	0x35, 0x91, 0xe7, 0x11, 0x86, 0x98, 0x4b, 0x3c, 0x29, 0xa6, 0x57, 0xc0, 0xdc, 0x1b, 0x8e, 0xb1,
-	0xb5, 0x5b, 0xaf, 0x79, 0x07, 0xc4, 0xc2, 0x27, 0xd0, 0x04, 0x05, 0x14, 0xd0, 0x3d, 0x64, 0xdb,
-	0x3e, 0xa6, 0xb4, 0xa8, 0x3c, 0x50, 0xd6, 0xa6, 0x2a, 0xb3, 0xe1, 0x45, 0x09, 0x6c, 0xed, 0xd6,
-	0xb7, 0x22, 0xab, 0x05, 0x50, 0x40, 0xe5, 0x6f, 0xbd, 0x0a, 0xe6, 0xd3, 0x1a, 0xb4, 0x45, 0x3c,
-	0x8a, 0xe1, 0x63, 0x70, 0xd3, 0xf5, 0x0e, 0x88, 0x50, 0x28, 0x6c, 0x2e, 0x18, 0xe9, 0x2b, 0x1a,
-	0x71, 0xb8, 0x08, 0xd2, 0x2b, 0xa0, 0x18, 0x8b, 0x70, 0xdd, 0xca, 0x69, 0xf5, 0x10, 0xb9, 0x5e,
-	0xed, 0x05, 0x27, 0x7a, 0x04, 0x26, 0x1b, 0xfc, 0xb4, 0xe7, 0xda, 0x12, 0xa7, 0x10, 0x5e, 0x94,
-	0x26, 0xe2, 0x88, 0x09, 0xe1, 0xac, 0xd9, 0xfa, 0x6b, 0x70, 0x3f, 0x47, 0x43, 0x12, 0x5d, 0xfb,
-	0x6a, 0x18, 0xdc, 0x8e, 0x15, 0x77, 0x10, 0x3d, 0x8a, 0x5b, 0xf4, 0x0c, 0x4c, 0x31, 0x44, 0x8f,
-	0x84, 0x90, 0x54, 0x29, 0x7e, 0xff, 0x52, 0x9e, 0x97, 0xdf, 0x49, 0x66, 0xd7, 0x99, 0xef, 0x7a,
-	0x8e, 0x35, 0xc9, 0x43, 0xb9, 0x09, 0x2e, 0x80, 0x09, 0x91, 0xe6, 0xda, 0xc5, 0x51, 0x9e, 0x64,
-	0x8d, 0xf3, 0x63, 0xcd, 0xd6, 0x3f, 0x2b, 0xf2, 0xf6, 0xf5, 0xf6, 0xfe, 0xb1, 0xcb, 0x78, 0x29,
-	0x0b, 0xd3, 0x76, 0x93, 0xf1, 0x62, 0x4f, 0xfa, 0x8b, 0xcd, 0x85, 0x17, 0xa5, 0xc2, 0x8e, 0x94,
-	0xe5, 0xcc, 0x97, 0xd7, 0x80, 0xcf, 0xc1, 0x0c, 0x69, 0x61, 0x1f, 0x31, 0xe2, 0x47, 0x52, 0x37,
-	0x2e, 0xe1, 0x9e, 0x8e, 0xc3, 0xb9, 0x59, 0xff, 0xaa, 0x80, 0x3b, 0x02, 0xb1, 0x7a, 0x88, 0x9a,
-	0x4d, 0xec, 0x39, 0x38, 0x6e, 0xc6, 0xf5, 0xf8, 0x36, 0x32, 0x7c, 0x43, 0x00, 0xfe, 0x13, 0x79,
-	0x5d, 0x4e, 0x45, 0x7f, 0x6f, 0xe5, 0x54, 0x6c, 0xa6, 0xe6, 0x54, 0xcb, 0xce, 0xe9, 0xbf, 0x8c,
-	0xc4, 0xb8, 0x56, 0x81, 0x3a, 0xa8, 0x1b, 0x52, 0x71, 0x05, 0xcc, 0x36, 0x62, 0x47, 0xa2, 0x2f,
-	0xd6, 0x4c, 0xcf, 0xca, 0xc9, 0x36, 0x7f, 0x8e, 0x81, 0x31, 0xa1, 0x02, 0x3b, 0x60, 0x3a, 0xb9,
-	0x42, 0xb0, 0x94, 0x85, 0xc8, 0x2c, 0xa9, 0xba, 0x3c, 0x3c, 0x20, 0xe2, 0xd0, 0x97, 0x3e, 0xfc,
-	0xf8, 0xf3, 0x71, 0xf4, 0x2e, 0x5c, 0x34, 0x93, 0x6f, 0x46, 0xaa, 0xd2, 0x7b, 0x05, 0xdc, 0xca,
-	0x8e, 0x38, 0x7c, 0x98, 0xa7, 0x9e, 0x58, 0x02, 0xb5, 0x38, 0xa8, 0x51, 0xdc, 0xa9, 0x97, 0x45,
-	0xd9, 0x55, 0xb8, 0x92, 0x2c, 0xcb, 0x3f, 0x26, 0x7f, 0xae, 0xb6, 0x31, 0xcb, 0x6c, 0xd3, 0xa7,
-	0x78, 0xb4, 0xb2, 0x7b, 0x0b, 0xd7, 0xf2, 0x38, 0xb2, 0x4f, 0x84, 0x5a, 0xbe, 0x62, 0xa4, 0x6c,
-	0xcc, 0xba, 0x20, 0x5c, 0x86, 0xfa, 0xc0, 0xc6, 0xa4, 0x21, 0x7a, 0x78, 0xd9, 0x01, 0xca, 0xc1,
-	0x1b, 0xb0, 0xc3, 0x39, 0x78, 0x79, 0x13, 0x39, 0x0c, 0xaf, 0x0f, 0xe2, 0x4c, 0x01, 0xb0, 0x7f,
-	0x14, 0xe1, 0xca, 0xc0, 0x8a, 0xd9, 0xe5, 0x55, 0xd7, 0xaf, 0x12, 0x26, 0xa9, 0x56, 0x05, 0xd5,
-	0x12, 0x2c, 0xf5, 0x53, 0xa5, 0x12, 0x2a, 0xdb, 0xdf, 0x42, 0x4d, 0x39, 0x0f, 0x35, 0xe5, 0x77,
-	0xa8, 0x29, 0x67, 0x5d, 0x6d, 0xe4, 0xbc, 0xab, 0x8d, 0xfc, 0xea, 0x6a, 0x23, 0x6f, 0xcb, 0x8e,
-	0xcb, 0x0e, 0xdb, 0xfb, 0x46, 0x83, 0x1c, 0x9b, 0x2f, 0x23, 0x91, 0x57, 0x98, 0xbd, 0x23, 0xfe,
-	0x51, 0x4f, 0xb3, 0x23, 0x54, 0xd9, 0x69, 0x0b, 0xd3, 0xfd, 0x71, 0xf1, 0x27, 0xf5, 0xf4, 0x6f,
-	0x00, 0x00, 0x00, 0xff, 0xff, 0xd1, 0x2f, 0x76, 0x3f, 0x3a, 0x07, 0x00, 0x00,
+	// 971 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x96, 0xcf, 0x6f, 0x1b, 0x45,
+	0x14, 0xc7, 0xb3, 0x69, 0x9b, 0x34, 0xe3, 0x36, 0x45, 0x43, 0x20, 0xce, 0x16, 0xd6, 0xed, 0xa6,
+	0x69, 0xd2, 0x94, 0xec, 0xca, 0x0b, 0x08, 0x2e, 0x1c, 0xb2, 0x01, 0x22, 0x5f, 0x50, 0xbb, 0x46,
+	0x3d, 0x70, 0x89, 0xc6, 0xf6, 0x74, 0xb3, 0x8a, 0xb3, 0xe3, 0xec, 0x8c, 0x8d, 0x2d, 0x71, 0x40,
+	0xfc, 0x05, 0x95, 0x7a, 0x84, 0x43, 0xff, 0x04, 0x84, 0x90, 0xb8, 0x71, 0xee, 0xb1, 0x82, 0x0b,
+	0x27, 0x0b, 0x39, 0xfc, 0x21, 0x68, 0x7e, 0xac, 0xb3, 0x3f, 0x9d, 0xa4, 0xca, 0xcd, 0xbb, 0xf3,
+	0xde, 0xfb, 0x7e, 0xf6, 0xbb, 0x6f, 0xdf, 0x33, 0xd0, 0xf1, 0x90, 0xb4, 0x49, 0x84, 0x6d, 0x34,
+	0xa0, 0xf6, 0xa0, 0x6e, 0x9f, 0xf4, 0x71, 0x34, 0xb2, 0x7a, 0x11, 0x61, 0x04, 0x2e, 0xab, 0x33,
+	0x0b, 0x0d, 0xa8, 0x35, 0xa8, 0xeb, 0xdb, 0x6d, 0x42, 0x8f, 0x09, 0xb5, 0x5b, 0x88, 0x62, 0x19,
+	0x68, 0x0f, 0xea, 0x2d, 0xcc, 0x50, 0xdd, 0xee, 0x21, 0x3f, 0x08, 0x11, 0x0b, 0x48, 0x28, 0x73,
+	0xf5, 0x35, 0x19, 0x7b, 0x20, 0xae, 0x6c, 0x79, 0xa1, 0x8e, 0x56, 0x33, 0x92, 0x6c, 0xa8, 0x0e,
+	0x56, 0x7c, 0xe2, 0x13, 0x99, 0xc0, 0x7f, 0xa9, 0xbb, 0x1f, 0xf8, 0x84, 0xf8, 0x5d, 0x6c, 0xa3,
+	0x5e, 0x60, 0xa3, 0x30, 0x24, 0x4c, 0xc8, 0xa8, 0x62, 0xa6, 0x0b, 0xee, 0x3c, 0xe5, 0x24, 0xbb,
+	0xcf, 0x9a, 0x8d, 0xf0, 0x39, 0xf1, 0xf0, 0x09, 0xb4, 0x41, 0x05, 0x0d, 0xe8, 0x01, 0xea, 0x74,
+	0x22, 0x4c, 0x69, 0x55, 0xbb, 0xa7, 0x6d, 0x2d, 0xb9, 0xcb, 0x93, 0x71, 0x0d, 0xec, 0x3e, 0x6b,
+	0xee, 0xca, 0xbb, 0x1e, 0x40, 0x03, 0xaa, 0x7e, 0x9b, 0x7b, 0x60, 0x25, 0x5d, 0x83, 0xf6, 0x48,
+	0x48, 0x31, 0x7c, 0x0c, 0xae, 0x07, 0xe1, 0x73, 0x22, 0x2a, 0x54, 0x9c, 0x55, 0x2b, 0x6d, 0x87,
+	0x15, 0x87, 0x8b, 0x20, 0xd3, 0x05, 0xd5, 0xb8, 0x08, 0xaf, 0xeb, 0x8e, 0xf6, 0x0e, 0x51, 0x10,
+	0x36, 0xbe, 0xe4, 0x44, 0x0f, 0xc1, 0xcd, 0x36, 0xbf, 0x3a, 0x08, 0x3a, 0x0a, 0xa7, 0x32, 0x19,
+	0xd7, 0x16, 0xe3, 0x88, 0x45, 0x71, 0xd8, 0xe8, 0x98, 0x4f, 0xc0, 0x87, 0x25, 0x35, 0x14, 0xd1,
+	0xa5, 0x1f, 0xed, 0x73, 0xb0, 0x96, 0xae, 0xf8, 0x2d, 0xa2, 0x47, 0xfc, 0x17, 0xc7, 0xba, 0x0b,
+	0x96, 0x18, 0xa2, 0x47, 0xa2, 0x9c, 0xac, 0xe5, 0xdd, 0x64, 0xea, 0xdc, 0x7c, 0x0a, 0x8c, 0xb2,
+	0xcc, 0xb7, 0x85, 0xc1, 0xe0, 0xdd, 0xb8, 0x24, 0x2f, 0x16, 0xbf, 0xaf, 0x4f, 0x73, 0x18, 0x6e,
+	0xf5, 0xaf, 0xdf, 0x77, 0x56, 0x54, 0xd3, 0xa8, 0xec, 0x26, 0x8b, 0x82, 0xd0, 0x3f, 0x03, 0x84,
+	0xab, 0x60, 0x51, 0xa4, 0x05, 0x9d, 0xea, 0xbc, 0x60, 0x5f, 0xe0, 0x97, 0x8d, 0x8e, 0xf9, 0x4a,
+	0x53, 0xaf, 0xa2, 0xd9, 0x6f, 0x1d, 0x07, 0x8c, 0x4b, 0x79, 0x98, 0xf6, 0xbb, 0x8c, 0x8b, 0x7d,
+	0x94, 0x17, 0xbb, 0x33, 0x19, 0xd7, 0x2a, 0xf1, 0xd3, 0x71, 0xe6, 0xf3, 0x35, 0xe0, 0x17, 0xe0,
+	0x36, 0xe9, 0xe1, 0x08, 0x31, 0x12, 0xc9, 0x52, 0xd7, 0xce, 0xe1, 0xbe, 0x15, 0x87, 0x0b, 0x73,
+	0xff, 0xd0, 0xc0, 0x7b, 0x02, 0x71, 0xef, 0x10, 0x75, 0xbb, 0x38, 0xf4, 0x71, 0x6c, 0xc6, 0xe5,
+	0xf8, 0xea, 0x19, 0xbe, 0x19, 0x00, 0x57, 0x44, 0xde, 0x54, 0x2d, 0x9a, 0xf7, 0x56, 0x75, 0x85,
+	0x93, 0xfa, 0x68, 0x8c, 0xec, 0x47, 0x73, 0x96, 0x91, 0xf8, 0x76, 0x7e, 0x00, 0x7a, 0x91, 0x1b,
+	0xaa, 0xe2, 0x06, 0x58, 0x6e, 0xc7, 0x07, 0xc9, 0x5e, 0xbd, 0x3d, 0xbd, 0x2b, 0xbc, 0xf8, 0x0c,
+	0x2c, 0x44, 0xb8, 0x4d, 0x22, 0x69, 0x45, 0xc5, 0xa9, 0x65, 0xa5, 0xa7, 0xd5, 0x3d, 0x11, 0xe6,
+	0xa9, 0x70, 0xd3, 0x57, 0x8f, 0xc4, 0xd1, 0x9a, 0x7c, 0xba, 0x50, 0x16, 0xb4, 0xa9, 0x87, 0x59,
+	0x14, 0x60, 0xca, 0xdf, 0xc9, 0xd7, 0x00, 0x9c, 0xcd, 0x37, 0xf5, 0x60, 0x0f, 0x2d, 0x65, 0x16,
+	0x1f, 0x86, 0x96, 0x9c, 0x9a, 0x6a, 0x18, 0x5a, 0x4f, 0x10, 0xd7, 0x38, 0xe9, 0x63, 0xca, 0xbc,
+	0x44, 0xa6, 0xf9, 0x9b, 0x06, 0xcc, 0x59, 0x4a, 0xea, 0x79, 0xf7, 0xc0, 0x62, 0x24, 0x6f, 0x55,
+	0xb5, 0x7b, 0xd7, 0xb6, 0x2a, 0xce, 0x7a, 0x91, 0x89, 0xe9, 0xfc, 0x91, 0x7b, 0xfd, 0xf5, 0xb8,
+	0x36, 0xe7, 0xc5, 0x99, 0x70, 0x3f, 0xc5, 0x2c, 0x1d, 0xd9, 0x3c, 0x97, 0x59, 0x12, 0xa4, 0xa0,
+	0x5f, 0xc6, 0xad, 0xca, 0x45, 0xa9, 0x3b, 0xe2, 0xba, 0x7d, 0x61, 0x8b, 0x03, 0x16, 0xa8, 0xb8,
+	0x10, 0x96, 0x2c, 0x3b, 0x7a, 0x19, 0x66, 0x9f, 0x7a, 0x2a, 0x32, 0x63, 0xe5, 0xfc, 0x5b, 0x5b,
+	0xf9, 0xb3, 0xa6, 0x5a, 0x26, 0x43, 0xa5, 0x2c, 0xfc, 0x04, 0xdc, 0xe0, 0xed, 0x1e, 0x1b, 0x58,
+	0x2d, 0x22, 0xe3, 0x3d, 0xa6, 0x5c, 0x93, 0xc1, 0x57, 0xe6, 0x99, 0xf3, 0xe7, 0x12, 0xb8, 0x21,
+	0xe8, 0xe0, 0x10, 0xdc, 0x4a, 0xae, 0x16, 0x98, 0x6b, 0xca, 0xcc, 0xf2, 0xd2, 0x1f, 0xcc, 0x0e,
+	0x90, 0x62, 0xe6, 0xfd, 0x9f, 0xfe, 0xfe, 0xef, 0xe5, 0xfc, 0x5d, 0xb8, 0x66, 0x27, 0x77, 0x69,
+	0x4a, 0xe9, 0x47, 0x0d, 0xbc, 0x93, 0x9d, 0xb6, 0x70, 0xbd, 0xac, 0x7a, 0x62, 0x1e, 0xeb, 0xa5,
+	0x6e, 0x99, 0x3b, 0x42, 0x76, 0x13, 0x6e, 0x24, 0x65, 0xb9, 0x77, 0x7c, 0x8d, 0xef, 0x63, 0x96,
+	0x19, 0xec, 0xbf, 0xc4, 0xad, 0x93, 0xdd, 0x67, 0x70, 0xab, 0x8c, 0x23, 0xbb, 0x3a, 0xf5, 0x9d,
+	0x0b, 0x46, 0x2a, 0x63, 0xb6, 0x05, 0xe1, 0x03, 0x68, 0x16, 0x1a, 0x93, 0x86, 0x78, 0xa5, 0x81,
+	0xf7, 0x8b, 0x57, 0x1c, 0x7c, 0x34, 0x5b, 0x35, 0xb1, 0x44, 0x75, 0xeb, 0xa2, 0xa1, 0x8a, 0xf0,
+	0xb1, 0x20, 0xdc, 0x80, 0xeb, 0x33, 0x08, 0xa7, 0x1c, 0x53, 0x07, 0xb3, 0xe3, 0xb6, 0xc4, 0xc1,
+	0x82, 0x8d, 0x57, 0xe2, 0x60, 0xd9, 0xfc, 0x9e, 0xe5, 0x60, 0x0e, 0xe2, 0x85, 0x06, 0x60, 0x7e,
+	0x70, 0xc3, 0x8d, 0x42, 0xc5, 0xec, 0xaa, 0xd3, 0xb7, 0x2f, 0x12, 0xa6, 0xa8, 0x36, 0x05, 0xd5,
+	0x7d, 0x58, 0xcb, 0x53, 0xa5, 0xb5, 0x7f, 0x4d, 0x0e, 0x86, 0xdc, 0x8c, 0x85, 0xc5, 0x66, 0x94,
+	0x4d, 0x7e, 0xdd, 0xb9, 0x4c, 0xb8, 0x42, 0xb5, 0x05, 0xea, 0x23, 0xb8, 0x99, 0x47, 0x2d, 0x66,
+	0x9a, 0xba, 0x98, 0x9a, 0x65, 0x25, 0x2e, 0x66, 0xa7, 0x70, 0x89, 0x8b, 0x85, 0x63, 0x71, 0x96,
+	0x8b, 0xa9, 0x04, 0x77, 0xff, 0xf5, 0xc4, 0xd0, 0xde, 0x4c, 0x0c, 0xed, 0xdf, 0x89, 0xa1, 0xbd,
+	0x38, 0x35, 0xe6, 0xde, 0x9c, 0x1a, 0x73, 0xff, 0x9c, 0x1a, 0x73, 0xdf, 0xed, 0xf8, 0x01, 0x3b,
+	0xec, 0xb7, 0xac, 0x36, 0x39, 0xb6, 0xbf, 0x92, 0x45, 0xbe, 0xc1, 0xec, 0x7b, 0x12, 0x1d, 0x4d,
+	0x6b, 0x0e, 0x45, 0x55, 0x36, 0xea, 0x61, 0xda, 0x5a, 0x10, 0xff, 0xd2, 0x3f, 0xfe, 0x3f, 0x00,
+	0x00, 0xff, 0xff, 0xdc, 0xfe, 0xbe, 0xba, 0x67, 0x0c, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -567,10 +906,16 @@ type QueryClient interface {
 	QueryAVSTaskInfo(ctx context.Context, in *QueryAVSTaskInfoReq, opts ...grpc.CallOption) (*TaskInfo, error)
 	// QueryAVSAddrByChainID queries the avs address by chain id
 	QueryAVSAddrByChainID(ctx context.Context, in *QueryAVSAddrByChainIDReq, opts ...grpc.CallOption) (*QueryAVSAddrByChainIDResponse, error)
+	// QueryAVSAddrByTaskAddr queries the avs address by the task contract address
+	QueryAVSAddrByTaskAddr(ctx context.Context, in *QueryAVSAddrByTaskAddrReq, opts ...grpc.CallOption) (*QueryAVSAddrByTaskAddrResponse, error)
 	// Parameters queries the parameters of the module.
 	QuerySubmitTaskResult(ctx context.Context, in *QuerySubmitTaskResultReq, opts ...grpc.CallOption) (*QuerySubmitTaskResultResponse, error)
 	// Parameters queries the parameters of the module.
 	QueryChallengeInfo(ctx context.Context, in *QueryChallengeInfoReq, opts ...grpc.CallOption) (*QueryChallengeInfoResponse, error)
+	// QueryTaskStatisticsRetries queries the tasks whose statistics are waiting to be retried.
+	QueryTaskStatisticsRetries(ctx context.Context, in *QueryTaskStatisticsRetriesReq, opts ...grpc.CallOption) (*QueryTaskStatisticsRetriesResponse, error)
+	// QueryTasksByStatus queries the tasks by their status.
+	QueryTasksByStatus(ctx context.Context, in *QueryTasksByStatusReq, opts ...grpc.CallOption) (*QueryTasksByStatusResponse, error)
 }
 
 type queryClient struct {
@@ -608,6 +953,15 @@ func (c *queryClient) QueryAVSAddrByChainID(ctx context.Context, in *QueryAVSAdd
 	return out, nil
 }
 
+func (c *queryClient) QueryAVSAddrByTaskAddr(ctx context.Context, in *QueryAVSAddrByTaskAddrReq, opts ...grpc.CallOption) (*QueryAVSAddrByTaskAddrResponse, error) {
+	out := new(QueryAVSAddrByTaskAddrResponse)
+	err := c.cc.Invoke(ctx, "/exocore.avs.v1.Query/QueryAVSAddrByTaskAddr", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) QuerySubmitTaskResult(ctx context.Context, in *QuerySubmitTaskResultReq, opts ...grpc.CallOption) (*QuerySubmitTaskResultResponse, error) {
 	out := new(QuerySubmitTaskResultResponse)
 	err := c.cc.Invoke(ctx, "/exocore.avs.v1.Query/QuerySubmitTaskResult", in, out, opts...)
@@ -626,6 +980,24 @@ func (c *queryClient) QueryChallengeInfo(ctx context.Context, in *QueryChallenge
 	return out, nil
 }
 
+func (c *queryClient) QueryTaskStatisticsRetries(ctx context.Context, in *QueryTaskStatisticsRetriesReq, opts ...grpc.CallOption) (*QueryTaskStatisticsRetriesResponse, error) {
+	out := new(QueryTaskStatisticsRetriesResponse)
+	err := c.cc.Invoke(ctx, "/exocore.avs.v1.Query/QueryTaskStatisticsRetries", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QueryTasksByStatus(ctx context.Context, in *QueryTasksByStatusReq, opts ...grpc.CallOption) (*QueryTasksByStatusResponse, error) {
+	out := new(QueryTasksByStatusResponse)
+	err := c.cc.Invoke(ctx, "/exocore.avs.v1.Query/QueryTasksByStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Parameters queries the parameters of the module.
@@ -634,10 +1006,16 @@ type QueryServer interface {
 	QueryAVSTaskInfo(context.Context, *QueryAVSTaskInfoReq) (*TaskInfo, error)
 	// QueryAVSAddrByChainID queries the avs address by chain id
 	QueryAVSAddrByChainID(context.Context, *QueryAVSAddrByChainIDReq) (*QueryAVSAddrByChainIDResponse, error)
+	// QueryAVSAddrByTaskAddr queries the avs address by the task contract address
+	QueryAVSAddrByTaskAddr(context.Context, *QueryAVSAddrByTaskAddrReq) (*QueryAVSAddrByTaskAddrResponse, error)
 	// Parameters queries the parameters of the module.
 	QuerySubmitTaskResult(context.Context, *QuerySubmitTaskResultReq) (*QuerySubmitTaskResultResponse, error)
 	// Parameters queries the parameters of the module.
 	QueryChallengeInfo(context.Context, *QueryChallengeInfoReq) (*QueryChallengeInfoResponse, error)
+	// QueryTaskStatisticsRetries queries the tasks whose statistics are waiting to be retried.
+	QueryTaskStatisticsRetries(context.Context, *QueryTaskStatisticsRetriesReq) (*QueryTaskStatisticsRetriesResponse, error)
+	// QueryTasksByStatus queries the tasks by their status.
+	QueryTasksByStatus(context.Context, *QueryTasksByStatusReq) (*QueryTasksByStatusResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -653,12 +1031,21 @@ func (*UnimplementedQueryServer) QueryAVSTaskInfo(ctx context.Context, req *Quer
 func (*UnimplementedQueryServer) QueryAVSAddrByChainID(ctx context.Context, req *QueryAVSAddrByChainIDReq) (*QueryAVSAddrByChainIDResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method QueryAVSAddrByChainID not implemented")
 }
+func (*UnimplementedQueryServer) QueryAVSAddrByTaskAddr(ctx context.Context, req *QueryAVSAddrByTaskAddrReq) (*QueryAVSAddrByTaskAddrResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryAVSAddrByTaskAddr not implemented")
+}
 func (*UnimplementedQueryServer) QuerySubmitTaskResult(ctx context.Context, req *QuerySubmitTaskResultReq) (*QuerySubmitTaskResultResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method QuerySubmitTaskResult not implemented")
 }
 func (*UnimplementedQueryServer) QueryChallengeInfo(ctx context.Context, req *QueryChallengeInfoReq) (*QueryChallengeInfoResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method QueryChallengeInfo not implemented")
 }
+func (*UnimplementedQueryServer) QueryTaskStatisticsRetries(ctx context.Context, req *QueryTaskStatisticsRetriesReq) (*QueryTaskStatisticsRetriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryTaskStatisticsRetries not implemented")
+}
+func (*UnimplementedQueryServer) QueryTasksByStatus(ctx context.Context, req *QueryTasksByStatusReq) (*QueryTasksByStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryTasksByStatus not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -718,6 +1105,24 @@ func _Query_QueryAVSAddrByChainID_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_QueryAVSAddrByTaskAddr_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAVSAddrByTaskAddrReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryAVSAddrByTaskAddr(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/exocore.avs.v1.Query/QueryAVSAddrByTaskAddr",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryAVSAddrByTaskAddr(ctx, req.(*QueryAVSAddrByTaskAddrReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_QuerySubmitTaskResult_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QuerySubmitTaskResultReq)
 	if err := dec(in); err != nil {
@@ -754,6 +1159,42 @@ func _Query_QueryChallengeInfo_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_QueryTaskStatisticsRetries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTaskStatisticsRetriesReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryTaskStatisticsRetries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/exocore.avs.v1.Query/QueryTaskStatisticsRetries",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryTaskStatisticsRetries(ctx, req.(*QueryTaskStatisticsRetriesReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryTasksByStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTasksByStatusReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryTasksByStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/exocore.avs.v1.Query/QueryTasksByStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryTasksByStatus(ctx, req.(*QueryTasksByStatusReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "exocore.avs.v1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -770,6 +1211,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "QueryAVSAddrByChainID",
 			Handler:    _Query_QueryAVSAddrByChainID_Handler,
 		},
+		{
+			MethodName: "QueryAVSAddrByTaskAddr",
+			Handler:    _Query_QueryAVSAddrByTaskAddr_Handler,
+		},
 		{
 			MethodName: "QuerySubmitTaskResult",
 			Handler:    _Query_QuerySubmitTaskResult_Handler,
@@ -778,6 +1223,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "QueryChallengeInfo",
 			Handler:    _Query_QueryChallengeInfo_Handler,
 		},
+		{
+			MethodName: "QueryTaskStatisticsRetries",
+			Handler:    _Query_QueryTaskStatisticsRetries_Handler,
+		},
+		{
+			MethodName: "QueryTasksByStatus",
+			Handler:    _Query_QueryTasksByStatus_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "exocore/avs/v1/query.proto",
@@ -908,6 +1361,66 @@ func (m *QueryAVSAddrByChainIDResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryAVSAddrByTaskAddrReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAVSAddrByTaskAddrReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAVSAddrByTaskAddrReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TaskAddr) > 0 {
+		i -= len(m.TaskAddr)
+		copy(dAtA[i:], m.TaskAddr)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TaskAddr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAVSAddrByTaskAddrResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAVSAddrByTaskAddrResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAVSAddrByTaskAddrResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AVSAddress) > 0 {
+		i -= len(m.AVSAddress)
+		copy(dAtA[i:], m.AVSAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.AVSAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *QueryAVSTaskInfoReq) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1088,6 +1601,18 @@ func (m *QueryChallengeInfoResponse) MarshalToSizedBuffer(dAtA []byte) (int, err
 	_ = i
 	var l int
 	_ = l
+	if m.Record != nil {
+		{
+			size, err := m.Record.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
 	if len(m.ChallengeAddr) > 0 {
 		i -= len(m.ChallengeAddr)
 		copy(dAtA[i:], m.ChallengeAddr)
@@ -1098,6 +1623,179 @@ func (m *QueryChallengeInfoResponse) MarshalToSizedBuffer(dAtA []byte) (int, err
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryTaskStatisticsRetriesReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTaskStatisticsRetriesReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTaskStatisticsRetriesReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTaskStatisticsRetriesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTaskStatisticsRetriesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTaskStatisticsRetriesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Retries) > 0 {
+		for iNdEx := len(m.Retries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Retries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTasksByStatusReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTasksByStatusReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTasksByStatusReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Status != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTasksByStatusResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTasksByStatusResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTasksByStatusResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Tasks) > 0 {
+		for iNdEx := len(m.Tasks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Tasks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -1161,6 +1859,32 @@ func (m *QueryAVSAddrByChainIDResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryAVSAddrByTaskAddrReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TaskAddr)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAVSAddrByTaskAddrResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.AVSAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
 func (m *QueryAVSTaskInfoReq) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1243,28 +1967,99 @@ func (m *QueryChallengeInfoResponse) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovQuery(uint64(l))
 	}
+	if m.Record != nil {
+		l = m.Record.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
 	return n
 }
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *QueryTaskStatisticsRetriesReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
 }
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *QueryTaskStatisticsRetriesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Retries) > 0 {
+		for _, e := range m.Retries {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
 }
-func (m *QueryAVSInfoReq) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
+
+func (m *QueryTasksByStatusReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Status != 0 {
+		n += 1 + sovQuery(uint64(m.Status))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTasksByStatusResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Tasks) > 0 {
+		for _, e := range m.Tasks {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryAVSInfoReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
 			b := dAtA[iNdEx]
 			iNdEx++
 			wire |= uint64(b&0x7F) << shift
@@ -1479,7 +2274,659 @@ func (m *QueryAVSAddrByChainIDReq) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ChainID = string(dAtA[iNdEx:postIndex])
+			m.ChainID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAVSAddrByChainIDResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAVSAddrByChainIDResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAVSAddrByChainIDResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AVSAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AVSAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAVSAddrByTaskAddrReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAVSAddrByTaskAddrReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAVSAddrByTaskAddrReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TaskAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAVSAddrByTaskAddrResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAVSAddrByTaskAddrResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAVSAddrByTaskAddrResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AVSAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AVSAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAVSTaskInfoReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAVSTaskInfoReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAVSTaskInfoReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TaskAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TaskId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySubmitTaskResultReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySubmitTaskResultReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySubmitTaskResultReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TaskAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TaskId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OperatorAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OperatorAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryChallengeInfoReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryChallengeInfoReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryChallengeInfoReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TaskAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TaskId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OperatorAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OperatorAddr = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1502,7 +2949,7 @@ func (m *QueryAVSAddrByChainIDReq) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAVSAddrByChainIDResponse) Unmarshal(dAtA []byte) error {
+func (m *QuerySubmitTaskResultResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1525,17 +2972,17 @@ func (m *QueryAVSAddrByChainIDResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAVSAddrByChainIDResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySubmitTaskResultResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAVSAddrByChainIDResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySubmitTaskResultResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AVSAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Info", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1545,23 +2992,27 @@ func (m *QueryAVSAddrByChainIDResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AVSAddress = string(dAtA[iNdEx:postIndex])
+			if m.Info == nil {
+				m.Info = &TaskResultInfo{}
+			}
+			if err := m.Info.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1584,7 +3035,7 @@ func (m *QueryAVSAddrByChainIDResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAVSTaskInfoReq) Unmarshal(dAtA []byte) error {
+func (m *QueryChallengeInfoResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1607,15 +3058,15 @@ func (m *QueryAVSTaskInfoReq) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAVSTaskInfoReq: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryChallengeInfoResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAVSTaskInfoReq: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryChallengeInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TaskAddr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChallengeAddr", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1643,13 +3094,13 @@ func (m *QueryAVSTaskInfoReq) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TaskAddr = string(dAtA[iNdEx:postIndex])
+			m.ChallengeAddr = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TaskId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Record", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1659,23 +3110,27 @@ func (m *QueryAVSTaskInfoReq) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TaskId = string(dAtA[iNdEx:postIndex])
+			if m.Record == nil {
+				m.Record = &ChallengeRecord{}
+			}
+			if err := m.Record.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1698,7 +3153,7 @@ func (m *QueryAVSTaskInfoReq) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QuerySubmitTaskResultReq) Unmarshal(dAtA []byte) error {
+func (m *QueryTaskStatisticsRetriesReq) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1721,49 +3176,17 @@ func (m *QuerySubmitTaskResultReq) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QuerySubmitTaskResultReq: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTaskStatisticsRetriesReq: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuerySubmitTaskResultReq: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTaskStatisticsRetriesReq: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TaskAddress", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.TaskAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TaskId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1773,55 +3196,27 @@ func (m *QuerySubmitTaskResultReq) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TaskId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OperatorAddr", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.OperatorAddr = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1844,7 +3239,7 @@ func (m *QuerySubmitTaskResultReq) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryChallengeInfoReq) Unmarshal(dAtA []byte) error {
+func (m *QueryTaskStatisticsRetriesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1867,17 +3262,17 @@ func (m *QueryChallengeInfoReq) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryChallengeInfoReq: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTaskStatisticsRetriesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryChallengeInfoReq: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTaskStatisticsRetriesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TaskAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Retries", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1887,29 +3282,31 @@ func (m *QueryChallengeInfoReq) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TaskAddress = string(dAtA[iNdEx:postIndex])
+			m.Retries = append(m.Retries, TaskStatisticsRetry{})
+			if err := m.Retries[len(m.Retries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TaskId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1919,55 +3316,27 @@ func (m *QueryChallengeInfoReq) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TaskId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OperatorAddr", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.OperatorAddr = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1990,7 +3359,7 @@ func (m *QueryChallengeInfoReq) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QuerySubmitTaskResultResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryTasksByStatusReq) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2013,15 +3382,34 @@ func (m *QuerySubmitTaskResultResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QuerySubmitTaskResultResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTasksByStatusReq: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuerySubmitTaskResultResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTasksByStatusReq: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= TaskStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Info", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2048,10 +3436,10 @@ func (m *QuerySubmitTaskResultResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Info == nil {
-				m.Info = &TaskResultInfo{}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
 			}
-			if err := m.Info.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2076,7 +3464,7 @@ func (m *QuerySubmitTaskResultResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryChallengeInfoResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryTasksByStatusResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2099,17 +3487,17 @@ func (m *QueryChallengeInfoResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryChallengeInfoResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTasksByStatusResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryChallengeInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTasksByStatusResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChallengeAddr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Tasks", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2119,23 +3507,61 @@ func (m *QueryChallengeInfoResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ChallengeAddr = string(dAtA[iNdEx:postIndex])
+			m.Tasks = append(m.Tasks, TaskInfo{})
+			if err := m.Tasks[len(m.Tasks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
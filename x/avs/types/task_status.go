@@ -0,0 +1,40 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+)
+
+// taskStatusTransitions lists the statuses a task may move to from each status.
+var taskStatusTransitions = map[TaskStatus][]TaskStatus{
+	// a task without any result fails the quorum at the end of its statistical period.
+	TaskStatusPending:       {TaskStatusResponded, TaskStatusQuorumFailed},
+	TaskStatusResponded:     {TaskStatusQuorumReached, TaskStatusQuorumFailed},
+	TaskStatusQuorumReached: {TaskStatusChallenged, TaskStatusFinalized},
+	TaskStatusQuorumFailed:  {TaskStatusChallenged, TaskStatusFinalized},
+	TaskStatusChallenged:    {TaskStatusFinalized},
+}
+
+// CanTransitionTo returns true if a task may move from the status to the next one.
+func (s TaskStatus) CanTransitionTo(next TaskStatus) bool {
+	for _, status := range taskStatusTransitions[s] {
+		if status == next {
+			return true
+		}
+	}
+	return false
+}
+
+// ParseTaskStatus parses a task status from its name, such as "TASK_STATUS_PENDING". The
+// name is case-insensitive and the "TASK_STATUS_" prefix may be omitted.
+func ParseTaskStatus(name string) (TaskStatus, error) {
+	name = strings.ToUpper(name)
+	if !strings.HasPrefix(name, "TASK_STATUS_") {
+		name = "TASK_STATUS_" + name
+	}
+	status, ok := TaskStatus_value[name]
+	if !ok {
+		return TaskStatusPending, fmt.Errorf("unknown task status: %s", name)
+	}
+	return TaskStatus(status), nil
+}
@@ -33,6 +33,88 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// TaskStatus is the status of a task in its lifecycle. A task moves from pending to responded
+// once a result is submitted, then to quorum reached or failed at the end of its statistical
+// period, possibly to challenged during its challenge period, and finally to finalized.
+type TaskStatus int32
+
+const (
+	// TASK_STATUS_PENDING means that no result has been submitted for the task yet.
+	TaskStatusPending TaskStatus = 0
+	// TASK_STATUS_RESPONDED means that at least one result has been submitted for the task.
+	TaskStatusResponded TaskStatus = 1
+	// TASK_STATUS_QUORUM_REACHED means that the power of the signers reached the threshold.
+	TaskStatusQuorumReached TaskStatus = 2
+	// TASK_STATUS_QUORUM_FAILED means that the power of the signers did not reach the threshold.
+	TaskStatusQuorumFailed TaskStatus = 3
+	// TASK_STATUS_CHALLENGED means that a result of the task has been challenged.
+	TaskStatusChallenged TaskStatus = 4
+	// TASK_STATUS_FINALIZED means that the challenge period of the task is over.
+	TaskStatusFinalized TaskStatus = 5
+)
+
+var TaskStatus_name = map[int32]string{
+	0: "TASK_STATUS_PENDING",
+	1: "TASK_STATUS_RESPONDED",
+	2: "TASK_STATUS_QUORUM_REACHED",
+	3: "TASK_STATUS_QUORUM_FAILED",
+	4: "TASK_STATUS_CHALLENGED",
+	5: "TASK_STATUS_FINALIZED",
+}
+
+var TaskStatus_value = map[string]int32{
+	"TASK_STATUS_PENDING":        0,
+	"TASK_STATUS_RESPONDED":      1,
+	"TASK_STATUS_QUORUM_REACHED": 2,
+	"TASK_STATUS_QUORUM_FAILED":  3,
+	"TASK_STATUS_CHALLENGED":     4,
+	"TASK_STATUS_FINALIZED":      5,
+}
+
+func (x TaskStatus) String() string {
+	return proto.EnumName(TaskStatus_name, int32(x))
+}
+
+func (TaskStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_ef1ed06249b07d86, []int{0}
+}
+
+// ChallengeStatus is the adjudication status of a challenge raised against a task result.
+type ChallengeStatus int32
+
+const (
+	// CHALLENGE_STATUS_UNSPECIFIED is the default value, which is never stored.
+	ChallengeStatusUnspecified ChallengeStatus = 0
+	// CHALLENGE_STATUS_PENDING means that the challenge is waiting for the adjudicator.
+	ChallengeStatusPending ChallengeStatus = 1
+	// CHALLENGE_STATUS_CONFIRMED means that the challenge was upheld and the operator was slashed.
+	ChallengeStatusConfirmed ChallengeStatus = 2
+	// CHALLENGE_STATUS_REJECTED means that the challenge was dismissed by the adjudicator.
+	ChallengeStatusRejected ChallengeStatus = 3
+)
+
+var ChallengeStatus_name = map[int32]string{
+	0: "CHALLENGE_STATUS_UNSPECIFIED",
+	1: "CHALLENGE_STATUS_PENDING",
+	2: "CHALLENGE_STATUS_CONFIRMED",
+	3: "CHALLENGE_STATUS_REJECTED",
+}
+
+var ChallengeStatus_value = map[string]int32{
+	"CHALLENGE_STATUS_UNSPECIFIED": 0,
+	"CHALLENGE_STATUS_PENDING":     1,
+	"CHALLENGE_STATUS_CONFIRMED":   2,
+	"CHALLENGE_STATUS_REJECTED":    3,
+}
+
+func (x ChallengeStatus) String() string {
+	return proto.EnumName(ChallengeStatus_name, int32(x))
+}
+
+func (ChallengeStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_ef1ed06249b07d86, []int{1}
+}
+
 // AVSinfo represent the information of avs
 type AVSInfo struct {
 	// name of avs as an arbitrary string
@@ -400,6 +482,18 @@ type TaskInfo struct {
 	TaskTotalPower github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,15,opt,name=task_total_power,json=taskTotalPower,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"task_total_power"`
 	// operator_active_power_list is a power list of operators opt-in to the current task
 	OperatorActivePower *OperatorActivePowerList `protobuf:"bytes,16,opt,name=operator_active_power,json=operatorActivePower,proto3" json:"operator_active_power,omitempty"`
+	// aggregate_response_hash is the hash of the task response signed by the aggregate signers.
+	// When the signers responded differently, the response backed by the most power is used.
+	AggregateResponseHash string `protobuf:"bytes,17,opt,name=aggregate_response_hash,json=aggregateResponseHash,proto3" json:"aggregate_response_hash,omitempty"`
+	// aggregate_signature is the aggregate BLS signature of the signers over the
+	// aggregate_response_hash. It can be verified with fastAggregateVerify against the
+	// registered BLS public keys of the signers.
+	AggregateSignature []byte `protobuf:"bytes,18,opt,name=aggregate_signature,json=aggregateSignature,proto3" json:"aggregate_signature,omitempty"`
+	// signer_bitmap marks the operators included in the aggregate_signature. The bit i%8 of
+	// the byte i/8 is set if opt_in_operators[i] is a signer.
+	SignerBitmap []byte `protobuf:"bytes,19,opt,name=signer_bitmap,json=signerBitmap,proto3" json:"signer_bitmap,omitempty"`
+	// status is the current status of the task in its lifecycle.
+	Status TaskStatus `protobuf:"varint,20,opt,name=status,proto3,enum=exocore.avs.v1.TaskStatus" json:"status,omitempty"`
 }
 
 func (m *TaskInfo) Reset()         { *m = TaskInfo{} }
@@ -540,6 +634,34 @@ func (m *TaskInfo) GetOperatorActivePower() *OperatorActivePowerList {
 	return nil
 }
 
+func (m *TaskInfo) GetAggregateResponseHash() string {
+	if m != nil {
+		return m.AggregateResponseHash
+	}
+	return ""
+}
+
+func (m *TaskInfo) GetAggregateSignature() []byte {
+	if m != nil {
+		return m.AggregateSignature
+	}
+	return nil
+}
+
+func (m *TaskInfo) GetSignerBitmap() []byte {
+	if m != nil {
+		return m.SignerBitmap
+	}
+	return nil
+}
+
+func (m *TaskInfo) GetStatus() TaskStatus {
+	if m != nil {
+		return m.Status
+	}
+	return TaskStatusPending
+}
+
 // OperatorActivePowerList is the power list of operators opt-in to the current task.
 // Because power is always changing, record the power of all operators
 // who have completed tasks and submitted results by the task deadline
@@ -636,6 +758,108 @@ func (m *OperatorActivePowerInfo) GetOperatorAddr() string {
 	return ""
 }
 
+// TaskStatisticsRetry is an entry of the queue of task statistics which failed to be
+// aggregated at the end of their statistical period. The aggregation is retried at the end
+// of subsequent epochs until it succeeds or the maximum number of attempts is reached.
+type TaskStatisticsRetry struct {
+	// task_contract_address is the contract address of the task.
+	TaskContractAddress string `protobuf:"bytes,1,opt,name=task_contract_address,json=taskContractAddress,proto3" json:"task_contract_address,omitempty"`
+	// task_id is the identifier of the task.
+	TaskId uint64 `protobuf:"varint,2,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	// epoch_identifier is the epoch identifier of the avs to which the task belongs.
+	EpochIdentifier string `protobuf:"bytes,3,opt,name=epoch_identifier,json=epochIdentifier,proto3" json:"epoch_identifier,omitempty"`
+	// failed_epoch is the epoch number at whose end the aggregation failed first.
+	FailedEpoch int64 `protobuf:"varint,4,opt,name=failed_epoch,json=failedEpoch,proto3" json:"failed_epoch,omitempty"`
+	// last_attempt_epoch is the epoch number at whose end the aggregation was last attempted.
+	LastAttemptEpoch int64 `protobuf:"varint,5,opt,name=last_attempt_epoch,json=lastAttemptEpoch,proto3" json:"last_attempt_epoch,omitempty"`
+	// attempts is the number of aggregation attempts made so far, including the first one.
+	Attempts uint32 `protobuf:"varint,6,opt,name=attempts,proto3" json:"attempts,omitempty"`
+	// last_error is the error returned by the last attempt.
+	LastError string `protobuf:"bytes,7,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`
+}
+
+func (m *TaskStatisticsRetry) Reset()         { *m = TaskStatisticsRetry{} }
+func (m *TaskStatisticsRetry) String() string { return proto.CompactTextString(m) }
+func (*TaskStatisticsRetry) ProtoMessage()    {}
+func (*TaskStatisticsRetry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ef1ed06249b07d86, []int{6}
+}
+func (m *TaskStatisticsRetry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TaskStatisticsRetry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TaskStatisticsRetry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TaskStatisticsRetry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TaskStatisticsRetry.Merge(m, src)
+}
+func (m *TaskStatisticsRetry) XXX_Size() int {
+	return m.Size()
+}
+func (m *TaskStatisticsRetry) XXX_DiscardUnknown() {
+	xxx_messageInfo_TaskStatisticsRetry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TaskStatisticsRetry proto.InternalMessageInfo
+
+func (m *TaskStatisticsRetry) GetTaskContractAddress() string {
+	if m != nil {
+		return m.TaskContractAddress
+	}
+	return ""
+}
+
+func (m *TaskStatisticsRetry) GetTaskId() uint64 {
+	if m != nil {
+		return m.TaskId
+	}
+	return 0
+}
+
+func (m *TaskStatisticsRetry) GetEpochIdentifier() string {
+	if m != nil {
+		return m.EpochIdentifier
+	}
+	return ""
+}
+
+func (m *TaskStatisticsRetry) GetFailedEpoch() int64 {
+	if m != nil {
+		return m.FailedEpoch
+	}
+	return 0
+}
+
+func (m *TaskStatisticsRetry) GetLastAttemptEpoch() int64 {
+	if m != nil {
+		return m.LastAttemptEpoch
+	}
+	return 0
+}
+
+func (m *TaskStatisticsRetry) GetAttempts() uint32 {
+	if m != nil {
+		return m.Attempts
+	}
+	return 0
+}
+
+func (m *TaskStatisticsRetry) GetLastError() string {
+	if m != nil {
+		return m.LastError
+	}
+	return ""
+}
+
 // BlsPubKeyInfo is the task info.
 type BlsPubKeyInfo struct {
 	// operator address
@@ -650,7 +874,7 @@ func (m *BlsPubKeyInfo) Reset()         { *m = BlsPubKeyInfo{} }
 func (m *BlsPubKeyInfo) String() string { return proto.CompactTextString(m) }
 func (*BlsPubKeyInfo) ProtoMessage()    {}
 func (*BlsPubKeyInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ef1ed06249b07d86, []int{6}
+	return fileDescriptor_ef1ed06249b07d86, []int{7}
 }
 func (m *BlsPubKeyInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -702,7 +926,7 @@ func (m *BlsPubKeyInfo) GetPubKey() []byte {
 
 // RegisterAVSTaskReq is the request to register a new task for avs.
 type RegisterAVSTaskReq struct {
-	// from_address is the address of the avs (sdk.AccAddress).
+	// from_address is the address of one of the AVS owners (sdk.AccAddress).
 	FromAddress string `protobuf:"bytes,1,opt,name=from_address,json=fromAddress,proto3" json:"from_address,omitempty"`
 	// info is the task info.
 	Task *TaskInfo `protobuf:"bytes,2,opt,name=task,proto3" json:"task,omitempty"`
@@ -712,7 +936,7 @@ func (m *RegisterAVSTaskReq) Reset()         { *m = RegisterAVSTaskReq{} }
 func (m *RegisterAVSTaskReq) String() string { return proto.CompactTextString(m) }
 func (*RegisterAVSTaskReq) ProtoMessage()    {}
 func (*RegisterAVSTaskReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ef1ed06249b07d86, []int{7}
+	return fileDescriptor_ef1ed06249b07d86, []int{8}
 }
 func (m *RegisterAVSTaskReq) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -743,13 +967,15 @@ var xxx_messageInfo_RegisterAVSTaskReq proto.InternalMessageInfo
 
 // RegisterAVSTaskResponse is the response for register avs task
 type RegisterAVSTaskResponse struct {
+	// task_id is the ID assigned to the new task.
+	TaskId uint64 `protobuf:"varint,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
 }
 
 func (m *RegisterAVSTaskResponse) Reset()         { *m = RegisterAVSTaskResponse{} }
 func (m *RegisterAVSTaskResponse) String() string { return proto.CompactTextString(m) }
 func (*RegisterAVSTaskResponse) ProtoMessage()    {}
 func (*RegisterAVSTaskResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ef1ed06249b07d86, []int{8}
+	return fileDescriptor_ef1ed06249b07d86, []int{9}
 }
 func (m *RegisterAVSTaskResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -778,9 +1004,17 @@ func (m *RegisterAVSTaskResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_RegisterAVSTaskResponse proto.InternalMessageInfo
 
+func (m *RegisterAVSTaskResponse) GetTaskId() uint64 {
+	if m != nil {
+		return m.TaskId
+	}
+	return 0
+}
+
 // RegisterAVSReq is requst to register avs
 type RegisterAVSReq struct {
-	// from_address is the source
+	// from_address is the source, which must be one of the AVS owners. The hex form of it is
+	// used as the address of the AVS.
 	FromAddress string `protobuf:"bytes,1,opt,name=from_address,json=fromAddress,proto3" json:"from_address,omitempty"`
 	// avs information
 	Info *AVSInfo `protobuf:"bytes,2,opt,name=info,proto3" json:"info,omitempty"`
@@ -790,7 +1024,7 @@ func (m *RegisterAVSReq) Reset()         { *m = RegisterAVSReq{} }
 func (m *RegisterAVSReq) String() string { return proto.CompactTextString(m) }
 func (*RegisterAVSReq) ProtoMessage()    {}
 func (*RegisterAVSReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ef1ed06249b07d86, []int{9}
+	return fileDescriptor_ef1ed06249b07d86, []int{10}
 }
 func (m *RegisterAVSReq) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -845,7 +1079,7 @@ func (m *RegisterAVSResponse) Reset()         { *m = RegisterAVSResponse{} }
 func (m *RegisterAVSResponse) String() string { return proto.CompactTextString(m) }
 func (*RegisterAVSResponse) ProtoMessage()    {}
 func (*RegisterAVSResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ef1ed06249b07d86, []int{10}
+	return fileDescriptor_ef1ed06249b07d86, []int{11}
 }
 func (m *RegisterAVSResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -890,7 +1124,7 @@ func (m *RegisterAVSResponse) GetInfo() *AVSInfo {
 
 // DeRegisterAVSReq is requst to deregister avs
 type DeRegisterAVSReq struct {
-	// from_address is the source address
+	// from_address is the source address, which must be one of the AVS owners.
 	FromAddress string `protobuf:"bytes,1,opt,name=from_address,json=fromAddress,proto3" json:"from_address,omitempty"`
 	// avs information
 	Info *AVSInfo `protobuf:"bytes,2,opt,name=info,proto3" json:"info,omitempty"`
@@ -900,7 +1134,7 @@ func (m *DeRegisterAVSReq) Reset()         { *m = DeRegisterAVSReq{} }
 func (m *DeRegisterAVSReq) String() string { return proto.CompactTextString(m) }
 func (*DeRegisterAVSReq) ProtoMessage()    {}
 func (*DeRegisterAVSReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ef1ed06249b07d86, []int{11}
+	return fileDescriptor_ef1ed06249b07d86, []int{12}
 }
 func (m *DeRegisterAVSReq) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -955,7 +1189,7 @@ func (m *DeRegisterAVSResponse) Reset()         { *m = DeRegisterAVSResponse{} }
 func (m *DeRegisterAVSResponse) String() string { return proto.CompactTextString(m) }
 func (*DeRegisterAVSResponse) ProtoMessage()    {}
 func (*DeRegisterAVSResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ef1ed06249b07d86, []int{12}
+	return fileDescriptor_ef1ed06249b07d86, []int{13}
 }
 func (m *DeRegisterAVSResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1021,7 +1255,7 @@ func (m *TaskResultInfo) Reset()         { *m = TaskResultInfo{} }
 func (m *TaskResultInfo) String() string { return proto.CompactTextString(m) }
 func (*TaskResultInfo) ProtoMessage()    {}
 func (*TaskResultInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ef1ed06249b07d86, []int{13}
+	return fileDescriptor_ef1ed06249b07d86, []int{14}
 }
 func (m *TaskResultInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1099,6 +1333,115 @@ func (m *TaskResultInfo) GetPhase() uint32 {
 	return 0
 }
 
+// ChallengeRecord tracks the lifecycle of a challenge raised against an operator's task result.
+type ChallengeRecord struct {
+	// challenger is the bech32 address of the account that raised the challenge.
+	Challenger string `protobuf:"bytes,1,opt,name=challenger,proto3" json:"challenger,omitempty"`
+	// operator_address is the bech32 address of the challenged operator.
+	OperatorAddress string `protobuf:"bytes,2,opt,name=operator_address,json=operatorAddress,proto3" json:"operator_address,omitempty"`
+	// task_contract_address is the hex address of the task contract.
+	TaskContractAddress string `protobuf:"bytes,3,opt,name=task_contract_address,json=taskContractAddress,proto3" json:"task_contract_address,omitempty"`
+	// task_id is the identifier of the challenged task.
+	TaskId uint64 `protobuf:"varint,4,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	// raised_height is the block height at which the challenge was raised.
+	RaisedHeight int64 `protobuf:"varint,5,opt,name=raised_height,json=raisedHeight,proto3" json:"raised_height,omitempty"`
+	// status is the current adjudication status of the challenge.
+	Status ChallengeStatus `protobuf:"varint,6,opt,name=status,proto3,enum=exocore.avs.v1.ChallengeStatus" json:"status,omitempty"`
+	// resolved_height is the block height at which the challenge was confirmed or rejected.
+	ResolvedHeight int64 `protobuf:"varint,7,opt,name=resolved_height,json=resolvedHeight,proto3" json:"resolved_height,omitempty"`
+	// slash_id is the identifier of the slash executed by the operator module, if confirmed.
+	SlashID string `protobuf:"bytes,8,opt,name=slash_id,json=slashId,proto3" json:"slash_id,omitempty"`
+}
+
+func (m *ChallengeRecord) Reset()         { *m = ChallengeRecord{} }
+func (m *ChallengeRecord) String() string { return proto.CompactTextString(m) }
+func (*ChallengeRecord) ProtoMessage()    {}
+func (*ChallengeRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ef1ed06249b07d86, []int{15}
+}
+func (m *ChallengeRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ChallengeRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ChallengeRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ChallengeRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChallengeRecord.Merge(m, src)
+}
+func (m *ChallengeRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *ChallengeRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChallengeRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChallengeRecord proto.InternalMessageInfo
+
+func (m *ChallengeRecord) GetChallenger() string {
+	if m != nil {
+		return m.Challenger
+	}
+	return ""
+}
+
+func (m *ChallengeRecord) GetOperatorAddress() string {
+	if m != nil {
+		return m.OperatorAddress
+	}
+	return ""
+}
+
+func (m *ChallengeRecord) GetTaskContractAddress() string {
+	if m != nil {
+		return m.TaskContractAddress
+	}
+	return ""
+}
+
+func (m *ChallengeRecord) GetTaskId() uint64 {
+	if m != nil {
+		return m.TaskId
+	}
+	return 0
+}
+
+func (m *ChallengeRecord) GetRaisedHeight() int64 {
+	if m != nil {
+		return m.RaisedHeight
+	}
+	return 0
+}
+
+func (m *ChallengeRecord) GetStatus() ChallengeStatus {
+	if m != nil {
+		return m.Status
+	}
+	return ChallengeStatusUnspecified
+}
+
+func (m *ChallengeRecord) GetResolvedHeight() int64 {
+	if m != nil {
+		return m.ResolvedHeight
+	}
+	return 0
+}
+
+func (m *ChallengeRecord) GetSlashID() string {
+	if m != nil {
+		return m.SlashID
+	}
+	return ""
+}
+
 // SubmitTaskResultReq is the request to submit task results.
 type SubmitTaskResultReq struct {
 	// from_address is the address of the operator (sdk.AccAddress).
@@ -1111,7 +1454,7 @@ func (m *SubmitTaskResultReq) Reset()         { *m = SubmitTaskResultReq{} }
 func (m *SubmitTaskResultReq) String() string { return proto.CompactTextString(m) }
 func (*SubmitTaskResultReq) ProtoMessage()    {}
 func (*SubmitTaskResultReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ef1ed06249b07d86, []int{14}
+	return fileDescriptor_ef1ed06249b07d86, []int{16}
 }
 func (m *SubmitTaskResultReq) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1148,7 +1491,7 @@ func (m *SubmitTaskResultResponse) Reset()         { *m = SubmitTaskResultRespon
 func (m *SubmitTaskResultResponse) String() string { return proto.CompactTextString(m) }
 func (*SubmitTaskResultResponse) ProtoMessage()    {}
 func (*SubmitTaskResultResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ef1ed06249b07d86, []int{15}
+	return fileDescriptor_ef1ed06249b07d86, []int{17}
 }
 func (m *SubmitTaskResultResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1178,6 +1521,8 @@ func (m *SubmitTaskResultResponse) XXX_DiscardUnknown() {
 var xxx_messageInfo_SubmitTaskResultResponse proto.InternalMessageInfo
 
 func init() {
+	proto.RegisterEnum("exocore.avs.v1.TaskStatus", TaskStatus_name, TaskStatus_value)
+	proto.RegisterEnum("exocore.avs.v1.ChallengeStatus", ChallengeStatus_name, ChallengeStatus_value)
 	proto.RegisterType((*AVSInfo)(nil), "exocore.avs.v1.AVSInfo")
 	proto.RegisterMapType((map[string]int64)(nil), "exocore.avs.v1.AVSInfo.AssetRewardAmountEpochBasisEntry")
 	proto.RegisterType((*OperatorStatus)(nil), "exocore.avs.v1.OperatorStatus")
@@ -1185,6 +1530,7 @@ func init() {
 	proto.RegisterType((*TaskInfo)(nil), "exocore.avs.v1.TaskInfo")
 	proto.RegisterType((*OperatorActivePowerList)(nil), "exocore.avs.v1.OperatorActivePowerList")
 	proto.RegisterType((*OperatorActivePowerInfo)(nil), "exocore.avs.v1.OperatorActivePowerInfo")
+	proto.RegisterType((*TaskStatisticsRetry)(nil), "exocore.avs.v1.TaskStatisticsRetry")
 	proto.RegisterType((*BlsPubKeyInfo)(nil), "exocore.avs.v1.BlsPubKeyInfo")
 	proto.RegisterType((*RegisterAVSTaskReq)(nil), "exocore.avs.v1.RegisterAVSTaskReq")
 	proto.RegisterType((*RegisterAVSTaskResponse)(nil), "exocore.avs.v1.RegisterAVSTaskResponse")
@@ -1193,6 +1539,7 @@ func init() {
 	proto.RegisterType((*DeRegisterAVSReq)(nil), "exocore.avs.v1.DeRegisterAVSReq")
 	proto.RegisterType((*DeRegisterAVSResponse)(nil), "exocore.avs.v1.DeRegisterAVSResponse")
 	proto.RegisterType((*TaskResultInfo)(nil), "exocore.avs.v1.TaskResultInfo")
+	proto.RegisterType((*ChallengeRecord)(nil), "exocore.avs.v1.ChallengeRecord")
 	proto.RegisterType((*SubmitTaskResultReq)(nil), "exocore.avs.v1.SubmitTaskResultReq")
 	proto.RegisterType((*SubmitTaskResultResponse)(nil), "exocore.avs.v1.SubmitTaskResultResponse")
 }
@@ -1200,112 +1547,151 @@ func init() {
 func init() { proto.RegisterFile("exocore/avs/v1/tx.proto", fileDescriptor_ef1ed06249b07d86) }
 
 var fileDescriptor_ef1ed06249b07d86 = []byte{
-	// 1670 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x58, 0xcf, 0x6f, 0x1b, 0xc7,
-	0x15, 0xd6, 0x4a, 0x94, 0x44, 0x3e, 0x52, 0x14, 0x35, 0xa2, 0xc3, 0x35, 0xdd, 0x92, 0xc2, 0xba,
-	0x4e, 0x64, 0x25, 0x26, 0x6d, 0xa5, 0x28, 0x0c, 0xf7, 0x24, 0x55, 0x6e, 0x2a, 0xa4, 0xb1, 0x85,
-	0xa5, 0x9a, 0x16, 0xed, 0x61, 0x31, 0xe4, 0x8e, 0x96, 0x0b, 0x2d, 0x77, 0xd8, 0x9d, 0x21, 0x6d,
-	0xf7, 0x50, 0x14, 0x3e, 0x15, 0x41, 0x51, 0xb4, 0x08, 0xd0, 0x73, 0xee, 0xbd, 0x18, 0x45, 0x2e,
-	0x05, 0xda, 0x7b, 0x8e, 0x41, 0x7a, 0x29, 0x7a, 0x30, 0x0a, 0xb9, 0x80, 0xdb, 0xff, 0xa2, 0x98,
-	0xb7, 0xb3, 0xd4, 0x2e, 0x29, 0x5a, 0x75, 0x73, 0x48, 0x2e, 0xe6, 0xce, 0xfb, 0x35, 0xdf, 0x7b,
-	0xf3, 0xde, 0x37, 0x63, 0x41, 0x8d, 0x3d, 0xe6, 0x3d, 0x1e, 0xb1, 0x36, 0x1d, 0x8b, 0xf6, 0xf8,
-	0x4e, 0x5b, 0x3e, 0x6e, 0x0d, 0x23, 0x2e, 0x39, 0x29, 0x6b, 0x45, 0x8b, 0x8e, 0x45, 0x6b, 0x7c,
-	0xa7, 0xbe, 0x41, 0x07, 0x7e, 0xc8, 0xdb, 0xf8, 0x6f, 0x6c, 0x52, 0xaf, 0xf5, 0xb8, 0x18, 0x70,
-	0xd1, 0x1e, 0x08, 0x4f, 0xb9, 0x0e, 0x84, 0xa7, 0x15, 0x57, 0x63, 0x85, 0x83, 0xab, 0x76, 0xbc,
-	0xd0, 0xaa, 0xaa, 0xc7, 0x3d, 0x1e, 0xcb, 0xd5, 0x97, 0x96, 0x7e, 0xc3, 0xe3, 0xdc, 0x0b, 0x58,
-	0x9b, 0x0e, 0xfd, 0x36, 0x0d, 0x43, 0x2e, 0xa9, 0xf4, 0x79, 0xa8, 0x7d, 0xac, 0xbf, 0xae, 0xc2,
-	0xea, 0xde, 0x87, 0x9d, 0xc3, 0xf0, 0x84, 0x13, 0x02, 0xb9, 0x90, 0x0e, 0x98, 0x69, 0x6c, 0x19,
-	0xdb, 0x05, 0x1b, 0xbf, 0x49, 0x13, 0x8a, 0x74, 0x2c, 0x1c, 0xea, 0xba, 0x11, 0x13, 0xc2, 0x5c,
-	0x44, 0x15, 0xd0, 0xb1, 0xd8, 0x8b, 0x25, 0x64, 0x1b, 0x2a, 0x03, 0x3f, 0x74, 0x84, 0xa4, 0xa7,
-	0xcc, 0xa1, 0x03, 0x3e, 0x0a, 0xa5, 0xb9, 0xb4, 0x65, 0x6c, 0xe7, 0xec, 0xf2, 0xc0, 0x0f, 0x3b,
-	0x4a, 0xbc, 0x87, 0x52, 0x72, 0x0d, 0x0a, 0x92, 0x8a, 0x53, 0x8c, 0x65, 0xe6, 0x30, 0x50, 0x5e,
-	0x09, 0x54, 0x24, 0xf2, 0x4d, 0x00, 0x11, 0x50, 0xd1, 0x8f, 0xb5, 0xcb, 0xa8, 0x2d, 0xa0, 0x04,
-	0xd5, 0x4d, 0x28, 0x46, 0xec, 0x11, 0x8d, 0xdc, 0x58, 0xbf, 0x12, 0xc3, 0x88, 0x45, 0x68, 0xb0,
-	0x03, 0x1b, 0x0a, 0x27, 0x7f, 0x14, 0xb2, 0x68, 0x82, 0x76, 0x75, 0x6b, 0x69, 0xbb, 0x60, 0xaf,
-	0xd3, 0xb1, 0x78, 0xa8, 0xe4, 0x09, 0xe4, 0x9b, 0x50, 0xa0, 0x42, 0x30, 0xe9, 0xf8, 0xae, 0x30,
-	0xf3, 0xca, 0x66, 0xbf, 0x74, 0xf6, 0xbc, 0x99, 0xdf, 0x53, 0xc2, 0xc3, 0x03, 0x61, 0xe7, 0x51,
-	0x7d, 0xe8, 0x0a, 0x72, 0x1b, 0xaa, 0x2a, 0xec, 0x28, 0xec, 0xf2, 0xd0, 0xf5, 0x43, 0xcf, 0x19,
-	0xb2, 0xc8, 0xe7, 0xae, 0x59, 0xc0, 0x0c, 0x09, 0x1d, 0x8b, 0x1f, 0x25, 0xaa, 0x23, 0xd4, 0x90,
-	0x16, 0x6c, 0x62, 0x3d, 0x58, 0x70, 0xe2, 0xb8, 0x2c, 0x60, 0x1e, 0x96, 0xdb, 0x04, 0x74, 0xd8,
-	0x50, 0x25, 0x61, 0xc1, 0xc9, 0xc1, 0x44, 0x41, 0x6e, 0x42, 0x85, 0x0d, 0x79, 0xaf, 0xef, 0xf8,
-	0x2e, 0x0b, 0xa5, 0x7f, 0xe2, 0xb3, 0xc8, 0x2c, 0x62, 0x7a, 0xeb, 0x28, 0x3f, 0x9c, 0x88, 0x49,
-	0x1b, 0xaa, 0x2a, 0x34, 0x1f, 0x4a, 0x07, 0x7f, 0x58, 0x44, 0x25, 0x8f, 0x84, 0x59, 0x9a, 0xc4,
-	0x7e, 0x38, 0x94, 0x87, 0xe1, 0xc3, 0x44, 0x41, 0xde, 0x85, 0x37, 0x94, 0x83, 0xe4, 0x92, 0x06,
-	0xd9, 0x13, 0x5a, 0x43, 0x17, 0x85, 0xf4, 0x58, 0x29, 0xd3, 0xc7, 0x74, 0x03, 0xca, 0x42, 0xd2,
-	0x48, 0xaa, 0x6c, 0x11, 0x81, 0x59, 0x46, 0xe3, 0xb5, 0x44, 0x7a, 0x5f, 0x09, 0xc9, 0x55, 0xc8,
-	0xf7, 0xfa, 0xd4, 0x0f, 0x1d, 0xdf, 0x35, 0xd7, 0x11, 0xef, 0x2a, 0xae, 0x0f, 0x5d, 0xf2, 0x01,
-	0xa8, 0x06, 0x71, 0xe2, 0xd3, 0x31, 0x2b, 0x4a, 0xb9, 0xdf, 0xfa, 0xec, 0x79, 0x73, 0xe1, 0x1f,
-	0xcf, 0x9b, 0x6f, 0x7a, 0xbe, 0xec, 0x8f, 0xba, 0xad, 0x1e, 0x1f, 0xe8, 0xe6, 0xd5, 0x3f, 0xb7,
-	0x84, 0x7b, 0xda, 0x96, 0x4f, 0x86, 0x4c, 0xb4, 0x0e, 0x58, 0xcf, 0x2e, 0xd0, 0xb1, 0xb0, 0x31,
-	0x00, 0x79, 0x1f, 0xd4, 0xc2, 0xc1, 0x66, 0x30, 0x37, 0xfe, 0xaf, 0x68, 0x79, 0x3a, 0x16, 0x1d,
-	0xe5, 0x4f, 0x7e, 0x09, 0xcd, 0xf8, 0xec, 0x93, 0x76, 0xc2, 0xa4, 0xe3, 0x44, 0x9d, 0x2e, 0x15,
-	0xbe, 0x30, 0xc9, 0xd6, 0xd2, 0x76, 0x71, 0xf7, 0x6e, 0x2b, 0x3b, 0xa4, 0x2d, 0x3d, 0x25, 0x2d,
-	0xec, 0x92, 0x18, 0x5a, 0x5c, 0x31, 0xac, 0xc7, 0xbe, 0x72, 0xbd, 0x1f, 0xca, 0xe8, 0x89, 0x7d,
-	0x8d, 0xce, 0xb7, 0xa8, 0x3f, 0x80, 0xad, 0xcb, 0x02, 0x90, 0x0a, 0x2c, 0x9d, 0xb2, 0x27, 0x7a,
-	0x0c, 0xd5, 0x27, 0xa9, 0xc2, 0xf2, 0x98, 0x06, 0x23, 0x86, 0xf3, 0xb7, 0x64, 0xc7, 0x8b, 0x7b,
-	0x8b, 0x77, 0x0d, 0x2b, 0x82, 0x72, 0x72, 0xde, 0x1d, 0x49, 0xe5, 0x48, 0x75, 0x77, 0x25, 0x69,
-	0x8d, 0xc9, 0x20, 0xc4, 0xa1, 0xd6, 0x13, 0x79, 0x32, 0x08, 0x6f, 0xc0, 0x8a, 0x40, 0x27, 0x3d,
-	0xd7, 0x7a, 0xa5, 0x86, 0x71, 0x18, 0x71, 0x7e, 0xe2, 0xb8, 0x54, 0x52, 0x9c, 0xe6, 0x92, 0x5d,
-	0x40, 0xc9, 0x01, 0x95, 0xd4, 0xfa, 0x8f, 0x01, 0x95, 0x18, 0x3f, 0xd6, 0xf4, 0x48, 0x29, 0x48,
-	0x0d, 0x56, 0x71, 0xba, 0x7d, 0x57, 0xef, 0xb6, 0xa2, 0x96, 0x87, 0x2e, 0xd9, 0x85, 0x2b, 0xa8,
-	0xe8, 0xf1, 0x50, 0x46, 0xb4, 0x27, 0xa7, 0xb8, 0x64, 0x53, 0x29, 0xbf, 0xa7, 0x75, 0x09, 0xb0,
-	0x06, 0x00, 0xf5, 0xbc, 0x48, 0xcd, 0x08, 0x8f, 0x10, 0x80, 0x22, 0x9d, 0x89, 0x64, 0x9a, 0x95,
-	0x72, 0x33, 0xac, 0xf4, 0x1e, 0x4c, 0x92, 0x75, 0x74, 0x8a, 0xcb, 0x78, 0xac, 0x8d, 0xe9, 0x63,
-	0xcd, 0x56, 0xcf, 0x2e, 0xf3, 0xcc, 0xda, 0x7a, 0xb6, 0x02, 0xf9, 0x63, 0x95, 0x88, 0x22, 0xc8,
-	0xb9, 0xa9, 0x18, 0xf3, 0x53, 0x49, 0x48, 0x75, 0x31, 0x45, 0xaa, 0x04, 0x72, 0x7d, 0xd5, 0xcc,
-	0x71, 0x65, 0xf1, 0x3b, 0x5d, 0xbf, 0x1c, 0xce, 0x5b, 0x52, 0xbf, 0xdb, 0x50, 0x45, 0x45, 0xc4,
-	0xc4, 0x90, 0x87, 0x82, 0x25, 0x14, 0xb4, 0x1c, 0x53, 0x90, 0xd2, 0xd9, 0x5a, 0xa5, 0x29, 0xe8,
-	0x3b, 0x50, 0x43, 0x0f, 0x95, 0xb8, 0x2f, 0xa4, 0xdf, 0xa3, 0x41, 0xe2, 0xb4, 0x82, 0x4e, 0x98,
-	0x45, 0xe7, 0x5c, 0xab, 0xfd, 0x26, 0xe9, 0xf5, 0x69, 0x10, 0xb0, 0xd0, 0x9b, 0x6c, 0xb5, 0x1a,
-	0xb3, 0x05, 0xa6, 0x97, 0xe8, 0xb4, 0xcf, 0x1d, 0xa8, 0xca, 0x7e, 0xc4, 0x44, 0x9f, 0x07, 0xae,
-	0x32, 0xef, 0xb1, 0x50, 0x52, 0x8f, 0x99, 0x79, 0xed, 0x92, 0xe8, 0x8e, 0x26, 0xaa, 0x0b, 0x08,
-	0xa6, 0x70, 0x11, 0xc1, 0xdc, 0x84, 0x0a, 0xed, 0xc9, 0x11, 0x0d, 0x9c, 0x49, 0x10, 0xcd, 0xa2,
-	0xeb, 0xb1, 0xfc, 0x38, 0x11, 0xab, 0x3b, 0x68, 0x86, 0x14, 0x8b, 0xc8, 0xfd, 0x65, 0x9e, 0x65,
-	0xc4, 0x9b, 0x50, 0x11, 0xbe, 0x17, 0x32, 0x37, 0x43, 0x9f, 0x78, 0x4b, 0xc4, 0xf2, 0x73, 0xd3,
-	0x16, 0x6c, 0x86, 0xdc, 0x99, 0xb1, 0x5e, 0x43, 0xeb, 0x8d, 0x90, 0x77, 0xa6, 0xec, 0x6f, 0x43,
-	0x95, 0x45, 0xd1, 0xac, 0x43, 0x19, 0x1d, 0x08, 0x8b, 0xa2, 0x69, 0x8f, 0xc7, 0x50, 0xc1, 0x7a,
-	0xc7, 0xfc, 0x3c, 0xe4, 0x8f, 0x58, 0x14, 0x53, 0xe9, 0xfe, 0x83, 0xd7, 0xe3, 0xb7, 0xb3, 0xe7,
-	0xcd, 0xb2, 0x6a, 0x52, 0xe4, 0xf2, 0x23, 0x15, 0xe7, 0x8b, 0x4f, 0x6f, 0x81, 0x7e, 0x1b, 0x28,
-	0xfe, 0x2b, 0xcb, 0x8c, 0x96, 0xfc, 0x0c, 0xae, 0x9c, 0x73, 0x44, 0x4f, 0xfa, 0x63, 0xa6, 0xb7,
-	0x57, 0x64, 0x5d, 0xdc, 0x7d, 0x6b, 0xde, 0x90, 0xec, 0xa1, 0x2d, 0xc6, 0xf8, 0xa1, 0x2f, 0xa4,
-	0xbd, 0xc9, 0x67, 0x15, 0x56, 0x04, 0xb5, 0x39, 0xf6, 0xe4, 0xc7, 0x30, 0xf1, 0x88, 0x37, 0x74,
-	0x02, 0x5f, 0x48, 0xd3, 0xc0, 0xd1, 0xfc, 0x5f, 0x76, 0x55, 0x63, 0x68, 0x6f, 0x24, 0x31, 0x26,
-	0x81, 0xad, 0x3f, 0x19, 0x17, 0x6e, 0x8a, 0x53, 0x7b, 0x1d, 0xd6, 0x32, 0x84, 0xa8, 0xa7, 0xb5,
-	0x94, 0x66, 0x43, 0x12, 0x41, 0x29, 0x53, 0x08, 0x1c, 0xd7, 0xfd, 0x87, 0xaf, 0x7d, 0x0e, 0xeb,
-	0xea, 0x7a, 0x4f, 0x21, 0x98, 0x3a, 0x88, 0x22, 0x4d, 0x15, 0xea, 0x27, 0xb0, 0xb6, 0x1f, 0x88,
-	0xa3, 0x51, 0xf7, 0x7d, 0xf6, 0x04, 0x91, 0xd6, 0x21, 0x9f, 0x80, 0xd2, 0x20, 0x27, 0xeb, 0x0b,
-	0x79, 0xa4, 0x06, 0xab, 0xc3, 0x51, 0xd7, 0x51, 0x97, 0x45, 0x4c, 0x25, 0x2b, 0x43, 0x0c, 0x66,
-	0xfd, 0xd9, 0x00, 0x62, 0x33, 0xcf, 0x17, 0x92, 0x45, 0x7b, 0x1f, 0x76, 0x8e, 0x91, 0x23, 0x7e,
-	0x4e, 0xbe, 0x0b, 0xa5, 0x93, 0x88, 0x0f, 0xb2, 0xb4, 0xb5, 0x6f, 0x7e, 0xf1, 0xe9, 0xad, 0xaa,
-	0xc6, 0xa8, 0x59, 0xab, 0x23, 0x23, 0x3f, 0xf4, 0xec, 0xa2, 0xb2, 0x4e, 0x88, 0xec, 0x1d, 0xc8,
-	0xa9, 0x2e, 0x42, 0x00, 0xc5, 0x5d, 0x73, 0xfa, 0xb0, 0x12, 0x92, 0xb4, 0xd1, 0xea, 0xde, 0xdd,
-	0x5f, 0x7f, 0xd2, 0x5c, 0xf8, 0xf7, 0x27, 0xcd, 0x85, 0xa7, 0x2f, 0x9f, 0xed, 0x14, 0xbf, 0x7f,
-	0x1e, 0xe7, 0xa3, 0x97, 0xcf, 0x76, 0xae, 0xa5, 0x8a, 0x77, 0x9c, 0xe2, 0x4c, 0xe5, 0x6f, 0x5d,
-	0x85, 0xda, 0x0c, 0xf4, 0x98, 0xde, 0xac, 0xdf, 0x18, 0x50, 0x4e, 0xe9, 0xbe, 0x74, 0x4a, 0x6f,
-	0x43, 0xce, 0x0f, 0x4f, 0xb8, 0x4e, 0xa9, 0x36, 0xe7, 0xc6, 0xb7, 0xd1, 0xe8, 0x5e, 0x65, 0x3a,
-	0x13, 0xeb, 0xf7, 0x06, 0x6c, 0x66, 0xe0, 0xc4, 0x30, 0xbf, 0x52, 0x4c, 0xbf, 0x35, 0xa0, 0x72,
-	0xc0, 0xbe, 0x46, 0x45, 0xfa, 0xd8, 0x80, 0x2b, 0x53, 0x80, 0xbe, 0x06, 0x65, 0xfa, 0xc3, 0x22,
-	0x94, 0x75, 0x6b, 0x8d, 0x02, 0xec, 0xbb, 0xd7, 0x79, 0x37, 0xbd, 0x03, 0x24, 0x7b, 0x25, 0xe3,
-	0x6d, 0x1e, 0x4f, 0x66, 0x25, 0x7d, 0x21, 0xff, 0x40, 0xdd, 0xec, 0xd7, 0x61, 0x2d, 0x63, 0xad,
-	0x67, 0xb5, 0x94, 0x36, 0x54, 0x46, 0xdd, 0x40, 0xe0, 0xed, 0x41, 0xe5, 0x28, 0x62, 0xf8, 0x08,
-	0x28, 0xd9, 0xa5, 0x6e, 0x20, 0x3a, 0x89, 0x6c, 0xfe, 0xfb, 0x63, 0x79, 0xfe, 0xfb, 0x23, 0xf5,
-	0xae, 0x58, 0xc9, 0xbc, 0x2b, 0xaa, 0xb0, 0x3c, 0xec, 0x53, 0xc1, 0xf0, 0x76, 0x5f, 0xb3, 0xe3,
-	0x85, 0xf5, 0x17, 0x03, 0x36, 0x3b, 0xa3, 0xee, 0xc0, 0x97, 0xe7, 0xe5, 0xf9, 0xd2, 0x2d, 0xb4,
-	0x9b, 0x39, 0xac, 0xc6, 0x45, 0xd4, 0x71, 0x7e, 0x10, 0xfa, 0xcc, 0xbe, 0xfd, 0x2a, 0x02, 0xa9,
-	0xa5, 0x08, 0x24, 0xa1, 0x7d, 0x24, 0x8f, 0x3a, 0x98, 0xb3, 0xe8, 0xe3, 0x12, 0xef, 0xfe, 0x31,
-	0x07, 0x4b, 0x1f, 0x08, 0x8f, 0xfc, 0x02, 0x8a, 0xa9, 0x76, 0x24, 0x33, 0x70, 0xb2, 0xc3, 0x53,
-	0xbf, 0xfe, 0x4a, 0xbd, 0x66, 0xa6, 0x37, 0x9f, 0xfe, 0xed, 0x5f, 0x1f, 0x2f, 0x6e, 0x59, 0x8d,
-	0xf6, 0xcc, 0xff, 0xf9, 0xdb, 0xe9, 0xcd, 0x9e, 0x1a, 0xb0, 0x96, 0x99, 0x06, 0xb2, 0x35, 0x1d,
-	0x7e, 0x7a, 0x7a, 0xeb, 0x37, 0x2e, 0xb1, 0xd0, 0x10, 0xb6, 0x11, 0x82, 0x65, 0x6d, 0x5d, 0x00,
-	0x21, 0xbb, 0xe5, 0x47, 0x06, 0xac, 0x4f, 0x51, 0x2c, 0xb1, 0x5e, 0x91, 0xa5, 0xbe, 0x3e, 0xea,
-	0x6f, 0x5d, 0x6a, 0xa3, 0xa1, 0xec, 0x20, 0x94, 0x6f, 0x59, 0xd6, 0xab, 0xab, 0x81, 0x1b, 0x2b,
-	0xc2, 0x9a, 0x3e, 0x32, 0x32, 0x53, 0xf3, 0x0b, 0x5a, 0xb2, 0xbe, 0x7d, 0xb9, 0x91, 0xc6, 0xf3,
-	0x36, 0xe2, 0xb9, 0x61, 0x5d, 0xbf, 0x00, 0xcf, 0xb4, 0x53, 0x7d, 0xf9, 0x57, 0x2f, 0x9f, 0xed,
-	0x18, 0xfb, 0xef, 0x7d, 0x76, 0xd6, 0x30, 0x3e, 0x3f, 0x6b, 0x18, 0xff, 0x3c, 0x6b, 0x18, 0xbf,
-	0x7b, 0xd1, 0x58, 0xf8, 0xfc, 0x45, 0x63, 0xe1, 0xef, 0x2f, 0x1a, 0x0b, 0x3f, 0xbd, 0x95, 0x7a,
-	0x0c, 0xdc, 0x8f, 0xe3, 0x3d, 0x60, 0xf2, 0x11, 0x8f, 0x4e, 0x27, 0xe1, 0x1f, 0xe3, 0x06, 0xf8,
-	0x2e, 0xe8, 0xae, 0xe0, 0x1f, 0x5a, 0xde, 0xfd, 0x6f, 0x00, 0x00, 0x00, 0xff, 0xff, 0xc7, 0x25,
-	0xed, 0x96, 0x0e, 0x12, 0x00, 0x00,
+	// 2293 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x58, 0xcd, 0x6f, 0x1b, 0xd7,
+	0x11, 0xd7, 0x4a, 0xd4, 0x07, 0x87, 0x12, 0x45, 0x3d, 0xc9, 0xd6, 0x7a, 0x93, 0x92, 0xec, 0xaa,
+	0xb1, 0x65, 0xc5, 0x26, 0x6d, 0x25, 0x68, 0x5d, 0xa7, 0x87, 0x52, 0x22, 0x65, 0xb3, 0xb6, 0x25,
+	0x65, 0x29, 0xa5, 0x45, 0x7a, 0x58, 0x3c, 0x71, 0x9f, 0xc8, 0xad, 0xc9, 0x5d, 0x76, 0xdf, 0x92,
+	0xb6, 0x73, 0x28, 0x0a, 0x9f, 0x02, 0xa1, 0x2d, 0x5a, 0x04, 0x28, 0xd0, 0x83, 0x80, 0x00, 0xbd,
+	0x14, 0x68, 0x0f, 0x46, 0x91, 0x4b, 0x81, 0xf6, 0x9e, 0x63, 0x90, 0x5e, 0x82, 0x1e, 0x8c, 0x42,
+	0x2e, 0xe0, 0xf6, 0xda, 0xbf, 0xa0, 0x78, 0xb3, 0x1f, 0x5c, 0x2e, 0x29, 0xcb, 0x4e, 0x80, 0xb6,
+	0x17, 0x89, 0x6f, 0xbe, 0xde, 0xcc, 0xbc, 0x99, 0xdf, 0x0c, 0x09, 0xcb, 0xec, 0xa1, 0x5d, 0xb7,
+	0x1d, 0x56, 0xa4, 0x3d, 0x5e, 0xec, 0x5d, 0x2f, 0xba, 0x0f, 0x0b, 0x1d, 0xc7, 0x76, 0x6d, 0x92,
+	0xf6, 0x19, 0x05, 0xda, 0xe3, 0x85, 0xde, 0x75, 0x65, 0x81, 0xb6, 0x4d, 0xcb, 0x2e, 0xe2, 0x5f,
+	0x4f, 0x44, 0x59, 0xae, 0xdb, 0xbc, 0x6d, 0xf3, 0x62, 0x9b, 0x37, 0x84, 0x6a, 0x9b, 0x37, 0x7c,
+	0xc6, 0x05, 0x8f, 0xa1, 0xe3, 0xa9, 0xe8, 0x1d, 0x7c, 0xd6, 0x52, 0xc3, 0x6e, 0xd8, 0x1e, 0x5d,
+	0x7c, 0xf2, 0xa9, 0xaf, 0x37, 0x6c, 0xbb, 0xd1, 0x62, 0x45, 0xda, 0x31, 0x8b, 0xd4, 0xb2, 0x6c,
+	0x97, 0xba, 0xa6, 0x6d, 0xf9, 0x3a, 0xea, 0x5f, 0xa6, 0x61, 0xba, 0xf4, 0x5e, 0xad, 0x6a, 0x1d,
+	0xda, 0x84, 0x40, 0xc2, 0xa2, 0x6d, 0x26, 0x4b, 0x79, 0x69, 0x35, 0xa9, 0xe1, 0x67, 0x92, 0x83,
+	0x14, 0xed, 0x71, 0x9d, 0x1a, 0x86, 0xc3, 0x38, 0x97, 0xc7, 0x91, 0x05, 0xb4, 0xc7, 0x4b, 0x1e,
+	0x85, 0xac, 0x42, 0xa6, 0x6d, 0x5a, 0x3a, 0x77, 0xe9, 0x7d, 0xa6, 0xd3, 0xb6, 0xdd, 0xb5, 0x5c,
+	0x79, 0x22, 0x2f, 0xad, 0x26, 0xb4, 0x74, 0xdb, 0xb4, 0x6a, 0x82, 0x5c, 0x42, 0x2a, 0x79, 0x0d,
+	0x92, 0x2e, 0xe5, 0xf7, 0xd1, 0x96, 0x9c, 0x40, 0x43, 0x33, 0x82, 0x20, 0x2c, 0x91, 0xaf, 0x01,
+	0xf0, 0x16, 0xe5, 0x4d, 0x8f, 0x3b, 0x89, 0xdc, 0x24, 0x52, 0x90, 0x9d, 0x83, 0x94, 0xc3, 0x1e,
+	0x50, 0xc7, 0xf0, 0xf8, 0x53, 0x9e, 0x1b, 0x1e, 0x09, 0x05, 0xd6, 0x60, 0x41, 0xf8, 0x69, 0x3f,
+	0xb0, 0x98, 0x13, 0x7a, 0x3b, 0x9d, 0x9f, 0x58, 0x4d, 0x6a, 0xf3, 0xb4, 0xc7, 0x77, 0x04, 0x3d,
+	0x70, 0xf9, 0x32, 0x24, 0x29, 0xe7, 0xcc, 0xd5, 0x4d, 0x83, 0xcb, 0x33, 0x42, 0x66, 0x63, 0xf6,
+	0xe4, 0x69, 0x6e, 0xa6, 0x24, 0x88, 0xd5, 0x32, 0xd7, 0x66, 0x90, 0x5d, 0x35, 0x38, 0xb9, 0x06,
+	0x4b, 0xc2, 0x6c, 0xd7, 0x3a, 0xb0, 0x2d, 0xc3, 0xb4, 0x1a, 0x7a, 0x87, 0x39, 0xa6, 0x6d, 0xc8,
+	0x49, 0x8c, 0x90, 0xd0, 0x1e, 0xdf, 0x0f, 0x58, 0xbb, 0xc8, 0x21, 0x05, 0x58, 0xc4, 0x7c, 0xb0,
+	0xd6, 0xa1, 0x6e, 0xb0, 0x16, 0x6b, 0x60, 0xba, 0x65, 0x40, 0x85, 0x05, 0x91, 0x12, 0xd6, 0x3a,
+	0x2c, 0x87, 0x0c, 0x72, 0x19, 0x32, 0xac, 0x63, 0xd7, 0x9b, 0xba, 0x69, 0x30, 0xcb, 0x35, 0x0f,
+	0x4d, 0xe6, 0xc8, 0x29, 0x0c, 0x6f, 0x1e, 0xe9, 0xd5, 0x90, 0x4c, 0x8a, 0xb0, 0x24, 0x4c, 0xdb,
+	0x1d, 0x57, 0xc7, 0x7f, 0xcc, 0xa1, 0xae, 0xed, 0x70, 0x79, 0x36, 0xb4, 0xbd, 0xd3, 0x71, 0xab,
+	0xd6, 0x4e, 0xc0, 0x20, 0x6f, 0xc1, 0x79, 0xa1, 0xe0, 0xda, 0x2e, 0x6d, 0x0d, 0xbe, 0xd0, 0x1c,
+	0xaa, 0x08, 0x4f, 0xf7, 0x04, 0x33, 0xfa, 0x4c, 0x6f, 0x40, 0x9a, 0xbb, 0xd4, 0x71, 0x45, 0xb4,
+	0xe8, 0x81, 0x9c, 0x46, 0xe1, 0xb9, 0x80, 0x5a, 0x11, 0x44, 0x72, 0x01, 0x66, 0xea, 0x4d, 0x6a,
+	0x5a, 0xba, 0x69, 0xc8, 0xf3, 0xe8, 0xef, 0x34, 0x9e, 0xab, 0x06, 0xb9, 0x07, 0xa2, 0x40, 0x74,
+	0xef, 0x75, 0xe4, 0x8c, 0x60, 0x6e, 0x14, 0x3e, 0x7d, 0x9a, 0x1b, 0xfb, 0xdb, 0xd3, 0xdc, 0xc5,
+	0x86, 0xe9, 0x36, 0xbb, 0x07, 0x85, 0xba, 0xdd, 0xf6, 0x8b, 0xd7, 0xff, 0x77, 0x95, 0x1b, 0xf7,
+	0x8b, 0xee, 0xa3, 0x0e, 0xe3, 0x85, 0x32, 0xab, 0x6b, 0x49, 0xda, 0xe3, 0x1a, 0x1a, 0x20, 0x77,
+	0x40, 0x1c, 0x74, 0x2c, 0x06, 0x79, 0xe1, 0x4b, 0x59, 0x9b, 0xa1, 0x3d, 0x5e, 0x13, 0xfa, 0xe4,
+	0x27, 0x90, 0xf3, 0xde, 0x3e, 0x28, 0x27, 0x0c, 0xda, 0x0b, 0x54, 0x3f, 0xa0, 0xdc, 0xe4, 0x32,
+	0xc9, 0x4f, 0xac, 0xa6, 0xd6, 0x6f, 0x14, 0x06, 0x9b, 0xb4, 0xe0, 0x77, 0x49, 0x01, 0xab, 0xc4,
+	0x73, 0xcd, 0xcb, 0x18, 0xe6, 0x63, 0x43, 0xa8, 0x56, 0x2c, 0xd7, 0x79, 0xa4, 0xbd, 0x46, 0x4f,
+	0x97, 0x50, 0xb6, 0x21, 0x7f, 0x96, 0x01, 0x92, 0x81, 0x89, 0xfb, 0xec, 0x91, 0xdf, 0x86, 0xe2,
+	0x23, 0x59, 0x82, 0xc9, 0x1e, 0x6d, 0x75, 0x19, 0xf6, 0xdf, 0x84, 0xe6, 0x1d, 0x6e, 0x8e, 0xdf,
+	0x90, 0x54, 0x07, 0xd2, 0xc1, 0x7b, 0xd7, 0x5c, 0xea, 0x76, 0x45, 0x75, 0x67, 0x82, 0xd2, 0x08,
+	0x1b, 0xc1, 0x33, 0x35, 0x1f, 0xd0, 0x83, 0x46, 0x38, 0x0f, 0x53, 0x1c, 0x95, 0xfc, 0xbe, 0xf6,
+	0x4f, 0xa2, 0x19, 0x3b, 0x8e, 0x6d, 0x1f, 0xea, 0x06, 0x75, 0x29, 0x76, 0xf3, 0xac, 0x96, 0x44,
+	0x4a, 0x99, 0xba, 0x54, 0xfd, 0x97, 0x04, 0x19, 0xcf, 0x7f, 0xcc, 0xe9, 0xae, 0x60, 0x90, 0x65,
+	0x98, 0xc6, 0xee, 0x36, 0x0d, 0xff, 0xb6, 0x29, 0x71, 0xac, 0x1a, 0x64, 0x1d, 0xce, 0x21, 0xa3,
+	0x6e, 0x5b, 0xae, 0x43, 0xeb, 0x6e, 0x0c, 0x4b, 0x16, 0x05, 0x73, 0xd3, 0xe7, 0x05, 0x8e, 0x65,
+	0x01, 0x68, 0xa3, 0xe1, 0x88, 0x1e, 0xb1, 0x1d, 0x74, 0x40, 0x80, 0x4e, 0x48, 0x89, 0xa3, 0x52,
+	0x62, 0x08, 0x95, 0x6e, 0x41, 0x18, 0xac, 0xee, 0x87, 0x38, 0x89, 0xcf, 0x9a, 0x8d, 0x3f, 0xeb,
+	0x60, 0xf6, 0xb4, 0xb4, 0x3d, 0x70, 0x56, 0xff, 0x3d, 0x0d, 0x33, 0x7b, 0x22, 0x10, 0x01, 0x90,
+	0xa7, 0x86, 0x22, 0x9d, 0x1e, 0x4a, 0x00, 0xaa, 0xe3, 0x11, 0x50, 0x25, 0x90, 0x68, 0x8a, 0x62,
+	0xf6, 0x32, 0x8b, 0x9f, 0xa3, 0xf9, 0x4b, 0x60, 0xbf, 0x05, 0xf9, 0xbb, 0x06, 0x4b, 0xc8, 0x70,
+	0x18, 0xef, 0xd8, 0x16, 0x67, 0x01, 0x04, 0x4d, 0x7a, 0x10, 0x24, 0x78, 0x9a, 0xcf, 0xf2, 0x21,
+	0xe8, 0x9b, 0xb0, 0x8c, 0x1a, 0x22, 0x70, 0x93, 0xbb, 0x66, 0x9d, 0xb6, 0x02, 0xa5, 0x29, 0x54,
+	0xc2, 0x28, 0x6a, 0x7d, 0xae, 0xaf, 0x17, 0x86, 0xd7, 0xa4, 0xad, 0x16, 0xb3, 0x1a, 0xe1, 0x55,
+	0xd3, 0x1e, 0x5a, 0x60, 0x78, 0x01, 0xcf, 0xd7, 0xb9, 0x0e, 0x4b, 0x6e, 0xd3, 0x61, 0xbc, 0x69,
+	0xb7, 0x0c, 0x21, 0x5e, 0x67, 0x96, 0x4b, 0x1b, 0x4c, 0x9e, 0xf1, 0x55, 0x02, 0xde, 0x6e, 0xc8,
+	0x1a, 0x01, 0x30, 0xc9, 0x51, 0x00, 0x73, 0x19, 0x32, 0xb4, 0xee, 0x76, 0x69, 0x4b, 0x0f, 0x8d,
+	0xf8, 0x28, 0x3a, 0xef, 0xd1, 0xf7, 0x02, 0xb2, 0x98, 0x41, 0x43, 0xa0, 0x98, 0x42, 0xec, 0x4f,
+	0xdb, 0x83, 0x88, 0x78, 0x19, 0x32, 0xdc, 0x6c, 0x58, 0xcc, 0x18, 0x80, 0x4f, 0x9c, 0x12, 0x1e,
+	0xbd, 0x2f, 0x5a, 0x80, 0x45, 0xcb, 0xd6, 0x87, 0xa4, 0xe7, 0x50, 0x7a, 0xc1, 0xb2, 0x6b, 0x31,
+	0xf9, 0x6b, 0xb0, 0xc4, 0x1c, 0x67, 0x58, 0x21, 0x8d, 0x0a, 0x84, 0x39, 0x4e, 0x5c, 0xe3, 0x21,
+	0x64, 0x30, 0xdf, 0x1e, 0x3e, 0x77, 0xec, 0x07, 0xcc, 0xf1, 0xa0, 0x74, 0x63, 0xfb, 0xd5, 0xf0,
+	0xed, 0xe4, 0x69, 0x2e, 0x2d, 0x8a, 0x14, 0xb1, 0x7c, 0x57, 0xd8, 0xf9, 0xfc, 0x93, 0xab, 0xe0,
+	0xef, 0x06, 0x02, 0xff, 0xd2, 0xee, 0x00, 0x97, 0xfc, 0x10, 0xce, 0xf5, 0x31, 0xa2, 0xee, 0x9a,
+	0x3d, 0xe6, 0x5f, 0x2f, 0xc0, 0x3a, 0xb5, 0x7e, 0xe9, 0xb4, 0x26, 0x29, 0xa1, 0x2c, 0xda, 0xb8,
+	0x6b, 0x72, 0x57, 0x5b, 0xb4, 0x87, 0x19, 0xa2, 0xfc, 0x82, 0x56, 0x65, 0xfd, 0xaa, 0x6d, 0x86,
+	0xe8, 0xad, 0x9d, 0x0b, 0xd9, 0x41, 0xe1, 0xde, 0x16, 0x1d, 0x50, 0x84, 0xc5, 0xbe, 0x9e, 0x48,
+	0x23, 0x75, 0xbb, 0x0e, 0x93, 0x09, 0x36, 0x09, 0x09, 0x59, 0xb5, 0x80, 0x43, 0x56, 0x60, 0x0e,
+	0xb3, 0xed, 0xe8, 0x07, 0xa6, 0xdb, 0xa6, 0x1d, 0x79, 0x11, 0x45, 0x67, 0x3d, 0xe2, 0x06, 0xd2,
+	0xc8, 0x7a, 0x88, 0x71, 0x4b, 0x79, 0x69, 0x35, 0xbd, 0xae, 0xc4, 0x63, 0xdb, 0xf3, 0x7b, 0xa1,
+	0xcb, 0x03, 0xfc, 0x53, 0x1d, 0x58, 0x3e, 0x25, 0x62, 0xf2, 0x7d, 0x08, 0x63, 0xf6, 0x52, 0xa6,
+	0xb7, 0x4c, 0xee, 0xca, 0x12, 0x82, 0xcb, 0xcb, 0xe4, 0x4d, 0x00, 0x89, 0xb6, 0x10, 0xd8, 0x08,
+	0x0d, 0xab, 0x7f, 0x94, 0x46, 0x5e, 0x8a, 0xb8, 0xb3, 0x02, 0x73, 0x03, 0x90, 0xee, 0xe3, 0xcd,
+	0x6c, 0x14, 0xcf, 0x89, 0x03, 0xb3, 0x03, 0x4f, 0x89, 0x80, 0xb3, 0xb1, 0xf3, 0xca, 0x95, 0x34,
+	0x2f, 0x16, 0x94, 0x88, 0x07, 0xb1, 0x52, 0x4a, 0xd1, 0x3e, 0x4b, 0xfd, 0xcd, 0x38, 0x2c, 0xee,
+	0x45, 0xb1, 0x84, 0x6b, 0x4c, 0x4c, 0xb0, 0x2f, 0x03, 0x94, 0x11, 0x00, 0x1c, 0x1f, 0x00, 0xc0,
+	0x51, 0x1b, 0xd2, 0xc4, 0xe8, 0x0d, 0xe9, 0xeb, 0x30, 0x7b, 0x48, 0xcd, 0x16, 0x33, 0x7c, 0x60,
+	0x49, 0xe0, 0xb8, 0x4c, 0x79, 0x34, 0x0f, 0x56, 0xae, 0x00, 0x69, 0x51, 0xee, 0xea, 0xd4, 0x75,
+	0x59, 0xbb, 0xe3, 0x4f, 0x7e, 0x04, 0xd3, 0x09, 0x2d, 0x23, 0x38, 0x25, 0x8f, 0xe1, 0x49, 0x2b,
+	0x30, 0xe3, 0x0b, 0x72, 0xc4, 0xce, 0x39, 0x2d, 0x3c, 0x8b, 0x29, 0x89, 0x96, 0x98, 0xe3, 0xd8,
+	0x0e, 0x62, 0x64, 0x52, 0x4b, 0x0a, 0x4a, 0x45, 0x10, 0xd4, 0x1f, 0xc0, 0xdc, 0x46, 0x8b, 0xef,
+	0x76, 0x0f, 0xee, 0xb0, 0x47, 0xf8, 0x8a, 0x0a, 0xcc, 0x04, 0x0f, 0xe6, 0xe7, 0x21, 0x3c, 0x8f,
+	0x9c, 0x12, 0xcb, 0x30, 0xdd, 0xe9, 0x1e, 0xe8, 0x62, 0x15, 0xf0, 0x06, 0xc5, 0x54, 0x07, 0x8d,
+	0xa9, 0x7f, 0x92, 0x80, 0x68, 0xac, 0x61, 0x72, 0x97, 0x39, 0xa5, 0xf7, 0x6a, 0x7b, 0x38, 0x01,
+	0x7e, 0x4c, 0xde, 0x81, 0xd9, 0x43, 0xc7, 0x6e, 0x0f, 0xe6, 0x7a, 0x43, 0xfe, 0xfc, 0x93, 0xab,
+	0x4b, 0xfe, 0xfb, 0xf9, 0xa9, 0xae, 0xb9, 0x8e, 0x69, 0x35, 0xb4, 0x94, 0x90, 0x0e, 0xb2, 0x7f,
+	0x05, 0x12, 0x22, 0xdd, 0xe8, 0x40, 0x6a, 0x5d, 0x1e, 0xd5, 0x24, 0x58, 0xb9, 0x28, 0x75, 0xf3,
+	0xc6, 0x87, 0x1f, 0xe7, 0xc6, 0xfe, 0xf9, 0x71, 0x6e, 0xec, 0xf1, 0xf3, 0x27, 0x6b, 0x03, 0xb7,
+	0x1e, 0x3d, 0x7f, 0xb2, 0xa6, 0x04, 0xdf, 0x7b, 0x86, 0x9d, 0x54, 0xd7, 0x61, 0x79, 0x88, 0xea,
+	0x61, 0x40, 0x7c, 0x83, 0x08, 0x0b, 0x40, 0xfd, 0x9d, 0x04, 0xe9, 0x88, 0xd2, 0x57, 0x8e, 0xf5,
+	0x4d, 0x48, 0x98, 0xd6, 0xa1, 0xed, 0xc7, 0xba, 0x7c, 0xca, 0xa2, 0xa7, 0xa1, 0xd0, 0xcd, 0x2b,
+	0x23, 0x43, 0x3c, 0x3f, 0x22, 0x44, 0x11, 0xde, 0xaf, 0x24, 0x58, 0x1c, 0x20, 0xf9, 0xb1, 0xfd,
+	0xf7, 0xfc, 0xcd, 0x08, 0x7f, 0x53, 0x5b, 0x7d, 0x75, 0xf5, 0x0f, 0x12, 0x64, 0xca, 0xec, 0x7f,
+	0x96, 0xc0, 0xc2, 0xc8, 0x04, 0xca, 0x41, 0x02, 0xe3, 0x9e, 0xa9, 0x1f, 0x49, 0x70, 0x2e, 0x46,
+	0xfc, 0x3f, 0x48, 0xe2, 0xaf, 0xc7, 0x21, 0xed, 0x57, 0x6b, 0xb7, 0xe5, 0x62, 0x3f, 0xbf, 0xc2,
+	0xa2, 0x7d, 0x05, 0xc8, 0xe0, 0x0e, 0x87, 0xd3, 0xd0, 0x6b, 0xf6, 0x4c, 0x74, 0x83, 0xc3, 0x41,
+	0xb8, 0x02, 0x73, 0x03, 0xd2, 0x7e, 0xfb, 0xcf, 0x46, 0x05, 0x85, 0xd0, 0x41, 0x8b, 0x47, 0xe6,
+	0x64, 0xc2, 0x13, 0x3a, 0x68, 0xf1, 0xfe, 0x84, 0x3c, 0x15, 0x87, 0x27, 0x5f, 0x0a, 0x87, 0xa7,
+	0x06, 0x70, 0x78, 0x09, 0x26, 0x3b, 0x4d, 0xca, 0x19, 0x42, 0xdd, 0x9c, 0xe6, 0x1d, 0xd4, 0x9f,
+	0x4d, 0xc0, 0x7c, 0xb8, 0x14, 0x6a, 0xac, 0x6e, 0x3b, 0x06, 0xb9, 0x01, 0x10, 0xee, 0x90, 0xce,
+	0x99, 0xcf, 0x14, 0x91, 0x25, 0x9b, 0x23, 0x72, 0x3a, 0x7e, 0x86, 0xfe, 0x50, 0xb6, 0x4f, 0x8d,
+	0x7a, 0xe2, 0xa5, 0xa2, 0x1e, 0x5c, 0xbf, 0x57, 0x60, 0xce, 0xa1, 0x26, 0x67, 0x86, 0xde, 0x64,
+	0x66, 0xa3, 0xe9, 0xfa, 0xa3, 0x62, 0xd6, 0x23, 0xde, 0x46, 0x1a, 0xf9, 0x56, 0xb8, 0x64, 0x4c,
+	0xe1, 0x92, 0x91, 0x8b, 0x97, 0x57, 0x98, 0xa1, 0xc1, 0x4d, 0x83, 0x5c, 0x82, 0x79, 0x87, 0x71,
+	0xbb, 0xd5, 0xeb, 0xdb, 0x9f, 0x46, 0xfb, 0xe9, 0x80, 0xec, 0xdf, 0x70, 0x11, 0x66, 0xbc, 0xdf,
+	0x47, 0x4c, 0x03, 0x77, 0xeb, 0xe4, 0x46, 0xea, 0xe4, 0x69, 0x6e, 0x1a, 0xbf, 0x80, 0x55, 0xcb,
+	0xda, 0x34, 0x32, 0xab, 0x86, 0xfa, 0x67, 0x09, 0x16, 0x6b, 0xdd, 0x83, 0xb6, 0xe9, 0xf6, 0xab,
+	0xf5, 0x2b, 0xf7, 0xfb, 0xfa, 0x40, 0xef, 0x64, 0x47, 0x0d, 0x87, 0x7e, 0x5f, 0xf8, 0x2d, 0xf4,
+	0x76, 0x74, 0x44, 0x44, 0x5b, 0x49, 0x74, 0xff, 0x72, 0x64, 0xf7, 0x08, 0x96, 0x1e, 0xa1, 0xab,
+	0x2a, 0x20, 0x0f, 0x7b, 0xef, 0x55, 0xfc, 0xda, 0x17, 0xe3, 0x00, 0xfd, 0x65, 0x4d, 0xec, 0xe7,
+	0x7b, 0xa5, 0xda, 0x1d, 0xbd, 0xb6, 0x57, 0xda, 0xdb, 0xaf, 0xe9, 0xbb, 0x95, 0xed, 0x72, 0x75,
+	0xfb, 0x56, 0x66, 0x4c, 0x39, 0x77, 0x74, 0x9c, 0x5f, 0xe8, 0x0b, 0xee, 0x32, 0xfc, 0x79, 0x46,
+	0x54, 0x45, 0x54, 0x5e, 0xab, 0xd4, 0x76, 0x77, 0xb6, 0xcb, 0x95, 0x72, 0x46, 0x52, 0x96, 0x8f,
+	0x8e, 0xf3, 0x8b, 0x91, 0x3d, 0x10, 0x6f, 0x34, 0x98, 0x41, 0xde, 0x01, 0x25, 0xaa, 0xf3, 0xee,
+	0xfe, 0x8e, 0xb6, 0x7f, 0x4f, 0xd7, 0x2a, 0xa5, 0xcd, 0xdb, 0x95, 0x72, 0x66, 0x5c, 0x79, 0xed,
+	0xe8, 0x38, 0xbf, 0xdc, 0x57, 0x7c, 0xb7, 0x6b, 0x3b, 0xdd, 0xb6, 0xc6, 0x68, 0xbd, 0xc9, 0x0c,
+	0xf2, 0x6d, 0xb8, 0x30, 0x42, 0x79, 0xab, 0x54, 0xbd, 0x5b, 0x29, 0x67, 0x26, 0x14, 0xe5, 0xe8,
+	0x38, 0x7f, 0x3e, 0xae, 0xbb, 0x85, 0x9b, 0x0a, 0x79, 0x1b, 0xce, 0x47, 0x55, 0x37, 0x6f, 0x97,
+	0xee, 0xde, 0xad, 0x6c, 0xdf, 0xaa, 0x94, 0x33, 0x09, 0x45, 0x3e, 0x3a, 0xce, 0x2f, 0xf5, 0xf5,
+	0xc2, 0xca, 0x32, 0xe2, 0x11, 0x6e, 0x55, 0xb7, 0x4b, 0x77, 0xab, 0xef, 0x57, 0xca, 0x99, 0xc9,
+	0x78, 0x84, 0x5b, 0xa6, 0x45, 0x5b, 0xe6, 0x07, 0xcc, 0x50, 0x12, 0x1f, 0xfe, 0x36, 0x3b, 0xb6,
+	0xf6, 0xf3, 0xf1, 0x48, 0x13, 0xfb, 0xf9, 0xfd, 0x2e, 0xbc, 0x1e, 0xde, 0x1b, 0x98, 0xdc, 0xdf,
+	0xae, 0xed, 0x56, 0x36, 0xab, 0x5b, 0xd5, 0x4a, 0x39, 0x33, 0xa6, 0x64, 0x8f, 0x8e, 0xf3, 0x4a,
+	0x4c, 0x6d, 0xdf, 0xe2, 0x1d, 0x56, 0x17, 0xdb, 0x98, 0x80, 0x01, 0x79, 0xc8, 0x42, 0xf0, 0x4c,
+	0x92, 0x17, 0x7f, 0x4c, 0x3b, 0x78, 0xab, 0xef, 0x80, 0x32, 0xa4, 0xb9, 0xb9, 0xb3, 0xbd, 0x55,
+	0xd5, 0xee, 0x61, 0xde, 0x5f, 0x3f, 0x3a, 0xce, 0xcb, 0x31, 0xdd, 0x4d, 0xdb, 0x3a, 0x34, 0x9d,
+	0x36, 0x33, 0xc8, 0x4d, 0xb8, 0x30, 0xa4, 0xad, 0x55, 0xbe, 0x57, 0xd9, 0xdc, 0xc3, 0xc4, 0xe3,
+	0xa3, 0xc5, 0x1b, 0x92, 0xfd, 0x88, 0xd5, 0xdd, 0x20, 0x1f, 0xeb, 0xbf, 0x4f, 0xc0, 0xc4, 0x3d,
+	0xde, 0x20, 0x1f, 0x40, 0x2a, 0x32, 0x88, 0xc8, 0x50, 0xe5, 0x0f, 0x8e, 0x2e, 0x65, 0xe5, 0x85,
+	0x7c, 0xaf, 0x8c, 0xd5, 0x8b, 0x8f, 0xff, 0xfa, 0x8f, 0x8f, 0xc6, 0xf3, 0x6a, 0xb6, 0x38, 0xf4,
+	0xf3, 0x70, 0x74, 0x9b, 0x20, 0x8f, 0x25, 0x98, 0x1b, 0x98, 0x83, 0x24, 0x1f, 0x37, 0x1f, 0x9f,
+	0x9d, 0xca, 0x1b, 0x67, 0x48, 0xf8, 0x2e, 0xac, 0xa2, 0x0b, 0xaa, 0x9a, 0x1f, 0xe1, 0xc2, 0xe0,
+	0x95, 0x47, 0x12, 0xcc, 0xc7, 0xf6, 0x35, 0xa2, 0xbe, 0x20, 0x4a, 0x7f, 0xcd, 0x53, 0x2e, 0x9d,
+	0x29, 0xe3, 0xbb, 0xb2, 0x86, 0xae, 0x7c, 0x43, 0x55, 0x5f, 0x9c, 0x0d, 0xbc, 0xf8, 0x17, 0x12,
+	0x64, 0xe2, 0xe8, 0x40, 0x86, 0x72, 0x3e, 0x02, 0xfd, 0x94, 0xd5, 0xb3, 0x85, 0x7c, 0x7f, 0xde,
+	0x44, 0x7f, 0xde, 0x50, 0x57, 0x46, 0xf8, 0x13, 0x57, 0x52, 0x26, 0x7f, 0xfa, 0xfc, 0xc9, 0x9a,
+	0xb4, 0x71, 0xeb, 0xd3, 0x93, 0xac, 0xf4, 0xd9, 0x49, 0x56, 0xfa, 0xfb, 0x49, 0x56, 0xfa, 0xe5,
+	0xb3, 0xec, 0xd8, 0x67, 0xcf, 0xb2, 0x63, 0x5f, 0x3c, 0xcb, 0x8e, 0xbd, 0x7f, 0x35, 0xf2, 0xad,
+	0xab, 0xe2, 0xd9, 0xdb, 0x66, 0xee, 0x03, 0xdb, 0xb9, 0x1f, 0x9a, 0x7f, 0x88, 0x17, 0xe0, 0x17,
+	0xb0, 0x83, 0x29, 0xfc, 0x4d, 0xfe, 0xad, 0xff, 0x04, 0x00, 0x00, 0xff, 0xff, 0xf1, 0x7c, 0x72,
+	0x2b, 0x39, 0x18, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1796,6 +2182,40 @@ func (m *TaskInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Status != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa0
+	}
+	if len(m.SignerBitmap) > 0 {
+		i -= len(m.SignerBitmap)
+		copy(dAtA[i:], m.SignerBitmap)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.SignerBitmap)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x9a
+	}
+	if len(m.AggregateSignature) > 0 {
+		i -= len(m.AggregateSignature)
+		copy(dAtA[i:], m.AggregateSignature)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.AggregateSignature)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x92
+	}
+	if len(m.AggregateResponseHash) > 0 {
+		i -= len(m.AggregateResponseHash)
+		copy(dAtA[i:], m.AggregateResponseHash)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.AggregateResponseHash)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x8a
+	}
 	if m.OperatorActivePower != nil {
 		{
 			size, err := m.OperatorActivePower.MarshalToSizedBuffer(dAtA[:i])
@@ -1992,7 +2412,7 @@ func (m *OperatorActivePowerInfo) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func (m *BlsPubKeyInfo) Marshal() (dAtA []byte, err error) {
+func (m *TaskStatisticsRetry) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2002,41 +2422,61 @@ func (m *BlsPubKeyInfo) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *BlsPubKeyInfo) MarshalTo(dAtA []byte) (int, error) {
+func (m *TaskStatisticsRetry) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *BlsPubKeyInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *TaskStatisticsRetry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.PubKey) > 0 {
-		i -= len(m.PubKey)
-		copy(dAtA[i:], m.PubKey)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.PubKey)))
+	if len(m.LastError) > 0 {
+		i -= len(m.LastError)
+		copy(dAtA[i:], m.LastError)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.LastError)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.Attempts != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Attempts))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.LastAttemptEpoch != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.LastAttemptEpoch))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.FailedEpoch != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.FailedEpoch))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.EpochIdentifier) > 0 {
+		i -= len(m.EpochIdentifier)
+		copy(dAtA[i:], m.EpochIdentifier)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.EpochIdentifier)))
 		i--
 		dAtA[i] = 0x1a
 	}
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Name)))
+	if m.TaskId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.TaskId))
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x10
 	}
-	if len(m.Operator) > 0 {
-		i -= len(m.Operator)
-		copy(dAtA[i:], m.Operator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Operator)))
+	if len(m.TaskContractAddress) > 0 {
+		i -= len(m.TaskContractAddress)
+		copy(dAtA[i:], m.TaskContractAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.TaskContractAddress)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *RegisterAVSTaskReq) Marshal() (dAtA []byte, err error) {
+func (m *BlsPubKeyInfo) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2046,21 +2486,65 @@ func (m *RegisterAVSTaskReq) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *RegisterAVSTaskReq) MarshalTo(dAtA []byte) (int, error) {
+func (m *BlsPubKeyInfo) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RegisterAVSTaskReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *BlsPubKeyInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Task != nil {
-		{
-			size, err := m.Task.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
+	if len(m.PubKey) > 0 {
+		i -= len(m.PubKey)
+		copy(dAtA[i:], m.PubKey)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.PubKey)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Operator) > 0 {
+		i -= len(m.Operator)
+		copy(dAtA[i:], m.Operator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Operator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RegisterAVSTaskReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RegisterAVSTaskReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RegisterAVSTaskReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Task != nil {
+		{
+			size, err := m.Task.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
 			}
 			i -= size
 			i = encodeVarintTx(dAtA, i, uint64(size))
@@ -2098,6 +2582,11 @@ func (m *RegisterAVSTaskResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	_ = i
 	var l int
 	_ = l
+	if m.TaskId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.TaskId))
+		i--
+		dAtA[i] = 0x8
+	}
 	return len(dAtA) - i, nil
 }
 
@@ -2337,6 +2826,77 @@ func (m *TaskResultInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ChallengeRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ChallengeRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ChallengeRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.SlashID) > 0 {
+		i -= len(m.SlashID)
+		copy(dAtA[i:], m.SlashID)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.SlashID)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.ResolvedHeight != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ResolvedHeight))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.Status != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.RaisedHeight != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.RaisedHeight))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.TaskId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.TaskId))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.TaskContractAddress) > 0 {
+		i -= len(m.TaskContractAddress)
+		copy(dAtA[i:], m.TaskContractAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.TaskContractAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.OperatorAddress) > 0 {
+		i -= len(m.OperatorAddress)
+		copy(dAtA[i:], m.OperatorAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.OperatorAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Challenger) > 0 {
+		i -= len(m.Challenger)
+		copy(dAtA[i:], m.Challenger)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Challenger)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *SubmitTaskResultReq) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -2613,6 +3173,21 @@ func (m *TaskInfo) Size() (n int) {
 		l = m.OperatorActivePower.Size()
 		n += 2 + l + sovTx(uint64(l))
 	}
+	l = len(m.AggregateResponseHash)
+	if l > 0 {
+		n += 2 + l + sovTx(uint64(l))
+	}
+	l = len(m.AggregateSignature)
+	if l > 0 {
+		n += 2 + l + sovTx(uint64(l))
+	}
+	l = len(m.SignerBitmap)
+	if l > 0 {
+		n += 2 + l + sovTx(uint64(l))
+	}
+	if m.Status != 0 {
+		n += 2 + sovTx(uint64(m.Status))
+	}
 	return n
 }
 
@@ -2646,6 +3221,39 @@ func (m *OperatorActivePowerInfo) Size() (n int) {
 	return n
 }
 
+func (m *TaskStatisticsRetry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TaskContractAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.TaskId != 0 {
+		n += 1 + sovTx(uint64(m.TaskId))
+	}
+	l = len(m.EpochIdentifier)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.FailedEpoch != 0 {
+		n += 1 + sovTx(uint64(m.FailedEpoch))
+	}
+	if m.LastAttemptEpoch != 0 {
+		n += 1 + sovTx(uint64(m.LastAttemptEpoch))
+	}
+	if m.Attempts != 0 {
+		n += 1 + sovTx(uint64(m.Attempts))
+	}
+	l = len(m.LastError)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
 func (m *BlsPubKeyInfo) Size() (n int) {
 	if m == nil {
 		return 0
@@ -2690,6 +3298,9 @@ func (m *RegisterAVSTaskResponse) Size() (n int) {
 	}
 	var l int
 	_ = l
+	if m.TaskId != 0 {
+		n += 1 + sovTx(uint64(m.TaskId))
+	}
 	return n
 }
 
@@ -2796,6 +3407,43 @@ func (m *TaskResultInfo) Size() (n int) {
 	return n
 }
 
+func (m *ChallengeRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Challenger)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.OperatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.TaskContractAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.TaskId != 0 {
+		n += 1 + sovTx(uint64(m.TaskId))
+	}
+	if m.RaisedHeight != 0 {
+		n += 1 + sovTx(uint64(m.RaisedHeight))
+	}
+	if m.Status != 0 {
+		n += 1 + sovTx(uint64(m.Status))
+	}
+	if m.ResolvedHeight != 0 {
+		n += 1 + sovTx(uint64(m.ResolvedHeight))
+	}
+	l = len(m.SlashID)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
 func (m *SubmitTaskResultReq) Size() (n int) {
 	if m == nil {
 		return 0
@@ -4279,61 +4927,43 @@ func (m *TaskInfo) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTx(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AggregateResponseHash", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthTx
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *OperatorActivePowerList) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: OperatorActivePowerList: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: OperatorActivePowerList: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.AggregateResponseHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 18:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OperatorPowerList", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AggregateSignature", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -4343,28 +4973,165 @@ func (m *OperatorActivePowerList) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OperatorPowerList = append(m.OperatorPowerList, &OperatorActivePowerInfo{})
-			if err := m.OperatorPowerList[len(m.OperatorPowerList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.AggregateSignature = append(m.AggregateSignature[:0], dAtA[iNdEx:postIndex]...)
+			if m.AggregateSignature == nil {
+				m.AggregateSignature = []byte{}
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignerBitmap", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SignerBitmap = append(m.SignerBitmap[:0], dAtA[iNdEx:postIndex]...)
+			if m.SignerBitmap == nil {
+				m.SignerBitmap = []byte{}
+			}
+			iNdEx = postIndex
+		case 20:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= TaskStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OperatorActivePowerList) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OperatorActivePowerList: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OperatorActivePowerList: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OperatorPowerList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OperatorPowerList = append(m.OperatorPowerList, &OperatorActivePowerInfo{})
+			if err := m.OperatorPowerList[len(m.OperatorPowerList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
 			if err != nil {
 				return err
@@ -4500,6 +5267,228 @@ func (m *OperatorActivePowerInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *TaskStatisticsRetry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TaskStatisticsRetry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TaskStatisticsRetry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TaskContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskId", wireType)
+			}
+			m.TaskId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TaskId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochIdentifier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EpochIdentifier = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FailedEpoch", wireType)
+			}
+			m.FailedEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FailedEpoch |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastAttemptEpoch", wireType)
+			}
+			m.LastAttemptEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastAttemptEpoch |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attempts", wireType)
+			}
+			m.Attempts = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Attempts |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastError", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LastError = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *BlsPubKeyInfo) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -4785,16 +5774,35 @@ func (m *RegisterAVSTaskResponse) Unmarshal(dAtA []byte) error {
 			if b < 0x80 {
 				break
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: RegisterAVSTaskResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RegisterAVSTaskResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RegisterAVSTaskResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RegisterAVSTaskResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskId", wireType)
+			}
+			m.TaskId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TaskId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -5540,6 +6548,260 @@ func (m *TaskResultInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ChallengeRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ChallengeRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ChallengeRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Challenger", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Challenger = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OperatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OperatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TaskContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskId", wireType)
+			}
+			m.TaskId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TaskId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RaisedHeight", wireType)
+			}
+			m.RaisedHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RaisedHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= ChallengeStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResolvedHeight", wireType)
+			}
+			m.ResolvedHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ResolvedHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SlashID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *SubmitTaskResultReq) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -82,6 +82,17 @@ type ChallengeParams struct {
 	CallerAddress       string         `json:"caller_address"`
 }
 
+type ResolveChallengeParams struct {
+	TaskContractAddress common.Address `json:"task_contract_address"`
+	TaskID              uint64         `json:"task_id"`
+	OperatorAddress     sdk.AccAddress `json:"operator_address"`
+	// Confirmed indicates whether the challenge is upheld (true) or rejected (false).
+	Confirmed bool `json:"confirmed"`
+	// CallerAddress is the bech32 address of the adjudicator, which must be either the
+	// slash contract of the AVS or one of its owners.
+	CallerAddress string `json:"caller_address"`
+}
+
 type TaskResultParams struct {
 	OperatorAddress     string         `json:"operator_address"`
 	TaskResponseHash    string         `json:"task_response_hash"`
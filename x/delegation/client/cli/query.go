@@ -31,6 +31,7 @@ func GetQueryCmd() *cobra.Command {
 		QueryUndelegations(),
 		QueryUndelegationsByHeight(),
 		QueryUndelegationHoldCount(),
+		QueryRedelegations(),
 		QueryAssociatedOperatorByStaker(),
 	)
 	return cmd
@@ -151,6 +152,44 @@ func QueryUndelegations() *cobra.Command {
 	return cmd
 }
 
+// QueryRedelegations queries all redelegations for staker and asset
+func QueryRedelegations() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "QueryRedelegations <stakerID> <assetID>",
+		Short: "Get redelegations",
+		Long:  "Get redelegations which are still slashable for the source operators",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := delegationtype.NewQueryClient(clientCtx)
+			_, _, err = types.ValidateID(args[0], false, false)
+			if err != nil {
+				return err
+			}
+			_, _, err = types.ValidateID(args[1], false, false)
+			if err != nil {
+				return err
+			}
+			req := &delegationtype.RedelegationsReq{
+				StakerID: strings.ToLower(args[0]),
+				AssetID:  strings.ToLower(args[1]),
+			}
+			res, err := queryClient.QueryRedelegations(context.Background(), req)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
 // QueryUndelegationsByHeight queries all undelegations waiting to be completed by height
 func QueryUndelegationsByHeight() *cobra.Command {
 	cmd := &cobra.Command{
@@ -21,6 +21,7 @@ func NewTxCmd() *cobra.Command {
 		// add tx commands
 		CmdDelegate(),
 		CmdUndelegate(),
+		CmdRedelegate(),
 	)
 	return txCmd
 }
@@ -65,6 +65,33 @@ func CmdUndelegate() *cobra.Command {
 	return cmd
 }
 
+func CmdRedelegate() *cobra.Command {
+	cmd := &cobra.Command{
+		// TODO: only support native token for now
+		Use:   "redelegate asset-id src-operator dst-operator amount",
+		Short: "Broadcast a transaction to redelegate amount of native token from the source operator to the destination operator",
+		Args:  cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			assetID, dstOperatorAddrStr, amount, err := parseArgs([]string{args[0], args[2], args[3]})
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRedelegate(assetID, clientCtx.GetFromAddress().String(), args[1], dstOperatorAddrStr, amount)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
 func parseArgs(args []string) (string, string, sdkmath.Int, error) {
 	if len(args) != 3 {
 		return "", "", sdkmath.ZeroInt(), errors.New("3 arguments needed")
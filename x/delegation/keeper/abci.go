@@ -15,6 +15,14 @@ func (k *Keeper) EndBlock(
 	originalCtx sdk.Context, _ abci.RequestEndBlock,
 ) []abci.ValidatorUpdate {
 	logger := k.Logger(originalCtx)
+	// the redelegations are no longer slashable for the source operators
+	redelegations, err := k.GetPendingRedelegationRecords(originalCtx, uint64(originalCtx.BlockHeight()))
+	if err != nil {
+		logger.Error("Error in GetPendingRedelegationRecords during the delegation's EndBlock execution", "error", err)
+	} else {
+		k.completeRedelegations(originalCtx, redelegations)
+	}
+
 	records, err := k.GetPendingUndelegationRecords(
 		originalCtx, uint64(originalCtx.BlockHeight()),
 	)
@@ -23,9 +31,18 @@ func (k *Keeper) EndBlock(
 		logger.Error("Error in GetPendingUndelegationRecords during the delegation's EndBlock execution", "error", err)
 		return []abci.ValidatorUpdate{}
 	}
-	if len(records) == 0 {
-		return []abci.ValidatorUpdate{}
-	}
+	// #nosec G701
+	k.completeUndelegations(originalCtx, records, uint64(originalCtx.BlockHeight())+1)
+	return []abci.ValidatorUpdate{}
+}
+
+// completeUndelegations completes the matured undelegation records. The records on hold, and
+// the ones undelegating a redelegated amount which is still slashable for the source operator,
+// are rescheduled to complete at the rescheduleHeight.
+func (k *Keeper) completeUndelegations(
+	originalCtx sdk.Context, records []*types.UndelegationRecord, rescheduleHeight uint64,
+) {
+	logger := k.Logger(originalCtx)
 	for i := range records {
 		record := records[i] // avoid implicit memory aliasing
 		cc, writeCache := originalCtx.CacheContext()
@@ -35,15 +52,22 @@ func (k *Keeper) EndBlock(
 		recordID := types.GetUndelegationRecordKey(
 			record.BlockNumber, record.LzTxNonce, record.TxHash, record.OperatorAddr,
 		)
-		if k.GetUndelegationHoldCount(cc, recordID) > 0 {
+		redelegated, err := k.hasSlashableRedelegation(cc, record)
+		if err != nil {
+			// retry with the rescheduled record rather than releasing a slashable amount.
+			logger.Error("failed to check the redelegations of the undelegation", "error", err)
+			redelegated = true
+		}
+		if redelegated || k.GetUndelegationHoldCount(cc, recordID) > 0 {
 			// delete from all 3 states
 			if err := k.DeleteUndelegationRecord(cc, record); err != nil {
 				logger.Error("failed to delete undelegation record", "error", err)
 				continue
 			}
 			// add back to all 3 states, with the new block height
-			// #nosec G701
-			record.CompleteBlockNumber = uint64(cc.BlockHeight()) + 1
+			record.CompleteEpochIdentifier = ""
+			record.CompleteEpochNumber = 0
+			record.CompleteBlockNumber = rescheduleHeight
 			if err := k.SetUndelegationRecords(
 				cc, []types.UndelegationRecord{*record},
 			); err != nil {
@@ -125,5 +149,4 @@ func (k *Keeper) EndBlock(
 		// when calling `writeCache`, events are automatically emitted on the parent context
 		writeCache()
 	}
-	return []abci.ValidatorUpdate{}
 }
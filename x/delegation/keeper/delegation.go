@@ -1,9 +1,11 @@
 package keeper
 
 import (
+	"errors"
 	"fmt"
 
 	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
 	assetstype "github.com/ExocoreNetwork/exocore/x/assets/types"
 	delegationtype "github.com/ExocoreNetwork/exocore/x/delegation/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -63,39 +65,8 @@ func (k *Keeper) delegateTo(
 			return err
 		}
 	}
-	// calculate the share from the delegation amount
-	share, err := k.CalculateShare(ctx, params.OperatorAddress, assetID, params.OpAmount)
-	if err != nil {
-		return err
-	}
-
-	deltaOperatorAsset := assetstype.DeltaOperatorSingleAsset{
-		TotalAmount: params.OpAmount,
-		TotalShare:  share,
-	}
-	// Check if the staker belongs to the delegated operator. Increase the operator's share if yes.
-	operator, err := k.GetAssociatedOperator(ctx, stakerID)
-	if err != nil {
-		return err
-	}
-	if operator == params.OperatorAddress.String() {
-		deltaOperatorAsset.OperatorShare = share
-	}
-
-	err = k.assetsKeeper.UpdateOperatorAssetState(ctx, params.OperatorAddress, assetID, deltaOperatorAsset)
-	if err != nil {
-		return err
-	}
-
-	deltaAmount := &delegationtype.DeltaDelegationAmounts{
-		UndelegatableShare: share,
-	}
-	_, err = k.UpdateDelegationState(ctx, stakerID, assetID, params.OperatorAddress.String(), deltaAmount)
-	if err != nil {
-		return err
-	}
-	err = k.AppendStakerForOperator(ctx, params.OperatorAddress.String(), assetID, stakerID)
-	if err != nil {
+	// add the share of the delegation amount to the operator
+	if _, err := k.AddShare(ctx, params.OperatorAddress, stakerID, assetID, params.OpAmount); err != nil {
 		return err
 	}
 
@@ -143,7 +114,18 @@ func (k *Keeper) UndelegateFrom(ctx sdk.Context, params *delegationtype.Delegati
 		Amount:                removeToken,
 		ActualCompletedAmount: removeToken,
 	}
-	r.CompleteBlockNumber = k.operatorKeeper.GetUnbondingExpirationBlockNumber(ctx, params.OperatorAddress, r.BlockNumber)
+	// the undelegation completes after the longest unbonding period of the AVSs the operator
+	// is opted into, or after a fixed number of blocks if it isn't opted into any AVS.
+	epochIdentifier, epochNumber, found, err := k.operatorKeeper.GetUnbondingExpiration(ctx, params.OperatorAddress)
+	if err != nil {
+		return err
+	}
+	if found {
+		r.CompleteEpochIdentifier = epochIdentifier
+		r.CompleteEpochNumber = epochNumber
+	} else {
+		r.CompleteBlockNumber = k.operatorKeeper.GetUnbondingExpirationBlockNumber(ctx, params.OperatorAddress, r.BlockNumber)
+	}
 	err = k.SetUndelegationRecords(ctx, []delegationtype.UndelegationRecord{r})
 	if err != nil {
 		return err
@@ -153,6 +135,201 @@ func (k *Keeper) UndelegateFrom(ctx sdk.Context, params *delegationtype.Delegati
 	return k.Hooks().AfterUndelegationStarted(ctx, params.OperatorAddress, delegationtype.GetUndelegationRecordKey(r.BlockNumber, r.LzTxNonce, r.TxHash, r.OperatorAddr))
 }
 
+// Redelegate moves the share of a staker from the source operator to the destination operator
+// for the same asset, without waiting for the unbonding period. The redelegated amount is
+// delegated to the destination operator immediately, but it remains slashable for the
+// infractions of the source operator until the unbonding period of the source operator ends.
+// This exposure is tracked by a redelegation record, which is deleted once the period ends.
+func (k *Keeper) Redelegate(ctx sdk.Context, params *delegationtype.RedelegationParams) error {
+	if !params.OpAmount.IsPositive() {
+		return delegationtype.ErrAmountIsNotPositive
+	}
+	if params.SrcOperatorAddress.Equals(params.DstOperatorAddress) {
+		return delegationtype.ErrSameSrcAndDstOperator
+	}
+	if !k.operatorKeeper.IsOperator(ctx, params.SrcOperatorAddress) {
+		return errorsmod.Wrap(delegationtype.ErrOperatorNotExist, fmt.Sprintf("input srcOperatorAddr is:%s", params.SrcOperatorAddress))
+	}
+	if !k.operatorKeeper.IsOperator(ctx, params.DstOperatorAddress) {
+		return errorsmod.Wrap(delegationtype.ErrOperatorNotExist, fmt.Sprintf("input dstOperatorAddr is:%s", params.DstOperatorAddress))
+	}
+	if k.slashKeeper.IsOperatorFrozen(ctx, params.DstOperatorAddress) {
+		return delegationtype.ErrOperatorIsFrozen
+	}
+	stakerID, assetID := assetstype.GetStakerIDAndAssetID(params.ClientChainID, params.StakerAddress, params.AssetsAddress)
+	// the amount redelegated to the source operator can't be redelegated again while it is
+	// slashable, since the slashing of the redelegation record only covers its destination.
+	transitive, err := k.hasSlashableRedelegationTo(ctx, stakerID, assetID, params.SrcOperatorAddress.String())
+	if err != nil {
+		return err
+	}
+	if transitive {
+		return errorsmod.Wrap(delegationtype.ErrTransitiveRedelegation, fmt.Sprintf("input srcOperatorAddr is:%s", params.SrcOperatorAddress))
+	}
+
+	// remove the share from the source operator
+	share, err := k.ValidateUndelegationAmount(ctx, params.SrcOperatorAddress, stakerID, assetID, params.OpAmount)
+	if err != nil {
+		return err
+	}
+	removeToken, err := k.RemoveShare(ctx, false, params.SrcOperatorAddress, stakerID, assetID, share)
+	if err != nil {
+		return err
+	}
+	if !removeToken.IsPositive() {
+		return errorsmod.Wrap(delegationtype.ErrAmountIsNotPositive, "the redelegated amount is zero")
+	}
+
+	// add the share of the removed amount to the destination operator
+	if _, err := k.AddShare(ctx, params.DstOperatorAddress, stakerID, assetID, removeToken); err != nil {
+		return err
+	}
+
+	// record the redelegation, which remains slashable for the source operator until the
+	// longest unbonding period of the AVSs the source operator is opted into ends.
+	r := delegationtype.RedelegationRecord{
+		StakerID:        stakerID,
+		AssetID:         assetID,
+		SrcOperatorAddr: params.SrcOperatorAddress.String(),
+		DstOperatorAddr: params.DstOperatorAddress.String(),
+		TxHash:          params.TxHash.String(),
+		LzTxNonce:       params.LzNonce,
+		BlockNumber:     uint64(ctx.BlockHeight()),
+		Amount:          removeToken,
+		RemainingAmount: removeToken,
+	}
+	epochIdentifier, epochNumber, found, err := k.operatorKeeper.GetUnbondingExpiration(ctx, params.SrcOperatorAddress)
+	if err != nil {
+		return err
+	}
+	if found {
+		r.CompleteEpochIdentifier = epochIdentifier
+		r.CompleteEpochNumber = epochNumber
+	} else {
+		r.CompleteBlockNumber = k.operatorKeeper.GetUnbondingExpirationBlockNumber(ctx, params.SrcOperatorAddress, r.BlockNumber)
+	}
+	err = k.SetRedelegationRecords(ctx, []delegationtype.RedelegationRecord{r})
+	if err != nil {
+		return err
+	}
+
+	// call the hooks registered by the other modules
+	k.Hooks().AfterDelegation(ctx, params.DstOperatorAddress)
+	return nil
+}
+
+// SlashRedelegation slashes the amount from the share of the staker in the destination operator
+// of the redelegation, since the redelegation remains slashable for the source operator. If
+// the staker has undelegated the share from the destination operator, the rest is slashed from
+// those undelegations, which don't complete while the redelegation is slashable. It returns
+// the slashed amount.
+func (k *Keeper) SlashRedelegation(
+	ctx sdk.Context, redelegation *delegationtype.RedelegationRecord, slashAmount sdkmath.Int,
+) (sdkmath.Int, error) {
+	if !slashAmount.IsPositive() {
+		return sdkmath.ZeroInt(), nil
+	}
+	slashedAmount, err := k.slashRedelegatedShare(ctx, redelegation, slashAmount)
+	if err != nil {
+		return sdkmath.ZeroInt(), err
+	}
+	remainingAmount := slashAmount.Sub(slashedAmount)
+	if !remainingAmount.IsPositive() {
+		return slashedAmount, nil
+	}
+	slashedFromUndelegations, err := k.slashRedelegatedUndelegations(ctx, redelegation, remainingAmount)
+	if err != nil {
+		return sdkmath.ZeroInt(), err
+	}
+	return slashedAmount.Add(slashedFromUndelegations), nil
+}
+
+// slashRedelegatedShare slashes the amount from the share of the staker in the destination
+// operator of the redelegation. It returns the slashed amount.
+func (k *Keeper) slashRedelegatedShare(
+	ctx sdk.Context, redelegation *delegationtype.RedelegationRecord, slashAmount sdkmath.Int,
+) (sdkmath.Int, error) {
+	dstOperator, err := sdk.AccAddressFromBech32(redelegation.DstOperatorAddr)
+	if err != nil {
+		return sdkmath.ZeroInt(), err
+	}
+	delegationInfo, err := k.GetSingleDelegationInfo(ctx, redelegation.StakerID, redelegation.AssetID, redelegation.DstOperatorAddr)
+	if err != nil {
+		if errors.Is(err, delegationtype.ErrNoKeyInTheStore) {
+			return sdkmath.ZeroInt(), nil
+		}
+		return sdkmath.ZeroInt(), err
+	}
+	if !delegationInfo.UndelegatableShare.IsPositive() {
+		return sdkmath.ZeroInt(), nil
+	}
+	share, err := k.CalculateSlashShare(ctx, dstOperator, redelegation.StakerID, redelegation.AssetID, slashAmount)
+	if err != nil {
+		return sdkmath.ZeroInt(), err
+	}
+	if !share.IsPositive() {
+		return sdkmath.ZeroInt(), nil
+	}
+	return k.RemoveShare(ctx, false, dstOperator, redelegation.StakerID, redelegation.AssetID, share)
+}
+
+// slashRedelegatedUndelegations slashes the amount from the undelegations of the staker from
+// the destination operator of the redelegation, which are submitted after the redelegation.
+// It returns the slashed amount.
+func (k *Keeper) slashRedelegatedUndelegations(
+	ctx sdk.Context, redelegation *delegationtype.RedelegationRecord, slashAmount sdkmath.Int,
+) (sdkmath.Int, error) {
+	slashedAmount := sdkmath.ZeroInt()
+	opFunc := func(_ string, undelegation *delegationtype.UndelegationRecord) (bool, error) {
+		if undelegation.OperatorAddr != redelegation.DstOperatorAddr ||
+			undelegation.BlockNumber < redelegation.BlockNumber {
+			return false, nil
+		}
+		amount := sdkmath.MinInt(slashAmount.Sub(slashedAmount), undelegation.ActualCompletedAmount)
+		undelegation.ActualCompletedAmount = undelegation.ActualCompletedAmount.Sub(amount)
+		slashedAmount = slashedAmount.Add(amount)
+		return slashedAmount.GTE(slashAmount), nil
+	}
+	err := k.IterateUndelegationsByStakerAndAsset(ctx, redelegation.StakerID, redelegation.AssetID, true, opFunc)
+	if err != nil {
+		return sdkmath.ZeroInt(), err
+	}
+	return slashedAmount, nil
+}
+
+// hasSlashableRedelegation returns true if the amount undelegated by the record may come from a
+// redelegation which is still slashable for its source operator. Such an undelegation can't
+// complete until the redelegation is no longer slashable.
+func (k *Keeper) hasSlashableRedelegation(ctx sdk.Context, undelegation *delegationtype.UndelegationRecord) (bool, error) {
+	redelegations, err := k.GetStakerRedelegationRecords(ctx, undelegation.StakerID, undelegation.AssetID)
+	if err != nil {
+		return false, err
+	}
+	for _, redelegation := range redelegations {
+		if redelegation.DstOperatorAddr == undelegation.OperatorAddr &&
+			redelegation.BlockNumber <= undelegation.BlockNumber &&
+			redelegation.RemainingAmount.IsPositive() {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// hasSlashableRedelegationTo returns true if the staker has redelegated the asset to the
+// operator, and the redelegation is still slashable for its source operator.
+func (k *Keeper) hasSlashableRedelegationTo(ctx sdk.Context, stakerID, assetID, operatorAddr string) (bool, error) {
+	redelegations, err := k.GetStakerRedelegationRecords(ctx, stakerID, assetID)
+	if err != nil {
+		return false, err
+	}
+	for _, redelegation := range redelegations {
+		if redelegation.DstOperatorAddr == operatorAddr && redelegation.RemainingAmount.IsPositive() {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
 // AssociateOperatorWithStaker marks that a staker is claiming to be associated with an operator.
 // In other words, the staker's delegations will be marked as self-delegations for the operator.
 // Each stakerID can associate, at most, to one operator. To change that operator, the staker must
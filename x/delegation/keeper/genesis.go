@@ -45,6 +45,10 @@ func (k Keeper) InitGenesis(
 	if err != nil {
 		panic(errorsmod.Wrap(err, "failed to set all undelegation records"))
 	}
+	err = k.SetRedelegationRecords(ctx, gs.Redelegations)
+	if err != nil {
+		panic(errorsmod.Wrap(err, "failed to set all redelegation records"))
+	}
 	return []abci.ValidatorUpdate{}
 }
 
@@ -70,5 +74,10 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *delegationtype.GenesisState {
 	if err != nil {
 		panic(errorsmod.Wrap(err, "failed to get all undelegations").Error())
 	}
+
+	res.Redelegations, err = k.AllRedelegations(ctx)
+	if err != nil {
+		panic(errorsmod.Wrap(err, "failed to get all redelegations").Error())
+	}
 	return &res
 }
@@ -41,6 +41,17 @@ func (k *Keeper) QueryUndelegationsByHeight(ctx context.Context, req *delegation
 	}, nil
 }
 
+func (k *Keeper) QueryRedelegations(ctx context.Context, req *delegationtype.RedelegationsReq) (*delegationtype.RedelegationRecordList, error) {
+	c := sdk.UnwrapSDKContext(ctx)
+	redelegations, err := k.GetStakerRedelegationRecords(c, req.StakerID, req.AssetID)
+	if err != nil {
+		return nil, err
+	}
+	return &delegationtype.RedelegationRecordList{
+		Redelegations: redelegations,
+	}, nil
+}
+
 func (k Keeper) QueryUndelegationHoldCount(ctx context.Context, req *delegationtype.UndelegationHoldCountReq) (*delegationtype.UndelegationHoldCountResponse, error) {
 	c := sdk.UnwrapSDKContext(ctx)
 	res := k.GetUndelegationHoldCount(c, []byte(req.RecordKey))
@@ -0,0 +1,56 @@
+package keeper
+
+import (
+	epochstypes "github.com/ExocoreNetwork/exocore/x/epochs/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// EpochsHooksWrapper is the wrapper structure that implements the epochs hooks for the delegation
+// keeper.
+type EpochsHooksWrapper struct {
+	keeper *Keeper
+}
+
+// Interface guard
+var _ epochstypes.EpochHooks = EpochsHooksWrapper{}
+
+// EpochsHooks returns the epochs hooks wrapper. It follows the "accept interfaces, return
+// concretes" pattern.
+func (k *Keeper) EpochsHooks() EpochsHooksWrapper {
+	return EpochsHooksWrapper{k}
+}
+
+// AfterEpochEnd is called after an epoch ends. It is called during the BeginBlock function.
+// It completes the undelegations which mature at the end of the epoch, and the records on hold
+// are rescheduled to the EndBlock of the current block. The redelegations which are no longer
+// slashable for the source operators are deleted as well.
+func (wrapper EpochsHooksWrapper) AfterEpochEnd(
+	ctx sdk.Context, epochIdentifier string, epochNumber int64,
+) {
+	redelegations, err := wrapper.keeper.GetPendingRedelegationRecordsByEpoch(ctx, epochIdentifier, epochNumber)
+	if err != nil {
+		wrapper.keeper.Logger(ctx).Error(
+			"failed to get the pending redelegation records by epoch",
+			"epochIdentifier", epochIdentifier, "epochNumber", epochNumber, "error", err,
+		)
+	} else {
+		wrapper.keeper.completeRedelegations(ctx, redelegations)
+	}
+
+	records, err := wrapper.keeper.GetPendingUndelegationRecordsByEpoch(ctx, epochIdentifier, epochNumber)
+	if err != nil {
+		wrapper.keeper.Logger(ctx).Error(
+			"failed to get the pending undelegation records by epoch",
+			"epochIdentifier", epochIdentifier, "epochNumber", epochNumber, "error", err,
+		)
+		return
+	}
+	// #nosec G701
+	wrapper.keeper.completeUndelegations(ctx, records, uint64(ctx.BlockHeight()))
+}
+
+// BeforeEpochStart is called before an epoch starts.
+func (wrapper EpochsHooksWrapper) BeforeEpochStart(
+	sdk.Context, string, int64,
+) {
+}
@@ -93,6 +93,44 @@ func (k *Keeper) UndelegateAssetFromOperator(
 	return &types.UndelegationResponse{}, nil
 }
 
+// RedelegateAsset redelegates asset from one operator to another. Currently, it only supports
+// native token.
+func (k *Keeper) RedelegateAsset(
+	goCtx context.Context, msg *types.MsgRedelegate,
+) (*types.RedelegationResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	logger := k.Logger(ctx)
+	logger.Info("RedelegateAsset", "msg", msg)
+	// can use `Must` since pre-validated
+	fromAddr := sdk.MustAccAddressFromBech32(msg.FromAddress)
+	// no need to check that `assetID` is native token, since that is done by ValidateBasic.
+	// create nonce and unique hash
+	nonce, err := k.accountKeeper.GetSequence(ctx, fromAddr)
+	if err != nil {
+		logger.Error("failed to get nonce", "error", err)
+		return nil, err
+	}
+	txBytes := ctx.TxBytes()
+	txHash := sha256.Sum256(txBytes)
+	combined := fmt.Sprintf("%s-%d", txHash, nonce)
+	uniqueHash := sha256.Sum256([]byte(combined))
+
+	params := &types.RedelegationParams{
+		ClientChainID:      assetstypes.ExocoreChainLzID,
+		AssetsAddress:      common.HexToAddress(assetstypes.ExocoreAssetAddr).Bytes(),
+		SrcOperatorAddress: sdk.MustAccAddressFromBech32(msg.SrcOperator),
+		DstOperatorAddress: sdk.MustAccAddressFromBech32(msg.DstOperator),
+		StakerAddress:      fromAddr.Bytes(),
+		OpAmount:           msg.Amount,
+		LzNonce:            nonce,
+		TxHash:             uniqueHash,
+	}
+	if err := k.Redelegate(ctx, params); err != nil {
+		return nil, err
+	}
+	return &types.RedelegationResponse{}, nil
+}
+
 // newDelegationParams creates delegation params from the given base info.
 func newDelegationParams(
 	baseInfo *types.DelegationIncOrDecInfo,
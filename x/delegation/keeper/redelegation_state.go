@@ -0,0 +1,192 @@
+package keeper
+
+import (
+	"fmt"
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+
+	"github.com/ExocoreNetwork/exocore/x/delegation/types"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// AllRedelegations function returns all the redelegation records in the module.
+// It is used during `ExportGenesis` to export the redelegation records.
+func (k Keeper) AllRedelegations(ctx sdk.Context) (redelegations []types.RedelegationRecord, err error) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixRedelegationInfo)
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+	defer iterator.Close()
+
+	ret := make([]types.RedelegationRecord, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		var redelegation types.RedelegationRecord
+		k.cdc.MustUnmarshal(iterator.Value(), &redelegation)
+		ret = append(ret, redelegation)
+	}
+	return ret, nil
+}
+
+// SetRedelegationRecords stores the provided redelegation records.
+// The records are stored with 3 different keys:
+// (1) recordKey == srcOperatorAddress + blockNumber + lzNonce + txHash => record
+// (2) stakerID + assetID + lzNonce => recordKey
+// (3) completeBlockNumber + recordKey => recordKey, or
+// completeEpochIdentifier + completeEpochNumber + recordKey => recordKey if the slashing
+// exposure ends at the end of an epoch
+func (k *Keeper) SetRedelegationRecords(ctx sdk.Context, records []types.RedelegationRecord) error {
+	singleRecordStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixRedelegationInfo)
+	stakerRedelegationStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixStakerRedelegationInfo)
+	pendingRedelegationStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixPendingRedelegations)
+	pendingRedelegationByEpochStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixPendingRedelegationsByEpoch)
+	currentHeight := ctx.BlockHeight()
+	for i := range records {
+		record := records[i]
+		// #nosec G115
+		if record.CompleteEpochIdentifier == "" && record.CompleteBlockNumber < uint64(currentHeight) {
+			return errorsmod.Wrapf(types.ErrInvalidCompletedHeight, "currentHeight:%d,CompleteBlockNumber:%d", currentHeight, record.CompleteBlockNumber)
+		}
+		bz := k.cdc.MustMarshal(&record)
+		singleRecKey := types.GetRedelegationRecordKey(record.BlockNumber, record.LzTxNonce, record.TxHash, record.SrcOperatorAddr)
+		singleRecordStore.Set(singleRecKey, bz)
+
+		stakerKey := types.GetStakerUndelegationRecordKey(record.StakerID, record.AssetID, record.LzTxNonce)
+		stakerRedelegationStore.Set(stakerKey, singleRecKey)
+
+		if record.CompleteEpochIdentifier != "" {
+			pendingKey := types.GetPendingRedelegationRecordKeyByEpoch(record.CompleteEpochIdentifier, record.CompleteEpochNumber, singleRecKey)
+			pendingRedelegationByEpochStore.Set(pendingKey, singleRecKey)
+			continue
+		}
+		pendingKey := types.GetPendingRedelegationRecordKey(record.CompleteBlockNumber, singleRecKey)
+		pendingRedelegationStore.Set(pendingKey, singleRecKey)
+	}
+	return nil
+}
+
+// DeleteRedelegationRecord deletes the redelegation record from the module.
+// The deletion is performed from all the 3 stores.
+func (k *Keeper) DeleteRedelegationRecord(ctx sdk.Context, record *types.RedelegationRecord) error {
+	singleRecordStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixRedelegationInfo)
+	stakerRedelegationStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixStakerRedelegationInfo)
+
+	singleRecKey := types.GetRedelegationRecordKey(record.BlockNumber, record.LzTxNonce, record.TxHash, record.SrcOperatorAddr)
+	singleRecordStore.Delete(singleRecKey)
+
+	stakerKey := types.GetStakerUndelegationRecordKey(record.StakerID, record.AssetID, record.LzTxNonce)
+	stakerRedelegationStore.Delete(stakerKey)
+
+	if record.CompleteEpochIdentifier != "" {
+		pendingRedelegationByEpochStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixPendingRedelegationsByEpoch)
+		pendingRedelegationByEpochStore.Delete(
+			types.GetPendingRedelegationRecordKeyByEpoch(record.CompleteEpochIdentifier, record.CompleteEpochNumber, singleRecKey),
+		)
+		return nil
+	}
+	pendingRedelegationStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixPendingRedelegations)
+	pendingRedelegationStore.Delete(types.GetPendingRedelegationRecordKey(record.CompleteBlockNumber, singleRecKey))
+	return nil
+}
+
+// GetRedelegationRecords returns the redelegation records for the provided record keys.
+func (k *Keeper) GetRedelegationRecords(ctx sdk.Context, singleRecordKeys []string) (records []*types.RedelegationRecord, err error) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixRedelegationInfo)
+	ret := make([]*types.RedelegationRecord, 0)
+	for _, singleRecordKey := range singleRecordKeys {
+		value := store.Get([]byte(singleRecordKey))
+		if value == nil {
+			return nil, errorsmod.Wrap(types.ErrNoKeyInTheStore, fmt.Sprintf("redelegation record key doesn't exist: key is %s", singleRecordKey))
+		}
+		redelegationRecord := types.RedelegationRecord{}
+		k.cdc.MustUnmarshal(value, &redelegationRecord)
+		ret = append(ret, &redelegationRecord)
+	}
+	return ret, nil
+}
+
+// IterateRedelegationsBySrcOperator iterates over the redelegation records from the provided
+// source operator. If the filter is non-nil, it will only iterate over the records for which
+// the block height is greater than or equal to the filter.
+func (k *Keeper) IterateRedelegationsBySrcOperator(
+	ctx sdk.Context, operator string, heightFilter *uint64, isUpdate bool,
+	opFunc func(redelegation *types.RedelegationRecord) error,
+) error {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixRedelegationInfo)
+	iterator := sdk.KVStorePrefixIterator(store, []byte(operator))
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		if heightFilter != nil {
+			keyFields, err := types.ParseUndelegationRecordKey(iterator.Key())
+			if err != nil {
+				return err
+			}
+			if keyFields.BlockHeight < *heightFilter {
+				continue
+			}
+		}
+		redelegation := types.RedelegationRecord{}
+		k.cdc.MustUnmarshal(iterator.Value(), &redelegation)
+		err := opFunc(&redelegation)
+		if err != nil {
+			return err
+		}
+
+		if isUpdate {
+			bz := k.cdc.MustMarshal(&redelegation)
+			store.Set(iterator.Key(), bz)
+		}
+	}
+	return nil
+}
+
+// GetStakerRedelegationRecords returns the redelegation records for the provided staker and asset.
+func (k *Keeper) GetStakerRedelegationRecords(ctx sdk.Context, stakerID, assetID string) (records []*types.RedelegationRecord, err error) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixStakerRedelegationInfo)
+	iterator := sdk.KVStorePrefixIterator(store, []byte(strings.Join([]string{stakerID, assetID}, "/")))
+	defer iterator.Close()
+
+	recordKeys := make([]string, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		recordKeys = append(recordKeys, string(iterator.Value()))
+	}
+	return k.GetRedelegationRecords(ctx, recordKeys)
+}
+
+// GetPendingRedelegationRecords returns the redelegation records whose slashing exposure to the
+// source operator ends at the end of the block with the provided height.
+func (k *Keeper) GetPendingRedelegationRecords(ctx sdk.Context, height uint64) (records []*types.RedelegationRecord, err error) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixPendingRedelegations)
+	iterator := sdk.KVStorePrefixIterator(store, types.IteratorPrefixForPendingRedelegations(height))
+	defer iterator.Close()
+
+	recordKeys := make([]string, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		recordKeys = append(recordKeys, string(iterator.Value()))
+	}
+	return k.GetRedelegationRecords(ctx, recordKeys)
+}
+
+// GetPendingRedelegationRecordsByEpoch returns the redelegation records whose slashing exposure
+// to the source operator ends at the end of the epoch.
+func (k *Keeper) GetPendingRedelegationRecordsByEpoch(ctx sdk.Context, epochIdentifier string, epochNumber int64) (records []*types.RedelegationRecord, err error) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixPendingRedelegationsByEpoch)
+	iterator := sdk.KVStorePrefixIterator(store, types.IteratorPrefixForPendingUndelegationsByEpoch(epochIdentifier, epochNumber))
+	defer iterator.Close()
+
+	recordKeys := make([]string, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		recordKeys = append(recordKeys, string(iterator.Value()))
+	}
+	return k.GetRedelegationRecords(ctx, recordKeys)
+}
+
+// completeRedelegations deletes the redelegation records whose slashing exposure to the source
+// operator has ended.
+func (k *Keeper) completeRedelegations(ctx sdk.Context, records []*types.RedelegationRecord) {
+	for i := range records {
+		if err := k.DeleteRedelegationRecord(ctx, records[i]); err != nil {
+			k.Logger(ctx).Error("failed to delete redelegation record", "error", err)
+		}
+	}
+}
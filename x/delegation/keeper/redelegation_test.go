@@ -0,0 +1,253 @@
+package keeper_test
+
+import (
+	sdkmath "cosmossdk.io/math"
+	"github.com/ExocoreNetwork/exocore/x/assets/types"
+	delegationtype "github.com/ExocoreNetwork/exocore/x/delegation/types"
+	operatortype "github.com/ExocoreNetwork/exocore/x/operator/types"
+	abci "github.com/cometbft/cometbft/abci/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+func (suite *DelegationTestSuite) TestRedelegate() {
+	suite.basicPrepare()
+	suite.prepareDeposit(suite.depositAmount)
+	delegationEvent := suite.prepareDelegation(suite.delegationAmount, suite.opAccAddr)
+
+	dstOperator, err := sdk.AccAddressFromBech32("exo18cggcpvwspnd5c6ny8wrqxpffj5zmhklprtnph")
+	suite.NoError(err)
+	_, err = s.OperatorMsgServer.RegisterOperator(s.Ctx, &operatortype.RegisterOperatorReq{
+		FromAddress: dstOperator.String(),
+		Info: &operatortype.OperatorInfo{
+			EarningsAddr: dstOperator.String(),
+		},
+	})
+	suite.NoError(err)
+
+	redelegateAmount := sdkmath.NewInt(20)
+	params := &delegationtype.RedelegationParams{
+		ClientChainID:      delegationEvent.ClientChainID,
+		AssetsAddress:      delegationEvent.AssetsAddress,
+		SrcOperatorAddress: suite.opAccAddr,
+		DstOperatorAddress: suite.opAccAddr,
+		StakerAddress:      delegationEvent.StakerAddress,
+		OpAmount:           redelegateAmount,
+		LzNonce:            1,
+		TxHash:             common.HexToHash("0x7d2e8c5a3b4f1e6d9c0a2b3f4e5d6c7b8a9f0e1d2c3b4a5f6e7d8c9b0a1f2e3d"),
+	}
+	// the source and destination operators should be different
+	err = suite.App.DelegationKeeper.Redelegate(suite.Ctx, params)
+	suite.ErrorIs(err, delegationtype.ErrSameSrcAndDstOperator)
+
+	// the redelegated amount can't exceed the delegated amount
+	params.DstOperatorAddress = dstOperator
+	params.OpAmount = suite.delegationAmount.Add(sdkmath.NewInt(1))
+	err = suite.App.DelegationKeeper.Redelegate(suite.Ctx, params)
+	suite.ErrorIs(err, delegationtype.ErrInsufficientShares)
+
+	params.OpAmount = redelegateAmount
+	err = suite.App.DelegationKeeper.Redelegate(suite.Ctx, params)
+	suite.NoError(err)
+
+	// check state, the redelegation doesn't change the staker assets state
+	stakerID, assetID := types.GetStakerIDAndAssetID(params.ClientChainID, params.StakerAddress, params.AssetsAddress)
+	restakerState, err := suite.App.AssetsKeeper.GetStakerSpecifiedAssetInfo(suite.Ctx, stakerID, assetID)
+	suite.NoError(err)
+	suite.Equal(types.StakerAssetInfo{
+		TotalDepositAmount:        suite.depositAmount,
+		WithdrawableAmount:        suite.depositAmount.Sub(suite.delegationAmount),
+		PendingUndelegationAmount: sdkmath.NewInt(0),
+	}, *restakerState)
+
+	srcOperatorState, err := suite.App.AssetsKeeper.GetOperatorSpecifiedAssetInfo(suite.Ctx, suite.opAccAddr, assetID)
+	suite.NoError(err)
+	suite.Equal(suite.delegationAmount.Sub(redelegateAmount), srcOperatorState.TotalAmount)
+	suite.Equal(sdkmath.NewInt(0), srcOperatorState.PendingUndelegationAmount)
+	dstOperatorState, err := suite.App.AssetsKeeper.GetOperatorSpecifiedAssetInfo(suite.Ctx, dstOperator, assetID)
+	suite.NoError(err)
+	suite.Equal(types.OperatorAssetInfo{
+		TotalAmount:               redelegateAmount,
+		PendingUndelegationAmount: sdkmath.NewInt(0),
+		TotalShare:                sdkmath.LegacyNewDecFromInt(redelegateAmount),
+		OperatorShare:             sdkmath.LegacyNewDec(0),
+	}, *dstOperatorState)
+
+	dstDelegation, err := suite.App.DelegationKeeper.GetSingleDelegationInfo(suite.Ctx, stakerID, assetID, dstOperator.String())
+	suite.NoError(err)
+	suite.Equal(sdkmath.LegacyNewDecFromInt(redelegateAmount), dstDelegation.UndelegatableShare)
+	totalDelegationAmount, err := suite.App.DelegationKeeper.TotalDelegatedAmountForStakerAsset(suite.Ctx, stakerID, assetID)
+	suite.NoError(err)
+	suite.Equal(suite.delegationAmount, totalDelegationAmount)
+
+	// the operator isn't opted into any AVS, so the record expires by the block number
+	records, err := suite.App.DelegationKeeper.GetStakerRedelegationRecords(suite.Ctx, stakerID, assetID)
+	suite.NoError(err)
+	suite.Equal(1, len(records))
+	blockNumber := uint64(suite.Ctx.BlockHeight())
+	suite.Equal(&delegationtype.RedelegationRecord{
+		StakerID:            stakerID,
+		AssetID:             assetID,
+		SrcOperatorAddr:     suite.opAccAddr.String(),
+		DstOperatorAddr:     dstOperator.String(),
+		TxHash:              params.TxHash.String(),
+		BlockNumber:         blockNumber,
+		CompleteBlockNumber: blockNumber + delegationtype.CanUndelegationDelayHeight,
+		LzTxNonce:           params.LzNonce,
+		Amount:              redelegateAmount,
+		RemainingAmount:     redelegateAmount,
+	}, records[0])
+
+	// the record is deleted once the slashing exposure ends
+	suite.Ctx = suite.Ctx.WithBlockHeight(int64(records[0].CompleteBlockNumber))
+	suite.App.DelegationKeeper.EndBlock(suite.Ctx, abci.RequestEndBlock{})
+	records, err = suite.App.DelegationKeeper.GetStakerRedelegationRecords(suite.Ctx, stakerID, assetID)
+	suite.NoError(err)
+	suite.Equal(0, len(records))
+}
+
+func (suite *DelegationTestSuite) TestUndelegateRedelegatedAmount() {
+	suite.basicPrepare()
+	suite.prepareDeposit(suite.depositAmount)
+	delegationEvent := suite.prepareDelegation(suite.delegationAmount, suite.opAccAddr)
+
+	dstOperator, err := sdk.AccAddressFromBech32("exo18cggcpvwspnd5c6ny8wrqxpffj5zmhklprtnph")
+	suite.NoError(err)
+	_, err = s.OperatorMsgServer.RegisterOperator(s.Ctx, &operatortype.RegisterOperatorReq{
+		FromAddress: dstOperator.String(),
+		Info: &operatortype.OperatorInfo{
+			EarningsAddr: dstOperator.String(),
+		},
+	})
+	suite.NoError(err)
+	redelegateAmount := sdkmath.NewInt(20)
+	err = suite.App.DelegationKeeper.Redelegate(suite.Ctx, &delegationtype.RedelegationParams{
+		ClientChainID:      delegationEvent.ClientChainID,
+		AssetsAddress:      delegationEvent.AssetsAddress,
+		SrcOperatorAddress: suite.opAccAddr,
+		DstOperatorAddress: dstOperator,
+		StakerAddress:      delegationEvent.StakerAddress,
+		OpAmount:           redelegateAmount,
+		LzNonce:            1,
+		TxHash:             common.HexToHash("0x7d2e8c5a3b4f1e6d9c0a2b3f4e5d6c7b8a9f0e1d2c3b4a5f6e7d8c9b0a1f2e3d"),
+	})
+	suite.NoError(err)
+
+	// the source operator has a longer unbonding period than the destination operator
+	stakerID, assetID := types.GetStakerIDAndAssetID(delegationEvent.ClientChainID, delegationEvent.StakerAddress, delegationEvent.AssetsAddress)
+	redelegations, err := suite.App.DelegationKeeper.GetStakerRedelegationRecords(suite.Ctx, stakerID, assetID)
+	suite.NoError(err)
+	suite.Equal(1, len(redelegations))
+	redelegation := redelegations[0]
+	suite.NoError(suite.App.DelegationKeeper.DeleteRedelegationRecord(suite.Ctx, redelegation))
+	redelegation.CompleteBlockNumber += 2
+	suite.NoError(suite.App.DelegationKeeper.SetRedelegationRecords(suite.Ctx, []delegationtype.RedelegationRecord{*redelegation}))
+
+	// the redelegated amount is undelegated from the destination operator at once
+	err = suite.App.DelegationKeeper.UndelegateFrom(suite.Ctx, &delegationtype.DelegationOrUndelegationParams{
+		ClientChainID:   delegationEvent.ClientChainID,
+		AssetsAddress:   delegationEvent.AssetsAddress,
+		OperatorAddress: dstOperator,
+		StakerAddress:   delegationEvent.StakerAddress,
+		OpAmount:        redelegateAmount,
+		LzNonce:         2,
+		TxHash:          common.HexToHash("0x1d2e8c5a3b4f1e6d9c0a2b3f4e5d6c7b8a9f0e1d2c3b4a5f6e7d8c9b0a1f2e3d"),
+	})
+	suite.NoError(err)
+	undelegations, err := suite.App.DelegationKeeper.GetStakerUndelegationRecords(suite.Ctx, stakerID, assetID)
+	suite.NoError(err)
+	suite.Equal(1, len(undelegations))
+	suite.Less(undelegations[0].CompleteBlockNumber, redelegation.CompleteBlockNumber)
+
+	// the undelegation doesn't complete while the redelegation is slashable
+	suite.Ctx = suite.Ctx.WithBlockHeight(int64(undelegations[0].CompleteBlockNumber))
+	suite.App.DelegationKeeper.EndBlock(suite.Ctx, abci.RequestEndBlock{})
+	undelegations, err = suite.App.DelegationKeeper.GetStakerUndelegationRecords(suite.Ctx, stakerID, assetID)
+	suite.NoError(err)
+	suite.Equal(1, len(undelegations))
+	suite.Equal(uint64(suite.Ctx.BlockHeight())+1, undelegations[0].CompleteBlockNumber)
+
+	// the slash of the redelegation is executed from the undelegation
+	slashAmount := sdkmath.NewInt(5)
+	slashedAmount, err := suite.App.DelegationKeeper.SlashRedelegation(suite.Ctx, redelegation, slashAmount)
+	suite.NoError(err)
+	suite.Equal(slashAmount, slashedAmount)
+	undelegations, err = suite.App.DelegationKeeper.GetStakerUndelegationRecords(suite.Ctx, stakerID, assetID)
+	suite.NoError(err)
+	suite.Equal(redelegateAmount.Sub(slashAmount), undelegations[0].ActualCompletedAmount)
+
+	// the undelegation completes once the redelegation is no longer slashable
+	for height := suite.Ctx.BlockHeight() + 1; height <= int64(redelegation.CompleteBlockNumber); height++ {
+		suite.Ctx = suite.Ctx.WithBlockHeight(height)
+		suite.App.DelegationKeeper.EndBlock(suite.Ctx, abci.RequestEndBlock{})
+	}
+	undelegations, err = suite.App.DelegationKeeper.GetStakerUndelegationRecords(suite.Ctx, stakerID, assetID)
+	suite.NoError(err)
+	suite.Equal(0, len(undelegations))
+	restakerState, err := suite.App.AssetsKeeper.GetStakerSpecifiedAssetInfo(suite.Ctx, stakerID, assetID)
+	suite.NoError(err)
+	suite.Equal(suite.depositAmount.Sub(suite.delegationAmount).Add(redelegateAmount).Sub(slashAmount), restakerState.WithdrawableAmount)
+}
+
+func (suite *DelegationTestSuite) TestTransitiveRedelegation() {
+	suite.basicPrepare()
+	suite.prepareDeposit(suite.depositAmount)
+	delegationEvent := suite.prepareDelegation(suite.delegationAmount, suite.opAccAddr)
+
+	operatorB, err := sdk.AccAddressFromBech32("exo18cggcpvwspnd5c6ny8wrqxpffj5zmhklprtnph")
+	suite.NoError(err)
+	operatorC := sdk.AccAddress(common.HexToAddress("0x4c4a9c1b7e2f3d5a6b8c9d0e1f2a3b4c5d6e7f80").Bytes())
+	for _, operator := range []sdk.AccAddress{operatorB, operatorC} {
+		_, err = s.OperatorMsgServer.RegisterOperator(s.Ctx, &operatortype.RegisterOperatorReq{
+			FromAddress: operator.String(),
+			Info: &operatortype.OperatorInfo{
+				EarningsAddr: operator.String(),
+			},
+		})
+		suite.NoError(err)
+	}
+
+	// A -> B
+	redelegateAmount := sdkmath.NewInt(20)
+	params := &delegationtype.RedelegationParams{
+		ClientChainID:      delegationEvent.ClientChainID,
+		AssetsAddress:      delegationEvent.AssetsAddress,
+		SrcOperatorAddress: suite.opAccAddr,
+		DstOperatorAddress: operatorB,
+		StakerAddress:      delegationEvent.StakerAddress,
+		OpAmount:           redelegateAmount,
+		LzNonce:            1,
+		TxHash:             common.HexToHash("0x7d2e8c5a3b4f1e6d9c0a2b3f4e5d6c7b8a9f0e1d2c3b4a5f6e7d8c9b0a1f2e3d"),
+	}
+	suite.NoError(suite.App.DelegationKeeper.Redelegate(suite.Ctx, params))
+
+	// B -> C is rejected while the amount is slashable for A
+	params.SrcOperatorAddress = operatorB
+	params.DstOperatorAddress = operatorC
+	params.LzNonce = 2
+	params.TxHash = common.HexToHash("0x1d2e8c5a3b4f1e6d9c0a2b3f4e5d6c7b8a9f0e1d2c3b4a5f6e7d8c9b0a1f2e3d")
+	err = suite.App.DelegationKeeper.Redelegate(suite.Ctx, params)
+	suite.ErrorIs(err, delegationtype.ErrTransitiveRedelegation)
+
+	// so the slash of A is executed from the share of the staker in B
+	stakerID, assetID := types.GetStakerIDAndAssetID(delegationEvent.ClientChainID, delegationEvent.StakerAddress, delegationEvent.AssetsAddress)
+	redelegations, err := suite.App.DelegationKeeper.GetStakerRedelegationRecords(suite.Ctx, stakerID, assetID)
+	suite.NoError(err)
+	suite.Equal(1, len(redelegations))
+	slashAmount := sdkmath.NewInt(5)
+	slashedAmount, err := suite.App.DelegationKeeper.SlashRedelegation(suite.Ctx, redelegations[0], slashAmount)
+	suite.NoError(err)
+	suite.Equal(slashAmount, slashedAmount)
+	stateB, err := suite.App.AssetsKeeper.GetOperatorSpecifiedAssetInfo(suite.Ctx, operatorB, assetID)
+	suite.NoError(err)
+	suite.Equal(redelegateAmount.Sub(slashAmount), stateB.TotalAmount)
+
+	// B -> C is allowed once the redelegation from A is no longer slashable
+	suite.Ctx = suite.Ctx.WithBlockHeight(int64(redelegations[0].CompleteBlockNumber))
+	suite.App.DelegationKeeper.EndBlock(suite.Ctx, abci.RequestEndBlock{})
+	params.OpAmount = redelegateAmount.Sub(slashAmount)
+	suite.NoError(suite.App.DelegationKeeper.Redelegate(suite.Ctx, params))
+	stateC, err := suite.App.AssetsKeeper.GetOperatorSpecifiedAssetInfo(suite.Ctx, operatorC, assetID)
+	suite.NoError(err)
+	suite.Equal(redelegateAmount.Sub(slashAmount), stateC.TotalAmount)
+}
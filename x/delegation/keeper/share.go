@@ -199,6 +199,49 @@ func (k Keeper) RemoveShareFromOperator(
 	return removedToken, nil
 }
 
+// AddShare updates all states regarding staker and operator when adding the share of the amount
+// to the operator. It's used for delegation and redelegation, and returns the added share.
+func (k Keeper) AddShare(
+	ctx sdk.Context, operator sdk.AccAddress, stakerID, assetID string, amount sdkmath.Int,
+) (share sdkmath.LegacyDec, err error) {
+	// calculate the share from the amount
+	share, err = k.CalculateShare(ctx, operator, assetID, amount)
+	if err != nil {
+		return share, err
+	}
+
+	deltaOperatorAsset := assetstype.DeltaOperatorSingleAsset{
+		TotalAmount: amount,
+		TotalShare:  share,
+	}
+	// Check if the staker belongs to the delegated operator. Increase the operator's share if yes.
+	associatedOperator, err := k.GetAssociatedOperator(ctx, stakerID)
+	if err != nil {
+		return share, err
+	}
+	if associatedOperator == operator.String() {
+		deltaOperatorAsset.OperatorShare = share
+	}
+
+	err = k.assetsKeeper.UpdateOperatorAssetState(ctx, operator, assetID, deltaOperatorAsset)
+	if err != nil {
+		return share, err
+	}
+
+	deltaAmount := &delegationtypes.DeltaDelegationAmounts{
+		UndelegatableShare: share,
+	}
+	_, err = k.UpdateDelegationState(ctx, stakerID, assetID, operator.String(), deltaAmount)
+	if err != nil {
+		return share, err
+	}
+	err = k.AppendStakerForOperator(ctx, operator.String(), assetID, stakerID)
+	if err != nil {
+		return share, err
+	}
+	return share, nil
+}
+
 // RemoveShare updates all states regarding staker and operator when removing share.
 // It might be used for undelegation, slash and native token. For the native token,
 // it will be considered a slash operation in exocore when the asset amount is reduced
@@ -33,17 +33,20 @@ func (k Keeper) AllUndelegations(ctx sdk.Context) (undelegations []types.Undeleg
 // The records are stored with 3 different keys:
 // (1) recordKey == blockNumber + lzNonce + txHash + operatorAddress => record
 // (2) stakerID + assetID + lzNonce => recordKey
-// (3) completeBlockNumber + lzNonce => recordKey
+// (3) completeBlockNumber + lzNonce => recordKey, or
+// completeEpochIdentifier + completeEpochNumber + lzNonce => recordKey if the record completes
+// at the end of an epoch
 // If a record exists with the same key, it will be overwritten; however, that is not a big
 // concern since the lzNonce and txHash are unique for each record.
 func (k *Keeper) SetUndelegationRecords(ctx sdk.Context, records []types.UndelegationRecord) error {
 	singleRecordStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixUndelegationInfo)
 	stakerUndelegationStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixStakerUndelegationInfo)
 	pendingUndelegationStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixPendingUndelegations)
+	pendingUndelegationByEpochStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixPendingUndelegationsByEpoch)
 	currentHeight := ctx.BlockHeight()
 	for i := range records {
 		record := records[i]
-		if record.CompleteBlockNumber < uint64(currentHeight) {
+		if record.CompleteEpochIdentifier == "" && record.CompleteBlockNumber < uint64(currentHeight) {
 			return errorsmod.Wrapf(types.ErrInvalidCompletedHeight, "currentHeight:%d,CompleteBlockNumber:%d", currentHeight, record.CompleteBlockNumber)
 		}
 		bz := k.cdc.MustMarshal(&record)
@@ -54,6 +57,11 @@ func (k *Keeper) SetUndelegationRecords(ctx sdk.Context, records []types.Undeleg
 		stakerKey := types.GetStakerUndelegationRecordKey(record.StakerID, record.AssetID, record.LzTxNonce)
 		stakerUndelegationStore.Set(stakerKey, singleRecKey)
 
+		if record.CompleteEpochIdentifier != "" {
+			pendingUndelegationKey := types.GetPendingUndelegationRecordKeyByEpoch(record.CompleteEpochIdentifier, record.CompleteEpochNumber, record.LzTxNonce)
+			pendingUndelegationByEpochStore.Set(pendingUndelegationKey, singleRecKey)
+			continue
+		}
 		pendingUndelegationKey := types.GetPendingUndelegationRecordKey(record.CompleteBlockNumber, record.LzTxNonce)
 		pendingUndelegationStore.Set(pendingUndelegationKey, singleRecKey)
 	}
@@ -73,6 +81,12 @@ func (k *Keeper) DeleteUndelegationRecord(ctx sdk.Context, record *types.Undeleg
 	stakerKey := types.GetStakerUndelegationRecordKey(record.StakerID, record.AssetID, record.LzTxNonce)
 	stakerUndelegationStore.Delete(stakerKey)
 
+	if record.CompleteEpochIdentifier != "" {
+		pendingUndelegationByEpochStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixPendingUndelegationsByEpoch)
+		pendingUndelegationKey := types.GetPendingUndelegationRecordKeyByEpoch(record.CompleteEpochIdentifier, record.CompleteEpochNumber, record.LzTxNonce)
+		pendingUndelegationByEpochStore.Delete(pendingUndelegationKey)
+		return nil
+	}
 	pendingUndelegationKey := types.GetPendingUndelegationRecordKey(record.CompleteBlockNumber, record.LzTxNonce)
 	pendingUndelegationStore.Delete(pendingUndelegationKey)
 	return nil
@@ -217,6 +231,20 @@ func (k *Keeper) GetPendingUndelegationRecords(ctx sdk.Context, height uint64) (
 	return k.GetUndelegationRecords(ctx, recordKeys)
 }
 
+// GetPendingUndelegationRecordsByEpoch returns the undelegation records scheduled to mature at
+// the end of the epoch.
+func (k *Keeper) GetPendingUndelegationRecordsByEpoch(ctx sdk.Context, epochIdentifier string, epochNumber int64) (records []*types.UndelegationRecord, err error) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixPendingUndelegationsByEpoch)
+	iterator := sdk.KVStorePrefixIterator(store, types.IteratorPrefixForPendingUndelegationsByEpoch(epochIdentifier, epochNumber))
+	defer iterator.Close()
+
+	recordKeys := make([]string, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		recordKeys = append(recordKeys, string(iterator.Value()))
+	}
+	return k.GetUndelegationRecords(ctx, recordKeys)
+}
+
 // IncrementUndelegationHoldCount increments the hold count for the undelegation record key.
 func (k Keeper) IncrementUndelegationHoldCount(ctx sdk.Context, recordKey []byte) error {
 	prev := k.GetUndelegationHoldCount(ctx, recordKey)
@@ -25,6 +25,7 @@ const (
 	// Amino names
 	delegateAssetToOperator     = "exocore/MsgDelegation"
 	UndelegateAssetFromOperator = "exocore/MsgUndelegation"
+	redelegateAsset             = "exocore/MsgRedelegate"
 )
 
 // NOTE: This is required for the GetSignBytes function
@@ -39,6 +40,7 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 		(*sdk.Msg)(nil),
 		&MsgDelegation{},
 		&MsgUndelegation{},
+		&MsgRedelegate{},
 	)
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
 }
@@ -49,4 +51,5 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgDelegation{}, delegateAssetToOperator, nil)
 	cdc.RegisterConcrete(&MsgUndelegation{}, UndelegateAssetFromOperator, nil)
+	cdc.RegisterConcrete(&MsgRedelegate{}, redelegateAsset, nil)
 }
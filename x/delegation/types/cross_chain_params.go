@@ -40,3 +40,16 @@ func NewDelegationOrUndelegationParams(
 		TxHash:          txHash,
 	}
 }
+
+// RedelegationParams is the params to redelegate an asset from the source operator to the
+// destination operator.
+type RedelegationParams struct {
+	ClientChainID      uint64
+	AssetsAddress      []byte
+	SrcOperatorAddress sdk.AccAddress
+	DstOperatorAddress sdk.AccAddress
+	StakerAddress      []byte
+	OpAmount           sdkmath.Int
+	LzNonce            uint64
+	TxHash             common.Hash
+}
@@ -87,4 +87,12 @@ var (
 		ModuleName, 23,
 		"the block height to complete the unelegation is invalid",
 	)
+	ErrSameSrcAndDstOperator = errorsmod.Register(
+		ModuleName, 24,
+		"the source and destination operators of the redelegation are the same",
+	)
+	ErrTransitiveRedelegation = errorsmod.Register(
+		ModuleName, 25,
+		"the redelegated amount is still slashable for the operator it was redelegated from",
+	)
 )
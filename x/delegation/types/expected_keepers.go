@@ -40,6 +40,9 @@ type DelegationHooks interface {
 type OperatorKeeper interface {
 	IsOperator(ctx sdk.Context, addr sdk.AccAddress) bool
 	GetUnbondingExpirationBlockNumber(ctx sdk.Context, OperatorAddress sdk.AccAddress, startHeight uint64) uint64
+	// GetUnbondingExpiration returns the epoch at the end of which the undelegation from the
+	// operator completes, it returns false if the operator isn't opted into any AVS.
+	GetUnbondingExpiration(ctx sdk.Context, operator sdk.AccAddress) (epochIdentifier string, epochNumber int64, found bool, err error)
 
 	// UpdateOptedInAssetsState(ctx sdk.Context, assetID, operatorAddr string, opAmount sdkmath.Int) error
 }
@@ -5,6 +5,7 @@ type DeltaDelegationAmounts DelegationAmounts
 const (
 	NotBondedPoolName = "not_bonded_tokens_pool"
 	BondedPoolName    = "bonded_tokens_pool"
-	// TODO: we currently not support redelegation, and operators is not directly related to bonded(need to optIn first), so we use this pool name for now
+	// TODO: operators is not directly related to bonded(need to optIn first), so we use this pool name for now.
+	// The redelegated tokens stay in this pool as well.
 	DelegatedPoolName = "delegated_tokens_pool"
 )
@@ -221,7 +221,7 @@ func (gs GenesisState) ValidateUndelegations() error {
 				undelegation,
 			)
 		}
-		if undelegation.CompleteBlockNumber < undelegation.BlockNumber {
+		if undelegation.CompleteEpochIdentifier == "" && undelegation.CompleteBlockNumber < undelegation.BlockNumber {
 			return errorsmod.Wrapf(
 				ErrInvalidGenesisData, "the block number to complete shouldn't be less than the submitted , undelegation：%v",
 				undelegation,
@@ -245,6 +245,57 @@ func (gs GenesisState) ValidateUndelegations() error {
 	return nil
 }
 
+func (gs GenesisState) ValidateRedelegations() error {
+	validationFunc := func(_ int, redelegation RedelegationRecord) error {
+		err := ValidateIDAndOperator(redelegation.StakerID, redelegation.AssetID, redelegation.SrcOperatorAddr)
+		if err != nil {
+			return errorsmod.Wrap(ErrInvalidGenesisData, err.Error())
+		}
+		if _, err := sdk.AccAddressFromBech32(redelegation.DstOperatorAddr); err != nil {
+			return errorsmod.Wrapf(
+				ErrInvalidGenesisData, "invalid destination operator address, redelegation:%v",
+				redelegation,
+			)
+		}
+		if redelegation.SrcOperatorAddr == redelegation.DstOperatorAddr {
+			return errorsmod.Wrapf(
+				ErrInvalidGenesisData, "the source and destination operators are the same, redelegation:%v",
+				redelegation,
+			)
+		}
+		if redelegation.CompleteEpochIdentifier == "" && redelegation.CompleteBlockNumber < redelegation.BlockNumber {
+			return errorsmod.Wrapf(
+				ErrInvalidGenesisData, "the block number to complete shouldn't be less than the submitted, redelegation:%v",
+				redelegation,
+			)
+		}
+		if redelegation.Amount.IsNil() || !redelegation.Amount.IsPositive() {
+			return errorsmod.Wrapf(
+				ErrInvalidGenesisData, "the redelegated amount should be positive, redelegation:%v",
+				redelegation,
+			)
+		}
+		if redelegation.RemainingAmount.IsNil() || redelegation.RemainingAmount.IsNegative() ||
+			redelegation.RemainingAmount.GT(redelegation.Amount) {
+			return errorsmod.Wrapf(
+				ErrInvalidGenesisData, "the remaining amount should be between zero and the redelegated amount, redelegation:%v",
+				redelegation,
+			)
+		}
+		return nil
+	}
+	seenFieldValueFunc := func(redelegation RedelegationRecord) (string, struct{}) {
+		return string(GetRedelegationRecordKey(
+			redelegation.BlockNumber, redelegation.LzTxNonce, redelegation.TxHash, redelegation.SrcOperatorAddr,
+		)), struct{}{}
+	}
+	_, err := utils.CommonValidation(gs.Redelegations, seenFieldValueFunc, validationFunc)
+	if err != nil {
+		return errorsmod.Wrap(ErrInvalidGenesisData, err.Error())
+	}
+	return nil
+}
+
 // Validate performs basic genesis state validation returning an error upon any
 // failure.
 func (gs GenesisState) Validate() error {
@@ -264,5 +315,9 @@ func (gs GenesisState) Validate() error {
 	if err != nil {
 		return err
 	}
+	err = gs.ValidateRedelegations()
+	if err != nil {
+		return err
+	}
 	return nil
 }
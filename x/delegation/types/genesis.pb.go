@@ -36,6 +36,9 @@ type GenesisState struct {
 	StakersByOperator []StakersByOperator `protobuf:"bytes,3,rep,name=stakers_by_operator,json=stakersByOperator,proto3" json:"stakers_by_operator"`
 	// undelegations is a list of all undelegations
 	Undelegations []UndelegationRecord `protobuf:"bytes,4,rep,name=undelegations,proto3" json:"undelegations"`
+	// redelegations is a list of all redelegations which are still exposed to the slashing
+	// of the source operators.
+	Redelegations []RedelegationRecord `protobuf:"bytes,5,rep,name=redelegations,proto3" json:"redelegations"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -99,6 +102,13 @@ func (m *GenesisState) GetUndelegations() []UndelegationRecord {
 	return nil
 }
 
+func (m *GenesisState) GetRedelegations() []RedelegationRecord {
+	if m != nil {
+		return m.Redelegations
+	}
+	return nil
+}
+
 // DelegationStates is a helper struct for the delegation state
 // used to construct the genesis state
 type DelegationStates struct {
@@ -215,7 +225,7 @@ func (m *StakersByOperator) GetStakers() []string {
 
 // DelegationsByStaker is a list of delegations for a single staker.
 type DelegationsByStaker struct {
-	// staker_id is the staker's account address + _ + l0 chain id (hex).“
+	// staker_id is the staker's account address + _ + l0 chain id (hex).``
 	StakerID string `protobuf:"bytes,1,opt,name=staker_id,json=stakerId,proto3" json:"staker_id,omitempty"`
 	// delegations is the list of delegations for the staker, indexed by the
 	// asset_id.
@@ -337,37 +347,38 @@ func init() {
 }
 
 var fileDescriptor_c26dd0d733927603 = []byte{
-	// 471 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x93, 0xcd, 0x6e, 0xd3, 0x40,
-	0x10, 0xc7, 0xe3, 0xa4, 0x2a, 0xc9, 0x36, 0x48, 0xc9, 0x16, 0x24, 0x2b, 0x07, 0xb7, 0x98, 0x03,
-	0xe9, 0xc5, 0x56, 0x81, 0x17, 0xa8, 0xd5, 0x82, 0x72, 0x29, 0x22, 0xa1, 0x42, 0xf4, 0x40, 0xe4,
-	0xc4, 0x83, 0xb1, 0x92, 0x7a, 0x82, 0x67, 0x53, 0xe2, 0xb7, 0xe0, 0xca, 0x1b, 0xf5, 0xd8, 0x23,
-	0xa7, 0x0a, 0x25, 0xaf, 0xc0, 0x03, 0xa0, 0xec, 0x6e, 0xe2, 0xd4, 0x04, 0x4b, 0xbd, 0xad, 0xe7,
-	0xe3, 0x37, 0xff, 0x19, 0xcf, 0xb0, 0xe7, 0x30, 0xc3, 0x21, 0x26, 0xe0, 0x06, 0x30, 0x86, 0xd0,
-	0x17, 0x11, 0xc6, 0xee, 0xf5, 0xb1, 0x1b, 0x42, 0x0c, 0x14, 0x91, 0x33, 0x49, 0x50, 0x20, 0x7f,
-	0xaa, 0x83, 0x9c, 0x2c, 0xc8, 0xb9, 0x3e, 0x6e, 0x3d, 0xdb, 0x9e, 0xfb, 0x6d, 0x0a, 0x49, 0xaa,
-	0x32, 0x5b, 0xd6, 0xf6, 0x10, 0x31, 0xd3, 0xfe, 0x27, 0x21, 0x86, 0x28, 0x9f, 0xee, 0xf2, 0xa5,
-	0xac, 0xf6, 0x9f, 0x32, 0xab, 0xbf, 0x55, 0x0a, 0x7a, 0xc2, 0x17, 0xc0, 0xdf, 0xb3, 0xba, 0x4f,
-	0x84, 0xc3, 0x48, 0x12, 0xc8, 0x34, 0x0e, 0x2b, 0xed, 0xbd, 0x97, 0x2f, 0x9c, 0xad, 0xba, 0x9c,
-	0x9e, 0xf0, 0x47, 0x90, 0x7c, 0xc0, 0x77, 0x13, 0x48, 0x7c, 0x81, 0x89, 0xb7, 0x73, 0x73, 0x77,
-	0x50, 0xea, 0xde, 0x43, 0xf0, 0x4b, 0xd6, 0xcc, 0xb2, 0xfa, 0xb4, 0x2c, 0x43, 0x66, 0xb9, 0x90,
-	0x7b, 0xba, 0xfe, 0x92, 0xaa, 0x48, 0x73, 0x1b, 0x41, 0xce, 0xce, 0x3f, 0xb3, 0x7d, 0x92, 0x1a,
-	0xa8, 0x3f, 0x48, 0xfb, 0xa8, 0x65, 0x98, 0x15, 0x49, 0x6f, 0x17, 0xaa, 0x26, 0x2f, 0xcd, 0xc9,
-	0x6e, 0x52, 0xde, 0xc1, 0x2f, 0xd8, 0xe3, 0x69, 0x9c, 0x65, 0x93, 0xb9, 0x23, 0xc9, 0x47, 0xff,
-	0x21, 0x5f, 0x6c, 0xc4, 0x76, 0x61, 0x88, 0x49, 0xa0, 0xd1, 0xf7, 0x29, 0xf6, 0x98, 0x35, 0xf2,
-	0x2d, 0xf2, 0x06, 0xab, 0x8c, 0x20, 0x35, 0x8d, 0x43, 0xa3, 0x5d, 0xeb, 0x2e, 0x9f, 0xfc, 0x0d,
-	0xdb, 0x5d, 0x4f, 0xcb, 0x28, 0xe8, 0x27, 0x43, 0x9d, 0x5c, 0xe1, 0x34, 0x16, 0xab, 0x71, 0xe9,
-	0x6c, 0xfb, 0x8c, 0x35, 0xff, 0x69, 0x79, 0x4b, 0x39, 0x8b, 0x3d, 0xd2, 0x03, 0x90, 0x7f, 0xa7,
-	0xa6, 0x29, 0x2b, 0xa3, 0xfd, 0xd3, 0x60, 0xfb, 0x59, 0x29, 0xf2, 0x52, 0x05, 0xe5, 0x47, 0xac,
-	0xa6, 0x42, 0xfa, 0x51, 0xa0, 0x78, 0x5e, 0x7d, 0x7e, 0x77, 0x50, 0x55, 0xee, 0xce, 0x69, 0xb7,
-	0xaa, 0xdc, 0x9d, 0x80, 0x7f, 0x64, 0x7b, 0x9b, 0xc3, 0x54, 0x4b, 0xe0, 0x16, 0xb7, 0x05, 0x41,
-	0x2f, 0x8a, 0xc3, 0x31, 0x9c, 0x10, 0x81, 0xe8, 0xc4, 0x5f, 0x50, 0xeb, 0xda, 0x24, 0xd9, 0x9f,
-	0x58, 0x23, 0xbf, 0x8b, 0x0f, 0xd1, 0xd5, 0x62, 0xd5, 0xf5, 0xee, 0x94, 0xe5, 0x44, 0xd6, 0xdf,
-	0xde, 0xf9, 0xcd, 0xdc, 0x32, 0x6e, 0xe7, 0x96, 0xf1, 0x7b, 0x6e, 0x19, 0x3f, 0x16, 0x56, 0xe9,
-	0x76, 0x61, 0x95, 0x7e, 0x2d, 0xac, 0xd2, 0xe5, 0xeb, 0x30, 0x12, 0x5f, 0xa7, 0x03, 0x67, 0x88,
-	0x57, 0xee, 0x99, 0x6a, 0xe1, 0x1c, 0xc4, 0x77, 0x4c, 0x46, 0xee, 0xea, 0x18, 0x67, 0x9b, 0xe7,
-	0x28, 0xd2, 0x09, 0xd0, 0x60, 0x57, 0x5e, 0xde, 0xab, 0xbf, 0x01, 0x00, 0x00, 0xff, 0xff, 0x0f,
-	0x22, 0xc1, 0x73, 0x10, 0x04, 0x00, 0x00,
+	// 486 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x93, 0xc1, 0x6e, 0xd3, 0x4c,
+	0x10, 0xc7, 0xe3, 0xa6, 0x5f, 0xbf, 0x64, 0x1b, 0xa4, 0x64, 0x0b, 0x92, 0x95, 0x83, 0x5b, 0xcc,
+	0x81, 0xf4, 0x62, 0xab, 0xc0, 0x0b, 0xd4, 0x6a, 0x41, 0xb9, 0x14, 0xe1, 0x50, 0x21, 0x7a, 0x20,
+	0x72, 0xe2, 0xc1, 0x58, 0x49, 0xbd, 0x61, 0x67, 0x53, 0xe2, 0xb7, 0xe0, 0xca, 0x1b, 0x55, 0xe2,
+	0xd2, 0x23, 0xa7, 0x0a, 0x25, 0x2f, 0x82, 0xb2, 0xbb, 0x89, 0x1d, 0x93, 0x5a, 0xe2, 0xb6, 0xbb,
+	0x33, 0xf3, 0xdb, 0xff, 0xfe, 0x77, 0x86, 0x3c, 0x83, 0x19, 0x1b, 0x32, 0x0e, 0x6e, 0x08, 0x63,
+	0x88, 0x02, 0x11, 0xb3, 0xc4, 0xbd, 0x39, 0x71, 0x23, 0x48, 0x00, 0x63, 0x74, 0x26, 0x9c, 0x09,
+	0x46, 0x9f, 0xe8, 0x24, 0x27, 0x4b, 0x72, 0x6e, 0x4e, 0xda, 0x4f, 0xb7, 0xd7, 0x7e, 0x9d, 0x02,
+	0x4f, 0x55, 0x65, 0xdb, 0xda, 0x9e, 0x22, 0x66, 0x3a, 0xfe, 0x38, 0x62, 0x11, 0x93, 0x4b, 0x77,
+	0xb9, 0x52, 0xa7, 0xf6, 0xcf, 0x2a, 0x69, 0xbc, 0x51, 0x0a, 0x7a, 0x22, 0x10, 0x40, 0xdf, 0x91,
+	0x46, 0x80, 0xc8, 0x86, 0xb1, 0x24, 0xa0, 0x69, 0x1c, 0x55, 0x3b, 0xfb, 0x2f, 0x9e, 0x3b, 0x5b,
+	0x75, 0x39, 0x3d, 0x11, 0x8c, 0x80, 0xbf, 0x67, 0x6f, 0x27, 0xc0, 0x03, 0xc1, 0xb8, 0xb7, 0x7b,
+	0x7b, 0x7f, 0x58, 0xf1, 0x37, 0x10, 0xf4, 0x8a, 0xb4, 0xb2, 0xaa, 0x3e, 0x2e, 0xaf, 0x41, 0x73,
+	0xa7, 0x94, 0x7b, 0xb6, 0xde, 0x49, 0x55, 0xa8, 0xb9, 0xcd, 0xb0, 0x70, 0x4e, 0x3f, 0x91, 0x03,
+	0x94, 0x1a, 0xb0, 0x3f, 0x48, 0xfb, 0x4c, 0xcb, 0x30, 0xab, 0x92, 0xde, 0x29, 0x55, 0x8d, 0x5e,
+	0x5a, 0x90, 0xdd, 0xc2, 0x62, 0x80, 0x5e, 0x92, 0x47, 0xd3, 0x24, 0xab, 0x46, 0x73, 0x57, 0x92,
+	0x8f, 0x1f, 0x20, 0x5f, 0xe6, 0x72, 0x7d, 0x18, 0x32, 0x1e, 0x6a, 0xf4, 0x26, 0x65, 0x89, 0xe5,
+	0x90, 0xc7, 0xfe, 0x57, 0x8a, 0xf5, 0xe1, 0x21, 0xec, 0x06, 0xc5, 0x1e, 0x93, 0x66, 0xd1, 0x39,
+	0xda, 0x24, 0xd5, 0x11, 0xa4, 0xa6, 0x71, 0x64, 0x74, 0xea, 0xfe, 0x72, 0x49, 0x5f, 0x93, 0xbd,
+	0xf5, 0x27, 0x18, 0x25, 0x36, 0x65, 0xa8, 0xd3, 0x6b, 0x36, 0x4d, 0xc4, 0xea, 0x17, 0x74, 0xb5,
+	0x7d, 0x4e, 0x5a, 0x7f, 0x39, 0xb9, 0xe5, 0x3a, 0x8b, 0xfc, 0xaf, 0x7d, 0x95, 0x9f, 0x5e, 0xd7,
+	0x94, 0xd5, 0xa1, 0xfd, 0xc3, 0x20, 0x07, 0xd9, 0x55, 0xe8, 0xa5, 0x0a, 0x4a, 0x8f, 0x49, 0x5d,
+	0xa5, 0xf4, 0xe3, 0x50, 0xf1, 0xbc, 0xc6, 0xfc, 0xfe, 0xb0, 0xa6, 0xc2, 0xdd, 0x33, 0xbf, 0xa6,
+	0xc2, 0xdd, 0x90, 0x7e, 0x20, 0xfb, 0x79, 0x33, 0x55, 0x6f, 0xb9, 0xe5, 0xcf, 0x82, 0xb0, 0x17,
+	0x27, 0xd1, 0x18, 0x4e, 0x11, 0x41, 0x74, 0x93, 0xcf, 0x4c, 0xeb, 0xca, 0x93, 0xec, 0x8f, 0xa4,
+	0x59, 0x6c, 0xf1, 0x7f, 0xd1, 0xd5, 0x26, 0xb5, 0x75, 0x4b, 0xee, 0x48, 0x47, 0xd6, 0x7b, 0xef,
+	0xe2, 0x76, 0x6e, 0x19, 0x77, 0x73, 0xcb, 0xf8, 0x3d, 0xb7, 0x8c, 0xef, 0x0b, 0xab, 0x72, 0xb7,
+	0xb0, 0x2a, 0xbf, 0x16, 0x56, 0xe5, 0xea, 0x55, 0x14, 0x8b, 0x2f, 0xd3, 0x81, 0x33, 0x64, 0xd7,
+	0xee, 0xb9, 0x7a, 0xc2, 0x05, 0x88, 0x6f, 0x8c, 0x8f, 0xdc, 0xd5, 0x8c, 0xcf, 0xf2, 0x53, 0x2e,
+	0xd2, 0x09, 0xe0, 0x60, 0x4f, 0x0e, 0xf4, 0xcb, 0x3f, 0x01, 0x00, 0x00, 0xff, 0xff, 0xb1, 0x4a,
+	0xa9, 0x76, 0x67, 0x04, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -390,6 +401,20 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Redelegations) > 0 {
+		for iNdEx := len(m.Redelegations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Redelegations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
 	if len(m.Undelegations) > 0 {
 		for iNdEx := len(m.Undelegations) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -650,6 +675,12 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.Redelegations) > 0 {
+		for _, e := range m.Redelegations {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -894,6 +925,40 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Redelegations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Redelegations = append(m.Redelegations, RedelegationRecord{})
+			if err := m.Redelegations[len(m.Redelegations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -42,6 +42,16 @@ const (
 	prefixUndelegationOnHold
 
 	prefixAssociatedOperatorByStaker
+
+	prefixPendingUndelegationsByEpoch
+
+	prefixRedelegationInfo
+
+	prefixStakerRedelegationInfo
+
+	prefixPendingRedelegations
+
+	prefixPendingRedelegationsByEpoch
 )
 
 var (
@@ -64,6 +74,21 @@ var (
 
 	// KeyPrefixAssociatedOperatorByStaker stakerID -> operator address
 	KeyPrefixAssociatedOperatorByStaker = []byte{prefixAssociatedOperatorByStaker}
+
+	// KeyPrefixPendingUndelegationsByEpoch
+	// completeEpochIdentifier +'/'+completeEpochNumber +'/'+LzNonce -> singleRecordKey
+	KeyPrefixPendingUndelegationsByEpoch = []byte{prefixPendingUndelegationsByEpoch}
+
+	// KeyPrefixRedelegationInfo singleRecordKey = srcOperatorAddr+'/'+BlockHeight+'/'+LzNonce+'/'+txHash
+	// singleRecordKey -> RedelegationRecord
+	KeyPrefixRedelegationInfo = []byte{prefixRedelegationInfo}
+	// KeyPrefixStakerRedelegationInfo restakerID+'/'+assetID+'/'+LzNonce -> singleRecordKey
+	KeyPrefixStakerRedelegationInfo = []byte{prefixStakerRedelegationInfo}
+	// KeyPrefixPendingRedelegations completeHeight +'/'+singleRecordKey -> singleRecordKey
+	KeyPrefixPendingRedelegations = []byte{prefixPendingRedelegations}
+	// KeyPrefixPendingRedelegationsByEpoch
+	// completeEpochIdentifier +'/'+completeEpochNumber +'/'+singleRecordKey -> singleRecordKey
+	KeyPrefixPendingRedelegationsByEpoch = []byte{prefixPendingRedelegationsByEpoch}
 )
 
 func IteratorPrefixForStakerAsset(stakerID, assetID string) []byte {
@@ -133,6 +158,47 @@ func GetPendingUndelegationRecordKey(height, lzNonce uint64) []byte {
 	return []byte(strings.Join([]string{hexutil.EncodeUint64(height), hexutil.EncodeUint64(lzNonce)}, "/"))
 }
 
+// GetPendingUndelegationRecordKeyByEpoch returns the key of the pending undelegation record which
+// completes at the end of the epoch
+func GetPendingUndelegationRecordKeyByEpoch(epochIdentifier string, epochNumber int64, lzNonce uint64) []byte {
+	return []byte(strings.Join([]string{
+		// #nosec G115
+		epochIdentifier, hexutil.EncodeUint64(uint64(epochNumber)), hexutil.EncodeUint64(lzNonce),
+	}, "/"))
+}
+
+// IteratorPrefixForPendingUndelegationsByEpoch returns the prefix to iterate the pending
+// undelegation records which complete at the end of the epoch
+func IteratorPrefixForPendingUndelegationsByEpoch(epochIdentifier string, epochNumber int64) []byte {
+	// #nosec G115
+	return []byte(strings.Join([]string{epochIdentifier, hexutil.EncodeUint64(uint64(epochNumber)), ""}, "/"))
+}
+
+// GetRedelegationRecordKey returns the key for the redelegation record. It has the same format
+// as the undelegation record key, so it can be parsed by ParseUndelegationRecordKey. The caller
+// must ensure that the parameters are valid.
+func GetRedelegationRecordKey(blockHeight, lzNonce uint64, txHash string, srcOperatorAddr string) []byte {
+	return GetUndelegationRecordKey(blockHeight, lzNonce, txHash, srcOperatorAddr)
+}
+
+// GetPendingRedelegationRecordKey returns the key of the redelegation record whose slashing
+// exposure ends at the height
+func GetPendingRedelegationRecordKey(height uint64, recordKey []byte) []byte {
+	return []byte(strings.Join([]string{hexutil.EncodeUint64(height), string(recordKey)}, "/"))
+}
+
+// IteratorPrefixForPendingRedelegations returns the prefix to iterate the redelegation records
+// whose slashing exposure ends at the height
+func IteratorPrefixForPendingRedelegations(height uint64) []byte {
+	return []byte(strings.Join([]string{hexutil.EncodeUint64(height), ""}, "/"))
+}
+
+// GetPendingRedelegationRecordKeyByEpoch returns the key of the redelegation record whose
+// slashing exposure ends at the end of the epoch
+func GetPendingRedelegationRecordKeyByEpoch(epochIdentifier string, epochNumber int64, recordKey []byte) []byte {
+	return append(IteratorPrefixForPendingUndelegationsByEpoch(epochIdentifier, epochNumber), recordKey...)
+}
+
 // GetUndelegationOnHoldKey returns the key for the undelegation hold count
 func GetUndelegationOnHoldKey(recordKey []byte) []byte {
 	return append([]byte{prefixUndelegationOnHold}, recordKey...)
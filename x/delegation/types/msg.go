@@ -2,6 +2,7 @@ package types
 
 import (
 	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
 	assetstype "github.com/ExocoreNetwork/exocore/x/assets/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
@@ -9,6 +10,7 @@ import (
 var (
 	_ sdk.Msg = &MsgDelegation{}
 	_ sdk.Msg = &MsgUndelegation{}
+	_ sdk.Msg = &MsgRedelegate{}
 )
 
 // GetSigners returns the expected signers for a MsgUpdateParams message.
@@ -81,6 +83,55 @@ func NewMsgUndelegation(assetID, fromAddress string, amountPerOperator []KeyValu
 	}
 }
 
+// GetSigners returns the expected signers for a MsgRedelegate message.
+func (m *MsgRedelegate) GetSigners() []sdk.AccAddress {
+	addr := sdk.MustAccAddressFromBech32(m.FromAddress)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic does a sanity check of the provided data
+func (m *MsgRedelegate) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.FromAddress); err != nil {
+		return errorsmod.Wrap(err, "invalid from address")
+	}
+	if _, err := sdk.AccAddressFromBech32(m.SrcOperator); err != nil {
+		return errorsmod.Wrap(err, "invalid source operator address")
+	}
+	if _, err := sdk.AccAddressFromBech32(m.DstOperator); err != nil {
+		return errorsmod.Wrap(err, "invalid destination operator address")
+	}
+	if m.SrcOperator == m.DstOperator {
+		return ErrSameSrcAndDstOperator
+	}
+	if m.Amount.IsNil() || !m.Amount.IsPositive() {
+		return ErrAmountIsNotPositive.Wrapf("amount should be positive, got %s", m.Amount)
+	}
+	if m.AssetID != assetstype.ExocoreAssetID {
+		return ErrInvalidAssetID.Wrapf(
+			"only nativeToken is support, expected:%s,got:%s", assetstype.ExocoreAssetID, m.AssetID,
+		)
+	}
+	return nil
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m *MsgRedelegate) GetSignBytes() []byte {
+	return nil
+}
+
+// NewMsgRedelegate creates a new message to redelegate asset from one operator to another
+func NewMsgRedelegate(
+	assetID, fromAddress, srcOperator, dstOperator string, amount sdkmath.Int,
+) *MsgRedelegate {
+	return &MsgRedelegate{
+		FromAddress: fromAddress,
+		AssetID:     assetID,
+		SrcOperator: srcOperator,
+		DstOperator: dstOperator,
+		Amount:      amount,
+	}
+}
+
 // validateDelegationInfo validates the delegation or undelegation info.
 // (1) the operator amounts are positive, and the operator addresses are valid.
 // (2) the assetID is native only, since only native token is supported for this mechanism.
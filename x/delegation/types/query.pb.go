@@ -537,6 +537,107 @@ func (m *UndelegationRecordList) GetUndelegations() []*UndelegationRecord {
 	return nil
 }
 
+// RedelegationsReq is the request to obtain all redelegations by staker id and asset id.
+type RedelegationsReq struct {
+	// staker_id is the staker id.
+	StakerID string `protobuf:"bytes,1,opt,name=staker_id,json=stakerId,proto3" json:"staker_id,omitempty"`
+	// asset_id is the asset id.
+	AssetID string `protobuf:"bytes,2,opt,name=asset_id,json=assetId,proto3" json:"asset_id,omitempty"`
+}
+
+func (m *RedelegationsReq) Reset()         { *m = RedelegationsReq{} }
+func (m *RedelegationsReq) String() string { return proto.CompactTextString(m) }
+func (*RedelegationsReq) ProtoMessage()    {}
+func (*RedelegationsReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aab345e1cf20490c, []int{10}
+}
+func (m *RedelegationsReq) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RedelegationsReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RedelegationsReq.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RedelegationsReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RedelegationsReq.Merge(m, src)
+}
+func (m *RedelegationsReq) XXX_Size() int {
+	return m.Size()
+}
+func (m *RedelegationsReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_RedelegationsReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RedelegationsReq proto.InternalMessageInfo
+
+func (m *RedelegationsReq) GetStakerID() string {
+	if m != nil {
+		return m.StakerID
+	}
+	return ""
+}
+
+func (m *RedelegationsReq) GetAssetID() string {
+	if m != nil {
+		return m.AssetID
+	}
+	return ""
+}
+
+// RedelegationRecordList is the response to query redelegations.
+type RedelegationRecordList struct {
+	// redelegations is the returned redelegations
+	Redelegations []*RedelegationRecord `protobuf:"bytes,1,rep,name=redelegations,proto3" json:"redelegations,omitempty"`
+}
+
+func (m *RedelegationRecordList) Reset()         { *m = RedelegationRecordList{} }
+func (m *RedelegationRecordList) String() string { return proto.CompactTextString(m) }
+func (*RedelegationRecordList) ProtoMessage()    {}
+func (*RedelegationRecordList) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aab345e1cf20490c, []int{11}
+}
+func (m *RedelegationRecordList) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RedelegationRecordList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RedelegationRecordList.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RedelegationRecordList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RedelegationRecordList.Merge(m, src)
+}
+func (m *RedelegationRecordList) XXX_Size() int {
+	return m.Size()
+}
+func (m *RedelegationRecordList) XXX_DiscardUnknown() {
+	xxx_messageInfo_RedelegationRecordList.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RedelegationRecordList proto.InternalMessageInfo
+
+func (m *RedelegationRecordList) GetRedelegations() []*RedelegationRecord {
+	if m != nil {
+		return m.Redelegations
+	}
+	return nil
+}
+
 // QueryAssociatedOperatorByStakerReq is the request to obtain the associated operator of the specified staker
 type QueryAssociatedOperatorByStakerReq struct {
 	// stake_id is the staker id for which the query is made.
@@ -547,7 +648,7 @@ func (m *QueryAssociatedOperatorByStakerReq) Reset()         { *m = QueryAssocia
 func (m *QueryAssociatedOperatorByStakerReq) String() string { return proto.CompactTextString(m) }
 func (*QueryAssociatedOperatorByStakerReq) ProtoMessage()    {}
 func (*QueryAssociatedOperatorByStakerReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aab345e1cf20490c, []int{10}
+	return fileDescriptor_aab345e1cf20490c, []int{12}
 }
 func (m *QueryAssociatedOperatorByStakerReq) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -595,7 +696,7 @@ func (m *QueryAssociatedOperatorByStakerResponse) Reset() {
 func (m *QueryAssociatedOperatorByStakerResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryAssociatedOperatorByStakerResponse) ProtoMessage()    {}
 func (*QueryAssociatedOperatorByStakerResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aab345e1cf20490c, []int{11}
+	return fileDescriptor_aab345e1cf20490c, []int{13}
 }
 func (m *QueryAssociatedOperatorByStakerResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -643,6 +744,8 @@ func init() {
 	proto.RegisterType((*UndelegationsReq)(nil), "exocore.delegation.v1.UndelegationsReq")
 	proto.RegisterType((*UndelegationsByHeightReq)(nil), "exocore.delegation.v1.UndelegationsByHeightReq")
 	proto.RegisterType((*UndelegationRecordList)(nil), "exocore.delegation.v1.UndelegationRecordList")
+	proto.RegisterType((*RedelegationsReq)(nil), "exocore.delegation.v1.RedelegationsReq")
+	proto.RegisterType((*RedelegationRecordList)(nil), "exocore.delegation.v1.RedelegationRecordList")
 	proto.RegisterType((*QueryAssociatedOperatorByStakerReq)(nil), "exocore.delegation.v1.QueryAssociatedOperatorByStakerReq")
 	proto.RegisterType((*QueryAssociatedOperatorByStakerResponse)(nil), "exocore.delegation.v1.QueryAssociatedOperatorByStakerResponse")
 }
@@ -650,66 +753,68 @@ func init() {
 func init() { proto.RegisterFile("exocore/delegation/v1/query.proto", fileDescriptor_aab345e1cf20490c) }
 
 var fileDescriptor_aab345e1cf20490c = []byte{
-	// 934 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0x4f, 0x6f, 0x1b, 0x45,
-	0x14, 0xcf, 0x24, 0x94, 0xc4, 0x2f, 0xa9, 0x08, 0xd3, 0x50, 0xdc, 0x2d, 0xb1, 0xdb, 0x3d, 0xa4,
-	0x49, 0x51, 0x76, 0x89, 0x5b, 0xaa, 0x16, 0x11, 0x8b, 0xa4, 0x8e, 0x5a, 0xab, 0xa8, 0x11, 0x1b,
-	0x71, 0xe1, 0xb2, 0xda, 0xec, 0x4e, 0xd6, 0x2b, 0x6f, 0x76, 0xdc, 0x9d, 0x71, 0x1a, 0x0b, 0x71,
-	0xe1, 0xd4, 0x23, 0x12, 0x5f, 0x81, 0x13, 0x5c, 0x38, 0xe4, 0xc8, 0x07, 0xe8, 0x31, 0x0a, 0x17,
-	0xc4, 0x21, 0x02, 0x07, 0x89, 0xef, 0xc0, 0x05, 0xb4, 0xb3, 0x63, 0xef, 0x3a, 0xd9, 0x75, 0x6c,
-	0x10, 0xa7, 0x78, 0xde, 0xbc, 0xf7, 0x9b, 0xdf, 0xfb, 0xf3, 0x7b, 0x59, 0xb8, 0x4d, 0x0e, 0xa9,
-	0x4d, 0x43, 0xa2, 0x3b, 0xc4, 0x27, 0xae, 0xc5, 0x3d, 0x1a, 0xe8, 0x07, 0x6b, 0xfa, 0x8b, 0x36,
-	0x09, 0x3b, 0x5a, 0x2b, 0xa4, 0x9c, 0xe2, 0x77, 0xa4, 0x8b, 0x96, 0xb8, 0x68, 0x07, 0x6b, 0xca,
-	0x4d, 0x9b, 0xb2, 0x7d, 0xca, 0x62, 0xd7, 0x73, 0x31, 0xca, 0x8d, 0xf8, 0xd2, 0x14, 0x27, 0x3d,
-	0x3e, 0xc8, 0xab, 0x52, 0xf6, 0x8b, 0xfc, 0x50, 0xde, 0x2f, 0xb8, 0xd4, 0xa5, 0x71, 0x5c, 0xf4,
-	0x4b, 0x5a, 0xdf, 0x73, 0x29, 0x75, 0x7d, 0xa2, 0x5b, 0x2d, 0x4f, 0xb7, 0x82, 0x80, 0x72, 0x11,
-	0x28, 0x31, 0xd5, 0x3d, 0x78, 0xbb, 0xd6, 0x47, 0xab, 0x07, 0x7b, 0xd4, 0x20, 0x2f, 0xf0, 0x0a,
-	0x14, 0x18, 0xb7, 0x9a, 0x24, 0x34, 0x3d, 0xa7, 0x88, 0x6e, 0xa1, 0xe5, 0xc2, 0xe6, 0x5c, 0xf7,
-	0xb4, 0x3c, 0xb3, 0x23, 0x8c, 0xf5, 0x9a, 0x31, 0x13, 0x5f, 0xd7, 0x1d, 0xbc, 0x04, 0x33, 0x16,
-	0x63, 0x84, 0x47, 0x9e, 0x93, 0xc2, 0x73, 0xb6, 0x7b, 0x5a, 0x9e, 0xde, 0x88, 0x6c, 0xf5, 0x9a,
-	0x31, 0x2d, 0x2e, 0xeb, 0x8e, 0xba, 0x04, 0x10, 0x47, 0x7f, 0xea, 0x31, 0x8e, 0x8b, 0x30, 0x1d,
-	0x23, 0xb0, 0x22, 0xba, 0x35, 0xb5, 0x5c, 0x30, 0x7a, 0x47, 0xf5, 0x2f, 0x94, 0x26, 0xb4, 0xb1,
-	0x4f, 0xdb, 0x01, 0x67, 0x78, 0x1f, 0xae, 0xb5, 0x03, 0x99, 0xb5, 0xb5, 0xeb, 0x13, 0x93, 0x35,
-	0xac, 0x90, 0x48, 0x6a, 0x1f, 0xbf, 0x3e, 0x2d, 0x4f, 0xfc, 0x7a, 0x5a, 0x5e, 0x72, 0x3d, 0xde,
-	0x68, 0xef, 0x6a, 0x36, 0xdd, 0x97, 0x75, 0x93, 0x7f, 0x56, 0x99, 0xd3, 0xd4, 0x79, 0xa7, 0x45,
-	0x98, 0x56, 0x23, 0xf6, 0xc9, 0xd1, 0x2a, 0xc8, 0xb2, 0xd6, 0x88, 0x6d, 0xe0, 0x01, 0xe0, 0x9d,
-	0x08, 0x17, 0x1f, 0x40, 0xf1, 0xa5, 0xe5, 0x71, 0xb3, 0x7f, 0xe5, 0xd1, 0xc0, 0xb4, 0x04, 0x17,
-	0x99, 0xe4, 0x38, 0x6f, 0xd6, 0x03, 0x9e, 0x7a, 0xb3, 0x1e, 0x70, 0xe3, 0x7a, 0x84, 0xfe, 0x79,
-	0x0a, 0x3c, 0xce, 0x53, 0xfd, 0x1b, 0xc1, 0xcd, 0xcf, 0xa2, 0x59, 0x38, 0xdf, 0x12, 0xd6, 0xa2,
-	0x01, 0x23, 0x38, 0x84, 0xf9, 0x14, 0x21, 0x2f, 0xd8, 0xa3, 0x71, 0xfd, 0x66, 0x2b, 0x4f, 0xb4,
-	0xcc, 0x51, 0xd3, 0x86, 0xa0, 0x69, 0x83, 0x66, 0xb6, 0x15, 0xf0, 0xb0, 0x63, 0xbc, 0xe5, 0x0c,
-	0x5a, 0x15, 0x1f, 0x16, 0xb2, 0x1c, 0xf1, 0x3c, 0x4c, 0x35, 0x49, 0x27, 0x6e, 0x81, 0x11, 0xfd,
-	0xc4, 0x55, 0xb8, 0x72, 0x60, 0xf9, 0x6d, 0x22, 0x4a, 0x34, 0x5b, 0x59, 0xce, 0xa1, 0x74, 0xa1,
-	0xbb, 0x46, 0x1c, 0xf6, 0xd1, 0xe4, 0x43, 0xa4, 0xfe, 0x80, 0xe0, 0xdd, 0x1d, 0x2f, 0x70, 0x7d,
-	0xf2, 0x9f, 0xa6, 0x72, 0x1d, 0xae, 0xd2, 0x16, 0x09, 0x2d, 0x4e, 0x43, 0xd3, 0x72, 0x9c, 0x50,
-	0x76, 0xad, 0x78, 0x72, 0xb4, 0xba, 0x20, 0xfb, 0xb0, 0xe1, 0x38, 0x21, 0x61, 0x6c, 0x87, 0x87,
-	0x5e, 0xe0, 0x1a, 0x73, 0x3d, 0xf7, 0xc8, 0x3c, 0x30, 0xd4, 0x53, 0x43, 0x86, 0xfa, 0x11, 0x14,
-	0xd3, 0x5d, 0x7c, 0x4a, 0x7d, 0xe7, 0x71, 0x94, 0x52, 0xc4, 0x76, 0x11, 0x20, 0x24, 0x36, 0x0d,
-	0x1d, 0x33, 0x29, 0x53, 0x21, 0xb6, 0x3c, 0x23, 0x1d, 0xb5, 0x0a, 0x8b, 0x39, 0xa1, 0xb2, 0xd7,
-	0x8b, 0x00, 0x0d, 0xea, 0x3b, 0xa6, 0x2d, 0xa6, 0x2e, 0x8a, 0x7f, 0xc3, 0x28, 0x34, 0x7a, 0x6e,
-	0x2a, 0x81, 0xf9, 0x74, 0x3c, 0xfb, 0x9f, 0x64, 0xbb, 0x3e, 0x98, 0x21, 0xdb, 0xec, 0x3c, 0x25,
-	0x9e, 0xdb, 0x10, 0x19, 0xde, 0x86, 0xb9, 0x5d, 0x9f, 0xda, 0x4d, 0xb3, 0x21, 0x4c, 0x92, 0xe3,
-	0xac, 0xb0, 0xc5, 0x5e, 0xaa, 0x07, 0xd7, 0xd3, 0xe1, 0x86, 0x48, 0x5f, 0x6c, 0x80, 0x6d, 0xb8,
-	0x9a, 0x56, 0x57, 0x6f, 0x8e, 0x57, 0x72, 0x86, 0xe6, 0x22, 0x8a, 0x31, 0x18, 0xaf, 0x6e, 0x83,
-	0x2a, 0x86, 0x7d, 0x83, 0x31, 0x6a, 0x7b, 0x16, 0x27, 0xce, 0xb6, 0x6c, 0xe9, 0x66, 0x27, 0x2e,
-	0xc0, 0x78, 0x25, 0x52, 0xb7, 0xe0, 0xce, 0xa5, 0x80, 0xb2, 0x57, 0x0a, 0xcc, 0xf4, 0xe6, 0x47,
-	0x76, 0xba, 0x7f, 0xae, 0xbc, 0x2a, 0xc0, 0x15, 0x81, 0x83, 0xbf, 0x47, 0x70, 0x2d, 0x43, 0x8f,
-	0xf8, 0x72, 0xa1, 0x48, 0x05, 0x28, 0x95, 0xf1, 0x55, 0xae, 0x7e, 0xf8, 0xea, 0xcf, 0x1f, 0xef,
-	0xa2, 0xaf, 0x7f, 0xfe, 0xe3, 0xdb, 0xc9, 0xbb, 0x78, 0x59, 0xcf, 0xfe, 0x17, 0xf2, 0x84, 0xf0,
-	0x73, 0xa4, 0x8e, 0x10, 0xdc, 0x10, 0xb0, 0x59, 0x6a, 0xc4, 0x5a, 0x0e, 0x91, 0x1c, 0xe9, 0x2a,
-	0x23, 0xef, 0x02, 0x75, 0x3d, 0xa1, 0x5b, 0xc1, 0x1f, 0xe4, 0xd0, 0xcd, 0x27, 0x76, 0x8c, 0x40,
-	0x11, 0xb7, 0x99, 0xe2, 0xc2, 0xfa, 0x08, 0xe3, 0x95, 0x56, 0xb1, 0x72, 0x7f, 0xbc, 0x00, 0x59,
-	0xf3, 0x67, 0x49, 0x12, 0x9f, 0xe0, 0xea, 0xb0, 0x24, 0x32, 0x71, 0xf4, 0x2f, 0x93, 0xcd, 0xf1,
-	0x15, 0xfe, 0x0e, 0x01, 0xbe, 0xe0, 0xcb, 0xf0, 0x9d, 0x11, 0x98, 0x45, 0x5b, 0x41, 0x59, 0x1d,
-	0x59, 0x52, 0x91, 0x30, 0xd5, 0x07, 0x09, 0xf7, 0xf7, 0xf1, 0xca, 0xa8, 0xdc, 0x19, 0xfe, 0x29,
-	0xab, 0xf2, 0xfd, 0x7d, 0x31, 0x52, 0xe5, 0xd3, 0xdb, 0x65, 0x5c, 0xda, 0xd5, 0x84, 0xf6, 0x3d,
-	0xbc, 0x36, 0x32, 0xed, 0x3e, 0xbf, 0xdf, 0x11, 0x94, 0x2f, 0x91, 0x3b, 0x7e, 0x34, 0x4c, 0x7e,
-	0x43, 0xf7, 0x8e, 0x52, 0xfd, 0xb7, 0xa1, 0x72, 0xa2, 0x1e, 0x27, 0xe9, 0x3d, 0xc4, 0x0f, 0x86,
-	0xa5, 0x97, 0x0f, 0xb6, 0xf9, 0xfc, 0x75, 0xb7, 0x84, 0x8e, 0xbb, 0x25, 0xf4, 0x5b, 0xb7, 0x84,
-	0xbe, 0x39, 0x2b, 0x4d, 0x1c, 0x9f, 0x95, 0x26, 0x7e, 0x39, 0x2b, 0x4d, 0x7c, 0x71, 0x3f, 0xf5,
-	0x19, 0xb3, 0x15, 0x63, 0x3f, 0x27, 0xfc, 0x25, 0x0d, 0x9b, 0xfd, 0xa7, 0x0e, 0xd3, 0x8f, 0x89,
-	0x0f, 0x9b, 0xdd, 0x37, 0xc5, 0x27, 0xe4, 0xbd, 0x7f, 0x02, 0x00, 0x00, 0xff, 0xff, 0xa1, 0xe2,
-	0xcd, 0x70, 0x0a, 0x0b, 0x00, 0x00,
+	// 975 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0x4f, 0x6f, 0xdc, 0x44,
+	0x14, 0x8f, 0x13, 0x4a, 0xb2, 0x2f, 0xa9, 0x08, 0xd3, 0x10, 0xb6, 0x2e, 0xd9, 0x6d, 0x7d, 0x48,
+	0x93, 0xa2, 0xd8, 0x64, 0x5b, 0xaa, 0x16, 0x91, 0x15, 0x49, 0x37, 0x6a, 0x57, 0x45, 0x8d, 0x70,
+	0xc4, 0x85, 0x8b, 0xe5, 0xd8, 0x13, 0xaf, 0xb5, 0x8e, 0x67, 0xeb, 0x99, 0x4d, 0xb3, 0x42, 0x5c,
+	0x38, 0x71, 0x44, 0xe2, 0x2b, 0x70, 0x82, 0x03, 0x1c, 0x72, 0xe4, 0x03, 0xf4, 0x18, 0x95, 0x0b,
+	0xe2, 0x10, 0xc1, 0x06, 0x89, 0xef, 0xc0, 0x05, 0xe4, 0xf1, 0xec, 0xae, 0x9d, 0xb5, 0x37, 0x5e,
+	0xaa, 0x9c, 0xb2, 0xf3, 0xe6, 0xbd, 0xdf, 0xfc, 0xde, 0xbf, 0x5f, 0x0c, 0xb7, 0xf0, 0x11, 0xb1,
+	0x48, 0x80, 0x35, 0x1b, 0x7b, 0xd8, 0x31, 0x99, 0x4b, 0x7c, 0xed, 0x70, 0x5d, 0x7b, 0xde, 0xc6,
+	0x41, 0x47, 0x6d, 0x05, 0x84, 0x11, 0xf4, 0x8e, 0x70, 0x51, 0x07, 0x2e, 0xea, 0xe1, 0xba, 0x7c,
+	0xc3, 0x22, 0xf4, 0x80, 0xd0, 0xc8, 0xf5, 0x5c, 0x8c, 0x7c, 0x3d, 0xba, 0x34, 0xf8, 0x49, 0x8b,
+	0x0e, 0xe2, 0xaa, 0x94, 0xfe, 0x22, 0x3b, 0x12, 0xf7, 0x0b, 0x0e, 0x71, 0x48, 0x14, 0x17, 0xfe,
+	0x12, 0xd6, 0xf7, 0x1c, 0x42, 0x1c, 0x0f, 0x6b, 0x66, 0xcb, 0xd5, 0x4c, 0xdf, 0x27, 0x8c, 0x07,
+	0x0a, 0x4c, 0x65, 0x1f, 0xde, 0xae, 0xf5, 0xd1, 0xea, 0xfe, 0x3e, 0xd1, 0xf1, 0x73, 0xb4, 0x0a,
+	0x05, 0xca, 0xcc, 0x26, 0x0e, 0x0c, 0xd7, 0x2e, 0x4a, 0x37, 0xa5, 0x95, 0xc2, 0xd6, 0x5c, 0xf7,
+	0xb4, 0x3c, 0xb3, 0xcb, 0x8d, 0xf5, 0x9a, 0x3e, 0x13, 0x5d, 0xd7, 0x6d, 0xb4, 0x0c, 0x33, 0x26,
+	0xa5, 0x98, 0x85, 0x9e, 0x93, 0xdc, 0x73, 0xb6, 0x7b, 0x5a, 0x9e, 0xde, 0x0c, 0x6d, 0xf5, 0x9a,
+	0x3e, 0xcd, 0x2f, 0xeb, 0xb6, 0xb2, 0x0c, 0x10, 0x45, 0x7f, 0xea, 0x52, 0x86, 0x8a, 0x30, 0x1d,
+	0x21, 0xd0, 0xa2, 0x74, 0x73, 0x6a, 0xa5, 0xa0, 0xf7, 0x8e, 0xca, 0x3f, 0x52, 0x9c, 0xd0, 0xe6,
+	0x01, 0x69, 0xfb, 0x8c, 0xa2, 0x03, 0xb8, 0xd6, 0xf6, 0x45, 0xd6, 0xe6, 0x9e, 0x87, 0x0d, 0xda,
+	0x30, 0x03, 0x2c, 0xa8, 0x7d, 0xfc, 0xf2, 0xb4, 0x3c, 0xf1, 0xfb, 0x69, 0x79, 0xd9, 0x71, 0x59,
+	0xa3, 0xbd, 0xa7, 0x5a, 0xe4, 0x40, 0xd4, 0x4d, 0xfc, 0x59, 0xa3, 0x76, 0x53, 0x63, 0x9d, 0x16,
+	0xa6, 0x6a, 0x0d, 0x5b, 0xaf, 0x8e, 0xd7, 0x40, 0x94, 0xb5, 0x86, 0x2d, 0x1d, 0x25, 0x80, 0x77,
+	0x43, 0x5c, 0x74, 0x08, 0xc5, 0x17, 0xa6, 0xcb, 0x8c, 0xfe, 0x95, 0x4b, 0x7c, 0xc3, 0xe4, 0x5c,
+	0x44, 0x92, 0xe3, 0xbc, 0x59, 0xf7, 0x59, 0xec, 0xcd, 0xba, 0xcf, 0xf4, 0xc5, 0x10, 0xfd, 0xf3,
+	0x18, 0x78, 0x94, 0xa7, 0xf2, 0xaf, 0x04, 0x37, 0x3e, 0x0b, 0x67, 0xe1, 0x7c, 0x4b, 0x68, 0x8b,
+	0xf8, 0x14, 0xa3, 0x00, 0xe6, 0x63, 0x84, 0x5c, 0x7f, 0x9f, 0x44, 0xf5, 0x9b, 0xad, 0x3c, 0x56,
+	0x53, 0x47, 0x4d, 0x1d, 0x81, 0xa6, 0x26, 0xcd, 0x74, 0xdb, 0x67, 0x41, 0x47, 0x7f, 0xcb, 0x4e,
+	0x5a, 0x65, 0x0f, 0x16, 0xd2, 0x1c, 0xd1, 0x3c, 0x4c, 0x35, 0x71, 0x27, 0x6a, 0x81, 0x1e, 0xfe,
+	0x44, 0x55, 0xb8, 0x72, 0x68, 0x7a, 0x6d, 0xcc, 0x4b, 0x34, 0x5b, 0x59, 0xc9, 0xa0, 0x34, 0xd4,
+	0x5d, 0x3d, 0x0a, 0xfb, 0x68, 0xf2, 0x81, 0xa4, 0xfc, 0x28, 0xc1, 0xbb, 0xbb, 0xae, 0xef, 0x78,
+	0xf8, 0xb5, 0xa6, 0x72, 0x03, 0xae, 0x92, 0x16, 0x0e, 0x4c, 0x46, 0x02, 0xc3, 0xb4, 0xed, 0x40,
+	0x74, 0xad, 0xf8, 0xea, 0x78, 0x6d, 0x41, 0xf4, 0x61, 0xd3, 0xb6, 0x03, 0x4c, 0xe9, 0x2e, 0x0b,
+	0x5c, 0xdf, 0xd1, 0xe7, 0x7a, 0xee, 0xa1, 0x39, 0x31, 0xd4, 0x53, 0x23, 0x86, 0xfa, 0x21, 0x14,
+	0xe3, 0x5d, 0x7c, 0x42, 0x3c, 0xfb, 0x51, 0x98, 0x52, 0xc8, 0x76, 0x09, 0x20, 0xc0, 0x16, 0x09,
+	0x6c, 0x63, 0x50, 0xa6, 0x42, 0x64, 0x79, 0x8a, 0x3b, 0x4a, 0x15, 0x96, 0x32, 0x42, 0x45, 0xaf,
+	0x97, 0x00, 0x1a, 0xc4, 0xb3, 0x0d, 0x8b, 0x4f, 0x5d, 0x18, 0xff, 0x86, 0x5e, 0x68, 0xf4, 0xdc,
+	0x14, 0x0c, 0xf3, 0xf1, 0x78, 0x7a, 0x49, 0x6b, 0xbb, 0x91, 0xcc, 0x90, 0x6e, 0x75, 0x9e, 0x60,
+	0xd7, 0x69, 0xf0, 0x0c, 0x6f, 0xc1, 0xdc, 0x9e, 0x47, 0xac, 0xa6, 0xd1, 0xe0, 0x26, 0xc1, 0x71,
+	0x96, 0xdb, 0x22, 0x2f, 0xc5, 0x85, 0xc5, 0x78, 0xb8, 0xce, 0xd3, 0xe7, 0x0a, 0xb0, 0x03, 0x57,
+	0xe3, 0xdb, 0xd5, 0x9b, 0xe3, 0xd5, 0x8c, 0xa1, 0x19, 0x46, 0xd1, 0x93, 0xf1, 0x61, 0x41, 0x74,
+	0x7c, 0xf9, 0x05, 0x71, 0x61, 0x31, 0xfe, 0x4c, 0x32, 0xa3, 0x00, 0xe7, 0xcf, 0x68, 0x18, 0x45,
+	0x4f, 0xc6, 0x2b, 0x3b, 0xa0, 0xf0, 0xf5, 0xdd, 0xa4, 0x94, 0x58, 0xae, 0xc9, 0xb0, 0xbd, 0x23,
+	0x86, 0x74, 0xab, 0x13, 0x65, 0x30, 0x5e, 0x8e, 0xca, 0x36, 0xdc, 0xbe, 0x10, 0x50, 0x4c, 0x9f,
+	0x0c, 0x33, 0xbd, 0x8d, 0x10, 0xb3, 0xdb, 0x3f, 0x57, 0x7e, 0x02, 0xb8, 0xc2, 0x71, 0xd0, 0x0f,
+	0x12, 0x5c, 0x4b, 0x51, 0x18, 0x74, 0xf1, 0xea, 0x8b, 0x9d, 0x96, 0x2b, 0xe3, 0xeb, 0x96, 0xf2,
+	0xe1, 0x37, 0x7f, 0xff, 0x7c, 0x47, 0xfa, 0xfa, 0xd7, 0xbf, 0xbe, 0x9b, 0xbc, 0x83, 0x56, 0xb4,
+	0xf4, 0x7f, 0x8a, 0x8f, 0x31, 0x3b, 0x47, 0xea, 0x58, 0x82, 0xeb, 0x1c, 0x36, 0x4d, 0x5f, 0x90,
+	0x9a, 0x41, 0x24, 0x43, 0x8c, 0xe4, 0xdc, 0xea, 0xa6, 0x6c, 0x0c, 0xe8, 0x56, 0xd0, 0x07, 0x19,
+	0x74, 0xb3, 0x89, 0x9d, 0x48, 0x20, 0xf3, 0xdb, 0x54, 0xb9, 0x40, 0x5a, 0x8e, 0x85, 0x89, 0xeb,
+	0x92, 0x7c, 0x6f, 0xbc, 0x00, 0x51, 0xf3, 0xa7, 0x83, 0x24, 0x3e, 0x41, 0xd5, 0x51, 0x49, 0xa4,
+	0xe2, 0x68, 0x5f, 0x0e, 0xb4, 0xf0, 0x2b, 0xf4, 0xbd, 0x04, 0x68, 0xc8, 0x97, 0xa2, 0xdb, 0x39,
+	0x98, 0x85, 0x6b, 0x2d, 0xaf, 0xe5, 0x16, 0x89, 0x70, 0x31, 0x95, 0xfb, 0x03, 0xee, 0xef, 0xa3,
+	0xd5, 0xbc, 0xdc, 0x29, 0xfa, 0x25, 0xad, 0xf2, 0x7d, 0x05, 0xcc, 0x55, 0xf9, 0xb8, 0x5e, 0x8e,
+	0x4b, 0xbb, 0x3a, 0xa0, 0x7d, 0x17, 0xad, 0xe7, 0xa6, 0xdd, 0xe7, 0xd7, 0xaf, 0x72, 0x42, 0x16,
+	0x33, 0xab, 0x7c, 0x5e, 0x3c, 0x33, 0xe9, 0xa6, 0xcb, 0xdf, 0x18, 0x55, 0x4e, 0xf2, 0xf9, 0x53,
+	0x82, 0xf2, 0x05, 0xaa, 0x84, 0x1e, 0x8e, 0x52, 0x89, 0x91, 0xf2, 0x28, 0x57, 0xff, 0x6f, 0xa8,
+	0x18, 0xfc, 0x47, 0x83, 0xb4, 0x1e, 0xa0, 0xfb, 0xa3, 0xd2, 0xca, 0x06, 0xdb, 0x7a, 0xf6, 0xb2,
+	0x5b, 0x92, 0x4e, 0xba, 0x25, 0xe9, 0x8f, 0x6e, 0x49, 0xfa, 0xf6, 0xac, 0x34, 0x71, 0x72, 0x56,
+	0x9a, 0xf8, 0xed, 0xac, 0x34, 0xf1, 0xc5, 0xbd, 0xd8, 0xf7, 0xe3, 0x76, 0x84, 0xfd, 0x0c, 0xb3,
+	0x17, 0x24, 0x68, 0xf6, 0x9f, 0x3a, 0x8a, 0x3f, 0xc6, 0xbf, 0x28, 0xf7, 0xde, 0xe4, 0xdf, 0xee,
+	0x77, 0xff, 0x0b, 0x00, 0x00, 0xff, 0xff, 0xe5, 0x67, 0x8a, 0xa9, 0x83, 0x0c, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -737,6 +842,9 @@ type QueryClient interface {
 	// QueryUndelegationsByHeight queries all undelegations waiting to be completed by
 	// {height}.
 	QueryUndelegationsByHeight(ctx context.Context, in *UndelegationsByHeightReq, opts ...grpc.CallOption) (*UndelegationRecordList, error)
+	// QueryRedelegations queries all redelegations which are still exposed to the slashing of
+	// the source operators for {staker, asset}.
+	QueryRedelegations(ctx context.Context, in *RedelegationsReq, opts ...grpc.CallOption) (*RedelegationRecordList, error)
 	// QueryAssociatedOperatorByStaker queries the associated operator for the specified staker
 	QueryAssociatedOperatorByStaker(ctx context.Context, in *QueryAssociatedOperatorByStakerReq, opts ...grpc.CallOption) (*QueryAssociatedOperatorByStakerResponse, error)
 }
@@ -794,6 +902,15 @@ func (c *queryClient) QueryUndelegationsByHeight(ctx context.Context, in *Undele
 	return out, nil
 }
 
+func (c *queryClient) QueryRedelegations(ctx context.Context, in *RedelegationsReq, opts ...grpc.CallOption) (*RedelegationRecordList, error) {
+	out := new(RedelegationRecordList)
+	err := c.cc.Invoke(ctx, "/exocore.delegation.v1.Query/QueryRedelegations", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) QueryAssociatedOperatorByStaker(ctx context.Context, in *QueryAssociatedOperatorByStakerReq, opts ...grpc.CallOption) (*QueryAssociatedOperatorByStakerResponse, error) {
 	out := new(QueryAssociatedOperatorByStakerResponse)
 	err := c.cc.Invoke(ctx, "/exocore.delegation.v1.Query/QueryAssociatedOperatorByStaker", in, out, opts...)
@@ -818,6 +935,9 @@ type QueryServer interface {
 	// QueryUndelegationsByHeight queries all undelegations waiting to be completed by
 	// {height}.
 	QueryUndelegationsByHeight(context.Context, *UndelegationsByHeightReq) (*UndelegationRecordList, error)
+	// QueryRedelegations queries all redelegations which are still exposed to the slashing of
+	// the source operators for {staker, asset}.
+	QueryRedelegations(context.Context, *RedelegationsReq) (*RedelegationRecordList, error)
 	// QueryAssociatedOperatorByStaker queries the associated operator for the specified staker
 	QueryAssociatedOperatorByStaker(context.Context, *QueryAssociatedOperatorByStakerReq) (*QueryAssociatedOperatorByStakerResponse, error)
 }
@@ -841,6 +961,9 @@ func (*UnimplementedQueryServer) QueryUndelegations(ctx context.Context, req *Un
 func (*UnimplementedQueryServer) QueryUndelegationsByHeight(ctx context.Context, req *UndelegationsByHeightReq) (*UndelegationRecordList, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method QueryUndelegationsByHeight not implemented")
 }
+func (*UnimplementedQueryServer) QueryRedelegations(ctx context.Context, req *RedelegationsReq) (*RedelegationRecordList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryRedelegations not implemented")
+}
 func (*UnimplementedQueryServer) QueryAssociatedOperatorByStaker(ctx context.Context, req *QueryAssociatedOperatorByStakerReq) (*QueryAssociatedOperatorByStakerResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method QueryAssociatedOperatorByStaker not implemented")
 }
@@ -939,6 +1062,24 @@ func _Query_QueryUndelegationsByHeight_Handler(srv interface{}, ctx context.Cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_QueryRedelegations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RedelegationsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryRedelegations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/exocore.delegation.v1.Query/QueryRedelegations",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryRedelegations(ctx, req.(*RedelegationsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_QueryAssociatedOperatorByStaker_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryAssociatedOperatorByStakerReq)
 	if err := dec(in); err != nil {
@@ -981,6 +1122,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "QueryUndelegationsByHeight",
 			Handler:    _Query_QueryUndelegationsByHeight_Handler,
 		},
+		{
+			MethodName: "QueryRedelegations",
+			Handler:    _Query_QueryRedelegations_Handler,
+		},
 		{
 			MethodName: "QueryAssociatedOperatorByStaker",
 			Handler:    _Query_QueryAssociatedOperatorByStaker_Handler,
@@ -1355,6 +1500,80 @@ func (m *UndelegationRecordList) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *RedelegationsReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RedelegationsReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RedelegationsReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AssetID) > 0 {
+		i -= len(m.AssetID)
+		copy(dAtA[i:], m.AssetID)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.AssetID)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.StakerID) > 0 {
+		i -= len(m.StakerID)
+		copy(dAtA[i:], m.StakerID)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.StakerID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RedelegationRecordList) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RedelegationRecordList) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RedelegationRecordList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Redelegations) > 0 {
+		for iNdEx := len(m.Redelegations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Redelegations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *QueryAssociatedOperatorByStakerReq) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1583,6 +1802,38 @@ func (m *UndelegationRecordList) Size() (n int) {
 	return n
 }
 
+func (m *RedelegationsReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.StakerID)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.AssetID)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *RedelegationRecordList) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Redelegations) > 0 {
+		for _, e := range m.Redelegations {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *QueryAssociatedOperatorByStakerReq) Size() (n int) {
 	if m == nil {
 		return 0
@@ -2672,6 +2923,204 @@ func (m *UndelegationRecordList) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *RedelegationsReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RedelegationsReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RedelegationsReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakerID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StakerID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AssetID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AssetID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RedelegationRecordList) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RedelegationRecordList: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RedelegationRecordList: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Redelegations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Redelegations = append(m.Redelegations, &RedelegationRecord{})
+			if err := m.Redelegations[len(m.Redelegations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *QueryAssociatedOperatorByStakerReq) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
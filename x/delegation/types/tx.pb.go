@@ -295,7 +295,8 @@ type UndelegationRecord struct {
 	// block_number is the block number on Exocore.
 	BlockNumber uint64 `protobuf:"varint,6,opt,name=block_number,json=blockNumber,proto3" json:"block_number,omitempty"`
 	// complete_block_number is the block number on Exocore at which the undelegation
-	// should be completed.
+	// should be completed. It's zero if the undelegation is completed at the end of the
+	// epoch specified by complete_epoch_identifier and complete_epoch_number.
 	CompleteBlockNumber uint64 `protobuf:"varint,7,opt,name=complete_block_number,json=completeBlockNumber,proto3" json:"complete_block_number,omitempty"`
 	// lz_tx_nonce is the nonce of the transaction on the client chain.
 	LzTxNonce uint64 `protobuf:"varint,8,opt,name=lz_tx_nonce,json=lzTxNonce,proto3" json:"lz_tx_nonce,omitempty"`
@@ -304,6 +305,14 @@ type UndelegationRecord struct {
 	// actual_completed_amount is the actual amount of the asset that has been
 	// undelegated so far. it may be lower than the amount in the case of slashing.
 	ActualCompletedAmount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,10,opt,name=actual_completed_amount,json=actualCompletedAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"actual_completed_amount"`
+	// complete_epoch_identifier is the identifier of the epoch at the end of which the
+	// undelegation should be completed. It's the epoch of the AVS with the longest unbonding
+	// period among the AVSs the operator is opted into, and empty if the operator isn't
+	// opted into any AVS.
+	CompleteEpochIdentifier string `protobuf:"bytes,11,opt,name=complete_epoch_identifier,json=completeEpochIdentifier,proto3" json:"complete_epoch_identifier,omitempty"`
+	// complete_epoch_number is the number of the epoch at the end of which the undelegation
+	// should be completed.
+	CompleteEpochNumber int64 `protobuf:"varint,12,opt,name=complete_epoch_number,json=completeEpochNumber,proto3" json:"complete_epoch_number,omitempty"`
 }
 
 func (m *UndelegationRecord) Reset()         { *m = UndelegationRecord{} }
@@ -395,6 +404,20 @@ func (m *UndelegationRecord) GetLzTxNonce() uint64 {
 	return 0
 }
 
+func (m *UndelegationRecord) GetCompleteEpochIdentifier() string {
+	if m != nil {
+		return m.CompleteEpochIdentifier
+	}
+	return ""
+}
+
+func (m *UndelegationRecord) GetCompleteEpochNumber() int64 {
+	if m != nil {
+		return m.CompleteEpochNumber
+	}
+	return 0
+}
+
 // UndelegationRecordKeyList is the list of undelegation records.
 type UndelegationRecordKeyList struct {
 	// key_list is the list of undelegation record keys.
@@ -570,6 +593,256 @@ func (m *UndelegationResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_UndelegationResponse proto.InternalMessageInfo
 
+// RedelegationRecord is the redelegation record, keyed by a RecordKey. The redelegated amount
+// remains exposed to the slashing of the source operator until the unbonding period of the
+// source operator ends, at which point the record is deleted.
+type RedelegationRecord struct {
+	// staker_id is the staker id.
+	StakerID string `protobuf:"bytes,1,opt,name=staker_id,json=stakerId,proto3" json:"staker_id,omitempty"`
+	// asset_id is the asset id.
+	AssetID string `protobuf:"bytes,2,opt,name=asset_id,json=assetId,proto3" json:"asset_id,omitempty"`
+	// src_operator_addr is the address of the operator from which the asset is redelegated.
+	SrcOperatorAddr string `protobuf:"bytes,3,opt,name=src_operator_addr,json=srcOperatorAddr,proto3" json:"src_operator_addr,omitempty"`
+	// dst_operator_addr is the address of the operator to which the asset is redelegated.
+	DstOperatorAddr string `protobuf:"bytes,4,opt,name=dst_operator_addr,json=dstOperatorAddr,proto3" json:"dst_operator_addr,omitempty"`
+	// tx_hash is the transaction hash.
+	TxHash string `protobuf:"bytes,5,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`
+	// block_number is the block number on Exocore.
+	BlockNumber uint64 `protobuf:"varint,6,opt,name=block_number,json=blockNumber,proto3" json:"block_number,omitempty"`
+	// complete_block_number is the block number on Exocore at which the slashing exposure
+	// to the source operator ends. It's zero if the exposure ends at the end of the epoch
+	// specified by complete_epoch_identifier and complete_epoch_number.
+	CompleteBlockNumber uint64 `protobuf:"varint,7,opt,name=complete_block_number,json=completeBlockNumber,proto3" json:"complete_block_number,omitempty"`
+	// complete_epoch_identifier is the identifier of the epoch at the end of which the
+	// slashing exposure to the source operator ends.
+	CompleteEpochIdentifier string `protobuf:"bytes,8,opt,name=complete_epoch_identifier,json=completeEpochIdentifier,proto3" json:"complete_epoch_identifier,omitempty"`
+	// complete_epoch_number is the number of the epoch at the end of which the slashing
+	// exposure to the source operator ends.
+	CompleteEpochNumber int64 `protobuf:"varint,9,opt,name=complete_epoch_number,json=completeEpochNumber,proto3" json:"complete_epoch_number,omitempty"`
+	// lz_tx_nonce is the nonce of the transaction.
+	LzTxNonce uint64 `protobuf:"varint,10,opt,name=lz_tx_nonce,json=lzTxNonce,proto3" json:"lz_tx_nonce,omitempty"`
+	// amount is the amount of the asset redelegated.
+	Amount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,11,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amount"`
+	// remaining_amount is the redelegated amount that can still be slashed for the source
+	// operator. It may be lower than the amount in the case of slashing.
+	RemainingAmount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,12,opt,name=remaining_amount,json=remainingAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"remaining_amount"`
+}
+
+func (m *RedelegationRecord) Reset()         { *m = RedelegationRecord{} }
+func (m *RedelegationRecord) String() string { return proto.CompactTextString(m) }
+func (*RedelegationRecord) ProtoMessage()    {}
+func (*RedelegationRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_16596a15a828f109, []int{10}
+}
+func (m *RedelegationRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RedelegationRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RedelegationRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RedelegationRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RedelegationRecord.Merge(m, src)
+}
+func (m *RedelegationRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *RedelegationRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_RedelegationRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RedelegationRecord proto.InternalMessageInfo
+
+func (m *RedelegationRecord) GetStakerID() string {
+	if m != nil {
+		return m.StakerID
+	}
+	return ""
+}
+
+func (m *RedelegationRecord) GetAssetID() string {
+	if m != nil {
+		return m.AssetID
+	}
+	return ""
+}
+
+func (m *RedelegationRecord) GetSrcOperatorAddr() string {
+	if m != nil {
+		return m.SrcOperatorAddr
+	}
+	return ""
+}
+
+func (m *RedelegationRecord) GetDstOperatorAddr() string {
+	if m != nil {
+		return m.DstOperatorAddr
+	}
+	return ""
+}
+
+func (m *RedelegationRecord) GetTxHash() string {
+	if m != nil {
+		return m.TxHash
+	}
+	return ""
+}
+
+func (m *RedelegationRecord) GetBlockNumber() uint64 {
+	if m != nil {
+		return m.BlockNumber
+	}
+	return 0
+}
+
+func (m *RedelegationRecord) GetCompleteBlockNumber() uint64 {
+	if m != nil {
+		return m.CompleteBlockNumber
+	}
+	return 0
+}
+
+func (m *RedelegationRecord) GetCompleteEpochIdentifier() string {
+	if m != nil {
+		return m.CompleteEpochIdentifier
+	}
+	return ""
+}
+
+func (m *RedelegationRecord) GetCompleteEpochNumber() int64 {
+	if m != nil {
+		return m.CompleteEpochNumber
+	}
+	return 0
+}
+
+func (m *RedelegationRecord) GetLzTxNonce() uint64 {
+	if m != nil {
+		return m.LzTxNonce
+	}
+	return 0
+}
+
+// MsgRedelegate is the Msg to redelegate an asset from one operator to another.
+type MsgRedelegate struct {
+	// from_address is the staker address
+	FromAddress string `protobuf:"bytes,1,opt,name=from_address,json=fromAddress,proto3" json:"from_address,omitempty"`
+	// asset_id is the identity of the asset.
+	AssetID string `protobuf:"bytes,2,opt,name=asset_id,json=assetId,proto3" json:"asset_id,omitempty"`
+	// src_operator is the address of the operator from which the asset is redelegated.
+	SrcOperator string `protobuf:"bytes,3,opt,name=src_operator,json=srcOperator,proto3" json:"src_operator,omitempty"`
+	// dst_operator is the address of the operator to which the asset is redelegated.
+	DstOperator string `protobuf:"bytes,4,opt,name=dst_operator,json=dstOperator,proto3" json:"dst_operator,omitempty"`
+	// amount is the amount of the asset to be redelegated.
+	Amount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,5,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amount"`
+}
+
+func (m *MsgRedelegate) Reset()         { *m = MsgRedelegate{} }
+func (m *MsgRedelegate) String() string { return proto.CompactTextString(m) }
+func (*MsgRedelegate) ProtoMessage()    {}
+func (*MsgRedelegate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_16596a15a828f109, []int{11}
+}
+func (m *MsgRedelegate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRedelegate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRedelegate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRedelegate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRedelegate.Merge(m, src)
+}
+func (m *MsgRedelegate) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRedelegate) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRedelegate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRedelegate proto.InternalMessageInfo
+
+func (m *MsgRedelegate) GetFromAddress() string {
+	if m != nil {
+		return m.FromAddress
+	}
+	return ""
+}
+
+func (m *MsgRedelegate) GetAssetID() string {
+	if m != nil {
+		return m.AssetID
+	}
+	return ""
+}
+
+func (m *MsgRedelegate) GetSrcOperator() string {
+	if m != nil {
+		return m.SrcOperator
+	}
+	return ""
+}
+
+func (m *MsgRedelegate) GetDstOperator() string {
+	if m != nil {
+		return m.DstOperator
+	}
+	return ""
+}
+
+// RedelegationResponse is the response to a redelegation request.
+type RedelegationResponse struct {
+}
+
+func (m *RedelegationResponse) Reset()         { *m = RedelegationResponse{} }
+func (m *RedelegationResponse) String() string { return proto.CompactTextString(m) }
+func (*RedelegationResponse) ProtoMessage()    {}
+func (*RedelegationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_16596a15a828f109, []int{12}
+}
+func (m *RedelegationResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RedelegationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RedelegationResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RedelegationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RedelegationResponse.Merge(m, src)
+}
+func (m *RedelegationResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *RedelegationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RedelegationResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RedelegationResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*ValueField)(nil), "exocore.delegation.v1.ValueField")
 	proto.RegisterType((*DelegatedSingleAssetInfo)(nil), "exocore.delegation.v1.DelegatedSingleAssetInfo")
@@ -581,67 +854,83 @@ func init() {
 	proto.RegisterType((*DelegationResponse)(nil), "exocore.delegation.v1.DelegationResponse")
 	proto.RegisterType((*MsgUndelegation)(nil), "exocore.delegation.v1.MsgUndelegation")
 	proto.RegisterType((*UndelegationResponse)(nil), "exocore.delegation.v1.UndelegationResponse")
+	proto.RegisterType((*RedelegationRecord)(nil), "exocore.delegation.v1.RedelegationRecord")
+	proto.RegisterType((*MsgRedelegate)(nil), "exocore.delegation.v1.MsgRedelegate")
+	proto.RegisterType((*RedelegationResponse)(nil), "exocore.delegation.v1.RedelegationResponse")
 }
 
 func init() { proto.RegisterFile("exocore/delegation/v1/tx.proto", fileDescriptor_16596a15a828f109) }
 
 var fileDescriptor_16596a15a828f109 = []byte{
-	// 866 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x55, 0x4f, 0x6f, 0xe3, 0x44,
-	0x14, 0x8f, 0x9b, 0xb6, 0x49, 0x5e, 0xba, 0x02, 0x86, 0x74, 0xeb, 0x06, 0x91, 0x64, 0x2d, 0xa8,
-	0xb2, 0x45, 0x8d, 0xb5, 0x05, 0x81, 0xc4, 0x9f, 0x43, 0x43, 0x58, 0x11, 0x96, 0x76, 0x91, 0xdb,
-	0x05, 0x89, 0x8b, 0xe5, 0x78, 0xa6, 0xae, 0x89, 0x3d, 0x63, 0xcd, 0x4c, 0x4a, 0xb2, 0x27, 0x04,
-	0x42, 0x42, 0x9c, 0xb8, 0x73, 0x59, 0xbe, 0x41, 0x0f, 0xfb, 0x21, 0xf6, 0xb8, 0xda, 0x13, 0xe2,
-	0x50, 0xa1, 0xf4, 0x50, 0x0e, 0x9c, 0xf8, 0x04, 0xc8, 0x1e, 0xbb, 0x71, 0x45, 0xc3, 0x22, 0xb1,
-	0xd2, 0x5e, 0x92, 0x99, 0xf7, 0xfb, 0xcd, 0x7b, 0xef, 0xf7, 0xe6, 0xf9, 0x0d, 0x34, 0xc8, 0x98,
-	0xb9, 0x8c, 0x13, 0x13, 0x93, 0x80, 0x78, 0x8e, 0xf4, 0x19, 0x35, 0x8f, 0x6f, 0x99, 0x72, 0xdc,
-	0x89, 0x38, 0x93, 0x0c, 0xad, 0xa6, 0x78, 0x67, 0x86, 0x77, 0x8e, 0x6f, 0xd5, 0x5f, 0x72, 0x42,
-	0x9f, 0x32, 0x33, 0xf9, 0x55, 0xcc, 0xfa, 0x9a, 0xcb, 0x44, 0xc8, 0x84, 0x19, 0x0a, 0x2f, 0xf6,
-	0x10, 0x0a, 0x2f, 0x05, 0xd6, 0x15, 0x60, 0x27, 0x3b, 0x53, 0x6d, 0x52, 0xa8, 0xe6, 0x31, 0x8f,
-	0x29, 0x7b, 0xbc, 0x52, 0x56, 0x63, 0x00, 0xf0, 0xb9, 0x13, 0x8c, 0xc8, 0x6d, 0x9f, 0x04, 0x18,
-	0x1d, 0xc0, 0xb2, 0x13, 0xb2, 0x11, 0x95, 0xba, 0xd6, 0xd2, 0xda, 0x95, 0xee, 0xfb, 0x8f, 0x4e,
-	0x9b, 0x85, 0xdf, 0x4e, 0x9b, 0x1b, 0x9e, 0x2f, 0x8f, 0x46, 0x83, 0x8e, 0xcb, 0xc2, 0xd4, 0x69,
-	0xfa, 0xb7, 0x25, 0xf0, 0xd0, 0x94, 0x93, 0x88, 0x88, 0x4e, 0x9f, 0xca, 0x27, 0x0f, 0xb7, 0x20,
-	0x8d, 0xd9, 0xa7, 0xd2, 0x4a, 0x7d, 0x19, 0x3f, 0x6b, 0xa0, 0xf7, 0x94, 0x24, 0x82, 0xf7, 0x7d,
-	0xea, 0x05, 0x64, 0x47, 0x08, 0x22, 0xfb, 0xf4, 0x90, 0xa1, 0x0d, 0x28, 0x3b, 0xf1, 0xc6, 0xf6,
-	0x71, 0x1a, 0xb4, 0x3a, 0x3d, 0x6d, 0x96, 0x14, 0xa1, 0x67, 0x95, 0x12, 0xb0, 0x8f, 0xd1, 0x17,
-	0x50, 0x8b, 0x08, 0xb7, 0x59, 0x44, 0xb8, 0x23, 0x19, 0xb7, 0x95, 0x6f, 0xa1, 0x17, 0x5b, 0xc5,
-	0x76, 0x75, 0xbb, 0xd9, 0xb9, 0xb2, 0x76, 0x9d, 0x3b, 0x64, 0x92, 0xc8, 0xeb, 0x2e, 0xc6, 0x4a,
-	0x2c, 0x14, 0x11, 0x7e, 0x37, 0xf5, 0xb0, 0xa3, 0x1c, 0x18, 0xf7, 0xa0, 0x9c, 0xb1, 0xd0, 0x8b,
-	0x50, 0x1c, 0x92, 0x89, 0xca, 0xc3, 0x8a, 0x97, 0xe8, 0x1d, 0x58, 0x3a, 0x8e, 0x21, 0x7d, 0xa1,
-	0xa5, 0xb5, 0xab, 0xdb, 0x37, 0xe6, 0xc4, 0x99, 0xd5, 0xd0, 0x52, 0x7c, 0xe3, 0x2f, 0x0d, 0xae,
-	0xf7, 0x2e, 0x38, 0x7d, 0xea, 0xde, 0xe5, 0x3d, 0xe2, 0x26, 0x92, 0xdf, 0x83, 0x95, 0x43, 0xce,
-	0x42, 0xdb, 0xc1, 0x98, 0x13, 0x21, 0x52, 0xd9, 0xfa, 0x93, 0x87, 0x5b, 0xb5, 0xb4, 0x7a, 0x3b,
-	0x0a, 0xd9, 0x97, 0xdc, 0xa7, 0x9e, 0x55, 0x8d, 0xd9, 0xa9, 0x69, 0x6e, 0x1d, 0x16, 0xfe, 0x67,
-	0x1d, 0xde, 0xed, 0xfe, 0xf0, 0xa0, 0x59, 0xf8, 0xe3, 0x41, 0xb3, 0xf0, 0xed, 0xf9, 0xc9, 0x66,
-	0x3e, 0xe4, 0x8f, 0xe7, 0x27, 0x9b, 0xaf, 0xe7, 0xae, 0x7b, 0x57, 0x78, 0x3b, 0x18, 0x27, 0x72,
-	0x38, 0x71, 0x04, 0x99, 0xa9, 0x34, 0xbe, 0xd3, 0xe0, 0xda, 0xae, 0xf0, 0x66, 0x96, 0xff, 0x7c,
-	0xbd, 0x9f, 0x40, 0x65, 0xe0, 0x08, 0x62, 0xfb, 0xf4, 0x90, 0xa5, 0xb5, 0xde, 0x9a, 0xa3, 0xe5,
-	0xea, 0xaa, 0x5a, 0xe5, 0xf8, 0x7c, 0xbc, 0x32, 0x7e, 0x59, 0x04, 0x74, 0x8f, 0xce, 0x0e, 0x59,
-	0xc4, 0x65, 0x1c, 0xa3, 0x9b, 0x50, 0x11, 0xd2, 0x19, 0x12, 0x3e, 0xcb, 0x65, 0x65, 0x7a, 0xda,
-	0x2c, 0xef, 0x27, 0xc6, 0x7e, 0xcf, 0x2a, 0x2b, 0xb8, 0x8f, 0x2f, 0x65, 0xbd, 0xf0, 0x2f, 0x59,
-	0x7f, 0x00, 0xd7, 0x66, 0x17, 0x81, 0x31, 0xd7, 0x8b, 0x4f, 0xb9, 0xca, 0x95, 0x8c, 0x1e, 0x9b,
-	0xd1, 0x1a, 0x94, 0xe4, 0xd8, 0x3e, 0x72, 0xc4, 0x91, 0xbe, 0x98, 0xb4, 0xdc, 0xb2, 0x1c, 0x7f,
-	0xec, 0x88, 0x23, 0xf4, 0x2a, 0x80, 0x2f, 0xec, 0x88, 0x50, 0xec, 0x53, 0x4f, 0x5f, 0x6a, 0x69,
-	0xed, 0xb2, 0x55, 0xf1, 0xc5, 0x67, 0xca, 0x80, 0x6e, 0xc0, 0xca, 0x20, 0x60, 0xee, 0xd0, 0xa6,
-	0xa3, 0x70, 0x40, 0xb8, 0xbe, 0xdc, 0xd2, 0xda, 0x8b, 0x56, 0x35, 0xb1, 0xed, 0x25, 0x26, 0xb4,
-	0x0d, 0xab, 0x2e, 0x0b, 0xa3, 0x80, 0x48, 0x62, 0x5f, 0xe2, 0x96, 0x12, 0xee, 0xcb, 0x19, 0xd8,
-	0xcd, 0x9d, 0x69, 0x40, 0x35, 0xb8, 0x6f, 0xcb, 0xb1, 0x4d, 0x19, 0x75, 0x89, 0x5e, 0x4e, 0x98,
-	0x95, 0xe0, 0xfe, 0xc1, 0x78, 0x2f, 0x36, 0xe4, 0xa6, 0x43, 0xe5, 0xd9, 0x4d, 0x07, 0x24, 0x61,
-	0xcd, 0x71, 0xe5, 0xc8, 0x09, 0xec, 0x2c, 0x27, 0x9c, 0x36, 0xb5, 0x0e, 0xcf, 0x20, 0xcc, 0xaa,
-	0x72, 0xfe, 0x61, 0xe6, 0x5b, 0xb5, 0xbb, 0xf1, 0x36, 0xac, 0xff, 0xb3, 0x45, 0xee, 0x90, 0xc9,
-	0xa7, 0xbe, 0x90, 0x68, 0x1d, 0xca, 0x43, 0x32, 0xb1, 0x03, 0x5f, 0xc4, 0x83, 0xb0, 0xd8, 0xae,
-	0x58, 0xa5, 0xa1, 0x82, 0x8c, 0x1a, 0xa0, 0x5e, 0xee, 0x94, 0x88, 0x18, 0x15, 0xc4, 0xf8, 0x5e,
-	0x83, 0x17, 0x76, 0x85, 0x97, 0xf7, 0xf8, 0x5c, 0x3a, 0xff, 0x3a, 0xd4, 0x2e, 0xab, 0x52, 0xf9,
-	0x6d, 0xff, 0xa9, 0x41, 0x71, 0x57, 0x78, 0xe8, 0x2b, 0x58, 0xcb, 0x06, 0x71, 0x92, 0xc7, 0x01,
-	0xcb, 0xa6, 0x00, 0x7a, 0x6d, 0x4e, 0xcc, 0x4b, 0x9f, 0x73, 0xfd, 0xe6, 0x53, 0x33, 0xcb, 0x62,
-	0x22, 0x0e, 0xaf, 0x5c, 0xe4, 0xa2, 0xa2, 0xdd, 0xe6, 0x2c, 0xbc, 0x88, 0xb7, 0x31, 0x3f, 0x5e,
-	0x5e, 0x42, 0xfd, 0x8d, 0x39, 0xbc, 0xab, 0x74, 0xd6, 0x97, 0xbe, 0x39, 0x3f, 0xd9, 0xd4, 0xba,
-	0x7b, 0x8f, 0xa6, 0x0d, 0xed, 0xf1, 0xb4, 0xa1, 0xfd, 0x3e, 0x6d, 0x68, 0x3f, 0x9d, 0x35, 0x0a,
-	0x8f, 0xcf, 0x1a, 0x85, 0x5f, 0xcf, 0x1a, 0x85, 0x2f, 0xdf, 0xca, 0xf5, 0xd0, 0x47, 0xca, 0xef,
-	0x1e, 0x91, 0x5f, 0x33, 0x3e, 0x34, 0xb3, 0xc7, 0x79, 0x9c, 0x7f, 0x9e, 0x93, 0xae, 0x1a, 0x2c,
-	0x27, 0x6f, 0xe5, 0x9b, 0x7f, 0x07, 0x00, 0x00, 0xff, 0xff, 0xd2, 0x32, 0x66, 0x81, 0xc1, 0x07,
-	0x00, 0x00,
+	// 1082 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x57, 0x41, 0x6f, 0x1b, 0x45,
+	0x14, 0xf6, 0xc6, 0x71, 0x62, 0x3f, 0xbb, 0x4a, 0x33, 0x24, 0xcd, 0x26, 0x08, 0xdb, 0xb5, 0x20,
+	0x72, 0x53, 0xc5, 0x56, 0x03, 0x02, 0x29, 0x85, 0x43, 0x82, 0x5b, 0x61, 0x4a, 0x12, 0xb4, 0x49,
+	0x41, 0xe2, 0xb2, 0x5a, 0xef, 0x4c, 0x36, 0x8b, 0xbd, 0x33, 0xd6, 0xcc, 0x24, 0x38, 0x3d, 0x21,
+	0x10, 0x12, 0xe2, 0x04, 0x67, 0x2e, 0xfd, 0x09, 0x11, 0xea, 0x8f, 0xe8, 0xb1, 0xca, 0x09, 0x71,
+	0x88, 0x50, 0x72, 0x08, 0x57, 0xf8, 0x05, 0x68, 0x77, 0x76, 0xed, 0x75, 0xc9, 0x26, 0x85, 0x1a,
+	0xb8, 0x24, 0xbb, 0xef, 0xbd, 0x79, 0x6f, 0xde, 0xf7, 0xbe, 0xf9, 0x3c, 0x0b, 0x45, 0xd2, 0x63,
+	0x36, 0xe3, 0xa4, 0x8e, 0x49, 0x87, 0x38, 0x96, 0x74, 0x19, 0xad, 0x1f, 0xdc, 0xa9, 0xcb, 0x5e,
+	0xad, 0xcb, 0x99, 0x64, 0x68, 0x36, 0xf4, 0xd7, 0x06, 0xfe, 0xda, 0xc1, 0x9d, 0x85, 0x69, 0xcb,
+	0x73, 0x29, 0xab, 0x07, 0x7f, 0x55, 0xe4, 0xc2, 0x9c, 0xcd, 0x84, 0xc7, 0x44, 0xdd, 0x13, 0x8e,
+	0x9f, 0xc1, 0x13, 0x4e, 0xe8, 0x98, 0x57, 0x0e, 0x33, 0x78, 0xab, 0xab, 0x97, 0xd0, 0x35, 0xe3,
+	0x30, 0x87, 0x29, 0xbb, 0xff, 0xa4, 0xac, 0x95, 0x16, 0xc0, 0x27, 0x56, 0x67, 0x9f, 0xdc, 0x77,
+	0x49, 0x07, 0xa3, 0x1d, 0x98, 0xb0, 0x3c, 0xb6, 0x4f, 0xa5, 0xae, 0x95, 0xb5, 0x6a, 0x6e, 0xfd,
+	0xdd, 0xa7, 0x27, 0xa5, 0xd4, 0x2f, 0x27, 0xa5, 0x45, 0xc7, 0x95, 0x7b, 0xfb, 0xad, 0x9a, 0xcd,
+	0xbc, 0x30, 0x69, 0xf8, 0x6f, 0x59, 0xe0, 0x76, 0x5d, 0x1e, 0x76, 0x89, 0xa8, 0x35, 0xa9, 0x3c,
+	0x7e, 0xb2, 0x0c, 0x61, 0xcd, 0x26, 0x95, 0x46, 0x98, 0xab, 0xf2, 0xa3, 0x06, 0x7a, 0x43, 0xb5,
+	0x44, 0xf0, 0xb6, 0x4b, 0x9d, 0x0e, 0x59, 0x13, 0x82, 0xc8, 0x26, 0xdd, 0x65, 0x68, 0x11, 0xb2,
+	0x96, 0xff, 0x62, 0xba, 0x38, 0x2c, 0x9a, 0x3f, 0x3d, 0x29, 0x4d, 0xaa, 0x80, 0x86, 0x31, 0x19,
+	0x38, 0x9b, 0x18, 0x7d, 0x0a, 0x33, 0x5d, 0xc2, 0x4d, 0xd6, 0x25, 0xdc, 0x92, 0x8c, 0x9b, 0x2a,
+	0xb7, 0xd0, 0xd3, 0xe5, 0x74, 0x35, 0xbf, 0x52, 0xaa, 0x5d, 0x88, 0x5d, 0xed, 0x01, 0x39, 0x0c,
+	0xda, 0x5b, 0x1f, 0xf7, 0x3b, 0x31, 0x50, 0x97, 0xf0, 0xad, 0x30, 0xc3, 0x9a, 0x4a, 0x50, 0x79,
+	0x08, 0xd9, 0x28, 0x0a, 0x5d, 0x87, 0x74, 0x9b, 0x1c, 0xaa, 0x7d, 0x18, 0xfe, 0x23, 0x7a, 0x07,
+	0x32, 0x07, 0xbe, 0x4b, 0x1f, 0x2b, 0x6b, 0xd5, 0xfc, 0xca, 0xcd, 0x84, 0x3a, 0x03, 0x0c, 0x0d,
+	0x15, 0x5f, 0xf9, 0x43, 0x83, 0x1b, 0x8d, 0x7e, 0x4c, 0x93, 0xda, 0x5b, 0xbc, 0x41, 0xec, 0xa0,
+	0xe5, 0xbb, 0x50, 0xd8, 0xe5, 0xcc, 0x33, 0x2d, 0x8c, 0x39, 0x11, 0x22, 0x6c, 0x5b, 0x3f, 0x7e,
+	0xb2, 0x3c, 0x13, 0xa2, 0xb7, 0xa6, 0x3c, 0xdb, 0x92, 0xbb, 0xd4, 0x31, 0xf2, 0x7e, 0x74, 0x68,
+	0x4a, 0xc4, 0x61, 0xec, 0x25, 0x71, 0x58, 0x5d, 0xff, 0xf6, 0x71, 0x29, 0xf5, 0xdb, 0xe3, 0x52,
+	0xea, 0xab, 0xf3, 0xa3, 0xa5, 0x78, 0xc9, 0xef, 0xce, 0x8f, 0x96, 0xde, 0x88, 0x8d, 0x7b, 0x43,
+	0x38, 0x6b, 0x18, 0x07, 0xed, 0x70, 0x62, 0x09, 0x32, 0xe8, 0xb2, 0xf2, 0xb5, 0x06, 0xd7, 0x36,
+	0x84, 0x33, 0xb0, 0xbc, 0xf0, 0x78, 0x3f, 0x84, 0x5c, 0xcb, 0x12, 0xc4, 0x74, 0xe9, 0x2e, 0x0b,
+	0xb1, 0x5e, 0x4e, 0xe8, 0xe5, 0x62, 0x54, 0x8d, 0xac, 0xbf, 0xde, 0x7f, 0xaa, 0xfc, 0x90, 0x01,
+	0xf4, 0x90, 0x0e, 0x16, 0x19, 0xc4, 0x66, 0x1c, 0xa3, 0x5b, 0x90, 0x13, 0xd2, 0x6a, 0x13, 0x3e,
+	0xd8, 0x4b, 0xe1, 0xf4, 0xa4, 0x94, 0xdd, 0x0e, 0x8c, 0xcd, 0x86, 0x91, 0x55, 0xee, 0x26, 0x1e,
+	0xda, 0xf5, 0xd8, 0x25, 0xbb, 0x7e, 0x0f, 0xae, 0x0d, 0x06, 0x81, 0x31, 0xd7, 0xd3, 0x57, 0x8c,
+	0xb2, 0x10, 0x85, 0xfb, 0x66, 0x34, 0x07, 0x93, 0xb2, 0x67, 0xee, 0x59, 0x62, 0x4f, 0x1f, 0x0f,
+	0x28, 0x37, 0x21, 0x7b, 0x1f, 0x58, 0x62, 0x0f, 0xbd, 0x06, 0xe0, 0x0a, 0xb3, 0x4b, 0x28, 0x76,
+	0xa9, 0xa3, 0x67, 0xca, 0x5a, 0x35, 0x6b, 0xe4, 0x5c, 0xf1, 0xb1, 0x32, 0xa0, 0x9b, 0x50, 0x68,
+	0x75, 0x98, 0xdd, 0x36, 0xe9, 0xbe, 0xd7, 0x22, 0x5c, 0x9f, 0x28, 0x6b, 0xd5, 0x71, 0x23, 0x1f,
+	0xd8, 0x36, 0x03, 0x13, 0x5a, 0x81, 0x59, 0x9b, 0x79, 0xdd, 0x0e, 0x91, 0xc4, 0x1c, 0x8a, 0x9d,
+	0x0c, 0x62, 0x5f, 0x89, 0x9c, 0xeb, 0xb1, 0x35, 0x45, 0xc8, 0x77, 0x1e, 0x99, 0xb2, 0x67, 0x52,
+	0x46, 0x6d, 0xa2, 0x67, 0x83, 0xc8, 0x5c, 0xe7, 0xd1, 0x4e, 0x6f, 0xd3, 0x37, 0xc4, 0xd4, 0x21,
+	0x37, 0x3a, 0x75, 0x40, 0x12, 0xe6, 0x2c, 0x5b, 0xee, 0x5b, 0x1d, 0x33, 0xda, 0x13, 0x0e, 0x49,
+	0xad, 0xc3, 0x08, 0xca, 0xcc, 0xaa, 0xe4, 0xef, 0x47, 0xb9, 0x15, 0xdd, 0xd1, 0x2a, 0xcc, 0xf7,
+	0xf1, 0x21, 0x5d, 0x66, 0xef, 0x99, 0x2e, 0x26, 0x54, 0xba, 0xbb, 0x2e, 0xe1, 0x7a, 0x3e, 0x18,
+	0xc6, 0x5c, 0x14, 0x70, 0xcf, 0xf7, 0x37, 0xfb, 0xee, 0x21, 0x6c, 0xd5, 0xda, 0x10, 0xdb, 0x42,
+	0x59, 0xab, 0xa6, 0x07, 0xd8, 0x06, 0xeb, 0x14, 0xb6, 0x95, 0xb7, 0x61, 0xfe, 0xaf, 0x94, 0x7c,
+	0x40, 0x0e, 0x3f, 0x72, 0x85, 0x44, 0xf3, 0x90, 0x6d, 0x93, 0x43, 0xb3, 0xe3, 0x0a, 0x5f, 0x78,
+	0xd3, 0xd5, 0x9c, 0x31, 0xd9, 0x56, 0xae, 0xca, 0x0c, 0xa0, 0x46, 0x6c, 0x95, 0xe8, 0x32, 0x2a,
+	0x48, 0xe5, 0x1b, 0x0d, 0xa6, 0x36, 0x84, 0x13, 0xcf, 0xf8, 0xbf, 0x9c, 0xb4, 0x1b, 0x30, 0x33,
+	0xdc, 0x55, 0xb8, 0xbf, 0x9f, 0x32, 0x80, 0x0c, 0xf2, 0x5f, 0x9c, 0xc0, 0x06, 0x4c, 0x0b, 0x6e,
+	0x9b, 0x7f, 0xef, 0x14, 0x4e, 0x09, 0x6e, 0x6f, 0xc5, 0x0f, 0x62, 0x03, 0xa6, 0xb1, 0x90, 0xcf,
+	0x65, 0x19, 0xbf, 0x2a, 0x0b, 0x16, 0x72, 0x2b, 0xe1, 0x38, 0x67, 0x86, 0x8e, 0xf3, 0xbf, 0x74,
+	0x5e, 0x2f, 0xe5, 0x70, 0xf6, 0x1f, 0x72, 0x38, 0x97, 0xc8, 0xe1, 0xe7, 0xf5, 0x01, 0x92, 0xf5,
+	0x21, 0x3f, 0x42, 0x7d, 0x70, 0xe0, 0x3a, 0x27, 0x9e, 0xe5, 0x52, 0x97, 0x3a, 0x91, 0x30, 0x14,
+	0x46, 0x90, 0x7f, 0xaa, 0x9f, 0x55, 0x49, 0x42, 0xe5, 0xf7, 0xb1, 0xe0, 0xc7, 0xab, 0xcf, 0x5b,
+	0xf2, 0x72, 0x3f, 0xd4, 0x2f, 0xca, 0xe0, 0xbb, 0x50, 0x88, 0x33, 0xf8, 0x4a, 0xf2, 0xe6, 0x63,
+	0xe4, 0xf5, 0x17, 0xc7, 0x89, 0x7b, 0x25, 0x67, 0xf3, 0x31, 0xce, 0xc6, 0xe6, 0x95, 0x19, 0xdd,
+	0xbc, 0x56, 0x6f, 0xfb, 0xf7, 0x87, 0x21, 0xdc, 0xfc, 0x0b, 0x44, 0x74, 0xb7, 0xad, 0x0f, 0x21,
+	0xec, 0x0b, 0xc8, 0xb0, 0x4e, 0x28, 0x01, 0x59, 0x39, 0x1e, 0x83, 0xf4, 0x86, 0x70, 0xd0, 0xe7,
+	0x30, 0x17, 0xdd, 0x1c, 0x03, 0xe0, 0x76, 0x58, 0x7f, 0xf7, 0xaf, 0x27, 0x88, 0xd6, 0xd0, 0xfd,
+	0x63, 0xe1, 0xd6, 0x95, 0xd2, 0x16, 0xd5, 0x44, 0x1c, 0x5e, 0xed, 0x8b, 0x99, 0xaa, 0x76, 0x9f,
+	0x33, 0xaf, 0x5f, 0x6f, 0x31, 0xb9, 0x5e, 0x5c, 0x03, 0x17, 0x6e, 0x27, 0xc4, 0x5d, 0x24, 0x94,
+	0x08, 0xc3, 0xd4, 0x00, 0x8d, 0xa0, 0xe6, 0x65, 0x7d, 0x0d, 0x42, 0x13, 0xab, 0x5c, 0x84, 0xe6,
+	0x42, 0xe6, 0xcb, 0xf3, 0xa3, 0x25, 0x6d, 0x7d, 0xf3, 0xe9, 0x69, 0x51, 0x7b, 0x76, 0x5a, 0xd4,
+	0x7e, 0x3d, 0x2d, 0x6a, 0xdf, 0x9f, 0x15, 0x53, 0xcf, 0xce, 0x8a, 0xa9, 0x9f, 0xcf, 0x8a, 0xa9,
+	0xcf, 0xde, 0x8a, 0x4d, 0xfc, 0x9e, 0xca, 0xbb, 0x49, 0xe4, 0x17, 0x8c, 0xb7, 0xeb, 0xd1, 0xdc,
+	0x7a, 0xf1, 0xaf, 0x96, 0x80, 0x03, 0xad, 0x89, 0xe0, 0x13, 0xe2, 0xcd, 0x3f, 0x03, 0x00, 0x00,
+	0xff, 0xff, 0x90, 0xbf, 0xd6, 0xdf, 0xd8, 0x0c, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -660,6 +949,8 @@ type MsgClient interface {
 	DelegateAssetToOperator(ctx context.Context, in *MsgDelegation, opts ...grpc.CallOption) (*DelegationResponse, error)
 	// UndelegateAssetFromOperator undelegates asset from operator.
 	UndelegateAssetFromOperator(ctx context.Context, in *MsgUndelegation, opts ...grpc.CallOption) (*UndelegationResponse, error)
+	// RedelegateAsset redelegates asset from one operator to another without unbonding.
+	RedelegateAsset(ctx context.Context, in *MsgRedelegate, opts ...grpc.CallOption) (*RedelegationResponse, error)
 }
 
 type msgClient struct {
@@ -688,12 +979,23 @@ func (c *msgClient) UndelegateAssetFromOperator(ctx context.Context, in *MsgUnde
 	return out, nil
 }
 
+func (c *msgClient) RedelegateAsset(ctx context.Context, in *MsgRedelegate, opts ...grpc.CallOption) (*RedelegationResponse, error) {
+	out := new(RedelegationResponse)
+	err := c.cc.Invoke(ctx, "/exocore.delegation.v1.Msg/RedelegateAsset", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// DelegateAssetToOperator delegates asset to operator.
 	DelegateAssetToOperator(context.Context, *MsgDelegation) (*DelegationResponse, error)
 	// UndelegateAssetFromOperator undelegates asset from operator.
 	UndelegateAssetFromOperator(context.Context, *MsgUndelegation) (*UndelegationResponse, error)
+	// RedelegateAsset redelegates asset from one operator to another without unbonding.
+	RedelegateAsset(context.Context, *MsgRedelegate) (*RedelegationResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -706,6 +1008,9 @@ func (*UnimplementedMsgServer) DelegateAssetToOperator(ctx context.Context, req
 func (*UnimplementedMsgServer) UndelegateAssetFromOperator(ctx context.Context, req *MsgUndelegation) (*UndelegationResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UndelegateAssetFromOperator not implemented")
 }
+func (*UnimplementedMsgServer) RedelegateAsset(ctx context.Context, req *MsgRedelegate) (*RedelegationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RedelegateAsset not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -747,6 +1052,24 @@ func _Msg_UndelegateAssetFromOperator_Handler(srv interface{}, ctx context.Conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_RedelegateAsset_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRedelegate)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RedelegateAsset(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/exocore.delegation.v1.Msg/RedelegateAsset",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RedelegateAsset(ctx, req.(*MsgRedelegate))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "exocore.delegation.v1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -759,6 +1082,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "UndelegateAssetFromOperator",
 			Handler:    _Msg_UndelegateAssetFromOperator_Handler,
 		},
+		{
+			MethodName: "RedelegateAsset",
+			Handler:    _Msg_RedelegateAsset_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "exocore/delegation/v1/tx.proto",
@@ -989,6 +1316,18 @@ func (m *UndelegationRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.CompleteEpochNumber != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.CompleteEpochNumber))
+		i--
+		dAtA[i] = 0x60
+	}
+	if len(m.CompleteEpochIdentifier) > 0 {
+		i -= len(m.CompleteEpochIdentifier)
+		copy(dAtA[i:], m.CompleteEpochIdentifier)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.CompleteEpochIdentifier)))
+		i--
+		dAtA[i] = 0x5a
+	}
 	{
 		size := m.ActualCompletedAmount.Size()
 		i -= size
@@ -1185,59 +1524,248 @@ func (m *UndelegationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *RedelegationRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *ValueField) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Amount.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+
+func (m *RedelegationRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *DelegatedSingleAssetInfo) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *RedelegationRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.AssetID)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	{
+		size := m.RemainingAmount.Size()
+		i -= size
+		if _, err := m.RemainingAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	if len(m.PerOperatorAmounts) > 0 {
-		for _, e := range m.PerOperatorAmounts {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
+	i--
+	dAtA[i] = 0x62
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	return n
-}
-
-func (m *KeyValue) Size() (n int) {
-	if m == nil {
-		return 0
+	i--
+	dAtA[i] = 0x5a
+	if m.LzTxNonce != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.LzTxNonce))
+		i--
+		dAtA[i] = 0x50
 	}
-	var l int
-	_ = l
-	l = len(m.Key)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if m.CompleteEpochNumber != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.CompleteEpochNumber))
+		i--
+		dAtA[i] = 0x48
 	}
-	if m.Value != nil {
-		l = m.Value.Size()
+	if len(m.CompleteEpochIdentifier) > 0 {
+		i -= len(m.CompleteEpochIdentifier)
+		copy(dAtA[i:], m.CompleteEpochIdentifier)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.CompleteEpochIdentifier)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.CompleteBlockNumber != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.CompleteBlockNumber))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.BlockNumber != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.BlockNumber))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.TxHash) > 0 {
+		i -= len(m.TxHash)
+		copy(dAtA[i:], m.TxHash)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.TxHash)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.DstOperatorAddr) > 0 {
+		i -= len(m.DstOperatorAddr)
+		copy(dAtA[i:], m.DstOperatorAddr)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.DstOperatorAddr)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.SrcOperatorAddr) > 0 {
+		i -= len(m.SrcOperatorAddr)
+		copy(dAtA[i:], m.SrcOperatorAddr)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.SrcOperatorAddr)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.AssetID) > 0 {
+		i -= len(m.AssetID)
+		copy(dAtA[i:], m.AssetID)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.AssetID)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.StakerID) > 0 {
+		i -= len(m.StakerID)
+		copy(dAtA[i:], m.StakerID)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.StakerID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRedelegate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRedelegate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRedelegate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if len(m.DstOperator) > 0 {
+		i -= len(m.DstOperator)
+		copy(dAtA[i:], m.DstOperator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.DstOperator)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.SrcOperator) > 0 {
+		i -= len(m.SrcOperator)
+		copy(dAtA[i:], m.SrcOperator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.SrcOperator)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.AssetID) > 0 {
+		i -= len(m.AssetID)
+		copy(dAtA[i:], m.AssetID)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.AssetID)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.FromAddress) > 0 {
+		i -= len(m.FromAddress)
+		copy(dAtA[i:], m.FromAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.FromAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RedelegationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RedelegationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RedelegationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ValueField) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *DelegatedSingleAssetInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.AssetID)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.PerOperatorAmounts) > 0 {
+		for _, e := range m.PerOperatorAmounts {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *KeyValue) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Value != nil {
+		l = m.Value.Size()
 		n += 1 + l + sovTx(uint64(l))
 	}
 	return n
@@ -1317,6 +1845,13 @@ func (m *UndelegationRecord) Size() (n int) {
 	n += 1 + l + sovTx(uint64(l))
 	l = m.ActualCompletedAmount.Size()
 	n += 1 + l + sovTx(uint64(l))
+	l = len(m.CompleteEpochIdentifier)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.CompleteEpochNumber != 0 {
+		n += 1 + sovTx(uint64(m.CompleteEpochNumber))
+	}
 	return n
 }
 
@@ -1370,6 +1905,91 @@ func (m *UndelegationResponse) Size() (n int) {
 	return n
 }
 
+func (m *RedelegationRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.StakerID)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.AssetID)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.SrcOperatorAddr)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.DstOperatorAddr)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.TxHash)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.BlockNumber != 0 {
+		n += 1 + sovTx(uint64(m.BlockNumber))
+	}
+	if m.CompleteBlockNumber != 0 {
+		n += 1 + sovTx(uint64(m.CompleteBlockNumber))
+	}
+	l = len(m.CompleteEpochIdentifier)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.CompleteEpochNumber != 0 {
+		n += 1 + sovTx(uint64(m.CompleteEpochNumber))
+	}
+	if m.LzTxNonce != 0 {
+		n += 1 + sovTx(uint64(m.LzTxNonce))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.RemainingAmount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgRedelegate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FromAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.AssetID)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.SrcOperator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.DstOperator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *RedelegationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -2230,21 +2850,72 @@ func (m *UndelegationRecord) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTx(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthTx
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompleteEpochIdentifier", wireType)
 			}
-			iNdEx += skippy
-		}
-	}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CompleteEpochIdentifier = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompleteEpochNumber", wireType)
+			}
+			m.CompleteEpochNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CompleteEpochNumber |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
 	if iNdEx > l {
 		return io.ErrUnexpectedEOF
@@ -2551,6 +3222,654 @@ func (m *UndelegationResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *RedelegationRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RedelegationRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RedelegationRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakerID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StakerID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AssetID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AssetID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SrcOperatorAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SrcOperatorAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DstOperatorAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DstOperatorAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockNumber", wireType)
+			}
+			m.BlockNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockNumber |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompleteBlockNumber", wireType)
+			}
+			m.CompleteBlockNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CompleteBlockNumber |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompleteEpochIdentifier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CompleteEpochIdentifier = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompleteEpochNumber", wireType)
+			}
+			m.CompleteEpochNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CompleteEpochNumber |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LzTxNonce", wireType)
+			}
+			m.LzTxNonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LzTxNonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemainingAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RemainingAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRedelegate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRedelegate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRedelegate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FromAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AssetID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AssetID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SrcOperator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SrcOperator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DstOperator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DstOperator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RedelegationResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RedelegationResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RedelegationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
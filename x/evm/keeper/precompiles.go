@@ -9,10 +9,12 @@ import (
 	avsManagerPrecompile "github.com/ExocoreNetwork/exocore/precompiles/avs"
 	blsPrecompile "github.com/ExocoreNetwork/exocore/precompiles/bls"
 	delegationprecompile "github.com/ExocoreNetwork/exocore/precompiles/delegation"
+	oraclePrecompile "github.com/ExocoreNetwork/exocore/precompiles/oracle"
 	rewardPrecompile "github.com/ExocoreNetwork/exocore/precompiles/reward"
 	stakingStateKeeper "github.com/ExocoreNetwork/exocore/x/assets/keeper"
 	avsManagerKeeper "github.com/ExocoreNetwork/exocore/x/avs/keeper"
 	delegationKeeper "github.com/ExocoreNetwork/exocore/x/delegation/keeper"
+	oracleKeeper "github.com/ExocoreNetwork/exocore/x/oracle/keeper"
 	rewardKeeper "github.com/ExocoreNetwork/exocore/x/reward/keeper"
 	exoslashKeeper "github.com/ExocoreNetwork/exocore/x/slash/keeper"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -39,6 +41,7 @@ func AvailablePrecompiles(
 	_ exoslashKeeper.Keeper,
 	rewardKeeper rewardKeeper.Keeper,
 	avsManagerKeeper avsManagerKeeper.Keeper,
+	oracleKeeper oracleKeeper.Keeper,
 ) map[common.Address]vm.PrecompiledContract {
 	// Clone the mapping from the latest EVM fork.
 	precompiles := maps.Clone(vm.PrecompiledContractsBerlin)
@@ -93,6 +96,10 @@ func AvailablePrecompiles(
 	if err != nil {
 		panic(fmt.Errorf("failed to load bls precompile: %v", err))
 	}
+	oraclePrecompile, err := oraclePrecompile.NewPrecompile(oracleKeeper)
+	if err != nil {
+		panic(fmt.Errorf("failed to load oracle precompile: %w", err))
+	}
 	// precompiles[slashPrecompile.Address()] = slashPrecompile
 	precompiles[rewardPrecompile.Address()] = rewardPrecompile
 	precompiles[assetsPrecompile.Address()] = assetsPrecompile
@@ -100,6 +107,7 @@ func AvailablePrecompiles(
 	precompiles[avsManagerPrecompile.Address()] = avsManagerPrecompile
 	// precompiles[ibcTransferPrecompile.Address()] = ibcTransferPrecompile
 	precompiles[blsPrecompile.Address()] = blsPrecompile
+	precompiles[oraclePrecompile.Address()] = oraclePrecompile
 	return precompiles
 }
 
@@ -20,6 +20,7 @@ var (
 		// the function has been merged to the assets precompile
 		"0x0000000000000000000000000000000000000809", // bls precompile
 		"0x0000000000000000000000000000000000000901", // avs precompile
+		"0x0000000000000000000000000000000000000902", // oracle precompile
 	}
 )
 
@@ -51,6 +51,7 @@ func GetQueryCmd() *cobra.Command {
 	}
 
 	cmd.AddCommand(
+		QueryParams(),
 		GetOperatorInfo(),
 		GetAllOperators(),
 		GetOperatorConsKey(),
@@ -67,6 +68,32 @@ func GetQueryCmd() *cobra.Command {
 	return cmd
 }
 
+// QueryParams queries the operator module parameters
+func QueryParams() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "params",
+		Short: "Get the operator module parameters",
+		Long:  "Get the operator module parameters",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := operatortypes.NewQueryClient(clientCtx)
+			res, err := queryClient.Params(context.Background(), &operatortypes.QueryParamsRequest{})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(&res.Params)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
 // GetOperatorInfo queries operator info
 func GetOperatorInfo() *cobra.Command {
 	cmd := &cobra.Command{
@@ -37,6 +37,7 @@ func NewTxCmd() *cobra.Command {
 
 	txCmd.AddCommand(
 		CmdRegisterOperator(),
+		CmdEditOperator(),
 		CmdOptIntoAVS(),
 		CmdOptOutOfAVS(),
 		// TODO: while the operator module is storing the consensus keys for now
@@ -129,12 +130,116 @@ func newBuildRegisterOperatorMsg(
 			OperatorMetaInfo: metaInfo,
 		},
 	}
-	clientChainEarningAddress := &types.ClientChainEarningAddrList{}
 	// #nosec G703
 	ccData, _ := fs.GetStringArray(FlagClientChainData)
-	clientChainEarningAddress.EarningInfoList = make(
-		[]*types.ClientChainEarningAddrInfo, len(ccData),
+	earningInfoList, err := parseClientChainData(ccData)
+	if err != nil {
+		return nil, err
+	}
+	msg.Info.ClientChainEarningsAddr = &types.ClientChainEarningAddrList{
+		EarningInfoList: earningInfoList,
+	}
+	// get the initial commission parameters
+	// #nosec G703
+	rateStr, _ := fs.GetString(stakingcli.FlagCommissionRate)
+	// #nosec G703
+	maxRateStr, _ := fs.GetString(stakingcli.FlagCommissionMaxRate)
+	// #nosec G703
+	maxChangeRateStr, _ := fs.GetString(stakingcli.FlagCommissionMaxChangeRate)
+	commission, err := buildCommission(rateStr, maxRateStr, maxChangeRateStr)
+	if err != nil {
+		return nil, err
+	}
+	msg.Info.Commission = commission
+	return msg, nil
+}
+
+// CmdEditOperator returns a CLI command handler for creating an EditOperatorReq transaction.
+func CmdEditOperator() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "edit-operator",
+		Short: "edit an existing operator",
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			txf, err := tx.NewFactoryCLI(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			msg, err := newBuildEditOperatorMsg(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			// this calls ValidateBasic internally so we don't need to do that.
+			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf, msg)
+		},
+	}
+
+	f := cmd.Flags()
+	f.String(
+		FlagApproveAddr, "", "The new address which is used to approve the delegations made to "+
+			"the operator. If not provided, it will be left unchanged.",
 	)
+	f.String(
+		FlagMetaInfo, "", "The operator's new meta info (like name). If not provided, it will be left unchanged.",
+	)
+	// clientChainLzID:ClientChainEarningsAddr
+	f.StringArray(
+		FlagClientChainData, []string{}, "The client chain's address to receive earnings, which is "+
+			"appended to the existing ones; can be supplied multiple times. "+
+			"Format: <client-chain-id>:<client-chain-earnings-addr>",
+	)
+	f.String(
+		stakingcli.FlagCommissionRate, "", "The new commission rate percentage. "+
+			"If not provided, it will be left unchanged.",
+	)
+
+	// transaction level flags from the SDK
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func newBuildEditOperatorMsg(
+	clientCtx client.Context, fs *flag.FlagSet,
+) (*types.EditOperatorReq, error) {
+	// #nosec G703 // this only errors if the flag isn't defined.
+	approveAddr, _ := fs.GetString(FlagApproveAddr)
+	// #nosec G703 // this only errors if the flag isn't defined.
+	metaInfo, _ := fs.GetString(FlagMetaInfo)
+	msg := &types.EditOperatorReq{
+		FromAddress:      clientCtx.GetFromAddress().String(),
+		OperatorMetaInfo: metaInfo,
+		ApproveAddr:      approveAddr,
+	}
+	// #nosec G703
+	ccData, _ := fs.GetStringArray(FlagClientChainData)
+	earningInfoList, err := parseClientChainData(ccData)
+	if err != nil {
+		return nil, err
+	}
+	msg.ClientChainEarningsAddr = earningInfoList
+	// #nosec G703
+	rateStr, _ := fs.GetString(stakingcli.FlagCommissionRate)
+	if rateStr != "" {
+		rate, err := sdk.NewDecFromStr(rateStr)
+		if err != nil {
+			return nil, err
+		}
+		msg.CommissionRate = &rate
+	}
+	return msg, nil
+}
+
+// parseClientChainData parses the client chain earning addresses in the format of
+// <client-chain-id>:<client-chain-earnings-addr>.
+func parseClientChainData(ccData []string) ([]*types.ClientChainEarningAddrInfo, error) {
+	earningInfoList := make([]*types.ClientChainEarningAddrInfo, len(ccData))
 	for i, arg := range ccData {
 		strList := strings.Split(arg, ":")
 		if len(strList) != 2 {
@@ -149,24 +254,11 @@ func newBuildRegisterOperatorMsg(
 				types.ErrCliCmdInputArg, "the error input arg is:%s", arg,
 			)
 		}
-		clientChainEarningAddress.EarningInfoList[i] = &types.ClientChainEarningAddrInfo{
+		earningInfoList[i] = &types.ClientChainEarningAddrInfo{
 			LzClientChainID: clientChainLzID, ClientChainEarningAddr: strList[1],
 		}
 	}
-	msg.Info.ClientChainEarningsAddr = clientChainEarningAddress
-	// get the initial commission parameters
-	// #nosec G703
-	rateStr, _ := fs.GetString(stakingcli.FlagCommissionRate)
-	// #nosec G703
-	maxRateStr, _ := fs.GetString(stakingcli.FlagCommissionMaxRate)
-	// #nosec G703
-	maxChangeRateStr, _ := fs.GetString(stakingcli.FlagCommissionMaxChangeRate)
-	commission, err := buildCommission(rateStr, maxRateStr, maxChangeRateStr)
-	if err != nil {
-		return nil, err
-	}
-	msg.Info.Commission = commission
-	return msg, nil
+	return earningInfoList, nil
 }
 
 func buildCommission(rateStr, maxRateStr, maxChangeRateStr string) (
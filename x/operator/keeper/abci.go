@@ -2,11 +2,14 @@ package keeper
 
 import (
 	"errors"
+	"sort"
+	"strings"
 
 	sdkmath "cosmossdk.io/math"
 	operatortypes "github.com/ExocoreNetwork/exocore/x/operator/types"
 	oracletypes "github.com/ExocoreNetwork/exocore/x/oracle/types"
 	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -37,15 +40,27 @@ func (k *Keeper) UpdateVotingPower(ctx sdk.Context, avsAddr string) error {
 	if err != nil {
 		return err
 	}
-	prices, err := k.oracleKeeper.GetMultipleAssetsPrices(ctx, assets)
-	// TODO: for now, we ignore the error when the price round is not found and set the price to 1 to avoid panic
+	prices, err := k.GetAssetsPrices(ctx, assets)
 	if err != nil {
 		// TODO: when assetID is not registered in oracle module, this error will finally lead to panic
 		if !errors.Is(err, oracletypes.ErrGetPriceRoundNotFound) {
 			ctx.Logger().Error("fail to get price from oracle, since current assetID is not bonded with oracle token", "details:", err)
 			return err
 		}
-		// TODO: for now, we ignore the error when the price round is not found and set the price to 1 to avoid panic
+		// the assets whose prices are missing or stale are excluded from the voting power
+		unpriced := make([]string, 0)
+		for assetID := range assets {
+			if _, ok := prices[assetID]; !ok {
+				unpriced = append(unpriced, assetID)
+			}
+		}
+		sort.Strings(unpriced)
+		ctx.Logger().Error("excluding the unpriced assets from the voting power", "avs", avsAddr, "assets", unpriced, "details", err)
+		ctx.EventManager().EmitEvent(sdk.NewEvent(
+			operatortypes.EventTypeUnpricedAssets,
+			sdk.NewAttribute(operatortypes.AttributeKeyAVSAddr, avsAddr),
+			sdk.NewAttribute(operatortypes.AttributeKeyAssetIDs, strings.Join(unpriced, ",")),
+		))
 	}
 	// update the voting power of operators and AVS
 	avsVotingPower := sdkmath.LegacyNewDec(0)
@@ -94,7 +109,76 @@ func (k *Keeper) UpdateVotingPower(ctx sdk.Context, avsAddr string) error {
 	return nil
 }
 
+// SetPriceUpdatedAsset marks the asset whose price has been updated by the oracle.
+func (k *Keeper) SetPriceUpdatedAsset(ctx sdk.Context, assetID string) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), operatortypes.KeyPrefixPriceUpdatedAsset)
+	store.Set([]byte(assetID), []byte{})
+}
+
+// GetPriceUpdatedAssets returns the assets whose prices have been updated since the last
+// EndBlock.
+func (k *Keeper) GetPriceUpdatedAssets(ctx sdk.Context) map[string]interface{} {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), operatortypes.KeyPrefixPriceUpdatedAsset)
+	iterator := sdk.KVStorePrefixIterator(store, nil)
+	defer iterator.Close()
+
+	ret := make(map[string]interface{})
+	for ; iterator.Valid(); iterator.Next() {
+		ret[string(iterator.Key())] = nil
+	}
+	return ret
+}
+
+// ClearPriceUpdatedAssets removes the marks of the assets whose prices have been updated.
+func (k *Keeper) ClearPriceUpdatedAssets(ctx sdk.Context) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), operatortypes.KeyPrefixPriceUpdatedAsset)
+	iterator := sdk.KVStorePrefixIterator(store, nil)
+	defer iterator.Close()
+
+	keys := make([][]byte, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+// UpdateVotingPowerForPriceChanges recalculates the USD values of the AVSs which support any of
+// the assets whose prices have been updated by the oracle. Only the AVSs whose USD values have
+// been recorded are recalculated, and the voting power snapshots are still taken at the end of
+// the epochs.
+func (k *Keeper) UpdateVotingPowerForPriceChanges(ctx sdk.Context) {
+	updatedAssets := k.GetPriceUpdatedAssets(ctx)
+	if len(updatedAssets) == 0 {
+		return
+	}
+	defer k.ClearPriceUpdatedAssets(ctx)
+	avsUSDValues, err := k.GetAllAVSUSDValues(ctx)
+	if err != nil {
+		ctx.Logger().Error("Failed to get the USD values of AVSs", "error", err)
+		return
+	}
+	for _, avsUSDValue := range avsUSDValues {
+		assets, err := k.avsKeeper.GetAVSSupportedAssets(ctx, avsUSDValue.AVSAddr)
+		if err != nil {
+			ctx.Logger().Error("Failed to get the supported assets", "avs", avsUSDValue.AVSAddr, "error", err)
+			continue
+		}
+		for assetID := range assets {
+			if _, ok := updatedAssets[assetID]; !ok {
+				continue
+			}
+			if err := k.UpdateVotingPower(ctx, avsUSDValue.AVSAddr); err != nil {
+				ctx.Logger().Error("Failed to update voting power for the price changes", "avs", avsUSDValue.AVSAddr, "error", err)
+			}
+			break
+		}
+	}
+}
+
 // EndBlock : update the assets' share when their prices change
-func (k *Keeper) EndBlock(_ sdk.Context, _ abci.RequestEndBlock) []abci.ValidatorUpdate {
+func (k *Keeper) EndBlock(ctx sdk.Context, _ abci.RequestEndBlock) []abci.ValidatorUpdate {
+	k.UpdateVotingPowerForPriceChanges(ctx)
 	return []abci.ValidatorUpdate{}
 }
@@ -9,6 +9,9 @@ import (
 )
 
 func (k Keeper) InitGenesis(ctx sdk.Context, state types.GenesisState) []abci.ValidatorUpdate {
+	// the params are set first, since the registration of the operators checks the minimum
+	// commission rate.
+	k.SetParams(ctx, state.Params)
 	for i := range state.Operators {
 		op := state.Operators[i] // avoid implicit memory aliasing
 		if op.OperatorInfo.EarningsAddr == "" {
@@ -53,12 +56,17 @@ func (k Keeper) InitGenesis(ctx sdk.Context, state types.GenesisState) []abci.Va
 	if err != nil {
 		panic(errorsmod.Wrap(err, "failed to set all key removals for operators"))
 	}
+	err = k.SetAllVotingPowerSnapshots(ctx, state.VotingPowerSnapshots)
+	if err != nil {
+		panic(errorsmod.Wrap(err, "failed to set all voting power snapshots"))
+	}
 	return []abci.ValidatorUpdate{}
 }
 
 func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 	res := types.GenesisState{}
 	var err error
+	res.Params = k.GetParams(ctx)
 	res.Operators = k.AllOperators(ctx)
 
 	res.OperatorRecords, err = k.GetAllOperatorConsKeyRecords(ctx)
@@ -96,5 +104,10 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 		panic(errorsmod.Wrap(err, "failed to get all key removals for operators").Error())
 	}
 
+	res.VotingPowerSnapshots, err = k.GetAllVotingPowerSnapshots(ctx)
+	if err != nil {
+		panic(errorsmod.Wrap(err, "failed to get all voting power snapshots").Error())
+	}
+
 	return &res
 }
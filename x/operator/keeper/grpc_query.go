@@ -17,6 +17,14 @@ import (
 
 var _ types.QueryServer = &Keeper{}
 
+// Params queries the parameters of the operator module.
+func (k *Keeper) Params(
+	ctx context.Context, _ *types.QueryParamsRequest,
+) (*types.QueryParamsResponse, error) {
+	c := sdk.UnwrapSDKContext(ctx)
+	return &types.QueryParamsResponse{Params: k.GetParams(c)}, nil
+}
+
 // QueryOperatorInfo queries the operator information for the given address.
 func (k *Keeper) QueryOperatorInfo(
 	ctx context.Context, req *types.GetOperatorInfoReq,
@@ -29,6 +29,11 @@ func (wrapper EpochsHooksWrapper) AfterEpochEnd(
 	// todo: need to consider the calling order
 	avsList := wrapper.keeper.avsKeeper.GetEpochEndAVSs(ctx, epochIdentifier, epochNumber)
 	for _, avs := range avsList {
+		// record the voting power before its first update, so that the infractions committed
+		// before the first snapshot of the AVS can be slashed.
+		if err := wrapper.keeper.SeedVotingPowerSnapshot(ctx, avs, epochIdentifier, epochNumber); err != nil {
+			ctx.Logger().Error("Failed to seed voting power snapshot", "avs", avs, "error", err)
+		}
 		// avs address should be hex
 		err := wrapper.keeper.UpdateVotingPower(ctx, avs)
 		if err != nil {
@@ -36,6 +41,16 @@ func (wrapper EpochsHooksWrapper) AfterEpochEnd(
 			// Handle the error gracefully, continue to the next AVS
 			continue
 		}
+		// record the updated voting power, which is used to slash the historical infractions.
+		err = wrapper.keeper.TakeVotingPowerSnapshot(ctx, avs, epochIdentifier, epochNumber)
+		if err != nil {
+			ctx.Logger().Error("Failed to take voting power snapshot", "avs", avs, "error", err)
+			continue
+		}
+		err = wrapper.keeper.PruneVotingPowerSnapshots(ctx, avs, epochNumber)
+		if err != nil {
+			ctx.Logger().Error("Failed to prune voting power snapshots", "avs", avs, "error", err)
+		}
 	}
 }
 
@@ -0,0 +1,34 @@
+package keeper
+
+import (
+	oracletypes "github.com/ExocoreNetwork/exocore/x/oracle/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// OracleHooksWrapper is the wrapper structure that implements the oracle hooks for the operator
+// keeper.
+type OracleHooksWrapper struct {
+	keeper *Keeper
+}
+
+// Interface guard
+var _ oracletypes.OracleHooks = OracleHooksWrapper{}
+
+// OracleHooks returns the oracle hooks wrapper. It follows the "accept interfaces, return
+// concretes" pattern.
+func (k *Keeper) OracleHooks() OracleHooksWrapper {
+	return OracleHooksWrapper{k}
+}
+
+// AfterPriceUpdated marks the assets bound to the token, so that the USD values of the AVSs
+// supporting them are recalculated in the EndBlock.
+func (wrapper OracleHooksWrapper) AfterPriceUpdated(
+	ctx sdk.Context, tokenID, _ uint64, _ oracletypes.PriceTimeRound,
+) {
+	for _, assetID := range wrapper.keeper.oracleKeeper.GetAssetIDsFromTokenID(ctx, tokenID) {
+		wrapper.keeper.SetPriceUpdatedAsset(ctx, assetID)
+	}
+}
+
+// AfterRoundFailed is a no-op, since the price of the token is unchanged.
+func (wrapper OracleHooksWrapper) AfterRoundFailed(sdk.Context, uint64, uint64) {}
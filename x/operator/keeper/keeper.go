@@ -2,6 +2,8 @@ package keeper
 
 import (
 	"context"
+	"fmt"
+	"time"
 
 	sdkmath "cosmossdk.io/math"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
@@ -23,6 +25,10 @@ type Keeper struct {
 
 	hooks       operatortypes.OperatorHooks // set separately via call to SetHooks
 	slashKeeper operatortypes.SlashKeeper   // for jailing and unjailing check TODO(mm)
+
+	// authority is the address capable of executing a MsgUpdateParams message. Typically,
+	// this should be the x/gov module account.
+	authority string
 }
 
 func NewKeeper(
@@ -33,7 +39,12 @@ func NewKeeper(
 	oracleKeeper operatortypes.OracleKeeper,
 	avsKeeper operatortypes.AVSKeeper,
 	slashKeeper operatortypes.SlashKeeper,
+	authority string,
 ) Keeper {
+	// ensure authority is a valid bech32 address
+	if _, err := sdk.AccAddressFromBech32(authority); err != nil {
+		panic(fmt.Sprintf("authority address %s is invalid: %s", authority, err))
+	}
 	return Keeper{
 		storeKey:         storeKey,
 		cdc:              cdc,
@@ -42,6 +53,7 @@ func NewKeeper(
 		oracleKeeper:     oracleKeeper,
 		avsKeeper:        avsKeeper,
 		slashKeeper:      slashKeeper,
+		authority:        authority,
 	}
 }
 
@@ -49,10 +61,40 @@ func (k *Keeper) OracleInterface() operatortypes.OracleKeeper {
 	return k.oracleKeeper
 }
 
+// GetUnbondingExpirationBlockNumber returns the block number at which the undelegation from an
+// operator, which isn't opted into any AVS, completes.
 func (k Keeper) GetUnbondingExpirationBlockNumber(_ sdk.Context, _ sdk.AccAddress, startHeight uint64) uint64 {
 	return startHeight + operatortypes.UnbondingExpiration
 }
 
+// GetUnbondingExpiration returns the epoch at the end of which the undelegation from the
+// operator, started in the current block, completes. It's the longest unbonding period among
+// the AVSs the operator is opted into, and the periods of the AVSs with different epoch
+// identifiers are compared by the estimated end time of their epochs. It returns false if the
+// operator isn't opted into any AVS.
+func (k *Keeper) GetUnbondingExpiration(
+	ctx sdk.Context, operator sdk.AccAddress,
+) (epochIdentifier string, epochNumber int64, found bool, err error) {
+	avsList, err := k.GetOptedInAVSForOperator(ctx, operator.String())
+	if err != nil {
+		return "", 0, false, err
+	}
+	var latest time.Time
+	for _, avsAddr := range avsList {
+		if !k.IsOptedIn(ctx, operator.String(), avsAddr) {
+			continue
+		}
+		identifier, number, endTime, err := k.avsKeeper.GetAVSUnbondingEpoch(ctx, avsAddr)
+		if err != nil {
+			return "", 0, false, err
+		}
+		if !found || endTime.After(latest) {
+			epochIdentifier, epochNumber, latest, found = identifier, number, endTime, true
+		}
+	}
+	return epochIdentifier, epochNumber, found, nil
+}
+
 // OperatorKeeper interface will be implemented by deposit keeper
 type OperatorKeeper interface {
 	// RegisterOperator handle the registerOperator txs from msg service
@@ -62,6 +104,8 @@ type OperatorKeeper interface {
 
 	GetUnbondingExpirationBlockNumber(ctx sdk.Context, OperatorAddress sdk.AccAddress, startHeight uint64) uint64
 
+	GetUnbondingExpiration(ctx sdk.Context, operator sdk.AccAddress) (epochIdentifier string, epochNumber int64, found bool, err error)
+
 	OptIn(ctx sdk.Context, operatorAddress sdk.AccAddress, AVSAddr string) error
 
 	OptOut(ctx sdk.Context, OperatorAddress sdk.AccAddress, AVSAddr string) error
@@ -0,0 +1,28 @@
+package keeper
+
+import (
+	operatortypes "github.com/ExocoreNetwork/exocore/x/operator/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{
+		keeper: keeper,
+	}
+}
+
+// Migrate1to2 migrates the store from consensus version 1 to 2. It stores the module params,
+// unless they are already set, and raises the commission rate of the operators below the
+// minimum commission rate to the minimum.
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	if !ctx.KVStore(m.keeper.storeKey).Has(operatortypes.KeyPrefixParams) {
+		m.keeper.SetParams(ctx, operatortypes.DefaultParams())
+	}
+	return m.keeper.raiseCommissionsToMinRate(ctx, m.keeper.GetParams(ctx).MinCommissionRate)
+}
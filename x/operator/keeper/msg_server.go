@@ -5,8 +5,10 @@ import (
 
 	errorsmod "cosmossdk.io/errors"
 	keytypes "github.com/ExocoreNetwork/exocore/types/keys"
+	"github.com/ExocoreNetwork/exocore/utils"
 	"github.com/ExocoreNetwork/exocore/x/operator/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 )
 
 type MsgServerImpl struct {
@@ -19,6 +21,28 @@ func NewMsgServerImpl(keeper Keeper) *MsgServerImpl {
 
 var _ types.MsgServer = &MsgServerImpl{}
 
+// UpdateParams updates the parameters of the operator module. It must be signed by the
+// authority.
+func (msgServer *MsgServerImpl) UpdateParams(goCtx context.Context, req *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if utils.IsMainnet(ctx.ChainID()) && msgServer.keeper.authority != req.Authority {
+		return nil, govtypes.ErrInvalidSigner.Wrapf(
+			"invalid authority; expected %s, got %s",
+			msgServer.keeper.authority, req.Authority,
+		)
+	}
+	if err := req.Params.Validate(); err != nil {
+		return nil, err
+	}
+	msgServer.keeper.SetParams(ctx, req.Params)
+	// the operators below a raised minimum couldn't reach it through EditOperator if their max
+	// change rate is too small, so they are raised here.
+	if err := msgServer.keeper.raiseCommissionsToMinRate(ctx, req.Params.MinCommissionRate); err != nil {
+		return nil, err
+	}
+	return &types.MsgUpdateParamsResponse{}, nil
+}
+
 // RegisterOperator is an implementation of the msg server for the operator module.
 func (msgServer *MsgServerImpl) RegisterOperator(goCtx context.Context, req *types.RegisterOperatorReq) (*types.RegisterOperatorResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
@@ -28,6 +52,15 @@ func (msgServer *MsgServerImpl) RegisterOperator(goCtx context.Context, req *typ
 	return &types.RegisterOperatorResponse{}, nil
 }
 
+// EditOperator is an implementation of the msg server for the operator module.
+func (msgServer *MsgServerImpl) EditOperator(goCtx context.Context, req *types.EditOperatorReq) (*types.EditOperatorResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := msgServer.keeper.EditOperator(ctx, req); err != nil {
+		return nil, err
+	}
+	return &types.EditOperatorResponse{}, nil
+}
+
 // OptIntoAVS is an implementation of the msg server for the operator module.
 func (msgServer *MsgServerImpl) OptIntoAVS(goCtx context.Context, req *types.OptIntoAVSReq) (res *types.OptIntoAVSResponse, err error) {
 	uncachedCtx := sdk.UnwrapSDKContext(goCtx)
@@ -9,15 +9,13 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	assetstype "github.com/ExocoreNetwork/exocore/x/assets/types"
+	delegationtypes "github.com/ExocoreNetwork/exocore/x/delegation/types"
 	operatortypes "github.com/ExocoreNetwork/exocore/x/operator/types"
 )
 
 // SetOperatorInfo is used to store the operator's information on the chain.
-// There is no current way implemented to delete an operator's registration or edit it.
-// TODO: implement operator edit function, which should allow editing:
-// approve address?
-// commission, subject to limits and once within 24 hours.
-// client chain earnings addresses (maybe append only?)
+// There is no current way implemented to delete an operator's registration. An existing
+// operator is edited through `EditOperator`.
 func (k *Keeper) SetOperatorInfo(
 	ctx sdk.Context, addr string, info *operatortypes.OperatorInfo,
 ) (err error) {
@@ -27,14 +25,15 @@ func (k *Keeper) SetOperatorInfo(
 		return errorsmod.Wrap(err, "SetOperatorInfo: error occurred when parse acc address from Bech32")
 	}
 	// if already registered, this request should go to EditOperator.
-	// TODO: EditOperator needs to be implemented.
 	if k.IsOperator(ctx, opAccAddr) {
 		return errorsmod.Wrap(
 			operatortypes.ErrOperatorAlreadyExists,
 			fmt.Sprintf("SetOperatorInfo: operator already exists, address: %s", opAccAddr),
 		)
 	}
-	// TODO: add minimum commission rate module parameter and check that commission exceeds it.
+	if err := k.validateMinCommissionRate(ctx, info.Commission.Rate); err != nil {
+		return errorsmod.Wrap(err, "SetOperatorInfo")
+	}
 	info.Commission.UpdateTime = ctx.BlockTime()
 
 	if info.ClientChainEarningsAddr != nil {
@@ -60,6 +59,121 @@ func (k *Keeper) SetOperatorInfo(
 	return nil
 }
 
+// EditOperator edits the information of an existing operator. The meta info and the approve
+// address are replaced if provided, the client chain earning addresses are appended, and the
+// commission rate is changed subject to the max change rate and the 24h cooldown.
+func (k *Keeper) EditOperator(ctx sdk.Context, req *operatortypes.EditOperatorReq) error {
+	info, err := k.OperatorInfo(ctx, req.FromAddress)
+	if err != nil {
+		return errorsmod.Wrap(
+			delegationtypes.ErrOperatorNotExist,
+			fmt.Sprintf("EditOperator: operator doesn't exist, address: %s", req.FromAddress),
+		)
+	}
+	if req.OperatorMetaInfo != "" {
+		info.OperatorMetaInfo = req.OperatorMetaInfo
+	}
+	if req.ApproveAddr != "" {
+		info.ApproveAddr = req.ApproveAddr
+	}
+	if len(req.ClientChainEarningsAddr) > 0 {
+		if info.ClientChainEarningsAddr == nil {
+			info.ClientChainEarningsAddr = &operatortypes.ClientChainEarningAddrList{}
+		}
+		for _, data := range req.ClientChainEarningsAddr {
+			if !k.assetsKeeper.ClientChainExists(ctx, data.LzClientChainID) {
+				return errorsmod.Wrap(
+					operatortypes.ErrParameterInvalid,
+					fmt.Sprintf("EditOperator: client chain not found, id: %d", data.LzClientChainID),
+				)
+			}
+			for _, existing := range info.ClientChainEarningsAddr.EarningInfoList {
+				if existing.LzClientChainID == data.LzClientChainID &&
+					existing.ClientChainEarningAddr == data.ClientChainEarningAddr {
+					return errorsmod.Wrap(
+						operatortypes.ErrParameterInvalid,
+						fmt.Sprintf("EditOperator: duplicate client chain earning address: %s", data.ClientChainEarningAddr),
+					)
+				}
+			}
+			info.ClientChainEarningsAddr.EarningInfoList = append(info.ClientChainEarningsAddr.EarningInfoList, data)
+		}
+	}
+	if req.CommissionRate != nil {
+		if err := info.Commission.ValidateNewRate(*req.CommissionRate, ctx.BlockTime()); err != nil {
+			return errorsmod.Wrap(err, "EditOperator: invalid commission rate")
+		}
+		if err := k.validateMinCommissionRate(ctx, *req.CommissionRate); err != nil {
+			return errorsmod.Wrap(err, "EditOperator")
+		}
+		info.Commission.Rate = *req.CommissionRate
+		info.Commission.UpdateTime = ctx.BlockTime()
+	}
+
+	// #nosec G703 // already validated in `ValidateBasic`
+	opAccAddr, _ := sdk.AccAddressFromBech32(req.FromAddress)
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), operatortypes.KeyPrefixOperatorInfo)
+	bz := k.cdc.MustMarshal(info)
+	store.Set(opAccAddr, bz)
+	return nil
+}
+
+// validateMinCommissionRate checks that the commission rate isn't less than the minimum
+// commission rate in the module parameters. A nil rate is only accepted if there is no minimum.
+func (k *Keeper) validateMinCommissionRate(ctx sdk.Context, rate sdk.Dec) error {
+	minRate := k.GetParams(ctx).MinCommissionRate
+	if minRate.IsPositive() && (rate.IsNil() || rate.LT(minRate)) {
+		return errorsmod.Wrapf(
+			operatortypes.ErrCommissionLTMinRate,
+			"commission rate %s is less than the minimum %s", rate, minRate,
+		)
+	}
+	return nil
+}
+
+// raiseCommissionsToMinRate raises the commission rate of the operators below the minimum
+// commission rate to the minimum. The max rate is raised too if required, while the update
+// time is left untouched so that the operators aren't locked out of editing their commission
+// by the cooldown.
+// It deliberately bypasses the max change rate and the update time checks of EditOperator,
+// since an operator whose max change rate is too small could never reach the new minimum.
+// An event is emitted for each raised operator so that the change is visible to its stakers.
+func (k *Keeper) raiseCommissionsToMinRate(ctx sdk.Context, minRate sdk.Dec) error {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), operatortypes.KeyPrefixOperatorInfo)
+	for _, op := range k.AllOperators(ctx) {
+		info := op.OperatorInfo
+		if !info.Commission.Rate.IsNil() && info.Commission.Rate.GTE(minRate) {
+			continue
+		}
+		oldRate := info.Commission.Rate
+		info.Commission.Rate = minRate
+		if info.Commission.MaxRate.IsNil() || info.Commission.MaxRate.LT(minRate) {
+			info.Commission.MaxRate = minRate
+		}
+		if info.Commission.MaxChangeRate.IsNil() {
+			info.Commission.MaxChangeRate = sdk.ZeroDec()
+		}
+		opAccAddr, err := sdk.AccAddressFromBech32(op.OperatorAddress)
+		if err != nil {
+			return err
+		}
+		k.Logger(ctx).Info(
+			"raising the commission rate to the minimum",
+			"operator", op.OperatorAddress,
+			"rate", minRate,
+		)
+		store.Set(opAccAddr, k.cdc.MustMarshal(&info))
+		ctx.EventManager().EmitEvent(sdk.NewEvent(
+			operatortypes.EventTypeCommissionRaised,
+			sdk.NewAttribute(operatortypes.AttributeKeyOperator, op.OperatorAddress),
+			sdk.NewAttribute(operatortypes.AttributeKeyOldRate, oldRate.String()),
+			sdk.NewAttribute(operatortypes.AttributeKeyNewRate, minRate.String()),
+			sdk.NewAttribute(operatortypes.AttributeKeyMaxRate, info.Commission.MaxRate.String()),
+		))
+	}
+	return nil
+}
+
 func (k *Keeper) OperatorInfo(ctx sdk.Context, addr string) (info *operatortypes.OperatorInfo, err error) {
 	opAccAddr, err := sdk.AccAddressFromBech32(addr)
 	if err != nil {
@@ -2,9 +2,11 @@ package keeper_test
 
 import (
 	"fmt"
+	"time"
 
 	"cosmossdk.io/math"
 
+	operatorKeeper "github.com/ExocoreNetwork/exocore/x/operator/keeper"
 	operatortype "github.com/ExocoreNetwork/exocore/x/operator/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
@@ -49,43 +51,108 @@ func (suite *OperatorTestSuite) TestAllOperators() {
 	suite.Contains(getOperators, operatorDetail)
 }
 
-// TODO: enable this test when editing operator is implemented. allow for querying
-// of the old commission against the new one.
-// func (suite *OperatorTestSuite) TestHistoricalOperatorInfo() {
-// 	height := suite.Ctx.BlockHeight()
-// 	info := &operatortype.OperatorInfo{
-// 		EarningsAddr:     suite.AccAddress.String(),
-// 		ApproveAddr:      "",
-// 		OperatorMetaInfo: "test operator",
-// 		ClientChainEarningsAddr: &operatortype.ClientChainEarningAddrList{
-// 			EarningInfoList: nil,
-// 		},
-// 	}
-// 	err := suite.App.OperatorKeeper.SetOperatorInfo(suite.Ctx, suite.AccAddress.String(), info)
-// 	suite.NoError(err)
-// 	suite.NextBlock()
-// 	suite.Equal(height+1, suite.Ctx.BlockHeight(), "nexBlock failed")
-
-// 	newInfo := *info
-// 	newInfo.OperatorMetaInfo = "new operator"
-// 	err = suite.App.OperatorKeeper.SetOperatorInfo(suite.Ctx, suite.AccAddress.String(), &newInfo)
-// 	suite.NoError(err)
-
-// 	for i := 0; i < 10; i++ {
-// 		suite.NextBlock()
-// 	}
-// 	// get historical operator info
-// 	historicalQueryCtx, err := suite.App.CreateQueryContext(height, false)
-// 	suite.NoError(err)
-// 	getInfo, err := suite.App.OperatorKeeper.QueryOperatorInfo(historicalQueryCtx, &operatortype.GetOperatorInfoReq{
-// 		OperatorAddr: suite.AccAddress.String(),
-// 	})
-// 	suite.NoError(err)
-// 	suite.Equal(info.OperatorMetaInfo, getInfo.OperatorMetaInfo)
-
-// 	getInfo, err = suite.App.OperatorKeeper.QueryOperatorInfo(suite.Ctx, &operatortype.GetOperatorInfoReq{
-// 		OperatorAddr: suite.AccAddress.String(),
-// 	})
-// 	suite.NoError(err)
-// 	suite.Equal(newInfo.OperatorMetaInfo, getInfo.OperatorMetaInfo)
-// }
+func (suite *OperatorTestSuite) TestEditOperator() {
+	info := &operatortype.OperatorInfo{
+		EarningsAddr:     suite.AccAddress.String(),
+		ApproveAddr:      suite.AccAddress.String(),
+		OperatorMetaInfo: "test operator",
+		Commission: stakingtypes.NewCommission(
+			sdk.NewDecWithPrec(1, 1), sdk.NewDecWithPrec(5, 1), sdk.NewDecWithPrec(1, 1),
+		),
+	}
+	err := suite.App.OperatorKeeper.SetOperatorInfo(suite.Ctx, suite.AccAddress.String(), info)
+	suite.NoError(err)
+	msgServer := operatorKeeper.NewMsgServerImpl(suite.App.OperatorKeeper)
+
+	// the commission can't be changed within 24 hours after the registration
+	newRate := sdk.NewDecWithPrec(2, 1)
+	_, err = msgServer.EditOperator(suite.Ctx, &operatortype.EditOperatorReq{
+		FromAddress:    suite.AccAddress.String(),
+		CommissionRate: &newRate,
+	})
+	suite.ErrorIs(err, stakingtypes.ErrCommissionUpdateTime)
+
+	suite.Ctx = suite.Ctx.WithBlockTime(suite.Ctx.BlockTime().Add(24 * time.Hour))
+	// the change can't exceed the max change rate
+	tooHighRate := sdk.NewDecWithPrec(3, 1)
+	_, err = msgServer.EditOperator(suite.Ctx, &operatortype.EditOperatorReq{
+		FromAddress:    suite.AccAddress.String(),
+		CommissionRate: &tooHighRate,
+	})
+	suite.ErrorIs(err, stakingtypes.ErrCommissionGTMaxChangeRate)
+
+	earningAddr := &operatortype.ClientChainEarningAddrInfo{
+		LzClientChainID:        suite.ClientChains[0].LayerZeroChainID,
+		ClientChainEarningAddr: "0x1f9840a85d5af5bf1d1762f925bdaddc4201f984",
+	}
+	_, err = msgServer.EditOperator(suite.Ctx, &operatortype.EditOperatorReq{
+		FromAddress:             suite.AccAddress.String(),
+		OperatorMetaInfo:        "new operator",
+		ClientChainEarningsAddr: []*operatortype.ClientChainEarningAddrInfo{earningAddr},
+		CommissionRate:          &newRate,
+	})
+	suite.NoError(err)
+
+	getInfo, err := suite.App.OperatorKeeper.OperatorInfo(suite.Ctx, suite.AccAddress.String())
+	suite.NoError(err)
+	suite.Equal("new operator", getInfo.OperatorMetaInfo)
+	suite.Equal(info.ApproveAddr, getInfo.ApproveAddr)
+	suite.Equal([]*operatortype.ClientChainEarningAddrInfo{earningAddr}, getInfo.ClientChainEarningsAddr.EarningInfoList)
+	suite.Equal(newRate, getInfo.Commission.Rate)
+	suite.Equal(suite.Ctx.BlockTime(), getInfo.Commission.UpdateTime)
+
+	// the same earning address can't be appended twice
+	_, err = msgServer.EditOperator(suite.Ctx, &operatortype.EditOperatorReq{
+		FromAddress:             suite.AccAddress.String(),
+		ClientChainEarningsAddr: []*operatortype.ClientChainEarningAddrInfo{earningAddr},
+	})
+	suite.ErrorIs(err, operatortype.ErrParameterInvalid)
+
+	// an unregistered address can't be edited
+	_, err = msgServer.EditOperator(suite.Ctx, &operatortype.EditOperatorReq{
+		FromAddress:      sdk.AccAddress(suite.Address[1:]).String(),
+		OperatorMetaInfo: "new operator",
+	})
+	suite.Error(err)
+}
+
+func (suite *OperatorTestSuite) TestHistoricalOperatorInfo() {
+	height := suite.Ctx.BlockHeight()
+	info := &operatortype.OperatorInfo{
+		EarningsAddr:     suite.AccAddress.String(),
+		ApproveAddr:      "",
+		OperatorMetaInfo: "test operator",
+		ClientChainEarningsAddr: &operatortype.ClientChainEarningAddrList{
+			EarningInfoList: nil,
+		},
+		Commission: stakingtypes.NewCommission(sdk.ZeroDec(), sdk.ZeroDec(), sdk.ZeroDec()),
+	}
+	err := suite.App.OperatorKeeper.SetOperatorInfo(suite.Ctx, suite.AccAddress.String(), info)
+	suite.NoError(err)
+	suite.NextBlock()
+	suite.Equal(height+1, suite.Ctx.BlockHeight(), "nexBlock failed")
+
+	err = suite.App.OperatorKeeper.EditOperator(suite.Ctx, &operatortype.EditOperatorReq{
+		FromAddress:      suite.AccAddress.String(),
+		OperatorMetaInfo: "new operator",
+	})
+	suite.NoError(err)
+
+	for i := 0; i < 10; i++ {
+		suite.NextBlock()
+	}
+	// get historical operator info
+	historicalQueryCtx, err := suite.App.CreateQueryContext(height, false)
+	suite.NoError(err)
+	getInfo, err := suite.App.OperatorKeeper.QueryOperatorInfo(historicalQueryCtx, &operatortype.GetOperatorInfoReq{
+		OperatorAddr: suite.AccAddress.String(),
+	})
+	suite.NoError(err)
+	suite.Equal(info.OperatorMetaInfo, getInfo.OperatorMetaInfo)
+
+	getInfo, err = suite.App.OperatorKeeper.QueryOperatorInfo(suite.Ctx, &operatortype.GetOperatorInfoReq{
+		OperatorAddr: suite.AccAddress.String(),
+	})
+	suite.NoError(err)
+	suite.Equal("new operator", getInfo.OperatorMetaInfo)
+}
@@ -0,0 +1,26 @@
+package keeper
+
+import (
+	operatortypes "github.com/ExocoreNetwork/exocore/x/operator/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// GetParams returns the parameters of the operator module. The default parameters are
+// returned if none have been stored yet, which is the case before the v2 migration runs.
+func (k Keeper) GetParams(ctx sdk.Context) operatortypes.Params {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(operatortypes.KeyPrefixParams)
+	if bz == nil {
+		return operatortypes.DefaultParams()
+	}
+	var params operatortypes.Params
+	k.cdc.MustUnmarshal(bz, &params)
+	return params
+}
+
+// SetParams stores the parameters of the operator module.
+func (k Keeper) SetParams(ctx sdk.Context, params operatortypes.Params) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&params)
+	store.Set(operatortypes.KeyPrefixParams, bz)
+}
@@ -0,0 +1,150 @@
+package keeper_test
+
+import (
+	"time"
+
+	operatorKeeper "github.com/ExocoreNetwork/exocore/x/operator/keeper"
+	operatortype "github.com/ExocoreNetwork/exocore/x/operator/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+func (suite *OperatorTestSuite) TestUpdateParams() {
+	msgServer := operatorKeeper.NewMsgServerImpl(suite.App.OperatorKeeper)
+	authority := suite.App.GovKeeper.GetGovernanceAccount(suite.Ctx).GetAddress().String()
+
+	_, err := msgServer.UpdateParams(suite.Ctx, &operatortype.MsgUpdateParams{
+		Authority: authority,
+		Params:    operatortype.NewParams(sdk.NewDec(2), 0),
+	})
+	suite.ErrorIs(err, operatortype.ErrInvalidParams)
+
+	params := operatortype.NewParams(sdk.NewDecWithPrec(5, 2), 10)
+	_, err = msgServer.UpdateParams(suite.Ctx, &operatortype.MsgUpdateParams{
+		Authority: authority,
+		Params:    params,
+	})
+	suite.NoError(err)
+	res, err := suite.App.OperatorKeeper.Params(suite.Ctx, &operatortype.QueryParamsRequest{})
+	suite.NoError(err)
+	suite.Equal(params, res.Params)
+}
+
+func (suite *OperatorTestSuite) TestMinCommissionRate() {
+	suite.App.OperatorKeeper.SetParams(suite.Ctx, operatortype.NewParams(sdk.NewDecWithPrec(5, 2), 0))
+	msgServer := operatorKeeper.NewMsgServerImpl(suite.App.OperatorKeeper)
+
+	info := &operatortype.OperatorInfo{
+		EarningsAddr:     suite.AccAddress.String(),
+		OperatorMetaInfo: "test operator",
+		Commission: stakingtypes.NewCommission(
+			sdk.NewDecWithPrec(1, 2), sdk.NewDecWithPrec(5, 1), sdk.NewDecWithPrec(1, 1),
+		),
+	}
+	_, err := msgServer.RegisterOperator(suite.Ctx, &operatortype.RegisterOperatorReq{
+		FromAddress: suite.AccAddress.String(),
+		Info:        info,
+	})
+	suite.ErrorIs(err, operatortype.ErrCommissionLTMinRate)
+
+	info.Commission = stakingtypes.NewCommission(
+		sdk.NewDecWithPrec(1, 1), sdk.NewDecWithPrec(5, 1), sdk.NewDecWithPrec(1, 1),
+	)
+	_, err = msgServer.RegisterOperator(suite.Ctx, &operatortype.RegisterOperatorReq{
+		FromAddress: suite.AccAddress.String(),
+		Info:        info,
+	})
+	suite.NoError(err)
+
+	suite.Ctx = suite.Ctx.WithBlockTime(suite.Ctx.BlockTime().Add(24 * time.Hour))
+	lowRate := sdk.NewDecWithPrec(4, 2)
+	_, err = msgServer.EditOperator(suite.Ctx, &operatortype.EditOperatorReq{
+		FromAddress:    suite.AccAddress.String(),
+		CommissionRate: &lowRate,
+	})
+	suite.ErrorIs(err, operatortype.ErrCommissionLTMinRate)
+}
+
+func (suite *OperatorTestSuite) TestUpdateParamsRaisesCommission() {
+	msgServer := operatorKeeper.NewMsgServerImpl(suite.App.OperatorKeeper)
+	authority := suite.App.GovKeeper.GetGovernanceAccount(suite.Ctx).GetAddress().String()
+	// the max change rate is too small to reach the new floor through EditOperator.
+	info := &operatortype.OperatorInfo{
+		EarningsAddr:     suite.AccAddress.String(),
+		OperatorMetaInfo: "test operator",
+		Commission: stakingtypes.NewCommission(
+			sdk.NewDecWithPrec(1, 2), sdk.NewDecWithPrec(2, 2), sdk.NewDecWithPrec(1, 2),
+		),
+	}
+	_, err := msgServer.RegisterOperator(suite.Ctx, &operatortype.RegisterOperatorReq{
+		FromAddress: suite.AccAddress.String(),
+		Info:        info,
+	})
+	suite.NoError(err)
+
+	minRate := sdk.NewDecWithPrec(5, 2)
+	_, err = msgServer.UpdateParams(suite.Ctx, &operatortype.MsgUpdateParams{
+		Authority: authority,
+		Params:    operatortype.NewParams(minRate, 0),
+	})
+	suite.NoError(err)
+	getInfo, err := suite.App.OperatorKeeper.OperatorInfo(suite.Ctx, suite.AccAddress.String())
+	suite.NoError(err)
+	suite.Equal(minRate, getInfo.Commission.Rate)
+	suite.Equal(minRate, getInfo.Commission.MaxRate)
+	// an event is emitted for each raised operator.
+	raised := make([]string, 0)
+	for _, event := range suite.Ctx.EventManager().Events() {
+		if event.Type == operatortype.EventTypeCommissionRaised {
+			attr, found := event.GetAttribute(operatortype.AttributeKeyOperator)
+			suite.True(found)
+			raised = append(raised, attr.Value)
+		}
+	}
+	suite.Contains(raised, suite.AccAddress.String())
+
+	// the operator can still edit its commission.
+	suite.Ctx = suite.Ctx.WithBlockTime(suite.Ctx.BlockTime().Add(24 * time.Hour))
+	_, err = msgServer.EditOperator(suite.Ctx, &operatortype.EditOperatorReq{
+		FromAddress:    suite.AccAddress.String(),
+		CommissionRate: &minRate,
+	})
+	suite.NoError(err)
+}
+
+func (suite *OperatorTestSuite) TestMigrate1to2() {
+	lowOperator := sdk.AccAddress(suite.Address[1:])
+	info := &operatortype.OperatorInfo{
+		EarningsAddr: lowOperator.String(),
+		Commission: stakingtypes.NewCommission(
+			sdk.NewDecWithPrec(1, 2), sdk.NewDecWithPrec(2, 2), sdk.NewDecWithPrec(1, 2),
+		),
+	}
+	err := suite.App.OperatorKeeper.SetOperatorInfo(suite.Ctx, lowOperator.String(), info)
+	suite.NoError(err)
+	highInfo := &operatortype.OperatorInfo{
+		EarningsAddr: suite.AccAddress.String(),
+		Commission: stakingtypes.NewCommission(
+			sdk.NewDecWithPrec(1, 1), sdk.NewDecWithPrec(5, 1), sdk.NewDecWithPrec(1, 1),
+		),
+	}
+	err = suite.App.OperatorKeeper.SetOperatorInfo(suite.Ctx, suite.AccAddress.String(), highInfo)
+	suite.NoError(err)
+
+	// the floor is set before the migration runs
+	minRate := sdk.NewDecWithPrec(5, 2)
+	suite.App.OperatorKeeper.SetParams(suite.Ctx, operatortype.NewParams(minRate, 0))
+	migrator := operatorKeeper.NewMigrator(suite.App.OperatorKeeper)
+	suite.NoError(migrator.Migrate1to2(suite.Ctx))
+
+	getInfo, err := suite.App.OperatorKeeper.OperatorInfo(suite.Ctx, lowOperator.String())
+	suite.NoError(err)
+	suite.Equal(minRate, getInfo.Commission.Rate)
+	suite.Equal(minRate, getInfo.Commission.MaxRate)
+	suite.Equal(info.Commission.UpdateTime, getInfo.Commission.UpdateTime)
+
+	getInfo, err = suite.App.OperatorKeeper.OperatorInfo(suite.Ctx, suite.AccAddress.String())
+	suite.NoError(err)
+	suite.Equal(highInfo.Commission.Rate, getInfo.Commission.Rate)
+	suite.Equal(highInfo.Commission.MaxRate, getInfo.Commission.MaxRate)
+}
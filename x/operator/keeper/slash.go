@@ -44,6 +44,38 @@ func SlashFromUndelegation(undelegation *delegationtype.UndelegationRecord, slas
 	}
 }
 
+// SlashFromRedelegation executes the slash from a redelegation. The redelegated amount has been
+// moved to the destination operator, so the slash is executed from the share of the staker in
+// the destination operator.
+func (k *Keeper) SlashFromRedelegation(
+	ctx sdk.Context, redelegation *delegationtype.RedelegationRecord, slashProportion sdkmath.LegacyDec,
+) (*types.SlashFromRedelegation, error) {
+	if redelegation.RemainingAmount.IsZero() {
+		return nil, nil
+	}
+	slashAmount := slashProportion.MulInt(redelegation.Amount).TruncateInt()
+	// reduce the remaining_amount in the record
+	if slashAmount.GTE(redelegation.RemainingAmount) {
+		slashAmount = redelegation.RemainingAmount
+		redelegation.RemainingAmount = sdkmath.NewInt(0)
+	} else {
+		redelegation.RemainingAmount = redelegation.RemainingAmount.Sub(slashAmount)
+	}
+	slashedAmount, err := k.delegationKeeper.SlashRedelegation(ctx, redelegation, slashAmount)
+	if err != nil {
+		return nil, err
+	}
+	if !slashedAmount.IsPositive() {
+		return nil, nil
+	}
+	return &types.SlashFromRedelegation{
+		StakerID:        redelegation.StakerID,
+		AssetID:         redelegation.AssetID,
+		DstOperatorAddr: redelegation.DstOperatorAddr,
+		Amount:          slashedAmount,
+	}, nil
+}
+
 func (k *Keeper) CheckSlashParameter(ctx sdk.Context, parameter *types.SlashInputInfo) error {
 	if parameter.SlashProportion.IsNil() || parameter.SlashProportion.IsNegative() {
 		return errorsmod.Wrapf(types.ErrValueIsNilOrZero, "Invalid SlashProportion; expected non-nil and non-negative, got: %+v", parameter.SlashProportion)
@@ -58,29 +90,67 @@ func (k *Keeper) CheckSlashParameter(ctx sdk.Context, parameter *types.SlashInpu
 			return errorsmod.Wrapf(types.ErrInvalidSlashPower, "slash for dogfood, the power is:%v", parameter.Power)
 		}
 	} else {
+		// the historical voting power of the other AVSs is retrieved from the snapshot,
+		// so it shouldn't be provided by the caller.
 		if parameter.Power != 0 {
 			return errorsmod.Wrapf(types.ErrInvalidSlashPower, "slash for other AVSs, the power is:%v", parameter.Power)
 		}
-		// todo: get the historical voting power from the snapshot for the other AVSs
+		power, err := k.GetOperatorVotingPowerAtHeight(ctx, parameter.AVSAddr, parameter.Operator.String(), parameter.SlashEventHeight)
+		if err != nil {
+			return err
+		}
+		if !power.IsPositive() {
+			return errorsmod.Wrapf(types.ErrInvalidSlashPower, "slash for other AVSs, the historical power is:%v", power)
+		}
 	}
 	return nil
 }
 
+// GetSlashPower returns the voting power of the operator when the infraction occurred. The
+// power is provided by the caller for dogfood, and is retrieved from the voting power
+// snapshot for the other AVSs.
+func (k *Keeper) GetSlashPower(ctx sdk.Context, parameter *types.SlashInputInfo) (sdkmath.LegacyDec, error) {
+	if parameter.IsDogFood {
+		return sdkmath.LegacyNewDec(parameter.Power), nil
+	}
+	return k.GetOperatorVotingPowerAtHeight(ctx, parameter.AVSAddr, parameter.Operator.String(), parameter.SlashEventHeight)
+}
+
 // SlashAssets slash the assets according to the new calculated proportion
-// It slashs the undelegation first, then slash the assets pool of the related operator
+// It slashs the undelegation and redelegation first, then slash the assets pool of the related
+// operator
 // If the remaining amount of the assets pool after slash is zero, the share of related
 // stakers should be cleared, because the divisor will be zero when calculating the share
 // of new delegation after the slash.
 func (k *Keeper) SlashAssets(ctx sdk.Context, parameter *types.SlashInputInfo) (*types.SlashExecutionInfo, error) {
 	// calculate the new slash proportion according to the historical power and current assets state
-	slashUSDValue := sdkmath.LegacyNewDec(parameter.Power).Mul(parameter.SlashProportion)
+	power, err := k.GetSlashPower(ctx, parameter)
+	if err != nil {
+		return nil, err
+	}
+	slashUSDValue := power.Mul(parameter.SlashProportion)
 	// calculate the current usd value of all assets pool for the operator
 	stakingInfo, err := k.CalculateUSDValueForOperator(ctx, true, parameter.Operator.String(), nil, nil, nil)
 	if err != nil {
 		return nil, err
 	}
+	slashableUSDValue := stakingInfo.StakingAndWaitUnbonding
+	// #nosec G701
+	heightFilter := uint64(parameter.SlashEventHeight)
+	if parameter.SlashEventHeight < ctx.BlockHeight() {
+		// the amounts redelegated after the infraction are slashed too, so they are part of
+		// the value from which the slash proportion is derived.
+		redelegatedUSDValue, err := k.redelegatedUSDValue(ctx, parameter.Operator.String(), heightFilter)
+		if err != nil {
+			return nil, err
+		}
+		slashableUSDValue = slashableUSDValue.Add(redelegatedUSDValue)
+	}
+	if slashableUSDValue.IsNil() || !slashableUSDValue.IsPositive() {
+		return nil, errorsmod.Wrapf(types.ErrValueIsNilOrZero, "there isn't any asset to be slashed for the operator:%s", parameter.Operator.String())
+	}
 	// calculate the new slash proportion
-	newSlashProportion := slashUSDValue.Quo(stakingInfo.StakingAndWaitUnbonding)
+	newSlashProportion := slashUSDValue.Quo(slashableUSDValue)
 	newSlashProportion = sdkmath.LegacyMinDec(sdkmath.LegacyNewDec(1), newSlashProportion)
 
 	executionInfo := &types.SlashExecutionInfo{
@@ -88,27 +158,64 @@ func (k *Keeper) SlashAssets(ctx sdk.Context, parameter *types.SlashInputInfo) (
 		SlashValue:         slashUSDValue,
 		SlashUndelegations: make([]types.SlashFromUndelegation, 0),
 		SlashAssetsPool:    make([]types.SlashFromAssetsPool, 0),
+		SlashRedelegations: make([]types.SlashFromRedelegation, 0),
+	}
+	// the assets without any price aren't part of the slashable value, so they aren't slashed.
+	pricedAssets := make(map[string]bool)
+	isPriced := func(assetID string) (bool, error) {
+		if priced, ok := pricedAssets[assetID]; ok {
+			return priced, nil
+		}
+		_, _, priced, err := k.getSlashPrice(ctx, assetID)
+		if err != nil {
+			return false, err
+		}
+		pricedAssets[assetID] = priced
+		return priced, nil
 	}
 	// slash from the unbonding stakers
 	if parameter.SlashEventHeight < ctx.BlockHeight() {
 		// get the undelegations that are submitted after the slash.
 		opFunc := func(undelegation *delegationtype.UndelegationRecord) error {
+			if priced, err := isPriced(undelegation.AssetID); err != nil || !priced {
+				return err
+			}
 			slashFromUndelegation := SlashFromUndelegation(undelegation, newSlashProportion)
 			if slashFromUndelegation != nil {
 				executionInfo.SlashUndelegations = append(executionInfo.SlashUndelegations, *slashFromUndelegation)
 			}
 			return nil
 		}
-		// #nosec G701
-		heightFilter := uint64(parameter.SlashEventHeight)
 		err = k.delegationKeeper.IterateUndelegationsByOperator(ctx, parameter.Operator.String(), &heightFilter, true, opFunc)
 		if err != nil {
 			return nil, err
 		}
+		// get the redelegations that are submitted after the slash, they are slashed from
+		// the destination operators.
+		redelegationOpFunc := func(redelegation *delegationtype.RedelegationRecord) error {
+			if priced, err := isPriced(redelegation.AssetID); err != nil || !priced {
+				return err
+			}
+			slashFromRedelegation, err := k.SlashFromRedelegation(ctx, redelegation, newSlashProportion)
+			if err != nil {
+				return err
+			}
+			if slashFromRedelegation != nil {
+				executionInfo.SlashRedelegations = append(executionInfo.SlashRedelegations, *slashFromRedelegation)
+			}
+			return nil
+		}
+		err = k.delegationKeeper.IterateRedelegationsBySrcOperator(ctx, parameter.Operator.String(), &heightFilter, true, redelegationOpFunc)
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	// slash from the assets pool of the operator
 	opFuncToIterateAssets := func(assetID string, state *assetstype.OperatorAssetInfo) error {
+		if priced, err := isPriced(assetID); err != nil || !priced {
+			return err
+		}
 		slashAmount := newSlashProportion.MulInt(state.TotalAmount).TruncateInt()
 		remainingAmount := state.TotalAmount.Sub(slashAmount)
 		// todo: consider slash all assets if the remaining amount is too small,
@@ -149,6 +256,31 @@ func (k *Keeper) SlashAssets(ctx sdk.Context, parameter *types.SlashInputInfo) (
 	return executionInfo, nil
 }
 
+// redelegatedUSDValue returns the USD value of the remaining amounts of the redelegations from
+// the operator, which are submitted at or after the height.
+func (k *Keeper) redelegatedUSDValue(ctx sdk.Context, operator string, height uint64) (sdkmath.LegacyDec, error) {
+	ret := sdkmath.LegacyZeroDec()
+	opFunc := func(redelegation *delegationtype.RedelegationRecord) error {
+		if !redelegation.RemainingAmount.IsPositive() {
+			return nil
+		}
+		price, decimal, priced, err := k.getSlashPrice(ctx, redelegation.AssetID)
+		if err != nil {
+			return err
+		}
+		if !priced {
+			return nil
+		}
+		ret = ret.Add(CalculateUSDValue(redelegation.RemainingAmount, price.Value, decimal, price.Decimal))
+		return nil
+	}
+	err := k.delegationKeeper.IterateRedelegationsBySrcOperator(ctx, operator, &height, false, opFunc)
+	if err != nil {
+		return sdkmath.LegacyDec{}, err
+	}
+	return ret, nil
+}
+
 // Slash performs all slash events and stores the execution result
 func (k *Keeper) Slash(ctx sdk.Context, parameter *types.SlashInputInfo) error {
 	err := k.CheckSlashParameter(ctx, parameter)
@@ -163,6 +295,14 @@ func (k *Keeper) Slash(ctx sdk.Context, parameter *types.SlashInputInfo) error {
 	if err != nil {
 		return err
 	}
+	// freeze the operator if the slash is severe. the proportion actually applied to the current
+	// assets is used, since it differs from the requested one once the assets have changed.
+	err = k.slashKeeper.FreezeOperatorForSlash(
+		cc, parameter.Operator, parameter.AVSAddr, parameter.SlashID, executionInfo.SlashProportion,
+	)
+	if err != nil {
+		return err
+	}
 	writeFunc()
 	// store the slash information
 	height := ctx.BlockHeight()
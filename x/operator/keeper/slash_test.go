@@ -5,9 +5,10 @@ import (
 
 	sdkmath "cosmossdk.io/math"
 	avstypes "github.com/ExocoreNetwork/exocore/x/avs/types"
+	delegationtype "github.com/ExocoreNetwork/exocore/x/delegation/types"
 	"github.com/ExocoreNetwork/exocore/x/operator/keeper"
 	"github.com/ExocoreNetwork/exocore/x/operator/types"
-	abci "github.com/cometbft/cometbft/abci/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	"github.com/ethereum/go-ethereum/common"
 )
@@ -49,8 +50,19 @@ func (suite *OperatorTestSuite) TestSlashWithInfractionReason() {
 	undelegationAmount := sdkmath.NewIntWithDecimal(10, assetDecimal)
 	suite.prepareDelegation(false, suite.assetAddr, undelegationAmount)
 	delegationRemaining := delegationAmount.Add(newDelegateAmount).Sub(undelegationAmount)
-	startHeight := uint64(suite.Ctx.BlockHeight())
-	completedHeight := suite.App.OperatorKeeper.GetUnbondingExpirationBlockNumber(suite.Ctx, suite.operatorAddr, startHeight)
+	// the operator is opted into the AVS, so the undelegation completes by the epoch of the AVS
+	epochIdentifier, completedEpoch, found, err := suite.App.OperatorKeeper.GetUnbondingExpiration(suite.Ctx, suite.operatorAddr)
+	suite.NoError(err)
+	suite.True(found)
+	// the unbonding lasts for the whole unbonding period after the current epoch, which is
+	// when the dogfood AVS releases it as well.
+	avsInfo, err := suite.App.AVSManagerKeeper.GetAVSInfo(suite.Ctx, avsAddr)
+	suite.NoError(err)
+	epochInfo, found := suite.App.EpochsKeeper.GetEpochInfo(suite.Ctx, epochIdentifier)
+	suite.True(found)
+	// #nosec G115
+	suite.Equal(epochInfo.CurrentEpoch+int64(avsInfo.Info.AvsUnbondingPeriod), completedEpoch)
+	suite.Equal(suite.App.StakingKeeper.GetUnbondingCompletionEpoch(suite.Ctx), completedEpoch)
 
 	// trigger the slash with a downtime event
 	slashFactor := suite.App.SlashingKeeper.SlashFractionDowntime(suite.Ctx)
@@ -70,6 +82,8 @@ func (suite *OperatorTestSuite) TestSlashWithInfractionReason() {
 	suite.Equal(infractionHeight, slashInfo.EventHeight)
 	suite.Equal(slashFactor, slashInfo.SlashProportion)
 	suite.Equal(uint32(slashType), slashInfo.SlashType)
+	// the downtime slash isn't severe enough to freeze the operator
+	suite.False(suite.App.ExoSlashKeeper.IsOperatorFrozen(suite.Ctx, suite.operatorAddr))
 	suite.Equal(types.SlashFromUndelegation{
 		StakerID: suite.stakerID,
 		AssetID:  suite.assetID,
@@ -88,13 +102,193 @@ func (suite *OperatorTestSuite) TestSlashWithInfractionReason() {
 	undelegations, err := suite.App.DelegationKeeper.GetStakerUndelegationRecords(suite.Ctx, suite.stakerID, suite.assetID)
 	suite.NoError(err)
 	suite.Equal(undelegationAmount.Sub(slashInfo.ExecutionInfo.SlashUndelegations[0].Amount), undelegations[0].ActualCompletedAmount)
+	suite.Equal(epochIdentifier, undelegations[0].CompleteEpochIdentifier)
+	suite.Equal(completedEpoch, undelegations[0].CompleteEpochNumber)
+	suite.Equal(uint64(0), undelegations[0].CompleteBlockNumber)
 
-	// run to the block at which the undelegation is completed
-	for i := startHeight; i < completedHeight; i++ {
+	// the undelegation isn't completed by the block number
+	for i := 0; i < int(delegationtype.CanUndelegationDelayHeight); i++ {
 		suite.NextBlock()
 	}
-	suite.App.DelegationKeeper.EndBlock(suite.Ctx, abci.RequestEndBlock{})
+	undelegations, err = suite.App.DelegationKeeper.GetStakerUndelegationRecords(suite.Ctx, suite.stakerID, suite.assetID)
+	suite.NoError(err)
+	suite.Equal(1, len(undelegations))
+
+	// run to the end of the epoch at which the undelegation is completed
+	for {
+		epochInfo, found := suite.App.EpochsKeeper.GetEpochInfo(suite.Ctx, epochIdentifier)
+		suite.True(found)
+		if epochInfo.CurrentEpoch > completedEpoch {
+			break
+		}
+		suite.CommitAfter(epochInfo.Duration)
+	}
 	undelegations, err = suite.App.DelegationKeeper.GetStakerUndelegationRecords(suite.Ctx, suite.stakerID, suite.assetID)
 	suite.NoError(err)
 	suite.Equal(0, len(undelegations))
 }
+
+func (suite *OperatorTestSuite) TestSlashFreezeByExecutedProportion() {
+	suite.prepareOperator()
+	usdtAddress := common.HexToAddress("0xdAC17F958D2ee523a2206206994597C13D831ec7")
+	assetDecimal := 6
+	suite.prepareDeposit(usdtAddress, sdkmath.NewIntWithDecimal(300, assetDecimal))
+	suite.prepareDelegation(true, suite.assetAddr, sdkmath.NewIntWithDecimal(100, assetDecimal))
+	err := suite.App.DelegationKeeper.AssociateOperatorWithStaker(suite.Ctx, suite.clientChainLzID, suite.operatorAddr, suite.Address[:])
+	suite.NoError(err)
+	avsAddr := avstypes.GenerateAVSAddr(avstypes.ChainIDWithoutRevision(suite.Ctx.ChainID()))
+	err = suite.App.OperatorKeeper.OptIn(suite.Ctx, suite.operatorAddr, avsAddr)
+	suite.NoError(err)
+	suite.CommitAfter(time.Hour*24 + time.Nanosecond)
+	infractionHeight := suite.Ctx.BlockHeight()
+	optedUSDValues, err := suite.App.OperatorKeeper.GetOperatorOptedUSDValue(suite.Ctx, avsAddr, suite.operatorAddr.String())
+	suite.NoError(err)
+	power := optedUSDValues.TotalUSDValue.TruncateInt64()
+	suite.NextBlock()
+
+	// the assets are doubled after the infraction, so the proportion applied to them is halved.
+	suite.prepareDelegation(true, suite.assetAddr, sdkmath.NewIntWithDecimal(100, assetDecimal))
+	suite.CommitAfter(time.Hour*24 + time.Nanosecond)
+
+	// the requested proportion reaches the freeze threshold, but the executed one doesn't.
+	params, err := suite.App.ExoSlashKeeper.GetParams(suite.Ctx)
+	suite.NoError(err)
+	slashType := stakingtypes.Infraction_INFRACTION_DOUBLE_SIGN
+	suite.App.OperatorKeeper.SlashWithInfractionReason(suite.Ctx, suite.operatorAddr, infractionHeight, power, params.FreezeThreshold, slashType)
+	slashID := keeper.GetSlashIDForDogfood(slashType, infractionHeight)
+	slashInfo, err := suite.App.OperatorKeeper.GetOperatorSlashInfo(suite.Ctx, avsAddr, suite.operatorAddr.String(), slashID)
+	suite.NoError(err)
+	suite.True(slashInfo.ExecutionInfo.SlashProportion.LT(params.FreezeThreshold))
+	suite.False(suite.App.ExoSlashKeeper.IsOperatorFrozen(suite.Ctx, suite.operatorAddr))
+}
+
+func (suite *OperatorTestSuite) TestSlashFromRedelegation() {
+	// prepare the deposit and delegation
+	suite.prepareOperator()
+	usdtAddress := common.HexToAddress("0xdAC17F958D2ee523a2206206994597C13D831ec7")
+	assetDecimal := 6
+	depositAmount := sdkmath.NewIntWithDecimal(200, assetDecimal)
+	suite.prepareDeposit(usdtAddress, depositAmount)
+	delegationAmount := sdkmath.NewIntWithDecimal(100, assetDecimal)
+	suite.prepareDelegation(true, suite.assetAddr, delegationAmount)
+	err := suite.App.DelegationKeeper.AssociateOperatorWithStaker(suite.Ctx, suite.clientChainLzID, suite.operatorAddr, suite.Address[:])
+	suite.NoError(err)
+	dstOperator, err := sdk.AccAddressFromBech32("exo18cggcpvwspnd5c6ny8wrqxpffj5zmhklprtnph")
+	suite.NoError(err)
+	_, err = s.OperatorMsgServer.RegisterOperator(s.Ctx, &types.RegisterOperatorReq{
+		FromAddress: dstOperator.String(),
+		Info: &types.OperatorInfo{
+			EarningsAddr: dstOperator.String(),
+		},
+	})
+	suite.NoError(err)
+
+	// opt into the AVS
+	avsAddr := avstypes.GenerateAVSAddr(avstypes.ChainIDWithoutRevision(suite.Ctx.ChainID()))
+	err = suite.App.OperatorKeeper.OptIn(suite.Ctx, suite.operatorAddr, avsAddr)
+	suite.NoError(err)
+	// call the EndBlock to update the voting power
+	suite.CommitAfter(time.Hour*24 + time.Nanosecond)
+	infractionHeight := suite.Ctx.BlockHeight()
+	optedUSDValues, err := suite.App.OperatorKeeper.GetOperatorOptedUSDValue(suite.Ctx, avsAddr, suite.operatorAddr.String())
+	suite.NoError(err)
+	power := optedUSDValues.TotalUSDValue.TruncateInt64()
+	suite.NextBlock()
+
+	// redelegates to another operator after the infraction
+	redelegateAmount := sdkmath.NewIntWithDecimal(40, assetDecimal)
+	err = suite.App.DelegationKeeper.Redelegate(suite.Ctx, &delegationtype.RedelegationParams{
+		ClientChainID:      suite.clientChainLzID,
+		AssetsAddress:      suite.assetAddr[:],
+		SrcOperatorAddress: suite.operatorAddr,
+		DstOperatorAddress: dstOperator,
+		StakerAddress:      suite.Address[:],
+		OpAmount:           redelegateAmount,
+		LzNonce:            1,
+		TxHash:             common.HexToHash("0x7d2e8c5a3b4f1e6d9c0a2b3f4e5d6c7b8a9f0e1d2c3b4a5f6e7d8c9b0a1f2e3d"),
+	})
+	suite.NoError(err)
+
+	// trigger the slash with a downtime event
+	slashFactor := suite.App.SlashingKeeper.SlashFractionDowntime(suite.Ctx)
+	slashType := stakingtypes.Infraction_INFRACTION_DOWNTIME
+	suite.App.OperatorKeeper.SlashWithInfractionReason(suite.Ctx, suite.operatorAddr, infractionHeight, power, slashFactor, slashType)
+	slashID := keeper.GetSlashIDForDogfood(slashType, infractionHeight)
+	slashInfo, err := suite.App.OperatorKeeper.GetOperatorSlashInfo(suite.Ctx, avsAddr, suite.operatorAddr.String(), slashID)
+	suite.NoError(err)
+
+	// the redelegated amount is part of the slashable value, so the slash proportion isn't
+	// inflated by the redelegation and the total slashed value matches the slash value.
+	suite.Equal(slashFactor, slashInfo.ExecutionInfo.SlashProportion)
+	suite.Equal(
+		[]types.SlashFromAssetsPool{{
+			AssetID: suite.assetID,
+			Amount:  slashFactor.MulInt(delegationAmount.Sub(redelegateAmount)).TruncateInt(),
+		}},
+		slashInfo.ExecutionInfo.SlashAssetsPool,
+	)
+
+	// the redelegated amount is slashed from the destination operator
+	slashAmount := slashInfo.ExecutionInfo.SlashProportion.MulInt(redelegateAmount).TruncateInt()
+	suite.True(slashAmount.IsPositive())
+	suite.Equal([]types.SlashFromRedelegation{{
+		StakerID:        suite.stakerID,
+		AssetID:         suite.assetID,
+		DstOperatorAddr: dstOperator.String(),
+		Amount:          slashAmount,
+	}}, slashInfo.ExecutionInfo.SlashRedelegations)
+	dstAssetsInfo, err := suite.App.AssetsKeeper.GetOperatorSpecifiedAssetInfo(suite.Ctx, dstOperator, suite.assetID)
+	suite.NoError(err)
+	suite.Equal(redelegateAmount.Sub(slashAmount), dstAssetsInfo.TotalAmount)
+	redelegations, err := suite.App.DelegationKeeper.GetStakerRedelegationRecords(suite.Ctx, suite.stakerID, suite.assetID)
+	suite.NoError(err)
+	suite.Equal(1, len(redelegations))
+	suite.Equal(redelegateAmount.Sub(slashAmount), redelegations[0].RemainingAmount)
+}
+
+func (suite *OperatorTestSuite) TestSlashWithStalePrice() {
+	// prepare the deposit and delegation
+	suite.prepareOperator()
+	usdtAddress := common.HexToAddress("0xdAC17F958D2ee523a2206206994597C13D831ec7")
+	assetDecimal := 6
+	depositAmount := sdkmath.NewIntWithDecimal(200, assetDecimal)
+	suite.prepareDeposit(usdtAddress, depositAmount)
+	delegationAmount := sdkmath.NewIntWithDecimal(100, assetDecimal)
+	suite.prepareDelegation(true, suite.assetAddr, delegationAmount)
+	err := suite.App.DelegationKeeper.AssociateOperatorWithStaker(suite.Ctx, suite.clientChainLzID, suite.operatorAddr, suite.Address[:])
+	suite.NoError(err)
+
+	// opt into the AVS
+	avsAddr := avstypes.GenerateAVSAddr(avstypes.ChainIDWithoutRevision(suite.Ctx.ChainID()))
+	err = suite.App.OperatorKeeper.OptIn(suite.Ctx, suite.operatorAddr, avsAddr)
+	suite.NoError(err)
+	// call the EndBlock to update the voting power
+	suite.CommitAfter(time.Hour*24 + time.Nanosecond)
+	infractionHeight := suite.Ctx.BlockHeight()
+	optedUSDValues, err := suite.App.OperatorKeeper.GetOperatorOptedUSDValue(suite.Ctx, avsAddr, suite.operatorAddr.String())
+	suite.NoError(err)
+	power := optedUSDValues.TotalUSDValue.TruncateInt64()
+
+	// the price of the asset goes stale since it isn't updated within the max age
+	params := suite.App.OracleKeeper.GetParams(suite.Ctx)
+	params.Tokens[1].MaxPriceAge = 1
+	suite.App.OracleKeeper.SetParams(suite.Ctx, params)
+	suite.App.OracleKeeper.ResetAggregatorContext()
+	suite.App.OracleKeeper.SetPriceUpdatedHeight(suite.Ctx, 1, suite.Ctx.BlockHeight())
+	suite.Ctx = suite.Ctx.WithBlockHeight(suite.Ctx.BlockHeight() + 2)
+	_, err = suite.App.OperatorKeeper.GetAssetsPrices(suite.Ctx, map[string]interface{}{suite.assetID: nil})
+	suite.Error(err)
+
+	// the asset is slashed by the slash factor, valued at its stale price
+	slashFactor := suite.App.SlashingKeeper.SlashFractionDowntime(suite.Ctx)
+	slashType := stakingtypes.Infraction_INFRACTION_DOWNTIME
+	suite.App.OperatorKeeper.SlashWithInfractionReason(suite.Ctx, suite.operatorAddr, infractionHeight, power, slashFactor, slashType)
+	slashID := keeper.GetSlashIDForDogfood(slashType, infractionHeight)
+	slashInfo, err := suite.App.OperatorKeeper.GetOperatorSlashInfo(suite.Ctx, avsAddr, suite.operatorAddr.String(), slashID)
+	suite.NoError(err)
+	suite.Equal(slashFactor, slashInfo.ExecutionInfo.SlashProportion)
+	suite.Equal([]types.SlashFromAssetsPool{{
+		AssetID: suite.assetID,
+		Amount:  slashFactor.MulInt(delegationAmount).TruncateInt(),
+	}}, slashInfo.ExecutionInfo.SlashAssetsPool)
+}
@@ -332,6 +332,10 @@ func (k *Keeper) GetAllAVSUSDValues(ctx sdk.Context) ([]operatortypes.AVSUSDValu
 // When it's called by the slash execution, the needed output is the sum of the current total amount and
 // the pending unbonding amount, because the undelegation also needs to be slashed. And the prices of
 // all assets haven't been prepared by the caller, so the prices should be retrieved in this function.
+// In both cases, the assets without a valid price are excluded instead of being valued by a default
+// price. For the voting power, the assets whose prices are missing or stale in the oracle are
+// excluded. For slashing, the stale prices are used, so only the assets without any price are
+// excluded, and they're also left out when the slash is applied.
 func (k *Keeper) CalculateUSDValueForOperator(
 	ctx sdk.Context,
 	isForSlash bool,
@@ -354,19 +358,15 @@ func (k *Keeper) CalculateUSDValueForOperator(
 		if isForSlash {
 			// when calculated the USD value for slashing, the input prices map is null
 			// so the price needs to be retrieved here
-			price, err = k.oracleKeeper.GetSpecifiedAssetsPrice(ctx, assetID)
-			if err != nil {
-				// TODO: when assetID is not registered in oracle module, this error will finally lead to panic
-				if !errors.Is(err, oracletype.ErrGetPriceRoundNotFound) {
-					return err
-				}
-				// TODO: for now, we ignore the error when the price round is not found and set the price to 1 to avoid panic
-			}
-			assetInfo, err := k.assetsKeeper.GetStakingAssetInfo(ctx, assetID)
+			var priced bool
+			price, decimal, priced, err = k.getSlashPrice(ctx, assetID)
 			if err != nil {
 				return err
 			}
-			decimal = assetInfo.AssetBasicInfo.Decimals
+			if !priced {
+				// the asset without any price is excluded
+				return nil
+			}
 			ret.StakingAndWaitUnbonding = ret.StakingAndWaitUnbonding.Add(CalculateUSDValue(state.TotalAmount.Add(state.PendingUndelegationAmount), price.Value, decimal, price.Decimal))
 		} else {
 			if prices == nil {
@@ -374,7 +374,8 @@ func (k *Keeper) CalculateUSDValueForOperator(
 			}
 			price, ok := prices[assetID]
 			if !ok {
-				return errorsmod.Wrap(operatortypes.ErrKeyNotExistInMap, "CalculateUSDValueForOperator map: prices, key: assetID")
+				// the asset whose price is missing or stale is unpriced and excluded
+				return nil
 			}
 			decimal, ok := decimals[assetID]
 			if !ok {
@@ -397,6 +398,32 @@ func (k *Keeper) CalculateUSDValueForOperator(
 	return ret, nil
 }
 
+// getSlashPrice returns the price and the decimals used to value the asset for slashing. The
+// asset is valued at its latest price even if the price is stale, since the slash would
+// otherwise be applied to the asset without accounting for its value. The returned flag is
+// false if the asset doesn't have any price.
+func (k *Keeper) getSlashPrice(ctx sdk.Context, assetID string) (oracletype.Price, uint32, bool, error) {
+	prices, err := k.oracleKeeper.GetMultipleAssetsLastPrices(ctx, map[string]interface{}{assetID: nil})
+	if err != nil {
+		// TODO: when assetID is not registered in oracle module, this error will finally lead to panic
+		if !errors.Is(err, oracletype.ErrGetPriceRoundNotFound) {
+			return oracletype.Price{}, 0, false, err
+		}
+		return oracletype.Price{}, 0, false, nil
+	}
+	assetInfo, err := k.assetsKeeper.GetStakingAssetInfo(ctx, assetID)
+	if err != nil {
+		return oracletype.Price{}, 0, false, err
+	}
+	return prices[assetID], assetInfo.AssetBasicInfo.Decimals, true, nil
+}
+
+// GetAssetsPrices returns the prices used to value the assets, which are the time-weighted average
+// prices over the latest TwapRounds rounds of the oracle, or the latest prices if it's zero.
+func (k Keeper) GetAssetsPrices(ctx sdk.Context, assets map[string]interface{}) (map[string]oracletype.Price, error) {
+	return k.oracleKeeper.GetMultipleAssetsTWAPPrices(ctx, assets, k.GetParams(ctx).TwapRounds)
+}
+
 func (k Keeper) GetOrCalculateOperatorUSDValues(
 	ctx sdk.Context,
 	operator sdk.AccAddress,
@@ -418,7 +445,7 @@ func (k Keeper) GetOrCalculateOperatorUSDValues(
 		if err != nil {
 			return operatortypes.OperatorOptedUSDValue{}, err
 		}
-		prices, err := k.oracleKeeper.GetMultipleAssetsPrices(ctx, assets)
+		prices, err := k.GetAssetsPrices(ctx, assets)
 		if err != nil {
 			return operatortypes.OperatorOptedUSDValue{}, err
 		}
@@ -457,7 +484,7 @@ func (k *Keeper) CalculateUSDValueForStaker(ctx sdk.Context, stakerID, avsAddr s
 	if assets == nil {
 		return sdkmath.LegacyNewDec(0), nil
 	}
-	prices, err := k.oracleKeeper.GetMultipleAssetsPrices(ctx, assets)
+	prices, err := k.GetAssetsPrices(ctx, assets)
 	// we don't ignore the error regarding the price round not found here, because it's used to
 	// distribute the reward.
 	if err != nil {
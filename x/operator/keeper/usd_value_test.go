@@ -1,12 +1,18 @@
 package keeper_test
 
 import (
+	"math/big"
 	"time"
 
 	sdkmath "cosmossdk.io/math"
 	assetstype "github.com/ExocoreNetwork/exocore/x/assets/types"
 	avstypes "github.com/ExocoreNetwork/exocore/x/avs/types"
 	operatorKeeper "github.com/ExocoreNetwork/exocore/x/operator/keeper"
+	operatortypes "github.com/ExocoreNetwork/exocore/x/operator/types"
+	"github.com/ExocoreNetwork/exocore/x/oracle/keeper/aggregator"
+	oracletypes "github.com/ExocoreNetwork/exocore/x/oracle/types"
+	abci "github.com/cometbft/cometbft/abci/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/ethereum/go-ethereum/common"
 )
 
@@ -176,3 +182,88 @@ func (suite *OperatorTestSuite) TestVotingPowerForDogFood() {
 		suite.Equal(initialPowers[i]+int64(addPower), update.Power)
 	}
 }
+
+func (suite *OperatorTestSuite) TestTWAPPrices() {
+	// the token 1 of the oracle is bonded with the asset and has the price 1 in round 1
+	assetID := suite.AssetIDs[0]
+	ok := suite.App.OracleKeeper.AppendPriceTR(suite.Ctx, 1, oracletypes.PriceTimeRound{Price: "3", RoundID: 2})
+	suite.True(ok)
+	assets := map[string]interface{}{assetID: nil}
+
+	prices, err := suite.App.OperatorKeeper.GetAssetsPrices(suite.Ctx, assets)
+	suite.NoError(err)
+	suite.Equal(sdkmath.NewInt(3), prices[assetID].Value)
+
+	params := suite.App.OperatorKeeper.GetParams(suite.Ctx)
+	params.TwapRounds = 2
+	suite.App.OperatorKeeper.SetParams(suite.Ctx, params)
+	prices, err = suite.App.OperatorKeeper.GetAssetsPrices(suite.Ctx, assets)
+	suite.NoError(err)
+	suite.Equal(sdkmath.NewInt(2), prices[assetID].Value)
+}
+
+func (suite *OperatorTestSuite) TestUnpricedAssets() {
+	suite.prepare()
+	suite.prepareAvs([]string{suite.assetID})
+	err := suite.App.OperatorKeeper.OptIn(suite.Ctx, suite.operatorAddr, suite.avsAddr)
+	suite.NoError(err)
+	suite.NoError(suite.App.OperatorKeeper.UpdateVotingPower(suite.Ctx, suite.avsAddr))
+	avsUSDValue, err := suite.App.OperatorKeeper.GetAVSUSDValue(suite.Ctx, suite.avsAddr)
+	suite.NoError(err)
+	suite.True(avsUSDValue.IsPositive())
+
+	// the price of the asset goes stale since it isn't updated within the max age
+	params := suite.App.OracleKeeper.GetParams(suite.Ctx)
+	params.Tokens[1].MaxPriceAge = 1
+	suite.App.OracleKeeper.SetParams(suite.Ctx, params)
+	suite.App.OracleKeeper.ResetAggregatorContext()
+	suite.App.OracleKeeper.SetPriceUpdatedHeight(suite.Ctx, 1, suite.Ctx.BlockHeight())
+	suite.Ctx = suite.Ctx.WithBlockHeight(suite.Ctx.BlockHeight() + 2).WithEventManager(sdk.NewEventManager())
+
+	_, err = suite.App.OperatorKeeper.GetAssetsPrices(suite.Ctx, map[string]interface{}{suite.assetID: nil})
+	suite.ErrorIs(err, oracletypes.ErrGetPriceRoundNotFound)
+	suite.NoError(suite.App.OperatorKeeper.UpdateVotingPower(suite.Ctx, suite.avsAddr))
+	avsUSDValue, err = suite.App.OperatorKeeper.GetAVSUSDValue(suite.Ctx, suite.avsAddr)
+	suite.NoError(err)
+	suite.True(avsUSDValue.IsZero())
+	optedUSDValues, err := suite.App.OperatorKeeper.GetOperatorOptedUSDValue(suite.Ctx, suite.avsAddr, suite.operatorAddr.String())
+	suite.NoError(err)
+	suite.True(optedUSDValues.TotalUSDValue.IsZero())
+
+	var found bool
+	for _, event := range suite.Ctx.EventManager().Events() {
+		if event.Type == operatortypes.EventTypeUnpricedAssets {
+			found = true
+			suite.Equal(suite.avsAddr, string(event.Attributes[0].Value))
+			suite.Equal(suite.assetID, string(event.Attributes[1].Value))
+		}
+	}
+	suite.True(found)
+
+	// the asset is still valued at its stale price for slashing
+	stakingInfo, err := suite.App.OperatorKeeper.CalculateUSDValueForOperator(suite.Ctx, true, suite.operatorAddr.String(), nil, nil, nil)
+	suite.NoError(err)
+	suite.True(stakingInfo.StakingAndWaitUnbonding.IsPositive())
+}
+
+func (suite *OperatorTestSuite) TestPriceUpdatedHook() {
+	suite.prepare()
+	suite.prepareAvs([]string{suite.assetID})
+	err := suite.App.OperatorKeeper.OptIn(suite.Ctx, suite.operatorAddr, suite.avsAddr)
+	suite.NoError(err)
+	suite.NoError(suite.App.OperatorKeeper.UpdateVotingPower(suite.Ctx, suite.avsAddr))
+	avsUSDValue, err := suite.App.OperatorKeeper.GetAVSUSDValue(suite.Ctx, suite.avsAddr)
+	suite.NoError(err)
+	suite.True(avsUSDValue.IsPositive())
+
+	// the token 1 of the oracle is bonded with the asset and has the price 1 in round 1
+	priceTR := oracletypes.PriceTimeRound{Price: "2", RoundID: 2}
+	suite.App.OracleKeeper.AppendFinalPrice(suite.Ctx, &aggregator.PriceItemKV{TokenID: 1, PriceTR: priceTR, FeederID: 1}, map[string]*big.Int{})
+	suite.Contains(suite.App.OperatorKeeper.GetPriceUpdatedAssets(suite.Ctx), suite.assetID)
+
+	suite.App.OperatorKeeper.EndBlock(suite.Ctx, abci.RequestEndBlock{})
+	suite.Empty(suite.App.OperatorKeeper.GetPriceUpdatedAssets(suite.Ctx))
+	newAVSUSDValue, err := suite.App.OperatorKeeper.GetAVSUSDValue(suite.Ctx, suite.avsAddr)
+	suite.NoError(err)
+	suite.Equal(avsUSDValue.MulInt64(2), newAVSUSDValue)
+}
@@ -0,0 +1,210 @@
+package keeper
+
+import (
+	"errors"
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+
+	operatortypes "github.com/ExocoreNetwork/exocore/x/operator/types"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// SetVotingPowerSnapshot stores the voting power snapshot of the AVS taken at the specified height.
+func (k *Keeper) SetVotingPowerSnapshot(ctx sdk.Context, avsAddr string, height int64, snapshot *operatortypes.VotingPowerSnapshot) error {
+	if height < 0 {
+		return errorsmod.Wrapf(operatortypes.ErrParameterInvalid, "SetVotingPowerSnapshot the height is negative: %d", height)
+	}
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), operatortypes.KeyPrefixVotingPowerSnapshot)
+	bz := k.cdc.MustMarshal(snapshot)
+	store.Set(operatortypes.KeyForVotingPowerSnapshot(avsAddr, height), bz)
+	return nil
+}
+
+// GetVotingPowerSnapshot returns the voting power snapshot which is effective at the specified
+// height for the AVS, that is, the latest snapshot taken at or before the height. It also
+// returns the height at which the snapshot is taken.
+func (k *Keeper) GetVotingPowerSnapshot(ctx sdk.Context, avsAddr string, height int64) (int64, *operatortypes.VotingPowerSnapshot, error) {
+	if height < 0 {
+		return 0, nil, errorsmod.Wrapf(operatortypes.ErrParameterInvalid, "GetVotingPowerSnapshot the height is negative: %d", height)
+	}
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), operatortypes.KeyPrefixVotingPowerSnapshot)
+	iterator := store.ReverseIterator(
+		operatortypes.KeyForVotingPowerSnapshot(avsAddr, 0),
+		operatortypes.KeyForVotingPowerSnapshot(avsAddr, height+1),
+	)
+	defer iterator.Close()
+	if !iterator.Valid() {
+		return 0, nil, errorsmod.Wrap(
+			operatortypes.ErrNoKeyInTheStore,
+			fmt.Sprintf("GetVotingPowerSnapshot: there isn't any snapshot for avs %s at height %d", avsAddr, height),
+		)
+	}
+	_, snapshotHeight, err := operatortypes.ParseVotingPowerSnapshotKey(iterator.Key())
+	if err != nil {
+		return 0, nil, err
+	}
+	var snapshot operatortypes.VotingPowerSnapshot
+	k.cdc.MustUnmarshal(iterator.Value(), &snapshot)
+	return snapshotHeight, &snapshot, nil
+}
+
+// GetOperatorVotingPowerAtHeight returns the voting power of the operator for the AVS at the
+// specified height according to the snapshots. The voting power is zero if the operator
+// isn't in the effective snapshot.
+func (k *Keeper) GetOperatorVotingPowerAtHeight(ctx sdk.Context, avsAddr, operatorAddr string, height int64) (sdkmath.LegacyDec, error) {
+	_, snapshot, err := k.GetVotingPowerSnapshot(ctx, avsAddr, height)
+	if err != nil {
+		// the voting power is only updated at the end of the epochs, where the snapshots are
+		// taken. so without any snapshot, the current voting power is the one at the height.
+		if errors.Is(err, operatortypes.ErrNoKeyInTheStore) && !k.HasVotingPowerSnapshot(ctx, avsAddr) {
+			return k.currentOperatorVotingPower(ctx, avsAddr, operatorAddr)
+		}
+		return sdkmath.LegacyDec{}, err
+	}
+	for _, powerInfo := range snapshot.OperatorVotingPowers {
+		if powerInfo.OperatorAddr == operatorAddr {
+			return powerInfo.VotingPower, nil
+		}
+	}
+	return sdkmath.LegacyNewDec(0), nil
+}
+
+// currentOperatorVotingPower returns the current voting power of the operator for the AVS,
+// which is zero if the operator isn't opted into the AVS.
+func (k *Keeper) currentOperatorVotingPower(ctx sdk.Context, avsAddr, operatorAddr string) (sdkmath.LegacyDec, error) {
+	optedUSDValues, err := k.GetOperatorOptedUSDValue(ctx, avsAddr, operatorAddr)
+	if err != nil {
+		if errors.Is(err, operatortypes.ErrNoKeyInTheStore) {
+			return sdkmath.LegacyNewDec(0), nil
+		}
+		return sdkmath.LegacyDec{}, err
+	}
+	return optedUSDValues.ActiveUSDValue, nil
+}
+
+// HasVotingPowerSnapshot returns whether any voting power snapshot is stored for the AVS.
+func (k *Keeper) HasVotingPowerSnapshot(ctx sdk.Context, avsAddr string) bool {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), operatortypes.KeyPrefixVotingPowerSnapshot)
+	iterator := sdk.KVStorePrefixIterator(store, operatortypes.VotingPowerSnapshotPrefixForAVS(avsAddr))
+	defer iterator.Close()
+	return iterator.Valid()
+}
+
+// SeedVotingPowerSnapshot stores the current voting power of the AVS at height 0 if the AVS
+// doesn't have any snapshot yet, so that the infractions committed before its first snapshot,
+// such as the ones before the upgrade introducing the snapshots, can still be slashed. It
+// should be called before the voting power is updated at the end of the epoch, since the
+// current voting power is the one effective since the end of the previous epoch.
+func (k *Keeper) SeedVotingPowerSnapshot(ctx sdk.Context, avsAddr, epochIdentifier string, epochNumber int64) error {
+	if k.HasVotingPowerSnapshot(ctx, avsAddr) {
+		return nil
+	}
+	return k.takeVotingPowerSnapshot(ctx, avsAddr, epochIdentifier, epochNumber-1, 0)
+}
+
+// TakeVotingPowerSnapshot stores the current voting power of the AVS and its operators. It
+// should be called after the voting power is updated at the end of the epoch.
+func (k *Keeper) TakeVotingPowerSnapshot(ctx sdk.Context, avsAddr, epochIdentifier string, epochNumber int64) error {
+	return k.takeVotingPowerSnapshot(ctx, avsAddr, epochIdentifier, epochNumber, ctx.BlockHeight())
+}
+
+// takeVotingPowerSnapshot stores the current voting power of the AVS and its operators as the
+// snapshot taken at the specified height.
+func (k *Keeper) takeVotingPowerSnapshot(ctx sdk.Context, avsAddr, epochIdentifier string, epochNumber, height int64) error {
+	totalVotingPower, err := k.GetAVSUSDValue(ctx, avsAddr)
+	if err != nil {
+		// the voting power is cleared if the AVS doesn't support any assets.
+		if !errors.Is(err, operatortypes.ErrNoKeyInTheStore) {
+			return err
+		}
+		totalVotingPower = sdkmath.LegacyNewDec(0)
+	}
+	snapshot := operatortypes.VotingPowerSnapshot{
+		EpochIdentifier:      epochIdentifier,
+		EpochNumber:          epochNumber,
+		TotalVotingPower:     totalVotingPower,
+		OperatorVotingPowers: make([]operatortypes.OperatorVotingPower, 0),
+	}
+	opFunc := func(operator string, optedUSDValues *operatortypes.OperatorOptedUSDValue) error {
+		snapshot.OperatorVotingPowers = append(snapshot.OperatorVotingPowers, operatortypes.OperatorVotingPower{
+			OperatorAddr: operator,
+			VotingPower:  optedUSDValues.ActiveUSDValue,
+		})
+		return nil
+	}
+	err = k.IterateOperatorsForAVS(ctx, avsAddr, false, opFunc)
+	if err != nil {
+		return err
+	}
+	return k.SetVotingPowerSnapshot(ctx, avsAddr, height, &snapshot)
+}
+
+// PruneVotingPowerSnapshots deletes the snapshots that can't be used for slashing anymore.
+// An infraction can only be slashed within the unbonding duration of the AVS, and the
+// voting power during an epoch is recorded by the snapshot taken at the end of the previous
+// epoch, so the snapshots taken before the epoch `epochNumber - unbondingDuration - 1` are
+// deleted.
+func (k *Keeper) PruneVotingPowerSnapshots(ctx sdk.Context, avsAddr string, epochNumber int64) error {
+	unbondingDuration, err := k.avsKeeper.GetAVSUnbondingDuration(ctx, avsAddr)
+	if err != nil {
+		return err
+	}
+	// #nosec G115 // the unbonding duration is small enough
+	retainedEpoch := epochNumber - int64(unbondingDuration) - 1
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), operatortypes.KeyPrefixVotingPowerSnapshot)
+	iterator := sdk.KVStorePrefixIterator(store, operatortypes.VotingPowerSnapshotPrefixForAVS(avsAddr))
+	defer iterator.Close()
+
+	var expiredKeys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		var snapshot operatortypes.VotingPowerSnapshot
+		k.cdc.MustUnmarshal(iterator.Value(), &snapshot)
+		// the snapshots are sorted by height, so the remaining ones are retained.
+		if snapshot.EpochNumber >= retainedEpoch {
+			break
+		}
+		expiredKeys = append(expiredKeys, iterator.Key())
+	}
+	for _, key := range expiredKeys {
+		store.Delete(key)
+	}
+	return nil
+}
+
+// SetAllVotingPowerSnapshots is used to import the voting power snapshots from the genesis state.
+func (k *Keeper) SetAllVotingPowerSnapshots(ctx sdk.Context, snapshots []operatortypes.AVSVotingPowerSnapshot) error {
+	for i := range snapshots {
+		snapshot := snapshots[i]
+		err := k.SetVotingPowerSnapshot(ctx, snapshot.AVSAddr, snapshot.Height, &snapshot.Snapshot)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// GetAllVotingPowerSnapshots is used to export all the voting power snapshots.
+func (k *Keeper) GetAllVotingPowerSnapshots(ctx sdk.Context) ([]operatortypes.AVSVotingPowerSnapshot, error) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), operatortypes.KeyPrefixVotingPowerSnapshot)
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+	defer iterator.Close()
+
+	ret := make([]operatortypes.AVSVotingPowerSnapshot, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		avsAddr, height, err := operatortypes.ParseVotingPowerSnapshotKey(iterator.Key())
+		if err != nil {
+			return nil, err
+		}
+		var snapshot operatortypes.VotingPowerSnapshot
+		k.cdc.MustUnmarshal(iterator.Value(), &snapshot)
+		ret = append(ret, operatortypes.AVSVotingPowerSnapshot{
+			AVSAddr:  avsAddr,
+			Height:   height,
+			Snapshot: snapshot,
+		})
+	}
+	return ret, nil
+}
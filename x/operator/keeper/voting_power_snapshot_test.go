@@ -0,0 +1,123 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	avstypes "github.com/ExocoreNetwork/exocore/x/avs/types"
+	"github.com/ExocoreNetwork/exocore/x/operator/types"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+func (suite *OperatorTestSuite) TestVotingPowerSnapshot() {
+	suite.prepareOperator()
+	usdtAddress := common.HexToAddress("0xdAC17F958D2ee523a2206206994597C13D831ec7")
+	assetDecimal := 6
+	suite.prepareDeposit(usdtAddress, sdkmath.NewIntWithDecimal(200, assetDecimal))
+	suite.prepareDelegation(true, suite.assetAddr, sdkmath.NewIntWithDecimal(100, assetDecimal))
+	// the self delegation is required by the dogfood AVS
+	err := suite.App.DelegationKeeper.AssociateOperatorWithStaker(suite.Ctx, suite.clientChainLzID, suite.operatorAddr, suite.Address[:])
+	suite.NoError(err)
+
+	avsAddr := avstypes.GenerateAVSAddr(avstypes.ChainIDWithoutRevision(suite.Ctx.ChainID()))
+	err = suite.App.OperatorKeeper.OptIn(suite.Ctx, suite.operatorAddr, avsAddr)
+	suite.NoError(err)
+	// the snapshot is taken after the voting power is updated at the end of the epoch
+	suite.CommitAfter(time.Hour*24 + time.Nanosecond)
+	snapshotHeight := suite.Ctx.BlockHeight()
+	optedUSDValues, err := suite.App.OperatorKeeper.GetOperatorOptedUSDValue(suite.Ctx, avsAddr, suite.operatorAddr.String())
+	suite.NoError(err)
+	suite.True(optedUSDValues.ActiveUSDValue.IsPositive())
+
+	height, snapshot, err := suite.App.OperatorKeeper.GetVotingPowerSnapshot(suite.Ctx, avsAddr, snapshotHeight)
+	suite.NoError(err)
+	suite.Equal(snapshotHeight, height)
+	avsUSDValue, err := suite.App.OperatorKeeper.GetAVSUSDValue(suite.Ctx, avsAddr)
+	suite.NoError(err)
+	suite.Equal(avsUSDValue, snapshot.TotalVotingPower)
+	// the genesis operators are also included in the snapshot
+	suite.Contains(snapshot.OperatorVotingPowers, types.OperatorVotingPower{
+		OperatorAddr: suite.operatorAddr.String(), VotingPower: optedUSDValues.ActiveUSDValue,
+	})
+
+	// the voting power changes in the next epoch, but the historical power doesn't.
+	suite.NextBlock()
+	infractionHeight := suite.Ctx.BlockHeight()
+	suite.prepareDelegation(true, suite.assetAddr, sdkmath.NewIntWithDecimal(20, assetDecimal))
+	suite.CommitAfter(time.Hour*24 + time.Nanosecond)
+	newOptedUSDValues, err := suite.App.OperatorKeeper.GetOperatorOptedUSDValue(suite.Ctx, avsAddr, suite.operatorAddr.String())
+	suite.NoError(err)
+	suite.True(newOptedUSDValues.ActiveUSDValue.GT(optedUSDValues.ActiveUSDValue))
+
+	power, err := suite.App.OperatorKeeper.GetOperatorVotingPowerAtHeight(suite.Ctx, avsAddr, suite.operatorAddr.String(), infractionHeight)
+	suite.NoError(err)
+	suite.Equal(optedUSDValues.ActiveUSDValue, power)
+	power, err = suite.App.OperatorKeeper.GetOperatorVotingPowerAtHeight(suite.Ctx, avsAddr, suite.operatorAddr.String(), suite.Ctx.BlockHeight())
+	suite.NoError(err)
+	suite.Equal(newOptedUSDValues.ActiveUSDValue, power)
+
+	// the voting power before the first update is seeded at height 0, when the operator
+	// doesn't have any voting power yet.
+	height, seed, err := suite.App.OperatorKeeper.GetVotingPowerSnapshot(suite.Ctx, avsAddr, snapshotHeight-1)
+	suite.NoError(err)
+	suite.Equal(int64(0), height)
+	suite.Equal(snapshot.EpochNumber-1, seed.EpochNumber)
+	power, err = suite.App.OperatorKeeper.GetOperatorVotingPowerAtHeight(suite.Ctx, avsAddr, suite.operatorAddr.String(), snapshotHeight-1)
+	suite.NoError(err)
+	suite.True(power.IsZero())
+}
+
+func (suite *OperatorTestSuite) TestVotingPowerWithoutSnapshot() {
+	suite.prepareOperator()
+	usdtAddress := common.HexToAddress("0xdAC17F958D2ee523a2206206994597C13D831ec7")
+	assetDecimal := 6
+	suite.prepareDeposit(usdtAddress, sdkmath.NewIntWithDecimal(200, assetDecimal))
+	suite.prepareDelegation(true, suite.assetAddr, sdkmath.NewIntWithDecimal(100, assetDecimal))
+
+	avsAddr := avstypes.GenerateAVSAddr(avstypes.ChainIDWithoutRevision(suite.Ctx.ChainID()))
+	suite.False(suite.App.OperatorKeeper.HasVotingPowerSnapshot(suite.Ctx, avsAddr))
+	_, _, err := suite.App.OperatorKeeper.GetVotingPowerSnapshot(suite.Ctx, avsAddr, suite.Ctx.BlockHeight())
+	suite.ErrorIs(err, types.ErrNoKeyInTheStore)
+
+	// without any snapshot, the current voting power is used
+	power, err := suite.App.OperatorKeeper.GetOperatorVotingPowerAtHeight(suite.Ctx, avsAddr, suite.operatorAddr.String(), suite.Ctx.BlockHeight())
+	suite.NoError(err)
+	suite.True(power.IsZero())
+
+	// the self delegation is required by the dogfood AVS
+	err = suite.App.DelegationKeeper.AssociateOperatorWithStaker(suite.Ctx, suite.clientChainLzID, suite.operatorAddr, suite.Address[:])
+	suite.NoError(err)
+	suite.NoError(suite.App.OperatorKeeper.OptIn(suite.Ctx, suite.operatorAddr, avsAddr))
+	suite.NoError(suite.App.OperatorKeeper.UpdateVotingPower(suite.Ctx, avsAddr))
+	optedUSDValues, err := suite.App.OperatorKeeper.GetOperatorOptedUSDValue(suite.Ctx, avsAddr, suite.operatorAddr.String())
+	suite.NoError(err)
+	suite.True(optedUSDValues.ActiveUSDValue.IsPositive())
+	power, err = suite.App.OperatorKeeper.GetOperatorVotingPowerAtHeight(suite.Ctx, avsAddr, suite.operatorAddr.String(), suite.Ctx.BlockHeight())
+	suite.NoError(err)
+	suite.Equal(optedUSDValues.ActiveUSDValue, power)
+}
+
+func (suite *OperatorTestSuite) TestPruneVotingPowerSnapshots() {
+	avsAddr := avstypes.GenerateAVSAddr(avstypes.ChainIDWithoutRevision(suite.Ctx.ChainID()))
+	unbondingDuration, err := suite.App.AVSManagerKeeper.GetAVSUnbondingDuration(suite.Ctx, avsAddr)
+	suite.NoError(err)
+
+	epochNumber := int64(unbondingDuration) + 10
+	for i := int64(1); i <= epochNumber; i++ {
+		err = suite.App.OperatorKeeper.SetVotingPowerSnapshot(suite.Ctx, avsAddr, i*10, &types.VotingPowerSnapshot{
+			EpochNumber:      i,
+			TotalVotingPower: sdkmath.LegacyNewDec(i),
+		})
+		suite.NoError(err)
+	}
+	err = suite.App.OperatorKeeper.PruneVotingPowerSnapshots(suite.Ctx, avsAddr, epochNumber)
+	suite.NoError(err)
+
+	snapshots, err := suite.App.OperatorKeeper.GetAllVotingPowerSnapshots(suite.Ctx)
+	suite.NoError(err)
+	retainedEpoch := epochNumber - int64(unbondingDuration) - 1
+	suite.Equal(int(epochNumber-retainedEpoch+1), len(snapshots))
+	for _, snapshot := range snapshots {
+		suite.GreaterOrEqual(snapshot.Snapshot.EpochNumber, retainedEpoch)
+	}
+}
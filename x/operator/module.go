@@ -79,8 +79,21 @@ func (am AppModule) IsAppModule() {}
 func (am AppModule) RegisterServices(cfg module.Configurator) {
 	operatortypes.RegisterMsgServer(cfg.MsgServer(), keeper.NewMsgServerImpl(am.keeper))
 	operatortypes.RegisterQueryServer(cfg.QueryServer(), &am.keeper)
+
+	m := keeper.NewMigrator(am.keeper)
+	if err := cfg.RegisterMigration(operatortypes.ModuleName, 1, m.Migrate1to2); err != nil {
+		panic(err)
+	}
 }
 
+// ConsensusVersion is a sequence number for state-breaking change of the module. It should be
+// incremented on each consensus-breaking change introduced by the module. To avoid wrong/empty
+// versions, the initial version should be set to 1.
+// The module didn't report a version before v2, so the chains running it have 0 in their
+// version map. The v1.1.0 upgrade handler sets it to 1 before running the migrations, since
+// the SDK doesn't allow registering a migration from version 0.
+func (AppModule) ConsensusVersion() uint64 { return 2 }
+
 func (am AppModule) GenerateGenesisState(_ *module.SimulationState) {
 }
 
@@ -19,9 +19,11 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 	registry.RegisterImplementations(
 		(*sdk.Msg)(nil),
 		&RegisterOperatorReq{},
+		&EditOperatorReq{},
 		&OptIntoAVSReq{},
 		&OptOutOfAVSReq{},
 		&SetConsKeyReq{},
+		&MsgUpdateParams{},
 	)
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
 }
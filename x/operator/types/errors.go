@@ -111,4 +111,14 @@ var (
 		ModuleName, 24,
 		"the operator USD value is less than the minimum self delegation",
 	)
+
+	ErrInvalidParams = errorsmod.Register(
+		ModuleName, 25,
+		"invalid params",
+	)
+
+	ErrCommissionLTMinRate = errorsmod.Register(
+		ModuleName, 26,
+		"the commission rate is less than the minimum commission rate",
+	)
 )
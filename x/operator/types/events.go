@@ -0,0 +1,18 @@
+package types
+
+// operator events
+const (
+	// EventTypeUnpricedAssets is emitted when the assets without a valid price are excluded from
+	// the voting power of an AVS.
+	EventTypeUnpricedAssets = "unpriced_assets"
+	// EventTypeCommissionRaised is emitted when the commission of an operator is raised to a new
+	// minimum commission rate, outside of the usual commission edit rules.
+	EventTypeCommissionRaised = "commission_raised"
+
+	AttributeKeyAVSAddr  = "avs_address"
+	AttributeKeyAssetIDs = "asset_ids"
+	AttributeKeyOperator = "operator"
+	AttributeKeyOldRate  = "old_rate"
+	AttributeKeyNewRate  = "new_rate"
+	AttributeKeyMaxRate  = "max_rate"
+)
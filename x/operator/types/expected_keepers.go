@@ -1,6 +1,8 @@
 package types
 
 import (
+	"time"
+
 	sdkmath "cosmossdk.io/math"
 	keytypes "github.com/ExocoreNetwork/exocore/types/keys"
 	assetstype "github.com/ExocoreNetwork/exocore/x/assets/types"
@@ -34,6 +36,12 @@ type DelegationKeeper interface {
 	IterateUndelegationsByOperator(
 		ctx sdk.Context, operator string, heightFilter *uint64, isUpdate bool,
 		opFunc func(undelegation *delegationtype.UndelegationRecord) error) error
+	IterateRedelegationsBySrcOperator(
+		ctx sdk.Context, operator string, heightFilter *uint64, isUpdate bool,
+		opFunc func(redelegation *delegationtype.RedelegationRecord) error) error
+	SlashRedelegation(
+		ctx sdk.Context, redelegation *delegationtype.RedelegationRecord, slashAmount sdkmath.Int,
+	) (sdkmath.Int, error)
 	HasStakerList(ctx sdk.Context, operator, assetID string) bool
 	GetStakersByOperator(
 		ctx sdk.Context, operator, assetID string,
@@ -69,6 +77,14 @@ type OracleKeeper interface {
 	// GetMultipleAssetsPrices is a function to retrieve multiple assets prices according to the
 	// assetID.
 	GetMultipleAssetsPrices(ctx sdk.Context, assets map[string]interface{}) (map[string]oracletype.Price, error)
+	// GetMultipleAssetsTWAPPrices is a function to retrieve the time-weighted average prices of
+	// multiple assets over the latest rounds, it returns the latest prices if rounds is zero.
+	GetMultipleAssetsTWAPPrices(ctx sdk.Context, assets map[string]interface{}, rounds uint64) (map[string]oracletype.Price, error)
+	// GetMultipleAssetsLastPrices is a function to retrieve the latest prices of multiple
+	// assets, including the stale ones.
+	GetMultipleAssetsLastPrices(ctx sdk.Context, assets map[string]interface{}) (map[string]oracletype.Price, error)
+	// GetAssetIDsFromTokenID returns the assetIDs bound to the oracle token.
+	GetAssetIDsFromTokenID(ctx sdk.Context, tokenID uint64) []string
 }
 
 type MockOracle struct{}
@@ -91,6 +107,18 @@ func (MockOracle) GetMultipleAssetsPrices(_ sdk.Context, assets map[string]inter
 	return ret, nil
 }
 
+func (m MockOracle) GetMultipleAssetsTWAPPrices(ctx sdk.Context, assets map[string]interface{}, _ uint64) (map[string]oracletype.Price, error) {
+	return m.GetMultipleAssetsPrices(ctx, assets)
+}
+
+func (m MockOracle) GetMultipleAssetsLastPrices(ctx sdk.Context, assets map[string]interface{}) (map[string]oracletype.Price, error) {
+	return m.GetMultipleAssetsPrices(ctx, assets)
+}
+
+func (MockOracle) GetAssetIDsFromTokenID(_ sdk.Context, _ uint64) []string {
+	return nil
+}
+
 type AVSKeeper interface {
 	// GetAVSSupportedAssets The ctx can be historical or current, depending on the state you
 	// wish to retrieve. If the caller want to retrieve a historical assets info supported by
@@ -103,6 +131,12 @@ type AVSKeeper interface {
 	// GetAVSMinimumSelfDelegation returns the USD value of minimum self delegation, which
 	// is set for operator
 	GetAVSMinimumSelfDelegation(ctx sdk.Context, avsAddr string) (sdkmath.LegacyDec, error)
+	// GetAVSUnbondingDuration returns the unbonding duration of the AVS in the number of epochs.
+	// It's also used as the retention window of the voting power snapshots.
+	GetAVSUnbondingDuration(ctx sdk.Context, avsAddr string) (uint64, error)
+	// GetAVSUnbondingEpoch returns the epoch at the end of which an unbonding from the AVS,
+	// started in the current epoch, completes, along with the estimated end time of the epoch.
+	GetAVSUnbondingEpoch(ctx sdk.Context, avsAddr string) (epochIdentifier string, epochNumber int64, endTime time.Time, err error)
 	// GetEpochEndAVSs returns the AVS list where the current block marks the end of their epoch.
 	// todo: maybe the epoch of different AVSs should be implemented in the AVS module,then
 	// the other modules implement the EpochsHooks to trigger state updating.
@@ -114,6 +148,10 @@ type AVSKeeper interface {
 
 type SlashKeeper interface {
 	IsOperatorFrozen(ctx sdk.Context, addr sdk.AccAddress) bool
+	// FreezeOperatorForSlash freezes the operator if the slash proportion is severe enough.
+	FreezeOperatorForSlash(
+		ctx sdk.Context, addr sdk.AccAddress, avsAddr, slashID string, slashProportion sdkmath.LegacyDec,
+	) error
 }
 
 type OperatorHooks interface {
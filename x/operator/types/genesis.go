@@ -11,6 +11,7 @@ import (
 )
 
 func NewGenesisState(
+	params Params,
 	operators []OperatorDetail,
 	operatorConsKeys []OperatorConsKeyRecord,
 	optStates []OptedState,
@@ -21,6 +22,7 @@ func NewGenesisState(
 	operatorKeyRemovals []OperatorKeyRemoval,
 ) *GenesisState {
 	return &GenesisState{
+		Params:              params,
 		Operators:           operators,
 		OperatorRecords:     operatorConsKeys,
 		OptStates:           optStates,
@@ -34,7 +36,7 @@ func NewGenesisState(
 
 // DefaultGenesis returns the default genesis state
 func DefaultGenesis() *GenesisState {
-	return NewGenesisState(nil, nil, nil, nil, nil, nil, nil, nil)
+	return NewGenesisState(DefaultParams(), nil, nil, nil, nil, nil, nil, nil, nil)
 }
 
 // ValidateOperators rationale for the validation:
@@ -106,6 +108,13 @@ func (gs GenesisState) ValidateOperators() (map[string]struct{}, error) {
 				"invalid commission for operator %s: %s", address, err,
 			)
 		}
+		if op.OperatorInfo.Commission.Rate.LT(gs.Params.MinCommissionRate) {
+			return nil, errorsmod.Wrapf(
+				ErrInvalidGenesisData,
+				"commission rate %s of operator %s is less than the minimum %s",
+				op.OperatorInfo.Commission.Rate, address, gs.Params.MinCommissionRate,
+			)
+		}
 	}
 	return operators, nil
 }
@@ -425,6 +434,16 @@ func (gs GenesisState) ValidateSlashStates(operators, avs map[string]struct{}) e
 		if err != nil {
 			return errorsmod.Wrap(ErrInvalidGenesisData, err.Error())
 		}
+		// validate the slashing record regarding redelegation
+		for _, slashFromRedelegation := range slash.Info.ExecutionInfo.SlashRedelegations {
+			if slashFromRedelegation.Amount.IsNil() || slashFromRedelegation.Amount.LTE(sdkmath.NewInt(0)) {
+				return errorsmod.Wrapf(
+					ErrInvalidGenesisData,
+					"invalid slashing amount from the redelegation, it's nil, zero, or negative: %+v",
+					slash,
+				)
+			}
+		}
 		return nil
 	}
 	seenFieldValueFunc := func(slash OperatorSlashState) (string, struct{}) {
@@ -511,9 +530,73 @@ func (gs GenesisState) ValidateOperatorKeyRemovals(operators map[string]struct{}
 	return nil
 }
 
+func (gs GenesisState) ValidateVotingPowerSnapshots(operators map[string]struct{}) error {
+	validationFunc := func(_ int, snapshot AVSVotingPowerSnapshot) error {
+		if !common.IsHexAddress(snapshot.AVSAddr) {
+			return errorsmod.Wrapf(
+				ErrInvalidGenesisData,
+				"the AVS address isn't an ethereum hex address, snapshot: %+v",
+				snapshot,
+			)
+		}
+		if snapshot.Height < 0 {
+			return errorsmod.Wrapf(
+				ErrInvalidGenesisData,
+				"the height of the voting power snapshot is negative, snapshot: %+v",
+				snapshot,
+			)
+		}
+		if snapshot.Snapshot.TotalVotingPower.IsNil() ||
+			snapshot.Snapshot.TotalVotingPower.IsNegative() {
+			return errorsmod.Wrapf(
+				ErrInvalidGenesisData,
+				"the total voting power is nil or negative, snapshot: %+v",
+				snapshot,
+			)
+		}
+		powerValidationFunc := func(_ int, power OperatorVotingPower) error {
+			// check that the operator is registered
+			if _, ok := operators[power.OperatorAddr]; !ok {
+				return errorsmod.Wrapf(
+					ErrInvalidGenesisData,
+					"unknown operator address for the voting power snapshot, %+v",
+					power,
+				)
+			}
+			if power.VotingPower.IsNil() || power.VotingPower.IsNegative() {
+				return errorsmod.Wrapf(
+					ErrInvalidGenesisData,
+					"the voting power of operator is nil or negative, %+v",
+					power,
+				)
+			}
+			return nil
+		}
+		powerSeenFieldValueFunc := func(power OperatorVotingPower) (string, struct{}) {
+			return power.OperatorAddr, struct{}{}
+		}
+		_, err := utils.CommonValidation(snapshot.Snapshot.OperatorVotingPowers, powerSeenFieldValueFunc, powerValidationFunc)
+		if err != nil {
+			return errorsmod.Wrap(ErrInvalidGenesisData, err.Error())
+		}
+		return nil
+	}
+	seenFieldValueFunc := func(snapshot AVSVotingPowerSnapshot) (string, struct{}) {
+		return string(KeyForVotingPowerSnapshot(snapshot.AVSAddr, snapshot.Height)), struct{}{}
+	}
+	_, err := utils.CommonValidation(gs.VotingPowerSnapshots, seenFieldValueFunc, validationFunc)
+	if err != nil {
+		return errorsmod.Wrap(ErrInvalidGenesisData, err.Error())
+	}
+	return nil
+}
+
 // Validate performs basic genesis state validation returning an error upon any
 // failure.
 func (gs GenesisState) Validate() error {
+	if err := gs.Params.Validate(); err != nil {
+		return errorsmod.Wrap(ErrInvalidGenesisData, err.Error())
+	}
 	operators, err := gs.ValidateOperators()
 	if err != nil {
 		return err
@@ -546,5 +629,9 @@ func (gs GenesisState) Validate() error {
 	if err != nil {
 		return err
 	}
+	err = gs.ValidateVotingPowerSnapshots(operators)
+	if err != nil {
+		return err
+	}
 	return nil
 }
@@ -27,7 +27,6 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // GenesisState defines the operator module's genesis state.
 type GenesisState struct {
-	// there are no params for this module.
 	// operators is a list of the registered operators.
 	Operators []OperatorDetail `protobuf:"bytes,1,rep,name=operators,proto3" json:"operators"`
 	// add other information for exporting / importing.
@@ -50,6 +49,10 @@ type GenesisState struct {
 	// operator_key_removal is a list of operator with the given address
 	// is in the process of unbonding their key for the given chainID.
 	OperatorKeyRemovals []OperatorKeyRemoval `protobuf:"bytes,8,rep,name=operator_key_removals,json=operatorKeyRemovals,proto3" json:"operator_key_removals"`
+	// voting_power_snapshots is a list of the retained voting power snapshots of the AVSs.
+	VotingPowerSnapshots []AVSVotingPowerSnapshot `protobuf:"bytes,9,rep,name=voting_power_snapshots,json=votingPowerSnapshots,proto3" json:"voting_power_snapshots"`
+	// params is the parameters of the operator module.
+	Params Params `protobuf:"bytes,10,opt,name=params,proto3" json:"params"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -141,6 +144,20 @@ func (m *GenesisState) GetOperatorKeyRemovals() []OperatorKeyRemoval {
 	return nil
 }
 
+func (m *GenesisState) GetVotingPowerSnapshots() []AVSVotingPowerSnapshot {
+	if m != nil {
+		return m.VotingPowerSnapshots
+	}
+	return nil
+}
+
+func (m *GenesisState) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
 // OperatorDetail is helper structure to store the operator information for the genesis state.
 // it's corresponding to the kvStore `KeyPrefixOperatorInfo`
 type OperatorDetail struct {
@@ -533,6 +550,71 @@ func (m *OperatorKeyRemoval) GetKey() string {
 	return ""
 }
 
+// AVSVotingPowerSnapshot is helper structure to store the voting power snapshot for the genesis state.
+// it's corresponding to the kvStore `KeyPrefixVotingPowerSnapshot`
+type AVSVotingPowerSnapshot struct {
+	// avs_addr is the hex address of the AVS.
+	AVSAddr string `protobuf:"bytes,1,opt,name=avs_addr,json=avsAddr,proto3" json:"avs_addr,omitempty"`
+	// height is the block height at which the snapshot is taken.
+	Height int64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	// snapshot is the voting power snapshot for the above AVS and height.
+	Snapshot VotingPowerSnapshot `protobuf:"bytes,3,opt,name=snapshot,proto3" json:"snapshot"`
+}
+
+func (m *AVSVotingPowerSnapshot) Reset()         { *m = AVSVotingPowerSnapshot{} }
+func (m *AVSVotingPowerSnapshot) String() string { return proto.CompactTextString(m) }
+func (*AVSVotingPowerSnapshot) ProtoMessage()    {}
+func (*AVSVotingPowerSnapshot) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bb7040bc6ae6ddee, []int{8}
+}
+func (m *AVSVotingPowerSnapshot) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AVSVotingPowerSnapshot) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AVSVotingPowerSnapshot.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AVSVotingPowerSnapshot) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AVSVotingPowerSnapshot.Merge(m, src)
+}
+func (m *AVSVotingPowerSnapshot) XXX_Size() int {
+	return m.Size()
+}
+func (m *AVSVotingPowerSnapshot) XXX_DiscardUnknown() {
+	xxx_messageInfo_AVSVotingPowerSnapshot.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AVSVotingPowerSnapshot proto.InternalMessageInfo
+
+func (m *AVSVotingPowerSnapshot) GetAVSAddr() string {
+	if m != nil {
+		return m.AVSAddr
+	}
+	return ""
+}
+
+func (m *AVSVotingPowerSnapshot) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *AVSVotingPowerSnapshot) GetSnapshot() VotingPowerSnapshot {
+	if m != nil {
+		return m.Snapshot
+	}
+	return VotingPowerSnapshot{}
+}
+
 // OperatorConsKeyRecord is a helper structure for the genesis state. Each record
 // contains an operator address and a list of chain id + cons key combination.
 type OperatorConsKeyRecord struct {
@@ -547,7 +629,7 @@ func (m *OperatorConsKeyRecord) Reset()         { *m = OperatorConsKeyRecord{} }
 func (m *OperatorConsKeyRecord) String() string { return proto.CompactTextString(m) }
 func (*OperatorConsKeyRecord) ProtoMessage()    {}
 func (*OperatorConsKeyRecord) Descriptor() ([]byte, []int) {
-	return fileDescriptor_bb7040bc6ae6ddee, []int{8}
+	return fileDescriptor_bb7040bc6ae6ddee, []int{9}
 }
 func (m *OperatorConsKeyRecord) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -605,7 +687,7 @@ func (m *ChainDetails) Reset()         { *m = ChainDetails{} }
 func (m *ChainDetails) String() string { return proto.CompactTextString(m) }
 func (*ChainDetails) ProtoMessage()    {}
 func (*ChainDetails) Descriptor() ([]byte, []int) {
-	return fileDescriptor_bb7040bc6ae6ddee, []int{9}
+	return fileDescriptor_bb7040bc6ae6ddee, []int{10}
 }
 func (m *ChainDetails) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -662,7 +744,7 @@ func (m *StakerRecord) Reset()         { *m = StakerRecord{} }
 func (m *StakerRecord) String() string { return proto.CompactTextString(m) }
 func (*StakerRecord) ProtoMessage()    {}
 func (*StakerRecord) Descriptor() ([]byte, []int) {
-	return fileDescriptor_bb7040bc6ae6ddee, []int{10}
+	return fileDescriptor_bb7040bc6ae6ddee, []int{11}
 }
 func (m *StakerRecord) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -718,7 +800,7 @@ func (m *StakerDetails) Reset()         { *m = StakerDetails{} }
 func (m *StakerDetails) String() string { return proto.CompactTextString(m) }
 func (*StakerDetails) ProtoMessage()    {}
 func (*StakerDetails) Descriptor() ([]byte, []int) {
-	return fileDescriptor_bb7040bc6ae6ddee, []int{11}
+	return fileDescriptor_bb7040bc6ae6ddee, []int{12}
 }
 func (m *StakerDetails) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -776,7 +858,7 @@ func (m *AssetDetails) Reset()         { *m = AssetDetails{} }
 func (m *AssetDetails) String() string { return proto.CompactTextString(m) }
 func (*AssetDetails) ProtoMessage()    {}
 func (*AssetDetails) Descriptor() ([]byte, []int) {
-	return fileDescriptor_bb7040bc6ae6ddee, []int{12}
+	return fileDescriptor_bb7040bc6ae6ddee, []int{13}
 }
 func (m *AssetDetails) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -821,6 +903,7 @@ func init() {
 	proto.RegisterType((*OperatorSlashState)(nil), "exocore.operator.v1.OperatorSlashState")
 	proto.RegisterType((*PrevConsKey)(nil), "exocore.operator.v1.PrevConsKey")
 	proto.RegisterType((*OperatorKeyRemoval)(nil), "exocore.operator.v1.OperatorKeyRemoval")
+	proto.RegisterType((*AVSVotingPowerSnapshot)(nil), "exocore.operator.v1.AVSVotingPowerSnapshot")
 	proto.RegisterType((*OperatorConsKeyRecord)(nil), "exocore.operator.v1.OperatorConsKeyRecord")
 	proto.RegisterType((*ChainDetails)(nil), "exocore.operator.v1.ChainDetails")
 	proto.RegisterType((*StakerRecord)(nil), "exocore.operator.v1.StakerRecord")
@@ -831,63 +914,70 @@ func init() {
 func init() { proto.RegisterFile("exocore/operator/v1/genesis.proto", fileDescriptor_bb7040bc6ae6ddee) }
 
 var fileDescriptor_bb7040bc6ae6ddee = []byte{
-	// 886 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x56, 0xcd, 0x6e, 0xdb, 0x46,
-	0x10, 0x36, 0xe3, 0x1f, 0xc9, 0x23, 0xca, 0x71, 0x36, 0x31, 0xa0, 0x04, 0x85, 0xe4, 0x30, 0xa8,
-	0xeb, 0x16, 0x88, 0x84, 0xb8, 0xd7, 0xa2, 0xa9, 0x14, 0xb5, 0x81, 0x9a, 0xa0, 0x09, 0xa8, 0xc6,
-	0x87, 0xa6, 0x00, 0xc1, 0x88, 0x1b, 0x9b, 0x90, 0xcc, 0x25, 0x38, 0x2b, 0xd6, 0xea, 0xb5, 0xa7,
-	0xf6, 0xd2, 0x3e, 0x4c, 0x1f, 0x22, 0x40, 0x2f, 0x41, 0x4f, 0x45, 0x0f, 0x42, 0x21, 0xbf, 0x48,
-	0xb1, 0x3f, 0x24, 0x57, 0x0e, 0xa3, 0x24, 0x27, 0xed, 0xce, 0xcf, 0xf7, 0xcd, 0x8c, 0xbe, 0x21,
-	0x16, 0x6e, 0xd3, 0x73, 0x36, 0x62, 0x09, 0xed, 0xb0, 0x98, 0x26, 0x3e, 0x67, 0x49, 0x27, 0xbd,
-	0xd7, 0x39, 0xa1, 0x11, 0xc5, 0x10, 0xdb, 0x71, 0xc2, 0x38, 0x23, 0xd7, 0x75, 0x48, 0x3b, 0x0b,
-	0x69, 0xa7, 0xf7, 0x6e, 0xdd, 0x1c, 0x31, 0x3c, 0x63, 0xe8, 0xc9, 0x90, 0x8e, 0xba, 0xa8, 0xf8,
-	0x5b, 0x37, 0x4e, 0xd8, 0x09, 0x53, 0x76, 0x71, 0xd2, 0xd6, 0x8f, 0xca, 0x88, 0xf8, 0xb9, 0xf2,
-	0x3a, 0x7f, 0x6d, 0x82, 0xfd, 0x50, 0xb1, 0x0e, 0xb9, 0xcf, 0x29, 0x79, 0x08, 0xdb, 0x59, 0x20,
-	0x36, 0xac, 0xfd, 0xf5, 0xc3, 0xda, 0xd1, 0x9d, 0x76, 0x49, 0x21, 0xed, 0x27, 0xfa, 0xdc, 0xa7,
-	0xdc, 0x0f, 0x27, 0xbd, 0x8d, 0x57, 0xf3, 0xd6, 0x9a, 0x5b, 0xe4, 0x92, 0xe7, 0xb0, 0x9b, 0x5d,
-	0xbc, 0x84, 0x8e, 0x58, 0x12, 0x60, 0xe3, 0x8a, 0xc4, 0xfb, 0x6c, 0x25, 0xde, 0x03, 0x16, 0xe1,
-	0x23, 0x3a, 0x73, 0x65, 0x8a, 0x86, 0xbd, 0x9a, 0x05, 0x2a, 0x2b, 0x92, 0x3e, 0x00, 0x8b, 0xb9,
-	0x87, 0xa2, 0x64, 0x6c, 0xac, 0x4b, 0xd8, 0xd6, 0x5b, 0x60, 0x39, 0x0d, 0x64, 0x6b, 0x45, 0x89,
-	0x5c, 0xde, 0x91, 0xfc, 0x08, 0x3b, 0x7e, 0x8a, 0xde, 0x14, 0x03, 0x2f, 0xf5, 0x27, 0x53, 0x8a,
-	0x8d, 0x0d, 0x89, 0xb4, 0x5f, 0x8a, 0xd4, 0x3d, 0x1e, 0x3e, 0x1b, 0xf6, 0x8f, 0x45, 0x60, 0xef,
-	0x86, 0x80, 0x5a, 0xcc, 0x5b, 0xb6, 0x61, 0x44, 0xd7, 0xf6, 0x53, 0x7c, 0x86, 0x81, 0xba, 0x91,
-	0x18, 0xae, 0xe7, 0x03, 0x30, 0x28, 0x36, 0x25, 0xc5, 0xc7, 0x2b, 0x67, 0x90, 0xf3, 0xdc, 0xd4,
-	0x3c, 0xd7, 0x2e, 0x7b, 0xd0, 0xbd, 0x96, 0x25, 0x16, 0x8c, 0x4f, 0xc1, 0xc6, 0x89, 0x8f, 0xa7,
-	0xd9, 0x5c, 0xb6, 0x24, 0xd5, 0x27, 0x2b, 0xa9, 0x86, 0x22, 0xc1, 0x9c, 0x4f, 0x0d, 0x73, 0x0b,
-	0x92, 0x6f, 0xa1, 0x1e, 0x27, 0xd4, 0x1b, 0xb1, 0x08, 0xbd, 0x31, 0x9d, 0x61, 0xa3, 0xb2, 0x62,
-	0x40, 0x4f, 0x13, 0x9a, 0xea, 0x7f, 0x2f, 0xc3, 0x8a, 0x13, 0xaa, 0x2d, 0x48, 0x7c, 0xd8, 0xcb,
-	0xe7, 0x31, 0xa6, 0x33, 0x2f, 0xa1, 0x67, 0x2c, 0xf5, 0x27, 0xd8, 0xa8, 0xbe, 0x47, 0x99, 0x52,
-	0x11, 0x32, 0x5e, 0x43, 0xe7, 0xb3, 0x2d, 0x3c, 0xe8, 0xfc, 0x6a, 0xc1, 0xce, 0xb2, 0x2e, 0xc9,
-	0xa7, 0x86, 0x0c, 0xfd, 0x20, 0x48, 0x28, 0x0a, 0x59, 0x5b, 0x87, 0xdb, 0x85, 0xa8, 0xba, 0xca,
-	0x4c, 0x1e, 0x43, 0x3d, 0x0f, 0x0d, 0xa3, 0x97, 0xac, 0x71, 0x65, 0xdf, 0x3a, 0xac, 0x1d, 0xdd,
-	0x5e, 0x59, 0xd8, 0x20, 0x7a, 0xc9, 0x74, 0x49, 0x36, 0x33, 0x6c, 0x8e, 0x07, 0x50, 0x68, 0x8f,
-	0xec, 0xc2, 0xfa, 0x98, 0xce, 0x34, 0xb3, 0x38, 0x92, 0xfb, 0x50, 0x15, 0x12, 0x36, 0x88, 0x9a,
-	0x6f, 0x17, 0xb0, 0xc1, 0x52, 0x61, 0x31, 0x97, 0x04, 0x53, 0xa8, 0x19, 0xea, 0x23, 0x07, 0x50,
-	0x15, 0x62, 0x16, 0x3d, 0x2a, 0x9a, 0x5e, 0x6d, 0x31, 0x6f, 0x55, 0xba, 0xc7, 0x43, 0xd1, 0x9f,
-	0x5b, 0xf1, 0x53, 0x14, 0x07, 0xf2, 0x25, 0x6c, 0x4a, 0x25, 0x6a, 0x52, 0xa7, 0x94, 0xb4, 0x4f,
-	0x47, 0x12, 0xf5, 0x9b, 0x90, 0x4e, 0xb2, 0x25, 0x54, 0x69, 0xce, 0xef, 0x16, 0xec, 0x5e, 0x56,
-	0x63, 0x49, 0x7b, 0x21, 0x5c, 0x65, 0xa2, 0xf2, 0x42, 0xfa, 0x9a, 0x70, 0xf5, 0xf6, 0xcb, 0x6e,
-	0x73, 0xf9, 0xef, 0x69, 0xf9, 0xd7, 0x97, 0xcc, 0x6e, 0x5d, 0x22, 0x67, 0xba, 0x77, 0x4e, 0x81,
-	0xbc, 0xa9, 0xe6, 0x92, 0x92, 0xbe, 0x82, 0x0d, 0x63, 0xda, 0x07, 0xef, 0x5e, 0x0b, 0x63, 0xea,
-	0x32, 0xd3, 0xe9, 0x43, 0xcd, 0x10, 0x79, 0x09, 0xc5, 0x1d, 0xa8, 0x8b, 0x5d, 0xa1, 0x11, 0x4e,
-	0xe5, 0xc2, 0x48, 0xae, 0x6d, 0xd7, 0xce, 0x8d, 0x8f, 0xe8, 0xcc, 0x39, 0x28, 0xea, 0x2d, 0xc4,
-	0xfb, 0x26, 0x98, 0xf3, 0x8b, 0x05, 0x7b, 0xa5, 0x5f, 0xc5, 0x0f, 0x11, 0xf5, 0x7d, 0xd8, 0x1a,
-	0x9d, 0xfa, 0x61, 0x94, 0x7d, 0x7c, 0xcb, 0xd5, 0xfc, 0x40, 0x84, 0xa8, 0x8d, 0x41, 0xdd, 0xb1,
-	0x4e, 0x73, 0x9e, 0x83, 0x6d, 0x7a, 0x85, 0xce, 0xa4, 0xc7, 0x0b, 0x03, 0x53, 0x67, 0x32, 0x66,
-	0xd0, 0x77, 0x2b, 0xd2, 0x39, 0x08, 0xde, 0x6f, 0x14, 0xbf, 0x59, 0x60, 0x0f, 0xb9, 0x3f, 0xa6,
-	0x49, 0xde, 0xd9, 0x36, 0xca, 0x7b, 0x01, 0x6f, 0x2f, 0xe6, 0xad, 0xaa, 0x0a, 0x1a, 0xf4, 0xdd,
-	0xaa, 0x72, 0x0f, 0x02, 0xf2, 0x04, 0x76, 0x74, 0x68, 0xa0, 0x4a, 0xd3, 0x1d, 0x96, 0x2b, 0x5a,
-	0x01, 0x2c, 0xb7, 0x58, 0x47, 0xd3, 0xe8, 0xfc, 0x0c, 0xf5, 0xa5, 0x28, 0xb9, 0x52, 0x88, 0x94,
-	0x5f, 0x6a, 0xb5, 0x2b, 0x6c, 0xa2, 0x55, 0xe9, 0x1c, 0x04, 0xa4, 0x0b, 0x95, 0xe5, 0x12, 0xca,
-	0x87, 0x2c, 0xf3, 0x96, 0x2b, 0xc8, 0xf2, 0xc4, 0x56, 0xd9, 0xa6, 0xff, 0x43, 0xfe, 0xe2, 0xef,
-	0x61, 0xcb, 0x3f, 0x63, 0xd3, 0x88, 0xab, 0x11, 0xf7, 0xbe, 0x10, 0xd0, 0xff, 0xce, 0x5b, 0x07,
-	0x27, 0x21, 0x3f, 0x9d, 0xbe, 0x68, 0x8f, 0xd8, 0x99, 0x7e, 0x28, 0xe8, 0x9f, 0xbb, 0x18, 0x8c,
-	0x3b, 0x7c, 0x16, 0x53, 0x6c, 0x0f, 0x22, 0xfe, 0xf7, 0x9f, 0x77, 0x41, 0xbf, 0x23, 0x06, 0x11,
-	0x77, 0x35, 0x56, 0xef, 0xf1, 0xab, 0x45, 0xd3, 0x7a, 0xbd, 0x68, 0x5a, 0xff, 0x2d, 0x9a, 0xd6,
-	0x1f, 0x17, 0xcd, 0xb5, 0xd7, 0x17, 0xcd, 0xb5, 0x7f, 0x2e, 0x9a, 0x6b, 0x3f, 0x1c, 0x19, 0xb8,
-	0x5f, 0xab, 0x3e, 0xbf, 0xa3, 0xfc, 0x27, 0x96, 0x8c, 0x3b, 0xd9, 0x5b, 0xe3, 0xbc, 0x78, 0x6d,
-	0x48, 0x9e, 0x17, 0x5b, 0xf2, 0xb9, 0xf1, 0xf9, 0xff, 0x01, 0x00, 0x00, 0xff, 0xff, 0xc1, 0x55,
-	0xa8, 0x87, 0xf7, 0x08, 0x00, 0x00,
+	// 997 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x56, 0xcb, 0x6e, 0xdb, 0x46,
+	0x17, 0x36, 0x63, 0x47, 0x97, 0x23, 0xca, 0x71, 0x26, 0xb6, 0xc1, 0xe4, 0xff, 0x21, 0x39, 0x0c,
+	0xea, 0xba, 0x2d, 0x22, 0x21, 0xee, 0xaa, 0x40, 0xd1, 0xd4, 0x8e, 0xda, 0x40, 0x49, 0xd0, 0x18,
+	0x54, 0xe3, 0x45, 0x53, 0x80, 0x60, 0xc4, 0x89, 0x44, 0xc8, 0xe2, 0x10, 0x3c, 0x23, 0xc6, 0xea,
+	0xb6, 0xab, 0x76, 0xd3, 0x3e, 0x44, 0x1f, 0xa1, 0x0f, 0x91, 0x65, 0xd0, 0x55, 0xd1, 0x85, 0x50,
+	0xc8, 0x0f, 0xd0, 0x57, 0x28, 0x66, 0x38, 0xbc, 0xc8, 0xa6, 0x55, 0x67, 0xa5, 0x99, 0x73, 0xf9,
+	0xbe, 0x73, 0xce, 0xcc, 0x27, 0x0e, 0xdc, 0xa5, 0xa7, 0xac, 0xcf, 0x42, 0xda, 0x66, 0x01, 0x0d,
+	0x1d, 0xce, 0xc2, 0x76, 0xf4, 0xa0, 0x3d, 0xa0, 0x3e, 0x45, 0x0f, 0x5b, 0x41, 0xc8, 0x38, 0x23,
+	0xb7, 0x54, 0x48, 0x2b, 0x09, 0x69, 0x45, 0x0f, 0xee, 0xdc, 0xee, 0x33, 0x1c, 0x33, 0xb4, 0x65,
+	0x48, 0x3b, 0xde, 0xc4, 0xf1, 0x77, 0x36, 0x07, 0x6c, 0xc0, 0x62, 0xbb, 0x58, 0x29, 0xeb, 0x4e,
+	0x11, 0x51, 0xe0, 0x84, 0xce, 0x38, 0xc9, 0xfb, 0x7f, 0x51, 0x04, 0x3f, 0x8d, 0xbd, 0xe6, 0x3f,
+	0x25, 0xd0, 0x1f, 0xc7, 0x75, 0xf5, 0xb8, 0xc3, 0x29, 0x79, 0x0c, 0xd5, 0x24, 0x10, 0x0d, 0x6d,
+	0x67, 0x75, 0xaf, 0xb6, 0x7f, 0xaf, 0x55, 0x50, 0x6a, 0xeb, 0xb9, 0x5a, 0x77, 0x28, 0x77, 0xbc,
+	0x93, 0xc3, 0xb5, 0xb7, 0xb3, 0xe6, 0x8a, 0x95, 0xe5, 0x92, 0x97, 0xb0, 0x91, 0x6c, 0xec, 0x90,
+	0xf6, 0x59, 0xe8, 0xa2, 0x71, 0x4d, 0xe2, 0x7d, 0xbc, 0x14, 0xef, 0x11, 0xf3, 0xf1, 0x29, 0x9d,
+	0x5a, 0x32, 0x45, 0xc1, 0xde, 0x48, 0x02, 0x63, 0x2b, 0x92, 0x0e, 0x00, 0x0b, 0xb8, 0x8d, 0xa2,
+	0x64, 0x34, 0x56, 0x25, 0x6c, 0xf3, 0x12, 0x58, 0x4e, 0x5d, 0xd9, 0x5a, 0x56, 0x22, 0x97, 0x7b,
+	0x24, 0xdf, 0xc3, 0xba, 0x13, 0xa1, 0x3d, 0x41, 0xd7, 0x8e, 0x9c, 0x93, 0x09, 0x45, 0x63, 0x4d,
+	0x22, 0xed, 0x14, 0x22, 0x1d, 0x1c, 0xf7, 0x5e, 0xf4, 0x3a, 0xc7, 0x22, 0xf0, 0x70, 0x53, 0x40,
+	0xcd, 0x67, 0x4d, 0x3d, 0x67, 0x44, 0x4b, 0x77, 0x22, 0x7c, 0x81, 0x6e, 0xbc, 0x23, 0x01, 0xdc,
+	0x4a, 0x07, 0x90, 0xa3, 0xb8, 0x2e, 0x29, 0x3e, 0x58, 0x3a, 0x83, 0x94, 0xe7, 0xb6, 0xe2, 0xb9,
+	0x79, 0xde, 0x83, 0xd6, 0xcd, 0x24, 0x31, 0x63, 0x3c, 0x02, 0x1d, 0x4f, 0x1c, 0x1c, 0x26, 0x73,
+	0x29, 0x49, 0xaa, 0x0f, 0x97, 0x52, 0xf5, 0x44, 0x42, 0x7e, 0x3e, 0x35, 0x4c, 0x2d, 0x48, 0x9e,
+	0x40, 0x3d, 0x08, 0xa9, 0xdd, 0x67, 0x3e, 0xda, 0x23, 0x3a, 0x45, 0xa3, 0xbc, 0x64, 0x40, 0x47,
+	0x21, 0x8d, 0xd4, 0xe9, 0x25, 0x58, 0x41, 0x48, 0x95, 0x05, 0x89, 0x03, 0x5b, 0xe9, 0x3c, 0x46,
+	0x74, 0x6a, 0x87, 0x74, 0xcc, 0x22, 0xe7, 0x04, 0x8d, 0xca, 0x15, 0xca, 0x94, 0x37, 0x42, 0xc6,
+	0x2b, 0xe8, 0x74, 0xb6, 0x99, 0x07, 0xc9, 0x00, 0xb6, 0x23, 0xc6, 0x3d, 0x7f, 0x60, 0x07, 0xec,
+	0x0d, 0x0d, 0x6d, 0xf4, 0x9d, 0x00, 0x87, 0x8c, 0xa3, 0x51, 0x95, 0x1c, 0x9f, 0x5c, 0x76, 0xb0,
+	0xc7, 0x32, 0xeb, 0x48, 0x24, 0xf5, 0x54, 0x8e, 0xe2, 0xd9, 0x8c, 0x2e, 0xba, 0x90, 0x7c, 0x06,
+	0xa5, 0x58, 0x64, 0x06, 0xec, 0x68, 0x7b, 0xb5, 0xfd, 0xff, 0x15, 0x0f, 0x44, 0x86, 0x28, 0x20,
+	0x95, 0x60, 0xfe, 0xa4, 0xc1, 0xfa, 0xa2, 0x76, 0xc8, 0x47, 0x39, 0xa9, 0x38, 0xae, 0x1b, 0x52,
+	0x14, 0xd2, 0xd3, 0xf6, 0xaa, 0xd9, 0xc5, 0x3f, 0x88, 0xcd, 0xe4, 0x19, 0xd4, 0xd3, 0x50, 0xcf,
+	0x7f, 0xcd, 0x8c, 0x6b, 0x92, 0xff, 0xee, 0xd2, 0xe1, 0x75, 0xfd, 0xd7, 0x4c, 0x55, 0xa1, 0xb3,
+	0x9c, 0xcd, 0xb4, 0x01, 0x32, 0x7d, 0x90, 0x0d, 0x58, 0x1d, 0xd1, 0xa9, 0x62, 0x16, 0x4b, 0xf2,
+	0x10, 0x2a, 0x42, 0x66, 0x39, 0xa2, 0xc6, 0xe5, 0x22, 0xcb, 0xb1, 0x94, 0x59, 0xc0, 0x25, 0xc1,
+	0x04, 0x6a, 0x39, 0x85, 0x90, 0x5d, 0xa8, 0x08, 0xc1, 0x89, 0x1e, 0x63, 0x9a, 0xc3, 0xda, 0x7c,
+	0xd6, 0x2c, 0x1f, 0x1c, 0xf7, 0x44, 0x7f, 0x56, 0xd9, 0x89, 0x50, 0x2c, 0xc8, 0x17, 0x70, 0x5d,
+	0xaa, 0x45, 0x91, 0x9a, 0x85, 0xa4, 0x1d, 0xda, 0x97, 0xa8, 0x5f, 0x7b, 0xf4, 0x24, 0xf9, 0xa3,
+	0x88, 0xd3, 0xcc, 0x5f, 0x34, 0xd8, 0x38, 0xaf, 0x98, 0x82, 0xf6, 0x3c, 0xb8, 0xc1, 0x44, 0xe5,
+	0x99, 0x3c, 0x15, 0xe1, 0xf2, 0x7f, 0x28, 0xd9, 0x6d, 0x2a, 0xd1, 0x2d, 0x25, 0xd1, 0xfa, 0x82,
+	0xd9, 0xaa, 0x4b, 0xe4, 0x44, 0x9b, 0xe6, 0x10, 0xc8, 0x45, 0xc5, 0x15, 0x94, 0xf4, 0x25, 0xac,
+	0xe5, 0xa6, 0xbd, 0xfb, 0xdf, 0xd2, 0xcd, 0x4d, 0x5d, 0x66, 0x9a, 0x1d, 0xa8, 0xe5, 0x84, 0x58,
+	0x40, 0x71, 0x0f, 0xea, 0x42, 0xcf, 0xd4, 0xc7, 0x89, 0x14, 0xb5, 0xe4, 0xaa, 0x5a, 0x7a, 0x6a,
+	0x7c, 0x4a, 0xa7, 0xe6, 0x6e, 0x56, 0x6f, 0x26, 0xb0, 0x8b, 0x60, 0xe6, 0x6f, 0x1a, 0x6c, 0x17,
+	0xeb, 0xe7, 0xca, 0x87, 0xbd, 0x0d, 0xa5, 0x21, 0xf5, 0x06, 0x43, 0x2e, 0x0b, 0x59, 0xb5, 0xd4,
+	0x8e, 0x3c, 0x81, 0x4a, 0xa2, 0x5f, 0x63, 0x55, 0x8e, 0x63, 0xaf, 0x70, 0x1c, 0x97, 0x6b, 0x37,
+	0xcd, 0x37, 0x7f, 0xd4, 0x60, 0xab, 0xf0, 0x03, 0xf3, 0x3e, 0xda, 0x7b, 0x08, 0xa5, 0xfe, 0xd0,
+	0xf1, 0xfc, 0xe4, 0x3b, 0x56, 0x2c, 0xba, 0x47, 0x22, 0x24, 0x16, 0x76, 0x2a, 0xfd, 0x38, 0xcd,
+	0x7c, 0x09, 0x7a, 0xde, 0x2b, 0x26, 0x24, 0x3d, 0xb6, 0xe7, 0xe6, 0x27, 0x24, 0x63, 0xba, 0x1d,
+	0xab, 0x2c, 0x9d, 0x5d, 0xf7, 0x6a, 0x27, 0xf6, 0xb3, 0x06, 0x7a, 0x8f, 0x3b, 0x23, 0x1a, 0xa6,
+	0x9d, 0x55, 0x51, 0xee, 0x33, 0x78, 0x7d, 0x3e, 0x6b, 0x56, 0xe2, 0xa0, 0x6e, 0xc7, 0xaa, 0xc4,
+	0xee, 0xae, 0x4b, 0x9e, 0xc3, 0xba, 0x0a, 0x75, 0xe3, 0xd2, 0x54, 0x87, 0xc5, 0xc2, 0x8b, 0x01,
+	0x16, 0x5b, 0xac, 0x63, 0xde, 0x68, 0xfe, 0x00, 0xf5, 0x85, 0x28, 0x79, 0x19, 0x10, 0x29, 0x3f,
+	0xd7, 0xea, 0x81, 0xb0, 0x89, 0x56, 0xa5, 0xb3, 0xeb, 0x92, 0x03, 0x28, 0x2f, 0x96, 0x50, 0x3c,
+	0x64, 0x99, 0xb7, 0x58, 0x41, 0x92, 0x27, 0xc4, 0xaf, 0xe7, 0xfd, 0xef, 0x73, 0xc4, 0xdf, 0x42,
+	0xc9, 0x19, 0xb3, 0x89, 0x1f, 0xdf, 0xc5, 0xea, 0xe1, 0xe7, 0x02, 0xfa, 0xaf, 0x59, 0x73, 0x77,
+	0xe0, 0xf1, 0xe1, 0xe4, 0x55, 0xab, 0xcf, 0xc6, 0xea, 0x55, 0xa6, 0x7e, 0xee, 0xa3, 0x3b, 0x6a,
+	0xf3, 0x69, 0x40, 0xb1, 0xd5, 0xf5, 0xf9, 0x1f, 0xbf, 0xdf, 0x07, 0xf5, 0x68, 0xeb, 0xfa, 0xdc,
+	0x52, 0x58, 0x87, 0xcf, 0xde, 0xce, 0x1b, 0xda, 0xbb, 0x79, 0x43, 0xfb, 0x7b, 0xde, 0xd0, 0x7e,
+	0x3d, 0x6b, 0xac, 0xbc, 0x3b, 0x6b, 0xac, 0xfc, 0x79, 0xd6, 0x58, 0xf9, 0x6e, 0x3f, 0x87, 0xfb,
+	0x55, 0xdc, 0xe7, 0x37, 0x94, 0xbf, 0x61, 0xe1, 0xa8, 0x9d, 0x3c, 0xdb, 0x4e, 0xb3, 0x87, 0x9b,
+	0xe4, 0x79, 0x55, 0x92, 0x2f, 0xb7, 0x4f, 0xff, 0x0d, 0x00, 0x00, 0xff, 0xff, 0x08, 0x4a, 0x13,
+	0xd8, 0x64, 0x0a, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -910,6 +1000,30 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x52
+	if len(m.VotingPowerSnapshots) > 0 {
+		for iNdEx := len(m.VotingPowerSnapshots) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.VotingPowerSnapshots[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
 	if len(m.OperatorKeyRemovals) > 0 {
 		for iNdEx := len(m.OperatorKeyRemovals) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -1292,6 +1406,51 @@ func (m *OperatorKeyRemoval) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *AVSVotingPowerSnapshot) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AVSVotingPowerSnapshot) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AVSVotingPowerSnapshot) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Snapshot.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.Height != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.AVSAddr) > 0 {
+		i -= len(m.AVSAddr)
+		copy(dAtA[i:], m.AVSAddr)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.AVSAddr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *OperatorConsKeyRecord) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1566,6 +1725,14 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.VotingPowerSnapshots) > 0 {
+		for _, e := range m.VotingPowerSnapshots {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovGenesis(uint64(l))
 	return n
 }
 
@@ -1674,6 +1841,24 @@ func (m *OperatorKeyRemoval) Size() (n int) {
 	return n
 }
 
+func (m *AVSVotingPowerSnapshot) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.AVSAddr)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovGenesis(uint64(m.Height))
+	}
+	l = m.Snapshot.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
 func (m *OperatorConsKeyRecord) Size() (n int) {
 	if m == nil {
 		return 0
@@ -2070,6 +2255,73 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VotingPowerSnapshots", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VotingPowerSnapshots = append(m.VotingPowerSnapshots, AVSVotingPowerSnapshot{})
+			if err := m.VotingPowerSnapshots[len(m.VotingPowerSnapshots)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -2862,6 +3114,140 @@ func (m *OperatorKeyRemoval) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *AVSVotingPowerSnapshot) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AVSVotingPowerSnapshot: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AVSVotingPowerSnapshot: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AVSAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AVSAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Snapshot", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Snapshot.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *OperatorConsKeyRecord) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -3,6 +3,7 @@ package types_test
 import (
 	"testing"
 
+	sdkmath "cosmossdk.io/math"
 	"github.com/ExocoreNetwork/exocore/utils"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
 	"github.com/ethereum/go-ethereum/common/hexutil"
@@ -10,6 +11,7 @@ import (
 	utiltx "github.com/ExocoreNetwork/exocore/testutil/tx"
 	"github.com/ExocoreNetwork/exocore/x/operator/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	"github.com/stretchr/testify/suite"
 )
 
@@ -28,7 +30,8 @@ func (suite *GenesisTestSuite) TestValidateGenesis() {
 	key := hexutil.Encode(ed25519.GenPrivKey().PubKey().Bytes())
 	accAddress1 := sdk.AccAddress(utiltx.GenerateAddress().Bytes())
 	accAddress2 := sdk.AccAddress(utiltx.GenerateAddress().Bytes())
-	newGen := &types.GenesisState{}
+	avsAddress := utiltx.GenerateAddress().String()
+	newGen := &types.GenesisState{Params: types.DefaultParams()}
 
 	testCases := []struct {
 		name     string
@@ -49,6 +52,7 @@ func (suite *GenesisTestSuite) TestValidateGenesis() {
 		{
 			name: "invalid genesis state due to non bech32 operator address",
 			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
 				Operators: []types.OperatorDetail{
 					{
 						OperatorAddress: "invalid",
@@ -60,6 +64,7 @@ func (suite *GenesisTestSuite) TestValidateGenesis() {
 		{
 			name: "invalid genesis state due to duplicate operator address",
 			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
 				Operators: []types.OperatorDetail{
 					{
 						OperatorAddress: accAddress1.String(),
@@ -74,6 +79,7 @@ func (suite *GenesisTestSuite) TestValidateGenesis() {
 		{
 			name: "invalid genesis state due to duplicate lz chain id",
 			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
 				Operators: []types.OperatorDetail{
 					{
 						OperatorAddress: accAddress1.String(),
@@ -100,6 +106,7 @@ func (suite *GenesisTestSuite) TestValidateGenesis() {
 		{
 			name: "invalid genesis state due to invalid cons key operator address",
 			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
 				Operators: []types.OperatorDetail{
 					{
 						OperatorAddress: accAddress1.String(),
@@ -116,6 +123,7 @@ func (suite *GenesisTestSuite) TestValidateGenesis() {
 		{
 			name: "invalid genesis state due to unregistered operator in cons key",
 			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
 				Operators: []types.OperatorDetail{
 					{
 						OperatorAddress: accAddress1.String(),
@@ -132,6 +140,7 @@ func (suite *GenesisTestSuite) TestValidateGenesis() {
 		{
 			name: "invalid genesis state due to duplicate operator in cons key",
 			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
 				Operators: []types.OperatorDetail{
 					{
 						OperatorAddress: accAddress1.String(),
@@ -166,6 +175,7 @@ func (suite *GenesisTestSuite) TestValidateGenesis() {
 		{
 			name: "invalid genesis state due to invalid cons key",
 			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
 				Operators: []types.OperatorDetail{
 					{
 						OperatorAddress: accAddress1.String(),
@@ -188,6 +198,7 @@ func (suite *GenesisTestSuite) TestValidateGenesis() {
 		{
 			name: "invalid genesis state due to duplicate cons key for the same chain id",
 			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
 				Operators: []types.OperatorDetail{
 					{
 						OperatorAddress: accAddress1.String(),
@@ -219,6 +230,120 @@ func (suite *GenesisTestSuite) TestValidateGenesis() {
 			},
 			expPass: false,
 		},
+		{
+			name: "valid genesis state with voting power snapshot",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				Operators: []types.OperatorDetail{
+					{
+						OperatorAddress: accAddress1.String(),
+						OperatorInfo: types.OperatorInfo{
+							Commission: stakingtypes.NewCommission(sdkmath.LegacyZeroDec(), sdkmath.LegacyZeroDec(), sdkmath.LegacyZeroDec()),
+						},
+					},
+				},
+				VotingPowerSnapshots: []types.AVSVotingPowerSnapshot{
+					{
+						AVSAddr: avsAddress,
+						Height:  10,
+						Snapshot: types.VotingPowerSnapshot{
+							EpochNumber:      1,
+							TotalVotingPower: sdkmath.LegacyNewDec(10),
+							OperatorVotingPowers: []types.OperatorVotingPower{
+								{
+									OperatorAddr: accAddress1.String(),
+									VotingPower:  sdkmath.LegacyNewDec(10),
+								},
+							},
+						},
+					},
+				},
+			},
+			expPass: true,
+		},
+		{
+			name: "invalid genesis state due to unregistered operator in voting power snapshot",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				Operators: []types.OperatorDetail{
+					{
+						OperatorAddress: accAddress1.String(),
+						OperatorInfo: types.OperatorInfo{
+							Commission: stakingtypes.NewCommission(sdkmath.LegacyZeroDec(), sdkmath.LegacyZeroDec(), sdkmath.LegacyZeroDec()),
+						},
+					},
+				},
+				VotingPowerSnapshots: []types.AVSVotingPowerSnapshot{
+					{
+						AVSAddr: avsAddress,
+						Height:  10,
+						Snapshot: types.VotingPowerSnapshot{
+							EpochNumber:      1,
+							TotalVotingPower: sdkmath.LegacyNewDec(10),
+							OperatorVotingPowers: []types.OperatorVotingPower{
+								{
+									OperatorAddr: accAddress2.String(),
+									VotingPower:  sdkmath.LegacyNewDec(10),
+								},
+							},
+						},
+					},
+				},
+			},
+			expPass: false,
+		},
+		{
+			name: "invalid genesis state due to missing params",
+			genState: &types.GenesisState{
+				Params: types.Params{},
+			},
+			expPass: false,
+		},
+		{
+			name: "invalid genesis state due to min commission rate greater than 1",
+			genState: &types.GenesisState{
+				Params: types.NewParams(sdkmath.LegacyNewDec(2), 0),
+			},
+			expPass: false,
+		},
+		{
+			name: "invalid genesis state due to commission rate less than the minimum",
+			genState: &types.GenesisState{
+				Params: types.NewParams(sdkmath.LegacyNewDecWithPrec(5, 2), 0),
+				Operators: []types.OperatorDetail{
+					{
+						OperatorAddress: accAddress1.String(),
+						OperatorInfo: types.OperatorInfo{
+							Commission: stakingtypes.NewCommission(sdkmath.LegacyNewDecWithPrec(1, 2), sdkmath.LegacyOneDec(), sdkmath.LegacyZeroDec()),
+						},
+					},
+				},
+			},
+			expPass: false,
+		},
+		{
+			name: "invalid genesis state due to duplicate voting power snapshot",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				VotingPowerSnapshots: []types.AVSVotingPowerSnapshot{
+					{
+						AVSAddr: avsAddress,
+						Height:  10,
+						Snapshot: types.VotingPowerSnapshot{
+							TotalVotingPower: sdkmath.LegacyNewDec(0),
+						},
+					},
+					{
+						AVSAddr: avsAddress,
+						Height:  10,
+						Snapshot: types.VotingPowerSnapshot{
+							TotalVotingPower: sdkmath.LegacyNewDec(0),
+						},
+					},
+				},
+			},
+			expPass: false,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -2,6 +2,7 @@ package types
 
 import (
 	"math"
+	"strings"
 
 	"golang.org/x/xerrors"
 
@@ -67,6 +68,12 @@ const (
 	// BytePrefixForOperatorKeyRemovalForChainID is the prefix to store that the operator with
 	// the given address is in the process of unbonding their key for the given chainID.
 	BytePrefixForOperatorKeyRemovalForChainID
+
+	prefixVotingPowerSnapshot
+
+	prefixParams
+
+	prefixPriceUpdatedAsset
 )
 
 var (
@@ -94,6 +101,19 @@ var (
 	// processedSlashHeight + '/' + assetID + '/' + stakerID -> SlashAmount
 	// processedSlashHeight + '/' + assetID + '/' + operatorAddr -> SlashAmount
 	KeyPrefixSlashAssetsState = []byte{prefixSlashAssetsState}
+
+	// KeyPrefixVotingPowerSnapshot key-value:
+	// AVSAddr + '/' + height -> types.VotingPowerSnapshot
+	// the height is big-endian encoded, so the snapshots of an AVS are sorted by height.
+	KeyPrefixVotingPowerSnapshot = []byte{prefixVotingPowerSnapshot}
+
+	// KeyPrefixParams is the key for the module parameters.
+	KeyPrefixParams = []byte{prefixParams}
+
+	// KeyPrefixPriceUpdatedAsset key-value:
+	// assetID -> nil
+	// the assets whose prices have been updated by the oracle since the last EndBlock.
+	KeyPrefixPriceUpdatedAsset = []byte{prefixPriceUpdatedAsset}
 )
 
 // ModuleAddress is the native module address for EVM
@@ -241,3 +261,32 @@ func IterateOperatorsForAVSPrefix(avsAddr string) []byte {
 	tmp := append([]byte(avsAddr), '/')
 	return tmp
 }
+
+// VotingPowerSnapshotPrefixForAVS returns the prefix to iterate the voting power snapshots
+// of the AVS.
+func VotingPowerSnapshotPrefixForAVS(avsAddr string) []byte {
+	return append([]byte(strings.ToLower(avsAddr)), '/')
+}
+
+// KeyForVotingPowerSnapshot returns the key of the voting power snapshot taken at the
+// specified height for the AVS.
+func KeyForVotingPowerSnapshot(avsAddr string, height int64) []byte {
+	return AppendMany(
+		VotingPowerSnapshotPrefixForAVS(avsAddr),
+		// #nosec G115 // the block height is positive
+		sdk.Uint64ToBigEndian(uint64(height)),
+	)
+}
+
+// ParseVotingPowerSnapshotKey parses the AVS address and the height from the key of
+// a voting power snapshot.
+func ParseVotingPowerSnapshotKey(key []byte) (avsAddr string, height int64, err error) {
+	// the key is composed of the hex address, the delimiter, and the height.
+	if len(key) < ByteLengthForUint64+1 {
+		return "", 0, xerrors.Errorf("invalid voting power snapshot key length:%d", len(key))
+	}
+	avsAddr = string(key[:len(key)-ByteLengthForUint64-1])
+	// #nosec G115 // the block height is positive
+	height = int64(sdk.BigEndianToUint64(key[len(key)-ByteLengthForUint64:]))
+	return avsAddr, height, nil
+}
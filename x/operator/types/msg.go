@@ -4,28 +4,64 @@ import (
 	errorsmod "cosmossdk.io/errors"
 	keytypes "github.com/ExocoreNetwork/exocore/types/keys"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	"github.com/ethereum/go-ethereum/common"
 )
 
 const (
 	// TypeRegisterOperatorReq is the type for the RegisterOperatorReq message.
 	TypeRegisterOperatorReq = "register_operator"
+	// TypeEditOperatorReq is the type for the EditOperatorReq message.
+	TypeEditOperatorReq = "edit_operator"
 	// TypeSetConsKeyReq is the type for the SetConsKeyReq message.
 	TypeSetConsKeyReq = "set_cons_key"
 	// TypeOptIntoAVSReq is the type for the OptIntoAVSReq message.
 	TypeOptIntoAVSReq = "opt_into_avs"
 	// TypeOptOutOfAVSReq is the type for the OptOutOfAVSReq message.
 	TypeOptOutOfAVSReq = "opt_out_of_avs"
+	// TypeMsgUpdateParams is the type for the MsgUpdateParams message.
+	TypeMsgUpdateParams = "update_params"
 )
 
 // interface guards
 var (
 	_ sdk.Msg = &RegisterOperatorReq{}
+	_ sdk.Msg = &EditOperatorReq{}
 	_ sdk.Msg = &OptIntoAVSReq{}
 	_ sdk.Msg = &OptOutOfAVSReq{}
 	_ sdk.Msg = &SetConsKeyReq{}
+	_ sdk.Msg = &MsgUpdateParams{}
 )
 
+// GetSigners returns the expected signers for the message.
+func (m *MsgUpdateParams) GetSigners() []sdk.AccAddress {
+	addr := sdk.MustAccAddressFromBech32(m.Authority)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic does a sanity check of the provided data
+func (m *MsgUpdateParams) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errorsmod.Wrap(err, "invalid authority address")
+	}
+	return m.Params.Validate()
+}
+
+// Route returns the transaction route.
+func (m *MsgUpdateParams) Route() string {
+	return RouterKey
+}
+
+// Type returns the transaction type.
+func (m *MsgUpdateParams) Type() string {
+	return TypeMsgUpdateParams
+}
+
+// GetSignBytes returns the bytes all expected signers must sign over.
+func (m *MsgUpdateParams) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(m))
+}
+
 // GetSigners returns the expected signers for the message.
 func (m *RegisterOperatorReq) GetSigners() []sdk.AccAddress {
 	addr := sdk.MustAccAddressFromBech32(m.FromAddress)
@@ -55,6 +91,57 @@ func (m *RegisterOperatorReq) GetSignBytes() []byte {
 	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(m))
 }
 
+// GetSigners returns the expected signers for the message.
+func (m *EditOperatorReq) GetSigners() []sdk.AccAddress {
+	addr := sdk.MustAccAddressFromBech32(m.FromAddress)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic does a sanity check of the provided data
+func (m *EditOperatorReq) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.FromAddress); err != nil {
+		return errorsmod.Wrap(err, "invalid from address")
+	}
+	if m.OperatorMetaInfo == "" && m.ApproveAddr == "" &&
+		len(m.ClientChainEarningsAddr) == 0 && m.CommissionRate == nil {
+		return errorsmod.Wrap(ErrParameterInvalid, "nothing to edit")
+	}
+	if len(m.OperatorMetaInfo) > stakingtypes.MaxIdentityLength {
+		return errorsmod.Wrapf(
+			ErrParameterInvalid, "info length exceeds %d", stakingtypes.MaxIdentityLength,
+		)
+	}
+	for _, data := range m.ClientChainEarningsAddr {
+		if data == nil || data.ClientChainEarningAddr == "" {
+			return errorsmod.Wrap(ErrParameterInvalid, "client chain earning address is empty")
+		}
+	}
+	if m.CommissionRate != nil {
+		if m.CommissionRate.IsNegative() {
+			return stakingtypes.ErrCommissionNegative
+		}
+		if m.CommissionRate.GT(sdk.OneDec()) {
+			return stakingtypes.ErrCommissionHuge
+		}
+	}
+	return nil
+}
+
+// Route returns the transaction route.
+func (m *EditOperatorReq) Route() string {
+	return RouterKey
+}
+
+// Type returns the transaction type.
+func (m *EditOperatorReq) Type() string {
+	return TypeEditOperatorReq
+}
+
+// GetSignBytes returns the bytes all expected signers must sign over.
+func (m *EditOperatorReq) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(m))
+}
+
 // GetSigners returns the expected signers for the message.
 func (m *SetConsKeyReq) GetSigners() []sdk.AccAddress {
 	addr := sdk.MustAccAddressFromBech32(m.Address)
@@ -0,0 +1,36 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+)
+
+// DefaultMinCommissionRate is the default minimum commission rate of the operators. It's zero
+// so that the existing operators aren't affected until the governance raises it.
+var DefaultMinCommissionRate = sdkmath.LegacyZeroDec()
+
+// DefaultTWAPRounds is the default number of the oracle rounds to average the prices over. It's
+// zero so that the assets are valued by the latest prices until the governance raises it.
+const DefaultTWAPRounds = 0
+
+// NewParams creates a new Params instance
+func NewParams(minCommissionRate sdkmath.LegacyDec, twapRounds uint64) Params {
+	return Params{
+		MinCommissionRate: minCommissionRate,
+		TwapRounds:        twapRounds,
+	}
+}
+
+// DefaultParams returns a default set of parameters
+func DefaultParams() Params {
+	return NewParams(DefaultMinCommissionRate, DefaultTWAPRounds)
+}
+
+// Validate validates the set of params
+func (p Params) Validate() error {
+	if p.MinCommissionRate.IsNil() || p.MinCommissionRate.IsNegative() ||
+		p.MinCommissionRate.GT(sdkmath.LegacyOneDec()) {
+		return errorsmod.Wrapf(ErrInvalidParams, "min commission rate: %s", p.MinCommissionRate)
+	}
+	return nil
+}
@@ -1353,6 +1353,89 @@ func (m *QueryOptInfoRequest) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_QueryOptInfoRequest proto.InternalMessageInfo
 
+// QueryParamsRequest is request type for the Query/Params RPC method.
+type QueryParamsRequest struct {
+}
+
+func (m *QueryParamsRequest) Reset()         { *m = QueryParamsRequest{} }
+func (m *QueryParamsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsRequest) ProtoMessage()    {}
+func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f91e795a3cecbdbf, []int{25}
+}
+func (m *QueryParamsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryParamsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsRequest.Merge(m, src)
+}
+func (m *QueryParamsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryParamsRequest proto.InternalMessageInfo
+
+// QueryParamsResponse is response type for the Query/Params RPC method.
+type QueryParamsResponse struct {
+	// params holds all the parameters of this module.
+	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+}
+
+func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
+func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsResponse) ProtoMessage()    {}
+func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f91e795a3cecbdbf, []int{26}
+}
+func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsResponse.Merge(m, src)
+}
+func (m *QueryParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryParamsResponse proto.InternalMessageInfo
+
+func (m *QueryParamsResponse) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
 func init() {
 	proto.RegisterType((*GetOperatorInfoReq)(nil), "exocore.operator.v1.GetOperatorInfoReq")
 	proto.RegisterType((*QueryAllOperatorsRequest)(nil), "exocore.operator.v1.QueryAllOperatorsRequest")
@@ -1379,106 +1462,112 @@ func init() {
 	proto.RegisterType((*QueryAllAVSsByOperatorRequest)(nil), "exocore.operator.v1.QueryAllAVSsByOperatorRequest")
 	proto.RegisterType((*QueryAllAVSsByOperatorResponse)(nil), "exocore.operator.v1.QueryAllAVSsByOperatorResponse")
 	proto.RegisterType((*QueryOptInfoRequest)(nil), "exocore.operator.v1.QueryOptInfoRequest")
+	proto.RegisterType((*QueryParamsRequest)(nil), "exocore.operator.v1.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "exocore.operator.v1.QueryParamsResponse")
 }
 
 func init() { proto.RegisterFile("exocore/operator/v1/query.proto", fileDescriptor_f91e795a3cecbdbf) }
 
 var fileDescriptor_f91e795a3cecbdbf = []byte{
-	// 1493 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x58, 0x4f, 0x6f, 0xdc, 0xc4,
-	0x1b, 0x8e, 0xd3, 0xf6, 0xd7, 0xec, 0xa4, 0xed, 0x2f, 0x9d, 0xa4, 0x90, 0xba, 0xed, 0x6e, 0xeb,
-	0x42, 0x9b, 0x46, 0xad, 0x4d, 0xd2, 0xb4, 0xa0, 0x86, 0x82, 0x76, 0x9b, 0xb6, 0x4a, 0x5b, 0x91,
-	0xe0, 0x15, 0x29, 0x20, 0xc1, 0xca, 0xb1, 0xa7, 0x1b, 0x53, 0xc7, 0xb3, 0xf5, 0xcc, 0x6e, 0xbb,
-	0x8a, 0x82, 0x10, 0x27, 0xb8, 0x20, 0x44, 0x8f, 0x08, 0x3e, 0x05, 0x12, 0x07, 0x0e, 0x70, 0xcc,
-	0x81, 0x43, 0x80, 0x0b, 0xa7, 0x80, 0x12, 0x3e, 0x01, 0x07, 0xce, 0xc8, 0xe3, 0x19, 0xaf, 0xbd,
-	0xb6, 0xf7, 0x4f, 0x1a, 0x7a, 0x5b, 0xdb, 0xf3, 0xce, 0xfb, 0x3c, 0xef, 0xbf, 0x79, 0x66, 0x41,
-	0x01, 0x3d, 0xc1, 0x26, 0xf6, 0x90, 0x86, 0x6b, 0xc8, 0x33, 0x28, 0xf6, 0xb4, 0xc6, 0x94, 0xf6,
-	0xa8, 0x8e, 0xbc, 0xa6, 0x5a, 0xf3, 0x30, 0xc5, 0x70, 0x94, 0x2f, 0x50, 0xc5, 0x02, 0xb5, 0x31,
-	0x25, 0x4f, 0x9a, 0x98, 0xac, 0x62, 0xa2, 0x2d, 0x1b, 0x04, 0x05, 0xab, 0xb5, 0xc6, 0xd4, 0x32,
-	0xa2, 0xc6, 0x94, 0x56, 0x33, 0xaa, 0xb6, 0x6b, 0x50, 0x1b, 0xbb, 0xc1, 0x06, 0xf2, 0xf1, 0x60,
-	0x6d, 0x85, 0x3d, 0x69, 0xc1, 0x03, 0xff, 0x74, 0x32, 0xcd, 0x39, 0x7d, 0xc2, 0xbf, 0x8e, 0x55,
-	0x71, 0x15, 0x07, 0x56, 0xfe, 0x2f, 0x61, 0x53, 0xc5, 0xb8, 0xea, 0x20, 0xcd, 0xa8, 0xd9, 0x9a,
-	0xe1, 0xba, 0x98, 0x32, 0x5f, 0xe1, 0x8e, 0x14, 0xb9, 0x16, 0xf2, 0x56, 0x6d, 0x97, 0x6a, 0xa6,
-	0xd7, 0xac, 0x51, 0xac, 0x3d, 0x44, 0x4d, 0xfe, 0x55, 0x29, 0x03, 0x78, 0x1b, 0xd1, 0x05, 0xee,
-	0x6c, 0xde, 0x7d, 0x80, 0x75, 0xf4, 0x08, 0x5e, 0x07, 0x87, 0x85, 0xff, 0x8a, 0x61, 0x59, 0xde,
-	0xb8, 0x74, 0x5a, 0x9a, 0xc8, 0x95, 0xc6, 0x7f, 0xfd, 0xee, 0xd2, 0x18, 0x87, 0x5b, 0xb4, 0x2c,
-	0x0f, 0x11, 0x52, 0xa6, 0x9e, 0xed, 0x56, 0xf5, 0x43, 0x62, 0xb9, 0xff, 0x5a, 0x59, 0x06, 0xe3,
-	0x6f, 0xfb, 0x11, 0x28, 0x3a, 0x8e, 0xd8, 0x99, 0xe8, 0xe8, 0x51, 0x1d, 0x11, 0x0a, 0x6f, 0x01,
-	0xd0, 0x8a, 0x07, 0xdb, 0x77, 0x78, 0xfa, 0x9c, 0xca, 0x37, 0xf5, 0x83, 0xa7, 0x06, 0xa1, 0xe6,
-	0xc1, 0x53, 0x17, 0x8d, 0x2a, 0xe2, 0xb6, 0x7a, 0xc4, 0x52, 0xf9, 0x4a, 0x02, 0xc7, 0x53, 0x9c,
-	0x90, 0x1a, 0x76, 0x09, 0x82, 0x17, 0x01, 0x6c, 0x11, 0x30, 0x4d, 0x46, 0x82, 0x8c, 0x4b, 0xa7,
-	0xf7, 0x4d, 0xe4, 0xf4, 0x91, 0x10, 0xab, 0x69, 0xfa, 0x70, 0x09, 0xbc, 0x1d, 0xc3, 0x34, 0xc8,
-	0x30, 0x9d, 0xef, 0x8a, 0x29, 0x70, 0x15, 0x03, 0x45, 0x01, 0x14, 0x58, 0x8a, 0x4b, 0x65, 0x1e,
-	0xa2, 0x67, 0x8c, 0x26, 0x2c, 0x80, 0x61, 0xa3, 0x41, 0x98, 0x25, 0x22, 0x84, 0xc1, 0xcb, 0xe9,
-	0xc0, 0x68, 0x10, 0x6e, 0xa4, 0x3c, 0x06, 0x27, 0x59, 0x24, 0x84, 0xeb, 0x77, 0xca, 0x73, 0x4b,
-	0x86, 0x53, 0x17, 0x61, 0x83, 0xf7, 0xc1, 0x48, 0xcb, 0xbf, 0x6b, 0x55, 0x8c, 0x06, 0xe1, 0x81,
-	0x3f, 0xaf, 0xa6, 0x94, 0xb2, 0x9a, 0xa4, 0x50, 0xda, 0xbf, 0xb9, 0x55, 0x90, 0xf4, 0x23, 0x21,
-	0x2e, 0xd7, 0x2a, 0x36, 0x88, 0xd2, 0x04, 0xa7, 0x32, 0x1c, 0xf3, 0x34, 0xbc, 0x0b, 0x40, 0x9d,
-	0x58, 0x95, 0x86, 0xff, 0x52, 0xf8, 0x9c, 0xec, 0xe8, 0x73, 0xa1, 0x46, 0x91, 0x25, 0xf6, 0x29,
-	0x1d, 0xde, 0xde, 0x2a, 0xe4, 0xc4, 0x13, 0xd1, 0x73, 0x75, 0x62, 0x05, 0x3f, 0x95, 0x3b, 0xe0,
-	0xc5, 0x20, 0xfb, 0x4b, 0xe5, 0x76, 0xba, 0x5a, 0x3c, 0x5e, 0x41, 0xb0, 0x8f, 0x6c, 0x6f, 0x15,
-	0x40, 0x8b, 0x50, 0x2c, 0x7e, 0x3f, 0x49, 0x6d, 0x3c, 0xca, 0x8e, 0x41, 0x56, 0x78, 0x2f, 0xfc,
-	0xa7, 0x11, 0x6c, 0xeb, 0x86, 0xc1, 0x5d, 0x77, 0xc3, 0x1a, 0x38, 0x96, 0x00, 0x5f, 0x6a, 0xce,
-	0xcf, 0xc1, 0x73, 0x60, 0x88, 0xf8, 0x2f, 0x2a, 0xb6, 0xc5, 0x23, 0x31, 0xbc, 0xbd, 0x55, 0x38,
-	0x18, 0x2c, 0x9a, 0xd3, 0x0f, 0xb2, 0x8f, 0xf3, 0x16, 0xbc, 0x06, 0xf6, 0xdb, 0xee, 0x03, 0x1c,
-	0x42, 0xe8, 0xc4, 0xaa, 0x15, 0x1e, 0x66, 0xa3, 0xfc, 0x20, 0x81, 0x7c, 0x56, 0xfc, 0x78, 0x21,
-	0x2c, 0x82, 0x23, 0x86, 0xe3, 0x54, 0x38, 0x14, 0xdf, 0x91, 0xdf, 0x8b, 0xdd, 0x8a, 0x21, 0x46,
-	0x45, 0x3f, 0x64, 0x38, 0x4e, 0xf8, 0x66, 0xef, 0x7a, 0xb6, 0x02, 0x4e, 0xc4, 0xc0, 0xdf, 0xc0,
-	0x2e, 0xb9, 0x8b, 0x9a, 0x22, 0xf5, 0x93, 0xe0, 0x68, 0x62, 0x92, 0x04, 0x91, 0xd4, 0xff, 0xdf,
-	0x36, 0x48, 0xe0, 0x18, 0x38, 0x60, 0xae, 0x18, 0xb6, 0xcb, 0x7b, 0x34, 0x78, 0x50, 0x3e, 0x91,
-	0xda, 0xfa, 0x33, 0xf4, 0xc0, 0x83, 0x53, 0x04, 0xa0, 0x56, 0x5f, 0x76, 0x6c, 0xb3, 0xf2, 0x10,
-	0x35, 0x79, 0x5d, 0x9d, 0x54, 0x5b, 0x63, 0x5b, 0x0d, 0xc6, 0xb6, 0xba, 0xc8, 0x16, 0xdd, 0x45,
-	0xcd, 0xd2, 0xfe, 0x8d, 0xad, 0xc2, 0x80, 0x9e, 0xab, 0x89, 0x17, 0xf0, 0x14, 0x00, 0xb8, 0x46,
-	0x6d, 0xb7, 0x5a, 0xc1, 0x75, 0xca, 0xdc, 0x0f, 0xe9, 0xb9, 0xe0, 0xcd, 0x42, 0x9d, 0x2a, 0x26,
-	0x28, 0x24, 0x10, 0x88, 0x4e, 0xd8, 0x33, 0x9e, 0x1f, 0x82, 0xd3, 0xd9, 0x4e, 0x38, 0xd5, 0x13,
-	0x20, 0x67, 0x62, 0x97, 0x44, 0x77, 0x1f, 0x32, 0xf9, 0xba, 0x6e, 0x24, 0x3e, 0x93, 0xc0, 0x44,
-	0xfb, 0xc4, 0xe7, 0xa1, 0x24, 0xa5, 0xe6, 0x0d, 0x1f, 0xc3, 0xfc, 0x9c, 0xa0, 0x13, 0x42, 0x94,
-	0x22, 0x10, 0xf7, 0xac, 0xdd, 0x7e, 0x96, 0xc0, 0x85, 0x1e, 0xa0, 0x70, 0xd2, 0x4b, 0x91, 0xc3,
-	0x88, 0xb1, 0xf7, 0xcf, 0x5f, 0xde, 0x00, 0x13, 0x1d, 0x1b, 0x80, 0xef, 0xb9, 0x68, 0xd8, 0x5e,
-	0xeb, 0xd8, 0x12, 0x8e, 0xf6, 0xae, 0x05, 0xbe, 0x91, 0xc0, 0x68, 0x8a, 0xcb, 0xbe, 0x6a, 0x62,
-	0x36, 0x56, 0xc4, 0x83, 0xdd, 0x8b, 0x38, 0xbb, 0x7c, 0xf7, 0xb5, 0x67, 0xfe, 0xf3, 0x8c, 0x70,
-	0xb3, 0xd3, 0xfb, 0x39, 0xa7, 0x7e, 0x53, 0x02, 0x93, 0xbd, 0x60, 0xe1, 0xb9, 0x7f, 0x0f, 0x8c,
-	0xc6, 0x73, 0xdf, 0x52, 0x22, 0xc3, 0xd3, 0x17, 0xba, 0x26, 0xdf, 0xdf, 0x95, 0x65, 0xff, 0x28,
-	0x6e, 0xf7, 0xb5, 0x77, 0xe9, 0xff, 0x18, 0x8c, 0xa5, 0xf9, 0xec, 0x2b, 0xfd, 0xb1, 0xc6, 0x1e,
-	0xec, 0xd8, 0xd8, 0x89, 0xf4, 0x5e, 0x05, 0x4a, 0x42, 0xc9, 0x95, 0x9a, 0x0b, 0x35, 0x3a, 0xef,
-	0x16, 0x97, 0xca, 0x22, 0xad, 0x23, 0x60, 0x9f, 0x38, 0x76, 0x73, 0xba, 0xff, 0x53, 0xb9, 0x03,
-	0xce, 0x76, 0xb4, 0xe3, 0x29, 0x38, 0x1b, 0x91, 0x5f, 0x8e, 0x4d, 0x28, 0x97, 0x81, 0xa1, 0xc8,
-	0xba, 0x67, 0x13, 0xaa, 0xcc, 0x72, 0x09, 0x50, 0x74, 0x9c, 0xe2, 0x52, 0x99, 0x6d, 0x13, 0x7c,
-	0x15, 0xee, 0x65, 0x30, 0x24, 0x0c, 0xc4, 0xe0, 0x12, 0xcf, 0xca, 0x2c, 0x3f, 0xff, 0x52, 0x8c,
-	0x39, 0x86, 0xe3, 0x60, 0xc8, 0xd7, 0x24, 0x11, 0xf7, 0x07, 0x8d, 0x06, 0x61, 0x9e, 0x5d, 0x30,
-	0xca, 0xc7, 0x26, 0x7d, 0x1e, 0x92, 0x63, 0xfa, 0x8b, 0x51, 0x70, 0x80, 0x39, 0x84, 0x5f, 0x4b,
-	0xe0, 0x68, 0x6c, 0x62, 0xb3, 0x83, 0x35, 0x7d, 0xfb, 0xe4, 0x25, 0x41, 0x3e, 0xd3, 0x11, 0x87,
-	0xbf, 0x4a, 0xb9, 0xf6, 0xe9, 0x6f, 0x7f, 0x3d, 0x1d, 0x9c, 0x81, 0xd3, 0x5a, 0xda, 0xb5, 0x26,
-	0xe4, 0xe7, 0x0b, 0x02, 0x6d, 0x2d, 0xa6, 0x91, 0xd7, 0xe1, 0xb7, 0x02, 0x5d, 0x34, 0xbd, 0xf0,
-	0x52, 0xaa, 0xd3, 0xac, 0xdb, 0x86, 0xac, 0xf6, 0xba, 0x3c, 0xc8, 0x93, 0x32, 0xc9, 0x00, 0xbf,
-	0x04, 0x95, 0x54, 0xc0, 0xbe, 0x84, 0xc1, 0x21, 0x94, 0x5f, 0xda, 0x65, 0x0f, 0x1f, 0x9d, 0xb7,
-	0xb0, 0xc7, 0xa7, 0x00, 0x7c, 0x25, 0xdb, 0x7d, 0xba, 0xdc, 0x90, 0xa7, 0xfa, 0xb0, 0xe0, 0x98,
-	0xef, 0x30, 0xcc, 0x73, 0xb0, 0xd4, 0x39, 0xc8, 0xe2, 0xe4, 0x89, 0x06, 0x9a, 0x37, 0xf5, 0xba,
-	0xb6, 0xc6, 0x86, 0xe4, 0x3a, 0xdc, 0x92, 0x78, 0x2f, 0xa6, 0x1c, 0xe2, 0x11, 0x5e, 0x33, 0xbd,
-	0xa1, 0x8c, 0x4b, 0x0c, 0xf9, 0x4a, 0x9f, 0x56, 0x9c, 0xdf, 0x5d, 0xc6, 0xef, 0x26, 0xbc, 0xd1,
-	0x03, 0x3f, 0x9f, 0x4d, 0x47, 0x82, 0x7f, 0x48, 0xe0, 0x4c, 0xd7, 0x93, 0x1b, 0x5e, 0xef, 0xa9,
-	0x6c, 0xb2, 0xc4, 0x87, 0xfc, 0xc6, 0x6e, 0xcd, 0x39, 0xe3, 0x59, 0xc6, 0xf8, 0x0a, 0xbc, 0xdc,
-	0xb5, 0x0a, 0x5b, 0x7a, 0x22, 0x64, 0xf8, 0xb7, 0x04, 0x8e, 0xa5, 0xde, 0xca, 0x60, 0x0f, 0xb5,
-	0xd5, 0x76, 0x97, 0x92, 0xa7, 0xfb, 0x31, 0xe1, 0xe8, 0x3d, 0x86, 0xde, 0x81, 0x1f, 0xa5, 0xa2,
-	0x4f, 0xb5, 0x8d, 0xa6, 0x2c, 0x98, 0x75, 0x6a, 0x7c, 0x1a, 0xa4, 0x2c, 0x88, 0xdc, 0xf1, 0xd6,
-	0xe1, 0x53, 0x09, 0x8c, 0xb4, 0xdf, 0x07, 0xe1, 0xc5, 0x0e, 0x69, 0x48, 0x5c, 0x1b, 0x65, 0x25,
-	0x75, 0xf5, 0x1c, 0x32, 0xd9, 0xaa, 0x5b, 0x36, 0x72, 0x2c, 0xe5, 0x12, 0xa3, 0x76, 0x1e, 0xbe,
-	0x9c, 0x4d, 0x2d, 0x0a, 0xe0, 0x1f, 0x09, 0xbc, 0x90, 0x7e, 0x31, 0x82, 0x3d, 0x04, 0xb6, 0xfd,
-	0x16, 0x2a, 0x5f, 0xee, 0xcb, 0x86, 0x67, 0x83, 0x30, 0xc8, 0xab, 0xf0, 0x61, 0xf7, 0x6c, 0x84,
-	0xc6, 0xcf, 0x9c, 0x8e, 0x1d, 0x29, 0x79, 0xa4, 0x27, 0x45, 0x12, 0xec, 0xbd, 0x4f, 0x52, 0x95,
-	0x9e, 0xfc, 0xe6, 0xae, 0xed, 0x79, 0x70, 0x5e, 0x67, 0xc1, 0xb9, 0x0a, 0x67, 0x7a, 0x6c, 0x34,
-	0x26, 0xde, 0xc2, 0x4e, 0xdb, 0x90, 0x5a, 0xa2, 0x21, 0x3c, 0x4a, 0xee, 0xdb, 0x74, 0x45, 0x48,
-	0x10, 0xf8, 0x6a, 0x6f, 0xc7, 0x4f, 0x42, 0xec, 0xc8, 0xaf, 0xf5, 0x6f, 0xc8, 0x29, 0xcd, 0x30,
-	0x4a, 0x2a, 0xbc, 0x98, 0x31, 0x2d, 0xa9, 0x16, 0x13, 0x43, 0xda, 0x9a, 0xd1, 0x20, 0xeb, 0xf0,
-	0x7b, 0x51, 0xa9, 0x09, 0x09, 0xd3, 0xa9, 0x52, 0xb3, 0xc4, 0x52, 0xa7, 0x4a, 0xcd, 0xd4, 0x48,
-	0x3d, 0x20, 0x17, 0x12, 0xaa, 0x55, 0x7e, 0xeb, 0xf0, 0x47, 0x09, 0x1c, 0x8a, 0xea, 0x27, 0x38,
-	0xd1, 0xa9, 0x4b, 0xa2, 0x12, 0x4b, 0xce, 0x67, 0x08, 0x18, 0x8a, 0x2c, 0xa6, 0x5e, 0x10, 0x03,
-	0x54, 0x81, 0x1f, 0x64, 0x01, 0x4a, 0x08, 0x97, 0xdd, 0x34, 0x4b, 0xe9, 0xde, 0xc6, 0x76, 0x5e,
-	0xda, 0xdc, 0xce, 0x4b, 0x7f, 0x6e, 0xe7, 0xa5, 0x2f, 0x77, 0xf2, 0x03, 0x9b, 0x3b, 0xf9, 0x81,
-	0xdf, 0x77, 0xf2, 0x03, 0xef, 0x4f, 0x57, 0x6d, 0xba, 0x52, 0x5f, 0x56, 0x4d, 0xbc, 0xaa, 0xdd,
-	0x0c, 0x20, 0xbc, 0x85, 0xe8, 0x63, 0xec, 0xb5, 0x9a, 0xf9, 0x49, 0x0b, 0x13, 0x6d, 0xd6, 0x10,
-	0x59, 0xfe, 0x1f, 0xfb, 0x5f, 0xf7, 0xf2, 0xbf, 0x01, 0x00, 0x00, 0xff, 0xff, 0x0d, 0x78, 0xe6,
-	0x95, 0xc6, 0x16, 0x00, 0x00,
+	// 1564 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x58, 0xcf, 0x73, 0xdb, 0xd4,
+	0x16, 0x8e, 0xd2, 0x5f, 0xf1, 0x49, 0xdb, 0x97, 0xde, 0xa4, 0xef, 0xa5, 0x4a, 0x6b, 0xa7, 0xea,
+	0xa3, 0x4d, 0x33, 0xad, 0x44, 0xd2, 0xb4, 0x40, 0x43, 0x61, 0xec, 0xa6, 0xed, 0xa4, 0xed, 0x90,
+	0x20, 0x0f, 0x29, 0x30, 0x03, 0x1e, 0x45, 0xba, 0x75, 0x44, 0x15, 0xc9, 0xd5, 0x95, 0xdd, 0x7a,
+	0x32, 0x61, 0x3a, 0xac, 0x60, 0x07, 0x74, 0xc9, 0xc0, 0x5f, 0xc1, 0x0c, 0x0b, 0x16, 0xb0, 0xec,
+	0x82, 0x45, 0x80, 0x0d, 0xab, 0xc0, 0x24, 0xfc, 0x05, 0x2c, 0x58, 0x33, 0xba, 0xba, 0x57, 0x96,
+	0x2c, 0xc9, 0x76, 0xd2, 0xd0, 0x9d, 0x25, 0x9d, 0x73, 0xcf, 0x77, 0xbe, 0x73, 0xce, 0xbd, 0xdf,
+	0x35, 0x14, 0xf0, 0x63, 0x47, 0x77, 0x5c, 0xac, 0x38, 0x35, 0xec, 0x6a, 0x9e, 0xe3, 0x2a, 0x8d,
+	0x29, 0xe5, 0x61, 0x1d, 0xbb, 0x4d, 0xb9, 0xe6, 0x3a, 0x9e, 0x83, 0x86, 0x99, 0x81, 0xcc, 0x0d,
+	0xe4, 0xc6, 0x94, 0x38, 0xa9, 0x3b, 0x64, 0xd5, 0x21, 0xca, 0xb2, 0x46, 0x70, 0x60, 0xad, 0x34,
+	0xa6, 0x96, 0xb1, 0xa7, 0x4d, 0x29, 0x35, 0xad, 0x6a, 0xda, 0x9a, 0x67, 0x3a, 0x76, 0xb0, 0x80,
+	0x78, 0x22, 0xb0, 0xad, 0xd0, 0x27, 0x25, 0x78, 0x60, 0x9f, 0xc6, 0xd3, 0x82, 0xd7, 0x34, 0x57,
+	0x5b, 0xe5, 0x16, 0x27, 0xd3, 0x2c, 0xbc, 0xc7, 0xec, 0xeb, 0x48, 0xd5, 0xa9, 0x3a, 0xc1, 0xba,
+	0xfe, 0x2f, 0xee, 0x53, 0x75, 0x9c, 0xaa, 0x85, 0x15, 0xad, 0x66, 0x2a, 0x9a, 0x6d, 0x3b, 0x1e,
+	0x45, 0x13, 0xae, 0xe8, 0x61, 0xdb, 0xc0, 0xee, 0xaa, 0x69, 0x7b, 0x8a, 0xee, 0x36, 0x6b, 0x9e,
+	0xa3, 0x3c, 0xc0, 0x4d, 0xf6, 0x55, 0x2a, 0x03, 0xba, 0x85, 0xbd, 0x05, 0x16, 0x6c, 0xde, 0xbe,
+	0xef, 0xa8, 0xf8, 0x21, 0xba, 0x06, 0x47, 0x78, 0xfc, 0x8a, 0x66, 0x18, 0xee, 0xa8, 0x30, 0x2e,
+	0x4c, 0xe4, 0x4a, 0xa3, 0xbf, 0x7c, 0x7b, 0x71, 0x84, 0x25, 0x54, 0x34, 0x0c, 0x17, 0x13, 0x52,
+	0xf6, 0x5c, 0xd3, 0xae, 0xaa, 0x87, 0xb9, 0xb9, 0xff, 0x5a, 0x5a, 0x86, 0xd1, 0xb7, 0x7d, 0x8e,
+	0x8a, 0x96, 0xc5, 0x57, 0x26, 0x2a, 0x7e, 0x58, 0xc7, 0xc4, 0x43, 0x37, 0x01, 0x5a, 0x8c, 0xd1,
+	0x75, 0x07, 0xa7, 0xcf, 0xca, 0x6c, 0x51, 0x9f, 0x5e, 0x39, 0x28, 0x06, 0xa3, 0x57, 0x5e, 0xd4,
+	0xaa, 0x98, 0xf9, 0xaa, 0x11, 0x4f, 0xe9, 0x4b, 0x01, 0x4e, 0xa4, 0x04, 0x21, 0x35, 0xc7, 0x26,
+	0x18, 0x5d, 0x00, 0xd4, 0x4a, 0x40, 0xd7, 0x69, 0x12, 0x64, 0x54, 0x18, 0xdf, 0x37, 0x91, 0x53,
+	0x87, 0x42, 0xac, 0xba, 0xee, 0xc3, 0x25, 0xe8, 0x56, 0x0c, 0x53, 0x3f, 0xc5, 0x74, 0xae, 0x2b,
+	0xa6, 0x20, 0x54, 0x0c, 0x94, 0x07, 0x88, 0x63, 0x29, 0x2e, 0x95, 0x19, 0x45, 0xcf, 0xc9, 0x26,
+	0x2a, 0xc0, 0xa0, 0xd6, 0x20, 0xd4, 0x13, 0x13, 0x42, 0xe1, 0xe5, 0x54, 0xd0, 0x1a, 0x84, 0x39,
+	0x49, 0x8f, 0xe0, 0x24, 0x65, 0x82, 0x87, 0x7e, 0xa7, 0x3c, 0xb7, 0xa4, 0x59, 0x75, 0x4e, 0x1b,
+	0xba, 0x07, 0x43, 0xad, 0xf8, 0xb6, 0x51, 0xd1, 0x1a, 0x84, 0x11, 0x7f, 0x4e, 0x4e, 0x69, 0x76,
+	0x39, 0x99, 0x42, 0x69, 0xff, 0xc6, 0x66, 0x41, 0x50, 0x8f, 0x86, 0xb8, 0x6c, 0xa3, 0xd8, 0x20,
+	0x52, 0x13, 0x4e, 0x65, 0x04, 0x66, 0x65, 0x78, 0x17, 0xa0, 0x4e, 0x8c, 0x4a, 0xc3, 0x7f, 0xc9,
+	0x63, 0x4e, 0x76, 0x8c, 0xb9, 0x50, 0xf3, 0xb0, 0xc1, 0xd7, 0x29, 0x1d, 0xd9, 0xda, 0x2c, 0xe4,
+	0xf8, 0x13, 0x51, 0x73, 0x75, 0x62, 0x04, 0x3f, 0xa5, 0xdb, 0xf0, 0xbf, 0xa0, 0xfa, 0x4b, 0xe5,
+	0xf6, 0x74, 0x95, 0x38, 0x5f, 0x01, 0xd9, 0x47, 0xb7, 0x36, 0x0b, 0xd0, 0x4a, 0x28, 0xc6, 0xdf,
+	0x8f, 0x42, 0x5b, 0x1e, 0x65, 0x4b, 0x23, 0x2b, 0x6c, 0x16, 0xfe, 0x55, 0x06, 0xdb, 0xa6, 0xa1,
+	0x7f, 0xd7, 0xd3, 0xb0, 0x06, 0xc7, 0x13, 0xe0, 0x4b, 0xcd, 0xf9, 0x39, 0x74, 0x16, 0x06, 0x88,
+	0xff, 0xa2, 0x62, 0x1a, 0x8c, 0x89, 0xc1, 0xad, 0xcd, 0xc2, 0xa1, 0xc0, 0x68, 0x4e, 0x3d, 0x44,
+	0x3f, 0xce, 0x1b, 0xe8, 0x2a, 0xec, 0x37, 0xed, 0xfb, 0x4e, 0x08, 0xa1, 0x53, 0x56, 0x2d, 0x7a,
+	0xa8, 0x8f, 0xf4, 0xbd, 0x00, 0xf9, 0x2c, 0xfe, 0x58, 0x23, 0x2c, 0xc2, 0x51, 0xcd, 0xb2, 0x2a,
+	0x0c, 0x8a, 0x1f, 0xc8, 0x9f, 0xc5, 0x6e, 0xcd, 0x10, 0x4b, 0x45, 0x3d, 0xac, 0x59, 0x56, 0xf8,
+	0x66, 0xef, 0x66, 0xb6, 0x02, 0x63, 0x31, 0xf0, 0xd7, 0x1d, 0x9b, 0xdc, 0xc1, 0x4d, 0x5e, 0xfa,
+	0x49, 0x38, 0x96, 0xd8, 0x49, 0x02, 0x26, 0xd5, 0xff, 0xb4, 0x6d, 0x24, 0x68, 0x04, 0x0e, 0xe8,
+	0x2b, 0x9a, 0x69, 0xb3, 0x19, 0x0d, 0x1e, 0xa4, 0x27, 0x42, 0xdb, 0x7c, 0x86, 0x11, 0x18, 0x39,
+	0x45, 0x80, 0x5a, 0x7d, 0xd9, 0x32, 0xf5, 0xca, 0x03, 0xdc, 0x64, 0x7d, 0x75, 0x52, 0x6e, 0x6d,
+	0xdb, 0x72, 0xb0, 0x6d, 0xcb, 0x8b, 0xd4, 0xe8, 0x0e, 0x6e, 0x96, 0xf6, 0x3f, 0xdb, 0x2c, 0xf4,
+	0xa9, 0xb9, 0x1a, 0x7f, 0x81, 0x4e, 0x01, 0x38, 0x35, 0xcf, 0xb4, 0xab, 0x15, 0xa7, 0xee, 0xd1,
+	0xf0, 0x03, 0x6a, 0x2e, 0x78, 0xb3, 0x50, 0xf7, 0x24, 0x1d, 0x0a, 0x09, 0x04, 0x7c, 0x12, 0xf6,
+	0x2c, 0xcf, 0x0f, 0x61, 0x3c, 0x3b, 0x08, 0x4b, 0x75, 0x0c, 0x72, 0xba, 0x63, 0x93, 0xe8, 0xea,
+	0x03, 0x3a, 0xb3, 0xeb, 0x96, 0xc4, 0xa7, 0x02, 0x4c, 0xb4, 0xef, 0xf8, 0x8c, 0x4a, 0x52, 0x6a,
+	0x5e, 0xf7, 0x31, 0xcc, 0xcf, 0xf1, 0x74, 0x42, 0x88, 0x42, 0x04, 0xe2, 0x9e, 0x8d, 0xdb, 0x4f,
+	0x02, 0x9c, 0xef, 0x01, 0x0a, 0x4b, 0x7a, 0x29, 0x72, 0x18, 0xd1, 0xec, 0xfd, 0xf3, 0x97, 0x0d,
+	0xc0, 0x44, 0xc7, 0x01, 0x60, 0x6b, 0x2e, 0x6a, 0xa6, 0xdb, 0x3a, 0xb6, 0x78, 0xa0, 0xbd, 0x1b,
+	0x81, 0xaf, 0x05, 0x18, 0x4e, 0x09, 0xb9, 0xa3, 0x9e, 0x98, 0x8d, 0x35, 0x71, 0x7f, 0xf7, 0x26,
+	0xce, 0x6e, 0xdf, 0x7d, 0xed, 0x95, 0xff, 0x2c, 0x83, 0x6e, 0x7a, 0x7a, 0xbf, 0xe0, 0xd2, 0x6f,
+	0x08, 0x30, 0xd9, 0x0b, 0x16, 0x56, 0xfb, 0xf7, 0x60, 0x38, 0x5e, 0xfb, 0x96, 0x12, 0x19, 0x9c,
+	0x3e, 0xdf, 0xb5, 0xf8, 0xfe, 0xaa, 0xb4, 0xfa, 0xc7, 0x9c, 0xf6, 0x58, 0x7b, 0x57, 0xfe, 0x8f,
+	0x61, 0x24, 0x2d, 0xe6, 0x8e, 0xca, 0x1f, 0x1b, 0xec, 0xfe, 0x8e, 0x83, 0x9d, 0x28, 0xef, 0x15,
+	0x90, 0x12, 0x4a, 0xae, 0xd4, 0x5c, 0xa8, 0x79, 0xf3, 0x76, 0x71, 0xa9, 0xcc, 0xcb, 0x3a, 0x04,
+	0xfb, 0xf8, 0xb1, 0x9b, 0x53, 0xfd, 0x9f, 0xd2, 0x6d, 0x38, 0xd3, 0xd1, 0x8f, 0x95, 0xe0, 0x4c,
+	0x44, 0x7e, 0x59, 0x26, 0xf1, 0x98, 0x0c, 0x0c, 0x45, 0xd6, 0x5d, 0x93, 0x78, 0xd2, 0x2c, 0x93,
+	0x00, 0x45, 0xcb, 0x2a, 0x2e, 0x95, 0xe9, 0x32, 0xc1, 0x57, 0x1e, 0x5e, 0x84, 0x01, 0xee, 0xc0,
+	0x37, 0x2e, 0xfe, 0x2c, 0xcd, 0xb2, 0xf3, 0x2f, 0xc5, 0x99, 0x61, 0x38, 0x01, 0x03, 0xbe, 0x26,
+	0x89, 0x84, 0x3f, 0xa4, 0x35, 0x08, 0x8d, 0x6c, 0xc3, 0x30, 0xdb, 0x36, 0xbd, 0x17, 0x21, 0x39,
+	0xa4, 0x11, 0x40, 0x34, 0xde, 0x22, 0xbd, 0x76, 0xb0, 0x70, 0xd2, 0x22, 0x43, 0xc1, 0xdf, 0x32,
+	0xdc, 0xaf, 0xc1, 0xc1, 0xe0, 0x7a, 0xc2, 0x62, 0x8f, 0xa5, 0xc6, 0x0e, 0x9c, 0xd8, 0xa9, 0xc4,
+	0x1c, 0xa6, 0xbf, 0x18, 0x81, 0x03, 0x74, 0x49, 0xf4, 0x44, 0x80, 0x83, 0x81, 0x09, 0x4a, 0xc7,
+	0x9e, 0xc4, 0x23, 0x4e, 0x74, 0x37, 0x0c, 0x20, 0x4a, 0x67, 0x3e, 0xf9, 0xf5, 0xcf, 0xa7, 0xfd,
+	0xa7, 0xd0, 0x98, 0x92, 0x76, 0x75, 0x62, 0x71, 0xbf, 0x12, 0xe0, 0x58, 0xec, 0x70, 0xa2, 0x1a,
+	0x22, 0x1d, 0x4d, 0xf2, 0x3e, 0x24, 0x9e, 0xee, 0x48, 0xb9, 0x6f, 0x25, 0x5d, 0xa5, 0x30, 0x66,
+	0xd0, 0x74, 0x2a, 0x8c, 0xb0, 0x94, 0xbe, 0xf6, 0x51, 0xd6, 0x62, 0xd7, 0x81, 0x75, 0xf4, 0x0d,
+	0x47, 0x17, 0xed, 0x64, 0x74, 0x31, 0x9b, 0x82, 0x94, 0x8b, 0x95, 0x28, 0xf7, 0x6a, 0xce, 0x78,
+	0x9b, 0xa4, 0x80, 0xff, 0x8f, 0xa4, 0x54, 0xc0, 0xbe, 0x5a, 0x73, 0x42, 0x28, 0x3f, 0xb7, 0x2b,
+	0x3c, 0x76, 0x4a, 0xdc, 0x74, 0x5c, 0xb6, 0xe1, 0xa1, 0x97, 0xb3, 0xc3, 0xa7, 0x2b, 0x2b, 0x71,
+	0x6a, 0x07, 0x1e, 0x0c, 0xf3, 0x6d, 0x8a, 0x79, 0x0e, 0x95, 0x3a, 0x93, 0xcc, 0x0f, 0xd9, 0x28,
+	0xd1, 0x6c, 0xff, 0x5a, 0x57, 0xd6, 0xe8, 0x79, 0xb0, 0x8e, 0x36, 0x05, 0xb6, 0xed, 0xa4, 0xe8,
+	0x95, 0x48, 0x5e, 0x33, 0xbd, 0xa1, 0x8c, 0xab, 0x29, 0xf1, 0xf2, 0x0e, 0xbd, 0x58, 0x7e, 0x77,
+	0x68, 0x7e, 0x37, 0xd0, 0xf5, 0x1e, 0xf2, 0xf3, 0xb3, 0xe9, 0x98, 0xe0, 0xef, 0x02, 0x9c, 0xee,
+	0x2a, 0x52, 0xd0, 0xb5, 0x9e, 0xda, 0x26, 0x4b, 0x67, 0x89, 0x6f, 0xec, 0xd6, 0x9d, 0x65, 0x3c,
+	0x4b, 0x33, 0xbe, 0x8c, 0x2e, 0x75, 0xed, 0xc2, 0x96, 0x74, 0x0a, 0x33, 0xfc, 0x4b, 0x80, 0xe3,
+	0xa9, 0x17, 0x50, 0xd4, 0x43, 0x6f, 0xb5, 0x5d, 0x1b, 0xc5, 0xe9, 0x9d, 0xb8, 0x30, 0xf4, 0x2e,
+	0x45, 0x6f, 0xa1, 0x8f, 0x52, 0xd1, 0xa7, 0xfa, 0x46, 0x4b, 0x16, 0x6c, 0xeb, 0x72, 0x7c, 0x37,
+	0x48, 0x31, 0x88, 0x5c, 0x67, 0xd7, 0xd1, 0x53, 0x01, 0x86, 0xda, 0xaf, 0xbe, 0xe8, 0x42, 0x87,
+	0x32, 0x24, 0x6e, 0xc8, 0xa2, 0x94, 0x6a, 0x3d, 0x87, 0x75, 0x6a, 0x75, 0xd3, 0xc4, 0x96, 0x21,
+	0x5d, 0xa4, 0xa9, 0x9d, 0x43, 0x2f, 0x65, 0xa7, 0x16, 0x05, 0xf0, 0xb7, 0x00, 0xff, 0x4d, 0xbf,
+	0x03, 0xa2, 0x1e, 0x88, 0x6d, 0xbf, 0x70, 0x8b, 0x97, 0x76, 0xe4, 0xc3, 0xaa, 0x41, 0x28, 0xe4,
+	0x55, 0xf4, 0xa0, 0x7b, 0x35, 0x42, 0xe7, 0xe7, 0x2e, 0xc7, 0xb6, 0x90, 0x54, 0x2f, 0x49, 0x3d,
+	0x88, 0x7a, 0x9f, 0x93, 0x54, 0x51, 0x2b, 0xbe, 0xb9, 0x6b, 0x7f, 0x46, 0xce, 0xeb, 0x94, 0x9c,
+	0x2b, 0x68, 0xa6, 0xc7, 0x41, 0xa3, 0x3a, 0x35, 0x9c, 0xb4, 0x67, 0x42, 0x4b, 0x1f, 0x85, 0x47,
+	0xc9, 0x3d, 0xd3, 0x5b, 0xe1, 0x6a, 0x0b, 0xbd, 0xd2, 0xdb, 0xf1, 0x93, 0xd0, 0x75, 0xe2, 0xab,
+	0x3b, 0x77, 0x64, 0x29, 0xcd, 0xd0, 0x94, 0x64, 0x74, 0x21, 0x63, 0xb7, 0xf4, 0x94, 0x98, 0xee,
+	0x53, 0xd6, 0xb4, 0x06, 0x59, 0x47, 0xdf, 0xf1, 0x4e, 0x4d, 0xa8, 0xb5, 0x4e, 0x9d, 0x9a, 0xa5,
+	0x0b, 0x3b, 0x75, 0x6a, 0xa6, 0x1c, 0xec, 0x01, 0x39, 0x57, 0x8b, 0xad, 0xf6, 0x5b, 0x47, 0x3f,
+	0x08, 0x70, 0x38, 0x2a, 0x15, 0xd1, 0x44, 0xa7, 0x29, 0x89, 0xaa, 0x49, 0x31, 0x9f, 0x21, 0x60,
+	0x3c, 0x6c, 0x50, 0xf5, 0x82, 0x29, 0xa0, 0x0a, 0xfa, 0x20, 0x0b, 0x50, 0x42, 0xb8, 0xec, 0x66,
+	0x58, 0x4a, 0x77, 0x9f, 0x6d, 0xe5, 0x85, 0x8d, 0xad, 0xbc, 0xf0, 0xc7, 0x56, 0x5e, 0xf8, 0x7c,
+	0x3b, 0xdf, 0xb7, 0xb1, 0x9d, 0xef, 0xfb, 0x6d, 0x3b, 0xdf, 0xf7, 0xfe, 0x74, 0xd5, 0xf4, 0x56,
+	0xea, 0xcb, 0xb2, 0xee, 0xac, 0x2a, 0x37, 0x02, 0x08, 0x6f, 0x61, 0xef, 0x91, 0xe3, 0xb6, 0x86,
+	0xf9, 0x71, 0x0b, 0x93, 0xd7, 0xac, 0x61, 0xb2, 0x7c, 0x90, 0xfe, 0x85, 0x7d, 0xe9, 0x9f, 0x00,
+	0x00, 0x00, 0xff, 0xff, 0xf1, 0xe6, 0x00, 0x11, 0xd3, 0x17, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1493,6 +1582,8 @@ const _ = grpc.SupportPackageIsVersion4
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
 type QueryClient interface {
+	// Params queries the parameters of the module.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
 	// QueryOperatorInfo queries the operator information.
 	QueryOperatorInfo(ctx context.Context, in *GetOperatorInfoReq, opts ...grpc.CallOption) (*OperatorInfo, error)
 	// QueryAllOperators queries all operators.
@@ -1529,6 +1620,15 @@ func NewQueryClient(cc grpc1.ClientConn) QueryClient {
 	return &queryClient{cc}
 }
 
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/exocore.operator.v1.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) QueryOperatorInfo(ctx context.Context, in *GetOperatorInfoReq, opts ...grpc.CallOption) (*OperatorInfo, error) {
 	out := new(OperatorInfo)
 	err := c.cc.Invoke(ctx, "/exocore.operator.v1.Query/QueryOperatorInfo", in, out, opts...)
@@ -1639,6 +1739,8 @@ func (c *queryClient) QueryOptInfo(ctx context.Context, in *QueryOptInfoRequest,
 
 // QueryServer is the server API for Query service.
 type QueryServer interface {
+	// Params queries the parameters of the module.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
 	// QueryOperatorInfo queries the operator information.
 	QueryOperatorInfo(context.Context, *GetOperatorInfoReq) (*OperatorInfo, error)
 	// QueryAllOperators queries all operators.
@@ -1671,6 +1773,9 @@ type QueryServer interface {
 type UnimplementedQueryServer struct {
 }
 
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
 func (*UnimplementedQueryServer) QueryOperatorInfo(ctx context.Context, req *GetOperatorInfoReq) (*OperatorInfo, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method QueryOperatorInfo not implemented")
 }
@@ -1712,6 +1817,24 @@ func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
 }
 
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/exocore.operator.v1.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_QueryOperatorInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetOperatorInfoReq)
 	if err := dec(in); err != nil {
@@ -1932,6 +2055,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "exocore.operator.v1.Query",
 	HandlerType: (*QueryServer)(nil),
 	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
 		{
 			MethodName: "QueryOperatorInfo",
 			Handler:    _Query_QueryOperatorInfo_Handler,
@@ -2966,6 +3093,62 @@ func (m *QueryOptInfoRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -3376,6 +3559,26 @@ func (m *QueryOptInfoRequest) Size() (n int) {
 	return n
 }
 
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -5991,6 +6194,139 @@ func (m *QueryOptInfoRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
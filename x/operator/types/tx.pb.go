@@ -152,6 +152,123 @@ func (m *OperatorOptedUSDValue) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_OperatorOptedUSDValue proto.InternalMessageInfo
 
+// OperatorVotingPower is the voting power of an operator recorded in a snapshot.
+type OperatorVotingPower struct {
+	// operator_addr is the bech32 address of the operator.
+	OperatorAddr string `protobuf:"bytes,1,opt,name=operator_addr,json=operatorAddr,proto3" json:"operator_addr,omitempty"`
+	// voting_power is the active opted-in USD value of the operator for the AVS.
+	VotingPower github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=voting_power,json=votingPower,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"voting_power"`
+}
+
+func (m *OperatorVotingPower) Reset()         { *m = OperatorVotingPower{} }
+func (m *OperatorVotingPower) String() string { return proto.CompactTextString(m) }
+func (*OperatorVotingPower) ProtoMessage()    {}
+func (*OperatorVotingPower) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b229d5663e4df167, []int{2}
+}
+func (m *OperatorVotingPower) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OperatorVotingPower) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OperatorVotingPower.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *OperatorVotingPower) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OperatorVotingPower.Merge(m, src)
+}
+func (m *OperatorVotingPower) XXX_Size() int {
+	return m.Size()
+}
+func (m *OperatorVotingPower) XXX_DiscardUnknown() {
+	xxx_messageInfo_OperatorVotingPower.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OperatorVotingPower proto.InternalMessageInfo
+
+func (m *OperatorVotingPower) GetOperatorAddr() string {
+	if m != nil {
+		return m.OperatorAddr
+	}
+	return ""
+}
+
+// VotingPowerSnapshot records the voting power of an AVS and its operators at the end of
+// an epoch. The voting power is effective from the block at which the snapshot is taken
+// until the next snapshot, so it's used to slash the operators for the historical
+// infractions instead of their current voting power.
+type VotingPowerSnapshot struct {
+	// epoch_identifier is the epoch identifier of the AVS.
+	EpochIdentifier string `protobuf:"bytes,1,opt,name=epoch_identifier,json=epochIdentifier,proto3" json:"epoch_identifier,omitempty"`
+	// epoch_number is the number of the epoch whose end triggers the snapshot.
+	EpochNumber int64 `protobuf:"varint,2,opt,name=epoch_number,json=epochNumber,proto3" json:"epoch_number,omitempty"`
+	// total_voting_power is the total voting power of the AVS.
+	TotalVotingPower github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=total_voting_power,json=totalVotingPower,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"total_voting_power"`
+	// operator_voting_powers is the voting power list of the operators opted into the AVS.
+	OperatorVotingPowers []OperatorVotingPower `protobuf:"bytes,4,rep,name=operator_voting_powers,json=operatorVotingPowers,proto3" json:"operator_voting_powers"`
+}
+
+func (m *VotingPowerSnapshot) Reset()         { *m = VotingPowerSnapshot{} }
+func (m *VotingPowerSnapshot) String() string { return proto.CompactTextString(m) }
+func (*VotingPowerSnapshot) ProtoMessage()    {}
+func (*VotingPowerSnapshot) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b229d5663e4df167, []int{3}
+}
+func (m *VotingPowerSnapshot) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VotingPowerSnapshot) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VotingPowerSnapshot.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *VotingPowerSnapshot) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VotingPowerSnapshot.Merge(m, src)
+}
+func (m *VotingPowerSnapshot) XXX_Size() int {
+	return m.Size()
+}
+func (m *VotingPowerSnapshot) XXX_DiscardUnknown() {
+	xxx_messageInfo_VotingPowerSnapshot.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VotingPowerSnapshot proto.InternalMessageInfo
+
+func (m *VotingPowerSnapshot) GetEpochIdentifier() string {
+	if m != nil {
+		return m.EpochIdentifier
+	}
+	return ""
+}
+
+func (m *VotingPowerSnapshot) GetEpochNumber() int64 {
+	if m != nil {
+		return m.EpochNumber
+	}
+	return 0
+}
+
+func (m *VotingPowerSnapshot) GetOperatorVotingPowers() []OperatorVotingPower {
+	if m != nil {
+		return m.OperatorVotingPowers
+	}
+	return nil
+}
+
 // ClientChainEarningAddrList is the list of client chain earning addresses.
 // Because the reward token provide by the AVS might be located at different client chain, the operator need to
 // provide the different client chain address to receive the token rewards.
@@ -164,7 +281,7 @@ func (m *ClientChainEarningAddrList) Reset()         { *m = ClientChainEarningAd
 func (m *ClientChainEarningAddrList) String() string { return proto.CompactTextString(m) }
 func (*ClientChainEarningAddrList) ProtoMessage()    {}
 func (*ClientChainEarningAddrList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b229d5663e4df167, []int{2}
+	return fileDescriptor_b229d5663e4df167, []int{4}
 }
 func (m *ClientChainEarningAddrList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -212,7 +329,7 @@ func (m *ClientChainEarningAddrInfo) Reset()         { *m = ClientChainEarningAd
 func (m *ClientChainEarningAddrInfo) String() string { return proto.CompactTextString(m) }
 func (*ClientChainEarningAddrInfo) ProtoMessage()    {}
 func (*ClientChainEarningAddrInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b229d5663e4df167, []int{3}
+	return fileDescriptor_b229d5663e4df167, []int{5}
 }
 func (m *ClientChainEarningAddrInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -274,7 +391,7 @@ func (m *OperatorInfo) Reset()         { *m = OperatorInfo{} }
 func (m *OperatorInfo) String() string { return proto.CompactTextString(m) }
 func (*OperatorInfo) ProtoMessage()    {}
 func (*OperatorInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b229d5663e4df167, []int{4}
+	return fileDescriptor_b229d5663e4df167, []int{6}
 }
 func (m *OperatorInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -354,7 +471,7 @@ func (m *OptedInfo) Reset()         { *m = OptedInfo{} }
 func (m *OptedInfo) String() string { return proto.CompactTextString(m) }
 func (*OptedInfo) ProtoMessage()    {}
 func (*OptedInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b229d5663e4df167, []int{5}
+	return fileDescriptor_b229d5663e4df167, []int{7}
 }
 func (m *OptedInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -423,7 +540,7 @@ func (m *OptedInAssetState) Reset()         { *m = OptedInAssetState{} }
 func (m *OptedInAssetState) String() string { return proto.CompactTextString(m) }
 func (*OptedInAssetState) ProtoMessage()    {}
 func (*OptedInAssetState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b229d5663e4df167, []int{6}
+	return fileDescriptor_b229d5663e4df167, []int{8}
 }
 func (m *OptedInAssetState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -466,7 +583,7 @@ func (m *SlashFromUndelegation) Reset()         { *m = SlashFromUndelegation{} }
 func (m *SlashFromUndelegation) String() string { return proto.CompactTextString(m) }
 func (*SlashFromUndelegation) ProtoMessage()    {}
 func (*SlashFromUndelegation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b229d5663e4df167, []int{7}
+	return fileDescriptor_b229d5663e4df167, []int{9}
 }
 func (m *SlashFromUndelegation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -509,6 +626,72 @@ func (m *SlashFromUndelegation) GetAssetID() string {
 	return ""
 }
 
+// SlashFromRedelegation records the slash detail from the redelegation
+type SlashFromRedelegation struct {
+	// staker_id is the staker id.
+	StakerID string `protobuf:"bytes,1,opt,name=staker_id,json=stakerId,proto3" json:"staker_id,omitempty"`
+	// asset_id is the asset id.
+	AssetID string `protobuf:"bytes,2,opt,name=asset_id,json=assetId,proto3" json:"asset_id,omitempty"`
+	// dst_operator_addr is the operator to which the slashed asset has been redelegated.
+	DstOperatorAddr string `protobuf:"bytes,3,opt,name=dst_operator_addr,json=dstOperatorAddr,proto3" json:"dst_operator_addr,omitempty"`
+	// amount is the slashed amount from the redelegation.
+	Amount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amount"`
+}
+
+func (m *SlashFromRedelegation) Reset()         { *m = SlashFromRedelegation{} }
+func (m *SlashFromRedelegation) String() string { return proto.CompactTextString(m) }
+func (*SlashFromRedelegation) ProtoMessage()    {}
+func (*SlashFromRedelegation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b229d5663e4df167, []int{10}
+}
+func (m *SlashFromRedelegation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SlashFromRedelegation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SlashFromRedelegation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SlashFromRedelegation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SlashFromRedelegation.Merge(m, src)
+}
+func (m *SlashFromRedelegation) XXX_Size() int {
+	return m.Size()
+}
+func (m *SlashFromRedelegation) XXX_DiscardUnknown() {
+	xxx_messageInfo_SlashFromRedelegation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SlashFromRedelegation proto.InternalMessageInfo
+
+func (m *SlashFromRedelegation) GetStakerID() string {
+	if m != nil {
+		return m.StakerID
+	}
+	return ""
+}
+
+func (m *SlashFromRedelegation) GetAssetID() string {
+	if m != nil {
+		return m.AssetID
+	}
+	return ""
+}
+
+func (m *SlashFromRedelegation) GetDstOperatorAddr() string {
+	if m != nil {
+		return m.DstOperatorAddr
+	}
+	return ""
+}
+
 // SlashFromAssetsPool records the slash detail from the operator assets pool
 type SlashFromAssetsPool struct {
 	// asset_id is the asset id.
@@ -521,7 +704,7 @@ func (m *SlashFromAssetsPool) Reset()         { *m = SlashFromAssetsPool{} }
 func (m *SlashFromAssetsPool) String() string { return proto.CompactTextString(m) }
 func (*SlashFromAssetsPool) ProtoMessage()    {}
 func (*SlashFromAssetsPool) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b229d5663e4df167, []int{8}
+	return fileDescriptor_b229d5663e4df167, []int{11}
 }
 func (m *SlashFromAssetsPool) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -567,13 +750,15 @@ type SlashExecutionInfo struct {
 	SlashUndelegations []SlashFromUndelegation `protobuf:"bytes,3,rep,name=slash_undelegations,json=slashUndelegations,proto3" json:"slash_undelegations"`
 	// SlashFromAssetsPool records all slash info related to the assets pool
 	SlashAssetsPool []SlashFromAssetsPool `protobuf:"bytes,4,rep,name=slash_assets_pool,json=slashAssetsPool,proto3" json:"slash_assets_pool"`
+	// SlashRedelegations records all slash info related to the redelegation
+	SlashRedelegations []SlashFromRedelegation `protobuf:"bytes,5,rep,name=slash_redelegations,json=slashRedelegations,proto3" json:"slash_redelegations"`
 }
 
 func (m *SlashExecutionInfo) Reset()         { *m = SlashExecutionInfo{} }
 func (m *SlashExecutionInfo) String() string { return proto.CompactTextString(m) }
 func (*SlashExecutionInfo) ProtoMessage()    {}
 func (*SlashExecutionInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b229d5663e4df167, []int{9}
+	return fileDescriptor_b229d5663e4df167, []int{12}
 }
 func (m *SlashExecutionInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -616,6 +801,13 @@ func (m *SlashExecutionInfo) GetSlashAssetsPool() []SlashFromAssetsPool {
 	return nil
 }
 
+func (m *SlashExecutionInfo) GetSlashRedelegations() []SlashFromRedelegation {
+	if m != nil {
+		return m.SlashRedelegations
+	}
+	return nil
+}
+
 // OperatorSlashInfo is the slash info of operator
 type OperatorSlashInfo struct {
 	// slash_contract is the address of slash contract
@@ -638,7 +830,7 @@ func (m *OperatorSlashInfo) Reset()         { *m = OperatorSlashInfo{} }
 func (m *OperatorSlashInfo) String() string { return proto.CompactTextString(m) }
 func (*OperatorSlashInfo) ProtoMessage()    {}
 func (*OperatorSlashInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b229d5663e4df167, []int{10}
+	return fileDescriptor_b229d5663e4df167, []int{13}
 }
 func (m *OperatorSlashInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -721,7 +913,7 @@ func (m *RegisterOperatorReq) Reset()         { *m = RegisterOperatorReq{} }
 func (m *RegisterOperatorReq) String() string { return proto.CompactTextString(m) }
 func (*RegisterOperatorReq) ProtoMessage()    {}
 func (*RegisterOperatorReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b229d5663e4df167, []int{11}
+	return fileDescriptor_b229d5663e4df167, []int{14}
 }
 func (m *RegisterOperatorReq) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -758,7 +950,7 @@ func (m *RegisterOperatorResponse) Reset()         { *m = RegisterOperatorRespon
 func (m *RegisterOperatorResponse) String() string { return proto.CompactTextString(m) }
 func (*RegisterOperatorResponse) ProtoMessage()    {}
 func (*RegisterOperatorResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b229d5663e4df167, []int{12}
+	return fileDescriptor_b229d5663e4df167, []int{15}
 }
 func (m *RegisterOperatorResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -787,6 +979,92 @@ func (m *RegisterOperatorResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_RegisterOperatorResponse proto.InternalMessageInfo
 
+// EditOperatorReq is the request to edit an existing operator. The empty fields are left
+// unchanged.
+type EditOperatorReq struct {
+	// from_address is the address of the operator (sdk.AccAddress).
+	FromAddress string `protobuf:"bytes,1,opt,name=from_address,json=fromAddress,proto3" json:"from_address,omitempty"`
+	// operator_meta_info is the new operator meta info.
+	OperatorMetaInfo string `protobuf:"bytes,2,opt,name=operator_meta_info,json=operatorMetaInfo,proto3" json:"operator_meta_info,omitempty"`
+	// approve_addr is the new approve address.
+	ApproveAddr string `protobuf:"bytes,3,opt,name=approve_addr,json=approveAddr,proto3" json:"approve_addr,omitempty"`
+	// client_chain_earnings_addr is the list of client chain earning addresses to be appended.
+	ClientChainEarningsAddr []*ClientChainEarningAddrInfo `protobuf:"bytes,4,rep,name=client_chain_earnings_addr,json=clientChainEarningsAddr,proto3" json:"client_chain_earnings_addr,omitempty"`
+	// commission_rate is the new commission rate. it can only be changed once within 24 hours,
+	// and the change shouldn't exceed the max change rate of the commission.
+	CommissionRate *github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=commission_rate,json=commissionRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"commission_rate,omitempty"`
+}
+
+func (m *EditOperatorReq) Reset()         { *m = EditOperatorReq{} }
+func (m *EditOperatorReq) String() string { return proto.CompactTextString(m) }
+func (*EditOperatorReq) ProtoMessage()    {}
+func (*EditOperatorReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b229d5663e4df167, []int{16}
+}
+func (m *EditOperatorReq) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EditOperatorReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EditOperatorReq.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EditOperatorReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EditOperatorReq.Merge(m, src)
+}
+func (m *EditOperatorReq) XXX_Size() int {
+	return m.Size()
+}
+func (m *EditOperatorReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_EditOperatorReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EditOperatorReq proto.InternalMessageInfo
+
+// EditOperatorResponse is the response to an edit operator request.
+type EditOperatorResponse struct {
+}
+
+func (m *EditOperatorResponse) Reset()         { *m = EditOperatorResponse{} }
+func (m *EditOperatorResponse) String() string { return proto.CompactTextString(m) }
+func (*EditOperatorResponse) ProtoMessage()    {}
+func (*EditOperatorResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b229d5663e4df167, []int{17}
+}
+func (m *EditOperatorResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EditOperatorResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EditOperatorResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EditOperatorResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EditOperatorResponse.Merge(m, src)
+}
+func (m *EditOperatorResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *EditOperatorResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_EditOperatorResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EditOperatorResponse proto.InternalMessageInfo
+
 // OptIntoAVSReq is the request to opt into an AVS.
 type OptIntoAVSReq struct {
 	// from_address is the address of the operator (sdk.AccAddress).
@@ -803,7 +1081,7 @@ func (m *OptIntoAVSReq) Reset()         { *m = OptIntoAVSReq{} }
 func (m *OptIntoAVSReq) String() string { return proto.CompactTextString(m) }
 func (*OptIntoAVSReq) ProtoMessage()    {}
 func (*OptIntoAVSReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b229d5663e4df167, []int{13}
+	return fileDescriptor_b229d5663e4df167, []int{18}
 }
 func (m *OptIntoAVSReq) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -840,7 +1118,7 @@ func (m *OptIntoAVSResponse) Reset()         { *m = OptIntoAVSResponse{} }
 func (m *OptIntoAVSResponse) String() string { return proto.CompactTextString(m) }
 func (*OptIntoAVSResponse) ProtoMessage()    {}
 func (*OptIntoAVSResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b229d5663e4df167, []int{14}
+	return fileDescriptor_b229d5663e4df167, []int{19}
 }
 func (m *OptIntoAVSResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -881,7 +1159,7 @@ func (m *OptOutOfAVSReq) Reset()         { *m = OptOutOfAVSReq{} }
 func (m *OptOutOfAVSReq) String() string { return proto.CompactTextString(m) }
 func (*OptOutOfAVSReq) ProtoMessage()    {}
 func (*OptOutOfAVSReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b229d5663e4df167, []int{15}
+	return fileDescriptor_b229d5663e4df167, []int{20}
 }
 func (m *OptOutOfAVSReq) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -918,7 +1196,7 @@ func (m *OptOutOfAVSResponse) Reset()         { *m = OptOutOfAVSResponse{} }
 func (m *OptOutOfAVSResponse) String() string { return proto.CompactTextString(m) }
 func (*OptOutOfAVSResponse) ProtoMessage()    {}
 func (*OptOutOfAVSResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b229d5663e4df167, []int{16}
+	return fileDescriptor_b229d5663e4df167, []int{21}
 }
 func (m *OptOutOfAVSResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -965,7 +1243,7 @@ func (m *SetConsKeyReq) Reset()         { *m = SetConsKeyReq{} }
 func (m *SetConsKeyReq) String() string { return proto.CompactTextString(m) }
 func (*SetConsKeyReq) ProtoMessage()    {}
 func (*SetConsKeyReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b229d5663e4df167, []int{17}
+	return fileDescriptor_b229d5663e4df167, []int{22}
 }
 func (m *SetConsKeyReq) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1002,7 +1280,7 @@ func (m *SetConsKeyResponse) Reset()         { *m = SetConsKeyResponse{} }
 func (m *SetConsKeyResponse) String() string { return proto.CompactTextString(m) }
 func (*SetConsKeyResponse) ProtoMessage()    {}
 func (*SetConsKeyResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b229d5663e4df167, []int{18}
+	return fileDescriptor_b229d5663e4df167, []int{23}
 }
 func (m *SetConsKeyResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1031,130 +1309,255 @@ func (m *SetConsKeyResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_SetConsKeyResponse proto.InternalMessageInfo
 
+// MsgUpdateParams is the Msg/UpdateParams request type for the operator parameters.
+type MsgUpdateParams struct {
+	// authority is the address of the governance account.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// params defines the x/operator parameters to update.
+	// NOTE: All parameters must be supplied.
+	Params Params `protobuf:"bytes,2,opt,name=params,proto3" json:"params"`
+}
+
+func (m *MsgUpdateParams) Reset()         { *m = MsgUpdateParams{} }
+func (m *MsgUpdateParams) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateParams) ProtoMessage()    {}
+func (*MsgUpdateParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b229d5663e4df167, []int{24}
+}
+func (m *MsgUpdateParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateParams.Merge(m, src)
+}
+func (m *MsgUpdateParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateParams proto.InternalMessageInfo
+
+func (m *MsgUpdateParams) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgUpdateParams) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
+// MsgUpdateParamsResponse defines the response structure for executing a
+// MsgUpdateParams message.
+type MsgUpdateParamsResponse struct {
+}
+
+func (m *MsgUpdateParamsResponse) Reset()         { *m = MsgUpdateParamsResponse{} }
+func (m *MsgUpdateParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateParamsResponse) ProtoMessage()    {}
+func (*MsgUpdateParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b229d5663e4df167, []int{25}
+}
+func (m *MsgUpdateParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateParamsResponse.Merge(m, src)
+}
+func (m *MsgUpdateParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateParamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterEnum("exocore.operator.v1.SlashType", SlashType_name, SlashType_value)
 	proto.RegisterType((*DecValueField)(nil), "exocore.operator.v1.DecValueField")
 	proto.RegisterType((*OperatorOptedUSDValue)(nil), "exocore.operator.v1.OperatorOptedUSDValue")
+	proto.RegisterType((*OperatorVotingPower)(nil), "exocore.operator.v1.OperatorVotingPower")
+	proto.RegisterType((*VotingPowerSnapshot)(nil), "exocore.operator.v1.VotingPowerSnapshot")
 	proto.RegisterType((*ClientChainEarningAddrList)(nil), "exocore.operator.v1.ClientChainEarningAddrList")
 	proto.RegisterType((*ClientChainEarningAddrInfo)(nil), "exocore.operator.v1.ClientChainEarningAddrInfo")
 	proto.RegisterType((*OperatorInfo)(nil), "exocore.operator.v1.OperatorInfo")
 	proto.RegisterType((*OptedInfo)(nil), "exocore.operator.v1.OptedInfo")
 	proto.RegisterType((*OptedInAssetState)(nil), "exocore.operator.v1.OptedInAssetState")
 	proto.RegisterType((*SlashFromUndelegation)(nil), "exocore.operator.v1.SlashFromUndelegation")
+	proto.RegisterType((*SlashFromRedelegation)(nil), "exocore.operator.v1.SlashFromRedelegation")
 	proto.RegisterType((*SlashFromAssetsPool)(nil), "exocore.operator.v1.SlashFromAssetsPool")
 	proto.RegisterType((*SlashExecutionInfo)(nil), "exocore.operator.v1.SlashExecutionInfo")
 	proto.RegisterType((*OperatorSlashInfo)(nil), "exocore.operator.v1.OperatorSlashInfo")
 	proto.RegisterType((*RegisterOperatorReq)(nil), "exocore.operator.v1.RegisterOperatorReq")
 	proto.RegisterType((*RegisterOperatorResponse)(nil), "exocore.operator.v1.RegisterOperatorResponse")
+	proto.RegisterType((*EditOperatorReq)(nil), "exocore.operator.v1.EditOperatorReq")
+	proto.RegisterType((*EditOperatorResponse)(nil), "exocore.operator.v1.EditOperatorResponse")
 	proto.RegisterType((*OptIntoAVSReq)(nil), "exocore.operator.v1.OptIntoAVSReq")
 	proto.RegisterType((*OptIntoAVSResponse)(nil), "exocore.operator.v1.OptIntoAVSResponse")
 	proto.RegisterType((*OptOutOfAVSReq)(nil), "exocore.operator.v1.OptOutOfAVSReq")
 	proto.RegisterType((*OptOutOfAVSResponse)(nil), "exocore.operator.v1.OptOutOfAVSResponse")
 	proto.RegisterType((*SetConsKeyReq)(nil), "exocore.operator.v1.SetConsKeyReq")
 	proto.RegisterType((*SetConsKeyResponse)(nil), "exocore.operator.v1.SetConsKeyResponse")
+	proto.RegisterType((*MsgUpdateParams)(nil), "exocore.operator.v1.MsgUpdateParams")
+	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "exocore.operator.v1.MsgUpdateParamsResponse")
 }
 
 func init() { proto.RegisterFile("exocore/operator/v1/tx.proto", fileDescriptor_b229d5663e4df167) }
 
 var fileDescriptor_b229d5663e4df167 = []byte{
-	// 1545 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x58, 0xcb, 0x4f, 0x1b, 0xd7,
-	0x1a, 0x67, 0x6c, 0x5e, 0xfe, 0x6c, 0x63, 0x33, 0xe4, 0xe1, 0xf8, 0xe6, 0xe2, 0x30, 0xb9, 0x21,
-	0x84, 0x7b, 0xb1, 0x05, 0xb9, 0xb9, 0x52, 0x72, 0xef, 0xe2, 0x1a, 0x0c, 0x8a, 0x1b, 0xb0, 0xd1,
-	0x0c, 0x44, 0x6a, 0xaa, 0x6a, 0x34, 0x8c, 0x0f, 0x66, 0xc2, 0x78, 0xce, 0x74, 0xce, 0xb1, 0x0b,
-	0x59, 0x55, 0x59, 0x45, 0x55, 0x17, 0x95, 0xb2, 0xaa, 0xda, 0x45, 0x56, 0x55, 0x97, 0x59, 0x64,
-	0x5b, 0xb5, 0xdd, 0x65, 0x19, 0xa5, 0x5d, 0x54, 0x5d, 0xd0, 0x8a, 0x54, 0x4a, 0xff, 0x88, 0x56,
-	0xaa, 0xce, 0x99, 0x33, 0x66, 0x9c, 0xd8, 0x24, 0x28, 0xa4, 0x1b, 0xe0, 0x7c, 0xaf, 0xdf, 0xef,
-	0x7b, 0x9c, 0xef, 0xd8, 0xc0, 0x59, 0xb4, 0x83, 0x4d, 0xec, 0xa1, 0x02, 0x76, 0x91, 0x67, 0x50,
-	0xec, 0x15, 0x5a, 0xb3, 0x05, 0xba, 0x93, 0x77, 0x3d, 0x4c, 0xb1, 0x3c, 0x26, 0xb4, 0xf9, 0x40,
-	0x9b, 0x6f, 0xcd, 0x66, 0x47, 0x8d, 0x86, 0xe5, 0xe0, 0x02, 0xff, 0xe9, 0xdb, 0x65, 0x4f, 0x9b,
-	0x98, 0x34, 0x30, 0x29, 0x34, 0x48, 0x9d, 0xf9, 0x37, 0x48, 0x5d, 0x28, 0xfe, 0x21, 0x14, 0x84,
-	0x1a, 0xdb, 0x96, 0xc3, 0x94, 0x1b, 0x88, 0x1a, 0xb3, 0xc1, 0x59, 0x58, 0x9d, 0xf1, 0xad, 0x74,
-	0x7e, 0x2a, 0xf8, 0x07, 0xa1, 0x3a, 0x51, 0xc7, 0x75, 0xec, 0xcb, 0xd9, 0x5f, 0x42, 0x7a, 0xb6,
-	0x8e, 0x71, 0xdd, 0x46, 0x05, 0xc3, 0xb5, 0x0a, 0x86, 0xe3, 0x60, 0x6a, 0x50, 0x0b, 0x3b, 0xc2,
-	0x47, 0x41, 0x90, 0x2c, 0x21, 0xf3, 0xa6, 0x61, 0x37, 0xd1, 0x92, 0x85, 0xec, 0x9a, 0xbc, 0x06,
-	0x83, 0x46, 0x03, 0x37, 0x1d, 0x9a, 0x91, 0xce, 0x49, 0x53, 0xb1, 0xf9, 0xff, 0x3d, 0xde, 0xcb,
-	0xf5, 0xfd, 0xb4, 0x97, 0x9b, 0xac, 0x5b, 0x74, 0xab, 0xb9, 0x91, 0x37, 0x71, 0x43, 0xa0, 0x8a,
-	0x5f, 0x33, 0xa4, 0xb6, 0x5d, 0xa0, 0xbb, 0x2e, 0x22, 0xf9, 0x12, 0x32, 0x9f, 0x3e, 0x9a, 0x01,
-	0x41, 0xaa, 0x84, 0x4c, 0x55, 0xc4, 0x52, 0x7e, 0x8f, 0xc0, 0xc9, 0xaa, 0xa8, 0x4b, 0xd5, 0xa5,
-	0xa8, 0xb6, 0xae, 0x95, 0x38, 0xa8, 0xec, 0xc1, 0x08, 0x41, 0xf6, 0xa6, 0xde, 0x24, 0x35, 0xbd,
-	0xc5, 0x24, 0x02, 0x77, 0xf9, 0x68, 0xb8, 0xfb, 0x7b, 0xb9, 0x84, 0x86, 0xec, 0xcd, 0x20, 0xee,
-	0x0b, 0x3c, 0x12, 0x0c, 0x63, 0x9d, 0xd4, 0x7c, 0xcc, 0x26, 0xa4, 0x28, 0xa6, 0x86, 0x1d, 0x02,
-	0x8d, 0x70, 0xd0, 0x95, 0x23, 0x83, 0x26, 0xd7, 0x58, 0xa0, 0x1e, 0xa8, 0x49, 0x8e, 0xd2, 0x86,
-	0xdd, 0x81, 0xb4, 0x61, 0x52, 0xab, 0x85, 0x42, 0xb8, 0x51, 0x8e, 0x5b, 0x39, 0x32, 0xee, 0x48,
-	0x91, 0x47, 0xea, 0x01, 0x3c, 0xe2, 0xe3, 0x04, 0xc8, 0xca, 0x2e, 0x64, 0x17, 0x6c, 0x0b, 0x39,
-	0x74, 0x61, 0xcb, 0xb0, 0x9c, 0x45, 0xc3, 0x73, 0x2c, 0xa7, 0x5e, 0xac, 0xd5, 0xbc, 0x65, 0x8b,
-	0x50, 0xf9, 0x3d, 0x18, 0x45, 0xbe, 0x48, 0xb7, 0x9c, 0x4d, 0xac, 0xdb, 0x16, 0x61, 0xdd, 0x8f,
-	0x4e, 0xc5, 0xe7, 0x0a, 0xf9, 0x2e, 0x53, 0x9d, 0xef, 0x1e, 0xab, 0xec, 0x6c, 0x62, 0x35, 0x25,
-	0x22, 0xb1, 0x03, 0x0b, 0xae, 0x7c, 0x26, 0xf5, 0xc2, 0x66, 0x26, 0xf2, 0xff, 0x41, 0xb6, 0xef,
-	0xe8, 0x26, 0x37, 0xd0, 0x4d, 0x66, 0xa1, 0x5b, 0x35, 0x3e, 0x02, 0xfd, 0xf3, 0x63, 0xfb, 0x7b,
-	0xb9, 0xd4, 0xf2, 0x9d, 0x90, 0x77, 0xb9, 0xa4, 0xa6, 0xec, 0x0e, 0x41, 0x4d, 0xbe, 0x0a, 0x67,
-	0x3a, 0xdc, 0x83, 0x54, 0x8c, 0x5a, 0xcd, 0xf3, 0xdb, 0xaa, 0x9e, 0x32, 0xbb, 0x12, 0x50, 0xbe,
-	0x8b, 0x40, 0x22, 0x98, 0x4a, 0xce, 0xe6, 0x3c, 0x24, 0x85, 0x3b, 0xf1, 0xfd, 0xf9, 0x2c, 0xaa,
-	0x89, 0x40, 0xc8, 0xbc, 0xe4, 0x09, 0x48, 0x18, 0xae, 0xeb, 0xe1, 0x16, 0x0a, 0x63, 0xc4, 0x85,
-	0x8c, 0x9b, 0xfc, 0x0b, 0xe4, 0xa0, 0x5e, 0x7a, 0x03, 0x51, 0x83, 0xd7, 0xd5, 0xef, 0xb5, 0x9a,
-	0x0e, 0x34, 0x2b, 0x88, 0x1a, 0x1c, 0xd5, 0x86, 0x6c, 0xb7, 0x0c, 0x04, 0x85, 0xfe, 0x73, 0xd2,
-	0x11, 0x1b, 0xc1, 0xea, 0xae, 0x9e, 0x7e, 0x39, 0x67, 0x9f, 0xfe, 0x0a, 0x80, 0x89, 0x1b, 0x0d,
-	0x8b, 0x10, 0x0b, 0x3b, 0x99, 0x01, 0x1e, 0x5d, 0xc9, 0x8b, 0xe1, 0x09, 0x76, 0x8d, 0xd8, 0x3d,
-	0xf9, 0x85, 0xb6, 0xe5, 0x7c, 0x8c, 0xcd, 0xe8, 0x57, 0xcf, 0x1f, 0x4e, 0x4b, 0x6a, 0x28, 0x80,
-	0xf2, 0x85, 0x04, 0x31, 0x7e, 0xa3, 0x79, 0x2a, 0x17, 0x60, 0x84, 0xd8, 0x06, 0xd9, 0xd2, 0x4d,
-	0xec, 0x50, 0xcf, 0x30, 0xc5, 0x16, 0x51, 0x93, 0x5c, 0xba, 0x20, 0x84, 0xf2, 0x24, 0xa4, 0x30,
-	0xf3, 0xd1, 0x2d, 0x47, 0xdf, 0x42, 0x56, 0x7d, 0x8b, 0xf2, 0x2a, 0xf6, 0xab, 0x49, 0xec, 0x87,
-	0xba, 0xce, 0x85, 0xf2, 0x14, 0xa4, 0x7d, 0x3b, 0xdc, 0xa4, 0x81, 0x61, 0x94, 0x1b, 0x8e, 0x70,
-	0x79, 0xb5, 0x49, 0x85, 0xe5, 0x29, 0x18, 0xbc, 0x6d, 0x58, 0x36, 0xaa, 0xf1, 0x7a, 0x0d, 0xab,
-	0xe2, 0xa4, 0x7c, 0x2d, 0xc1, 0xa8, 0xa0, 0x57, 0x24, 0x04, 0x51, 0x8d, 0x1a, 0x14, 0xbd, 0xd1,
-	0x92, 0x2b, 0x3b, 0x34, 0x74, 0xdb, 0xca, 0x0e, 0x0d, 0x96, 0x9c, 0xac, 0xc2, 0x40, 0x78, 0x99,
-	0xbc, 0xd9, 0xe6, 0xf4, 0x43, 0x29, 0xdf, 0x4a, 0x70, 0x52, 0x63, 0xb5, 0x5b, 0xf2, 0x70, 0x63,
-	0xdd, 0xa9, 0x21, 0x1b, 0xd5, 0xf9, 0x02, 0x97, 0x2f, 0x41, 0x8c, 0x75, 0x0b, 0x79, 0xc1, 0x85,
-	0x89, 0xcd, 0x27, 0xf6, 0xf7, 0x72, 0xc3, 0x1a, 0x17, 0x96, 0x4b, 0xea, 0xb0, 0xaf, 0x2e, 0xd7,
-	0xe4, 0x49, 0x18, 0x36, 0x58, 0xf2, 0xcc, 0xd2, 0xe7, 0x16, 0xdf, 0xdf, 0xcb, 0x0d, 0xf1, 0x82,
-	0x94, 0x4b, 0xea, 0x10, 0x57, 0x96, 0xc3, 0xbb, 0x3f, 0x7a, 0x7c, 0x65, 0x51, 0xee, 0x4b, 0x30,
-	0xd6, 0x4e, 0x81, 0x63, 0x92, 0x55, 0x8c, 0xed, 0x0e, 0x56, 0xd2, 0x6b, 0xb1, 0x8a, 0x1c, 0x23,
-	0xab, 0xcf, 0xa3, 0x20, 0x73, 0x56, 0x8b, 0x3b, 0xc8, 0x6c, 0xb2, 0x8a, 0xf2, 0x01, 0xae, 0x43,
-	0xda, 0x1f, 0x60, 0xd7, 0xc3, 0x2e, 0xf6, 0x98, 0xfc, 0x58, 0x1e, 0xc2, 0x14, 0x8f, 0xba, 0xda,
-	0x0e, 0x2a, 0xbf, 0x0f, 0x71, 0x1f, 0xe8, 0xf8, 0x46, 0x06, 0x78, 0x40, 0xff, 0xad, 0x31, 0x60,
-	0xcc, 0x0f, 0xdf, 0x0c, 0xcd, 0x0c, 0xc9, 0x44, 0xf9, 0x56, 0x9f, 0xee, 0xba, 0x4c, 0xba, 0x8e,
-	0xd9, 0x7c, 0x3f, 0xa3, 0xa4, 0xca, 0x3c, 0x58, 0x58, 0x41, 0xe4, 0x5b, 0x30, 0xea, 0x43, 0xf0,
-	0x46, 0x11, 0xdd, 0xc5, 0xd8, 0xce, 0xf4, 0x73, 0x80, 0xa9, 0xc3, 0x01, 0x0e, 0x86, 0x40, 0x84,
-	0xf7, 0xab, 0x73, 0x20, 0x56, 0xfe, 0x88, 0xb0, 0x6b, 0xeb, 0xbb, 0x72, 0xb7, 0xa3, 0x6c, 0x97,
-	0x4b, 0x90, 0x26, 0xcd, 0x8d, 0x86, 0x45, 0xd9, 0xe6, 0x08, 0xad, 0x97, 0xa8, 0x9a, 0x6a, 0xcb,
-	0xc5, 0xda, 0x98, 0x80, 0x04, 0x6a, 0xb1, 0xcd, 0x1b, 0x5a, 0x2e, 0x51, 0x35, 0xce, 0x65, 0xc2,
-	0xe4, 0x6f, 0x10, 0xb3, 0x88, 0xde, 0x42, 0x14, 0xb7, 0x97, 0xcb, 0xb0, 0x45, 0x6e, 0xf2, 0x73,
-	0xd7, 0x71, 0x19, 0x78, 0x1b, 0xe3, 0xf2, 0x77, 0xf0, 0xbb, 0xab, 0x33, 0x8f, 0xcc, 0xe0, 0x39,
-	0x69, 0x2a, 0xa9, 0xc6, 0xb8, 0x64, 0x6d, 0xd7, 0x45, 0x72, 0x05, 0x46, 0x50, 0x30, 0xc7, 0xfe,
-	0x63, 0x33, 0xc4, 0x17, 0xfb, 0xc5, 0xde, 0x8d, 0xe8, 0x98, 0x7b, 0x35, 0x89, 0xc2, 0x47, 0xe5,
-	0x1b, 0x09, 0xc6, 0x54, 0x54, 0xb7, 0x08, 0x45, 0x5e, 0xd0, 0x07, 0x15, 0x7d, 0x20, 0xff, 0x17,
-	0x12, 0x9b, 0x1e, 0x6e, 0xf0, 0x97, 0x09, 0x11, 0x22, 0xae, 0x46, 0xe6, 0xe9, 0xa3, 0x99, 0x13,
-	0x82, 0x7d, 0xd1, 0xd7, 0x68, 0xd4, 0xb3, 0x9c, 0xba, 0x1a, 0x67, 0xd6, 0x42, 0x24, 0x5f, 0x81,
-	0x7e, 0x4e, 0x2d, 0xc2, 0xa9, 0x4d, 0x74, 0xa5, 0x16, 0x7e, 0x8e, 0x55, 0x6e, 0x7e, 0xed, 0xdf,
-	0xf7, 0x1e, 0xe4, 0xfa, 0x7e, 0x7b, 0x90, 0xeb, 0xbb, 0xfb, 0xfc, 0xe1, 0x74, 0x7c, 0xe9, 0x20,
-	0xe0, 0xc7, 0xcf, 0x1f, 0x4e, 0x9f, 0x0e, 0x15, 0x33, 0xec, 0xab, 0x64, 0x21, 0xf3, 0x72, 0x02,
-	0xc4, 0xc5, 0x0e, 0x41, 0xca, 0xcf, 0x12, 0x24, 0xab, 0x2e, 0x2d, 0x3b, 0x14, 0x17, 0x6f, 0x6a,
-	0x6f, 0x9c, 0x57, 0x0e, 0xe2, 0x46, 0x8b, 0xb4, 0x7d, 0xfd, 0xcf, 0x03, 0x60, 0xb4, 0x48, 0x60,
-	0x70, 0x15, 0x52, 0x6e, 0x73, 0xc3, 0xb6, 0x4c, 0x7d, 0x1b, 0xed, 0xea, 0xb7, 0x09, 0x76, 0xc4,
-	0x82, 0x1d, 0x65, 0x9f, 0x20, 0x57, 0xb9, 0xea, 0x06, 0xda, 0x7d, 0x47, 0xab, 0x56, 0xd4, 0xa4,
-	0xdb, 0x3e, 0x12, 0xec, 0x5c, 0xbb, 0x72, 0x58, 0xf2, 0x99, 0x8e, 0xe4, 0x43, 0xf9, 0x28, 0x27,
-	0x40, 0x0e, 0x0b, 0x44, 0xde, 0x5f, 0x4a, 0x30, 0x52, 0x75, 0x69, 0xb5, 0x49, 0xab, 0x9b, 0x7f,
-	0x45, 0xe2, 0xd7, 0xfe, 0x73, 0x18, 0xfb, 0x33, 0x9d, 0xec, 0x43, 0xac, 0x94, 0x93, 0x30, 0xd6,
-	0x21, 0x11, 0xfc, 0x9f, 0x4a, 0x90, 0xd4, 0x10, 0x5d, 0xc0, 0x0e, 0xb9, 0x81, 0x76, 0x19, 0xfd,
-	0x39, 0x18, 0x7a, 0x5d, 0xe6, 0x81, 0xe1, 0x5b, 0x6d, 0xd7, 0x6c, 0x38, 0xe1, 0x00, 0xf1, 0xc5,
-	0x56, 0x75, 0xa4, 0xc0, 0x5a, 0x15, 0x16, 0xf8, 0xa9, 0x4e, 0xdb, 0x10, 0xd3, 0xda, 0xb7, 0x3b,
-	0x0b, 0xa7, 0xb4, 0xe5, 0xa2, 0x76, 0x5d, 0x5f, 0x7b, 0x77, 0x75, 0x51, 0x5f, 0xaf, 0x68, 0xab,
-	0x8b, 0x0b, 0xe5, 0xa5, 0xf2, 0x62, 0x29, 0xdd, 0x27, 0x9f, 0x85, 0x4c, 0x48, 0x57, 0xae, 0x68,
-	0x6b, 0xc5, 0xca, 0x9a, 0xce, 0x45, 0x69, 0x49, 0xbe, 0x00, 0x13, 0x21, 0x6d, 0xa5, 0x1a, 0x18,
-	0x14, 0x2b, 0x8b, 0xd5, 0x75, 0x4d, 0x98, 0x45, 0xe6, 0x7e, 0xe8, 0x87, 0xe8, 0x0a, 0xa9, 0xcb,
-	0x0f, 0x24, 0x48, 0xbf, 0x78, 0x6b, 0xe4, 0xee, 0xcb, 0xbc, 0xcb, 0x76, 0xc8, 0xce, 0xbc, 0xa6,
-	0xa5, 0x68, 0xe7, 0xe5, 0xbb, 0xdf, 0xff, 0x7a, 0x3f, 0x32, 0xa3, 0xfc, 0xb3, 0xd0, 0xfd, 0x8b,
-	0x75, 0xa1, 0xdb, 0x06, 0xba, 0x27, 0x01, 0x1c, 0xd4, 0x4b, 0x56, 0xba, 0x2f, 0xb8, 0x70, 0x85,
-	0xb3, 0x17, 0x5f, 0x69, 0x23, 0x08, 0xcd, 0x70, 0x42, 0x17, 0x95, 0x0b, 0xbd, 0x08, 0x75, 0x0e,
-	0x1f, 0xa3, 0x72, 0x70, 0xcb, 0x7a, 0x50, 0xe9, 0xb8, 0x97, 0x3d, 0xa8, 0x74, 0xb9, 0xaa, 0xaf,
-	0xa4, 0xd2, 0xb9, 0xbf, 0x3e, 0x91, 0x20, 0x1e, 0xba, 0x31, 0xf2, 0xf9, 0x5e, 0x38, 0xa1, 0x5b,
-	0x96, 0x9d, 0x7a, 0xb5, 0x91, 0x60, 0x93, 0xe7, 0x6c, 0xa6, 0x94, 0xc9, 0x43, 0xd8, 0x84, 0x23,
-	0x0f, 0x7c, 0xc4, 0xbe, 0x27, 0xcc, 0x2f, 0x3f, 0xde, 0x1f, 0x97, 0x9e, 0xec, 0x8f, 0x4b, 0xbf,
-	0xec, 0x8f, 0x4b, 0x9f, 0x3e, 0x1b, 0xef, 0x7b, 0xf2, 0x6c, 0xbc, 0xef, 0xc7, 0x67, 0xe3, 0x7d,
-	0xb7, 0xe6, 0x42, 0xaf, 0xe2, 0xa2, 0x1f, 0xb2, 0x82, 0xe8, 0x87, 0xd8, 0xdb, 0x6e, 0x23, 0xec,
-	0x1c, 0x60, 0xf0, 0x57, 0x72, 0x63, 0x90, 0xff, 0xc7, 0xe2, 0xf2, 0x9f, 0x01, 0x00, 0x00, 0xff,
-	0xff, 0x0c, 0x47, 0x83, 0x3c, 0x87, 0x11, 0x00, 0x00,
+	// 1927 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x58, 0xcd, 0x6f, 0x1b, 0xc7,
+	0x15, 0xd7, 0x92, 0xb2, 0x2c, 0x3d, 0x92, 0x22, 0x35, 0x92, 0x65, 0x99, 0x71, 0x25, 0x79, 0x1d,
+	0xdb, 0x92, 0x1a, 0x91, 0xb0, 0xd3, 0xa4, 0xb5, 0xda, 0x43, 0xf5, 0x65, 0x84, 0x8d, 0x44, 0x0a,
+	0x4b, 0xc9, 0x40, 0x53, 0x14, 0x8b, 0x15, 0x77, 0x44, 0xad, 0xbd, 0xdc, 0xd9, 0xee, 0x0c, 0x19,
+	0x29, 0xa7, 0x22, 0x87, 0x22, 0x28, 0x7a, 0x08, 0x90, 0x5e, 0x8a, 0xf6, 0xe0, 0x53, 0xd1, 0xde,
+	0x7c, 0x48, 0x8f, 0x45, 0xdb, 0x5b, 0x80, 0x5e, 0x02, 0xf7, 0x52, 0xf4, 0xa0, 0x16, 0x72, 0x01,
+	0xf7, 0x8f, 0x68, 0x8b, 0x62, 0x66, 0x67, 0xc9, 0x21, 0xbd, 0xd4, 0x47, 0x2c, 0xf7, 0x62, 0x6b,
+	0xde, 0xbc, 0x79, 0xbf, 0xdf, 0xfb, 0x98, 0xf7, 0x86, 0x0b, 0xd7, 0xf1, 0x01, 0xa9, 0x91, 0x00,
+	0x17, 0x89, 0x8f, 0x03, 0x8b, 0x91, 0xa0, 0xd8, 0xba, 0x5b, 0x64, 0x07, 0x05, 0x3f, 0x20, 0x8c,
+	0xa0, 0x71, 0xb9, 0x5b, 0x88, 0x76, 0x0b, 0xad, 0xbb, 0xf9, 0x31, 0xab, 0xe1, 0x78, 0xa4, 0x28,
+	0xfe, 0x0d, 0xf5, 0xf2, 0x57, 0x6b, 0x84, 0x36, 0x08, 0x2d, 0x36, 0x68, 0x9d, 0x9f, 0x6f, 0xd0,
+	0xba, 0xdc, 0x78, 0x53, 0x6e, 0x50, 0x66, 0x3d, 0x76, 0x3c, 0xbe, 0xb9, 0x8b, 0x99, 0x75, 0x37,
+	0x5a, 0x4b, 0xad, 0x6b, 0xa1, 0x96, 0x29, 0x56, 0xc5, 0x70, 0x21, 0xb7, 0x66, 0xe3, 0xf8, 0xf9,
+	0x56, 0x60, 0x35, 0x22, 0x8d, 0x89, 0x3a, 0xa9, 0x93, 0xf0, 0x24, 0xff, 0x4b, 0x4a, 0xaf, 0xd7,
+	0x09, 0xa9, 0xbb, 0xb8, 0x68, 0xf9, 0x4e, 0xd1, 0xf2, 0x3c, 0xc2, 0x2c, 0xe6, 0x10, 0x4f, 0x9e,
+	0xd1, 0x31, 0x64, 0xd6, 0x70, 0xed, 0xa1, 0xe5, 0x36, 0xf1, 0x03, 0x07, 0xbb, 0x36, 0xda, 0x86,
+	0x21, 0xab, 0x41, 0x9a, 0x1e, 0x9b, 0xd2, 0x66, 0xb5, 0xb9, 0x91, 0x95, 0xef, 0x7c, 0x71, 0x34,
+	0x33, 0xf0, 0xb7, 0xa3, 0x99, 0xdb, 0x75, 0x87, 0xed, 0x37, 0x77, 0x0b, 0x35, 0xd2, 0x90, 0xbc,
+	0xe4, 0x7f, 0x8b, 0xd4, 0x7e, 0x5c, 0x64, 0x87, 0x3e, 0xa6, 0x85, 0x35, 0x5c, 0x7b, 0xf6, 0xf9,
+	0x22, 0x48, 0xda, 0x6b, 0xb8, 0x66, 0x48, 0x5b, 0xfa, 0xbf, 0x13, 0x70, 0xa5, 0x22, 0x79, 0x57,
+	0x7c, 0x86, 0xed, 0x9d, 0xea, 0x9a, 0x00, 0x45, 0x01, 0x8c, 0x52, 0xec, 0xee, 0x99, 0x4d, 0x6a,
+	0x9b, 0x2d, 0x2e, 0x91, 0xb8, 0x1b, 0xe7, 0xc3, 0x3d, 0x3e, 0x9a, 0x49, 0x57, 0xb1, 0xbb, 0x17,
+	0xd9, 0xed, 0xe1, 0x91, 0xe6, 0x18, 0x3b, 0xd4, 0x0e, 0x31, 0x9b, 0x90, 0x65, 0x84, 0x59, 0xae,
+	0x02, 0x9a, 0x10, 0xa0, 0x9b, 0xe7, 0x06, 0xcd, 0x6c, 0x73, 0x43, 0x7d, 0x50, 0x33, 0x02, 0xa5,
+	0x0d, 0x7b, 0x00, 0x39, 0xab, 0xc6, 0x9c, 0x16, 0x56, 0x70, 0x93, 0x02, 0xb7, 0x7c, 0x6e, 0xdc,
+	0xd1, 0x65, 0x61, 0xa9, 0x0f, 0xf0, 0x68, 0x88, 0x13, 0x21, 0xeb, 0xbf, 0xd4, 0x60, 0x3c, 0x0a,
+	0xff, 0x43, 0xc2, 0x1c, 0xaf, 0xbe, 0x45, 0x3e, 0xc4, 0x01, 0xba, 0x09, 0x99, 0xa8, 0x9a, 0x4c,
+	0xcb, 0xb6, 0x83, 0x30, 0xf6, 0x46, 0x3a, 0x12, 0x2e, 0xdb, 0x76, 0x80, 0x4c, 0x48, 0xb7, 0xc4,
+	0x19, 0xd3, 0xe7, 0x87, 0x64, 0xa8, 0x5e, 0xad, 0x2e, 0x52, 0xad, 0x0e, 0x0b, 0xfd, 0x77, 0x09,
+	0x18, 0x57, 0x58, 0x55, 0x3d, 0xcb, 0xa7, 0xfb, 0x84, 0xa1, 0x79, 0xc8, 0x61, 0x9f, 0xd4, 0xf6,
+	0x4d, 0xc7, 0xc6, 0x1e, 0x73, 0xf6, 0x1c, 0x1c, 0x11, 0xcc, 0x0a, 0x79, 0xa9, 0x2d, 0x46, 0x37,
+	0x20, 0x1d, 0xaa, 0x7a, 0xcd, 0xc6, 0xae, 0xe4, 0x98, 0x34, 0x52, 0x42, 0x56, 0x16, 0x22, 0xf4,
+	0x08, 0x50, 0x98, 0xf4, 0x2e, 0x67, 0x92, 0x17, 0xe0, 0x4c, 0x4e, 0xd8, 0x55, 0xe3, 0x6a, 0xc3,
+	0x64, 0x3b, 0xae, 0x2a, 0x1c, 0x9d, 0x1a, 0x9c, 0x4d, 0xce, 0xa5, 0xee, 0xcd, 0x15, 0x62, 0xda,
+	0x49, 0x21, 0x26, 0x43, 0x2b, 0x83, 0x9c, 0x99, 0x31, 0x41, 0x5e, 0xde, 0xa2, 0xfa, 0x21, 0xe4,
+	0x57, 0x5d, 0x07, 0x7b, 0x6c, 0x75, 0xdf, 0x72, 0xbc, 0x75, 0x2b, 0xf0, 0x1c, 0xaf, 0xce, 0x53,
+	0xb6, 0xe1, 0x50, 0x86, 0x7e, 0x00, 0x63, 0x38, 0x14, 0x99, 0x8e, 0xb7, 0x47, 0x4c, 0xd7, 0xa1,
+	0xfc, 0x4e, 0x73, 0xf8, 0x62, 0x2c, 0x7c, 0xbc, 0xad, 0x92, 0xb7, 0x47, 0x8c, 0xac, 0xb4, 0xc4,
+	0x17, 0xdc, 0xb8, 0xfe, 0x0b, 0xad, 0x1f, 0x36, 0x57, 0x41, 0xdf, 0x05, 0xe4, 0x7e, 0x64, 0xd6,
+	0x84, 0x82, 0x59, 0xe3, 0x1a, 0xa6, 0x63, 0x8b, 0xdc, 0x0d, 0xae, 0x8c, 0x1f, 0x1f, 0xcd, 0x64,
+	0x37, 0x3e, 0x52, 0x4e, 0x97, 0xd6, 0x8c, 0xac, 0xdb, 0x25, 0xb0, 0xd1, 0x7d, 0xb8, 0xd6, 0x75,
+	0x3c, 0x72, 0x45, 0x54, 0xa9, 0xa8, 0x40, 0x63, 0xb2, 0x16, 0x4b, 0x40, 0xff, 0x53, 0x02, 0xd2,
+	0x51, 0x28, 0x05, 0x9b, 0x9b, 0x90, 0x91, 0xc7, 0x69, 0x57, 0x95, 0x47, 0x42, 0x51, 0xe5, 0x37,
+	0x20, 0x6d, 0xf9, 0x7e, 0x40, 0x5a, 0x58, 0xc5, 0x48, 0x49, 0x99, 0x50, 0x79, 0x0b, 0x50, 0x3b,
+	0xab, 0x0d, 0xcc, 0x2c, 0x11, 0xd7, 0xb0, 0x82, 0x8c, 0x5c, 0xb4, 0xb3, 0x89, 0x99, 0x25, 0x50,
+	0x5d, 0xc8, 0xc7, 0x79, 0x20, 0x29, 0x0c, 0xce, 0x6a, 0xe7, 0x4c, 0x04, 0x8f, 0xbb, 0x71, 0xf5,
+	0x65, 0x9f, 0x43, 0xfa, 0x9b, 0x00, 0x35, 0xd2, 0x68, 0x38, 0x94, 0x3a, 0xc4, 0x9b, 0xba, 0x24,
+	0xac, 0xeb, 0x05, 0x59, 0xa4, 0xd1, 0x8c, 0x91, 0x33, 0xa7, 0xb0, 0xda, 0xd6, 0x5c, 0x19, 0xe1,
+	0xf5, 0xf5, 0x9b, 0x17, 0x4f, 0x17, 0x34, 0x43, 0x31, 0xa0, 0xff, 0x4a, 0x83, 0x11, 0xd1, 0xa7,
+	0x85, 0x2b, 0xb7, 0x60, 0x94, 0xba, 0x16, 0xdd, 0x37, 0x6b, 0xc4, 0x63, 0x81, 0x55, 0x93, 0xb3,
+	0xc1, 0xc8, 0x08, 0xe9, 0xaa, 0x14, 0xa2, 0xdb, 0x90, 0x25, 0xfc, 0x8c, 0xe9, 0x78, 0xe6, 0x3e,
+	0x76, 0xea, 0xfb, 0x4c, 0x44, 0x71, 0xd0, 0xc8, 0x90, 0xd0, 0xd4, 0x7b, 0x42, 0x88, 0xe6, 0x20,
+	0x17, 0xea, 0x91, 0x26, 0x8b, 0x14, 0x93, 0x42, 0x71, 0x54, 0xc8, 0x2b, 0x4d, 0x26, 0x35, 0x27,
+	0x61, 0xe8, 0x91, 0xe5, 0xb8, 0xd8, 0x16, 0xf1, 0x1a, 0x36, 0xe4, 0x4a, 0xff, 0xbd, 0x06, 0x63,
+	0x92, 0xde, 0x32, 0xa5, 0x98, 0x55, 0x99, 0xc5, 0xf0, 0x2b, 0x8d, 0xae, 0x92, 0xc7, 0x94, 0x5b,
+	0x5d, 0xf2, 0x58, 0x34, 0xba, 0x90, 0x01, 0x97, 0xd4, 0x11, 0xf1, 0x6a, 0xad, 0x22, 0x34, 0xa5,
+	0xff, 0x51, 0x83, 0x2b, 0x55, 0x1e, 0xbb, 0x07, 0x01, 0x69, 0xec, 0x78, 0x36, 0x76, 0x71, 0x5d,
+	0x8c, 0x65, 0x34, 0x0f, 0x23, 0x3c, 0x5b, 0x38, 0x88, 0x2e, 0xcc, 0xc8, 0x4a, 0xfa, 0xf8, 0x68,
+	0x66, 0xb8, 0x2a, 0x84, 0xa5, 0x35, 0x63, 0x38, 0xdc, 0x2e, 0xd9, 0xe8, 0x36, 0x0c, 0x5b, 0xdc,
+	0x79, 0xae, 0x19, 0x72, 0x4b, 0x1d, 0x1f, 0xcd, 0x5c, 0x16, 0x01, 0x29, 0xad, 0x19, 0x97, 0xc5,
+	0x66, 0x49, 0x9d, 0xe8, 0xc9, 0x8b, 0x0b, 0x8b, 0xfe, 0x93, 0x84, 0xe2, 0x82, 0x81, 0x5f, 0xaf,
+	0x0b, 0x6b, 0x30, 0x66, 0x53, 0x66, 0x76, 0xcf, 0xaa, 0xd0, 0x9b, 0xa9, 0x67, 0x9f, 0x2f, 0x4e,
+	0x48, 0x7e, 0xfc, 0x26, 0x60, 0x4a, 0xab, 0x2c, 0x70, 0xbc, 0xba, 0x91, 0xb5, 0x29, 0xab, 0xa8,
+	0x83, 0xac, 0x13, 0x88, 0xc1, 0x0b, 0x0c, 0xc4, 0x67, 0x1a, 0x8c, 0xb7, 0x03, 0x21, 0x98, 0xd3,
+	0x2d, 0x42, 0xdc, 0x2e, 0xdf, 0xb4, 0x33, 0xa5, 0x27, 0x71, 0x81, 0xac, 0xfe, 0x9b, 0x04, 0x24,
+	0x58, 0xad, 0x1f, 0xe0, 0x5a, 0x93, 0xe7, 0x45, 0xdc, 0xe4, 0x3a, 0xe4, 0xc2, 0x9b, 0xec, 0x07,
+	0xc4, 0x27, 0x01, 0x97, 0x5f, 0xc8, 0x3b, 0x2f, 0x2b, 0xac, 0x6e, 0xb5, 0x8d, 0xa2, 0x1f, 0x42,
+	0x2a, 0x04, 0xba, 0xb8, 0xbb, 0x03, 0xc2, 0x60, 0xf8, 0x94, 0xb2, 0x60, 0x3c, 0x34, 0xdf, 0x54,
+	0x2e, 0x0f, 0x9d, 0x4a, 0x8a, 0xf1, 0xb6, 0x10, 0xdb, 0x55, 0x63, 0xef, 0x9b, 0x9c, 0xaf, 0x48,
+	0x18, 0x53, 0x37, 0x28, 0xfa, 0x00, 0xc6, 0x42, 0x08, 0x91, 0x28, 0x6a, 0xfa, 0x84, 0xb8, 0x27,
+	0x8e, 0xef, 0x98, 0x22, 0x90, 0xe6, 0xc3, 0xe8, 0x28, 0xb5, 0xd1, 0xa6, 0x1f, 0x60, 0x95, 0xfe,
+	0xa5, 0xb3, 0xd0, 0x57, 0xef, 0x5a, 0x17, 0x7d, 0x75, 0x83, 0xea, 0xff, 0x49, 0xf0, 0x16, 0x19,
+	0x9e, 0x17, 0x67, 0xcf, 0xd3, 0xc9, 0xe7, 0x21, 0x47, 0x9b, 0xbb, 0x0d, 0x87, 0xf1, 0x2e, 0xad,
+	0xb4, 0xf2, 0xa4, 0x91, 0x6d, 0xcb, 0x65, 0x8b, 0xe6, 0x2f, 0xaf, 0x16, 0x9f, 0x72, 0x4a, 0x23,
+	0xe7, 0x2f, 0x2f, 0x2e, 0x93, 0x2a, 0x6f, 0xc0, 0x88, 0x43, 0xcd, 0x16, 0x66, 0xa4, 0xdd, 0xc8,
+	0x87, 0x1d, 0xfa, 0x50, 0xac, 0x63, 0x2b, 0xf2, 0xd2, 0xeb, 0xa8, 0xc8, 0xaf, 0x41, 0x58, 0x40,
+	0x26, 0x3f, 0x31, 0x35, 0x34, 0xab, 0xcd, 0x65, 0x8c, 0x11, 0x21, 0xd9, 0x3e, 0xf4, 0x31, 0x2a,
+	0xc3, 0x28, 0x8e, 0xae, 0x4a, 0x38, 0xd8, 0x2f, 0x8b, 0x21, 0x7a, 0xa7, 0x7f, 0x36, 0xba, 0xae,
+	0x96, 0x91, 0xc1, 0xea, 0x52, 0xff, 0x83, 0x06, 0xe3, 0x06, 0xae, 0x3b, 0x94, 0xe1, 0x20, 0xca,
+	0x83, 0x81, 0x7f, 0x84, 0xbe, 0x0d, 0xe9, 0xbd, 0x80, 0x34, 0x44, 0x0b, 0xc3, 0x94, 0xca, 0xdb,
+	0xd7, 0xbf, 0x8b, 0xa5, 0xb8, 0xb6, 0x14, 0xa1, 0x77, 0x60, 0x50, 0x50, 0x4b, 0x08, 0x6a, 0x37,
+	0x4e, 0x7c, 0x45, 0x0a, 0x52, 0x42, 0x7d, 0xe9, 0x1b, 0x9f, 0x3c, 0x99, 0x19, 0xf8, 0xd7, 0x93,
+	0x99, 0x81, 0x8f, 0x5f, 0x3c, 0x5d, 0x48, 0x3d, 0xe8, 0x18, 0xfc, 0xe9, 0x8b, 0xa7, 0x0b, 0x57,
+	0x95, 0x60, 0xaa, 0x67, 0xf5, 0x3c, 0x4c, 0xbd, 0xec, 0x00, 0xf5, 0x89, 0x47, 0xb1, 0xfe, 0xdb,
+	0x24, 0x64, 0xd7, 0x6d, 0x87, 0x5d, 0x98, 0x67, 0xf1, 0x6f, 0xab, 0x44, 0x9f, 0xb7, 0x55, 0xef,
+	0x63, 0x2d, 0xf9, 0xf2, 0x63, 0xed, 0xb4, 0xe7, 0xd7, 0x57, 0x7a, 0x07, 0xf7, 0x7d, 0x7e, 0x59,
+	0x90, 0xed, 0xbc, 0x9e, 0xcc, 0xc0, 0x62, 0x58, 0x16, 0xf1, 0xb7, 0xbe, 0x72, 0x01, 0x8f, 0x76,
+	0x0c, 0x1a, 0x16, 0xc3, 0x4b, 0xdf, 0x3c, 0x29, 0x89, 0x79, 0xc5, 0x60, 0x4f, 0x5e, 0xf4, 0x49,
+	0x98, 0xe8, 0x16, 0xc9, 0x1c, 0xfe, 0x5d, 0x83, 0x4c, 0xc5, 0x67, 0x25, 0x8f, 0x91, 0xe5, 0x87,
+	0xd5, 0x57, 0xce, 0xe0, 0x0c, 0xa4, 0xac, 0x16, 0x6d, 0x9f, 0x0d, 0x53, 0x07, 0x56, 0x8b, 0x46,
+	0x0a, 0xf7, 0x21, 0xeb, 0x37, 0x77, 0x5d, 0xa7, 0x66, 0x3e, 0xc6, 0x87, 0xe6, 0x23, 0x4a, 0x3c,
+	0x39, 0xc2, 0xc7, 0xf8, 0xef, 0xe8, 0x2d, 0xb1, 0xf5, 0x3e, 0x3e, 0xfc, 0x5e, 0xb5, 0x52, 0x36,
+	0x32, 0x7e, 0x7b, 0x49, 0x89, 0xb7, 0xf4, 0xce, 0x49, 0xbe, 0x4f, 0x75, 0x15, 0xb0, 0xe2, 0x8f,
+	0x3e, 0x01, 0x48, 0x15, 0x48, 0xbf, 0x7f, 0xad, 0xc1, 0x68, 0xc5, 0x67, 0x95, 0x26, 0xab, 0xec,
+	0xfd, 0x3f, 0x1c, 0x5f, 0x7a, 0xf7, 0x24, 0xf6, 0xd7, 0xba, 0xd9, 0x2b, 0xac, 0xf4, 0x2b, 0xfc,
+	0x47, 0xbb, 0x22, 0x91, 0xfc, 0x9f, 0x69, 0x90, 0xa9, 0x62, 0xb6, 0x4a, 0x3c, 0xfa, 0x3e, 0x3e,
+	0xe4, 0xf4, 0xef, 0xc1, 0xe5, 0xb3, 0x32, 0x8f, 0x14, 0x5f, 0x6b, 0xba, 0xee, 0xaa, 0x0e, 0x47,
+	0x88, 0xbd, 0xa9, 0xea, 0x72, 0x81, 0xa7, 0x4a, 0x15, 0x48, 0x57, 0x7f, 0xae, 0x41, 0x76, 0x93,
+	0xd6, 0x77, 0x7c, 0xdb, 0x62, 0x78, 0x4b, 0x7c, 0xeb, 0x42, 0xef, 0xc2, 0x88, 0xd5, 0x64, 0xfb,
+	0x24, 0x70, 0xd8, 0xe1, 0xa9, 0xee, 0x76, 0x54, 0xd1, 0x7d, 0x18, 0x0a, 0xbf, 0x96, 0xc9, 0xee,
+	0xf9, 0x46, 0xec, 0xe5, 0x0f, 0x41, 0xe4, 0x5c, 0x95, 0x07, 0x96, 0x46, 0xb9, 0x1f, 0x1d, 0x53,
+	0xfa, 0x35, 0xb8, 0xda, 0xc3, 0x2a, 0x62, 0xbc, 0xe0, 0xc2, 0x48, 0xb5, 0x3d, 0x53, 0xf2, 0x30,
+	0x59, 0xdd, 0x58, 0xae, 0xbe, 0x67, 0x6e, 0x7f, 0x7f, 0x6b, 0xdd, 0xdc, 0x29, 0x57, 0xb7, 0xd6,
+	0x57, 0x4b, 0x0f, 0x4a, 0xeb, 0x6b, 0xb9, 0x01, 0x74, 0x1d, 0xa6, 0x94, 0xbd, 0x52, 0xb9, 0xba,
+	0xbd, 0x5c, 0xde, 0x36, 0x85, 0x28, 0xa7, 0xa1, 0x5b, 0x70, 0x43, 0xd9, 0x2d, 0x57, 0x22, 0x85,
+	0xe5, 0xf2, 0x7a, 0x65, 0xa7, 0x2a, 0xd5, 0x12, 0xf7, 0xfe, 0x3c, 0x04, 0xc9, 0x4d, 0x5a, 0x47,
+	0xbb, 0x90, 0xee, 0x8a, 0xd1, 0x9b, 0xb1, 0xbe, 0xf5, 0x70, 0xce, 0xbf, 0x75, 0x16, 0xad, 0xc8,
+	0x33, 0xf4, 0x44, 0x83, 0x5c, 0xef, 0x3c, 0x40, 0xf1, 0x2f, 0xa1, 0x98, 0xb9, 0x97, 0x5f, 0x3c,
+	0xa3, 0xa6, 0xcc, 0xfc, 0xdb, 0x1f, 0xff, 0xe5, 0x9f, 0x9f, 0x25, 0x16, 0xf5, 0xaf, 0x17, 0xe3,
+	0x3f, 0xcb, 0x16, 0xe3, 0x66, 0xeb, 0xa7, 0x1a, 0xa4, 0xd5, 0x56, 0xd7, 0x27, 0x0e, 0x3d, 0x0d,
+	0x32, 0x3f, 0x7f, 0x06, 0x2d, 0x49, 0xab, 0x28, 0x68, 0xcd, 0xeb, 0x77, 0xfa, 0xd1, 0xea, 0x1d,
+	0x8a, 0x9f, 0x68, 0x00, 0x9d, 0xc2, 0x46, 0x7a, 0xfc, 0x6b, 0x42, 0xbd, 0x0a, 0xf9, 0x3b, 0xa7,
+	0xea, 0x48, 0x32, 0x8b, 0x82, 0xcc, 0x1d, 0xfd, 0x56, 0x3f, 0x32, 0xdd, 0x5d, 0x82, 0x53, 0xe9,
+	0xb4, 0xc3, 0x3e, 0x54, 0xba, 0x1a, 0x68, 0x1f, 0x2a, 0x31, 0x3d, 0xf5, 0x54, 0x2a, 0xdd, 0x83,
+	0xe6, 0x67, 0x1a, 0xa4, 0x94, 0xd6, 0x86, 0x6e, 0xf6, 0xc3, 0x51, 0xda, 0x61, 0x7e, 0xee, 0x74,
+	0x25, 0xc9, 0xa6, 0x20, 0xd8, 0xcc, 0xe9, 0xb7, 0x4f, 0x60, 0xa3, 0x5a, 0xbe, 0xf4, 0xe3, 0x17,
+	0x4f, 0x17, 0xb4, 0x95, 0x8d, 0x2f, 0x8e, 0xa7, 0xb5, 0x2f, 0x8f, 0xa7, 0xb5, 0x7f, 0x1c, 0x4f,
+	0x6b, 0x9f, 0x3e, 0x9f, 0x1e, 0xf8, 0xf2, 0xf9, 0xf4, 0xc0, 0x5f, 0x9f, 0x4f, 0x0f, 0x7c, 0x70,
+	0x4f, 0x99, 0xe0, 0xeb, 0xa1, 0xc9, 0x32, 0x66, 0x1f, 0x92, 0xe0, 0x71, 0x1b, 0xe1, 0xa0, 0x83,
+	0x21, 0x26, 0xfa, 0xee, 0x90, 0xf8, 0xc0, 0xfe, 0xf6, 0xff, 0x02, 0x00, 0x00, 0xff, 0xff, 0x76,
+	0x8b, 0x81, 0x89, 0x58, 0x18, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1169,8 +1572,12 @@ const _ = grpc.SupportPackageIsVersion4
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
 type MsgClient interface {
+	// UpdateParams updates the parameters of the operator module.
+	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
 	// RegisterOperator registers a new operator.
 	RegisterOperator(ctx context.Context, in *RegisterOperatorReq, opts ...grpc.CallOption) (*RegisterOperatorResponse, error)
+	// EditOperator edits the information of an existing operator.
+	EditOperator(ctx context.Context, in *EditOperatorReq, opts ...grpc.CallOption) (*EditOperatorResponse, error)
 	// SetConsKey sets the operator's consensus key for an AVS. To do this, the operator
 	// must have previously opted into the AVS.
 	SetConsKey(ctx context.Context, in *SetConsKeyReq, opts ...grpc.CallOption) (*SetConsKeyResponse, error)
@@ -1188,6 +1595,15 @@ func NewMsgClient(cc grpc1.ClientConn) MsgClient {
 	return &msgClient{cc}
 }
 
+func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
+	out := new(MsgUpdateParamsResponse)
+	err := c.cc.Invoke(ctx, "/exocore.operator.v1.Msg/UpdateParams", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) RegisterOperator(ctx context.Context, in *RegisterOperatorReq, opts ...grpc.CallOption) (*RegisterOperatorResponse, error) {
 	out := new(RegisterOperatorResponse)
 	err := c.cc.Invoke(ctx, "/exocore.operator.v1.Msg/RegisterOperator", in, out, opts...)
@@ -1197,6 +1613,15 @@ func (c *msgClient) RegisterOperator(ctx context.Context, in *RegisterOperatorRe
 	return out, nil
 }
 
+func (c *msgClient) EditOperator(ctx context.Context, in *EditOperatorReq, opts ...grpc.CallOption) (*EditOperatorResponse, error) {
+	out := new(EditOperatorResponse)
+	err := c.cc.Invoke(ctx, "/exocore.operator.v1.Msg/EditOperator", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) SetConsKey(ctx context.Context, in *SetConsKeyReq, opts ...grpc.CallOption) (*SetConsKeyResponse, error) {
 	out := new(SetConsKeyResponse)
 	err := c.cc.Invoke(ctx, "/exocore.operator.v1.Msg/SetConsKey", in, out, opts...)
@@ -1226,8 +1651,12 @@ func (c *msgClient) OptOutOfAVS(ctx context.Context, in *OptOutOfAVSReq, opts ..
 
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
+	// UpdateParams updates the parameters of the operator module.
+	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
 	// RegisterOperator registers a new operator.
 	RegisterOperator(context.Context, *RegisterOperatorReq) (*RegisterOperatorResponse, error)
+	// EditOperator edits the information of an existing operator.
+	EditOperator(context.Context, *EditOperatorReq) (*EditOperatorResponse, error)
 	// SetConsKey sets the operator's consensus key for an AVS. To do this, the operator
 	// must have previously opted into the AVS.
 	SetConsKey(context.Context, *SetConsKeyReq) (*SetConsKeyResponse, error)
@@ -1241,9 +1670,15 @@ type MsgServer interface {
 type UnimplementedMsgServer struct {
 }
 
+func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+}
 func (*UnimplementedMsgServer) RegisterOperator(ctx context.Context, req *RegisterOperatorReq) (*RegisterOperatorResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RegisterOperator not implemented")
 }
+func (*UnimplementedMsgServer) EditOperator(ctx context.Context, req *EditOperatorReq) (*EditOperatorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EditOperator not implemented")
+}
 func (*UnimplementedMsgServer) SetConsKey(ctx context.Context, req *SetConsKeyReq) (*SetConsKeyResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SetConsKey not implemented")
 }
@@ -1258,31 +1693,67 @@ func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
 }
 
-func _Msg_RegisterOperator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RegisterOperatorReq)
+func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateParams)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MsgServer).RegisterOperator(ctx, in)
+		return srv.(MsgServer).UpdateParams(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/exocore.operator.v1.Msg/RegisterOperator",
+		FullMethod: "/exocore.operator.v1.Msg/UpdateParams",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).RegisterOperator(ctx, req.(*RegisterOperatorReq))
+		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Msg_SetConsKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SetConsKeyReq)
+func _Msg_RegisterOperator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RegisterOperatorReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MsgServer).SetConsKey(ctx, in)
+		return srv.(MsgServer).RegisterOperator(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/exocore.operator.v1.Msg/RegisterOperator",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RegisterOperator(ctx, req.(*RegisterOperatorReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_EditOperator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EditOperatorReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).EditOperator(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/exocore.operator.v1.Msg/EditOperator",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).EditOperator(ctx, req.(*EditOperatorReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetConsKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetConsKeyReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetConsKey(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
@@ -1334,10 +1805,18 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "exocore.operator.v1.Msg",
 	HandlerType: (*MsgServer)(nil),
 	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "UpdateParams",
+			Handler:    _Msg_UpdateParams_Handler,
+		},
 		{
 			MethodName: "RegisterOperator",
 			Handler:    _Msg_RegisterOperator_Handler,
 		},
+		{
+			MethodName: "EditOperator",
+			Handler:    _Msg_EditOperator_Handler,
+		},
 		{
 			MethodName: "SetConsKey",
 			Handler:    _Msg_SetConsKey_Handler,
@@ -1441,6 +1920,105 @@ func (m *OperatorOptedUSDValue) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *OperatorVotingPower) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OperatorVotingPower) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OperatorVotingPower) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.VotingPower.Size()
+		i -= size
+		if _, err := m.VotingPower.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.OperatorAddr) > 0 {
+		i -= len(m.OperatorAddr)
+		copy(dAtA[i:], m.OperatorAddr)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.OperatorAddr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *VotingPowerSnapshot) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VotingPowerSnapshot) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VotingPowerSnapshot) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.OperatorVotingPowers) > 0 {
+		for iNdEx := len(m.OperatorVotingPowers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.OperatorVotingPowers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	{
+		size := m.TotalVotingPower.Size()
+		i -= size
+		if _, err := m.TotalVotingPower.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.EpochNumber != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.EpochNumber))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.EpochIdentifier) > 0 {
+		i -= len(m.EpochIdentifier)
+		copy(dAtA[i:], m.EpochIdentifier)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.EpochIdentifier)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *ClientChainEarningAddrList) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1719,6 +2297,60 @@ func (m *SlashFromUndelegation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *SlashFromRedelegation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SlashFromRedelegation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SlashFromRedelegation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.DstOperatorAddr) > 0 {
+		i -= len(m.DstOperatorAddr)
+		copy(dAtA[i:], m.DstOperatorAddr)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.DstOperatorAddr)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.AssetID) > 0 {
+		i -= len(m.AssetID)
+		copy(dAtA[i:], m.AssetID)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.AssetID)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.StakerID) > 0 {
+		i -= len(m.StakerID)
+		copy(dAtA[i:], m.StakerID)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.StakerID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *SlashFromAssetsPool) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1779,6 +2411,20 @@ func (m *SlashExecutionInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.SlashRedelegations) > 0 {
+		for iNdEx := len(m.SlashRedelegations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SlashRedelegations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
 	if len(m.SlashAssetsPool) > 0 {
 		for iNdEx := len(m.SlashAssetsPool) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -1972,6 +2618,99 @@ func (m *RegisterOperatorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
+func (m *EditOperatorReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EditOperatorReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EditOperatorReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CommissionRate != nil {
+		{
+			size := m.CommissionRate.Size()
+			i -= size
+			if _, err := m.CommissionRate.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.ClientChainEarningsAddr) > 0 {
+		for iNdEx := len(m.ClientChainEarningsAddr) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ClientChainEarningsAddr[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.ApproveAddr) > 0 {
+		i -= len(m.ApproveAddr)
+		copy(dAtA[i:], m.ApproveAddr)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ApproveAddr)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.OperatorMetaInfo) > 0 {
+		i -= len(m.OperatorMetaInfo)
+		copy(dAtA[i:], m.OperatorMetaInfo)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.OperatorMetaInfo)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.FromAddress) > 0 {
+		i -= len(m.FromAddress)
+		copy(dAtA[i:], m.FromAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.FromAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EditOperatorResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EditOperatorResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EditOperatorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func (m *OptIntoAVSReq) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -2166,14 +2905,77 @@ func (m *SetConsKeyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
+func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
 	dAtA[offset] = uint8(v)
 	return base
 }
@@ -2203,6 +3005,45 @@ func (m *OperatorOptedUSDValue) Size() (n int) {
 	return n
 }
 
+func (m *OperatorVotingPower) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OperatorAddr)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.VotingPower.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *VotingPowerSnapshot) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.EpochIdentifier)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.EpochNumber != 0 {
+		n += 1 + sovTx(uint64(m.EpochNumber))
+	}
+	l = m.TotalVotingPower.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if len(m.OperatorVotingPowers) > 0 {
+		for _, e := range m.OperatorVotingPowers {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *ClientChainEarningAddrList) Size() (n int) {
 	if m == nil {
 		return 0
@@ -2315,6 +3156,29 @@ func (m *SlashFromUndelegation) Size() (n int) {
 	return n
 }
 
+func (m *SlashFromRedelegation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.StakerID)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.AssetID)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.DstOperatorAddr)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
 func (m *SlashFromAssetsPool) Size() (n int) {
 	if m == nil {
 		return 0
@@ -2352,6 +3216,12 @@ func (m *SlashExecutionInfo) Size() (n int) {
 			n += 1 + l + sovTx(uint64(l))
 		}
 	}
+	if len(m.SlashRedelegations) > 0 {
+		for _, e := range m.SlashRedelegations {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -2412,6 +3282,46 @@ func (m *RegisterOperatorResponse) Size() (n int) {
 	return n
 }
 
+func (m *EditOperatorReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FromAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.OperatorMetaInfo)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ApproveAddr)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.ClientChainEarningsAddr) > 0 {
+		for _, e := range m.ClientChainEarningsAddr {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.CommissionRate != nil {
+		l = m.CommissionRate.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *EditOperatorResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func (m *OptIntoAVSReq) Size() (n int) {
 	if m == nil {
 		return 0
@@ -2498,6 +3408,30 @@ func (m *SetConsKeyResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -2740,7 +3674,7 @@ func (m *OperatorOptedUSDValue) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ClientChainEarningAddrList) Unmarshal(dAtA []byte) error {
+func (m *OperatorVotingPower) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2763,17 +3697,17 @@ func (m *ClientChainEarningAddrList) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ClientChainEarningAddrList: wiretype end group for non-group")
+			return fmt.Errorf("proto: OperatorVotingPower: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ClientChainEarningAddrList: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: OperatorVotingPower: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EarningInfoList", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OperatorAddr", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2783,23 +3717,55 @@ func (m *ClientChainEarningAddrList) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.EarningInfoList = append(m.EarningInfoList, &ClientChainEarningAddrInfo{})
-			if err := m.EarningInfoList[len(m.EarningInfoList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.OperatorAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VotingPower", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.VotingPower.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2824,7 +3790,7 @@ func (m *ClientChainEarningAddrList) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ClientChainEarningAddrInfo) Unmarshal(dAtA []byte) error {
+func (m *VotingPowerSnapshot) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2847,17 +3813,17 @@ func (m *ClientChainEarningAddrInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ClientChainEarningAddrInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: VotingPowerSnapshot: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ClientChainEarningAddrInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: VotingPowerSnapshot: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LzClientChainID", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochIdentifier", wireType)
 			}
-			m.LzClientChainID = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2867,14 +3833,46 @@ func (m *ClientChainEarningAddrInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.LzClientChainID |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EpochIdentifier = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochNumber", wireType)
+			}
+			m.EpochNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochNumber |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClientChainEarningAddr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalVotingPower", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2902,9 +3900,230 @@ func (m *ClientChainEarningAddrInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ClientChainEarningAddr = string(dAtA[iNdEx:postIndex])
+			if err := m.TotalVotingPower.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		default:
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OperatorVotingPowers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OperatorVotingPowers = append(m.OperatorVotingPowers, OperatorVotingPower{})
+			if err := m.OperatorVotingPowers[len(m.OperatorVotingPowers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ClientChainEarningAddrList) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ClientChainEarningAddrList: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ClientChainEarningAddrList: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EarningInfoList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EarningInfoList = append(m.EarningInfoList, &ClientChainEarningAddrInfo{})
+			if err := m.EarningInfoList[len(m.EarningInfoList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ClientChainEarningAddrInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ClientChainEarningAddrInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ClientChainEarningAddrInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LzClientChainID", wireType)
+			}
+			m.LzClientChainID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LzClientChainID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientChainEarningAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientChainEarningAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
 			if err != nil {
@@ -3546,7 +4765,7 @@ func (m *SlashFromUndelegation) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SlashFromAssetsPool) Unmarshal(dAtA []byte) error {
+func (m *SlashFromRedelegation) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3569,15 +4788,15 @@ func (m *SlashFromAssetsPool) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SlashFromAssetsPool: wiretype end group for non-group")
+			return fmt.Errorf("proto: SlashFromRedelegation: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SlashFromAssetsPool: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SlashFromRedelegation: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AssetID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StakerID", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3605,11 +4824,11 @@ func (m *SlashFromAssetsPool) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AssetID = string(dAtA[iNdEx:postIndex])
+			m.StakerID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AssetID", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3637,20 +4856,200 @@ func (m *SlashFromAssetsPool) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.AssetID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTx(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DstOperatorAddr", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DstOperatorAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SlashFromAssetsPool) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SlashFromAssetsPool: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SlashFromAssetsPool: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AssetID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AssetID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
 			iNdEx += skippy
@@ -3827,6 +5226,40 @@ func (m *SlashExecutionInfo) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashRedelegations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SlashRedelegations = append(m.SlashRedelegations, SlashFromRedelegation{})
+			if err := m.SlashRedelegations[len(m.SlashRedelegations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -4245,7 +5678,7 @@ func (m *RegisterOperatorResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *OptIntoAVSReq) Unmarshal(dAtA []byte) error {
+func (m *EditOperatorReq) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4268,10 +5701,10 @@ func (m *OptIntoAVSReq) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: OptIntoAVSReq: wiretype end group for non-group")
+			return fmt.Errorf("proto: EditOperatorReq: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: OptIntoAVSReq: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EditOperatorReq: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4308,7 +5741,7 @@ func (m *OptIntoAVSReq) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AvsAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OperatorMetaInfo", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4336,11 +5769,11 @@ func (m *OptIntoAVSReq) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AvsAddress = string(dAtA[iNdEx:postIndex])
+			m.OperatorMetaInfo = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PublicKeyJSON", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ApproveAddr", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4368,35 +5801,301 @@ func (m *OptIntoAVSReq) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PublicKeyJSON = string(dAtA[iNdEx:postIndex])
+			m.ApproveAddr = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTx(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientChainEarningsAddr", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *OptIntoAVSResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
+			m.ClientChainEarningsAddr = append(m.ClientChainEarningsAddr, &ClientChainEarningAddrInfo{})
+			if err := m.ClientChainEarningsAddr[len(m.ClientChainEarningsAddr)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommissionRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Dec
+			m.CommissionRate = &v
+			if err := m.CommissionRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EditOperatorResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EditOperatorResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EditOperatorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OptIntoAVSReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OptIntoAVSReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OptIntoAVSReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FromAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AvsAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AvsAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PublicKeyJSON", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PublicKeyJSON = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OptIntoAVSResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
 		for shift := uint(0); ; shift += 7 {
 			if shift >= 64 {
 				return ErrIntOverflowTx
@@ -4801,6 +6500,171 @@ func (m *SetConsKeyResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
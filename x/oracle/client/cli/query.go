@@ -28,6 +28,7 @@ func GetQueryCmd(_ string) *cobra.Command {
 	// cmd.AddCommand(CmdListPrices())
 	cmd.AddCommand(CmdShowPrices())
 	cmd.AddCommand(CmdShowLatestPrice())
+	cmd.AddCommand(CmdShowTWAPPrice())
 	cmd.AddCommand(CmdShowValidatorUpdateBlock())
 	cmd.AddCommand(CmdShowIndexRecentParams())
 	cmd.AddCommand(CmdShowIndexRecentMsg())
@@ -39,6 +40,9 @@ func GetQueryCmd(_ string) *cobra.Command {
 	cmd.AddCommand(CmdQueryStakerInfos())
 	cmd.AddCommand(CmdQueryStakerList())
 	cmd.AddCommand(CmdQueryTokenIndexes())
+	cmd.AddCommand(CmdShowValidatorReportInfo())
+	cmd.AddCommand(CmdListValidatorReportInfos())
+	cmd.AddCommand(CmdShowValidatorDeviationInfo())
 	// this line is used by starport scaffolding # 1
 
 	return cmd
@@ -19,6 +19,11 @@ func CmdQueryStakerInfos() *cobra.Command {
 				return err
 			}
 
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
 			queryClient := types.NewQueryClient(clientCtx)
 
 			assetID := args[0]
@@ -28,7 +33,8 @@ func CmdQueryStakerInfos() *cobra.Command {
 			}
 
 			request := &types.QueryStakerInfosRequest{
-				AssetId: assetID,
+				AssetId:    assetID,
+				Pagination: pageReq,
 			}
 
 			res, err := queryClient.StakerInfos(cmd.Context(), request)
@@ -40,6 +46,7 @@ func CmdQueryStakerInfos() *cobra.Command {
 		},
 	}
 
+	flags.AddPaginationFlagsToCmd(cmd, cmd.Use)
 	flags.AddQueryFlagsToCmd(cmd)
 
 	return cmd
@@ -48,7 +48,7 @@ import (
 func CmdShowPrices() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "show-prices [token-id]",
-		Short: "shows price of the latest round for a specific token",
+		Short: "shows the prices of the rounds for a specific token, use --reverse for the latest rounds first",
 		Args:  cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) (err error) {
 			clientCtx, err := client.GetClientQueryContext(cmd)
@@ -56,6 +56,11 @@ func CmdShowPrices() *cobra.Command {
 				return err
 			}
 
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
 			queryClient := types.NewQueryClient(clientCtx)
 
 			argTokenID, err := cast.ToUint64E(args[0])
@@ -64,7 +69,8 @@ func CmdShowPrices() *cobra.Command {
 			}
 
 			params := &types.QueryGetPricesRequest{
-				TokenId: argTokenID,
+				TokenId:    argTokenID,
+				Pagination: pageReq,
 			}
 
 			res, err := queryClient.Prices(cmd.Context(), params)
@@ -76,6 +82,7 @@ func CmdShowPrices() *cobra.Command {
 		},
 	}
 
+	flags.AddPaginationFlagsToCmd(cmd, cmd.Use)
 	flags.AddQueryFlagsToCmd(cmd)
 
 	return cmd
@@ -113,3 +120,56 @@ func CmdShowLatestPrice() *cobra.Command {
 
 	return cmd
 }
+
+const (
+	FlagRounds = "rounds"
+	FlagBlocks = "blocks"
+)
+
+func CmdShowTWAPPrice() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "show-twap-price [token-id]",
+		Short: "shows the time-weighted average price of a specific token over the latest rounds or blocks",
+		Long: "shows the time-weighted average price of a specific token over the window specified by " +
+			"either --rounds or --blocks, the blocks are converted to the rounds covering them",
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			argTokenID, err := cast.ToUint64E(args[0])
+			if err != nil {
+				return err
+			}
+			rounds, err := cmd.Flags().GetUint64(FlagRounds)
+			if err != nil {
+				return err
+			}
+			blocks, err := cmd.Flags().GetUint64(FlagBlocks)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			input := &types.QueryTWAPPriceRequest{
+				TokenId: argTokenID,
+				Rounds:  rounds,
+				Blocks:  blocks,
+			}
+			res, err := queryClient.TWAPPrice(cmd.Context(), input)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	cmd.Flags().Uint64(FlagRounds, 0, "the number of the latest rounds in the window")
+	cmd.Flags().Uint64(FlagBlocks, 0, "the number of the latest blocks in the window")
+	cmd.MarkFlagsMutuallyExclusive(FlagRounds, FlagBlocks)
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,124 @@
+package cli
+
+import (
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+
+	"github.com/ExocoreNetwork/exocore/x/oracle/types"
+	"github.com/spf13/cast"
+)
+
+func CmdShowValidatorReportInfo() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "show-validator-report-info [validator-cons-address] [feeder-id]",
+		Short: "shows the liveness of a validator as the price feeder of a feeder",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			argFeederID, err := cast.ToUint64E(args[1])
+			if err != nil {
+				return err
+			}
+
+			params := &types.QueryValidatorReportInfoRequest{
+				Validator: args[0],
+				FeederId:  argFeederID,
+			}
+
+			res, err := queryClient.ValidatorReportInfo(cmd.Context(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdListValidatorReportInfos() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list-validator-report-infos [validator-cons-address]",
+		Short: "list the liveness of the validators as price feeders, optionally for a validator",
+		Args:  cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryValidatorReportInfosRequest{
+				Pagination: pageReq,
+			}
+			if len(args) > 0 {
+				params.Validator = args[0]
+			}
+
+			res, err := queryClient.ValidatorReportInfos(cmd.Context(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, cmd.Use)
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdShowValidatorDeviationInfo() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "show-validator-deviation-info [validator-cons-address] [feeder-id]",
+		Short: "shows the deviation of the prices reported by a validator from the final prices of a feeder",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			argFeederID, err := cast.ToUint64E(args[1])
+			if err != nil {
+				return err
+			}
+
+			params := &types.QueryValidatorDeviationInfoRequest{
+				Validator: args[0],
+				FeederId:  argFeederID,
+			}
+
+			res, err := queryClient.ValidatorDeviationInfo(cmd.Context(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -11,6 +11,10 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 	// Set all the prices
 	for _, elem := range genState.PricesList {
 		k.SetPrices(ctx, elem)
+		// the imported prices are treated as finalized at the genesis height
+		if elem.NextRoundID > 1 {
+			k.SetPriceUpdatedHeight(ctx, elem.TokenID, ctx.BlockHeight())
+		}
 	}
 	// Set if defined
 	if genState.ValidatorUpdateBlock != nil {
@@ -40,6 +44,15 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 	for _, elem := range genState.StakerInfosAssets {
 		k.SetStakerInfos(ctx, elem.AssetId, elem.StakerInfos)
 	}
+	// Set the liveness of the validators as price feeders
+	for _, elem := range genState.ValidatorReportInfos {
+		k.SetValidatorReportInfo(ctx, elem)
+	}
+	for _, elem := range genState.ValidatorMissedRounds {
+		for _, index := range elem.MissedRounds {
+			k.SetMissedRoundBitArray(ctx, elem.Validator, elem.FeederID, index, true)
+		}
+	}
 	// this line is used by starport scaffolding # genesis/module/init
 	k.SetParams(ctx, genState.Params)
 }
@@ -70,6 +83,18 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 	// TODO: export stakerListAssets, and stakerInfosAssets
 	genesis.StakerInfosAssets = k.GetAllStakerInfosAssets(ctx)
 	genesis.StakerListAssets = k.GetAllStakerListAssets(ctx)
+	// Get the liveness of the validators as price feeders
+	genesis.ValidatorReportInfos = k.GetAllValidatorReportInfos(ctx)
+	for _, info := range genesis.ValidatorReportInfos {
+		missedRounds := k.GetValidatorMissedRounds(ctx, info.Validator, info.FeederID)
+		if len(missedRounds) > 0 {
+			genesis.ValidatorMissedRounds = append(genesis.ValidatorMissedRounds, types.ValidatorMissedRounds{
+				Validator:    info.Validator,
+				FeederID:     info.FeederID,
+				MissedRounds: missedRounds,
+			})
+		}
+	}
 	// this line is used by starport scaffolding # genesis/module/export
 
 	return genesis
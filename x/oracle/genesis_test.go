@@ -7,6 +7,7 @@ import (
 	"github.com/ExocoreNetwork/exocore/testutil/nullify"
 	"github.com/ExocoreNetwork/exocore/x/oracle"
 	"github.com/ExocoreNetwork/exocore/x/oracle/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
 )
 
@@ -65,6 +66,28 @@ func TestGenesis(t *testing.T) {
 				Block: 1,
 			},
 		},
+		ValidatorReportInfos: []types.ValidatorReportInfo{
+			{
+				Validator:           sdk.ConsAddress("validator1").String(),
+				FeederID:            1,
+				StartHeight:         1,
+				IndexOffset:         5,
+				MissedRoundsCounter: 2,
+			},
+			{
+				Validator:   sdk.ConsAddress("validator2").String(),
+				FeederID:    1,
+				StartHeight: 1,
+				IndexOffset: 5,
+			},
+		},
+		ValidatorMissedRounds: []types.ValidatorMissedRounds{
+			{
+				Validator:    sdk.ConsAddress("validator1").String(),
+				FeederID:     1,
+				MissedRounds: []uint64{1, 3},
+			},
+		},
 		// this line is used by starport scaffolding # genesis/test/state
 	}
 
@@ -82,5 +105,7 @@ func TestGenesis(t *testing.T) {
 	require.Equal(t, genesisState.IndexRecentMsg, got.IndexRecentMsg)
 	require.ElementsMatch(t, genesisState.RecentMsgList, got.RecentMsgList)
 	require.ElementsMatch(t, genesisState.RecentParamsList, got.RecentParamsList)
+	require.ElementsMatch(t, genesisState.ValidatorReportInfos, got.ValidatorReportInfos)
+	require.ElementsMatch(t, genesisState.ValidatorMissedRounds, got.ValidatorMissedRounds)
 	// this line is used by starport scaffolding # genesis/test/assert
 }
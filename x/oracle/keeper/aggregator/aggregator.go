@@ -5,6 +5,7 @@ import (
 
 	"github.com/ExocoreNetwork/exocore/x/oracle/keeper/common"
 	"github.com/ExocoreNetwork/exocore/x/oracle/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 type priceWithTimeAndRound struct {
@@ -29,12 +30,41 @@ func (r *reportPrice) aggregate() *big.Int {
 	}
 	tmp := make([]*big.Int, 0, len(r.prices))
 	for _, p := range r.prices {
-		tmp = append(tmp, p.price)
+		// the price of a deterministic source is nil until its detID is confirmed
+		if p.price != nil {
+			tmp = append(tmp, p.price)
+		}
+	}
+	if len(tmp) == 0 {
+		return nil
 	}
 	r.price = common.BigIntList(tmp).Median()
 	return r.price
 }
 
+// pricedSources returns the number of sources for which the validator has a price
+func (r *reportPrice) pricedSources() int {
+	count := 0
+	for _, p := range r.prices {
+		if p.price != nil {
+			count++
+		}
+	}
+	return count
+}
+
+// latestTimestamp returns the latest timestamp of the prices of the validator
+func (r *reportPrice) latestTimestamp() string {
+	latest := ""
+	for _, p := range r.prices {
+		// timestamps share the same format, so they can be compared as strings
+		if p.price != nil && p.timestamp > latest {
+			latest = p.timestamp
+		}
+	}
+	return latest
+}
+
 type aggregator struct {
 	finalPrice *big.Int
 	reports    []*reportPrice
@@ -46,6 +76,14 @@ type aggregator struct {
 	// sourceId->roundId used to track the confirmed DS roundId
 	// updated by calculator, detId use string
 	dsPrices map[uint64]string
+	// the power of the reports must exceed the threshold of the total power
+	threshold common.Threshold
+	// the latest timestamp of the prices aggregated in ConsensusModeRoundEnd
+	timestamp string
+	// method to aggregate the final price from the prices of the validators
+	method types.AggregationMethod
+	// fraction of the total power trimmed from each end for AggregationMethodTrimmedMean
+	trimFraction sdk.Dec
 }
 
 func (agg *aggregator) copy4CheckTx() *aggregator {
@@ -53,6 +91,11 @@ func (agg *aggregator) copy4CheckTx() *aggregator {
 		finalPrice:  big.NewInt(0).Set(agg.finalPrice),
 		reportPower: big.NewInt(0).Set(agg.reportPower),
 		totalPower:  big.NewInt(0).Set(agg.totalPower),
+		threshold:   agg.threshold,
+		timestamp:   agg.timestamp,
+
+		method:       agg.method,
+		trimFraction: agg.trimFraction,
 
 		reports:  make([]*reportPrice, 0, len(agg.reports)),
 		dsPrices: make(map[uint64]string),
@@ -140,7 +183,7 @@ func (agg *aggregator) confirmDSPrice(confirmedRounds []*confirmedPrice) {
 		// update the latest round-detId for DS, TODO: in v1 we only update this value once since calculator will just ignore any further value once a detId has reached consensus
 		//		agg.dsPrices[priceSourceRound.sourceId] = priceSourceRound.detId
 		// this id's comparison need to format id to make sure them be the same length
-		if id := agg.dsPrices[priceSourceRound.sourceID]; len(id) == 0 || (len(id) > 0 && id < priceSourceRound.detID) {
+		if id := agg.dsPrices[priceSourceRound.sourceID]; len(id) == 0 || detIDLess(id, priceSourceRound.detID) {
 			agg.dsPrices[priceSourceRound.sourceID] = priceSourceRound.detID
 			for _, report := range agg.reports {
 				if report.price != nil {
@@ -174,28 +217,90 @@ func (agg *aggregator) aggregate() *big.Int {
 	// currently: use rule_1+MODE_1: {rule:specified source:`chainlink`, MODE: asap when power exceeds the threshold}
 	// 1. check OVA threshold
 	// 2. check IVA consensus with rule, TODO: for v1 we only implement with mode=1&rule=1
-	if common.ExceedsThreshold(agg.reportPower, agg.totalPower) {
+	if agg.threshold.Exceeds(agg.reportPower, agg.totalPower) {
 		// TODO: this is kind of a mock way to suite V1, need update to check with params.rule
 		// check if IVA all reached consensus
 		if len(agg.dsPrices) > 0 {
-			validatorPrices := make([]*big.Int, 0, len(agg.reports))
+			validatorPrices := make(common.PricePowerList, 0, len(agg.reports))
 			// do the aggregation to find out the 'final price'
 			for _, validatorReport := range agg.reports {
-				validatorPrices = append(validatorPrices, validatorReport.aggregate())
+				if price := validatorReport.aggregate(); price != nil {
+					validatorPrices = append(validatorPrices, &common.PricePower{Price: price, Power: validatorReport.power})
+				}
 			}
-			// vTmp := bigIntList(validatorPrices)
-			agg.finalPrice = common.BigIntList(validatorPrices).Median()
+			if len(validatorPrices) == 0 {
+				return nil
+			}
+			agg.finalPrice = agg.aggregatePrices(validatorPrices)
 			// clear relative aggregator for this feeder, all the aggregator,calculator, filter can be removed since this round has been sealed
 		}
 	}
 	return agg.finalPrice
 }
 
-func newAggregator(validatorSetLength int, totalPower *big.Int) *aggregator {
+// aggregateRoundEnd is used by ConsensusModeRoundEnd at the end of a round to aggregate the
+// final price from the reports which have prices for at least requiredSources sources, with each
+// deterministic source priced at its latest confirmed detID. The final price is aggregated from
+// the prices of those reports with the aggregation method, and it's only available when their
+// power exceeds the threshold.
+func (agg *aggregator) aggregateRoundEnd(requiredSources int) *big.Int {
+	if agg.finalPrice != nil {
+		return agg.finalPrice
+	}
+	validPower := big.NewInt(0)
+	validatorPrices := make(common.PricePowerList, 0, len(agg.reports))
+	for _, validatorReport := range agg.reports {
+		if validatorReport.pricedSources() < requiredSources {
+			continue
+		}
+		price := validatorReport.aggregate()
+		if price == nil {
+			continue
+		}
+		if ts := validatorReport.latestTimestamp(); ts > agg.timestamp {
+			agg.timestamp = ts
+		}
+		validatorPrices = append(validatorPrices, &common.PricePower{Price: price, Power: validatorReport.power})
+		validPower = new(big.Int).Add(validPower, validatorReport.power)
+	}
+	if len(validatorPrices) == 0 || !agg.threshold.Exceeds(validPower, agg.totalPower) {
+		return nil
+	}
+	agg.finalPrice = agg.aggregatePrices(validatorPrices)
+	return agg.finalPrice
+}
+
+// aggregatePrices aggregates the final price from the prices of the validators with the
+// aggregation method of the aggregator
+func (agg *aggregator) aggregatePrices(validatorPrices common.PricePowerList) *big.Int {
+	switch agg.method {
+	case types.AggregationMethodWeightedMedian:
+		return validatorPrices.WeightedMedian()
+	case types.AggregationMethodTrimmedMean:
+		return validatorPrices.TrimmedMean(agg.trimFraction)
+	default:
+		// unweighted median is the aggregation before the method was introduced
+		return validatorPrices.Median()
+	}
+}
+
+// detIDLess compares two detIDs of a deterministic source, which are ordered as numbers when
+// they are numeric, shorter ids are less than longer ones for the same format.
+func detIDLess(a, b string) bool {
+	if len(a) != len(b) {
+		return len(a) < len(b)
+	}
+	return a < b
+}
+
+func newAggregator(validatorSetLength int, totalPower *big.Int, threshold common.Threshold, method types.AggregationMethod, trimFraction sdk.Dec) *aggregator {
 	return &aggregator{
-		reports:     make([]*reportPrice, 0, validatorSetLength),
-		reportPower: big.NewInt(0),
-		dsPrices:    make(map[uint64]string),
-		totalPower:  totalPower,
+		reports:      make([]*reportPrice, 0, validatorSetLength),
+		reportPower:  big.NewInt(0),
+		dsPrices:     make(map[uint64]string),
+		totalPower:   totalPower,
+		threshold:    threshold,
+		method:       method,
+		trimFraction: trimFraction,
 	}
 }
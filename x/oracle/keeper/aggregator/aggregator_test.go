@@ -4,12 +4,14 @@ import (
 	"math/big"
 	"testing"
 
+	"github.com/ExocoreNetwork/exocore/x/oracle/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	. "github.com/smartystreets/goconvey/convey"
 )
 
 func TestAggregator(t *testing.T) {
 	Convey("fill prices into aggregator", t, func() {
-		a := newAggregator(5, big.NewInt(4))
+		a := newAggregator(5, big.NewInt(4), defaultThreshold, types.AggregationMethodMedian, sdk.ZeroDec())
 		// a.fillPrice(pS1, "v1", one) //v1:{1, 2}
 
 		Convey("fill v1's report", func() {
@@ -66,3 +68,57 @@ func TestAggregator(t *testing.T) {
 		})
 	})
 }
+
+func TestAggregatorRoundEnd(t *testing.T) {
+	Convey("aggregate prices at the end of a round", t, func() {
+		a := newAggregator(5, big.NewInt(4), defaultThreshold, types.AggregationMethodWeightedMedian, sdk.ZeroDec())
+		a.fillPrice(pS1, "v1", one)           // v1:{s1}
+		a.fillPrice(pS2, "v2", big.NewInt(2)) // v2:{s1}
+		a.fillPrice(pS6, "v3", one)           // v3:{s2}
+		Convey("no final price before any detID is confirmed", func() {
+			So(a.aggregateRoundEnd(1), ShouldBeNil)
+		})
+		Convey("use the latest confirmed detID", func() {
+			a.confirmDSPrice([]*confirmedPrice{{sourceID: 1, detID: "2", price: big.NewInt(12), timestamp: "-"}})
+			a.confirmDSPrice([]*confirmedPrice{{sourceID: 1, detID: "10", price: fifteen, timestamp: "-"}})
+			// v3 has no price for source 1, so its report is excluded, and v1, v2 with 3 of 4 power exceed the threshold
+			So(a.aggregateRoundEnd(1), ShouldResemble, fifteen)
+			So(a.dsPrices[1], ShouldEqual, "10")
+			So(a.timestamp, ShouldEqual, "-")
+		})
+		Convey("no final price when the valid reports don't exceed the threshold", func() {
+			a.confirmDSPrice([]*confirmedPrice{{sourceID: 1, detID: "2", price: big.NewInt(12), timestamp: "-"}})
+			So(a.aggregateRoundEnd(2), ShouldBeNil)
+		})
+	})
+}
+
+func TestAggregatorMethods(t *testing.T) {
+	// v1:{s1:9-10, s2:3-20}:15 with power 1, v2:{s1:9-10}:10 with power 1, v3:{s2:3-20}:20 with power 3
+	aggregate := func(method types.AggregationMethod, trimFraction sdk.Dec) *big.Int {
+		a := newAggregator(5, big.NewInt(5), defaultThreshold, method, trimFraction)
+		a.fillPrice(pS1, "v1", one)
+		a.fillPrice(pS2, "v2", one)
+		a.fillPrice(pS21, "v1", one)
+		a.confirmDSPrice([]*confirmedPrice{
+			{sourceID: 1, detID: "9", price: ten, timestamp: "-"},
+			{sourceID: 2, detID: "3", price: twenty, timestamp: "-"},
+		})
+		a.fillPrice(pS6, "v3", big.NewInt(3))
+		return a.aggregate()
+	}
+	Convey("aggregate the final price with different methods", t, func() {
+		Convey("median counts every validator equally", func() {
+			So(aggregate(types.AggregationMethodMedian, sdk.ZeroDec()), ShouldResemble, fifteen)
+		})
+		Convey("weighted median follows the majority of the power", func() {
+			So(aggregate(types.AggregationMethodWeightedMedian, sdk.ZeroDec()), ShouldResemble, twenty)
+		})
+		Convey("trimmed mean trims the power from both ends", func() {
+			// (15*1+20*2)/3 after trimming the power of 1 from each end
+			So(aggregate(types.AggregationMethodTrimmedMean, sdk.NewDecWithPrec(2, 1)), ShouldResemble, big.NewInt(18))
+			// (10*1+15*1+20*3)/5 without trimming
+			So(aggregate(types.AggregationMethodTrimmedMean, sdk.ZeroDec()), ShouldResemble, big.NewInt(17))
+		})
+	})
+}
@@ -31,7 +31,7 @@ type roundPrices struct { // 0 means NS
 
 // udpate priceAndPower for a specific DSRoundID, if the price exists, increase its power with provided data
 // return confirmed=true, when detect power exceeds the threshold
-func (r *roundPrices) updatePriceAndPower(pw *priceAndPower, totalPower *big.Int) (updated bool, confirmed bool) {
+func (r *roundPrices) updatePriceAndPower(pw *priceAndPower, totalPower *big.Int, threshold common.Threshold) (updated bool, confirmed bool) {
 	if r.price != nil {
 		confirmed = true
 		return
@@ -40,7 +40,7 @@ func (r *roundPrices) updatePriceAndPower(pw *priceAndPower, totalPower *big.Int
 		if item.price.Cmp(pw.price) == 0 {
 			item.power = new(big.Int).Add(item.power, pw.power)
 			updated = true
-			if common.ExceedsThreshold(item.power, totalPower) {
+			if threshold.Exceeds(item.power, totalPower) {
 				r.price = item.price
 				confirmed = true
 			}
@@ -50,7 +50,7 @@ func (r *roundPrices) updatePriceAndPower(pw *priceAndPower, totalPower *big.Int
 	if len(r.prices) < cap(r.prices) {
 		r.prices = append(r.prices, pw)
 		updated = true
-		if common.ExceedsThreshold(pw.power, totalPower) {
+		if threshold.Exceeds(pw.power, totalPower) {
 			r.price = pw.price
 			//			r.confirmed = true
 			confirmed = true
@@ -131,10 +131,13 @@ type calculator struct {
 	deterministicSource map[uint64]*roundPricesList
 	validatorLength     int
 	totalPower          *big.Int
+	threshold           common.Threshold
+	maxDetID            int
+	mode                types.ConsensusMode
 }
 
 func (c *calculator) copy4CheckTx() *calculator {
-	ret := newCalculator(c.validatorLength, c.totalPower)
+	ret := newCalculator(c.validatorLength, c.totalPower, c.threshold, c.maxDetID, c.mode)
 
 	// copy deterministicSource
 	for k, v := range c.deterministicSource {
@@ -146,7 +149,7 @@ func (c *calculator) copy4CheckTx() *calculator {
 
 func (c *calculator) newRoundPricesList() *roundPricesList {
 	return &roundPricesList{
-		roundPricesList: make([]*roundPrices, 0, int(common.MaxDetID)*c.validatorLength),
+		roundPricesList: make([]*roundPrices, 0, c.maxDetID*c.validatorLength),
 		// for each DS-roundId, the count of prices provided is the number of validators at most
 		roundPricesCount: c.validatorLength,
 	}
@@ -162,11 +165,13 @@ func (c *calculator) getOrNewSourceID(sourceID uint64) *roundPricesList {
 }
 
 // fillPrice called upon new MsgCreatPrice arrived, to trigger the calculation to get to consensus on the same roundID_of_deterministic_source
-// v1 use mode1, TODO: switch modes
+// for ConsensusModeASAP, any detID after the first confirmed one is ignored, while ConsensusModeRoundEnd keeps collecting all detIDs
+// so that the latest confirmed one is used at the end of the round
 func (c *calculator) fillPrice(pSources []*types.PriceSource, _ string, power *big.Int) (confirmedRounds []*confirmedPrice) {
+	asap := c.mode != types.ConsensusModeRoundEnd
 	for _, pSource := range pSources {
 		rounds := c.getOrNewSourceID(pSource.SourceID)
-		if rounds.hasConfirmedDetID() {
+		if asap && rounds.hasConfirmedDetID() {
 			// TODO: this skip is just for V1 to do fast calculation and release EndBlocker pressure, may lead to 'not latest detId' be chosen
 			break
 		}
@@ -180,22 +185,27 @@ func (c *calculator) fillPrice(pSources []*types.PriceSource, _ string, power *b
 
 			roundPrice, _ := new(big.Int).SetString(pDetID.Price, 10)
 
-			updated, confirmed := round.updatePriceAndPower(&priceAndPower{roundPrice, power}, c.totalPower)
+			updated, confirmed := round.updatePriceAndPower(&priceAndPower{roundPrice, power}, c.totalPower, c.threshold)
 			if updated && confirmed {
 				// sourceId, detId, price
 				confirmedRounds = append(confirmedRounds, &confirmedPrice{pSource.SourceID, round.detID, round.price, round.timestamp}) // TODO: just in v1 with mode==1, we use asap, so we just ignore any further data from this DS, even higher detId may get to consensus, in this way, in most case, we can complete the calculation in the transaction execution process. Release the pressure in EndBlocker
 				// TODO: this may delay to current block finish
-				break
+				if asap {
+					break
+				}
 			}
 		}
 	}
 	return
 }
 
-func newCalculator(validatorSetLength int, totalPower *big.Int) *calculator {
+func newCalculator(validatorSetLength int, totalPower *big.Int, threshold common.Threshold, maxDetID int, mode types.ConsensusMode) *calculator {
 	return &calculator{
 		deterministicSource: make(map[uint64]*roundPricesList),
 		validatorLength:     validatorSetLength,
 		totalPower:          totalPower,
+		threshold:           threshold,
+		maxDetID:            maxDetID,
+		mode:                mode,
 	}
 }
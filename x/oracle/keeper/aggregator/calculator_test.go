@@ -4,6 +4,7 @@ import (
 	"math/big"
 	"testing"
 
+	"github.com/ExocoreNetwork/exocore/x/oracle/types"
 	. "github.com/smartystreets/goconvey/convey"
 )
 
@@ -23,7 +24,7 @@ ps2, ps2, ps3, ps5 ---> 1-10
 func TestCalculator(t *testing.T) {
 	one := big.NewInt(1)
 	Convey("fill prices into calculator", t, func() {
-		c := newCalculator(5, big.NewInt(4))
+		c := newCalculator(5, big.NewInt(4), defaultThreshold, 5, types.ConsensusModeASAP)
 		Convey("fill prices from single deterministic source", func() {
 			c.fillPrice(pS1, "v1", one) // 1-10, 2-12
 			c.fillPrice(pS2, "v2", one) // 2-12, 3-15
@@ -68,3 +69,24 @@ func TestCalculator(t *testing.T) {
 		})
 	})
 }
+
+func TestCalculatorRoundEnd(t *testing.T) {
+	one := big.NewInt(1)
+	Convey("fill prices into calculator of ConsensusModeRoundEnd", t, func() {
+		c := newCalculator(5, big.NewInt(4), defaultThreshold, 5, types.ConsensusModeRoundEnd)
+		c.fillPrice(pS1, "v1", one) // 1-10, 2-12
+		c.fillPrice(pS2, "v2", one) // 2-12, 3-15
+		c.fillPrice(pS3, "v3", one) // 1-10, 2-11
+		Convey("keep confirming later detIDs after detid=1", func() {
+			confirmed := c.fillPrice(pS5, "v5", one) // 1-10, 3-19
+			So(len(confirmed), ShouldEqual, 1)
+			So(confirmed[0].detID, ShouldEqual, "1")
+			So(confirmed[0].price, ShouldResemble, big.NewInt(10))
+
+			confirmed = c.fillPrice(pS4, "v4", one) // 2-12, 3-19
+			So(len(confirmed), ShouldEqual, 1)
+			So(confirmed[0].detID, ShouldEqual, "2")
+			So(confirmed[0].price, ShouldResemble, big.NewInt(12))
+		})
+	})
+}
@@ -4,9 +4,9 @@ import (
 	"errors"
 	"fmt"
 	"math/big"
+	"sort"
 
 	"github.com/ExocoreNetwork/exocore/x/oracle/keeper/cache"
-	"github.com/ExocoreNetwork/exocore/x/oracle/keeper/common"
 	"github.com/ExocoreNetwork/exocore/x/oracle/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
@@ -14,6 +14,10 @@ import (
 type PriceItemKV struct {
 	TokenID uint64
 	PriceTR types.PriceTimeRound
+	// FeederID is the feeder whose round got the final price
+	FeederID uint64
+	// Reports are the prices reported by the validators aggregated into the final price
+	Reports map[string]*big.Int
 }
 
 type roundInfo struct {
@@ -72,6 +76,9 @@ func (agc *AggregatorContext) Copy4CheckTx() *AggregatorContext {
 		w := newWorker(k, ret)
 		w.sealed = v.sealed
 		w.price = v.price
+		w.reports = v.reports
+		w.mode = v.mode
+		w.requiredSources = v.requiredSources
 
 		w.f = v.f.copy4CheckTx()
 		w.c = v.c.copy4CheckTx()
@@ -98,7 +105,7 @@ func (agc *AggregatorContext) sanityCheck(msg *types.MsgCreatePrice) error {
 	}
 
 	for _, pSource := range msg.Prices {
-		if len(pSource.Prices) == 0 || len(pSource.Prices) > int(common.MaxDetID) || !agc.params.IsValidSource(pSource.SourceID) {
+		if len(pSource.Prices) == 0 || len(pSource.Prices) > int(agc.params.MaxDetId) || !agc.params.IsValidSource(pSource.SourceID) {
 			return errors.New("source should be valid and provide at least one price")
 		}
 		// check with params is coressponding source is deteministic
@@ -167,13 +174,18 @@ func (agc *AggregatorContext) FillPrice(msg *types.MsgCreatePrice) (*PriceItemKV
 		if finalPrice := feederWorker.aggregate(); finalPrice != nil {
 			agc.rounds[msg.FeederID].status = roundStatusClosed
 			feederWorker.seal()
-			return &PriceItemKV{agc.params.GetTokenFeeder(msg.FeederID).TokenID, types.PriceTimeRound{
-				Price:   finalPrice.String(),
-				Decimal: agc.params.GetTokenInfo(msg.FeederID).Decimal,
-				// TODO: check the format
-				Timestamp: msg.Prices[0].Prices[0].Timestamp,
-				RoundID:   agc.rounds[msg.FeederID].nextRoundID,
-			}}, &cache.ItemM{FeederID: msg.FeederID}, nil
+			return &PriceItemKV{
+				TokenID: agc.params.GetTokenFeeder(msg.FeederID).TokenID,
+				PriceTR: types.PriceTimeRound{
+					Price:   finalPrice.String(),
+					Decimal: agc.params.GetTokenInfo(msg.FeederID).Decimal,
+					// TODO: check the format
+					Timestamp: msg.Prices[0].Prices[0].Timestamp,
+					RoundID:   agc.rounds[msg.FeederID].nextRoundID,
+				},
+				FeederID: msg.FeederID,
+				Reports:  feederWorker.reports,
+			}, &cache.ItemM{FeederID: msg.FeederID}, nil
 		}
 		return nil, &cache.ItemM{FeederID: msg.FeederID, PSources: listFilled, Validator: msg.Creator}, nil
 	}
@@ -197,15 +209,26 @@ func (agc *AggregatorContext) NewCreatePrice(_ sdk.Context, msg *types.MsgCreate
 // when validatorSet update, set force to true, to seal all alive round
 // returns: 1st successful sealed, need to be written to KVStore, 2nd: failed sealed tokenID, use previous price to write to KVStore
 func (agc *AggregatorContext) SealRound(ctx sdk.Context, force bool) (success []*PriceItemKV, failed []uint64, sealed []uint64) {
-	for feederID, round := range agc.rounds {
+	// iterate the feeders in order, so that the final prices are written in a deterministic order
+	feederIDs := make([]uint64, 0, len(agc.rounds))
+	for feederID := range agc.rounds {
+		feederIDs = append(feederIDs, feederID)
+	}
+	sort.Slice(feederIDs, func(i, j int) bool { return feederIDs[i] < feederIDs[j] })
+	for _, feederID := range feederIDs {
+		round := agc.rounds[feederID]
 		if round.status == roundStatusOpen {
 			feeder := agc.params.GetTokenFeeder(feederID)
-			// TODO: for mode=1, we don't do aggregate() here, since if it donesn't success in the transaction execution stage, it won't success here
-			// but it's not always the same for other modes, switch modes
-			switch common.Mode {
+			// the mode of a round is fixed by its worker when the round started
+			mode := agc.params.Mode
+			if w := agc.aggregators[feederID]; w != nil {
+				mode = w.mode
+			}
+			switch mode {
+			// for mode=1, we don't do aggregate() here, since if it donesn't success in the transaction execution stage, it won't success here
 			case types.ConsensusModeASAP:
 				expired := feeder.EndBlock > 0 && uint64(ctx.BlockHeight()) >= feeder.EndBlock
-				outOfWindow := uint64(ctx.BlockHeight())-round.basedBlock >= uint64(common.MaxNonce)
+				outOfWindow := uint64(ctx.BlockHeight())-round.basedBlock >= uint64(agc.params.MaxNonce)
 				if expired || outOfWindow || force {
 					failed = append(failed, feeder.TokenID)
 					if expired {
@@ -217,8 +240,25 @@ func (agc *AggregatorContext) SealRound(ctx sdk.Context, force bool) (success []
 					sealed = append(sealed, feederID)
 					delete(agc.aggregators, feederID)
 				}
+			case types.ConsensusModeRoundEnd:
+				expired := feeder.EndBlock > 0 && uint64(ctx.BlockHeight()) >= feeder.EndBlock
+				outOfWindow := uint64(ctx.BlockHeight())-round.basedBlock >= uint64(agc.params.MaxNonce)
+				if expired || outOfWindow || force {
+					if item := agc.sealRoundEnd(feederID, round); item != nil {
+						success = append(success, item)
+					} else {
+						failed = append(failed, feeder.TokenID)
+					}
+					if expired {
+						delete(agc.rounds, feederID)
+					} else {
+						round.status = roundStatusClosed
+					}
+					sealed = append(sealed, feederID)
+					delete(agc.aggregators, feederID)
+				}
 			default:
-				ctx.Logger().Info("mode other than 1 is not support now")
+				ctx.Logger().Info("unsupported consensus mode", "mode", mode)
 			}
 		}
 		// all status: 1->2, remove its aggregator
@@ -230,6 +270,32 @@ func (agc *AggregatorContext) SealRound(ctx sdk.Context, force bool) (success []
 	return success, failed, sealed
 }
 
+// sealRoundEnd aggregates the final price of a round at its end for ConsensusModeRoundEnd, it
+// returns nil if the final price can't be determined
+func (agc *AggregatorContext) sealRoundEnd(feederID uint64, round *roundInfo) *PriceItemKV {
+	w := agc.aggregators[feederID]
+	if w == nil || w.sealed {
+		return nil
+	}
+	finalPrice := w.aggregateRoundEnd()
+	if finalPrice == nil {
+		return nil
+	}
+	timestamp := w.a.timestamp
+	w.seal()
+	return &PriceItemKV{
+		TokenID: agc.params.GetTokenFeeder(feederID).TokenID,
+		PriceTR: types.PriceTimeRound{
+			Price:     finalPrice.String(),
+			Decimal:   agc.params.GetTokenInfo(feederID).Decimal,
+			Timestamp: timestamp,
+			RoundID:   round.nextRoundID,
+		},
+		FeederID: feederID,
+		Reports:  w.reports,
+	}
+}
+
 // PrepareEndBlock is called at EndBlock stage, to prepare the roundInfo for the next block(of input block)
 // func (agc *AggregatorContext) PrepareRoundEndBlock(ctx sdk.Context, block uint64) {
 func (agc *AggregatorContext) PrepareRoundEndBlock(block uint64) (newRoundFeederIDs []uint64) {
@@ -259,7 +325,7 @@ func (agc *AggregatorContext) PrepareRoundEndBlock(block uint64) (newRoundFeeder
 				basedBlock:  latestBasedblock,
 				nextRoundID: latestNextRoundID,
 			}
-			if left >= uint64(common.MaxNonce) {
+			if left >= uint64(agc.params.MaxNonce) {
 				// since do sealround properly before prepareRound, this only possible happens in node restart, and nonce has been taken care of in kvStore
 				round.status = roundStatusClosed
 			} else {
@@ -280,7 +346,7 @@ func (agc *AggregatorContext) PrepareRoundEndBlock(block uint64) (newRoundFeeder
 				newRoundFeederIDs = append(newRoundFeederIDs, feederIDUint64)
 				// drop previous worker
 				delete(agc.aggregators, feederIDUint64)
-			} else if round.status == roundStatusOpen && left >= uint64(common.MaxNonce) {
+			} else if round.status == roundStatusOpen && left >= uint64(agc.params.MaxNonce) {
 				// this shouldn't happen, if do sealround properly before prepareRound, basically for test only
 				round.status = roundStatusClosed
 				// TODO: just modify the status here, since sealRound should do all the related seal actions already when parepare invoked
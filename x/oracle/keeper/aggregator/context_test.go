@@ -5,7 +5,6 @@ import (
 	"testing"
 	"time"
 
-	"github.com/ExocoreNetwork/exocore/x/oracle/keeper/common"
 	. "github.com/agiledragon/gomonkey/v2"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	. "github.com/smartystreets/goconvey/convey"
@@ -27,9 +26,9 @@ func TestAggregatorContext(t *testing.T) {
 				Convey("update already exist round info", func() {
 					p.Reset()
 					time.Sleep(1 * time.Second)
-					patchBlockHeight(10 + int64(common.MaxNonce) + 1)
+					patchBlockHeight(10 + int64(defaultParams.MaxNonce) + 1)
 
-					agc.PrepareRoundEndBlock(uint64(10 + common.MaxNonce))
+					agc.PrepareRoundEndBlock(uint64(10 + defaultParams.MaxNonce))
 					So(agc.rounds[1].status, ShouldEqual, 2)
 				})
 				p.Reset()
@@ -37,8 +36,8 @@ func TestAggregatorContext(t *testing.T) {
 			})
 			Convey("pepare outside the window", func() {
 				Convey("for empty round list", func() {
-					p := patchBlockHeight(10 + int64(common.MaxNonce) + 1)
-					agc.PrepareRoundEndBlock(uint64(10 + common.MaxNonce))
+					p := patchBlockHeight(10 + int64(defaultParams.MaxNonce) + 1)
+					agc.PrepareRoundEndBlock(uint64(10 + defaultParams.MaxNonce))
 					So(agc.rounds[1].status, ShouldEqual, 2)
 					p.Reset()
 					time.Sleep(1 * time.Second)
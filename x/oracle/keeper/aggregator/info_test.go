@@ -3,6 +3,7 @@ package aggregator
 import (
 	"math/big"
 
+	"github.com/ExocoreNetwork/exocore/x/oracle/keeper/common"
 	"github.com/ExocoreNetwork/exocore/x/oracle/types"
 )
 
@@ -58,3 +59,5 @@ var defaultParams = types.Params{
 	Mode:         types.ConsensusModeASAP,
 	MaxDetId:     5,
 }
+
+var defaultThreshold = common.Threshold{ThresholdA: defaultParams.ThresholdA, ThresholdB: defaultParams.ThresholdB}
@@ -13,6 +13,12 @@ type worker struct {
 	sealed  bool
 	price   string
 	decimal int32
+	// validator->price reported by the validator, kept when the worker is sealed with a final price
+	reports map[string]*big.Int
+	// consensus mode of the round, which is fixed when the round starts
+	mode types.ConsensusMode
+	// number of sources a report must have prices for to be aggregated
+	requiredSources int
 	// mainly used for deterministic source data to check conflicts and validation
 	f *filter
 	// used to get to consensus on deterministic source's data
@@ -36,17 +42,37 @@ func (w *worker) do(msg *types.MsgCreatePrice) []*types.PriceSource {
 	return list4Aggregator
 }
 
+// aggregate returns the final price once it's determined during the round, which never happens
+// for ConsensusModeRoundEnd since its final price is only aggregated at the end of the round
 func (w *worker) aggregate() *big.Int {
+	if w.mode == types.ConsensusModeRoundEnd {
+		return nil
+	}
 	return w.a.aggregate()
 }
 
+// aggregateRoundEnd returns the final price aggregated at the end of the round for
+// ConsensusModeRoundEnd
+func (w *worker) aggregateRoundEnd() *big.Int {
+	if w.mode != types.ConsensusModeRoundEnd {
+		return nil
+	}
+	return w.a.aggregateRoundEnd(w.requiredSources)
+}
+
 // not concurrency safe
 func (w *worker) seal() {
 	if w.sealed {
 		return
 	}
 	w.sealed = true
-	w.price = w.a.aggregate().String()
+	w.price = w.a.finalPrice.String()
+	w.reports = make(map[string]*big.Int, len(w.a.reports))
+	for _, report := range w.a.reports {
+		if report.price != nil {
+			w.reports[report.validator] = new(big.Int).Set(report.price)
+		}
+	}
 	w.f = nil
 	w.c = nil
 	w.a = nil
@@ -54,11 +80,14 @@ func (w *worker) seal() {
 
 // newWorker new a instance for a tokenFeeder's specific round
 func newWorker(feederID uint64, agc *AggregatorContext) *worker {
+	threshold := common.Threshold{ThresholdA: agc.params.ThresholdA, ThresholdB: agc.params.ThresholdB}
 	return &worker{
-		f:       newFilter(int(common.MaxNonce), int(common.MaxDetID)),
-		c:       newCalculator(len(agc.validatorsPower), agc.totalPower),
-		a:       newAggregator(len(agc.validatorsPower), agc.totalPower),
-		decimal: agc.params.GetTokenInfo(feederID).Decimal,
-		ctx:     agc,
+		f:               newFilter(int(agc.params.MaxNonce), int(agc.params.MaxDetId)),
+		c:               newCalculator(len(agc.validatorsPower), agc.totalPower, threshold, int(agc.params.MaxDetId), agc.params.Mode),
+		a:               newAggregator(len(agc.validatorsPower), agc.totalPower, threshold, agc.params.AggregationMethod, agc.params.TrimFraction),
+		decimal:         agc.params.GetTokenInfo(feederID).Decimal,
+		mode:            agc.params.Mode,
+		requiredSources: agc.params.RequiredSourceCount(feederID),
+		ctx:             agc,
 	}
 }
@@ -65,11 +65,12 @@ func (c cacheMsgs) commit(ctx sdk.Context, k common.KeeperOracle) {
 		recentMsgs.Msgs = append(recentMsgs.Msgs, &msgTmp)
 	}
 	index, _ := k.GetIndexRecentMsg(ctx)
+	maxNonce := k.GetParams(ctx).MaxNonce
 
 	i := 0
 	for ; i < len(index.Index); i++ {
 		b := index.Index[i]
-		if b > block-uint64(common.MaxNonce) {
+		if b > block-uint64(maxNonce) {
 			break
 		}
 		k.RemoveRecentMsg(ctx, b)
@@ -115,10 +116,11 @@ func (c *cacheParams) add(p ItemP) {
 func (c *cacheParams) commit(ctx sdk.Context, k common.KeeperOracle) {
 	block := uint64(ctx.BlockHeight())
 	index, _ := k.GetIndexRecentParams(ctx)
+	maxNonce := k.GetParams(ctx).MaxNonce
 	i := 0
 	for ; i < len(index.Index); i++ {
 		b := index.Index[i]
-		if b >= block-uint64(common.MaxNonce) {
+		if b >= block-uint64(maxNonce) {
 			break
 		}
 		k.RemoveRecentParams(ctx, b)
@@ -1,6 +1,7 @@
 package common
 
 import (
+	"math/big"
 	"testing"
 
 	"cosmossdk.io/math"
@@ -27,3 +28,42 @@ func TestMock(t *testing.T) {
 		Convey("GetLastTotalPower", func() { So(x, ShouldResemble, math.NewInt(99)) })
 	})
 }
+
+func TestWeightedMedian(t *testing.T) {
+	Convey("power-weighted median of prices", t, func() {
+		newList := func(pricePowers ...int64) PricePowerList {
+			l := make(PricePowerList, 0, len(pricePowers)/2)
+			for i := 0; i < len(pricePowers); i += 2 {
+				l = append(l, &PricePower{Price: big.NewInt(pricePowers[i]), Power: big.NewInt(pricePowers[i+1])})
+			}
+			return l
+		}
+		So(PricePowerList{}.WeightedMedian(), ShouldBeNil)
+		// equal powers are the same as Median
+		So(newList(30, 1, 10, 1, 20, 1).WeightedMedian(), ShouldResemble, big.NewInt(20))
+		So(newList(30, 1, 10, 1, 20, 1, 40, 1).WeightedMedian(), ShouldResemble, big.NewInt(25))
+		// the price reported by the majority of the power
+		So(newList(10, 1, 20, 1, 100, 3).WeightedMedian(), ShouldResemble, big.NewInt(100))
+		So(newList(10, 3, 20, 1, 100, 1).WeightedMedian(), ShouldResemble, big.NewInt(10))
+	})
+}
+
+func TestTrimmedMean(t *testing.T) {
+	Convey("power-weighted trimmed mean of prices", t, func() {
+		newList := func(pricePowers ...int64) PricePowerList {
+			l := make(PricePowerList, 0, len(pricePowers)/2)
+			for i := 0; i < len(pricePowers); i += 2 {
+				l = append(l, &PricePower{Price: big.NewInt(pricePowers[i]), Power: big.NewInt(pricePowers[i+1])})
+			}
+			return l
+		}
+		So(PricePowerList{}.TrimmedMean(sdk.NewDecWithPrec(1, 1)), ShouldBeNil)
+		// the outliers are trimmed entirely
+		So(newList(1000, 1, 10, 3, 20, 3, 1, 1).TrimmedMean(sdk.NewDecWithPrec(125, 3)), ShouldResemble, big.NewInt(15))
+		// the power of the boundary prices is trimmed partially: (10*1+20*3+30*1)/5
+		So(newList(30, 2, 10, 2, 20, 3).TrimmedMean(sdk.NewDecWithPrec(15, 2)), ShouldResemble, big.NewInt(20))
+		So(newList(30, 2, 10, 1, 20, 3).TrimmedMean(sdk.NewDecWithPrec(2, 1)), ShouldResemble, big.NewInt(22))
+		// the mean of all prices without trimming
+		So(newList(10, 1, 20, 1, 40, 2).TrimmedMean(sdk.ZeroDec()), ShouldResemble, big.NewInt(27))
+	})
+}
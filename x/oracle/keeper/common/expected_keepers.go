@@ -58,4 +58,8 @@ type KeeperDogfood = interface {
 	GetValidatorByConsAddr(ctx sdk.Context, consAddr sdk.ConsAddress) (validator stakingTypes.Validator, found bool)
 
 	GetAllExocoreValidators(ctx sdk.Context) (validators []dogfoodtypes.ExocoreValidator)
+
+	Jail(ctx sdk.Context, addr sdk.ConsAddress)
+	IsValidatorJailed(ctx sdk.Context, addr sdk.ConsAddress) bool
+	SlashWithInfractionReason(ctx sdk.Context, addr sdk.ConsAddress, infractionHeight, power int64, slashFactor sdk.Dec, infraction stakingTypes.Infraction) sdkmath.Int
 }
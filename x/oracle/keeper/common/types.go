@@ -4,23 +4,7 @@ import (
 	"math/big"
 	"sort"
 
-	"github.com/ExocoreNetwork/exocore/x/oracle/types"
-)
-
-var (
-	// maxNonce indicates how many messages a validator can submit in a single round to offer price
-	// current we use this as a mock distance
-	MaxNonce int32 = 3
-
-	// these two threshold value used to set the threshold to tell when the price had come to consensus and was able to get a final price of that round
-	ThresholdA int32 = 2
-	ThresholdB int32 = 3
-
-	// maxDetId each validator can submit, so the calculator can cache maximum of maxDetId*count(validators) values, this is for resistance of malicious validator submmiting invalid detId
-	MaxDetID int32 = 5
-
-	// consensus mode: v1: as soon as possbile
-	Mode types.ConsensusMode = types.ConsensusModeASAP
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 type Set[T comparable] struct {
@@ -67,8 +51,15 @@ func NewSet[T comparable](length int) *Set[T] {
 	}
 }
 
-func ExceedsThreshold(power *big.Int, totalPower *big.Int) bool {
-	return new(big.Int).Mul(power, big.NewInt(int64(ThresholdB))).Cmp(new(big.Int).Mul(totalPower, big.NewInt(int64(ThresholdA)))) > 0
+// Threshold is set to tell when the price had come to consensus and was able to get a final price of that round, which is
+// when the power exceeds ThresholdA/ThresholdB of the total power
+type Threshold struct {
+	ThresholdA int32
+	ThresholdB int32
+}
+
+func (t Threshold) Exceeds(power *big.Int, totalPower *big.Int) bool {
+	return new(big.Int).Mul(power, big.NewInt(int64(t.ThresholdB))).Cmp(new(big.Int).Mul(totalPower, big.NewInt(int64(t.ThresholdA)))) > 0
 }
 
 type BigIntList []*big.Int
@@ -93,3 +84,109 @@ func (b BigIntList) Median() *big.Int {
 	}
 	return new(big.Int).Div(new(big.Int).Add(b[l/2], b[l/2-1]), big.NewInt(2))
 }
+
+// PricePower is a price with the power of the validator reporting it
+type PricePower struct {
+	Price *big.Int
+	Power *big.Int
+}
+
+type PricePowerList []*PricePower
+
+// Median returns the median of the prices, with every price counted equally regardless of its
+// power
+func (l PricePowerList) Median() *big.Int {
+	if len(l) == 0 {
+		return nil
+	}
+	prices := make(BigIntList, 0, len(l))
+	for _, item := range l {
+		prices = append(prices, item.Price)
+	}
+	return prices.Median()
+}
+
+// WeightedMedian returns the power-weighted median of the prices, which is the lowest price at
+// which the accumulated power of the prices in ascending order exceeds half of the total power.
+// If the accumulated power equals exactly half of the total power, the average of that price
+// and the next higher one is returned, so that it equals Median when all powers are the same.
+func (l PricePowerList) WeightedMedian() *big.Int {
+	if len(l) == 0 {
+		return nil
+	}
+	sorted, totalPower := l.sorted()
+	accPower := big.NewInt(0)
+	for i, item := range sorted {
+		accPower.Add(accPower, item.Power)
+		switch new(big.Int).Mul(accPower, big.NewInt(2)).Cmp(totalPower) {
+		case 1:
+			return item.Price
+		case 0:
+			if i+1 < len(sorted) {
+				return new(big.Int).Div(new(big.Int).Add(item.Price, sorted[i+1].Price), big.NewInt(2))
+			}
+			return item.Price
+		}
+	}
+	return sorted[len(sorted)-1].Price
+}
+
+// TrimmedMean returns the power-weighted mean of the prices after trimFraction of the total
+// power is trimmed from each end of the prices in ascending order, the power of a price on the
+// boundary is trimmed partially. The mean is truncated to an integer. It returns the
+// WeightedMedian if nothing is left after trimming.
+func (l PricePowerList) TrimmedMean(trimFraction sdk.Dec) *big.Int {
+	if len(l) == 0 {
+		return nil
+	}
+	sorted, totalPower := l.sorted()
+	trimPower := sdk.NewDecFromBigInt(totalPower).Mul(trimFraction).TruncateInt().BigInt()
+	// the accumulated power in (lower, upper] is kept
+	lower := trimPower
+	upper := new(big.Int).Sub(totalPower, trimPower)
+	keptPower := new(big.Int).Sub(upper, lower)
+	if keptPower.Sign() <= 0 {
+		return l.WeightedMedian()
+	}
+	sum := big.NewInt(0)
+	accPower := big.NewInt(0)
+	for _, item := range sorted {
+		start := new(big.Int).Set(accPower)
+		accPower.Add(accPower, item.Power)
+		// the overlap of (start, accPower] and (lower, upper]
+		from, to := bigMax(start, lower), bigMin(accPower, upper)
+		if to.Cmp(from) > 0 {
+			sum.Add(sum, new(big.Int).Mul(item.Price, new(big.Int).Sub(to, from)))
+		}
+	}
+	return sum.Quo(sum, keptPower)
+}
+
+// sorted returns a copy of the list sorted by price in ascending order and the total power, the
+// prices are compared only, so equal prices keep their order in the list
+func (l PricePowerList) sorted() (PricePowerList, *big.Int) {
+	sorted := make(PricePowerList, len(l))
+	copy(sorted, l)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].Price.Cmp(sorted[j].Price) < 0
+	})
+	totalPower := big.NewInt(0)
+	for _, item := range sorted {
+		totalPower.Add(totalPower, item.Power)
+	}
+	return sorted, totalPower
+}
+
+func bigMax(a, b *big.Int) *big.Int {
+	if a.Cmp(b) > 0 {
+		return a
+	}
+	return b
+}
+
+func bigMin(a, b *big.Int) *big.Int {
+	if a.Cmp(b) < 0 {
+		return a
+	}
+	return b
+}
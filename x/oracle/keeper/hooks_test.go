@@ -0,0 +1,50 @@
+package keeper_test
+
+import (
+	"math/big"
+	"testing"
+
+	keepertest "github.com/ExocoreNetwork/exocore/testutil/keeper"
+	"github.com/ExocoreNetwork/exocore/x/oracle/keeper/aggregator"
+	"github.com/ExocoreNetwork/exocore/x/oracle/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+// recordHooks records the calls of the oracle hooks
+type recordHooks struct {
+	updated []types.PriceTimeRound
+	failed  []uint64
+}
+
+func (h *recordHooks) AfterPriceUpdated(_ sdk.Context, _, _ uint64, price types.PriceTimeRound) {
+	h.updated = append(h.updated, price)
+}
+
+func (h *recordHooks) AfterRoundFailed(_ sdk.Context, _, roundID uint64) {
+	h.failed = append(h.failed, roundID)
+}
+
+func TestOracleHooks(t *testing.T) {
+	keeper, ctx := keepertest.OracleKeeper(t)
+	hooks := &recordHooks{}
+	keeper.SetHooks(types.NewMultiOracleHooks(hooks))
+
+	priceTR := types.PriceTimeRound{Price: "100", Decimal: 8, RoundID: 1}
+	keeper.AppendFinalPrice(ctx, &aggregator.PriceItemKV{TokenID: 1, PriceTR: priceTR, FeederID: 1}, map[string]*big.Int{})
+	require.Equal(t, []types.PriceTimeRound{priceTR}, hooks.updated)
+	require.Empty(t, hooks.failed)
+
+	// the round without a final price carries the previous price over
+	price, roundID := keeper.GrowRoundID(ctx, 1)
+	require.Equal(t, "100", price)
+	require.Equal(t, uint64(2), roundID)
+	require.Len(t, hooks.updated, 1)
+	require.Equal(t, []uint64{2}, hooks.failed)
+
+	// the final price with a mismatched round grows the round instead
+	priceTR.RoundID = 5
+	keeper.AppendFinalPrice(ctx, &aggregator.PriceItemKV{TokenID: 1, PriceTR: priceTR, FeederID: 1}, map[string]*big.Int{})
+	require.Len(t, hooks.updated, 1)
+	require.Equal(t, []uint64{2, 3}, hooks.failed)
+}
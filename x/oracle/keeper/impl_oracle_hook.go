@@ -0,0 +1,42 @@
+package keeper
+
+import (
+	assetstypes "github.com/ExocoreNetwork/exocore/x/assets/types"
+	"github.com/ExocoreNetwork/exocore/x/oracle/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NSTHooksWrapper is the wrapper structure that implements the oracle hooks to update the
+// balances of the native-restaking stakers.
+type NSTHooksWrapper struct {
+	keeper *Keeper
+}
+
+// Interface guard
+var _ types.OracleHooks = NSTHooksWrapper{}
+
+// NSTHooks returns the oracle hooks wrapper for the native-restaking tokens. It follows the
+// "accept interfaces, return concretes" pattern.
+func (k *Keeper) NSTHooks() NSTHooksWrapper {
+	return NSTHooksWrapper{k}
+}
+
+// AfterPriceUpdated applies the balance changes of the stakers carried in the price of a
+// native-restaking token.
+func (wrapper NSTHooksWrapper) AfterPriceUpdated(
+	ctx sdk.Context, tokenID, roundID uint64, price types.PriceTimeRound,
+) {
+	for _, assetID := range wrapper.keeper.GetAssetIDsFromTokenID(ctx, tokenID) {
+		if !assetstypes.IsNST(assetID) {
+			continue
+		}
+		if err := wrapper.keeper.UpdateNSTByBalanceChange(ctx, assetID, []byte(price.Price), roundID); err != nil {
+			// we just report this error in log to notify validators
+			wrapper.keeper.Logger(ctx).Error(types.ErrUpdateNativeTokenVirtualPriceFail.Error(), "error", err)
+		}
+	}
+}
+
+// AfterRoundFailed is a no-op, since the balance changes carried in the previous price have
+// been applied.
+func (wrapper NSTHooksWrapper) AfterRoundFailed(sdk.Context, uint64, uint64) {}
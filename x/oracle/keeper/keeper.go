@@ -25,6 +25,10 @@ type (
 		common.KeeperDogfood
 		delegationKeeper types.DelegationKeeper
 		assetsKeeper     types.AssetsKeeper
+		slashingKeeper   types.SlashingKeeper
+		hooks            types.OracleHooks
+		// memState is the in-memory state shared by all the copies of the keeper
+		memState *memoryState
 	}
 )
 
@@ -38,6 +42,7 @@ func NewKeeper(
 	sKeeper common.KeeperDogfood,
 	delegationKeeper types.DelegationKeeper,
 	assetsKeeper types.AssetsKeeper,
+	slashingKeeper types.SlashingKeeper,
 	authority string,
 ) Keeper {
 	// ensure authority is a valid bech32 address
@@ -57,10 +62,32 @@ func NewKeeper(
 		KeeperDogfood:    sKeeper,
 		delegationKeeper: delegationKeeper,
 		assetsKeeper:     assetsKeeper,
+		slashingKeeper:   slashingKeeper,
 		authority:        authority,
+		memState:         &memoryState{},
 	}
 }
 
+// SetHooks stores the given hooks implementations.
+// Note that the Keeper is changed into a pointer to prevent an ineffective assignment.
+func (k *Keeper) SetHooks(hooks types.OracleHooks) {
+	if hooks == nil {
+		panic("cannot set nil hooks")
+	}
+	if k.hooks != nil {
+		panic("cannot set hooks twice")
+	}
+	k.hooks = hooks
+}
+
+func (k Keeper) Hooks() types.OracleHooks {
+	if k.hooks == nil {
+		// return a no-op implementation if no hooks are set to prevent calling nil functions
+		return types.MultiOracleHooks{}
+	}
+	return k.hooks
+}
+
 func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
 }
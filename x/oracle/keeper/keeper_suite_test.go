@@ -67,7 +67,7 @@ func TestKeeper(t *testing.T) {
 
 	suite.Run(t, ks)
 
-	resetSingle()
+	resetSingle(ks.k)
 	RegisterFailHandler(Fail)
 	RunSpecs(t, "Keeper Suite")
 }
@@ -77,7 +77,7 @@ func (suite *KeeperSuite) Reset() {
 	suite.ms, ctxW, suite.k = setupMsgServer(suite.t)
 	suite.ctx = sdk.UnwrapSDKContext(ctxW)
 	suite.ctrl = gomock.NewController(suite.t)
-	resetSingle()
+	resetSingle(suite.k)
 }
 
 func (suite *KeeperSuite) SetupTest() {
@@ -99,10 +99,10 @@ func (suite *KeeperSuite) SetupTest() {
 	validators := suite.ValSet.Validators
 	suite.valAddr1, _ = sdk.ValAddressFromBech32(sdk.ValAddress(validators[0].Address).String())
 	suite.valAddr2, _ = sdk.ValAddressFromBech32(sdk.ValAddress(validators[1].Address).String())
-	resetSingle()
+	resetSingle(suite.App.OracleKeeper)
 }
 
-func resetSingle() {
-	keeper.ResetAggregatorContext()
-	keeper.ResetCache()
+func resetSingle(k keeper.Keeper) {
+	k.ResetAggregatorContext()
+	k.ResetCache()
 }
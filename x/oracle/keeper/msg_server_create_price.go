@@ -30,7 +30,7 @@ func (ms msgServer) CreatePrice(goCtx context.Context, msg *types.MsgCreatePrice
 		return nil, types.ErrPriceProposalFormatInvalid.Wrap(err.Error())
 	}
 
-	agc := GetAggregatorContext(ctx, ms.Keeper)
+	agc := ms.Keeper.GetAggregatorContext(ctx)
 	newItem, caches, err := agc.NewCreatePrice(ctx, msg)
 	if err != nil {
 		logger.Info("price proposal failed", "error", err, "height", ctx.BlockHeight(), "feederID", msg.FeederID)
@@ -51,30 +51,15 @@ func (ms msgServer) CreatePrice(goCtx context.Context, msg *types.MsgCreatePrice
 		return &types.MsgCreatePriceResponse{}, nil
 	}
 	if newItem != nil {
-		if success := ms.AppendPriceTR(ctx, newItem.TokenID, newItem.PriceTR); !success {
-			// This case should not exist, keep this line to avoid consensus fail if this happens
-			prevPrice, nextRoundID := ms.GrowRoundID(ctx, newItem.TokenID)
-			logger.Error("append new price round fail for mismatch roundID, and will just grow roundID with previous price", "roundID from finalPrice", newItem.PriceTR.RoundID, "expect nextRoundID", nextRoundID, "prevPrice", prevPrice)
-		} else {
-			logger.Info("final price aggregation done", "feederID", msg.FeederID, "roundID", newItem.PriceTR.RoundID, "price", newItem.PriceTR.Price)
-		}
+		ms.Keeper.AppendFinalPrice(ctx, newItem, agc.GetValidatorPowers())
+		ms.Keeper.UpdateValidatorReportInfos(ctx, msg.FeederID, agc.GetValidatorPowers())
 		ms.Keeper.RemoveNonceWithFeederIDForValidators(ctx, msg.FeederID, agc.GetValidators())
 
-		decimalStr := strconv.FormatInt(int64(newItem.PriceTR.Decimal), 10)
-		tokenIDStr := strconv.FormatUint(newItem.TokenID, 10)
-		roundIDStr := strconv.FormatUint(newItem.PriceTR.RoundID, 10)
-		ctx.EventManager().EmitEvent(sdk.NewEvent(
-			types.EventTypeCreatePrice,
-			sdk.NewAttribute(types.AttributeKeyRoundID, roundIDStr),
-			sdk.NewAttribute(types.AttributeKeyFinalPrice, tokenIDStr+"_"+roundIDStr+"_"+newItem.PriceTR.Price+"_"+decimalStr),
-			sdk.NewAttribute(types.AttributeKeyPriceUpdated, types.AttributeValuePriceUpdatedSuccess)),
-		)
 		if !ctx.IsCheckTx() {
-			cs.RemoveCache(caches)
+			ms.Keeper.GetCaches().RemoveCache(caches)
 		}
-		AppendUpdatedFeederIDs(msg.FeederID)
 	} else if !ctx.IsCheckTx() {
-		cs.AddCache(caches)
+		ms.Keeper.GetCaches().AddCache(caches)
 	}
 
 	return &types.MsgCreatePriceResponse{}, nil
@@ -6,7 +6,6 @@ import (
 	math "cosmossdk.io/math"
 	dogfoodkeeper "github.com/ExocoreNetwork/exocore/x/dogfood/keeper"
 	dogfoodtypes "github.com/ExocoreNetwork/exocore/x/dogfood/types"
-	"github.com/ExocoreNetwork/exocore/x/oracle/keeper"
 	"github.com/ExocoreNetwork/exocore/x/oracle/keeper/cache"
 	"github.com/ExocoreNetwork/exocore/x/oracle/keeper/testdata"
 	"github.com/ExocoreNetwork/exocore/x/oracle/types"
@@ -28,6 +27,7 @@ var _ = Describe("MsgCreatePrice", func() {
 
 		// TODO: remove monkey patch for test
 		p = ApplyMethod(reflect.TypeOf(dogfoodkeeper.Keeper{}), "GetLastTotalPower", func(k dogfoodkeeper.Keeper, ctx sdk.Context) math.Int { return math.NewInt(3) })
+		p.ApplyMethod(reflect.TypeOf(dogfoodkeeper.Keeper{}), "IsValidatorJailed", func(k dogfoodkeeper.Keeper, ctx sdk.Context, addr sdk.ConsAddress) bool { return false })
 		p.ApplyMethod(reflect.TypeOf(dogfoodkeeper.Keeper{}), "GetAllExocoreValidators", func(k dogfoodkeeper.Keeper, ctx sdk.Context) []dogfoodtypes.ExocoreValidator {
 			return []dogfoodtypes.ExocoreValidator{
 				{
@@ -65,7 +65,7 @@ var _ = Describe("MsgCreatePrice", func() {
 				Nonce:      1,
 			})
 
-			c = keeper.GetCaches()
+			c = ks.k.GetCaches()
 			var pRes cache.ItemP
 			c.GetCache(&pRes)
 			p4Test := types.DefaultParams()
@@ -113,6 +113,13 @@ var _ = Describe("MsgCreatePrice", func() {
 					},
 				},
 			}))
+			// the deviation of each reporting validator from the final price is recorded
+			for _, consAddr := range []sdk.AccAddress{ks.mockConsAddr1, ks.mockConsAddr2, ks.mockConsAddr3} {
+				info, found := ks.k.GetValidatorDeviationInfo(sdk.UnwrapSDKContext(ks.ctx), sdk.ConsAddress(consAddr).String(), 1)
+				Expect(found).Should(BeTrue())
+				Expect(info.RoundID).Should(Equal(uint64(1)))
+				Expect(info.FinalPrice).Should(Equal(testdata.PTD2.Price))
+			}
 		})
 	})
 })
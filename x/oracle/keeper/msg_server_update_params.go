@@ -54,6 +54,18 @@ func (ms msgServer) UpdateParams(goCtx context.Context, msg *types.MsgUpdatePara
 	if p, err = p.UpdateMaxPriceCount(msg.Params.MaxSizePrices); err != nil {
 		return nil, err
 	}
+	// update consensus mode
+	if p, err = p.UpdateMode(msg.Params.Mode); err != nil {
+		return nil, err
+	}
+	// update aggregation method
+	if p, err = p.UpdateAggregation(msg.Params.AggregationMethod, msg.Params.TrimFraction); err != nil {
+		return nil, err
+	}
+	// update slashing params
+	if p, err = p.UpdateSlashingParams(msg.Params.Slashing); err != nil {
+		return nil, err
+	}
 	// udpate tokenFeeders
 	for _, tokenFeeder := range msg.Params.TokenFeeders {
 		if p, err = p.UpdateTokenFeeder(tokenFeeder, height); err != nil {
@@ -66,7 +78,7 @@ func (ms msgServer) UpdateParams(goCtx context.Context, msg *types.MsgUpdatePara
 	}
 	// set updated new params
 	ms.SetParams(ctx, p)
-	_ = GetAggregatorContext(ctx, ms.Keeper)
-	cs.AddCache(cache.ItemP(p))
+	_ = ms.Keeper.GetAggregatorContext(ctx)
+	ms.Keeper.GetCaches().AddCache(cache.ItemP(p))
 	return &types.MsgUpdateParamsResponse{}, nil
 }
@@ -16,8 +16,11 @@ import (
 var _ = Describe("MsgUpdateParams", Ordered, func() {
 	var defaultParams types.Params
 	var patcher *Patches
-	AfterAll(func() {
+	AfterEach(func() {
+		// the patch is applied again for each spec
 		patcher.Reset()
+	})
+	AfterAll(func() {
 		ks.Reset()
 	})
 	BeforeEach(func() {
@@ -151,6 +154,23 @@ var _ = Describe("MsgUpdateParams", Ordered, func() {
 		}
 	})
 
+	Context("update the max price age of tokens", func() {
+		It("set and disable the max price age", func() {
+			_, err := ks.ms.UpdateParams(ks.ctx, types.NewMsgUpdateParams("", `{"tokens":[{"name":"ETH", "chain_id":"1", "max_price_age":"5"}]}`))
+			Expect(err).Should(BeNil())
+			Expect(ks.k.GetParams(ks.ctx).Tokens[1].MaxPriceAge).Should(BeEquivalentTo(5))
+			// a zero max price age leaves it unchanged.
+			_, err = ks.ms.UpdateParams(ks.ctx, types.NewMsgUpdateParams("", `{"tokens":[{"name":"ETH", "chain_id":"1"}]}`))
+			Expect(err).Should(BeNil())
+			Expect(ks.k.GetParams(ks.ctx).Tokens[1].MaxPriceAge).Should(BeEquivalentTo(5))
+			_, err = ks.ms.UpdateParams(ks.ctx, types.NewMsgUpdateParams("", `{"tokens":[{"name":"ETH", "chain_id":"1", "disable_max_price_age":true}]}`))
+			Expect(err).Should(BeNil())
+			token := ks.k.GetParams(ks.ctx).Tokens[1]
+			Expect(token.MaxPriceAge).Should(BeZero())
+			Expect(token.DisableMaxPriceAge).Should(BeFalse())
+		})
+	})
+
 	Context("update maxSizePrices", func() {
 		It("update maxSizePrices", func() {
 			_, err := ks.ms.UpdateParams(ks.ctx, &types.MsgUpdateParams{
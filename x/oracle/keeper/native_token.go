@@ -11,6 +11,7 @@ import (
 	"github.com/ExocoreNetwork/exocore/x/oracle/types"
 	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 )
 
@@ -49,22 +50,26 @@ func (k Keeper) GetStakerInfo(ctx sdk.Context, assetID, stakerAddr string) types
 	return stakerInfo
 }
 
-// TODO: pagination
-// GetStakerInfos returns all stakers information
-func (k Keeper) GetStakerInfos(ctx sdk.Context, assetID string) (ret []*types.StakerInfo) {
-	store := ctx.KVStore(k.storeKey)
-	iterator := sdk.KVStorePrefixIterator(store, types.NativeTokenStakerKeyPrefix(assetID))
-	defer iterator.Close()
-	for ; iterator.Valid(); iterator.Next() {
+// GetStakerInfos returns the information of the stakers in the page
+func (k Keeper) GetStakerInfos(ctx sdk.Context, assetID string, pagination *query.PageRequest) ([]*types.StakerInfo, *query.PageResponse, error) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.NativeTokenStakerKeyPrefix(assetID))
+	ret := make([]*types.StakerInfo, 0)
+	pageRes, err := query.Paginate(store, pagination, func(_ []byte, value []byte) error {
 		sInfo := types.StakerInfo{}
-		k.cdc.MustUnmarshal(iterator.Value(), &sInfo)
+		if err := k.cdc.Unmarshal(value, &sInfo); err != nil {
+			return err
+		}
 		// keep only the latest effective-balance
 		sInfo.BalanceList = sInfo.BalanceList[:len(sInfo.BalanceList)-1]
 		// this is mainly used by price feeder, so we remove the stakerAddr to reduce the size of return value
 		sInfo.StakerAddr = ""
 		ret = append(ret, &sInfo)
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
 	}
-	return ret
+	return ret, pageRes, nil
 }
 
 // GetAllStakerInfosAssets returns all stakerInfos combined with assetIDs they belong to, used for genesisstate exporting
@@ -3,7 +3,6 @@ package keeper
 import (
 	"errors"
 
-	"github.com/ExocoreNetwork/exocore/x/oracle/keeper/common"
 	"github.com/ExocoreNetwork/exocore/x/oracle/types"
 	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -110,7 +109,7 @@ func (k Keeper) RemoveNonceWithFeederIDForAll(ctx sdk.Context, feederID uint64)
 
 // CheckAndIncreaseNonce check and increase the nonce for a specific validator and feederID
 func (k Keeper) CheckAndIncreaseNonce(ctx sdk.Context, validator string, feederID uint64, nonce uint32) (prevNonce uint32, err error) {
-	if nonce > uint32(common.MaxNonce) {
+	if nonce > uint32(k.GetParams(ctx).MaxNonce) {
 		return 0, errors.New("nonce is too large")
 	}
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.NonceKeyPrefix))
@@ -5,6 +5,7 @@ import (
 
 	testkeeper "github.com/ExocoreNetwork/exocore/testutil/keeper"
 	"github.com/ExocoreNetwork/exocore/x/oracle/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
 )
 
@@ -294,3 +295,108 @@ func TestTokenFeederValidate(t *testing.T) {
 		})
 	}
 }
+
+func TestCheckRules(t *testing.T) {
+	p := types.DefaultParams()
+	p.Sources = append(p.Sources,
+		&types.Source{Name: "Binance", Valid: true},
+		&types.Source{Name: "Coinbase", Valid: true},
+	)
+	p.Rules = append(p.Rules,
+		// 2 out of sources 2 and 3
+		&types.RuleSource{Nom: &types.NOMSource{Minimum: 2, SourceIDs: []uint64{2, 3}}},
+		// 1 out of all sources
+		&types.RuleSource{Nom: &types.NOMSource{Minimum: 1, SourceIDs: []uint64{0}}},
+	)
+	p.Tokens = append(p.Tokens,
+		&types.Token{Name: "TEST", ChainID: 1, ContractAddress: "0x", Decimal: 8, Active: true},
+		&types.Token{Name: "TEST_NEW", ChainID: 1, ContractAddress: "0x", Decimal: 8, Active: true},
+	)
+	p.TokenFeeders = append(p.TokenFeeders,
+		&types.TokenFeeder{TokenID: 2, RuleID: 2, StartRoundID: 1, StartBaseBlock: 10, Interval: 10},
+		&types.TokenFeeder{TokenID: 3, RuleID: 3, StartRoundID: 1, StartBaseBlock: 10, Interval: 10},
+	)
+	require.NoError(t, p.Validate())
+
+	prices := func(sourceIDs ...uint64) []*types.PriceSource {
+		ret := make([]*types.PriceSource, 0, len(sourceIDs))
+		for _, sourceID := range sourceIDs {
+			ret = append(ret, &types.PriceSource{SourceID: sourceID})
+		}
+		return ret
+	}
+	cases := []struct {
+		name     string
+		feederID uint64
+		prices   []*types.PriceSource
+		accepted bool
+	}{
+		{"all sources required, all reported", 1, prices(1, 2, 3), true},
+		{"all sources required, missing one", 1, prices(1, 2), false},
+		{"2 out of 2 sources, reached", 2, prices(3, 2), true},
+		{"2 out of 2 sources, not reached", 2, prices(2), false},
+		{"2 out of 2 sources, duplicated sources", 2, prices(2, 2), false},
+		{"2 out of 2 sources, source not in rule", 2, prices(1, 2, 3), false},
+		{"1 out of all sources", 3, prices(3), true},
+		{"1 out of all sources, unknown source", 3, prices(4), false},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			accepted, err := p.CheckRules(c.feederID, c.prices)
+			require.Equal(t, c.accepted, accepted)
+			require.Equal(t, c.accepted, err == nil)
+		})
+	}
+	require.Equal(t, 3, p.RequiredSourceCount(1))
+	require.Equal(t, 2, p.RequiredSourceCount(2))
+	require.Equal(t, 1, p.RequiredSourceCount(3))
+
+	// the minimum of sources must be reachable
+	p.Rules[2].Nom.Minimum = 3
+	require.ErrorIs(t, p.Validate(), types.ErrInvalidParams)
+}
+
+func TestUpdateAggregation(t *testing.T) {
+	p := types.DefaultParams()
+	require.Equal(t, types.AggregationMethodWeightedMedian, p.AggregationMethod)
+
+	// nothing provided leaves the aggregation unchanged
+	updated, err := p.UpdateAggregation(types.AggregationMethodUnspecified, sdk.Dec{})
+	require.NoError(t, err)
+	require.Equal(t, p.AggregationMethod, updated.AggregationMethod)
+	require.Equal(t, p.TrimFraction, updated.TrimFraction)
+
+	updated, err = p.UpdateAggregation(types.AggregationMethodTrimmedMean, sdk.NewDecWithPrec(2, 1))
+	require.NoError(t, err)
+	require.NoError(t, updated.Validate())
+	require.Equal(t, types.AggregationMethodTrimmedMean, updated.AggregationMethod)
+	require.Equal(t, sdk.NewDecWithPrec(2, 1), updated.TrimFraction)
+
+	_, err = p.UpdateAggregation(types.AggregationMethod(4), sdk.Dec{})
+	require.ErrorIs(t, err, types.ErrInvalidParams)
+	// trimming half of the power leaves no price
+	updated, err = p.UpdateAggregation(types.AggregationMethodTrimmedMean, sdk.NewDecWithPrec(5, 1))
+	require.NoError(t, err)
+	require.ErrorIs(t, updated.Validate(), types.ErrInvalidParams)
+}
+
+func TestValidateLegacyAggregation(t *testing.T) {
+	// the params stored before the aggregation method was introduced aggregate by the median
+	p := types.DefaultParams()
+	p.AggregationMethod = types.AggregationMethodUnspecified
+	p.TrimFraction = sdk.Dec{}
+	require.NoError(t, p.Validate())
+
+	// a governance update of other params keeps them valid
+	updated, err := p.UpdateAggregation(types.AggregationMethodUnspecified, sdk.Dec{})
+	require.NoError(t, err)
+	require.NoError(t, updated.Validate())
+
+	// the trimmed mean can't be used without the trim fraction
+	updated, err = p.UpdateAggregation(types.AggregationMethodTrimmedMean, sdk.Dec{})
+	require.NoError(t, err)
+	require.ErrorIs(t, updated.Validate(), types.ErrInvalidParams)
+	updated, err = p.UpdateAggregation(types.AggregationMethodTrimmedMean, sdk.NewDecWithPrec(1, 1))
+	require.NoError(t, err)
+	require.NoError(t, updated.Validate())
+}
@@ -2,9 +2,12 @@ package keeper
 
 import (
 	"encoding/binary"
+	"math/big"
+	"strconv"
 
 	sdkmath "cosmossdk.io/math"
 	assetstypes "github.com/ExocoreNetwork/exocore/x/assets/types"
+	"github.com/ExocoreNetwork/exocore/x/oracle/keeper/aggregator"
 	"github.com/ExocoreNetwork/exocore/x/oracle/types"
 	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -45,60 +48,62 @@ func (k Keeper) GetPrices(
 	return
 }
 
-// return latest price for one specified price
+// GetSpecifiedAssetsPrice returns the latest price of an asset. The price which is missing or
+// stale is unavailable, and an ErrGetPriceRoundNotFound is returned instead of a default price.
 func (k Keeper) GetSpecifiedAssetsPrice(ctx sdk.Context, assetID string) (types.Price, error) {
-	// for native token exo, we temporarily use default price
-	if assetID == assetstypes.ExocoreAssetID {
-		return types.Price{
-			Value:   sdkmath.NewInt(types.DefaultPriceValue),
-			Decimal: types.DefaultPriceDecimal,
-		}, nil
+	prices, err := k.GetMultipleAssetsPrices(ctx, map[string]interface{}{assetID: nil})
+	if err != nil {
+		return types.Price{}, err
 	}
+	return prices[assetID], nil
+}
 
-	var p types.Params
-	// get params from cache if exists
-	if agc != nil {
-		p = agc.GetParams()
-	} else {
-		p = k.GetParams(ctx)
-	}
-	tokenID := p.GetTokenIDFromAssetID(assetID)
-	if tokenID == 0 {
-		return types.Price{}, types.ErrGetPriceAssetNotFound.Wrapf("assetID does not exist in oracle %s", assetID)
-	}
-	price, found := k.GetPriceTRLatest(ctx, uint64(tokenID))
-	if !found {
-		return types.Price{
-			Value:   sdkmath.NewInt(types.DefaultPriceValue),
-			Decimal: types.DefaultPriceDecimal,
-		}, types.ErrGetPriceRoundNotFound.Wrapf("no valid price for assetID=%s", assetID)
-	}
-	v, _ := sdkmath.NewIntFromString(price.Price)
-	// for tokens really have 0 price, it should be removed from assets support, not here to provide zero price
-	if v.IsNil() || v.LTE(sdkmath.ZeroInt()) {
-		return types.Price{
-			Value:   sdkmath.NewInt(types.DefaultPriceValue),
-			Decimal: types.DefaultPriceDecimal,
-		}, types.ErrGetPriceRoundNotFound.Wrapf("no valid price for assetID=%s", assetID)
+// return latest price for assets, the unpriced assets are left out of the returned prices
+func (k Keeper) GetMultipleAssetsPrices(ctx sdk.Context, assets map[string]interface{}) (prices map[string]types.Price, err error) {
+	return k.getMultipleAssetsPrices(ctx, assets, false, func(tokenID uint64) (types.PriceTimeRound, bool) {
+		return k.GetPriceTRLatest(ctx, tokenID)
+	})
+}
+
+// GetMultipleAssetsLastPrices returns the latest prices of assets, including the stale ones.
+// It's used where an outdated price is preferable to none, such as valuing the assets to be
+// slashed, the assets without any price are still left out of the returned prices.
+func (k Keeper) GetMultipleAssetsLastPrices(ctx sdk.Context, assets map[string]interface{}) (prices map[string]types.Price, err error) {
+	return k.getMultipleAssetsPrices(ctx, assets, true, func(tokenID uint64) (types.PriceTimeRound, bool) {
+		return k.GetPriceTRLatest(ctx, tokenID)
+	})
+}
+
+// GetMultipleAssetsTWAPPrices returns the time-weighted average prices of assets over the latest
+// rounds, it falls back to the latest prices if rounds is zero
+func (k Keeper) GetMultipleAssetsTWAPPrices(ctx sdk.Context, assets map[string]interface{}, rounds uint64) (prices map[string]types.Price, err error) {
+	if rounds == 0 {
+		return k.GetMultipleAssetsPrices(ctx, assets)
 	}
-	return types.Price{
-		Value:   v,
-		Decimal: uint8(price.Decimal), // #nosec G115
-	}, nil
+	return k.getMultipleAssetsPrices(ctx, assets, false, func(tokenID uint64) (types.PriceTimeRound, bool) {
+		price, _, found := k.GetPriceTRTWAP(ctx, tokenID, rounds)
+		return price, found
+	})
 }
 
-// return latest price for assets
-func (k Keeper) GetMultipleAssetsPrices(ctx sdk.Context, assets map[string]interface{}) (prices map[string]types.Price, err error) {
+// getMultipleAssetsPrices returns the prices of assets retrieved by getPrice from the tokens
+// bonded with them. The assets whose prices are missing or stale are unpriced and left out of
+// the returned prices, with an ErrGetPriceRoundNotFound listing them. The stale prices are
+// returned if allowStale is true.
+func (k Keeper) getMultipleAssetsPrices(
+	ctx sdk.Context, assets map[string]interface{}, allowStale bool,
+	getPrice func(tokenID uint64) (types.PriceTimeRound, bool),
+) (prices map[string]types.Price, err error) {
 	var p types.Params
 	// get params from cache if exists
-	if agc != nil {
+	if agc := k.memState.agc; agc != nil {
 		p = agc.GetParams()
 	} else {
 		p = k.GetParams(ctx)
 	}
 	// ret := make(map[string]types.Price)
 	prices = make(map[string]types.Price)
-	info := ""
+	info, stale := "", ""
 	for assetID := range assets {
 		// for native token exo, we temporarily use default price
 		if assetID == assetstypes.ExocoreAssetID {
@@ -114,36 +119,66 @@ func (k Keeper) GetMultipleAssetsPrices(ctx sdk.Context, assets map[string]inter
 			prices = nil
 			break
 		}
-		price, found := k.GetPriceTRLatest(ctx, uint64(tokenID))
+		// the stale prices are treated as missing
+		if !allowStale && k.IsPriceStale(ctx, p, uint64(tokenID)) {
+			stale = stale + assetID + " "
+			continue
+		}
+		price, found := getPrice(uint64(tokenID))
 		if !found {
 			info = info + assetID + " "
-			prices[assetID] = types.Price{
-				Value:   sdkmath.NewInt(types.DefaultPriceValue),
-				Decimal: types.DefaultPriceDecimal,
-			}
-		} else {
-			v, _ := sdkmath.NewIntFromString(price.Price)
-			// for tokens really have 0 price, it should be removed from assets support, not here to provide zero price
-			if v.IsNil() || v.LTE(sdkmath.ZeroInt()) {
-				info = info + assetID + " "
-				prices[assetID] = types.Price{
-					Value:   sdkmath.NewInt(types.DefaultPriceValue),
-					Decimal: types.DefaultPriceDecimal,
-				}
-				continue
-			}
-			prices[assetID] = types.Price{
-				Value:   v,
-				Decimal: uint8(price.Decimal), // #nosec G115
-			}
+			continue
+		}
+		v, _ := sdkmath.NewIntFromString(price.Price)
+		// for tokens really have 0 price, it should be removed from assets support, not here to provide zero price
+		if v.IsNil() || v.LTE(sdkmath.ZeroInt()) {
+			info = info + assetID + " "
+			continue
+		}
+		prices[assetID] = types.Price{
+			Value:   v,
+			Decimal: uint8(price.Decimal), // #nosec G115
 		}
 	}
-	if err == nil && len(info) > 0 {
-		err = types.ErrGetPriceRoundNotFound.Wrapf("no valid price for assetIDs=%s", info)
+	if err == nil && len(info)+len(stale) > 0 {
+		err = types.ErrGetPriceRoundNotFound.Wrapf("no valid price for assetIDs=%s, stale price for assetIDs=%s", info, stale)
 	}
 	return prices, err
 }
 
+// GetPriceUpdatedHeight returns the height when the latest price of a token was finalized
+func (k Keeper) GetPriceUpdatedHeight(ctx sdk.Context, tokenID uint64) (height int64, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.PriceUpdatedHeightKeyPrefix))
+	bz := store.Get(types.Uint64Bytes(tokenID))
+	if bz == nil {
+		return 0, false
+	}
+	// #nosec G115 // the height is always non-negative
+	return int64(binary.BigEndian.Uint64(bz)), true
+}
+
+// SetPriceUpdatedHeight sets the height when the latest price of a token was finalized
+func (k Keeper) SetPriceUpdatedHeight(ctx sdk.Context, tokenID uint64, height int64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.PriceUpdatedHeightKeyPrefix))
+	// #nosec G115 // the height is always non-negative
+	store.Set(types.Uint64Bytes(tokenID), types.Uint64Bytes(uint64(height)))
+}
+
+// IsPriceStale returns whether the latest price of a token is older than the max age of the
+// token, the price of a token without max age never goes stale. The rounds without consensus
+// carry the previous price over, which doesn't refresh the price.
+func (k Keeper) IsPriceStale(ctx sdk.Context, p types.Params, tokenID uint64) bool {
+	if tokenID >= uint64(len(p.Tokens)) || p.Tokens[tokenID].MaxPriceAge == 0 {
+		return false
+	}
+	height, found := k.GetPriceUpdatedHeight(ctx, tokenID)
+	if !found {
+		return true
+	}
+	// #nosec G115 // the max age is bounded by the block height in practice
+	return ctx.BlockHeight()-height > int64(p.Tokens[tokenID].MaxPriceAge)
+}
+
 // RemovePrices removes a prices from the store
 func (k Keeper) RemovePrices(
 	ctx sdk.Context,
@@ -201,34 +236,19 @@ func (k Keeper) AppendPriceTR(ctx sdk.Context, tokenID uint64, priceTR types.Pri
 	store := k.getPriceTRStore(ctx, tokenID)
 	b := k.cdc.MustMarshal(&priceTR)
 	store.Set(types.PricesRoundKey(nextRoundID), b)
-	if expiredRoundID := nextRoundID - agc.GetParamsMaxSizePrices(); expiredRoundID > 0 {
-		store.Delete(types.PricesRoundKey(expiredRoundID))
-	}
-	roundID := k.IncreaseNextRoundID(ctx, tokenID)
-
-	// update for native tokens
-	// TODO: set hooks as a genral approach
-	var p types.Params
 	// get params from cache if exists
-	if agc != nil {
-		p = agc.GetParams()
-	} else {
-		p = k.GetParams(ctx)
+	maxSizePrices := uint64(k.GetParams(ctx).MaxSizePrices)
+	if agc := k.memState.agc; agc != nil {
+		maxSizePrices = agc.GetParamsMaxSizePrices()
 	}
-	assetIDs := p.GetAssetIDsFromTokenID(tokenID)
-	for _, assetID := range assetIDs {
-		if assetstypes.IsNST(assetID) {
-			if err := k.UpdateNSTByBalanceChange(ctx, assetID, []byte(priceTR.Price), roundID); err != nil {
-				// we just report this error in log to notify validators
-				k.Logger(ctx).Error(types.ErrUpdateNativeTokenVirtualPriceFail.Error(), "error", err)
-			}
-		}
+	if expiredRoundID := nextRoundID - maxSizePrices; expiredRoundID > 0 {
+		store.Delete(types.PricesRoundKey(expiredRoundID))
 	}
-
+	k.IncreaseNextRoundID(ctx, tokenID)
 	return true
 }
 
-// GrowRoundID Increases roundID with the previous price
+// GrowRoundID Increases roundID with the previous price, and calls the AfterRoundFailed hooks
 func (k Keeper) GrowRoundID(ctx sdk.Context, tokenID uint64) (price string, roundID uint64) {
 	if pTR, ok := k.GetPriceTRLatest(ctx, tokenID); ok {
 		pTR.RoundID++
@@ -243,9 +263,49 @@ func (k Keeper) GrowRoundID(ctx sdk.Context, tokenID uint64) (price string, roun
 		price = ""
 		roundID = nextRoundID
 	}
+	k.Hooks().AfterRoundFailed(ctx, tokenID, roundID)
 	return
 }
 
+// GetAssetIDsFromTokenID returns the assetIDs bound to the token, the params are read from the
+// cache if exists
+func (k Keeper) GetAssetIDsFromTokenID(ctx sdk.Context, tokenID uint64) []string {
+	var p types.Params
+	if agc := k.memState.agc; agc != nil {
+		p = agc.GetParams()
+	} else {
+		p = k.GetParams(ctx)
+	}
+	return p.GetAssetIDsFromTokenID(tokenID)
+}
+
+// AppendFinalPrice writes the final price of a round aggregated from the prices reported by the
+// validators, and records the deviations of the reported prices from it
+func (k Keeper) AppendFinalPrice(ctx sdk.Context, item *aggregator.PriceItemKV, validatorPowers map[string]*big.Int) {
+	logger := k.Logger(ctx)
+	if success := k.AppendPriceTR(ctx, item.TokenID, item.PriceTR); !success {
+		// This case should not exist, keep this line to avoid consensus fail if this happens
+		prevPrice, nextRoundID := k.GrowRoundID(ctx, item.TokenID)
+		logger.Error("append new price round fail for mismatch roundID, and will just grow roundID with previous price", "roundID from finalPrice", item.PriceTR.RoundID, "expect nextRoundID", nextRoundID, "prevPrice", prevPrice)
+	} else {
+		k.SetPriceUpdatedHeight(ctx, item.TokenID, ctx.BlockHeight())
+		k.Hooks().AfterPriceUpdated(ctx, item.TokenID, item.PriceTR.RoundID, item.PriceTR)
+		logger.Info("final price aggregation done", "feederID", item.FeederID, "roundID", item.PriceTR.RoundID, "price", item.PriceTR.Price)
+	}
+	k.UpdateValidatorDeviationInfos(ctx, item, validatorPowers)
+
+	decimalStr := strconv.FormatInt(int64(item.PriceTR.Decimal), 10)
+	tokenIDStr := strconv.FormatUint(item.TokenID, 10)
+	roundIDStr := strconv.FormatUint(item.PriceTR.RoundID, 10)
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeCreatePrice,
+		sdk.NewAttribute(types.AttributeKeyRoundID, roundIDStr),
+		sdk.NewAttribute(types.AttributeKeyFinalPrice, tokenIDStr+"_"+roundIDStr+"_"+item.PriceTR.Price+"_"+decimalStr),
+		sdk.NewAttribute(types.AttributeKeyPriceUpdated, types.AttributeValuePriceUpdatedSuccess)),
+	)
+	k.AppendUpdatedFeederIDs(item.FeederID)
+}
+
 // GetPriceTRoundID gets the price of the specific roundID of a specific token, return format as PriceTimeRound
 func (k Keeper) GetPriceTRRoundID(ctx sdk.Context, tokenID uint64, roundID uint64) (price types.PriceTimeRound, found bool) {
 	store := k.getPriceTRStore(ctx, tokenID)
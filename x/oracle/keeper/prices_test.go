@@ -90,3 +90,49 @@ func TestPricesGetAll(t *testing.T) {
 		nullify.Fill(keeper.GetAllPrices(ctx)),
 	)
 }
+
+func TestPricesStale(t *testing.T) {
+	keeper, ctx := keepertest.OracleKeeper(t)
+	assetID := "0x0b34c4d876cd569129cf56bafabb3f9e97a4ff42_0x9ce1"
+	assets := map[string]interface{}{assetID: nil}
+
+	// the asset without any price is unpriced instead of being valued by a default price
+	prices, err := keeper.GetMultipleAssetsPrices(ctx, assets)
+	require.ErrorIs(t, err, types.ErrGetPriceRoundNotFound)
+	require.NotContains(t, prices, assetID)
+
+	keeper.SetPrices(ctx, testdata.P1)
+	keeper.SetPriceUpdatedHeight(ctx, 1, 10)
+	p := keeper.GetParams(ctx)
+	p.Tokens[1].MaxPriceAge = 5
+	keeper.SetParams(ctx, p)
+
+	ctx = ctx.WithBlockHeight(15)
+	require.False(t, keeper.IsPriceStale(ctx, p, 1))
+	prices, err = keeper.GetMultipleAssetsPrices(ctx, assets)
+	require.NoError(t, err)
+	require.Contains(t, prices, assetID)
+	res, err := keeper.LatestPrice(ctx, &types.QueryGetLatestPriceRequest{TokenId: 1})
+	require.NoError(t, err)
+	require.Equal(t, int64(10), res.UpdatedHeight)
+	require.False(t, res.Stale)
+
+	ctx = ctx.WithBlockHeight(16)
+	require.True(t, keeper.IsPriceStale(ctx, p, 1))
+	prices, err = keeper.GetMultipleAssetsPrices(ctx, assets)
+	require.ErrorIs(t, err, types.ErrGetPriceRoundNotFound)
+	require.NotContains(t, prices, assetID)
+	_, err = keeper.GetSpecifiedAssetsPrice(ctx, assetID)
+	require.ErrorIs(t, err, types.ErrGetPriceRoundNotFound)
+	// the last price is still available where a stale price is preferable to none
+	prices, err = keeper.GetMultipleAssetsLastPrices(ctx, assets)
+	require.NoError(t, err)
+	require.Contains(t, prices, assetID)
+	res, err = keeper.LatestPrice(ctx, &types.QueryGetLatestPriceRequest{TokenId: 1})
+	require.NoError(t, err)
+	require.True(t, res.Stale)
+
+	// the price without max age never goes stale
+	p.Tokens[1].MaxPriceAge = 0
+	require.False(t, keeper.IsPriceStale(ctx, p, 1))
+}
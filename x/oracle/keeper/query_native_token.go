@@ -24,8 +24,11 @@ func (k Keeper) StakerInfos(goCtx context.Context, req *types.QueryStakerInfosRe
 		return nil, ErrUnsupportedAsset
 	}
 	ctx := sdk.UnwrapSDKContext(goCtx)
-	stakerInfos := k.GetStakerInfos(ctx, req.AssetId)
-	return &types.QueryStakerInfosResponse{StakerInfos: stakerInfos}, nil
+	stakerInfos, pageRes, err := k.GetStakerInfos(ctx, req.AssetId, req.Pagination)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &types.QueryStakerInfosResponse{StakerInfos: stakerInfos, Pagination: pageRes}, nil
 }
 
 func (k Keeper) StakerInfo(goCtx context.Context, req *types.QueryStakerInfoRequest) (*types.QueryStakerInfoResponse, error) {
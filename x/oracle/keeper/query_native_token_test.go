@@ -0,0 +1,74 @@
+package keeper_test
+
+import (
+	"fmt"
+	"testing"
+
+	keepertest "github.com/ExocoreNetwork/exocore/testutil/keeper"
+	"github.com/ExocoreNetwork/exocore/x/oracle/keeper"
+	"github.com/ExocoreNetwork/exocore/x/oracle/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStakerInfosQueryPaginated(t *testing.T) {
+	k, ctx := keepertest.OracleKeeper(t)
+	wctx := sdk.WrapSDKContext(ctx)
+	stakerInfos := make([]*types.StakerInfo, 5)
+	for i := range stakerInfos {
+		stakerInfos[i] = &types.StakerInfo{
+			StakerAddr:  fmt.Sprintf("0x%040d", i),
+			StakerIndex: int64(i),
+			BalanceList: []*types.BalanceInfo{{RoundID: 1, Balance: 32}},
+		}
+	}
+	k.SetStakerInfos(ctx, keeper.NSTETHASSETID, stakerInfos)
+
+	request := func(next []byte, offset, limit uint64, total bool) *types.QueryStakerInfosRequest {
+		return &types.QueryStakerInfosRequest{
+			AssetId: keeper.NSTETHASSETID,
+			Pagination: &query.PageRequest{
+				Key:        next,
+				Offset:     offset,
+				Limit:      limit,
+				CountTotal: total,
+			},
+		}
+	}
+	t.Run("ByOffset", func(t *testing.T) {
+		step := 2
+		for i := 0; i < len(stakerInfos); i += step {
+			resp, err := k.StakerInfos(wctx, request(nil, uint64(i), uint64(step), false))
+			require.NoError(t, err)
+			require.LessOrEqual(t, len(resp.StakerInfos), step)
+			for j, stakerInfo := range resp.StakerInfos {
+				require.Equal(t, int64(i+j), stakerInfo.StakerIndex)
+			}
+		}
+	})
+	t.Run("ByKey", func(t *testing.T) {
+		step := 2
+		var next []byte
+		count := 0
+		for i := 0; i < len(stakerInfos); i += step {
+			resp, err := k.StakerInfos(wctx, request(next, 0, uint64(step), false))
+			require.NoError(t, err)
+			require.LessOrEqual(t, len(resp.StakerInfos), step)
+			count += len(resp.StakerInfos)
+			next = resp.Pagination.NextKey
+		}
+		require.Equal(t, len(stakerInfos), count)
+		require.Nil(t, next)
+	})
+	t.Run("Total", func(t *testing.T) {
+		resp, err := k.StakerInfos(wctx, request(nil, 0, 0, true))
+		require.NoError(t, err)
+		require.Equal(t, len(stakerInfos), int(resp.Pagination.Total))
+		require.Len(t, resp.StakerInfos, len(stakerInfos))
+	})
+	t.Run("InvalidRequest", func(t *testing.T) {
+		_, err := k.StakerInfos(wctx, nil)
+		require.ErrorIs(t, err, keeper.ErrInvalidRequest)
+	})
+}
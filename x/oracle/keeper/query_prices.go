@@ -1,10 +1,12 @@
 package keeper
 
 import (
+	"bytes"
 	"context"
 
 	"github.com/ExocoreNetwork/exocore/x/oracle/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
@@ -38,22 +40,41 @@ import (
 //	return &types.QueryAllPricesResponse{Prices: pricess, Pagination: pageRes}, nil
 //}
 
-// Prices return all prices for a specific token TODO: pagination
+// Prices returns the prices of a specific token in the rounds of the page, the rounds which
+// have been pruned are skipped
 func (k Keeper) Prices(goCtx context.Context, req *types.QueryGetPricesRequest) (*types.QueryGetPricesResponse, error) {
 	if req == nil || req.TokenId < 1 {
 		return nil, status.Error(codes.InvalidArgument, "invalid request")
 	}
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
-	val, found := k.GetPrices(
-		ctx,
-		req.TokenId,
-	)
-	if !found {
+	if _, found := k.GetPriceTRLatest(ctx, req.TokenId); !found {
 		return nil, status.Error(codes.NotFound, "not found")
 	}
 
-	return &types.QueryGetPricesResponse{Prices: val}, nil
+	prices := types.Prices{
+		TokenID:     req.TokenId,
+		NextRoundID: k.GetNextRoundID(ctx, req.TokenId),
+	}
+	pageRes, err := query.FilteredPaginate(k.getPriceTRStore(ctx, req.TokenId), req.Pagination, func(key []byte, value []byte, accumulate bool) (bool, error) {
+		// the store of the token keeps the next roundID along with the rounds
+		if bytes.Equal(key, types.PricesNextRoundIDKey) {
+			return false, nil
+		}
+		if accumulate {
+			var priceTR types.PriceTimeRound
+			if err := k.cdc.Unmarshal(value, &priceTR); err != nil {
+				return false, err
+			}
+			prices.PriceList = append(prices.PriceList, &priceTR)
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryGetPricesResponse{Prices: prices, Pagination: pageRes}, nil
 }
 
 // LatestPrice return the latest price for a specific token
@@ -68,5 +89,33 @@ func (k Keeper) LatestPrice(goCtx context.Context, req *types.QueryGetLatestPric
 		return nil, status.Error(codes.NotFound, "not found")
 	}
 
-	return &types.QueryGetLatestPriceResponse{Price: val}, nil
+	updatedHeight, _ := k.GetPriceUpdatedHeight(ctx, req.TokenId)
+	return &types.QueryGetLatestPriceResponse{
+		Price:         val,
+		UpdatedHeight: updatedHeight,
+		Stale:         k.IsPriceStale(ctx, k.GetParams(ctx), req.TokenId),
+	}, nil
+}
+
+// TWAPPrice returns the time-weighted average price for a specific token over the latest rounds
+// or blocks
+func (k Keeper) TWAPPrice(goCtx context.Context, req *types.QueryTWAPPriceRequest) (*types.QueryTWAPPriceResponse, error) {
+	if req == nil || req.TokenId < 1 {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if (req.Rounds == 0) == (req.Blocks == 0) {
+		return nil, status.Error(codes.InvalidArgument, "exactly one of rounds and blocks should be set")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	rounds := req.Rounds
+	if req.Blocks > 0 {
+		rounds = k.RoundsInBlocks(ctx, req.TokenId, req.Blocks)
+	}
+	val, count, found := k.GetPriceTRTWAP(ctx, req.TokenId, rounds)
+	if !found {
+		return nil, status.Error(codes.NotFound, "not found")
+	}
+
+	return &types.QueryTWAPPriceResponse{Price: val, Rounds: count}, nil
 }
@@ -5,12 +5,14 @@ import (
 	"testing"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/stretchr/testify/require"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
 	keepertest "github.com/ExocoreNetwork/exocore/testutil/keeper"
 	"github.com/ExocoreNetwork/exocore/testutil/nullify"
+	"github.com/ExocoreNetwork/exocore/x/oracle/keeper/testdata"
 	"github.com/ExocoreNetwork/exocore/x/oracle/types"
 )
 
@@ -61,66 +63,63 @@ func TestPricesQuerySingle(t *testing.T) {
 			} else {
 				require.NoError(t, err)
 				require.Equal(t,
-					nullify.Fill(tc.response),
-					nullify.Fill(response),
+					nullify.Fill(tc.response.Prices),
+					nullify.Fill(response.Prices),
 				)
 			}
 		})
 	}
 }
 
-//func TestPricesQueryPaginated(t *testing.T) {
-//	keeper, ctx := keepertest.OracleKeeper(t)
-//	wctx := sdk.WrapSDKContext(ctx)
-//	msgs := createNPrices(keeper, ctx, 5)
-//
-//	request := func(next []byte, offset, limit uint64, total bool) *types.QueryAllPricesRequest {
-//		return &types.QueryAllPricesRequest{
-//			Pagination: &query.PageRequest{
-//				Key:        next,
-//				Offset:     offset,
-//				Limit:      limit,
-//				CountTotal: total,
-//			},
-//		}
-//	}
-//	t.Run("ByOffset", func(t *testing.T) {
-//		step := 2
-//		for i := 0; i < len(msgs); i += step {
-//			resp, err := keeper.PricesAll(wctx, request(nil, uint64(i), uint64(step), false))
-//			require.NoError(t, err)
-//			require.LessOrEqual(t, len(resp.Prices), step)
-//			require.Subset(t,
-//				nullify.Fill(msgs),
-//				nullify.Fill(resp.Prices),
-//			)
-//		}
-//	})
-//	t.Run("ByKey", func(t *testing.T) {
-//		step := 2
-//		var next []byte
-//		for i := 0; i < len(msgs); i += step {
-//			resp, err := keeper.PricesAll(wctx, request(next, 0, uint64(step), false))
-//			require.NoError(t, err)
-//			require.LessOrEqual(t, len(resp.Prices), step)
-//			require.Subset(t,
-//				nullify.Fill(msgs),
-//				nullify.Fill(resp.Prices),
-//			)
-//			next = resp.Pagination.NextKey
-//		}
-//	})
-//	t.Run("Total", func(t *testing.T) {
-//		resp, err := keeper.PricesAll(wctx, request(nil, 0, 0, true))
-//		require.NoError(t, err)
-//		require.Equal(t, len(msgs), int(resp.Pagination.Total))
-//		require.ElementsMatch(t,
-//			nullify.Fill(msgs),
-//			nullify.Fill(resp.Prices),
-//		)
-//	})
-//	t.Run("InvalidRequest", func(t *testing.T) {
-//		_, err := keeper.PricesAll(wctx, nil)
-//		require.ErrorIs(t, err, status.Error(codes.InvalidArgument, "invalid request"))
-//	})
-//}
+func TestPricesQueryPaginated(t *testing.T) {
+	keeper, ctx := keepertest.OracleKeeper(t)
+	wctx := sdk.WrapSDKContext(ctx)
+	keeper.SetPrices(ctx, testdata.P1)
+	msgs := testdata.P1.PriceList
+
+	request := func(next []byte, offset, limit uint64, total, reverse bool) *types.QueryGetPricesRequest {
+		return &types.QueryGetPricesRequest{
+			TokenId: 1,
+			Pagination: &query.PageRequest{
+				Key:        next,
+				Offset:     offset,
+				Limit:      limit,
+				CountTotal: total,
+				Reverse:    reverse,
+			},
+		}
+	}
+	t.Run("ByOffset", func(t *testing.T) {
+		step := 2
+		for i := 0; i < len(msgs); i += step {
+			resp, err := keeper.Prices(wctx, request(nil, uint64(i), uint64(step), false, false))
+			require.NoError(t, err)
+			require.Equal(t, testdata.P1.NextRoundID, resp.Prices.NextRoundID)
+			require.LessOrEqual(t, len(resp.Prices.PriceList), step)
+			require.Subset(t, msgs, resp.Prices.PriceList)
+		}
+	})
+	t.Run("ByKey", func(t *testing.T) {
+		step := 2
+		var next []byte
+		for i := 0; i < len(msgs); i += step {
+			resp, err := keeper.Prices(wctx, request(next, 0, uint64(step), false, false))
+			require.NoError(t, err)
+			require.LessOrEqual(t, len(resp.Prices.PriceList), step)
+			require.Subset(t, msgs, resp.Prices.PriceList)
+			next = resp.Pagination.NextKey
+		}
+		require.Nil(t, next)
+	})
+	t.Run("Total", func(t *testing.T) {
+		resp, err := keeper.Prices(wctx, request(nil, 0, 0, true, false))
+		require.NoError(t, err)
+		require.Equal(t, len(msgs), int(resp.Pagination.Total))
+		require.Equal(t, msgs, resp.Prices.PriceList)
+	})
+	t.Run("Reverse", func(t *testing.T) {
+		resp, err := keeper.Prices(wctx, request(nil, 0, 1, false, true))
+		require.NoError(t, err)
+		require.Equal(t, []*types.PriceTimeRound{testdata.PTR5}, resp.Prices.PriceList)
+	})
+}
@@ -0,0 +1,75 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/ExocoreNetwork/exocore/x/oracle/types"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ValidatorReportInfo returns the liveness of a validator as the price feeder of a feeder
+func (k Keeper) ValidatorReportInfo(goCtx context.Context, req *types.QueryValidatorReportInfoRequest) (*types.QueryValidatorReportInfoResponse, error) {
+	if req == nil || len(req.Validator) == 0 || req.FeederId < 1 {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	val, found := k.GetValidatorReportInfo(ctx, req.Validator, req.FeederId)
+	if !found {
+		return nil, status.Error(codes.NotFound, "not found")
+	}
+
+	return &types.QueryValidatorReportInfoResponse{ReportInfo: val}, nil
+}
+
+// ValidatorReportInfos returns the liveness of the validators as price feeders, only the ones
+// of the validator are returned if it's specified
+func (k Keeper) ValidatorReportInfos(goCtx context.Context, req *types.QueryValidatorReportInfosRequest) (*types.QueryValidatorReportInfosResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	var reportInfos []types.ValidatorReportInfo
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	store := ctx.KVStore(k.storeKey)
+	reportInfoStore := prefix.NewStore(store, types.KeyPrefix(types.ValidatorReportInfoKeyPrefix))
+	if len(req.Validator) > 0 {
+		reportInfoStore = prefix.NewStore(reportInfoStore, types.ValidatorReportInfoValidatorKey(req.Validator))
+	}
+
+	pageRes, err := query.Paginate(reportInfoStore, req.Pagination, func(_ []byte, value []byte) error {
+		var reportInfo types.ValidatorReportInfo
+		if err := k.cdc.Unmarshal(value, &reportInfo); err != nil {
+			return err
+		}
+
+		reportInfos = append(reportInfos, reportInfo)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryValidatorReportInfosResponse{ReportInfos: reportInfos, Pagination: pageRes}, nil
+}
+
+// ValidatorDeviationInfo returns the deviation of the prices reported by a validator from the
+// final prices of a feeder
+func (k Keeper) ValidatorDeviationInfo(goCtx context.Context, req *types.QueryValidatorDeviationInfoRequest) (*types.QueryValidatorDeviationInfoResponse, error) {
+	if req == nil || len(req.Validator) == 0 || req.FeederId < 1 {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	val, found := k.GetValidatorDeviationInfo(ctx, req.Validator, req.FeederId)
+	if !found {
+		return nil, status.Error(codes.NotFound, "not found")
+	}
+
+	return &types.QueryValidatorDeviationInfoResponse{DeviationInfo: val}, nil
+}
@@ -11,60 +11,63 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
-var updatedFeederIDs []string
-
-var cs *cache.Cache
-
-var agc, agcCheckTx *aggregator.AggregatorContext
+// memoryState holds the in-memory state of the oracle module, it's owned by a Keeper and shared
+// by all its copies, and rebuilt from the KVStore when the node starts
+type memoryState struct {
+	updatedFeederIDs []string
+	cs               *cache.Cache
+	agc, agcCheckTx  *aggregator.AggregatorContext
+}
 
-func GetCaches() *cache.Cache {
-	if cs != nil {
-		return cs
+func (k Keeper) GetCaches() *cache.Cache {
+	if k.memState.cs != nil {
+		return k.memState.cs
 	}
-	cs = cache.NewCache()
-	return cs
+	k.memState.cs = cache.NewCache()
+	return k.memState.cs
 }
 
-// GetAggregatorContext returns singleton aggregatorContext used to calculate final price for each round of each tokenFeeder
-func GetAggregatorContext(ctx sdk.Context, k Keeper) *aggregator.AggregatorContext {
+// GetAggregatorContext returns the aggregatorContext of the keeper used to calculate final price for each round of each tokenFeeder
+func (k Keeper) GetAggregatorContext(ctx sdk.Context) *aggregator.AggregatorContext {
+	state := k.memState
 	if ctx.IsCheckTx() {
-		if agcCheckTx != nil {
-			return agcCheckTx
+		if state.agcCheckTx != nil {
+			return state.agcCheckTx
 		}
-		if agc == nil {
-			c := GetCaches()
+		if state.agc == nil {
+			c := k.GetCaches()
 			c.ResetCaches()
-			agcCheckTx = aggregator.NewAggregatorContext()
-			if ok := recacheAggregatorContext(ctx, agcCheckTx, k, c); !ok {
+			state.agcCheckTx = aggregator.NewAggregatorContext()
+			if ok := recacheAggregatorContext(ctx, state.agcCheckTx, k, c); !ok {
 				// this is the very first time oracle has been started, fill relalted info as initialization
-				initAggregatorContext(ctx, agcCheckTx, k, c)
+				initAggregatorContext(ctx, state.agcCheckTx, k, c)
 			}
-			return agcCheckTx
+			return state.agcCheckTx
 		}
-		agcCheckTx = agc.Copy4CheckTx()
-		return agcCheckTx
+		state.agcCheckTx = state.agc.Copy4CheckTx()
+		return state.agcCheckTx
 	}
 
-	if agc != nil {
-		return agc
+	if state.agc != nil {
+		return state.agc
 	}
 
-	c := GetCaches()
+	c := k.GetCaches()
 	c.ResetCaches()
-	agc = aggregator.NewAggregatorContext()
-	if ok := recacheAggregatorContext(ctx, agc, k, c); !ok {
+	state.agc = aggregator.NewAggregatorContext()
+	if ok := recacheAggregatorContext(ctx, state.agc, k, c); !ok {
 		// this is the very first time oracle has been started, fill relalted info as initialization
-		initAggregatorContext(ctx, agc, k, c)
+		initAggregatorContext(ctx, state.agc, k, c)
 	} else {
 		// this is when a node restart and use the persistent state to refill cache, we don't need to commit these data again
 		c.SkipCommit()
 	}
-	return agc
+	return state.agc
 }
 
 func recacheAggregatorContext(ctx sdk.Context, agc *aggregator.AggregatorContext, k Keeper, c *cache.Cache) bool {
 	logger := k.Logger(ctx)
-	from := ctx.BlockHeight() - int64(common.MaxNonce) + 1
+	from := ctx.BlockHeight() - int64(k.GetParams(ctx).MaxNonce) + 1
 	to := ctx.BlockHeight()
 
 	h, ok := k.GetValidatorUpdateBlock(ctx)
@@ -105,7 +108,6 @@ func recacheAggregatorContext(ctx sdk.Context, agc *aggregator.AggregatorContext
 		}
 		p = recentParamsMap[prev]
 		agc.SetParams(p)
-		setCommonParams(p)
 	} else {
 		prev := int64(0)
 		for ; from < to; from++ {
@@ -115,7 +117,6 @@ func recacheAggregatorContext(ctx sdk.Context, agc *aggregator.AggregatorContext
 				if b < from && b > prev {
 					agc.SetParams(p)
 					prev = b
-					setCommonParams(p)
 					delete(recentParamsMap, b)
 				}
 			}
@@ -142,7 +143,6 @@ func recacheAggregatorContext(ctx sdk.Context, agc *aggregator.AggregatorContext
 			if b < to && b > prev {
 				agc.SetParams(p)
 				prev = b
-				setCommonParams(p)
 			}
 		}
 
@@ -157,7 +157,6 @@ func recacheAggregatorContext(ctx sdk.Context, agc *aggregator.AggregatorContext
 	// since the latest params stored in KV for recache should be the same with the latest params, so these lines are just duplicated actions if everything is fine.
 	*p = k.GetParams(ctx)
 	agc.SetParams(p)
-	setCommonParams(p)
 	c.AddCache(cache.ItemP(*p))
 
 	return true
@@ -170,7 +169,6 @@ func initAggregatorContext(ctx sdk.Context, agc *aggregator.AggregatorContext, k
 	agc.SetParams(&p)
 	// set params cache
 	c.AddCache(cache.ItemP(p))
-	setCommonParams(&p)
 
 	totalPower := big.NewInt(0)
 	validatorPowers := make(map[string]*big.Int)
@@ -187,36 +185,28 @@ func initAggregatorContext(ctx sdk.Context, agc *aggregator.AggregatorContext, k
 	agc.PrepareRoundEndBlock(uint64(ctx.BlockHeight()) - 1)
 }
 
-func ResetAggregatorContext() {
-	agc = nil
-}
-
-func ResetCache() {
-	cs = nil
+func (k Keeper) ResetAggregatorContext() {
+	k.memState.agc = nil
 }
 
-func ResetAggregatorContextCheckTx() {
-	agcCheckTx = nil
+func (k Keeper) ResetCache() {
+	k.memState.cs = nil
 }
 
-func setCommonParams(p *types.Params) {
-	common.MaxNonce = p.MaxNonce
-	common.ThresholdA = p.ThresholdA
-	common.ThresholdB = p.ThresholdB
-	common.MaxDetID = p.MaxDetId
-	common.Mode = p.Mode
+func (k Keeper) ResetAggregatorContextCheckTx() {
+	k.memState.agcCheckTx = nil
 }
 
-func ResetUpdatedFeederIDs() {
-	if updatedFeederIDs != nil {
-		updatedFeederIDs = nil
+func (k Keeper) ResetUpdatedFeederIDs() {
+	if k.memState.updatedFeederIDs != nil {
+		k.memState.updatedFeederIDs = nil
 	}
 }
 
-func GetUpdatedFeederIDs() []string {
-	return updatedFeederIDs
+func (k Keeper) GetUpdatedFeederIDs() []string {
+	return k.memState.updatedFeederIDs
 }
 
-func AppendUpdatedFeederIDs(id uint64) {
-	updatedFeederIDs = append(updatedFeederIDs, strconv.FormatUint(id, 10))
+func (k Keeper) AppendUpdatedFeederIDs(id uint64) {
+	k.memState.updatedFeederIDs = append(k.memState.updatedFeederIDs, strconv.FormatUint(id, 10))
 }
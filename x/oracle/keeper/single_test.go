@@ -0,0 +1,62 @@
+package keeper_test
+
+import (
+	"sort"
+	"testing"
+
+	"github.com/ExocoreNetwork/exocore/testutil"
+	"github.com/ExocoreNetwork/exocore/x/oracle/keeper"
+	"github.com/ExocoreNetwork/exocore/x/oracle/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/stretchr/testify/require"
+)
+
+// TestTwoChainsInOneProcess runs two independent chains in the same process, each of them
+// must keep its own caches and aggregatorContext
+func TestTwoChainsInOneProcess(t *testing.T) {
+	chains := []*testutil.BaseTestSuite{{}, {}}
+	for _, chain := range chains {
+		chain.SetT(t)
+		chain.DoSetupTest()
+	}
+	chainA, chainB := chains[0], chains[1]
+
+	validators := func(chain *testutil.BaseTestSuite) []string {
+		var ret []string
+		for _, v := range chain.App.StakingKeeper.GetAllExocoreValidators(chain.Ctx) {
+			ret = append(ret, sdk.ConsAddress(v.Address).String())
+		}
+		sort.Strings(ret)
+		return ret
+	}
+	aggregatorValidators := func(chain *testutil.BaseTestSuite) []string {
+		ret := chain.App.OracleKeeper.GetAggregatorContext(chain.Ctx).GetValidators()
+		sort.Strings(ret)
+		return ret
+	}
+	require.NotEqual(t, validators(chainA), validators(chainB))
+	for _, chain := range chains {
+		require.Equal(t, validators(chain), aggregatorValidators(chain))
+	}
+
+	// update the params of chainA only
+	p := types.DefaultParams()
+	p.MaxSizePrices = 50
+	p.Chains, p.Tokens, p.Sources, p.Rules, p.TokenFeeders = nil, nil, nil, nil, nil
+	_, err := keeper.NewMsgServerImpl(chainA.App.OracleKeeper).UpdateParams(chainA.Ctx, &types.MsgUpdateParams{
+		Authority: authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+		Params:    p,
+	})
+	require.NoError(t, err)
+
+	for i := 0; i < 3; i++ {
+		for _, chain := range chains {
+			chain.Commit()
+			require.Equal(t, validators(chain), aggregatorValidators(chain))
+		}
+	}
+	require.Equal(t, uint64(50), chainA.App.OracleKeeper.GetAggregatorContext(chainA.Ctx).GetParamsMaxSizePrices())
+	require.Equal(t, uint64(100), chainB.App.OracleKeeper.GetAggregatorContext(chainB.Ctx).GetParamsMaxSizePrices())
+}
@@ -0,0 +1,68 @@
+package keeper
+
+import (
+	"math/big"
+
+	"github.com/ExocoreNetwork/exocore/x/oracle/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// GetPriceTRTWAP returns the time-weighted average price of a token over the latest rounds, along
+// with the number of rounds averaged. Each round of a feeder lasts for the same interval of blocks,
+// and a round without consensus carries the previous price over, so the arithmetic mean of the
+// prices of the rounds is weighted by the time each price was in effect. The rounds which have
+// been pruned or have no valid price are skipped, and the prices are scaled to the decimal of the
+// latest round. The returned PriceTimeRound carries the decimal, timestamp and roundID of the
+// latest round.
+func (k Keeper) GetPriceTRTWAP(ctx sdk.Context, tokenID, rounds uint64) (price types.PriceTimeRound, count uint64, found bool) {
+	latest, found := k.GetPriceTRLatest(ctx, tokenID)
+	if !found || rounds == 0 {
+		return types.PriceTimeRound{}, 0, false
+	}
+	sum := new(big.Int)
+	for roundID := latest.RoundID; roundID > 0 && latest.RoundID-roundID < rounds; roundID-- {
+		priceTR, ok := k.GetPriceTRRoundID(ctx, tokenID, roundID)
+		if !ok {
+			// the earlier rounds have been pruned
+			break
+		}
+		v, ok := new(big.Int).SetString(priceTR.Price, 10)
+		if !ok || v.Sign() <= 0 {
+			continue
+		}
+		sum.Add(sum, scalePrice(v, priceTR.Decimal, latest.Decimal))
+		count++
+	}
+	if count == 0 {
+		return types.PriceTimeRound{}, 0, false
+	}
+	latest.Price = sum.Quo(sum, new(big.Int).SetUint64(count)).String()
+	return latest, count, true
+}
+
+// RoundsInBlocks returns the number of rounds of a token covering the latest blocks, according
+// to the interval of the latest feeder of the token. It returns 0 if the token has no feeder.
+func (k Keeper) RoundsInBlocks(ctx sdk.Context, tokenID, blocks uint64) uint64 {
+	p := k.GetParams(ctx)
+	feederIDs := p.GetFeederIDsByTokenID(tokenID)
+	if len(feederIDs) == 0 || blocks == 0 {
+		return 0
+	}
+	interval := p.TokenFeeders[feederIDs[len(feederIDs)-1]].Interval
+	if interval == 0 {
+		return 0
+	}
+	return (blocks + interval - 1) / interval
+}
+
+// scalePrice scales a price from the decimal it's reported with to the target decimal
+func scalePrice(price *big.Int, decimal, target int32) *big.Int {
+	switch {
+	case decimal < target:
+		return new(big.Int).Mul(price, new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(target-decimal)), nil))
+	case decimal > target:
+		return new(big.Int).Quo(price, new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(decimal-target)), nil))
+	default:
+		return price
+	}
+}
@@ -0,0 +1,104 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	keepertest "github.com/ExocoreNetwork/exocore/testutil/keeper"
+	"github.com/ExocoreNetwork/exocore/x/oracle/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestGetPriceTRTWAP(t *testing.T) {
+	keeper, ctx := keepertest.OracleKeeper(t)
+	for i, price := range []types.PriceTimeRound{
+		{Price: "100", Decimal: 8},
+		{Price: "200", Decimal: 8},
+		{Price: "300", Decimal: 8},
+		// the decimal of the latest round is used for the average
+		{Price: "4000", Decimal: 9},
+	} {
+		price.RoundID = uint64(i + 1)
+		require.True(t, keeper.AppendPriceTR(ctx, 1, price))
+	}
+
+	price, count, found := keeper.GetPriceTRTWAP(ctx, 1, 2)
+	require.True(t, found)
+	require.Equal(t, uint64(2), count)
+	require.Equal(t, types.PriceTimeRound{Price: "3500", Decimal: 9, RoundID: 4}, price)
+
+	// the window is limited to the existing rounds
+	price, count, found = keeper.GetPriceTRTWAP(ctx, 1, 10)
+	require.True(t, found)
+	require.Equal(t, uint64(4), count)
+	require.Equal(t, "2500", price.Price)
+
+	_, _, found = keeper.GetPriceTRTWAP(ctx, 1, 0)
+	require.False(t, found)
+	_, _, found = keeper.GetPriceTRTWAP(ctx, 2, 1)
+	require.False(t, found)
+
+	// the interval of the feeder of token 1 is 10 blocks
+	require.Equal(t, uint64(1), keeper.RoundsInBlocks(ctx, 1, 10))
+	require.Equal(t, uint64(3), keeper.RoundsInBlocks(ctx, 1, 25))
+	require.Equal(t, uint64(0), keeper.RoundsInBlocks(ctx, 100, 25))
+
+	prices, err := keeper.GetMultipleAssetsTWAPPrices(ctx, map[string]interface{}{
+		"0x0b34c4d876cd569129cf56bafabb3f9e97a4ff42_0x9ce1": nil,
+	}, 2)
+	require.NoError(t, err)
+	require.Equal(t, types.Price{Value: sdkmath.NewInt(3500), Decimal: 9}, prices["0x0b34c4d876cd569129cf56bafabb3f9e97a4ff42_0x9ce1"])
+}
+
+func TestTWAPPriceQuery(t *testing.T) {
+	keeper, ctx := keepertest.OracleKeeper(t)
+	wctx := sdk.WrapSDKContext(ctx)
+	for i, price := range []string{"100", "200", "300"} {
+		require.True(t, keeper.AppendPriceTR(ctx, 1, types.PriceTimeRound{Price: price, Decimal: 8, RoundID: uint64(i + 1)}))
+	}
+	tests := []struct {
+		desc     string
+		request  *types.QueryTWAPPriceRequest
+		response *types.QueryTWAPPriceResponse
+		err      error
+	}{
+		{
+			desc:     "Rounds",
+			request:  &types.QueryTWAPPriceRequest{TokenId: 1, Rounds: 3},
+			response: &types.QueryTWAPPriceResponse{Price: types.PriceTimeRound{Price: "200", Decimal: 8, RoundID: 3}, Rounds: 3},
+		},
+		{
+			desc:     "Blocks",
+			request:  &types.QueryTWAPPriceRequest{TokenId: 1, Blocks: 20},
+			response: &types.QueryTWAPPriceResponse{Price: types.PriceTimeRound{Price: "250", Decimal: 8, RoundID: 3}, Rounds: 2},
+		},
+		{
+			desc:    "KeyNotFound",
+			request: &types.QueryTWAPPriceRequest{TokenId: 100000, Rounds: 1},
+			err:     status.Error(codes.NotFound, "not found"),
+		},
+		{
+			desc:    "BothWindows",
+			request: &types.QueryTWAPPriceRequest{TokenId: 1, Rounds: 1, Blocks: 10},
+			err:     status.Error(codes.InvalidArgument, "exactly one of rounds and blocks should be set"),
+		},
+		{
+			desc: "InvalidRequest",
+			err:  status.Error(codes.InvalidArgument, "invalid request"),
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			response, err := keeper.TWAPPrice(wctx, tc.request)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.response, response)
+			}
+		})
+	}
+}
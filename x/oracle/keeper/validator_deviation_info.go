@@ -0,0 +1,120 @@
+package keeper
+
+import (
+	"math/big"
+	"sort"
+	"strconv"
+
+	"github.com/ExocoreNetwork/exocore/x/oracle/keeper/aggregator"
+	"github.com/ExocoreNetwork/exocore/x/oracle/types"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// GetValidatorDeviationInfo returns the deviation of the prices reported by a validator from the
+// final prices of a feeder
+func (k Keeper) GetValidatorDeviationInfo(ctx sdk.Context, validator string, feederID uint64) (info types.ValidatorDeviationInfo, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ValidatorDeviationInfoKeyPrefix))
+	bz := store.Get(types.ValidatorDeviationInfoKey(validator, feederID))
+	if bz == nil {
+		return info, false
+	}
+	k.cdc.MustUnmarshal(bz, &info)
+	return info, true
+}
+
+// SetValidatorDeviationInfo sets the deviation of the prices reported by a validator from the
+// final prices of a feeder
+func (k Keeper) SetValidatorDeviationInfo(ctx sdk.Context, info types.ValidatorDeviationInfo) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ValidatorDeviationInfoKeyPrefix))
+	bz := k.cdc.MustMarshal(&info)
+	store.Set(types.ValidatorDeviationInfoKey(info.Validator, info.FeederID), bz)
+}
+
+// UpdateValidatorDeviationInfos records the deviation of the price reported by each validator
+// from the final price of a round, and slashes and jails the validators whose reports fall out
+// of the deviation band for too many consecutive rounds. The rounds in which a validator didn't
+// report a price neither extend nor break its consecutive deviations.
+func (k Keeper) UpdateValidatorDeviationInfos(ctx sdk.Context, item *aggregator.PriceItemKV, validatorPowers map[string]*big.Int) {
+	slashing := k.GetParams(ctx).Slashing
+	if slashing == nil || !slashing.MaliciousDetectionEnabled() {
+		return
+	}
+	// the deviation is relative to the final price, which must be positive
+	finalPrice, ok := new(big.Int).SetString(item.PriceTR.Price, 10)
+	if !ok || finalPrice.Sign() <= 0 {
+		return
+	}
+	feederID, roundID, reports := item.FeederID, item.PriceTR.RoundID, item.Reports
+	validators := make([]string, 0, len(reports))
+	for validator := range reports {
+		validators = append(validators, validator)
+	}
+	sort.Strings(validators)
+
+	logger := k.Logger(ctx)
+	for _, validator := range validators {
+		power, ok := validatorPowers[validator]
+		if !ok {
+			continue
+		}
+		consAddr, err := sdk.ConsAddressFromBech32(validator)
+		if err != nil {
+			logger.Error("invalid validator address in oracle", "validator", validator, "error", err)
+			continue
+		}
+		if k.IsValidatorJailed(ctx, consAddr) {
+			continue
+		}
+		price := reports[validator]
+		deviation := priceDeviation(price, finalPrice)
+		info, found := k.GetValidatorDeviationInfo(ctx, validator, feederID)
+		if !found {
+			info = types.ValidatorDeviationInfo{
+				Validator: validator,
+				FeederID:  feederID,
+			}
+		}
+		info.RoundID = roundID
+		info.Price = price.String()
+		info.FinalPrice = finalPrice.String()
+		info.Deviation = deviation
+		if deviation.GT(slashing.MaxPriceDeviation) {
+			info.ConsecutiveDeviations++
+			ctx.EventManager().EmitEvent(sdk.NewEvent(
+				types.EventTypePriceDeviation,
+				sdk.NewAttribute(types.AttributeKeyValidator, validator),
+				sdk.NewAttribute(types.AttributeKeyFeederID, strconv.FormatUint(feederID, 10)),
+				sdk.NewAttribute(types.AttributeKeyRoundID, strconv.FormatUint(roundID, 10)),
+				sdk.NewAttribute(types.AttributeKeyPrice, info.Price),
+				sdk.NewAttribute(types.AttributeKeyFinalPrice, info.FinalPrice),
+				sdk.NewAttribute(types.AttributeKeyDeviation, deviation.String()),
+				sdk.NewAttribute(types.AttributeKeyConsecutive, strconv.FormatInt(info.ConsecutiveDeviations, 10)),
+			))
+		} else {
+			info.ConsecutiveDeviations = 0
+		}
+
+		if info.ConsecutiveDeviations >= slashing.MaxConsecutiveDeviations {
+			k.slashAndJail(
+				ctx, consAddr, feederID, power.Int64(),
+				slashing.SlashFractionMalicious, slashing.OracleMaliciousJailDuration,
+				types.AttributeValueMaliciousReportPrice,
+			)
+			logger.Info(
+				"slashing and jailing validator due to reporting malicious prices",
+				"validator", validator, "feederID", feederID, "roundID", roundID,
+				"price", info.Price, "finalPrice", info.FinalPrice, "deviation", deviation,
+			)
+			info.ConsecutiveDeviations = 0
+		}
+		k.SetValidatorDeviationInfo(ctx, info)
+	}
+}
+
+// priceDeviation returns the relative deviation |price-finalPrice|/finalPrice
+func priceDeviation(price, finalPrice *big.Int) sdk.Dec {
+	diff := new(big.Int).Sub(price, finalPrice)
+	diff.Abs(diff)
+	return sdk.NewDecFromBigInt(diff).Quo(sdk.NewDecFromBigInt(finalPrice))
+}
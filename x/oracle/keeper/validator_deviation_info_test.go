@@ -0,0 +1,69 @@
+package keeper_test
+
+import (
+	"math/big"
+	"testing"
+
+	keepertest "github.com/ExocoreNetwork/exocore/testutil/keeper"
+	"github.com/ExocoreNetwork/exocore/x/oracle/keeper/aggregator"
+	"github.com/ExocoreNetwork/exocore/x/oracle/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUpdateValidatorDeviationInfos(t *testing.T) {
+	k, ctx := keepertest.OracleKeeper(t)
+	slashing := k.GetParams(ctx).Slashing
+	require.NotNil(t, slashing)
+	require.True(t, slashing.MaliciousDetectionEnabled())
+	require.Equal(t, int64(3), slashing.MaxConsecutiveDeviations)
+
+	honest := sdk.ConsAddress([]byte("honest______________")).String()
+	malicious := sdk.ConsAddress([]byte("malicious___________")).String()
+	powers := map[string]*big.Int{honest: big.NewInt(3), malicious: big.NewInt(1)}
+
+	jailed := make(map[string]bool)
+	var slashedPower int64
+	patches := patchSlashing(jailed, &slashedPower)
+	defer patches.Reset()
+
+	// finalItem returns a round of the feeder 1 whose final price is 1000
+	finalItem := func(roundID uint64, reports map[string]*big.Int) *aggregator.PriceItemKV {
+		return &aggregator.PriceItemKV{
+			TokenID:  1,
+			FeederID: 1,
+			PriceTR:  types.PriceTimeRound{Price: "1000", RoundID: roundID},
+			Reports:  reports,
+		}
+	}
+	for roundID := uint64(1); roundID <= 2; roundID++ {
+		k.UpdateValidatorDeviationInfos(ctx, finalItem(roundID, map[string]*big.Int{
+			honest:    big.NewInt(1050),
+			malicious: big.NewInt(1200),
+		}), powers)
+	}
+	info, found := k.GetValidatorDeviationInfo(ctx, malicious, 1)
+	require.True(t, found)
+	require.Equal(t, uint64(2), info.RoundID)
+	require.Equal(t, sdk.NewDecWithPrec(2, 1), info.Deviation)
+	require.Equal(t, int64(2), info.ConsecutiveDeviations)
+	info, _ = k.GetValidatorDeviationInfo(ctx, honest, 1)
+	require.Equal(t, sdk.NewDecWithPrec(5, 2), info.Deviation)
+	require.Equal(t, int64(0), info.ConsecutiveDeviations)
+
+	// a report within the band breaks the consecutive deviations
+	k.UpdateValidatorDeviationInfos(ctx, finalItem(3, map[string]*big.Int{malicious: big.NewInt(950)}), powers)
+	info, _ = k.GetValidatorDeviationInfo(ctx, malicious, 1)
+	require.Equal(t, int64(0), info.ConsecutiveDeviations)
+	require.False(t, jailed[malicious])
+
+	for roundID := uint64(4); roundID <= 6; roundID++ {
+		k.UpdateValidatorDeviationInfos(ctx, finalItem(roundID, map[string]*big.Int{malicious: big.NewInt(800)}), powers)
+	}
+	require.True(t, jailed[malicious])
+	require.False(t, jailed[honest])
+	require.Equal(t, int64(1), slashedPower)
+	info, _ = k.GetValidatorDeviationInfo(ctx, malicious, 1)
+	require.Equal(t, uint64(6), info.RoundID)
+	require.Equal(t, int64(0), info.ConsecutiveDeviations)
+}
@@ -0,0 +1,225 @@
+package keeper
+
+import (
+	"encoding/binary"
+	"math/big"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/ExocoreNetwork/exocore/x/oracle/types"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// GetValidatorReportInfo returns the liveness of a validator as the price feeder of a feeder
+func (k Keeper) GetValidatorReportInfo(ctx sdk.Context, validator string, feederID uint64) (info types.ValidatorReportInfo, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ValidatorReportInfoKeyPrefix))
+	bz := store.Get(types.ValidatorReportInfoKey(validator, feederID))
+	if bz == nil {
+		return info, false
+	}
+	k.cdc.MustUnmarshal(bz, &info)
+	return info, true
+}
+
+// SetValidatorReportInfo sets the liveness of a validator as the price feeder of a feeder
+func (k Keeper) SetValidatorReportInfo(ctx sdk.Context, info types.ValidatorReportInfo) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ValidatorReportInfoKeyPrefix))
+	bz := k.cdc.MustMarshal(&info)
+	store.Set(types.ValidatorReportInfoKey(info.Validator, info.FeederID), bz)
+}
+
+// GetAllValidatorReportInfos returns the liveness of all the validators as price feeders
+func (k Keeper) GetAllValidatorReportInfos(ctx sdk.Context) (list []types.ValidatorReportInfo) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ValidatorReportInfoKeyPrefix))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.ValidatorReportInfo
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+	return
+}
+
+// GetValidatorMissedRounds returns the indexes of the rounds missed by a validator within the
+// window for a feeder
+func (k Keeper) GetValidatorMissedRounds(ctx sdk.Context, validator string, feederID uint64) []uint64 {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.MissedRoundBitArrayKeyPrefix))
+	iterator := sdk.KVStorePrefixIterator(store, types.MissedRoundBitArrayPrefix(validator, feederID))
+	defer iterator.Close()
+	missedRounds := make([]uint64, 0)
+	prefixLen := len(types.MissedRoundBitArrayPrefix(validator, feederID))
+	for ; iterator.Valid(); iterator.Next() {
+		// the key is the prefix followed by the index and a separator
+		missedRounds = append(missedRounds, binary.BigEndian.Uint64(iterator.Key()[prefixLen:prefixLen+8]))
+	}
+	return missedRounds
+}
+
+// GetMissedRoundBitArray returns whether a validator missed the round at the index of the
+// window for a feeder
+func (k Keeper) GetMissedRoundBitArray(ctx sdk.Context, validator string, feederID, index uint64) bool {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.MissedRoundBitArrayKeyPrefix))
+	return store.Has(types.MissedRoundBitArrayKey(validator, feederID, index))
+}
+
+// SetMissedRoundBitArray sets whether a validator missed the round at the index of the window
+// for a feeder, only the missed rounds are stored
+func (k Keeper) SetMissedRoundBitArray(ctx sdk.Context, validator string, feederID, index uint64, missed bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.MissedRoundBitArrayKeyPrefix))
+	key := types.MissedRoundBitArrayKey(validator, feederID, index)
+	if missed {
+		store.Set(key, []byte{1})
+	} else {
+		store.Delete(key)
+	}
+}
+
+// ClearMissedRoundBitArray removes all the missed rounds of a validator for a feeder
+func (k Keeper) ClearMissedRoundBitArray(ctx sdk.Context, validator string, feederID uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.MissedRoundBitArrayKeyPrefix))
+	iterator := sdk.KVStorePrefixIterator(store, types.MissedRoundBitArrayPrefix(validator, feederID))
+	defer iterator.Close()
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+// UpdateValidatorReportInfos records whether the validators reported prices for the closing
+// round of a feeder, it must be called before the nonces of that round are removed. A validator
+// reported if its nonce for the feeder has been increased, and the validators without a nonce
+// for the feeder, which joined after the round started, are not tracked for this round. The
+// validators which missed more rounds than allowed within the window are slashed and jailed.
+func (k Keeper) UpdateValidatorReportInfos(ctx sdk.Context, feederID uint64, validatorPowers map[string]*big.Int) {
+	slashing := k.GetParams(ctx).Slashing
+	if slashing == nil {
+		return
+	}
+	validators := make([]string, 0, len(validatorPowers))
+	for validator := range validatorPowers {
+		validators = append(validators, validator)
+	}
+	sort.Strings(validators)
+
+	logger := k.Logger(ctx)
+	window := slashing.ReportedRoundsWindow
+	maxMissed := slashing.MaxMissedRoundsPerWindow()
+	for _, validator := range validators {
+		reported, tracked := k.reportedInRound(ctx, validator, feederID)
+		if !tracked {
+			continue
+		}
+		consAddr, err := sdk.ConsAddressFromBech32(validator)
+		if err != nil {
+			logger.Error("invalid validator address in oracle", "validator", validator, "error", err)
+			continue
+		}
+		if k.IsValidatorJailed(ctx, consAddr) {
+			continue
+		}
+		info, found := k.GetValidatorReportInfo(ctx, validator, feederID)
+		if !found {
+			info = types.ValidatorReportInfo{
+				Validator:   validator,
+				FeederID:    feederID,
+				StartHeight: ctx.BlockHeight(),
+			}
+		}
+		// #nosec G701 // the index is always non-negative
+		index := uint64(info.IndexOffset % window)
+		info.IndexOffset++
+		previous := k.GetMissedRoundBitArray(ctx, validator, feederID, index)
+		missed := !reported
+		switch {
+		case !previous && missed:
+			k.SetMissedRoundBitArray(ctx, validator, feederID, index, true)
+			info.MissedRoundsCounter++
+		case previous && !missed:
+			k.SetMissedRoundBitArray(ctx, validator, feederID, index, false)
+			info.MissedRoundsCounter--
+		}
+
+		if missed {
+			ctx.EventManager().EmitEvent(sdk.NewEvent(
+				types.EventTypeOracleLiveness,
+				sdk.NewAttribute(types.AttributeKeyValidator, validator),
+				sdk.NewAttribute(types.AttributeKeyFeederID, strconv.FormatUint(feederID, 10)),
+				sdk.NewAttribute(types.AttributeKeyMissedRounds, strconv.FormatInt(info.MissedRoundsCounter, 10)),
+				sdk.NewAttribute(types.AttributeKeyHeight, strconv.FormatInt(ctx.BlockHeight(), 10)),
+			))
+			logger.Debug("validator missed a round of prices", "validator", validator, "feederID", feederID, "missed", info.MissedRoundsCounter, "threshold", maxMissed)
+		}
+
+		// the validator is not punished before a whole window of rounds has been tracked
+		if info.IndexOffset >= window && info.MissedRoundsCounter > maxMissed {
+			k.slashAndJail(
+				ctx, consAddr, feederID, validatorPowers[validator].Int64(),
+				slashing.SlashFractionMiss, slashing.OracleMissJailDuration,
+				types.AttributeValueMissingReportPrice,
+			)
+			logger.Info(
+				"slashing and jailing validator due to missing too many rounds of prices",
+				"validator", validator, "feederID", feederID,
+				"missed", info.MissedRoundsCounter, "threshold", maxMissed,
+			)
+			// the tracking restarts once the validator is unjailed
+			info.StartHeight = ctx.BlockHeight()
+			info.IndexOffset = 0
+			info.MissedRoundsCounter = 0
+			k.ClearMissedRoundBitArray(ctx, validator, feederID)
+		}
+		k.SetValidatorReportInfo(ctx, info)
+	}
+}
+
+// slashAndJail slashes and jails a validator for misbehaving as the price feeder of a feeder, and
+// emits the event as the evidence of the infraction
+func (k Keeper) slashAndJail(
+	ctx sdk.Context, consAddr sdk.ConsAddress, feederID uint64, power int64,
+	slashFraction sdk.Dec, jailDuration time.Duration, reason string,
+) {
+	// the power used to slash is the one at the height when the validator updates of the
+	// infraction took effect
+	distributionHeight := ctx.BlockHeight() - sdk.ValidatorUpdateDelay - 1
+	coinsBurned := k.SlashWithInfractionReason(
+		ctx, consAddr, distributionHeight, power,
+		slashFraction, stakingtypes.Infraction_INFRACTION_UNSPECIFIED,
+	)
+	k.Jail(ctx, consAddr)
+	// the jail period is tracked by the signing info of x/slashing, which is checked when the
+	// validator unjails
+	if _, found := k.slashingKeeper.GetValidatorSigningInfo(ctx, consAddr); found {
+		k.slashingKeeper.JailUntil(ctx, consAddr, ctx.BlockHeader().Time.Add(jailDuration))
+	}
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeOracleSlash,
+		sdk.NewAttribute(types.AttributeKeyValidator, consAddr.String()),
+		sdk.NewAttribute(types.AttributeKeyFeederID, strconv.FormatUint(feederID, 10)),
+		sdk.NewAttribute(types.AttributeKeyPower, strconv.FormatInt(power, 10)),
+		sdk.NewAttribute(types.AttributeKeyReason, reason),
+		sdk.NewAttribute(types.AttributeKeyJailed, consAddr.String()),
+		sdk.NewAttribute(types.AttributeKeyBurnedCoins, coinsBurned.String()),
+	))
+}
+
+// reportedInRound returns whether a validator reported prices for the current round of a feeder,
+// the second return value is false if the validator has no nonce for the round.
+func (k Keeper) reportedInRound(ctx sdk.Context, validator string, feederID uint64) (reported bool, tracked bool) {
+	nonce, found := k.GetNonce(ctx, validator)
+	if !found {
+		return false, false
+	}
+	for _, n := range nonce.NonceList {
+		if n.FeederID == feederID {
+			return n.Value > 0, true
+		}
+	}
+	return false, false
+}
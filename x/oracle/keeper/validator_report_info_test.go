@@ -0,0 +1,122 @@
+package keeper_test
+
+import (
+	"math/big"
+	"reflect"
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	keepertest "github.com/ExocoreNetwork/exocore/testutil/keeper"
+	dogfoodkeeper "github.com/ExocoreNetwork/exocore/x/dogfood/keeper"
+	"github.com/ExocoreNetwork/exocore/x/oracle/types"
+	. "github.com/agiledragon/gomonkey/v2"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	slashingkeeper "github.com/cosmos/cosmos-sdk/x/slashing/keeper"
+	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUpdateValidatorReportInfos(t *testing.T) {
+	k, ctx := keepertest.OracleKeeper(t)
+	p := k.GetParams(ctx)
+	p.Slashing = &types.SlashingParams{
+		ReportedRoundsWindow:   4,
+		MinReportedPerWindow:   sdk.NewDecWithPrec(5, 1),
+		OracleMissJailDuration: types.DefaultSlashingParams().OracleMissJailDuration,
+		SlashFractionMiss:      sdk.NewDecWithPrec(1, 2),
+	}
+	require.NoError(t, p.Validate())
+	k.SetParams(ctx, p)
+
+	active := sdk.ConsAddress([]byte("active______________")).String()
+	absent := sdk.ConsAddress([]byte("absent______________")).String()
+	powers := map[string]*big.Int{active: big.NewInt(1), absent: big.NewInt(2)}
+
+	jailed := make(map[string]bool)
+	var slashedPower int64
+	patches := patchSlashing(jailed, &slashedPower)
+	defer patches.Reset()
+
+	// a round in which only the active validator reports a price
+	closeRound := func() {
+		k.AddZeroNonceItemWithFeederIDForValidators(ctx, 1, []string{active, absent})
+		k.SetNonce(ctx, types.ValidatorNonce{Validator: active, NonceList: []*types.Nonce{{FeederID: 1, Value: 1}}})
+		k.UpdateValidatorReportInfos(ctx, 1, powers)
+		k.RemoveNonceWithFeederIDForValidators(ctx, 1, []string{active, absent})
+	}
+
+	// the absent validator is not jailed before a whole window is tracked
+	for i := 0; i < 3; i++ {
+		closeRound()
+	}
+	info, found := k.GetValidatorReportInfo(ctx, absent, 1)
+	require.True(t, found)
+	require.Equal(t, int64(3), info.IndexOffset)
+	require.Equal(t, int64(3), info.MissedRoundsCounter)
+	require.False(t, jailed[absent])
+
+	closeRound()
+	require.True(t, jailed[absent])
+	require.False(t, jailed[active])
+	require.Equal(t, int64(2), slashedPower)
+	info, found = k.GetValidatorReportInfo(ctx, absent, 1)
+	require.True(t, found)
+	require.Equal(t, int64(0), info.IndexOffset)
+	require.Equal(t, int64(0), info.MissedRoundsCounter)
+	for i := uint64(0); i < 4; i++ {
+		require.False(t, k.GetMissedRoundBitArray(ctx, absent, 1, i))
+	}
+
+	// the jailed validator is not tracked any more, and a missed round of the active one
+	// replaces a reported round in the window
+	k.AddZeroNonceItemWithFeederIDForValidators(ctx, 1, []string{active, absent})
+	k.UpdateValidatorReportInfos(ctx, 1, powers)
+	info, _ = k.GetValidatorReportInfo(ctx, absent, 1)
+	require.Equal(t, int64(0), info.IndexOffset)
+	info, _ = k.GetValidatorReportInfo(ctx, active, 1)
+	require.Equal(t, int64(5), info.IndexOffset)
+	require.Equal(t, int64(1), info.MissedRoundsCounter)
+	require.True(t, k.GetMissedRoundBitArray(ctx, active, 1, 0))
+
+	res, err := k.ValidatorReportInfos(ctx, &types.QueryValidatorReportInfosRequest{Pagination: &query.PageRequest{}})
+	require.NoError(t, err)
+	require.Len(t, res.ReportInfos, 2)
+	res, err = k.ValidatorReportInfos(ctx, &types.QueryValidatorReportInfosRequest{Validator: active})
+	require.NoError(t, err)
+	require.Len(t, res.ReportInfos, 1)
+	require.Equal(t, active, res.ReportInfos[0].Validator)
+}
+
+func TestUpdateValidatorReportInfosDisabled(t *testing.T) {
+	k, ctx := keepertest.OracleKeeper(t)
+	p := k.GetParams(ctx)
+	p.Slashing = nil
+	k.SetParams(ctx, p)
+
+	validator := sdk.ConsAddress([]byte("absent______________")).String()
+	k.AddZeroNonceItemWithFeederIDForValidators(ctx, 1, []string{validator})
+	k.UpdateValidatorReportInfos(ctx, 1, map[string]*big.Int{validator: big.NewInt(1)})
+	_, found := k.GetValidatorReportInfo(ctx, validator, 1)
+	require.False(t, found)
+}
+
+// patchSlashing patches the jailing and slashing of the validators, which are recorded in
+// jailed and slashedPower
+func patchSlashing(jailed map[string]bool, slashedPower *int64) *Patches {
+	patches := ApplyMethod(reflect.TypeOf(dogfoodkeeper.Keeper{}), "IsValidatorJailed", func(_ dogfoodkeeper.Keeper, _ sdk.Context, addr sdk.ConsAddress) bool {
+		return jailed[addr.String()]
+	})
+	patches.ApplyMethod(reflect.TypeOf(dogfoodkeeper.Keeper{}), "Jail", func(_ dogfoodkeeper.Keeper, _ sdk.Context, addr sdk.ConsAddress) {
+		jailed[addr.String()] = true
+	})
+	patches.ApplyMethod(reflect.TypeOf(dogfoodkeeper.Keeper{}), "SlashWithInfractionReason", func(_ dogfoodkeeper.Keeper, _ sdk.Context, _ sdk.ConsAddress, _, power int64, _ sdk.Dec, _ stakingtypes.Infraction) sdkmath.Int {
+		*slashedPower = power
+		return sdkmath.ZeroInt()
+	})
+	patches.ApplyMethod(reflect.TypeOf(slashingkeeper.Keeper{}), "GetValidatorSigningInfo", func(_ slashingkeeper.Keeper, _ sdk.Context, _ sdk.ConsAddress) (slashingtypes.ValidatorSigningInfo, bool) {
+		return slashingtypes.ValidatorSigningInfo{}, false
+	})
+	return patches
+}
@@ -6,7 +6,6 @@ import (
 	"fmt"
 	"math/big"
 	"strings"
-	"sync"
 
 	// this line is used by starport scaffolding # 1
 
@@ -27,9 +26,8 @@ import (
 )
 
 var (
-	_    module.AppModule      = AppModule{}
-	_    module.AppModuleBasic = AppModuleBasic{}
-	once                       = sync.Once{}
+	_ module.AppModule      = AppModule{}
+	_ module.AppModuleBasic = AppModuleBasic{}
 )
 
 // ----------------------------------------------------------------------------
@@ -153,20 +151,19 @@ func (AppModule) ConsensusVersion() uint64 { return 1 }
 
 // BeginBlock contains the logic that is automatically triggered at the beginning of each block
 func (am AppModule) BeginBlock(ctx sdk.Context, _ abci.RequestBeginBlock) {
-	// init caches and aggregatorContext for node restart
+	// init caches and aggregatorContext for node restart, they're only rebuilt from the KVStore
+	// when the keeper doesn't have them yet
 	// TODO: try better way to init caches and aggregatorContext than beginBlock
-	once.Do(func() {
-		_ = keeper.GetCaches()
-		_ = keeper.GetAggregatorContext(ctx, am.keeper)
-	})
+	_ = am.keeper.GetCaches()
+	_ = am.keeper.GetAggregatorContext(ctx)
 }
 
 // EndBlock contains the logic that is automatically triggered at the end of each block
 func (am AppModule) EndBlock(ctx sdk.Context, _ abci.RequestEndBlock) []abci.ValidatorUpdate {
-	cs := keeper.GetCaches()
+	cs := am.keeper.GetCaches()
 	validatorUpdates := am.keeper.GetValidatorUpdates(ctx)
 	forceSeal := false
-	agc := keeper.GetAggregatorContext(ctx, am.keeper)
+	agc := am.keeper.GetAggregatorContext(ctx)
 
 	logger := am.keeper.Logger(ctx)
 	if len(validatorUpdates) > 0 {
@@ -186,9 +183,15 @@ func (am AppModule) EndBlock(ctx sdk.Context, _ abci.RequestEndBlock) []abci.Val
 		logger.Info("validator set changed, force seal all active rounds", "height", ctx.BlockHeight())
 	}
 
-	// TODO: for v1 use mode==1, just check the failed feeders
-	_, failed, sealed := agc.SealRound(ctx, forceSeal)
+	success, failed, sealed := agc.SealRound(ctx, forceSeal)
+	// write the final prices aggregated at the end of the rounds, which only happens for
+	// ConsensusModeRoundEnd
+	for _, item := range success {
+		am.keeper.AppendFinalPrice(ctx, item, agc.GetValidatorPowers())
+		cs.RemoveCache(&cache.ItemM{FeederID: item.FeederID})
+	}
 	for _, feederID := range sealed {
+		am.keeper.UpdateValidatorReportInfos(ctx, feederID, agc.GetValidatorPowers())
 		am.keeper.RemoveNonceWithFeederIDForValidators(ctx, feederID, agc.GetValidators())
 	}
 	// append new round with previous price for fail-seal token
@@ -197,7 +200,7 @@ func (am AppModule) EndBlock(ctx sdk.Context, _ abci.RequestEndBlock) []abci.Val
 		logger.Info("add new round with previous price under fail aggregation", "tokenID", tokenID, "roundID", nextRoundID, "price", prevPrice)
 	}
 
-	keeper.ResetAggregatorContextCheckTx()
+	am.keeper.ResetAggregatorContextCheckTx()
 
 	if _, _, paramsUpdated := cs.CommitCache(ctx, false, am.keeper); paramsUpdated {
 		var p cache.ItemP
@@ -210,14 +213,14 @@ func (am AppModule) EndBlock(ctx sdk.Context, _ abci.RequestEndBlock) []abci.Val
 		))
 	}
 
-	if feederIDs := keeper.GetUpdatedFeederIDs(); len(feederIDs) > 0 {
+	if feederIDs := am.keeper.GetUpdatedFeederIDs(); len(feederIDs) > 0 {
 		feederIDsStr := strings.Join(feederIDs, "_")
 		ctx.EventManager().EmitEvent(sdk.NewEvent(
 			types.EventTypeCreatePrice,
 			sdk.NewAttribute(types.AttributeKeyPriceUpdated, types.AttributeValuePriceUpdatedSuccess),
 			sdk.NewAttribute(types.AttributeKeyFeederIDs, feederIDsStr),
 		))
-		keeper.ResetUpdatedFeederIDs()
+		am.keeper.ResetUpdatedFeederIDs()
 	}
 
 	newRoundFeederIDs := agc.PrepareRoundEndBlock(uint64(ctx.BlockHeight()))
@@ -1,7 +1,10 @@
 package types
 
 const (
-	EventTypeCreatePrice = "create_price"
+	EventTypeCreatePrice    = "create_price"
+	EventTypeOracleLiveness = "oracle_liveness"
+	EventTypeOracleSlash    = "oracle_slash"
+	EventTypePriceDeviation = "price_deviation"
 
 	AttributeKeyFeederID          = "feeder_id"
 	AttributeKeyTokenID           = "token_id"
@@ -14,10 +17,22 @@ const (
 	AttributeKeyFeederIDs         = "feeder_ids"
 	AttributeKeyNativeTokenUpdate = "native_token_update"
 	AttributeKeyNativeTokenChange = "native_token_change"
+	AttributeKeyValidator         = "validator"
+	AttributeKeyMissedRounds      = "missed_rounds"
+	AttributeKeyHeight            = "height"
+	AttributeKeyPower             = "power"
+	AttributeKeyReason            = "reason"
+	AttributeKeyJailed            = "jailed"
+	AttributeKeyBurnedCoins       = "burned_coins"
+	AttributeKeyPrice             = "price"
+	AttributeKeyDeviation         = "deviation"
+	AttributeKeyConsecutive       = "consecutive_deviations"
 
 	AttributeValuePriceUpdatedSuccess  = "success"
 	AttributeValueParamsUpdatedSuccess = "success"
 	AttributeValueNativeTokenUpdate    = "update"
 	AttributeValueNativeTokenDeposit   = "deposit"
 	AttributeValueNativeTokenWithdraw  = "withdraw"
+	AttributeValueMissingReportPrice   = "missing_report_price"
+	AttributeValueMaliciousReportPrice = "malicious_report_price"
 )
@@ -1,9 +1,12 @@
 package types
 
 import (
+	"time"
+
 	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/auth/types"
+	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
 )
 
 // AccountKeeper defines the expected account keeper used for simulations (noalias)
@@ -26,3 +29,19 @@ type DelegationKeeper interface {
 type AssetsKeeper interface {
 	GetAssetsDecimal(ctx sdk.Context, assets map[string]interface{}) (decimals map[string]uint32, err error)
 }
+
+// SlashingKeeper defines the expected interfaces needed to set the jail period of the validators
+// which are jailed for missing too many rounds of prices
+type SlashingKeeper interface {
+	GetValidatorSigningInfo(ctx sdk.Context, address sdk.ConsAddress) (info slashingtypes.ValidatorSigningInfo, found bool)
+	JailUntil(ctx sdk.Context, consAddr sdk.ConsAddress, jailTime time.Time)
+}
+
+// OracleHooks are event hooks triggered by the oracle module when the rounds of prices end
+type OracleHooks interface {
+	// AfterPriceUpdated is called after a new price of the token is finalized in the round
+	AfterPriceUpdated(ctx sdk.Context, tokenID, roundID uint64, price PriceTimeRound)
+	// AfterRoundFailed is called after a round of the token ends without a final price, the
+	// round is filled with the previous price
+	AfterRoundFailed(ctx sdk.Context, tokenID, roundID uint64)
+}
@@ -2,6 +2,8 @@ package types
 
 import (
 	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 // DefaultIndex is the default global index
@@ -10,15 +12,17 @@ const DefaultIndex uint64 = 1
 // DefaultGenesis returns the default genesis state
 func DefaultGenesis() *GenesisState {
 	return &GenesisState{
-		PricesList:           []Prices{},
-		ValidatorUpdateBlock: nil,
-		IndexRecentParams:    nil,
-		IndexRecentMsg:       nil,
-		RecentMsgList:        []RecentMsg{},
-		RecentParamsList:     []RecentParams{},
-		Params:               DefaultParams(),
-		StakerInfosAssets:    []StakerInfosAssets{},
-		StakerListAssets:     []StakerListAssets{},
+		PricesList:            []Prices{},
+		ValidatorUpdateBlock:  nil,
+		IndexRecentParams:     nil,
+		IndexRecentMsg:        nil,
+		RecentMsgList:         []RecentMsg{},
+		RecentParamsList:      []RecentParams{},
+		Params:                DefaultParams(),
+		StakerInfosAssets:     []StakerInfosAssets{},
+		StakerListAssets:      []StakerListAssets{},
+		ValidatorReportInfos:  []ValidatorReportInfo{},
+		ValidatorMissedRounds: []ValidatorMissedRounds{},
 		// this line is used by starport scaffolding # genesis/types/default
 	}
 }
@@ -102,7 +106,75 @@ func (gs GenesisState) Validate() error {
 			return fmt.Errorf("assetID %s in stakerInfosAssets not found in stakerLisetAssets", stakerInfosAsset.AssetId)
 		}
 	}
+	if err := gs.validateLiveness(); err != nil {
+		return err
+	}
 	// this line is used by starport scaffolding # genesis/types/validate
 
 	return gs.Params.Validate()
 }
+
+// validateLiveness checks the liveness of the validators as price feeders: the missed rounds
+// of a validator must belong to its report info, lie within the window and match its counter.
+func (gs GenesisState) validateLiveness() error {
+	reportInfos := make(map[string]ValidatorReportInfo, len(gs.ValidatorReportInfos))
+	for _, info := range gs.ValidatorReportInfos {
+		if _, err := sdk.ConsAddressFromBech32(info.Validator); err != nil {
+			return fmt.Errorf("invalid validator %s in validatorReportInfos: %w", info.Validator, err)
+		}
+		if info.FeederID < 1 {
+			return fmt.Errorf("invalid feederID 0 in validatorReportInfos for validator %s", info.Validator)
+		}
+		if info.IndexOffset < 0 || info.MissedRoundsCounter < 0 || info.MissedRoundsCounter > info.IndexOffset {
+			return fmt.Errorf(
+				"invalid counters in validatorReportInfos for validator %s and feederID %d, indexOffset: %d, missedRoundsCounter: %d",
+				info.Validator, info.FeederID, info.IndexOffset, info.MissedRoundsCounter,
+			)
+		}
+		key := string(ValidatorReportInfoKey(info.Validator, info.FeederID))
+		if _, ok := reportInfos[key]; ok {
+			return fmt.Errorf("duplicated validatorReportInfo for validator %s and feederID %d", info.Validator, info.FeederID)
+		}
+		reportInfos[key] = info
+	}
+	missedRoundsSeen := make(map[string]struct{}, len(gs.ValidatorMissedRounds))
+	for _, elem := range gs.ValidatorMissedRounds {
+		key := string(ValidatorReportInfoKey(elem.Validator, elem.FeederID))
+		info, ok := reportInfos[key]
+		if !ok {
+			return fmt.Errorf("validatorMissedRounds for validator %s and feederID %d without validatorReportInfo", elem.Validator, elem.FeederID)
+		}
+		if _, ok := missedRoundsSeen[key]; ok {
+			return fmt.Errorf("duplicated validatorMissedRounds for validator %s and feederID %d", elem.Validator, elem.FeederID)
+		}
+		missedRoundsSeen[key] = struct{}{}
+		indexes := make(map[uint64]struct{}, len(elem.MissedRounds))
+		for _, index := range elem.MissedRounds {
+			if _, ok := indexes[index]; ok {
+				return fmt.Errorf("duplicated missed round %d for validator %s and feederID %d", index, elem.Validator, elem.FeederID)
+			}
+			// #nosec G115 // the window is validated to be positive
+			if gs.Params.Slashing != nil && index >= uint64(gs.Params.Slashing.ReportedRoundsWindow) {
+				return fmt.Errorf("missed round %d out of the window for validator %s and feederID %d", index, elem.Validator, elem.FeederID)
+			}
+			indexes[index] = struct{}{}
+		}
+		if int64(len(indexes)) != info.MissedRoundsCounter {
+			return fmt.Errorf(
+				"missedRoundsCounter %d doesn't match the %d missed rounds for validator %s and feederID %d",
+				info.MissedRoundsCounter, len(indexes), elem.Validator, elem.FeederID,
+			)
+		}
+	}
+	// the report infos with missed rounds must have their bitmaps exported too
+	for _, info := range gs.ValidatorReportInfos {
+		key := string(ValidatorReportInfoKey(info.Validator, info.FeederID))
+		if _, ok := missedRoundsSeen[key]; !ok && info.MissedRoundsCounter > 0 {
+			return fmt.Errorf(
+				"missedRoundsCounter %d without missed rounds for validator %s and feederID %d",
+				info.MissedRoundsCounter, info.Validator, info.FeederID,
+			)
+		}
+	}
+	return nil
+}
@@ -4,10 +4,19 @@ import (
 	"testing"
 
 	"github.com/ExocoreNetwork/exocore/x/oracle/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
 )
 
+func livenessGenesis(infos []types.ValidatorReportInfo, missedRounds []types.ValidatorMissedRounds) *types.GenesisState {
+	genState := types.DefaultGenesis()
+	genState.ValidatorReportInfos = infos
+	genState.ValidatorMissedRounds = missedRounds
+	return genState
+}
+
 func TestGenesisState_Validate(t *testing.T) {
+	validator := sdk.ConsAddress("validator").String()
 	tests := []struct {
 		desc     string
 		genState *types.GenesisState
@@ -49,12 +58,14 @@ func TestGenesisState_Validate(t *testing.T) {
 					},
 				},
 				Params: types.Params{
-					MaxNonce:      3,
-					ThresholdA:    2,
-					ThresholdB:    3,
-					Mode:          types.ConsensusModeASAP,
-					MaxDetId:      5,
-					MaxSizePrices: 100,
+					MaxNonce:          3,
+					ThresholdA:        2,
+					ThresholdB:        3,
+					Mode:              types.ConsensusModeASAP,
+					MaxDetId:          5,
+					MaxSizePrices:     100,
+					AggregationMethod: types.AggregationMethodWeightedMedian,
+					TrimFraction:      sdk.NewDecWithPrec(1, 1),
 				},
 				// this line is used by starport scaffolding # types/genesis/validField
 			},
@@ -297,6 +308,62 @@ func TestGenesisState_Validate(t *testing.T) {
 			valid: false,
 		},
 
+		{
+			desc: "valid liveness",
+			genState: livenessGenesis(
+				[]types.ValidatorReportInfo{{Validator: validator, FeederID: 1, IndexOffset: 5, MissedRoundsCounter: 2}},
+				[]types.ValidatorMissedRounds{{Validator: validator, FeederID: 1, MissedRounds: []uint64{1, 3}}},
+			),
+			valid: true,
+		},
+		{
+			desc: "invalid validator in liveness",
+			genState: livenessGenesis(
+				[]types.ValidatorReportInfo{{Validator: "validator", FeederID: 1}}, nil,
+			),
+			valid: false,
+		},
+		{
+			desc: "duplicated liveness",
+			genState: livenessGenesis(
+				[]types.ValidatorReportInfo{{Validator: validator, FeederID: 1}, {Validator: validator, FeederID: 1}}, nil,
+			),
+			valid: false,
+		},
+		{
+			desc: "missed rounds without liveness",
+			genState: livenessGenesis(
+				[]types.ValidatorReportInfo{{Validator: validator, FeederID: 1, IndexOffset: 5, MissedRoundsCounter: 1}},
+				[]types.ValidatorMissedRounds{{Validator: validator, FeederID: 2, MissedRounds: []uint64{1}}},
+			),
+			valid: false,
+		},
+		{
+			desc: "missed rounds not matching the counter",
+			genState: livenessGenesis(
+				[]types.ValidatorReportInfo{{Validator: validator, FeederID: 1, IndexOffset: 5, MissedRoundsCounter: 1}},
+				[]types.ValidatorMissedRounds{{Validator: validator, FeederID: 1, MissedRounds: []uint64{1, 3}}},
+			),
+			valid: false,
+		},
+		{
+			desc: "missed round out of the window",
+			genState: livenessGenesis(
+				[]types.ValidatorReportInfo{{Validator: validator, FeederID: 1, IndexOffset: 5, MissedRoundsCounter: 1}},
+				[]types.ValidatorMissedRounds{{
+					Validator: validator, FeederID: 1,
+					MissedRounds: []uint64{uint64(types.DefaultSlashingParams().ReportedRoundsWindow)},
+				}},
+			),
+			valid: false,
+		},
+		{
+			desc: "missed rounds counter without missed rounds",
+			genState: livenessGenesis(
+				[]types.ValidatorReportInfo{{Validator: validator, FeederID: 1, IndexOffset: 5, MissedRoundsCounter: 1}}, nil,
+			),
+			valid: false,
+		},
 		// this line is used by starport scaffolding # types/genesis/testcase
 	}
 	for _, tc := range tests {
@@ -0,0 +1,27 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var _ OracleHooks = &MultiOracleHooks{}
+
+// MultiOracleHooks is a collection of OracleHooks, all hook functions are run in the order of
+// the array.
+type MultiOracleHooks []OracleHooks
+
+func NewMultiOracleHooks(hooks ...OracleHooks) MultiOracleHooks {
+	return hooks
+}
+
+func (hooks MultiOracleHooks) AfterPriceUpdated(ctx sdk.Context, tokenID, roundID uint64, price PriceTimeRound) {
+	for _, hook := range hooks {
+		hook.AfterPriceUpdated(ctx, tokenID, roundID, price)
+	}
+}
+
+func (hooks MultiOracleHooks) AfterRoundFailed(ctx sdk.Context, tokenID, roundID uint64) {
+	for _, hook := range hooks {
+		hook.AfterRoundFailed(ctx, tokenID, roundID)
+	}
+}
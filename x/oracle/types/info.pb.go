@@ -92,6 +92,14 @@ type Token struct {
 	Active bool `protobuf:"varint,5,opt,name=active,proto3" json:"active,omitempty"`
 	// refer to assetID from assets module if exists
 	AssetID string `protobuf:"bytes,6,opt,name=asset_id,json=assetId,proto3" json:"asset_id,omitempty"`
+	// max_price_age is the maximum number of blocks since the latest price of the token was
+	// finalized, after which the price is stale and the assets bonded with the token are unpriced.
+	// 0 means the price never goes stale.
+	MaxPriceAge uint64 `protobuf:"varint,7,opt,name=max_price_age,json=maxPriceAge,proto3" json:"max_price_age,omitempty"`
+	// disable_max_price_age is only used to update the token: since a zero max_price_age leaves
+	// it unchanged, it resets max_price_age to 0 so that the price never goes stale. It is never
+	// stored in the params.
+	DisableMaxPriceAge bool `protobuf:"varint,8,opt,name=disable_max_price_age,json=disableMaxPriceAge,proto3" json:"disable_max_price_age,omitempty"`
 }
 
 func (m *Token) Reset()         { *m = Token{} }
@@ -169,6 +177,20 @@ func (m *Token) GetAssetID() string {
 	return ""
 }
 
+func (m *Token) GetMaxPriceAge() uint64 {
+	if m != nil {
+		return m.MaxPriceAge
+	}
+	return 0
+}
+
+func (m *Token) GetDisableMaxPriceAge() bool {
+	if m != nil {
+		return m.DisableMaxPriceAge
+	}
+	return false
+}
+
 // Endpoint tells where to fetch the price info
 type Endpoint struct {
 	// url int refer to TokenList.ID, 0 reprents default for all (as fall back)
@@ -311,37 +333,40 @@ func init() {
 func init() { proto.RegisterFile("exocore/oracle/v1/info.proto", fileDescriptor_cba81d5a815c12db) }
 
 var fileDescriptor_cba81d5a815c12db = []byte{
-	// 467 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x53, 0x41, 0x6f, 0xd3, 0x30,
-	0x14, 0xae, 0xdb, 0xa6, 0x0d, 0xaf, 0x4c, 0x0c, 0x6b, 0x42, 0x51, 0x41, 0xa1, 0xaa, 0x10, 0xca,
-	0x2e, 0x09, 0x1d, 0x17, 0x34, 0x4e, 0x2b, 0xf4, 0x50, 0x0e, 0x20, 0x05, 0x4e, 0x5c, 0xa6, 0xcc,
-	0x7e, 0xed, 0xac, 0xb6, 0x76, 0x95, 0xb8, 0x65, 0xfd, 0x0d, 0x48, 0x88, 0x9f, 0xb5, 0xe3, 0x8e,
-	0x9c, 0x10, 0x6a, 0xff, 0x08, 0xb2, 0x9d, 0xa0, 0x4d, 0x4c, 0x93, 0x76, 0x7b, 0xef, 0xfb, 0xde,
-	0xf7, 0xf9, 0x8b, 0x5f, 0x0c, 0xcf, 0xf0, 0x42, 0x31, 0x95, 0x63, 0xa2, 0xf2, 0x8c, 0xcd, 0x31,
-	0x59, 0x0f, 0x12, 0x21, 0x27, 0x2a, 0x5e, 0xe6, 0x4a, 0x2b, 0xfa, 0xb8, 0x64, 0x63, 0xc7, 0xc6,
-	0xeb, 0x41, 0xf7, 0x60, 0xaa, 0xa6, 0xca, 0xb2, 0x89, 0xa9, 0xdc, 0x60, 0x3f, 0x01, 0xef, 0xdd,
-	0x79, 0x26, 0x24, 0xa5, 0xd0, 0x94, 0xd9, 0x02, 0x03, 0xd2, 0x23, 0xd1, 0x83, 0xd4, 0xd6, 0x06,
-	0xe3, 0x58, 0xb0, 0xa0, 0xee, 0x30, 0x53, 0xf7, 0x2f, 0x09, 0x78, 0x5f, 0xd4, 0x0c, 0x6f, 0x57,
-	0xbc, 0x04, 0x9f, 0x19, 0xbb, 0x53, 0xc1, 0xad, 0xaa, 0x39, 0xec, 0x6c, 0x7f, 0x3f, 0x6f, 0xdb,
-	0x23, 0xc6, 0xef, 0xd3, 0xb6, 0x25, 0xc7, 0x9c, 0x1e, 0xc2, 0x3e, 0x53, 0x52, 0xe7, 0x19, 0xd3,
-	0xa7, 0x19, 0xe7, 0x39, 0x16, 0x45, 0xd0, 0xb0, 0x3e, 0x8f, 0x2a, 0xfc, 0xc4, 0xc1, 0x34, 0x80,
-	0x36, 0x47, 0x26, 0x16, 0xd9, 0x3c, 0x68, 0xf6, 0x48, 0xe4, 0xa5, 0x55, 0x4b, 0x9f, 0x40, 0x2b,
-	0x63, 0x5a, 0xac, 0x31, 0xf0, 0x7a, 0x24, 0xf2, 0xd3, 0xb2, 0x33, 0x21, 0xb2, 0xa2, 0x40, 0x6d,
-	0x42, 0xb4, 0x8c, 0xa9, 0x0b, 0x71, 0x62, 0x30, 0x13, 0xc2, 0x92, 0x63, 0xde, 0xff, 0x51, 0x07,
-	0x7f, 0x24, 0xf9, 0x52, 0x09, 0xa9, 0xe9, 0x08, 0x7c, 0x35, 0x99, 0xd8, 0x7c, 0x01, 0xe9, 0x35,
-	0xa2, 0xce, 0xd1, 0x61, 0xfc, 0xdf, 0x25, 0xc6, 0xd5, 0x78, 0xfc, 0xa9, 0x9c, 0x1d, 0x49, 0x9d,
-	0x6f, 0xd2, 0x7f, 0x52, 0x3a, 0x84, 0xb6, 0x92, 0xce, 0xa5, 0x6e, 0x5d, 0xa2, 0x3b, 0x5d, 0xe4,
-	0x35, 0x93, 0x4a, 0xd8, 0x7d, 0x0b, 0x7b, 0x37, 0xec, 0xe9, 0x3e, 0x34, 0x66, 0xb8, 0xb1, 0x17,
-	0xdd, 0x4c, 0x4d, 0x49, 0x0f, 0xc0, 0x5b, 0x67, 0xf3, 0x15, 0x96, 0xab, 0x71, 0xcd, 0x71, 0xfd,
-	0x0d, 0xe9, 0x1e, 0xc3, 0xc3, 0xeb, 0xae, 0xf7, 0xd1, 0xf6, 0xbf, 0x13, 0x68, 0x7d, 0x56, 0xab,
-	0x9c, 0xe1, 0xad, 0xcb, 0x1d, 0x80, 0x87, 0xc6, 0xd3, 0x0a, 0x3b, 0x47, 0x4f, 0xef, 0xf8, 0xb2,
-	0xd4, 0x4d, 0x96, 0x67, 0x09, 0x6e, 0x97, 0xeb, 0xa7, 0xae, 0xa1, 0x2f, 0x60, 0x8f, 0xa3, 0xc6,
-	0x7c, 0x21, 0xa4, 0x28, 0xb4, 0x60, 0x76, 0xb1, 0x7e, 0x7a, 0x13, 0x1c, 0x7e, 0xb8, 0xdc, 0x86,
-	0xe4, 0x6a, 0x1b, 0x92, 0x3f, 0xdb, 0x90, 0xfc, 0xdc, 0x85, 0xb5, 0xab, 0x5d, 0x58, 0xfb, 0xb5,
-	0x0b, 0x6b, 0x5f, 0x5f, 0x4d, 0x85, 0x3e, 0x5f, 0x9d, 0xc5, 0x4c, 0x2d, 0x92, 0x91, 0xcb, 0xf0,
-	0x11, 0xf5, 0x37, 0x95, 0xcf, 0x92, 0xea, 0x55, 0x5c, 0x54, 0xef, 0x42, 0x6f, 0x96, 0x58, 0x9c,
-	0xb5, 0xec, 0xdf, 0xfe, 0xfa, 0x6f, 0x00, 0x00, 0x00, 0xff, 0xff, 0xca, 0x95, 0xe1, 0x65, 0x36,
-	0x03, 0x00, 0x00,
+	// 513 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x53, 0x4d, 0x8f, 0x12, 0x41,
+	0x10, 0x65, 0x80, 0x81, 0xb1, 0x90, 0xb8, 0x76, 0x56, 0x33, 0x41, 0x33, 0x12, 0x62, 0x0c, 0x7b,
+	0x99, 0x91, 0xf5, 0x62, 0xd6, 0x13, 0x28, 0x07, 0x4c, 0xfc, 0xc8, 0xe8, 0xc9, 0x0b, 0x69, 0xba,
+	0x0b, 0xb6, 0x03, 0x33, 0x4d, 0x66, 0x1a, 0x84, 0xdf, 0x60, 0x62, 0xfc, 0x07, 0xfe, 0x1d, 0x8f,
+	0x7b, 0xf4, 0x64, 0x0c, 0xfc, 0x11, 0xd3, 0x3d, 0x33, 0xba, 0xc4, 0xcd, 0x26, 0x7b, 0xab, 0x7a,
+	0xaf, 0xde, 0xeb, 0xd7, 0xe9, 0x2e, 0x78, 0x88, 0x1b, 0xc9, 0x64, 0x82, 0x81, 0x4c, 0x28, 0x5b,
+	0x60, 0xb0, 0xee, 0x05, 0x22, 0x9e, 0x4a, 0x7f, 0x99, 0x48, 0x25, 0xc9, 0xdd, 0x9c, 0xf5, 0x33,
+	0xd6, 0x5f, 0xf7, 0x5a, 0xc7, 0x33, 0x39, 0x93, 0x86, 0x0d, 0x74, 0x95, 0x0d, 0x76, 0x02, 0xb0,
+	0x5f, 0x9e, 0x53, 0x11, 0x13, 0x02, 0xd5, 0x98, 0x46, 0xe8, 0x5a, 0x6d, 0xab, 0x7b, 0x2b, 0x34,
+	0xb5, 0xc6, 0x38, 0xa6, 0xcc, 0x2d, 0x67, 0x98, 0xae, 0x3b, 0xdf, 0xcb, 0x60, 0x7f, 0x94, 0x73,
+	0xbc, 0x5a, 0xf1, 0x04, 0x1c, 0xa6, 0xed, 0xc6, 0x82, 0x1b, 0x55, 0x75, 0xd0, 0xd8, 0xfd, 0x7a,
+	0x54, 0x37, 0x47, 0x8c, 0x5e, 0x85, 0x75, 0x43, 0x8e, 0x38, 0x39, 0x81, 0x23, 0x26, 0x63, 0x95,
+	0x50, 0xa6, 0xc6, 0x94, 0xf3, 0x04, 0xd3, 0xd4, 0xad, 0x18, 0x9f, 0x3b, 0x05, 0xde, 0xcf, 0x60,
+	0xe2, 0x42, 0x9d, 0x23, 0x13, 0x11, 0x5d, 0xb8, 0xd5, 0xb6, 0xd5, 0xb5, 0xc3, 0xa2, 0x25, 0xf7,
+	0xa1, 0x46, 0x99, 0x12, 0x6b, 0x74, 0xed, 0xb6, 0xd5, 0x75, 0xc2, 0xbc, 0xd3, 0x21, 0x68, 0x9a,
+	0xa2, 0xd2, 0x21, 0x6a, 0xda, 0x34, 0x0b, 0xd1, 0xd7, 0x98, 0x0e, 0x61, 0xc8, 0x11, 0x27, 0x1d,
+	0x68, 0x46, 0x74, 0x33, 0x5e, 0x26, 0x82, 0xe1, 0x98, 0xce, 0xd0, 0xad, 0xeb, 0xc4, 0x61, 0x23,
+	0xa2, 0x9b, 0xf7, 0x1a, 0xeb, 0xcf, 0x90, 0xf4, 0xe0, 0x1e, 0x17, 0x29, 0x9d, 0x2c, 0x70, 0x7c,
+	0x38, 0xeb, 0x98, 0x23, 0x49, 0x4e, 0xbe, 0xf9, 0x27, 0xe9, 0x7c, 0x2d, 0x83, 0x33, 0x8c, 0xf9,
+	0x52, 0x8a, 0x58, 0x91, 0x21, 0x38, 0x72, 0x3a, 0x35, 0xd7, 0x76, 0xad, 0x76, 0xa5, 0xdb, 0x38,
+	0x3d, 0xf1, 0xff, 0x7b, 0x1b, 0xbf, 0x18, 0xf7, 0xdf, 0xe5, 0xb3, 0xc3, 0x58, 0x25, 0xdb, 0xf0,
+	0xaf, 0x94, 0x0c, 0xa0, 0x2e, 0xe3, 0xcc, 0xa5, 0x6c, 0x5c, 0xba, 0xd7, 0xba, 0xc4, 0x97, 0x4c,
+	0x0a, 0x61, 0xeb, 0x05, 0x34, 0x0f, 0xec, 0xc9, 0x11, 0x54, 0xe6, 0xb8, 0x35, 0xef, 0x57, 0x0d,
+	0x75, 0x49, 0x8e, 0xc1, 0x5e, 0xd3, 0xc5, 0x0a, 0xf3, 0x17, 0xcf, 0x9a, 0xb3, 0xf2, 0x73, 0xab,
+	0x75, 0x06, 0xb7, 0x2f, 0xbb, 0xde, 0x44, 0xdb, 0xf9, 0x62, 0x41, 0xed, 0x83, 0x5c, 0x25, 0x0c,
+	0xaf, 0xfc, 0x33, 0x3d, 0xb0, 0x51, 0x7b, 0x1a, 0x61, 0xe3, 0xf4, 0xc1, 0x35, 0x37, 0x0b, 0xb3,
+	0xc9, 0xfc, 0x2c, 0xc1, 0xcd, 0x9f, 0x71, 0xc2, 0xac, 0x21, 0x8f, 0xa1, 0xc9, 0x51, 0x61, 0x12,
+	0x89, 0x58, 0xa4, 0x4a, 0x30, 0xf3, 0x5f, 0x9c, 0xf0, 0x10, 0x1c, 0xbc, 0xfe, 0xb1, 0xf3, 0xac,
+	0x8b, 0x9d, 0x67, 0xfd, 0xde, 0x79, 0xd6, 0xb7, 0xbd, 0x57, 0xba, 0xd8, 0x7b, 0xa5, 0x9f, 0x7b,
+	0xaf, 0xf4, 0xe9, 0xe9, 0x4c, 0xa8, 0xf3, 0xd5, 0xc4, 0x67, 0x32, 0x0a, 0x86, 0x59, 0x86, 0xb7,
+	0xa8, 0x3e, 0xcb, 0x64, 0x1e, 0x14, 0xcb, 0xb6, 0x29, 0xd6, 0x4d, 0x6d, 0x97, 0x98, 0x4e, 0x6a,
+	0x66, 0x89, 0x9e, 0xfd, 0x09, 0x00, 0x00, 0xff, 0xff, 0x95, 0x59, 0x1a, 0xfc, 0x8d, 0x03, 0x00,
+	0x00,
 }
 
 func (m *Chain) Marshal() (dAtA []byte, err error) {
@@ -401,6 +426,21 @@ func (m *Token) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.DisableMaxPriceAge {
+		i--
+		if m.DisableMaxPriceAge {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.MaxPriceAge != 0 {
+		i = encodeVarintInfo(dAtA, i, uint64(m.MaxPriceAge))
+		i--
+		dAtA[i] = 0x38
+	}
 	if len(m.AssetID) > 0 {
 		i -= len(m.AssetID)
 		copy(dAtA[i:], m.AssetID)
@@ -619,6 +659,12 @@ func (m *Token) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovInfo(uint64(l))
 	}
+	if m.MaxPriceAge != 0 {
+		n += 1 + sovInfo(uint64(m.MaxPriceAge))
+	}
+	if m.DisableMaxPriceAge {
+		n += 2
+	}
 	return n
 }
 
@@ -973,6 +1019,45 @@ func (m *Token) Unmarshal(dAtA []byte) error {
 			}
 			m.AssetID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPriceAge", wireType)
+			}
+			m.MaxPriceAge = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInfo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxPriceAge |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DisableMaxPriceAge", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInfo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DisableMaxPriceAge = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipInfo(dAtA[iNdEx:])
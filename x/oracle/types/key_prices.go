@@ -7,6 +7,9 @@ var _ binary.ByteOrder
 const (
 	// PricesKeyPrefix is the prefix to retrieve all Prices
 	PricesKeyPrefix = "Prices/value/"
+	// PriceUpdatedHeightKeyPrefix is the prefix to retrieve the heights when the latest prices
+	// of the tokens were finalized
+	PriceUpdatedHeightKeyPrefix = "PriceUpdatedHeight/value/"
 )
 
 // PricesNextRoundIDKey is the key set for each tokenId storeKV to store the next round id
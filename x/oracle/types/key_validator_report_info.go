@@ -0,0 +1,69 @@
+package types
+
+const (
+	// ValidatorReportInfoKeyPrefix is the prefix to retrieve all ValidatorReportInfo
+	ValidatorReportInfoKeyPrefix = "ValidatorReportInfo/value/"
+	// MissedRoundBitArrayKeyPrefix is the prefix to retrieve the missed rounds bitmap of all
+	// validators
+	MissedRoundBitArrayKeyPrefix = "MissedRoundBitArray/value/"
+	// ValidatorDeviationInfoKeyPrefix is the prefix to retrieve all ValidatorDeviationInfo
+	ValidatorDeviationInfoKeyPrefix = "ValidatorDeviationInfo/value/"
+)
+
+// ValidatorReportInfoValidatorKey returns the store key to retrieve all ValidatorReportInfo
+// of a validator
+func ValidatorReportInfoValidatorKey(
+	validator string,
+) []byte {
+	var key []byte
+
+	key = append(key, validator...)
+	key = append(key, []byte("/")...)
+
+	return key
+}
+
+// ValidatorReportInfoKey returns the store key to retrieve a ValidatorReportInfo from the
+// index fields
+func ValidatorReportInfoKey(
+	validator string,
+	feederID uint64,
+) []byte {
+	key := ValidatorReportInfoValidatorKey(validator)
+	key = append(key, Uint64Bytes(feederID)...)
+	key = append(key, []byte("/")...)
+
+	return key
+}
+
+// MissedRoundBitArrayPrefix returns the prefix to retrieve the missed rounds bitmap of a
+// validator for a feeder
+func MissedRoundBitArrayPrefix(
+	validator string,
+	feederID uint64,
+) []byte {
+	return ValidatorReportInfoKey(validator, feederID)
+}
+
+// MissedRoundBitArrayKey returns the store key to retrieve whether a validator missed the
+// round at the index of the window for a feeder
+func MissedRoundBitArrayKey(
+	validator string,
+	feederID uint64,
+	index uint64,
+) []byte {
+	key := MissedRoundBitArrayPrefix(validator, feederID)
+	key = append(key, Uint64Bytes(index)...)
+	key = append(key, []byte("/")...)
+
+	return key
+}
+
+// ValidatorDeviationInfoKey returns the store key to retrieve a ValidatorDeviationInfo from
+// the index fields
+func ValidatorDeviationInfoKey(
+	validator string,
+	feederID uint64,
+) []byte {
+	return ValidatorReportInfoKey(validator, feederID)
+}
@@ -3,7 +3,9 @@ package types
 import (
 	"errors"
 	"strings"
+	"time"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 	"gopkg.in/yaml.v2"
 )
@@ -41,6 +43,9 @@ func NewParams() Params {
 		Sources:      []*Source{{}},
 		Rules:        []*RuleSource{{}},
 		TokenFeeders: []*TokenFeeder{{}},
+		// weight the prices by the power of the validators reporting them
+		AggregationMethod: AggregationMethodWeightedMedian,
+		TrimFraction:      sdk.NewDecWithPrec(1, 1),
 	}
 }
 
@@ -103,6 +108,26 @@ func DefaultParams() Params {
 		Mode:          ConsensusModeASAP,
 		MaxDetId:      5,
 		MaxSizePrices: 100,
+		Slashing:      DefaultSlashingParams(),
+		// weight the prices by the power of the validators reporting them
+		AggregationMethod: AggregationMethodWeightedMedian,
+		TrimFraction:      sdk.NewDecWithPrec(1, 1),
+	}
+}
+
+// DefaultSlashingParams returns the default parameters of the liveness tracking of the
+// validators as price feeders
+func DefaultSlashingParams() *SlashingParams {
+	return &SlashingParams{
+		ReportedRoundsWindow:   100,
+		MinReportedPerWindow:   sdk.NewDecWithPrec(5, 1),
+		OracleMissJailDuration: 600 * time.Second,
+		SlashFractionMiss:      sdk.NewDecWithPrec(1, 4),
+		// a price deviating more than 10% from the final price in 3 consecutive reports
+		MaxPriceDeviation:           sdk.NewDecWithPrec(1, 1),
+		MaxConsecutiveDeviations:    3,
+		OracleMaliciousJailDuration: 24 * time.Hour,
+		SlashFractionMalicious:      sdk.NewDecWithPrec(1, 2),
 	}
 }
 
@@ -125,10 +150,29 @@ func (p Params) Validate() error {
 	// MaxDetID: This only works for DS, to tell how many continuous roundID_from_DS could be accept at most for one round of exorcore_oracle
 	// ThresholdA/ThresholdB: represents the threshold of voting power to confirm a price as final price
 	// Mode: tells how and when to confirm a final price, expect for voting power threshold, v1 set this value to 1 means final price will be confirmed as soon as it has reached the threshold of total voting power, and just ignore any remaining transactions followed for current round.
-	if p.MaxNonce < 1 || p.MaxDetId < 1 || p.ThresholdA < 1 || p.ThresholdB < p.ThresholdA || p.Mode != ConsensusModeASAP || p.MaxSizePrices < 1 {
+	if p.MaxNonce < 1 || p.MaxDetId < 1 || p.ThresholdA < 1 || p.ThresholdB < p.ThresholdA || !p.Mode.IsValid() || p.MaxSizePrices < 1 {
 		return ErrInvalidParams.Wrapf("invalid maxNonce/maxDetID/Threshold/Mode/MaxSizePrices: %d, %d, %d, %d, %d, %d", p.MaxNonce, p.MaxDetId, p.ThresholdA, p.ThresholdB, p.Mode, p.MaxSizePrices)
 	}
 
+	// the params stored before the aggregation method was introduced have neither of the
+	// method and the trim fraction set, which aggregate the final price by the median.
+	if p.AggregationMethod != AggregationMethodUnspecified && !p.AggregationMethod.IsValid() {
+		return ErrInvalidParams.Wrapf("invalid aggregationMethod: %d", p.AggregationMethod)
+	}
+	if p.TrimFraction.IsNil() && p.AggregationMethod == AggregationMethodTrimmedMean {
+		return ErrInvalidParams.Wrap("trimFraction is required by the trimmed mean")
+	}
+	// trimming half of the power from each end leaves no price to aggregate
+	if !p.TrimFraction.IsNil() && (p.TrimFraction.IsNegative() || p.TrimFraction.GTE(sdk.NewDecWithPrec(5, 1))) {
+		return ErrInvalidParams.Wrapf("invalid trimFraction: %s", p.TrimFraction)
+	}
+
+	if p.Slashing != nil {
+		if err := p.Slashing.validate(); err != nil {
+			return err
+		}
+	}
+
 	// validate tokenFeeders
 	feeders := make(map[uint64]*TokenFeeder)
 	for fID, feeder := range p.TokenFeeders {
@@ -211,16 +255,20 @@ func (p Params) Validate() error {
 		}
 		// cross validation with sources
 		for _, id := range rule.SourceIDs {
-			if id >= uint64(len(p.Rules)) {
+			if id >= uint64(len(p.Sources)) {
 				return ErrInvalidParams.Wrap("invalid rule")
 			}
 		}
 		if rule.Nom != nil {
-			for _, id := range rule.Nom.SourceIDs {
-				if id < 1 || id >= uint64(len(p.Rules)) {
+			for i, id := range rule.Nom.SourceIDs {
+				// 0 is only allowed as the 1st source to require all valid sources
+				if (id < 1 && i > 0) || id >= uint64(len(p.Sources)) {
 					return ErrInvalidParams.Wrap("invalid rule")
 				}
 			}
+			if rule.Nom.Minimum > uint64(len(p.ruleSourceIDs(rule.Nom.SourceIDs))) {
+				return ErrInvalidParams.Wrap("invalid rule, minimum exceeds the count of sources")
+			}
 		}
 	}
 	// validete sources
@@ -272,7 +320,8 @@ func (p Params) AddChains(chains ...*Chain) (Params, error) {
 // UpdateTokens upates token info
 // Since we don't allow to add any new token with the same Name&ChainID existed, so all fileds except the those are able to be modified
 // contractAddress and decimal are only allowed before any tokenFeeder of that token had been started
-// assetID is allowed to be modified no matter any tokenFeeder is started
+// assetID and maxPriceAge are allowed to be modified no matter any tokenFeeder is started
+// a zero maxPriceAge leaves it unchanged, disableMaxPriceAge resets it to 0 instead
 func (p Params) UpdateTokens(currentHeight uint64, tokens ...*Token) (Params, error) {
 	for _, t := range tokens {
 		update := false
@@ -286,6 +335,12 @@ func (p Params) UpdateTokens(currentHeight uint64, tokens ...*Token) (Params, er
 				if len(t.AssetID) > 0 {
 					token.AssetID = t.AssetID
 				}
+				// update the max age of the prices
+				if t.DisableMaxPriceAge {
+					token.MaxPriceAge = 0
+				} else if t.MaxPriceAge > 0 {
+					token.MaxPriceAge = t.MaxPriceAge
+				}
 				if !p.TokenStarted(uint64(tokenID), currentHeight) {
 					// contractAddres is mainly used as a description information
 					if len(t.ContractAddress) > 0 {
@@ -302,6 +357,10 @@ func (p Params) UpdateTokens(currentHeight uint64, tokens ...*Token) (Params, er
 		}
 		// add a new token
 		if !update {
+			if t.DisableMaxPriceAge {
+				t.MaxPriceAge = 0
+				t.DisableMaxPriceAge = false
+			}
 			p.Tokens = append(p.Tokens, t)
 		}
 	}
@@ -334,6 +393,90 @@ func (p Params) UpdateMaxPriceCount(count int32) (Params, error) {
 	return p, nil
 }
 
+// UpdateMode updates the consensus mode, it's left unchanged if the unspecified mode is provided
+func (p Params) UpdateMode(mode ConsensusMode) (Params, error) {
+	if mode == ConsensusModeUnspecified {
+		return p, nil
+	}
+	if !mode.IsValid() {
+		return p, ErrInvalidParams.Wrapf("invalid mode: %d", mode)
+	}
+	p.Mode = mode
+	return p, nil
+}
+
+// UpdateAggregation updates the method to aggregate the final price and the fraction of power
+// trimmed by the trimmed mean, each of them is left unchanged if it's not provided
+func (p Params) UpdateAggregation(method AggregationMethod, trimFraction sdk.Dec) (Params, error) {
+	if method != AggregationMethodUnspecified {
+		if !method.IsValid() {
+			return p, ErrInvalidParams.Wrapf("invalid aggregationMethod: %d", method)
+		}
+		p.AggregationMethod = method
+	}
+	if !trimFraction.IsNil() {
+		p.TrimFraction = trimFraction
+	}
+	return p, nil
+}
+
+// UpdateSlashingParams replaces the parameters of the liveness tracking, it's left unchanged if
+// nil is provided
+func (p Params) UpdateSlashingParams(slashing *SlashingParams) (Params, error) {
+	if slashing == nil {
+		return p, nil
+	}
+	if err := slashing.validate(); err != nil {
+		return p, err
+	}
+	p.Slashing = slashing
+	return p, nil
+}
+
+// MaxMissedRoundsPerWindow returns the maximum number of rounds a validator is allowed to miss
+// within the window before being jailed
+func (s SlashingParams) MaxMissedRoundsPerWindow() int64 {
+	minReported := s.MinReportedPerWindow.MulInt64(s.ReportedRoundsWindow).RoundInt64()
+	return s.ReportedRoundsWindow - minReported
+}
+
+func (s SlashingParams) validate() error {
+	if s.ReportedRoundsWindow < 1 {
+		return ErrInvalidParams.Wrapf("invalid slashing params, reportedRoundsWindow: %d", s.ReportedRoundsWindow)
+	}
+	if s.MinReportedPerWindow.IsNil() || s.MinReportedPerWindow.IsNegative() || s.MinReportedPerWindow.GT(sdk.OneDec()) {
+		return ErrInvalidParams.Wrapf("invalid slashing params, minReportedPerWindow: %s", s.MinReportedPerWindow)
+	}
+	if s.OracleMissJailDuration <= 0 {
+		return ErrInvalidParams.Wrapf("invalid slashing params, oracleMissJailDuration: %s", s.OracleMissJailDuration)
+	}
+	if s.SlashFractionMiss.IsNil() || s.SlashFractionMiss.IsNegative() || s.SlashFractionMiss.GT(sdk.OneDec()) {
+		return ErrInvalidParams.Wrapf("invalid slashing params, slashFractionMiss: %s", s.SlashFractionMiss)
+	}
+	if !s.MaliciousDetectionEnabled() {
+		if !s.MaxPriceDeviation.IsNil() && s.MaxPriceDeviation.IsNegative() {
+			return ErrInvalidParams.Wrapf("invalid slashing params, maxPriceDeviation: %s", s.MaxPriceDeviation)
+		}
+		return nil
+	}
+	if s.MaxConsecutiveDeviations < 1 {
+		return ErrInvalidParams.Wrapf("invalid slashing params, maxConsecutiveDeviations: %d", s.MaxConsecutiveDeviations)
+	}
+	if s.OracleMaliciousJailDuration <= 0 {
+		return ErrInvalidParams.Wrapf("invalid slashing params, oracleMaliciousJailDuration: %s", s.OracleMaliciousJailDuration)
+	}
+	if s.SlashFractionMalicious.IsNil() || s.SlashFractionMalicious.IsNegative() || s.SlashFractionMalicious.GT(sdk.OneDec()) {
+		return ErrInvalidParams.Wrapf("invalid slashing params, slashFractionMalicious: %s", s.SlashFractionMalicious)
+	}
+	return nil
+}
+
+// MaliciousDetectionEnabled returns whether the prices reported by the validators are checked
+// against the final prices
+func (s SlashingParams) MaliciousDetectionEnabled() bool {
+	return !s.MaxPriceDeviation.IsNil() && s.MaxPriceDeviation.IsPositive()
+}
+
 // UpdateTokenFeeder updates tokenfeeder info, validation first
 func (p Params) UpdateTokenFeeder(tf *TokenFeeder, currentHeight uint64) (Params, error) {
 	tfIDs := p.GetFeederIDsByTokenID(tf.TokenID)
@@ -450,8 +593,10 @@ func (r RuleSource) validate() error {
 	if len(r.SourceIDs) == 0 && (r.Nom == nil || len(r.Nom.SourceIDs) == 0) {
 		return ErrInvalidParams.Wrap("invalid RuleSource")
 	}
-	if r.Nom != nil &&
-		r.Nom.Minimum > uint64(len(r.Nom.SourceIDs)) {
+	// the minimum of sources to be fulfilled is cross validated with the sources of params when
+	// all valid sources are required
+	if r.Nom != nil && (len(r.Nom.SourceIDs) == 0 || r.Nom.Minimum < 1 ||
+		(r.Nom.SourceIDs[0] != 0 && r.Nom.Minimum > uint64(len(r.Nom.SourceIDs)))) {
 		return ErrInvalidParams.Wrap("invalid RuleSource")
 	}
 	return nil
@@ -536,53 +681,86 @@ func (p Params) GetTokenInfo(feederID uint64) *Token {
 func (p Params) CheckRules(feederID uint64, prices []*PriceSource) (bool, error) {
 	feeder := p.TokenFeeders[feederID]
 	rule := p.Rules[feeder.RuleID]
+	sourceIDs := make(map[uint64]struct{}, len(prices))
+	for _, price := range prices {
+		sourceIDs[price.SourceID] = struct{}{}
+	}
 	// specified sources set, v1 use this rule to set `chainlink` as official source
 	if len(rule.SourceIDs) > 0 {
-		if len(rule.SourceIDs) != len(prices) {
+		required := p.ruleSourceIDs(rule.SourceIDs)
+		if len(required) != len(prices) {
 			return false, errors.New("count prices should match rule")
 		}
-		notFound := false
-		if rule.SourceIDs[0] == 0 {
-			// match all sources listed
-			for sID, source := range p.Sources {
-				if sID == 0 {
-					continue
-				}
-				if source.Valid {
-					notFound = true
-					for _, p := range prices {
-						if p.SourceID == uint64(sID) {
-							notFound = false
-							break
-						}
-					}
-
-				}
+		for _, sourceID := range required {
+			if _, ok := sourceIDs[sourceID]; !ok {
+				return false, errors.New("price source not match with rule")
 			}
-		} else {
-			for _, source := range rule.SourceIDs {
-				notFound = true
-				for _, p := range prices {
-					if p.SourceID == source {
-						notFound = false
-						break
-					}
-				}
+		}
+		return true, nil
+	}
+
+	// n out of the sources set
+	if rule.Nom != nil {
+		candidates := make(map[uint64]struct{})
+		for _, sourceID := range p.ruleSourceIDs(rule.Nom.SourceIDs) {
+			candidates[sourceID] = struct{}{}
+		}
+		for sourceID := range sourceIDs {
+			if _, ok := candidates[sourceID]; !ok {
+				return false, errors.New("price source not match with rule")
 			}
 		}
-		if notFound {
-			return false, errors.New("price source not match with rule")
+		if uint64(len(sourceIDs)) < rule.Nom.Minimum {
+			return false, errors.New("count prices should reach the minimum of rule")
 		}
 	}
-
-	// TODO: check NOM
 	// return true if no rule set, we will accept any source
 	return true, nil
 }
 
+// RequiredSourceCount returns the number of sources for which a validator must have reported
+// prices to be counted in the aggregation of a feeder, as defined by the rule of the feeder
+func (p Params) RequiredSourceCount(feederID uint64) int {
+	feeder := p.TokenFeeders[feederID]
+	rule := p.Rules[feeder.RuleID]
+	if len(rule.SourceIDs) > 0 {
+		return len(p.ruleSourceIDs(rule.SourceIDs))
+	}
+	if rule.Nom != nil {
+		// #nosec G115 // the minimum is no more than the count of sources
+		return int(rule.Nom.Minimum)
+	}
+	return 1
+}
+
+// ruleSourceIDs returns the sources referred by a rule, a rule with the 1st source set to 0
+// refers to all valid sources
+func (p Params) ruleSourceIDs(sourceIDs []uint64) []uint64 {
+	if len(sourceIDs) == 0 || sourceIDs[0] != 0 {
+		return sourceIDs
+	}
+	ret := make([]uint64, 0, len(p.Sources))
+	for sID, source := range p.Sources {
+		if sID > 0 && source.Valid {
+			ret = append(ret, uint64(sID))
+		}
+	}
+	return ret
+}
+
 // CheckDecimal checks the decimal with feederID equals to decimal set in params, this check should be called after tokenfeeder valid check.
 func (p Params) CheckDecimal(feederID uint64, decimal int32) bool {
 	feeder := p.TokenFeeders[feederID]
 	token := p.Tokens[feeder.TokenID]
 	return token.Decimal == decimal
 }
+
+// IsValid returns whether the consensus mode is supported
+func (m ConsensusMode) IsValid() bool {
+	return m == ConsensusModeASAP || m == ConsensusModeRoundEnd
+}
+
+// IsValid returns whether the aggregation method is supported
+func (m AggregationMethod) IsValid() bool {
+	return m == AggregationMethodMedian || m == AggregationMethodWeightedMedian || m == AggregationMethodTrimmedMean
+}
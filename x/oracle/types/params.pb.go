@@ -5,17 +5,23 @@ package types
 
 import (
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -32,16 +38,22 @@ const (
 	// CONSENSUS_MODE_ASAP defines the mode to get final price immediately when the voting power
 	// exceeds the threshold.
 	ConsensusModeASAP ConsensusMode = 1
+	// CONSENSUS_MODE_ROUND_END defines the mode to collect prices until the end of the round, and
+	// then get the final price with the aggregation_method from the prices reported by the
+	// validators, with the latest confirmed round of each deterministic source.
+	ConsensusModeRoundEnd ConsensusMode = 2
 )
 
 var ConsensusMode_name = map[int32]string{
 	0: "CONSENSUS_MODE_UNSPECIFIED",
 	1: "CONSENSUS_MODE_ASAP",
+	2: "CONSENSUS_MODE_ROUND_END",
 }
 
 var ConsensusMode_value = map[string]int32{
 	"CONSENSUS_MODE_UNSPECIFIED": 0,
 	"CONSENSUS_MODE_ASAP":        1,
+	"CONSENSUS_MODE_ROUND_END":   2,
 }
 
 func (x ConsensusMode) String() string {
@@ -52,6 +64,45 @@ func (ConsensusMode) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_72f39bba4594b794, []int{0}
 }
 
+// AggregationMethod defines how the final price is aggregated from the prices reported by the
+// validators.
+type AggregationMethod int32
+
+const (
+	// AGGREGATION_METHOD_UNSPECIFIED defines an invalid method.
+	AggregationMethodUnspecified AggregationMethod = 0
+	// AGGREGATION_METHOD_MEDIAN defines the median of the prices, with every validator counted
+	// equally regardless of its power.
+	AggregationMethodMedian AggregationMethod = 1
+	// AGGREGATION_METHOD_WEIGHTED_MEDIAN defines the power-weighted median of the prices.
+	AggregationMethodWeightedMedian AggregationMethod = 2
+	// AGGREGATION_METHOD_TRIMMED_MEAN defines the power-weighted mean of the prices, after the
+	// trim_fraction of the total power is trimmed from each end of the sorted prices.
+	AggregationMethodTrimmedMean AggregationMethod = 3
+)
+
+var AggregationMethod_name = map[int32]string{
+	0: "AGGREGATION_METHOD_UNSPECIFIED",
+	1: "AGGREGATION_METHOD_MEDIAN",
+	2: "AGGREGATION_METHOD_WEIGHTED_MEDIAN",
+	3: "AGGREGATION_METHOD_TRIMMED_MEAN",
+}
+
+var AggregationMethod_value = map[string]int32{
+	"AGGREGATION_METHOD_UNSPECIFIED":     0,
+	"AGGREGATION_METHOD_MEDIAN":          1,
+	"AGGREGATION_METHOD_WEIGHTED_MEDIAN": 2,
+	"AGGREGATION_METHOD_TRIMMED_MEAN":    3,
+}
+
+func (x AggregationMethod) String() string {
+	return proto.EnumName(AggregationMethod_name, int32(x))
+}
+
+func (AggregationMethod) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_72f39bba4594b794, []int{1}
+}
+
 // Params defines the parameters for the module.
 type Params struct {
 	// chains represents the blockchains info
@@ -76,6 +127,15 @@ type Params struct {
 	MaxDetId int32 `protobuf:"varint,10,opt,name=max_det_id,json=maxDetId,proto3" json:"max_det_id,omitempty"`
 	// for each token, only keep max_size_prices round of prices
 	MaxSizePrices int32 `protobuf:"varint,11,opt,name=max_size_prices,json=maxSizePrices,proto3" json:"max_size_prices,omitempty"`
+	// slashing defines the liveness tracking of the validators as price feeders. It is
+	// disabled when not set.
+	Slashing *SlashingParams `protobuf:"bytes,12,opt,name=slashing,proto3" json:"slashing,omitempty"`
+	// aggregation_method defines how the final price is aggregated from the prices reported by
+	// the validators.
+	AggregationMethod AggregationMethod `protobuf:"varint,13,opt,name=aggregation_method,json=aggregationMethod,proto3,enum=exocore.oracle.v1.AggregationMethod" json:"aggregation_method,omitempty"`
+	// trim_fraction is the fraction of the total power trimmed from each end of the prices
+	// sorted in ascending order, which is only used by AGGREGATION_METHOD_TRIMMED_MEAN.
+	TrimFraction github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,14,opt,name=trim_fraction,json=trimFraction,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"trim_fraction"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -187,47 +247,187 @@ func (m *Params) GetMaxSizePrices() int32 {
 	return 0
 }
 
+func (m *Params) GetSlashing() *SlashingParams {
+	if m != nil {
+		return m.Slashing
+	}
+	return nil
+}
+
+func (m *Params) GetAggregationMethod() AggregationMethod {
+	if m != nil {
+		return m.AggregationMethod
+	}
+	return AggregationMethodUnspecified
+}
+
+// SlashingParams defines the parameters of the liveness tracking of the validators as price
+// feeders, which works like the signing info of x/slashing but counts rounds of each feeder.
+type SlashingParams struct {
+	// reported_rounds_window is the number of the latest rounds of a feeder over which the
+	// missed rounds of a validator are counted.
+	ReportedRoundsWindow int64 `protobuf:"varint,1,opt,name=reported_rounds_window,json=reportedRoundsWindow,proto3" json:"reported_rounds_window,omitempty"`
+	// min_reported_per_window is the minimum proportion of the rounds in the window for which
+	// a validator must report prices.
+	MinReportedPerWindow github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=min_reported_per_window,json=minReportedPerWindow,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"min_reported_per_window"`
+	// oracle_miss_jail_duration is the duration for which a validator is jailed after
+	// missing too many rounds.
+	OracleMissJailDuration time.Duration `protobuf:"bytes,3,opt,name=oracle_miss_jail_duration,json=oracleMissJailDuration,proto3,stdduration" json:"oracle_miss_jail_duration"`
+	// slash_fraction_miss is the fraction of the stake slashed from a validator after missing
+	// too many rounds.
+	SlashFractionMiss github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=slash_fraction_miss,json=slashFractionMiss,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"slash_fraction_miss"`
+	// max_price_deviation is the maximum relative deviation of the price reported by a validator
+	// from the final price of a round, the detection of malicious prices is disabled when zero.
+	MaxPriceDeviation github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=max_price_deviation,json=maxPriceDeviation,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_price_deviation"`
+	// max_consecutive_deviations is the number of consecutive reports out of the deviation band
+	// after which a validator is considered malicious.
+	MaxConsecutiveDeviations int64 `protobuf:"varint,6,opt,name=max_consecutive_deviations,json=maxConsecutiveDeviations,proto3" json:"max_consecutive_deviations,omitempty"`
+	// oracle_malicious_jail_duration is the duration for which a validator is jailed after
+	// being considered malicious.
+	OracleMaliciousJailDuration time.Duration `protobuf:"bytes,7,opt,name=oracle_malicious_jail_duration,json=oracleMaliciousJailDuration,proto3,stdduration" json:"oracle_malicious_jail_duration"`
+	// slash_fraction_malicious is the fraction of the stake slashed from a validator after being
+	// considered malicious.
+	SlashFractionMalicious github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,8,opt,name=slash_fraction_malicious,json=slashFractionMalicious,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"slash_fraction_malicious"`
+}
+
+func (m *SlashingParams) Reset()         { *m = SlashingParams{} }
+func (m *SlashingParams) String() string { return proto.CompactTextString(m) }
+func (*SlashingParams) ProtoMessage()    {}
+func (*SlashingParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_72f39bba4594b794, []int{1}
+}
+func (m *SlashingParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SlashingParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SlashingParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SlashingParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SlashingParams.Merge(m, src)
+}
+func (m *SlashingParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *SlashingParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_SlashingParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SlashingParams proto.InternalMessageInfo
+
+func (m *SlashingParams) GetReportedRoundsWindow() int64 {
+	if m != nil {
+		return m.ReportedRoundsWindow
+	}
+	return 0
+}
+
+func (m *SlashingParams) GetOracleMissJailDuration() time.Duration {
+	if m != nil {
+		return m.OracleMissJailDuration
+	}
+	return 0
+}
+
+func (m *SlashingParams) GetMaxConsecutiveDeviations() int64 {
+	if m != nil {
+		return m.MaxConsecutiveDeviations
+	}
+	return 0
+}
+
+func (m *SlashingParams) GetOracleMaliciousJailDuration() time.Duration {
+	if m != nil {
+		return m.OracleMaliciousJailDuration
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterEnum("exocore.oracle.v1.ConsensusMode", ConsensusMode_name, ConsensusMode_value)
+	proto.RegisterEnum("exocore.oracle.v1.AggregationMethod", AggregationMethod_name, AggregationMethod_value)
 	proto.RegisterType((*Params)(nil), "exocore.oracle.v1.Params")
+	proto.RegisterType((*SlashingParams)(nil), "exocore.oracle.v1.SlashingParams")
 }
 
 func init() { proto.RegisterFile("exocore/oracle/v1/params.proto", fileDescriptor_72f39bba4594b794) }
 
 var fileDescriptor_72f39bba4594b794 = []byte{
-	// 511 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x92, 0xbf, 0x6f, 0xd3, 0x40,
-	0x14, 0xc7, 0x6d, 0xf2, 0xa3, 0xed, 0x85, 0x40, 0x7b, 0x80, 0x74, 0x98, 0xe0, 0x5a, 0x08, 0xa1,
-	0x88, 0xc1, 0x6e, 0x1b, 0x26, 0xc4, 0x92, 0x26, 0xa9, 0x14, 0xa4, 0xa6, 0x91, 0x4d, 0x16, 0x16,
-	0xcb, 0xb1, 0x5f, 0x12, 0xab, 0xb1, 0x2f, 0xba, 0x73, 0x8a, 0xe9, 0xca, 0x82, 0x3a, 0x31, 0xb2,
-	0x54, 0x42, 0xe2, 0x9f, 0x61, 0xec, 0xc8, 0x88, 0x92, 0x7f, 0x83, 0x01, 0xf9, 0xec, 0x94, 0xa6,
-	0x49, 0xb7, 0xbb, 0xf7, 0xfd, 0x7c, 0xf4, 0x9e, 0x9e, 0x1e, 0x52, 0x21, 0xa6, 0x2e, 0x65, 0x60,
-	0x50, 0xe6, 0xb8, 0x63, 0x30, 0xce, 0xf6, 0x8d, 0x89, 0xc3, 0x9c, 0x80, 0xeb, 0x13, 0x46, 0x23,
-	0x8a, 0x77, 0xb2, 0x5c, 0x4f, 0x73, 0xfd, 0x6c, 0x5f, 0xa9, 0xac, 0x2a, 0x7e, 0x38, 0xa0, 0xa9,
-	0xa0, 0xbc, 0x5c, 0x4d, 0x23, 0x7a, 0x0a, 0xa1, 0x3d, 0x00, 0xf0, 0x80, 0x65, 0xd4, 0xe3, 0x21,
-	0x1d, 0x52, 0xf1, 0x34, 0x92, 0x57, 0x5a, 0x7d, 0xf1, 0x37, 0x87, 0x8a, 0x5d, 0xd1, 0x1d, 0xef,
-	0xa1, 0xa2, 0x3b, 0x72, 0xfc, 0x90, 0x13, 0x59, 0xcb, 0x55, 0x4b, 0x07, 0x44, 0x5f, 0x19, 0x44,
-	0x6f, 0x24, 0x80, 0x99, 0x71, 0x89, 0x21, 0x1a, 0x71, 0x72, 0xef, 0x4e, 0xe3, 0x43, 0x02, 0x98,
-	0x19, 0x87, 0x6b, 0x68, 0x83, 0xd3, 0x29, 0x73, 0x81, 0x93, 0x9c, 0x50, 0x9e, 0xae, 0x51, 0x2c,
-	0x41, 0x98, 0x0b, 0x12, 0xd7, 0x50, 0x81, 0x4d, 0xc7, 0xc0, 0x49, 0x5e, 0x28, 0xcf, 0xd7, 0x28,
-	0xe6, 0x74, 0x0c, 0x99, 0x96, 0xb2, 0xb8, 0x81, 0xca, 0x37, 0x97, 0xc0, 0x49, 0x41, 0xc8, 0xea,
-	0x5d, 0x23, 0x1e, 0x09, 0xcc, 0xbc, 0x1f, 0xfd, 0xff, 0x70, 0xfc, 0x0c, 0x6d, 0x05, 0x4e, 0x6c,
-	0x87, 0x34, 0x74, 0x81, 0x14, 0x35, 0xb9, 0x5a, 0x30, 0x37, 0x03, 0x27, 0xee, 0x24, 0x7f, 0xbc,
-	0x8b, 0x4a, 0xd1, 0x88, 0x01, 0x1f, 0xd1, 0xb1, 0x67, 0x3b, 0x64, 0x43, 0xc4, 0xe8, 0xba, 0x54,
-	0x5f, 0x06, 0xfa, 0x64, 0xf3, 0x16, 0x70, 0x88, 0xdf, 0xa0, 0x7c, 0x40, 0x3d, 0x20, 0x5b, 0x9a,
-	0x5c, 0x7d, 0x70, 0xa0, 0xad, 0xdb, 0x37, 0x0d, 0x39, 0x84, 0x7c, 0xca, 0x8f, 0xa9, 0x07, 0xa6,
-	0xa0, 0x71, 0x05, 0xa1, 0x64, 0x28, 0x0f, 0x22, 0xdb, 0xf7, 0x08, 0xba, 0x9e, 0xaa, 0x09, 0x51,
-	0xdb, 0xc3, 0xaf, 0xd0, 0xc3, 0x24, 0xe5, 0xfe, 0x39, 0xd8, 0x13, 0xe6, 0x27, 0x9b, 0x2e, 0x09,
-	0xa4, 0x1c, 0x38, 0xb1, 0xe5, 0x9f, 0x43, 0x57, 0x14, 0xdf, 0xe6, 0xbf, 0xff, 0xd8, 0x95, 0x5e,
-	0x7f, 0x91, 0x51, 0x79, 0xa9, 0x07, 0x7e, 0x87, 0x94, 0xc6, 0x49, 0xc7, 0x6a, 0x75, 0xac, 0x9e,
-	0x65, 0x1f, 0x9f, 0x34, 0x5b, 0x76, 0xaf, 0x63, 0x75, 0x5b, 0x8d, 0xf6, 0x51, 0xbb, 0xd5, 0xdc,
-	0x96, 0x94, 0xca, 0xc5, 0xa5, 0x46, 0x96, 0x94, 0x5e, 0xc8, 0x27, 0xe0, 0xfa, 0x03, 0x1f, 0x3c,
-	0xac, 0xa3, 0x47, 0xb7, 0xec, 0xba, 0x55, 0xef, 0x6e, 0xcb, 0xca, 0x93, 0x8b, 0x4b, 0x6d, 0x67,
-	0x49, 0x4b, 0x02, 0x25, 0xff, 0xf5, 0xa7, 0x2a, 0x1d, 0xbe, 0xff, 0x35, 0x53, 0xe5, 0xab, 0x99,
-	0x2a, 0xff, 0x99, 0xa9, 0xf2, 0xb7, 0xb9, 0x2a, 0x5d, 0xcd, 0x55, 0xe9, 0xf7, 0x5c, 0x95, 0x3e,
-	0xee, 0x0d, 0xfd, 0x68, 0x34, 0xed, 0xeb, 0x2e, 0x0d, 0x8c, 0x56, 0xba, 0x9d, 0x0e, 0x44, 0x9f,
-	0x28, 0x3b, 0x35, 0x16, 0x47, 0x1f, 0x2f, 0xce, 0x3e, 0xfa, 0x3c, 0x01, 0xde, 0x2f, 0x8a, 0xbb,
-	0xae, 0xfd, 0x0b, 0x00, 0x00, 0xff, 0xff, 0xf5, 0xbe, 0x10, 0x4f, 0x66, 0x03, 0x00, 0x00,
+	// 1047 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0xcd, 0x4e, 0x1b, 0x47,
+	0x1c, 0xf7, 0x62, 0x20, 0x30, 0x7c, 0x14, 0x26, 0x84, 0x8c, 0x0d, 0x5d, 0x6f, 0x69, 0x14, 0x59,
+	0x91, 0xb2, 0x4e, 0x20, 0x52, 0xa5, 0x28, 0x3d, 0x18, 0x76, 0x21, 0x4e, 0x65, 0x83, 0xd6, 0x46,
+	0x48, 0x3d, 0x74, 0x35, 0xec, 0x0e, 0xf6, 0x14, 0xef, 0x8e, 0xb5, 0xb3, 0x36, 0x6e, 0x9e, 0xa0,
+	0xf2, 0xa9, 0xb7, 0xe6, 0x62, 0xa9, 0x52, 0x1f, 0xa0, 0x97, 0x1e, 0xfb, 0x00, 0x39, 0x46, 0x3d,
+	0x55, 0x3d, 0xa4, 0x15, 0x3c, 0x42, 0x5f, 0xa0, 0x9a, 0xd9, 0x5d, 0x83, 0x3f, 0x90, 0x2a, 0x95,
+	0x93, 0x3d, 0xf3, 0xff, 0xfd, 0x7e, 0xff, 0xef, 0xd1, 0x02, 0x95, 0x74, 0x99, 0xc3, 0x02, 0x52,
+	0x60, 0x01, 0x76, 0x9a, 0xa4, 0xd0, 0x79, 0x5e, 0x68, 0xe1, 0x00, 0x7b, 0x5c, 0x6f, 0x05, 0x2c,
+	0x64, 0x70, 0x35, 0xb6, 0xeb, 0x91, 0x5d, 0xef, 0x3c, 0xcf, 0x66, 0x1c, 0xc6, 0x3d, 0xc6, 0x6d,
+	0x09, 0x28, 0x44, 0x87, 0x08, 0x9d, 0xdd, 0x1c, 0x57, 0xa3, 0xfe, 0x19, 0x8b, 0xad, 0x8f, 0xc6,
+	0xad, 0x21, 0x3b, 0x27, 0xbe, 0x7d, 0x46, 0x88, 0x4b, 0x82, 0x18, 0xb5, 0x56, 0x67, 0x75, 0x16,
+	0x69, 0x8b, 0x7f, 0xf1, 0xad, 0x5a, 0x67, 0xac, 0xde, 0x24, 0x05, 0x79, 0x3a, 0x6d, 0x9f, 0x15,
+	0xdc, 0x76, 0x80, 0x43, 0xca, 0xfc, 0xc8, 0xbe, 0xf5, 0xcf, 0x0c, 0x98, 0x3d, 0x92, 0x81, 0xc3,
+	0x67, 0x60, 0xd6, 0x69, 0x60, 0xea, 0x73, 0xa4, 0x68, 0xe9, 0xfc, 0xc2, 0x36, 0xd2, 0xc7, 0x72,
+	0xd0, 0xf7, 0x04, 0xc0, 0x8a, 0x71, 0x82, 0x21, 0x03, 0xe1, 0x68, 0xea, 0x56, 0x46, 0x4d, 0x00,
+	0xac, 0x18, 0x07, 0x77, 0xc0, 0x3d, 0xce, 0xda, 0x81, 0x43, 0x38, 0x4a, 0x4b, 0x4a, 0x66, 0x02,
+	0xa5, 0x2a, 0x11, 0x56, 0x82, 0x84, 0x3b, 0x60, 0x26, 0x68, 0x37, 0x09, 0x47, 0xd3, 0x92, 0xf2,
+	0xe9, 0x04, 0x8a, 0xd5, 0x6e, 0x92, 0x98, 0x16, 0x61, 0xe1, 0x1e, 0x58, 0xba, 0x59, 0x24, 0x8e,
+	0x66, 0x24, 0x59, 0xbd, 0x2d, 0xc4, 0x7d, 0x09, 0xb3, 0x16, 0xc3, 0xeb, 0x03, 0x87, 0x1b, 0x60,
+	0xde, 0xc3, 0x5d, 0xdb, 0x67, 0xbe, 0x43, 0xd0, 0xac, 0xa6, 0xe4, 0x67, 0xac, 0x39, 0x0f, 0x77,
+	0x2b, 0xe2, 0x0c, 0x73, 0x60, 0x21, 0x6c, 0x04, 0x84, 0x37, 0x58, 0xd3, 0xb5, 0x31, 0xba, 0x27,
+	0xcd, 0x60, 0x70, 0x55, 0x1c, 0x06, 0x9c, 0xa2, 0xb9, 0x11, 0xc0, 0x2e, 0x7c, 0x01, 0xa6, 0x3d,
+	0xe6, 0x12, 0x34, 0xaf, 0x29, 0xf9, 0xe5, 0x6d, 0x6d, 0x52, 0xbd, 0x99, 0xcf, 0x89, 0xcf, 0xdb,
+	0xbc, 0xcc, 0x5c, 0x62, 0x49, 0x34, 0xdc, 0x04, 0x40, 0x04, 0xe5, 0x92, 0xd0, 0xa6, 0x2e, 0x02,
+	0x83, 0xa8, 0x0c, 0x12, 0x96, 0x5c, 0xf8, 0x18, 0x7c, 0x22, 0xac, 0x9c, 0xbe, 0x25, 0x76, 0x2b,
+	0xa0, 0xa2, 0xd2, 0x0b, 0x12, 0xb2, 0xe4, 0xe1, 0x6e, 0x95, 0xbe, 0x25, 0x47, 0xf2, 0x12, 0x7e,
+	0x09, 0xe6, 0x78, 0x13, 0xf3, 0x06, 0xf5, 0xeb, 0x68, 0x51, 0x53, 0xf2, 0x0b, 0xdb, 0x9f, 0x4d,
+	0x6a, 0x45, 0x0c, 0x89, 0x46, 0xc4, 0x1a, 0x50, 0x60, 0x15, 0x40, 0x5c, 0xaf, 0x07, 0xa4, 0x2e,
+	0x87, 0xc9, 0xf6, 0x48, 0xd8, 0x60, 0x2e, 0x5a, 0x92, 0x89, 0x3c, 0x9a, 0x20, 0x54, 0xbc, 0x06,
+	0x97, 0x25, 0xd6, 0x5a, 0xc5, 0xa3, 0x57, 0x10, 0x83, 0xa5, 0x30, 0xa0, 0x9e, 0x7d, 0x16, 0x60,
+	0x47, 0x5c, 0xa3, 0x65, 0x4d, 0xc9, 0xcf, 0xef, 0xbe, 0x7a, 0xff, 0x31, 0x97, 0xfa, 0xf3, 0x63,
+	0xee, 0x71, 0x9d, 0x86, 0x8d, 0xf6, 0xa9, 0xee, 0x30, 0x2f, 0x5e, 0x9f, 0xf8, 0xe7, 0x29, 0x77,
+	0xcf, 0x0b, 0xe1, 0x77, 0x2d, 0xc2, 0x75, 0x83, 0x38, 0xbf, 0xff, 0xfa, 0x14, 0xc4, 0xdb, 0x65,
+	0x10, 0xc7, 0x5a, 0x14, 0x92, 0xfb, 0xb1, 0xe2, 0xcb, 0xe9, 0x77, 0x3f, 0xe5, 0x52, 0x5b, 0x3f,
+	0xce, 0x82, 0xe5, 0xe1, 0xd4, 0xe0, 0x0b, 0xb0, 0x1e, 0x90, 0x16, 0x0b, 0x42, 0xe2, 0xda, 0x01,
+	0x6b, 0xfb, 0x2e, 0xb7, 0x2f, 0xa8, 0xef, 0xb2, 0x0b, 0xa4, 0x68, 0x4a, 0x3e, 0x6d, 0xad, 0x25,
+	0x56, 0x4b, 0x1a, 0x4f, 0xa4, 0x0d, 0x72, 0xf0, 0xd0, 0xa3, 0xbe, 0x3d, 0x60, 0xb6, 0x48, 0x90,
+	0xd0, 0xa6, 0xee, 0x20, 0xf6, 0x35, 0x8f, 0xfa, 0x56, 0xac, 0x7d, 0x44, 0x82, 0xd8, 0xe9, 0x37,
+	0x20, 0x13, 0x15, 0xd6, 0xf6, 0x28, 0xe7, 0xf6, 0xb7, 0x98, 0x36, 0xed, 0x64, 0xad, 0x51, 0x5a,
+	0xf6, 0x32, 0xa3, 0x47, 0x7b, 0xaf, 0x27, 0x7b, 0xaf, 0x1b, 0x31, 0x60, 0x77, 0x4e, 0x44, 0xf4,
+	0xee, 0xaf, 0x9c, 0x62, 0xad, 0x47, 0x2a, 0x65, 0xca, 0xf9, 0x1b, 0x4c, 0x9b, 0x09, 0x02, 0x36,
+	0xc1, 0x7d, 0xd9, 0xe7, 0x41, 0x1f, 0xa4, 0x1f, 0x34, 0x7d, 0x07, 0x09, 0xad, 0x4a, 0xe1, 0xa4,
+	0x1b, 0xc2, 0xb3, 0xf0, 0x26, 0x06, 0x56, 0xce, 0xaa, 0xed, 0x92, 0x0e, 0x8d, 0xf2, 0x98, 0xb9,
+	0x0b, 0x6f, 0x1e, 0xee, 0xca, 0x71, 0x37, 0x12, 0x59, 0xf8, 0x0a, 0x64, 0x85, 0x37, 0x47, 0xec,
+	0x95, 0xd3, 0x0e, 0x69, 0xe7, 0x86, 0x4f, 0x2e, 0x57, 0x3c, 0x6d, 0x21, 0x0f, 0x77, 0xf7, 0xae,
+	0x01, 0x03, 0x32, 0x87, 0x0d, 0xa0, 0x26, 0x95, 0xc7, 0x4d, 0xea, 0x50, 0xd6, 0x1e, 0x2d, 0xff,
+	0xbd, 0xff, 0x5e, 0xfe, 0x8d, 0xb8, 0xfc, 0x89, 0xd2, 0x50, 0x0f, 0x3a, 0x00, 0x8d, 0xf6, 0x20,
+	0xc1, 0xc9, 0x87, 0xe4, 0xff, 0x96, 0x66, 0x7d, 0xb8, 0x11, 0x89, 0xf6, 0x93, 0xdf, 0x14, 0xb0,
+	0x34, 0xf4, 0xe8, 0x88, 0x8a, 0xed, 0x1d, 0x56, 0xaa, 0x66, 0xa5, 0x7a, 0x5c, 0xb5, 0xcb, 0x87,
+	0x86, 0x69, 0x1f, 0x57, 0xaa, 0x47, 0xe6, 0x5e, 0x69, 0xbf, 0x64, 0x1a, 0x2b, 0xa9, 0xec, 0x66,
+	0xaf, 0xaf, 0xa1, 0x21, 0xca, 0xb1, 0xcf, 0x5b, 0xc4, 0xa1, 0x67, 0x94, 0xb8, 0x50, 0x07, 0xf7,
+	0x47, 0xd8, 0xc5, 0x6a, 0xf1, 0x68, 0x45, 0xc9, 0x3e, 0xe8, 0xf5, 0xb5, 0xd5, 0x21, 0x9a, 0x30,
+	0xc0, 0x2f, 0x00, 0x1a, 0xc1, 0x5b, 0x87, 0xc7, 0x15, 0xc3, 0x36, 0x2b, 0xc6, 0xca, 0x54, 0x36,
+	0xd3, 0xeb, 0x6b, 0x0f, 0x86, 0xdf, 0x44, 0xb1, 0x8d, 0xa6, 0xef, 0x66, 0xa7, 0xbf, 0xff, 0x59,
+	0x4d, 0x3d, 0xf9, 0x65, 0x0a, 0xac, 0x8e, 0x3d, 0x35, 0xd0, 0x00, 0x6a, 0xf1, 0xe0, 0xc0, 0x32,
+	0x0f, 0x8a, 0xb5, 0xd2, 0x61, 0xc5, 0x2e, 0x9b, 0xb5, 0xd7, 0x87, 0xc6, 0x48, 0x1a, 0x5a, 0xaf,
+	0xaf, 0x6d, 0x8e, 0x51, 0x6f, 0xa6, 0xf2, 0x12, 0x64, 0x26, 0xa8, 0x94, 0x4d, 0xa3, 0x54, 0xac,
+	0xac, 0x28, 0xd9, 0x8d, 0x5e, 0x5f, 0x7b, 0x38, 0x26, 0x50, 0x26, 0x2e, 0xc5, 0x3e, 0xfc, 0x0a,
+	0x6c, 0x4d, 0xe0, 0x9e, 0x98, 0xa5, 0x83, 0xd7, 0x35, 0x73, 0x20, 0x32, 0x95, 0xfd, 0xbc, 0xd7,
+	0xd7, 0x72, 0x63, 0x22, 0x27, 0x84, 0xd6, 0x1b, 0x21, 0x49, 0xc4, 0x4c, 0x90, 0x9b, 0x20, 0x56,
+	0xb3, 0x4a, 0xe5, 0xb2, 0xd4, 0x2a, 0x56, 0x56, 0xd2, 0xb7, 0xe4, 0x53, 0x0b, 0xa8, 0xe7, 0x09,
+	0x21, 0xec, 0x47, 0x15, 0xdb, 0x7d, 0xf3, 0xfe, 0x52, 0x55, 0x3e, 0x5c, 0xaa, 0xca, 0xdf, 0x97,
+	0xaa, 0xf2, 0xc3, 0x95, 0x9a, 0xfa, 0x70, 0xa5, 0xa6, 0xfe, 0xb8, 0x52, 0x53, 0x5f, 0x3f, 0xbb,
+	0x31, 0x58, 0x66, 0xf4, 0xa0, 0x57, 0x48, 0x78, 0xc1, 0x82, 0xf3, 0x42, 0xf2, 0x41, 0xd2, 0x4d,
+	0x3e, 0x49, 0xe4, 0x98, 0x9d, 0xce, 0xca, 0x71, 0xdf, 0xf9, 0x37, 0x00, 0x00, 0xff, 0xff, 0x74,
+	0xb1, 0xc5, 0x65, 0x1d, 0x09, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -250,6 +450,33 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.TrimFraction.Size()
+		i -= size
+		if _, err := m.TrimFraction.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x72
+	if m.AggregationMethod != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.AggregationMethod))
+		i--
+		dAtA[i] = 0x68
+	}
+	if m.Slashing != nil {
+		{
+			size, err := m.Slashing.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintParams(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x62
+	}
 	if m.MaxSizePrices != 0 {
 		i = encodeVarintParams(dAtA, i, uint64(m.MaxSizePrices))
 		i--
@@ -353,6 +580,95 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *SlashingParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SlashingParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SlashingParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.SlashFractionMalicious.Size()
+		i -= size
+		if _, err := m.SlashFractionMalicious.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	n2, err2 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.OracleMaliciousJailDuration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.OracleMaliciousJailDuration):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintParams(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x3a
+	if m.MaxConsecutiveDeviations != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxConsecutiveDeviations))
+		i--
+		dAtA[i] = 0x30
+	}
+	{
+		size := m.MaxPriceDeviation.Size()
+		i -= size
+		if _, err := m.MaxPriceDeviation.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.SlashFractionMiss.Size()
+		i -= size
+		if _, err := m.SlashFractionMiss.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	n3, err3 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.OracleMissJailDuration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.OracleMissJailDuration):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintParams(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.MinReportedPerWindow.Size()
+		i -= size
+		if _, err := m.MinReportedPerWindow.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.ReportedRoundsWindow != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.ReportedRoundsWindow))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintParams(dAtA []byte, offset int, v uint64) int {
 	offset -= sovParams(v)
 	base := offset
@@ -418,6 +734,42 @@ func (m *Params) Size() (n int) {
 	if m.MaxSizePrices != 0 {
 		n += 1 + sovParams(uint64(m.MaxSizePrices))
 	}
+	if m.Slashing != nil {
+		l = m.Slashing.Size()
+		n += 1 + l + sovParams(uint64(l))
+	}
+	if m.AggregationMethod != 0 {
+		n += 1 + sovParams(uint64(m.AggregationMethod))
+	}
+	l = m.TrimFraction.Size()
+	n += 1 + l + sovParams(uint64(l))
+	return n
+}
+
+func (m *SlashingParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ReportedRoundsWindow != 0 {
+		n += 1 + sovParams(uint64(m.ReportedRoundsWindow))
+	}
+	l = m.MinReportedPerWindow.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.OracleMissJailDuration)
+	n += 1 + l + sovParams(uint64(l))
+	l = m.SlashFractionMiss.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.MaxPriceDeviation.Size()
+	n += 1 + l + sovParams(uint64(l))
+	if m.MaxConsecutiveDeviations != 0 {
+		n += 1 + sovParams(uint64(m.MaxConsecutiveDeviations))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.OracleMaliciousJailDuration)
+	n += 1 + l + sovParams(uint64(l))
+	l = m.SlashFractionMalicious.Size()
+	n += 1 + l + sovParams(uint64(l))
 	return n
 }
 
@@ -740,6 +1092,385 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Slashing", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Slashing == nil {
+				m.Slashing = &SlashingParams{}
+			}
+			if err := m.Slashing.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AggregationMethod", wireType)
+			}
+			m.AggregationMethod = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AggregationMethod |= AggregationMethod(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TrimFraction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TrimFraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SlashingParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SlashingParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SlashingParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReportedRoundsWindow", wireType)
+			}
+			m.ReportedRoundsWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReportedRoundsWindow |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinReportedPerWindow", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinReportedPerWindow.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OracleMissJailDuration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.OracleMissJailDuration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashFractionMiss", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SlashFractionMiss.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPriceDeviation", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxPriceDeviation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxConsecutiveDeviations", wireType)
+			}
+			m.MaxConsecutiveDeviations = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxConsecutiveDeviations |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OracleMaliciousJailDuration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.OracleMaliciousJailDuration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashFractionMalicious", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SlashFractionMalicious.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
@@ -365,6 +365,8 @@ func (m *QueryStakerInfoResponse) GetStakerInfo() *StakerInfo {
 type QueryStakerInfosRequest struct {
 	// asset id for the staker info request for
 	AssetId string `protobuf:"bytes,1,opt,name=asset_id,json=assetId,proto3" json:"asset_id,omitempty"`
+	// info of the pagination
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
 func (m *QueryStakerInfosRequest) Reset()         { *m = QueryStakerInfosRequest{} }
@@ -407,10 +409,19 @@ func (m *QueryStakerInfosRequest) GetAssetId() string {
 	return ""
 }
 
+func (m *QueryStakerInfosRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 // QueryStakerInfosResponse is response type for Query/StakerInfo RCP method
 type QueryStakerInfosResponse struct {
 	// all staker infos under the specified asset
 	StakerInfos []*StakerInfo `protobuf:"bytes,1,rep,name=staker_infos,json=stakerInfos,proto3" json:"staker_infos,omitempty"`
+	// info of the pagination
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
 func (m *QueryStakerInfosResponse) Reset()         { *m = QueryStakerInfosResponse{} }
@@ -453,6 +464,13 @@ func (m *QueryStakerInfosResponse) GetStakerInfos() []*StakerInfo {
 	return nil
 }
 
+func (m *QueryStakerInfosResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 // QueryParamsRequest is request type for the Query/Params RPC method.
 type QueryParamsRequest struct {
 }
@@ -540,6 +558,8 @@ func (m *QueryParamsResponse) GetParams() Params {
 type QueryGetPricesRequest struct {
 	// token_id represents which token's price will be retrieved
 	TokenId uint64 `protobuf:"varint,1,opt,name=token_id,json=tokenId,proto3" json:"token_id,omitempty"`
+	// info of the pagination over the rounds of the prices
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
 func (m *QueryGetPricesRequest) Reset()         { *m = QueryGetPricesRequest{} }
@@ -582,6 +602,13 @@ func (m *QueryGetPricesRequest) GetTokenId() uint64 {
 	return 0
 }
 
+func (m *QueryGetPricesRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 // QueryGetLatestPriceRequest is request type for the latest price of a specific token
 type QueryGetLatestPriceRequest struct {
 	// token_id represents which token's price will be retrieved
@@ -628,17 +655,85 @@ func (m *QueryGetLatestPriceRequest) GetTokenId() uint64 {
 	return 0
 }
 
+// QueryTWAPPriceRequest is request type for the time-weighted average price of a specific token,
+// the window is specified by either rounds or blocks
+type QueryTWAPPriceRequest struct {
+	// token_id represents which token's price will be retrieved
+	TokenId uint64 `protobuf:"varint,1,opt,name=token_id,json=tokenId,proto3" json:"token_id,omitempty"`
+	// rounds is the number of the latest rounds in the window
+	Rounds uint64 `protobuf:"varint,2,opt,name=rounds,proto3" json:"rounds,omitempty"`
+	// blocks is the number of the latest blocks in the window, which is converted to the rounds
+	// covering them by the interval of the token feeder
+	Blocks uint64 `protobuf:"varint,3,opt,name=blocks,proto3" json:"blocks,omitempty"`
+}
+
+func (m *QueryTWAPPriceRequest) Reset()         { *m = QueryTWAPPriceRequest{} }
+func (m *QueryTWAPPriceRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryTWAPPriceRequest) ProtoMessage()    {}
+func (*QueryTWAPPriceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b8cba1249806967d, []int{13}
+}
+func (m *QueryTWAPPriceRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTWAPPriceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTWAPPriceRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTWAPPriceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTWAPPriceRequest.Merge(m, src)
+}
+func (m *QueryTWAPPriceRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTWAPPriceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTWAPPriceRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTWAPPriceRequest proto.InternalMessageInfo
+
+func (m *QueryTWAPPriceRequest) GetTokenId() uint64 {
+	if m != nil {
+		return m.TokenId
+	}
+	return 0
+}
+
+func (m *QueryTWAPPriceRequest) GetRounds() uint64 {
+	if m != nil {
+		return m.Rounds
+	}
+	return 0
+}
+
+func (m *QueryTWAPPriceRequest) GetBlocks() uint64 {
+	if m != nil {
+		return m.Blocks
+	}
+	return 0
+}
+
 // QueryGetPricesResponse
 type QueryGetPricesResponse struct {
 	// prices returned prices
 	Prices Prices `protobuf:"bytes,1,opt,name=prices,proto3" json:"prices"`
+	// info of the pagination
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
 func (m *QueryGetPricesResponse) Reset()         { *m = QueryGetPricesResponse{} }
 func (m *QueryGetPricesResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryGetPricesResponse) ProtoMessage()    {}
 func (*QueryGetPricesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b8cba1249806967d, []int{13}
+	return fileDescriptor_b8cba1249806967d, []int{14}
 }
 func (m *QueryGetPricesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -674,17 +769,28 @@ func (m *QueryGetPricesResponse) GetPrices() Prices {
 	return Prices{}
 }
 
+func (m *QueryGetPricesResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 // QueryGetLatestPriceResponse
 type QueryGetLatestPriceResponse struct {
 	// prices returned prices
 	Price PriceTimeRound `protobuf:"bytes,1,opt,name=price,proto3" json:"price"`
+	// updated_height is the height when the latest price was finalized, 0 if it's unknown
+	UpdatedHeight int64 `protobuf:"varint,2,opt,name=updated_height,json=updatedHeight,proto3" json:"updated_height,omitempty"`
+	// stale is true if the latest price is older than the max_price_age of the token
+	Stale bool `protobuf:"varint,3,opt,name=stale,proto3" json:"stale,omitempty"`
 }
 
 func (m *QueryGetLatestPriceResponse) Reset()         { *m = QueryGetLatestPriceResponse{} }
 func (m *QueryGetLatestPriceResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryGetLatestPriceResponse) ProtoMessage()    {}
 func (*QueryGetLatestPriceResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b8cba1249806967d, []int{14}
+	return fileDescriptor_b8cba1249806967d, []int{15}
 }
 func (m *QueryGetLatestPriceResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -720,6 +826,77 @@ func (m *QueryGetLatestPriceResponse) GetPrice() PriceTimeRound {
 	return PriceTimeRound{}
 }
 
+func (m *QueryGetLatestPriceResponse) GetUpdatedHeight() int64 {
+	if m != nil {
+		return m.UpdatedHeight
+	}
+	return 0
+}
+
+func (m *QueryGetLatestPriceResponse) GetStale() bool {
+	if m != nil {
+		return m.Stale
+	}
+	return false
+}
+
+// QueryTWAPPriceResponse
+type QueryTWAPPriceResponse struct {
+	// price is the time-weighted average price, with the decimal, timestamp and round_id of the
+	// latest round in the window
+	Price PriceTimeRound `protobuf:"bytes,1,opt,name=price,proto3" json:"price"`
+	// rounds is the number of rounds with a price in the window, which is less than requested if
+	// the earlier rounds have been pruned or have no price
+	Rounds uint64 `protobuf:"varint,2,opt,name=rounds,proto3" json:"rounds,omitempty"`
+}
+
+func (m *QueryTWAPPriceResponse) Reset()         { *m = QueryTWAPPriceResponse{} }
+func (m *QueryTWAPPriceResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryTWAPPriceResponse) ProtoMessage()    {}
+func (*QueryTWAPPriceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b8cba1249806967d, []int{16}
+}
+func (m *QueryTWAPPriceResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTWAPPriceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTWAPPriceResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTWAPPriceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTWAPPriceResponse.Merge(m, src)
+}
+func (m *QueryTWAPPriceResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTWAPPriceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTWAPPriceResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTWAPPriceResponse proto.InternalMessageInfo
+
+func (m *QueryTWAPPriceResponse) GetPrice() PriceTimeRound {
+	if m != nil {
+		return m.Price
+	}
+	return PriceTimeRound{}
+}
+
+func (m *QueryTWAPPriceResponse) GetRounds() uint64 {
+	if m != nil {
+		return m.Rounds
+	}
+	return 0
+}
+
 // QueryAllPricesRequest
 type QueryAllPricesRequest struct {
 	// info of the pagination
@@ -730,7 +907,7 @@ func (m *QueryAllPricesRequest) Reset()         { *m = QueryAllPricesRequest{} }
 func (m *QueryAllPricesRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryAllPricesRequest) ProtoMessage()    {}
 func (*QueryAllPricesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b8cba1249806967d, []int{15}
+	return fileDescriptor_b8cba1249806967d, []int{17}
 }
 func (m *QueryAllPricesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -778,7 +955,7 @@ func (m *QueryAllPricesResponse) Reset()         { *m = QueryAllPricesResponse{}
 func (m *QueryAllPricesResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryAllPricesResponse) ProtoMessage()    {}
 func (*QueryAllPricesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b8cba1249806967d, []int{16}
+	return fileDescriptor_b8cba1249806967d, []int{18}
 }
 func (m *QueryAllPricesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -829,7 +1006,7 @@ func (m *QueryGetValidatorUpdateBlockRequest) Reset()         { *m = QueryGetVal
 func (m *QueryGetValidatorUpdateBlockRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryGetValidatorUpdateBlockRequest) ProtoMessage()    {}
 func (*QueryGetValidatorUpdateBlockRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b8cba1249806967d, []int{17}
+	return fileDescriptor_b8cba1249806967d, []int{19}
 }
 func (m *QueryGetValidatorUpdateBlockRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -868,7 +1045,7 @@ func (m *QueryGetValidatorUpdateBlockResponse) Reset()         { *m = QueryGetVa
 func (m *QueryGetValidatorUpdateBlockResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryGetValidatorUpdateBlockResponse) ProtoMessage()    {}
 func (*QueryGetValidatorUpdateBlockResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b8cba1249806967d, []int{18}
+	return fileDescriptor_b8cba1249806967d, []int{20}
 }
 func (m *QueryGetValidatorUpdateBlockResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -912,7 +1089,7 @@ func (m *QueryGetIndexRecentParamsRequest) Reset()         { *m = QueryGetIndexR
 func (m *QueryGetIndexRecentParamsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryGetIndexRecentParamsRequest) ProtoMessage()    {}
 func (*QueryGetIndexRecentParamsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b8cba1249806967d, []int{19}
+	return fileDescriptor_b8cba1249806967d, []int{21}
 }
 func (m *QueryGetIndexRecentParamsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -951,7 +1128,7 @@ func (m *QueryGetIndexRecentParamsResponse) Reset()         { *m = QueryGetIndex
 func (m *QueryGetIndexRecentParamsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryGetIndexRecentParamsResponse) ProtoMessage()    {}
 func (*QueryGetIndexRecentParamsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b8cba1249806967d, []int{20}
+	return fileDescriptor_b8cba1249806967d, []int{22}
 }
 func (m *QueryGetIndexRecentParamsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -995,7 +1172,7 @@ func (m *QueryGetIndexRecentMsgRequest) Reset()         { *m = QueryGetIndexRece
 func (m *QueryGetIndexRecentMsgRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryGetIndexRecentMsgRequest) ProtoMessage()    {}
 func (*QueryGetIndexRecentMsgRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b8cba1249806967d, []int{21}
+	return fileDescriptor_b8cba1249806967d, []int{23}
 }
 func (m *QueryGetIndexRecentMsgRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1034,7 +1211,7 @@ func (m *QueryGetIndexRecentMsgResponse) Reset()         { *m = QueryGetIndexRec
 func (m *QueryGetIndexRecentMsgResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryGetIndexRecentMsgResponse) ProtoMessage()    {}
 func (*QueryGetIndexRecentMsgResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b8cba1249806967d, []int{22}
+	return fileDescriptor_b8cba1249806967d, []int{24}
 }
 func (m *QueryGetIndexRecentMsgResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1080,7 +1257,7 @@ func (m *QueryGetRecentMsgRequest) Reset()         { *m = QueryGetRecentMsgReque
 func (m *QueryGetRecentMsgRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryGetRecentMsgRequest) ProtoMessage()    {}
 func (*QueryGetRecentMsgRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b8cba1249806967d, []int{23}
+	return fileDescriptor_b8cba1249806967d, []int{25}
 }
 func (m *QueryGetRecentMsgRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1126,7 +1303,7 @@ func (m *QueryGetRecentMsgResponse) Reset()         { *m = QueryGetRecentMsgResp
 func (m *QueryGetRecentMsgResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryGetRecentMsgResponse) ProtoMessage()    {}
 func (*QueryGetRecentMsgResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b8cba1249806967d, []int{24}
+	return fileDescriptor_b8cba1249806967d, []int{26}
 }
 func (m *QueryGetRecentMsgResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1172,7 +1349,7 @@ func (m *QueryAllRecentMsgRequest) Reset()         { *m = QueryAllRecentMsgReque
 func (m *QueryAllRecentMsgRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryAllRecentMsgRequest) ProtoMessage()    {}
 func (*QueryAllRecentMsgRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b8cba1249806967d, []int{25}
+	return fileDescriptor_b8cba1249806967d, []int{27}
 }
 func (m *QueryAllRecentMsgRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1220,7 +1397,7 @@ func (m *QueryAllRecentMsgResponse) Reset()         { *m = QueryAllRecentMsgResp
 func (m *QueryAllRecentMsgResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryAllRecentMsgResponse) ProtoMessage()    {}
 func (*QueryAllRecentMsgResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b8cba1249806967d, []int{26}
+	return fileDescriptor_b8cba1249806967d, []int{28}
 }
 func (m *QueryAllRecentMsgResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1273,7 +1450,7 @@ func (m *QueryGetRecentParamsRequest) Reset()         { *m = QueryGetRecentParam
 func (m *QueryGetRecentParamsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryGetRecentParamsRequest) ProtoMessage()    {}
 func (*QueryGetRecentParamsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b8cba1249806967d, []int{27}
+	return fileDescriptor_b8cba1249806967d, []int{29}
 }
 func (m *QueryGetRecentParamsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1319,7 +1496,7 @@ func (m *QueryGetRecentParamsResponse) Reset()         { *m = QueryGetRecentPara
 func (m *QueryGetRecentParamsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryGetRecentParamsResponse) ProtoMessage()    {}
 func (*QueryGetRecentParamsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b8cba1249806967d, []int{28}
+	return fileDescriptor_b8cba1249806967d, []int{30}
 }
 func (m *QueryGetRecentParamsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1365,7 +1542,7 @@ func (m *QueryAllRecentParamsRequest) Reset()         { *m = QueryAllRecentParam
 func (m *QueryAllRecentParamsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryAllRecentParamsRequest) ProtoMessage()    {}
 func (*QueryAllRecentParamsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b8cba1249806967d, []int{29}
+	return fileDescriptor_b8cba1249806967d, []int{31}
 }
 func (m *QueryAllRecentParamsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1413,7 +1590,7 @@ func (m *QueryAllRecentParamsResponse) Reset()         { *m = QueryAllRecentPara
 func (m *QueryAllRecentParamsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryAllRecentParamsResponse) ProtoMessage()    {}
 func (*QueryAllRecentParamsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b8cba1249806967d, []int{30}
+	return fileDescriptor_b8cba1249806967d, []int{32}
 }
 func (m *QueryAllRecentParamsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1456,194 +1633,547 @@ func (m *QueryAllRecentParamsResponse) GetPagination() *query.PageResponse {
 	return nil
 }
 
-func init() {
-	proto.RegisterType((*TokenIndex)(nil), "exocore.oracle.v1.TokenIndex")
-	proto.RegisterType((*QueryTokenIndexesRequest)(nil), "exocore.oracle.v1.QueryTokenIndexesRequest")
-	proto.RegisterType((*QueryTokenIndexesResponse)(nil), "exocore.oracle.v1.QueryTokenIndexesResponse")
-	proto.RegisterType((*QueryStakerListRequest)(nil), "exocore.oracle.v1.QueryStakerListRequest")
-	proto.RegisterType((*QueryStakerListResponse)(nil), "exocore.oracle.v1.QueryStakerListResponse")
-	proto.RegisterType((*QueryStakerInfoRequest)(nil), "exocore.oracle.v1.QueryStakerInfoRequest")
-	proto.RegisterType((*QueryStakerInfoResponse)(nil), "exocore.oracle.v1.QueryStakerInfoResponse")
-	proto.RegisterType((*QueryStakerInfosRequest)(nil), "exocore.oracle.v1.QueryStakerInfosRequest")
-	proto.RegisterType((*QueryStakerInfosResponse)(nil), "exocore.oracle.v1.QueryStakerInfosResponse")
-	proto.RegisterType((*QueryParamsRequest)(nil), "exocore.oracle.v1.QueryParamsRequest")
-	proto.RegisterType((*QueryParamsResponse)(nil), "exocore.oracle.v1.QueryParamsResponse")
-	proto.RegisterType((*QueryGetPricesRequest)(nil), "exocore.oracle.v1.QueryGetPricesRequest")
-	proto.RegisterType((*QueryGetLatestPriceRequest)(nil), "exocore.oracle.v1.QueryGetLatestPriceRequest")
-	proto.RegisterType((*QueryGetPricesResponse)(nil), "exocore.oracle.v1.QueryGetPricesResponse")
-	proto.RegisterType((*QueryGetLatestPriceResponse)(nil), "exocore.oracle.v1.QueryGetLatestPriceResponse")
-	proto.RegisterType((*QueryAllPricesRequest)(nil), "exocore.oracle.v1.QueryAllPricesRequest")
-	proto.RegisterType((*QueryAllPricesResponse)(nil), "exocore.oracle.v1.QueryAllPricesResponse")
-	proto.RegisterType((*QueryGetValidatorUpdateBlockRequest)(nil), "exocore.oracle.v1.QueryGetValidatorUpdateBlockRequest")
-	proto.RegisterType((*QueryGetValidatorUpdateBlockResponse)(nil), "exocore.oracle.v1.QueryGetValidatorUpdateBlockResponse")
-	proto.RegisterType((*QueryGetIndexRecentParamsRequest)(nil), "exocore.oracle.v1.QueryGetIndexRecentParamsRequest")
-	proto.RegisterType((*QueryGetIndexRecentParamsResponse)(nil), "exocore.oracle.v1.QueryGetIndexRecentParamsResponse")
-	proto.RegisterType((*QueryGetIndexRecentMsgRequest)(nil), "exocore.oracle.v1.QueryGetIndexRecentMsgRequest")
-	proto.RegisterType((*QueryGetIndexRecentMsgResponse)(nil), "exocore.oracle.v1.QueryGetIndexRecentMsgResponse")
-	proto.RegisterType((*QueryGetRecentMsgRequest)(nil), "exocore.oracle.v1.QueryGetRecentMsgRequest")
-	proto.RegisterType((*QueryGetRecentMsgResponse)(nil), "exocore.oracle.v1.QueryGetRecentMsgResponse")
-	proto.RegisterType((*QueryAllRecentMsgRequest)(nil), "exocore.oracle.v1.QueryAllRecentMsgRequest")
-	proto.RegisterType((*QueryAllRecentMsgResponse)(nil), "exocore.oracle.v1.QueryAllRecentMsgResponse")
-	proto.RegisterType((*QueryGetRecentParamsRequest)(nil), "exocore.oracle.v1.QueryGetRecentParamsRequest")
-	proto.RegisterType((*QueryGetRecentParamsResponse)(nil), "exocore.oracle.v1.QueryGetRecentParamsResponse")
-	proto.RegisterType((*QueryAllRecentParamsRequest)(nil), "exocore.oracle.v1.QueryAllRecentParamsRequest")
-	proto.RegisterType((*QueryAllRecentParamsResponse)(nil), "exocore.oracle.v1.QueryAllRecentParamsResponse")
+// QueryValidatorReportInfoRequest is request type for Query/ValidatorReportInfo RPC method
+type QueryValidatorReportInfoRequest struct {
+	// validator is the consensus address of the validator
+	Validator string `protobuf:"bytes,1,opt,name=validator,proto3" json:"validator,omitempty"`
+	// feeder_id is the ID of the token feeder
+	FeederId uint64 `protobuf:"varint,2,opt,name=feeder_id,json=feederId,proto3" json:"feeder_id,omitempty"`
 }
 
-func init() { proto.RegisterFile("exocore/oracle/v1/query.proto", fileDescriptor_b8cba1249806967d) }
-
-var fileDescriptor_b8cba1249806967d = []byte{
-	// 1379 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x98, 0xdf, 0x6f, 0xdb, 0x54,
-	0x14, 0xc7, 0xeb, 0xfe, 0x1a, 0x3d, 0xed, 0x06, 0xbd, 0x2b, 0xa3, 0x35, 0x6d, 0xda, 0x99, 0x76,
-	0xcb, 0xda, 0xce, 0x6e, 0xfa, 0x63, 0xd9, 0x0f, 0x06, 0x4b, 0x25, 0x56, 0x3a, 0x8d, 0xaa, 0x0d,
-	0x05, 0x89, 0x69, 0x22, 0x72, 0x92, 0xbb, 0x60, 0xea, 0xc4, 0x99, 0xed, 0x86, 0x4e, 0x55, 0x85,
-	0xc4, 0x1b, 0xe2, 0x05, 0x89, 0xb7, 0xbd, 0x20, 0x21, 0x24, 0x84, 0x78, 0x00, 0xb4, 0x67, 0xde,
-	0xc7, 0xdb, 0x24, 0x5e, 0x78, 0x42, 0xa8, 0xe5, 0x0f, 0xe0, 0x4f, 0x40, 0xbe, 0x3e, 0x4e, 0xec,
-	0xf8, 0x3a, 0x76, 0x50, 0xdf, 0x62, 0xdf, 0xf3, 0xe3, 0x73, 0xce, 0x3d, 0xf7, 0xfa, 0xab, 0xc0,
-	0x14, 0x3d, 0x30, 0x4a, 0x86, 0x49, 0x15, 0xc3, 0x54, 0x4b, 0x3a, 0x55, 0x1a, 0x19, 0xe5, 0xf1,
-	0x3e, 0x35, 0x9f, 0xc8, 0x75, 0xd3, 0xb0, 0x0d, 0x32, 0x8a, 0xcb, 0xb2, 0xbb, 0x2c, 0x37, 0x32,
-	0xe2, 0x7c, 0xc9, 0xb0, 0xaa, 0x86, 0xa5, 0x14, 0x55, 0x8b, 0xba, 0xb6, 0x4a, 0x23, 0x53, 0xa4,
-	0xb6, 0x9a, 0x51, 0xea, 0x6a, 0x45, 0xab, 0xa9, 0xb6, 0x66, 0xd4, 0x5c, 0x77, 0x31, 0x1d, 0x8e,
-	0xae, 0xd5, 0xca, 0xf4, 0xa0, 0x60, 0xd2, 0x12, 0xad, 0xd9, 0x85, 0xaa, 0x55, 0x41, 0xcb, 0x85,
-	0x18, 0xcb, 0xba, 0x6a, 0xaa, 0x55, 0x0b, 0x8d, 0x67, 0xc3, 0xc6, 0x4e, 0xda, 0x06, 0x2d, 0xd8,
-	0xc6, 0x1e, 0xf5, 0x92, 0xa7, 0xc2, 0x56, 0x81, 0x28, 0x9c, 0xd2, 0xeb, 0xa6, 0x56, 0xa2, 0x1d,
-	0xdc, 0x9d, 0x65, 0xcf, 0x5d, 0x0a, 0xaf, 0x87, 0xaa, 0x9a, 0x8b, 0xb4, 0x09, 0x90, 0xc8, 0x61,
-	0xb3, 0x86, 0xaa, 0x6b, 0x65, 0xd5, 0x36, 0xcc, 0xc2, 0x7e, 0xbd, 0xac, 0xda, 0xb4, 0x50, 0xd4,
-	0x8d, 0xd2, 0x1e, 0xda, 0x8f, 0x55, 0x8c, 0x8a, 0xc1, 0x7e, 0x2a, 0xce, 0x2f, 0x7c, 0x3b, 0x59,
-	0x31, 0x8c, 0x8a, 0x4e, 0x15, 0xb5, 0xae, 0x29, 0x6a, 0xad, 0x66, 0xd8, 0x6c, 0x27, 0x30, 0x87,
-	0x74, 0x1d, 0x60, 0xd7, 0x69, 0xce, 0xa6, 0xd3, 0x55, 0x32, 0x06, 0x03, 0xac, 0x55, 0xe3, 0xc2,
-	0x8c, 0x90, 0x1e, 0xca, 0xbb, 0x0f, 0xce, 0x5b, 0xd6, 0xf4, 0xf1, 0xde, 0x19, 0x21, 0xdd, 0x9f,
-	0x77, 0x1f, 0x24, 0x11, 0xc6, 0x77, 0x9c, 0x6d, 0x6e, 0xb9, 0x53, 0x2b, 0x4f, 0x1f, 0xef, 0x53,
-	0xcb, 0x96, 0x0a, 0x30, 0xc1, 0x59, 0xb3, 0xea, 0x46, 0xcd, 0xa2, 0x64, 0x1d, 0xce, 0xb2, 0xb8,
-	0x05, 0xcd, 0x5d, 0x18, 0x17, 0x66, 0xfa, 0xd2, 0xc3, 0xcb, 0x53, 0x72, 0x68, 0xa8, 0xe4, 0x96,
-	0x7f, 0x7e, 0xc4, 0xf6, 0xc5, 0x92, 0x56, 0xe0, 0x02, 0x4b, 0xf0, 0xbe, 0xad, 0xee, 0x51, 0xf3,
-	0xbe, 0x66, 0xd9, 0x98, 0x9a, 0x4c, 0xc0, 0x4b, 0xaa, 0x65, 0x51, 0xbb, 0xa0, 0x95, 0xb1, 0x8a,
-	0x33, 0xec, 0x79, 0xb3, 0x2c, 0x7d, 0x04, 0xaf, 0x85, 0x9c, 0x90, 0xe9, 0x2d, 0x18, 0xb6, 0xd8,
-	0xdb, 0x82, 0xae, 0x59, 0x36, 0x73, 0xe4, 0x13, 0xf9, 0x7c, 0xc1, 0x6a, 0xfe, 0x96, 0x76, 0x03,
-	0x3c, 0x9b, 0xb5, 0x47, 0x46, 0x3c, 0x0f, 0x99, 0x6e, 0x26, 0x55, 0xcb, 0x65, 0x93, 0x75, 0x77,
-	0xc8, 0x8b, 0x9a, 0x2b, 0x97, 0xcd, 0x36, 0x60, 0x37, 0x6a, 0x08, 0x58, 0xab, 0x3d, 0x32, 0x62,
-	0x81, 0x99, 0x2f, 0x86, 0x76, 0x7e, 0x4b, 0xab, 0xa1, 0xd0, 0x56, 0x82, 0x0e, 0x3e, 0xc4, 0x3d,
-	0x0f, 0x78, 0x21, 0xd1, 0x1d, 0x18, 0xf1, 0x11, 0x75, 0xda, 0x55, 0x1f, 0xd2, 0x70, 0x0b, 0xc9,
-	0x92, 0xc6, 0x80, 0xb0, 0xe8, 0xdb, 0xec, 0x10, 0x78, 0xb3, 0xb4, 0x05, 0xe7, 0x03, 0x6f, 0x31,
-	0x5d, 0x16, 0x06, 0xdd, 0xc3, 0x82, 0xb5, 0x4f, 0x70, 0x12, 0xb9, 0x2e, 0xeb, 0xfd, 0xcf, 0xff,
-	0x9a, 0xee, 0xc9, 0xa3, 0xb9, 0xb4, 0x0c, 0xaf, 0xb2, 0x78, 0x1b, 0xd4, 0xde, 0x66, 0x07, 0xd7,
-	0x57, 0x37, 0xce, 0xa5, 0x5b, 0x77, 0x7f, 0xfe, 0x8c, 0x3b, 0x73, 0x65, 0x29, 0x0b, 0xa2, 0xe7,
-	0x73, 0x5f, 0xb5, 0xa9, 0xe5, 0x7a, 0x26, 0x70, 0xdc, 0xc1, 0xb9, 0xf0, 0x25, 0xf3, 0xf1, 0xb3,
-	0x37, 0x9d, 0xf8, 0x99, 0x41, 0x93, 0x9f, 0x3d, 0x49, 0x0f, 0xe1, 0x75, 0x2e, 0x0b, 0xc6, 0xbd,
-	0x0d, 0x03, 0xcc, 0x10, 0xc3, 0x5e, 0x8c, 0x0a, 0xbb, 0xab, 0x55, 0x69, 0xde, 0xd8, 0xaf, 0x95,
-	0x31, 0xbc, 0xeb, 0x25, 0x15, 0xb0, 0x3b, 0x39, 0x5d, 0x0f, 0x76, 0xe7, 0x2e, 0x40, 0xeb, 0x1e,
-	0xc7, 0xe0, 0x97, 0x64, 0xf7, 0xd2, 0x97, 0x9d, 0x4b, 0x5f, 0x76, 0x3f, 0x10, 0x78, 0xe9, 0xcb,
-	0xdb, 0x6a, 0xc5, 0x6b, 0x50, 0xde, 0xe7, 0x29, 0x3d, 0x15, 0xb0, 0x25, 0xbe, 0x0c, 0x9c, 0x96,
-	0xf4, 0x75, 0xd1, 0x12, 0xb2, 0x11, 0x60, 0xeb, 0x65, 0x6c, 0x97, 0x63, 0xd9, 0xdc, 0xac, 0x01,
-	0xb8, 0x39, 0x78, 0xc3, 0xeb, 0xed, 0x87, 0xde, 0x4d, 0xfb, 0x01, 0xbb, 0x68, 0xd7, 0x9d, 0x7b,
-	0xd6, 0x1b, 0xc9, 0xaf, 0x04, 0x98, 0xed, 0x6c, 0x87, 0x15, 0x95, 0xe0, 0x02, 0xff, 0xc6, 0xc6,
-	0x06, 0x5e, 0xe6, 0x54, 0xc8, 0x0b, 0x88, 0xf5, 0x8e, 0x35, 0x38, 0x6b, 0x92, 0x04, 0x33, 0x1e,
-	0x8c, 0x7b, 0x55, 0xb2, 0x4f, 0x49, 0xf0, 0x10, 0x7d, 0x0e, 0x17, 0x3b, 0xd8, 0x20, 0xed, 0x03,
-	0x38, 0xcf, 0xf9, 0xb8, 0x22, 0xea, 0x2c, 0x07, 0x35, 0x14, 0x0a, 0x39, 0x47, 0xb5, 0xf6, 0x05,
-	0x69, 0x1a, 0xa6, 0x38, 0x00, 0xef, 0x59, 0x15, 0x8f, 0xd0, 0x82, 0x54, 0x94, 0x01, 0xe2, 0xed,
-	0xc0, 0x2b, 0xed, 0x2a, 0xa1, 0xc3, 0x90, 0x07, 0x83, 0x20, 0xd8, 0x39, 0x2d, 0xf0, 0x56, 0x5a,
-	0xc2, 0xfb, 0x6c, 0x83, 0xda, 0xed, 0x40, 0xce, 0x57, 0xaf, 0xb5, 0x55, 0xfd, 0x79, 0xf7, 0x41,
-	0xfa, 0x18, 0xbf, 0x6c, 0x41, 0x0f, 0x24, 0xcc, 0x01, 0x84, 0xd8, 0x26, 0x39, 0x6c, 0xed, 0x58,
-	0x43, 0x66, 0x93, 0xa8, 0x88, 0x44, 0x39, 0x5d, 0x0f, 0x11, 0x9d, 0xd6, 0x11, 0xfc, 0x41, 0xc0,
-	0x22, 0x82, 0x49, 0x22, 0x8a, 0xe8, 0xeb, 0xba, 0x88, 0xd3, 0x3b, 0x8f, 0x2b, 0xad, 0xbb, 0x8e,
-	0x33, 0xd5, 0x11, 0x5b, 0xf4, 0x29, 0x4c, 0xf2, 0x9d, 0xb0, 0xc0, 0x7b, 0x70, 0x96, 0x37, 0xe0,
-	0xd3, 0x91, 0x35, 0x06, 0x66, 0x7b, 0xc4, 0xf4, 0x8f, 0x35, 0x45, 0xc0, 0x66, 0x27, 0x83, 0x80,
-	0xa7, 0xb5, 0x63, 0xcf, 0x04, 0xac, 0x29, 0x94, 0x27, 0xba, 0xa6, 0xbe, 0xff, 0x59, 0xd3, 0xa9,
-	0xed, 0xde, 0xf2, 0xbf, 0x04, 0x06, 0x18, 0x35, 0xf9, 0x4e, 0x80, 0x11, 0xbf, 0x16, 0x24, 0x0b,
-	0x1c, 0xb0, 0x28, 0x35, 0x29, 0x2e, 0x26, 0x33, 0x76, 0x09, 0xa4, 0xec, 0x17, 0x7f, 0xfc, 0xf3,
-	0x4d, 0x6f, 0x86, 0x28, 0xca, 0x3b, 0xae, 0xd7, 0x16, 0xb5, 0x3f, 0x33, 0xcc, 0x3d, 0x25, 0xac,
-	0xa6, 0x03, 0x32, 0x94, 0x3c, 0x15, 0x00, 0x5a, 0xf2, 0x8e, 0x5c, 0x89, 0xca, 0x1a, 0xd2, 0x9c,
-	0xe2, 0x7c, 0x12, 0x53, 0xc4, 0x5b, 0x63, 0x78, 0x0a, 0xb9, 0x1a, 0x8f, 0xe7, 0x53, 0xa4, 0xe4,
-	0x47, 0x01, 0x86, 0x7d, 0xaa, 0x8b, 0xc4, 0xa4, 0xf4, 0x0b, 0x3a, 0x71, 0x21, 0x91, 0x2d, 0xf2,
-	0xe5, 0x18, 0xdf, 0x2d, 0x72, 0x23, 0x31, 0x1f, 0x93, 0x7b, 0xca, 0xa1, 0xa7, 0x19, 0x8f, 0xc8,
-	0xb3, 0x66, 0x23, 0x9d, 0xd0, 0x71, 0x8d, 0xf4, 0x89, 0x65, 0x71, 0x3e, 0x89, 0x29, 0x82, 0x6e,
-	0x31, 0xd0, 0x77, 0xc9, 0xdd, 0xae, 0x40, 0x7d, 0x9c, 0xca, 0xa1, 0x4f, 0x7d, 0x1f, 0x91, 0x2f,
-	0x05, 0x18, 0xc4, 0x13, 0x30, 0x17, 0x85, 0x11, 0x38, 0xdd, 0xe2, 0xa5, 0x38, 0x33, 0x24, 0x5d,
-	0x62, 0xa4, 0xf3, 0x24, 0x1d, 0x4f, 0xea, 0x9e, 0x5e, 0x67, 0x14, 0x07, 0x5d, 0xa5, 0x43, 0xd2,
-	0x51, 0x49, 0xda, 0xf5, 0xab, 0x78, 0x25, 0x81, 0x25, 0x12, 0xdd, 0x62, 0x44, 0x6b, 0x64, 0x25,
-	0x01, 0x11, 0xf3, 0x54, 0x0e, 0x3d, 0x85, 0x7b, 0x44, 0x7e, 0x16, 0x60, 0xd8, 0xa7, 0x3c, 0xc9,
-	0xd5, 0x0e, 0x79, 0xc3, 0x6a, 0x59, 0x94, 0x93, 0x9a, 0x77, 0x3f, 0x90, 0x3a, 0x73, 0x2f, 0x30,
-	0x64, 0x3f, 0xf1, 0xef, 0x02, 0x8c, 0xf1, 0x64, 0x15, 0xb9, 0xd6, 0x81, 0xa5, 0x83, 0x00, 0x14,
-	0xb3, 0x5d, 0xfb, 0x61, 0x31, 0x77, 0x58, 0x31, 0x37, 0xc9, 0xf5, 0xf8, 0x62, 0xf8, 0xc2, 0x91,
-	0xfc, 0x26, 0xc0, 0x68, 0x48, 0x77, 0x91, 0x95, 0x0e, 0x40, 0x51, 0xa2, 0x50, 0x5c, 0xed, 0xce,
-	0x09, 0x4b, 0xb8, 0xcd, 0x4a, 0xc8, 0x92, 0xb5, 0xf8, 0x12, 0x38, 0x6a, 0x92, 0xfc, 0x2a, 0xc0,
-	0xb9, 0xa0, 0x36, 0x23, 0x4b, 0xc9, 0x38, 0x5a, 0x4a, 0x48, 0xcc, 0x74, 0xe1, 0x81, 0xd8, 0x37,
-	0x19, 0xf6, 0x2a, 0x59, 0xee, 0x12, 0xbb, 0x6a, 0x55, 0xc8, 0xf7, 0x02, 0x0c, 0xb5, 0x70, 0x17,
-	0x3a, 0x24, 0x0f, 0x91, 0x2e, 0x26, 0x33, 0x46, 0xc8, 0x37, 0x19, 0xe4, 0x35, 0xb2, 0x1a, 0x0f,
-	0xd9, 0xc2, 0x53, 0x0e, 0xd9, 0x64, 0x1c, 0x91, 0x6f, 0x05, 0x18, 0x69, 0xc6, 0xcc, 0xe9, 0x7a,
-	0x34, 0x29, 0x47, 0x5d, 0x46, 0x93, 0xf2, 0x54, 0xa2, 0xb4, 0xca, 0x48, 0x65, 0xb2, 0xd8, 0x0d,
-	0x29, 0xf9, 0xa5, 0x49, 0x88, 0x73, 0x2b, 0xc7, 0xb6, 0x27, 0x38, 0xb2, 0x4a, 0x62, 0x7b, 0xe4,
-	0x7c, 0x9b, 0x71, 0xde, 0x20, 0xd9, 0xc4, 0x9c, 0xee, 0x9c, 0x36, 0x9b, 0xfa, 0x93, 0x00, 0x2f,
-	0xfb, 0x23, 0x3b, 0x7d, 0x95, 0x63, 0x5b, 0x95, 0x90, 0x3a, 0x42, 0xce, 0x75, 0xa3, 0x61, 0x02,
-	0xd4, 0xeb, 0xf7, 0x9e, 0x1f, 0xa7, 0x84, 0x17, 0xc7, 0x29, 0xe1, 0xef, 0xe3, 0x94, 0xf0, 0xf5,
-	0x49, 0xaa, 0xe7, 0xc5, 0x49, 0xaa, 0xe7, 0xcf, 0x93, 0x54, 0xcf, 0x83, 0xa5, 0x8a, 0x66, 0x7f,
-	0xb2, 0x5f, 0x94, 0x4b, 0x46, 0x35, 0x2a, 0xe8, 0x81, 0x17, 0xd6, 0x7e, 0x52, 0xa7, 0x56, 0x71,
-	0x90, 0xfd, 0x43, 0xb8, 0xf2, 0x5f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x57, 0x6e, 0x11, 0x04, 0x0c,
-	0x16, 0x00, 0x00,
+func (m *QueryValidatorReportInfoRequest) Reset()         { *m = QueryValidatorReportInfoRequest{} }
+func (m *QueryValidatorReportInfoRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryValidatorReportInfoRequest) ProtoMessage()    {}
+func (*QueryValidatorReportInfoRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b8cba1249806967d, []int{33}
+}
+func (m *QueryValidatorReportInfoRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryValidatorReportInfoRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryValidatorReportInfoRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryValidatorReportInfoRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryValidatorReportInfoRequest.Merge(m, src)
+}
+func (m *QueryValidatorReportInfoRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryValidatorReportInfoRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryValidatorReportInfoRequest.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_QueryValidatorReportInfoRequest proto.InternalMessageInfo
 
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// TokenIndexes shows the map tells token and its index for further usage
-	TokenIndexes(ctx context.Context, in *QueryTokenIndexesRequest, opts ...grpc.CallOption) (*QueryTokenIndexesResponse, error)
-	// StakerList shows all stakers related to the specified asset under native-restaking context
-	StakerList(ctx context.Context, in *QueryStakerListRequest, opts ...grpc.CallOption) (*QueryStakerListResponse, error)
-	// StakerInfos shows the details for all stakers of the specified assets under native-restaking context
-	StakerInfos(ctx context.Context, in *QueryStakerInfosRequest, opts ...grpc.CallOption) (*QueryStakerInfosResponse, error)
-	// StakerInfo shows the details for one staker required by input parsms of the specified asset
-	StakerInfo(ctx context.Context, in *QueryStakerInfoRequest, opts ...grpc.CallOption) (*QueryStakerInfoResponse, error)
-	// Parameters queries the parameters of the module.
-	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
-	// Queries a list of Prices items.
-	Prices(ctx context.Context, in *QueryGetPricesRequest, opts ...grpc.CallOption) (*QueryGetPricesResponse, error)
-	// Queries the latest price of a specific token
-	LatestPrice(ctx context.Context, in *QueryGetLatestPriceRequest, opts ...grpc.CallOption) (*QueryGetLatestPriceResponse, error)
-	// Queries a ValidatorUpdateBlock by index.
-	ValidatorUpdateBlock(ctx context.Context, in *QueryGetValidatorUpdateBlockRequest, opts ...grpc.CallOption) (*QueryGetValidatorUpdateBlockResponse, error)
-	// Queries a IndexRecentParams by index.
-	IndexRecentParams(ctx context.Context, in *QueryGetIndexRecentParamsRequest, opts ...grpc.CallOption) (*QueryGetIndexRecentParamsResponse, error)
-	// Queries a IndexRecentMsg by index.
-	IndexRecentMsg(ctx context.Context, in *QueryGetIndexRecentMsgRequest, opts ...grpc.CallOption) (*QueryGetIndexRecentMsgResponse, error)
-	// Queries a list of RecentMsg items.
-	RecentMsg(ctx context.Context, in *QueryGetRecentMsgRequest, opts ...grpc.CallOption) (*QueryGetRecentMsgResponse, error)
-	// RecentMsgAll all RecentMsg items.
-	RecentMsgAll(ctx context.Context, in *QueryAllRecentMsgRequest, opts ...grpc.CallOption) (*QueryAllRecentMsgResponse, error)
-	// Queries a list of RecentParams items.
-	RecentParams(ctx context.Context, in *QueryGetRecentParamsRequest, opts ...grpc.CallOption) (*QueryGetRecentParamsResponse, error)
-	// RecentParamsAll query all RecentParams.
-	RecentParamsAll(ctx context.Context, in *QueryAllRecentParamsRequest, opts ...grpc.CallOption) (*QueryAllRecentParamsResponse, error)
+func (m *QueryValidatorReportInfoRequest) GetValidator() string {
+	if m != nil {
+		return m.Validator
+	}
+	return ""
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+func (m *QueryValidatorReportInfoRequest) GetFeederId() uint64 {
+	if m != nil {
+		return m.FeederId
+	}
+	return 0
 }
 
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+// QueryValidatorReportInfoResponse is response type for Query/ValidatorReportInfo RPC method
+type QueryValidatorReportInfoResponse struct {
+	// report_info is the liveness of the validator as the price feeder of the feeder
+	ReportInfo ValidatorReportInfo `protobuf:"bytes,1,opt,name=report_info,json=reportInfo,proto3" json:"report_info"`
 }
 
-func (c *queryClient) TokenIndexes(ctx context.Context, in *QueryTokenIndexesRequest, opts ...grpc.CallOption) (*QueryTokenIndexesResponse, error) {
-	out := new(QueryTokenIndexesResponse)
-	err := c.cc.Invoke(ctx, "/exocore.oracle.v1.Query/TokenIndexes", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QueryValidatorReportInfoResponse) Reset()         { *m = QueryValidatorReportInfoResponse{} }
+func (m *QueryValidatorReportInfoResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryValidatorReportInfoResponse) ProtoMessage()    {}
+func (*QueryValidatorReportInfoResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b8cba1249806967d, []int{34}
 }
-
-func (c *queryClient) StakerList(ctx context.Context, in *QueryStakerListRequest, opts ...grpc.CallOption) (*QueryStakerListResponse, error) {
-	out := new(QueryStakerListResponse)
+func (m *QueryValidatorReportInfoResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryValidatorReportInfoResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryValidatorReportInfoResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryValidatorReportInfoResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryValidatorReportInfoResponse.Merge(m, src)
+}
+func (m *QueryValidatorReportInfoResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryValidatorReportInfoResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryValidatorReportInfoResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryValidatorReportInfoResponse proto.InternalMessageInfo
+
+func (m *QueryValidatorReportInfoResponse) GetReportInfo() ValidatorReportInfo {
+	if m != nil {
+		return m.ReportInfo
+	}
+	return ValidatorReportInfo{}
+}
+
+// QueryValidatorReportInfosRequest is request type for Query/ValidatorReportInfos RPC method
+type QueryValidatorReportInfosRequest struct {
+	// validator is the consensus address of the validator, all validators are returned if empty
+	Validator string `protobuf:"bytes,1,opt,name=validator,proto3" json:"validator,omitempty"`
+	// info of pagination
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryValidatorReportInfosRequest) Reset()         { *m = QueryValidatorReportInfosRequest{} }
+func (m *QueryValidatorReportInfosRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryValidatorReportInfosRequest) ProtoMessage()    {}
+func (*QueryValidatorReportInfosRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b8cba1249806967d, []int{35}
+}
+func (m *QueryValidatorReportInfosRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryValidatorReportInfosRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryValidatorReportInfosRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryValidatorReportInfosRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryValidatorReportInfosRequest.Merge(m, src)
+}
+func (m *QueryValidatorReportInfosRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryValidatorReportInfosRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryValidatorReportInfosRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryValidatorReportInfosRequest proto.InternalMessageInfo
+
+func (m *QueryValidatorReportInfosRequest) GetValidator() string {
+	if m != nil {
+		return m.Validator
+	}
+	return ""
+}
+
+func (m *QueryValidatorReportInfosRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryValidatorReportInfosResponse is response type for Query/ValidatorReportInfos RPC method
+type QueryValidatorReportInfosResponse struct {
+	// report_infos is the liveness of the validators as price feeders
+	ReportInfos []ValidatorReportInfo `protobuf:"bytes,1,rep,name=report_infos,json=reportInfos,proto3" json:"report_infos"`
+	// info of pagination
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryValidatorReportInfosResponse) Reset()         { *m = QueryValidatorReportInfosResponse{} }
+func (m *QueryValidatorReportInfosResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryValidatorReportInfosResponse) ProtoMessage()    {}
+func (*QueryValidatorReportInfosResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b8cba1249806967d, []int{36}
+}
+func (m *QueryValidatorReportInfosResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryValidatorReportInfosResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryValidatorReportInfosResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryValidatorReportInfosResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryValidatorReportInfosResponse.Merge(m, src)
+}
+func (m *QueryValidatorReportInfosResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryValidatorReportInfosResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryValidatorReportInfosResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryValidatorReportInfosResponse proto.InternalMessageInfo
+
+func (m *QueryValidatorReportInfosResponse) GetReportInfos() []ValidatorReportInfo {
+	if m != nil {
+		return m.ReportInfos
+	}
+	return nil
+}
+
+func (m *QueryValidatorReportInfosResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryValidatorDeviationInfoRequest is request type for Query/ValidatorDeviationInfo RPC method
+type QueryValidatorDeviationInfoRequest struct {
+	// validator is the consensus address of the validator
+	Validator string `protobuf:"bytes,1,opt,name=validator,proto3" json:"validator,omitempty"`
+	// feeder_id is the ID of the token feeder
+	FeederId uint64 `protobuf:"varint,2,opt,name=feeder_id,json=feederId,proto3" json:"feeder_id,omitempty"`
+}
+
+func (m *QueryValidatorDeviationInfoRequest) Reset()         { *m = QueryValidatorDeviationInfoRequest{} }
+func (m *QueryValidatorDeviationInfoRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryValidatorDeviationInfoRequest) ProtoMessage()    {}
+func (*QueryValidatorDeviationInfoRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b8cba1249806967d, []int{37}
+}
+func (m *QueryValidatorDeviationInfoRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryValidatorDeviationInfoRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryValidatorDeviationInfoRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryValidatorDeviationInfoRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryValidatorDeviationInfoRequest.Merge(m, src)
+}
+func (m *QueryValidatorDeviationInfoRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryValidatorDeviationInfoRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryValidatorDeviationInfoRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryValidatorDeviationInfoRequest proto.InternalMessageInfo
+
+func (m *QueryValidatorDeviationInfoRequest) GetValidator() string {
+	if m != nil {
+		return m.Validator
+	}
+	return ""
+}
+
+func (m *QueryValidatorDeviationInfoRequest) GetFeederId() uint64 {
+	if m != nil {
+		return m.FeederId
+	}
+	return 0
+}
+
+// QueryValidatorDeviationInfoResponse is response type for Query/ValidatorDeviationInfo RPC method
+type QueryValidatorDeviationInfoResponse struct {
+	// deviation_info is the deviation of the prices reported by the validator
+	DeviationInfo ValidatorDeviationInfo `protobuf:"bytes,1,opt,name=deviation_info,json=deviationInfo,proto3" json:"deviation_info"`
+}
+
+func (m *QueryValidatorDeviationInfoResponse) Reset()         { *m = QueryValidatorDeviationInfoResponse{} }
+func (m *QueryValidatorDeviationInfoResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryValidatorDeviationInfoResponse) ProtoMessage()    {}
+func (*QueryValidatorDeviationInfoResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b8cba1249806967d, []int{38}
+}
+func (m *QueryValidatorDeviationInfoResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryValidatorDeviationInfoResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryValidatorDeviationInfoResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryValidatorDeviationInfoResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryValidatorDeviationInfoResponse.Merge(m, src)
+}
+func (m *QueryValidatorDeviationInfoResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryValidatorDeviationInfoResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryValidatorDeviationInfoResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryValidatorDeviationInfoResponse proto.InternalMessageInfo
+
+func (m *QueryValidatorDeviationInfoResponse) GetDeviationInfo() ValidatorDeviationInfo {
+	if m != nil {
+		return m.DeviationInfo
+	}
+	return ValidatorDeviationInfo{}
+}
+
+func init() {
+	proto.RegisterType((*TokenIndex)(nil), "exocore.oracle.v1.TokenIndex")
+	proto.RegisterType((*QueryTokenIndexesRequest)(nil), "exocore.oracle.v1.QueryTokenIndexesRequest")
+	proto.RegisterType((*QueryTokenIndexesResponse)(nil), "exocore.oracle.v1.QueryTokenIndexesResponse")
+	proto.RegisterType((*QueryStakerListRequest)(nil), "exocore.oracle.v1.QueryStakerListRequest")
+	proto.RegisterType((*QueryStakerListResponse)(nil), "exocore.oracle.v1.QueryStakerListResponse")
+	proto.RegisterType((*QueryStakerInfoRequest)(nil), "exocore.oracle.v1.QueryStakerInfoRequest")
+	proto.RegisterType((*QueryStakerInfoResponse)(nil), "exocore.oracle.v1.QueryStakerInfoResponse")
+	proto.RegisterType((*QueryStakerInfosRequest)(nil), "exocore.oracle.v1.QueryStakerInfosRequest")
+	proto.RegisterType((*QueryStakerInfosResponse)(nil), "exocore.oracle.v1.QueryStakerInfosResponse")
+	proto.RegisterType((*QueryParamsRequest)(nil), "exocore.oracle.v1.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "exocore.oracle.v1.QueryParamsResponse")
+	proto.RegisterType((*QueryGetPricesRequest)(nil), "exocore.oracle.v1.QueryGetPricesRequest")
+	proto.RegisterType((*QueryGetLatestPriceRequest)(nil), "exocore.oracle.v1.QueryGetLatestPriceRequest")
+	proto.RegisterType((*QueryTWAPPriceRequest)(nil), "exocore.oracle.v1.QueryTWAPPriceRequest")
+	proto.RegisterType((*QueryGetPricesResponse)(nil), "exocore.oracle.v1.QueryGetPricesResponse")
+	proto.RegisterType((*QueryGetLatestPriceResponse)(nil), "exocore.oracle.v1.QueryGetLatestPriceResponse")
+	proto.RegisterType((*QueryTWAPPriceResponse)(nil), "exocore.oracle.v1.QueryTWAPPriceResponse")
+	proto.RegisterType((*QueryAllPricesRequest)(nil), "exocore.oracle.v1.QueryAllPricesRequest")
+	proto.RegisterType((*QueryAllPricesResponse)(nil), "exocore.oracle.v1.QueryAllPricesResponse")
+	proto.RegisterType((*QueryGetValidatorUpdateBlockRequest)(nil), "exocore.oracle.v1.QueryGetValidatorUpdateBlockRequest")
+	proto.RegisterType((*QueryGetValidatorUpdateBlockResponse)(nil), "exocore.oracle.v1.QueryGetValidatorUpdateBlockResponse")
+	proto.RegisterType((*QueryGetIndexRecentParamsRequest)(nil), "exocore.oracle.v1.QueryGetIndexRecentParamsRequest")
+	proto.RegisterType((*QueryGetIndexRecentParamsResponse)(nil), "exocore.oracle.v1.QueryGetIndexRecentParamsResponse")
+	proto.RegisterType((*QueryGetIndexRecentMsgRequest)(nil), "exocore.oracle.v1.QueryGetIndexRecentMsgRequest")
+	proto.RegisterType((*QueryGetIndexRecentMsgResponse)(nil), "exocore.oracle.v1.QueryGetIndexRecentMsgResponse")
+	proto.RegisterType((*QueryGetRecentMsgRequest)(nil), "exocore.oracle.v1.QueryGetRecentMsgRequest")
+	proto.RegisterType((*QueryGetRecentMsgResponse)(nil), "exocore.oracle.v1.QueryGetRecentMsgResponse")
+	proto.RegisterType((*QueryAllRecentMsgRequest)(nil), "exocore.oracle.v1.QueryAllRecentMsgRequest")
+	proto.RegisterType((*QueryAllRecentMsgResponse)(nil), "exocore.oracle.v1.QueryAllRecentMsgResponse")
+	proto.RegisterType((*QueryGetRecentParamsRequest)(nil), "exocore.oracle.v1.QueryGetRecentParamsRequest")
+	proto.RegisterType((*QueryGetRecentParamsResponse)(nil), "exocore.oracle.v1.QueryGetRecentParamsResponse")
+	proto.RegisterType((*QueryAllRecentParamsRequest)(nil), "exocore.oracle.v1.QueryAllRecentParamsRequest")
+	proto.RegisterType((*QueryAllRecentParamsResponse)(nil), "exocore.oracle.v1.QueryAllRecentParamsResponse")
+	proto.RegisterType((*QueryValidatorReportInfoRequest)(nil), "exocore.oracle.v1.QueryValidatorReportInfoRequest")
+	proto.RegisterType((*QueryValidatorReportInfoResponse)(nil), "exocore.oracle.v1.QueryValidatorReportInfoResponse")
+	proto.RegisterType((*QueryValidatorReportInfosRequest)(nil), "exocore.oracle.v1.QueryValidatorReportInfosRequest")
+	proto.RegisterType((*QueryValidatorReportInfosResponse)(nil), "exocore.oracle.v1.QueryValidatorReportInfosResponse")
+	proto.RegisterType((*QueryValidatorDeviationInfoRequest)(nil), "exocore.oracle.v1.QueryValidatorDeviationInfoRequest")
+	proto.RegisterType((*QueryValidatorDeviationInfoResponse)(nil), "exocore.oracle.v1.QueryValidatorDeviationInfoResponse")
+}
+
+func init() { proto.RegisterFile("exocore/oracle/v1/query.proto", fileDescriptor_b8cba1249806967d) }
+
+var fileDescriptor_b8cba1249806967d = []byte{
+	// 1745 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x99, 0xcd, 0x6f, 0x14, 0x47,
+	0x16, 0xc0, 0x5d, 0xd8, 0x18, 0xfc, 0xc6, 0xf6, 0x2e, 0x85, 0xd7, 0x6b, 0x37, 0x66, 0x6c, 0x7a,
+	0x31, 0x18, 0x1b, 0xa6, 0xfd, 0x89, 0xf9, 0x58, 0x76, 0xb1, 0xb5, 0x0b, 0x18, 0xf1, 0x61, 0x26,
+	0x0e, 0x28, 0x28, 0xca, 0xa8, 0x3d, 0x5d, 0x0c, 0x1d, 0x8f, 0xa7, 0x87, 0xee, 0xb6, 0x81, 0x38,
+	0x56, 0xa4, 0x9c, 0x12, 0xe5, 0x12, 0x29, 0xa7, 0x70, 0x89, 0x12, 0x25, 0x8a, 0xa2, 0x1c, 0x92,
+	0x88, 0x33, 0xa7, 0x5c, 0xc8, 0x0d, 0x29, 0x87, 0xe4, 0x14, 0x45, 0x38, 0x7f, 0x48, 0xd4, 0xd5,
+	0x6f, 0x7a, 0xaa, 0xdd, 0xdf, 0x68, 0xa4, 0xdc, 0xa6, 0xab, 0xdf, 0xc7, 0xef, 0xbd, 0x7a, 0xf5,
+	0xba, 0x9e, 0x0d, 0x87, 0xd9, 0x23, 0xa3, 0x6c, 0x98, 0x4c, 0x31, 0x4c, 0xb5, 0x5c, 0x65, 0xca,
+	0xe6, 0x94, 0xf2, 0x60, 0x83, 0x99, 0x8f, 0x0b, 0x75, 0xd3, 0xb0, 0x0d, 0x7a, 0x00, 0x5f, 0x17,
+	0xdc, 0xd7, 0x85, 0xcd, 0x29, 0x69, 0xbc, 0x6c, 0x58, 0xeb, 0x86, 0xa5, 0xac, 0xaa, 0x16, 0x73,
+	0x65, 0x95, 0xcd, 0xa9, 0x55, 0x66, 0xab, 0x53, 0x4a, 0x5d, 0xad, 0xe8, 0x35, 0xd5, 0xd6, 0x8d,
+	0x9a, 0xab, 0x2e, 0x8d, 0x05, 0xad, 0xeb, 0x35, 0x8d, 0x3d, 0x2a, 0x99, 0xac, 0xcc, 0x6a, 0x76,
+	0x69, 0xdd, 0xaa, 0xa0, 0xe4, 0x44, 0x82, 0x64, 0x5d, 0x35, 0xd5, 0x75, 0x0b, 0x85, 0x8f, 0x06,
+	0x85, 0x1d, 0xb7, 0x9b, 0xac, 0x64, 0x1b, 0x6b, 0xac, 0xe1, 0x3c, 0x1f, 0x94, 0xf2, 0x59, 0x09,
+	0x09, 0xbd, 0x6e, 0xea, 0x65, 0x16, 0xa3, 0xee, 0xbc, 0x6e, 0xa8, 0xcb, 0xc1, 0xf7, 0x81, 0xa8,
+	0x46, 0x23, 0x65, 0x7c, 0x24, 0xa7, 0x82, 0x62, 0x9b, 0x6a, 0x55, 0xd7, 0x54, 0xdb, 0x30, 0x4b,
+	0x26, 0xab, 0x1b, 0xa6, 0x5d, 0xd2, 0x6b, 0xf7, 0x0c, 0x14, 0x2f, 0xc4, 0x89, 0x6f, 0xd4, 0x35,
+	0xd5, 0x66, 0xa5, 0xd5, 0xaa, 0x51, 0x5e, 0x43, 0xf9, 0xbe, 0x8a, 0x51, 0x31, 0xf8, 0x4f, 0xc5,
+	0xf9, 0x85, 0xab, 0x43, 0x15, 0xc3, 0xa8, 0x54, 0x99, 0xa2, 0xd6, 0x75, 0x45, 0xad, 0xd5, 0x0c,
+	0x9b, 0x6f, 0x1c, 0x22, 0xc9, 0x67, 0x00, 0x56, 0x9c, 0x5c, 0x2e, 0x39, 0x9b, 0x40, 0xfb, 0x60,
+	0x2f, 0xcf, 0xec, 0x00, 0x19, 0x21, 0x63, 0x5d, 0x45, 0xf7, 0xc1, 0x59, 0xe5, 0x7b, 0x34, 0xb0,
+	0x67, 0x84, 0x8c, 0x75, 0x14, 0xdd, 0x07, 0x59, 0x82, 0x81, 0x5b, 0x4e, 0x55, 0x34, 0xd5, 0x99,
+	0x55, 0x64, 0x0f, 0x36, 0x98, 0x65, 0xcb, 0x25, 0x18, 0x0c, 0x79, 0x67, 0xd5, 0x8d, 0x9a, 0xc5,
+	0xe8, 0x22, 0xf4, 0x70, 0xbb, 0x25, 0xdd, 0x7d, 0x31, 0x40, 0x46, 0xda, 0xc7, 0x72, 0xd3, 0x87,
+	0x0b, 0x81, 0x1a, 0x2c, 0x34, 0xf5, 0x8b, 0xdd, 0xb6, 0x60, 0x4b, 0x9e, 0x81, 0x7e, 0xee, 0xe0,
+	0x35, 0x5b, 0x5d, 0x63, 0xe6, 0x35, 0xdd, 0xb2, 0xd1, 0x35, 0x1d, 0x84, 0xfd, 0xaa, 0x65, 0x31,
+	0xbb, 0xa4, 0x6b, 0x18, 0xc5, 0x3e, 0xfe, 0xbc, 0xa4, 0xc9, 0x6f, 0xc0, 0x3f, 0x03, 0x4a, 0xc8,
+	0xf4, 0x1f, 0xc8, 0x59, 0x7c, 0xb5, 0x54, 0xd5, 0x2d, 0x9b, 0x2b, 0x86, 0x13, 0x09, 0xba, 0x60,
+	0x79, 0xbf, 0xe5, 0x15, 0x1f, 0xcf, 0x52, 0xed, 0x9e, 0x91, 0xcc, 0x43, 0x87, 0x3d, 0xa7, 0xaa,
+	0xa6, 0x99, 0x3c, 0xbb, 0x5d, 0x0d, 0xab, 0x0b, 0x9a, 0x66, 0xee, 0x02, 0x76, 0xad, 0x06, 0x80,
+	0x9d, 0x82, 0x49, 0x04, 0xe6, 0xba, 0x68, 0xda, 0xf9, 0x2d, 0xbf, 0x1b, 0x30, 0x6d, 0xa5, 0x20,
+	0xbe, 0x04, 0xd0, 0x3c, 0xfb, 0x1c, 0x38, 0x37, 0x7d, 0xac, 0xe0, 0x36, 0x8a, 0x82, 0xd3, 0x28,
+	0x0a, 0x6e, 0x53, 0xc1, 0x46, 0x51, 0x58, 0x56, 0x2b, 0x0c, 0xcd, 0x16, 0x05, 0x4d, 0xf9, 0x2b,
+	0x82, 0xc5, 0xe3, 0x73, 0x8f, 0xa1, 0x5d, 0x84, 0x6e, 0x21, 0xb4, 0xb8, 0xf2, 0x10, 0x62, 0xcb,
+	0x35, 0x63, 0xb3, 0xe8, 0xe5, 0x10, 0xcc, 0xe3, 0x89, 0x98, 0xae, 0x7b, 0x1f, 0x67, 0x1f, 0x50,
+	0x8e, 0xb9, 0xcc, 0x4f, 0x71, 0xa3, 0xba, 0x6f, 0xc0, 0x41, 0xdf, 0x2a, 0x72, 0xcf, 0x43, 0xa7,
+	0x7b, 0xda, 0x71, 0x37, 0x06, 0x43, 0x88, 0x5d, 0x95, 0xc5, 0x8e, 0xe7, 0xbf, 0x0d, 0xb7, 0x15,
+	0x51, 0x5c, 0x7e, 0x07, 0xfe, 0xc1, 0xed, 0x5d, 0x66, 0xf6, 0x32, 0xef, 0x3c, 0xc2, 0x4e, 0xe0,
+	0x49, 0x71, 0x77, 0xa2, 0xa3, 0xb8, 0xcf, 0x3d, 0x05, 0xad, 0xdb, 0x89, 0x79, 0x90, 0x1a, 0xbe,
+	0xaf, 0xa9, 0x36, 0xb3, 0x5c, 0x82, 0x64, 0x00, 0x79, 0x15, 0xa1, 0x57, 0xee, 0x2c, 0x2c, 0xa7,
+	0xd4, 0xa1, 0xfd, 0xd0, 0x69, 0x1a, 0x1b, 0x35, 0xcd, 0xc2, 0x4e, 0x82, 0x4f, 0xce, 0x3a, 0xef,
+	0x63, 0xd6, 0x40, 0xbb, 0xbb, 0xee, 0x3e, 0xc9, 0x4f, 0x08, 0x1e, 0x2b, 0x21, 0x33, 0x42, 0xb2,
+	0xf9, 0x4a, 0x5c, 0xb2, 0xb9, 0x80, 0x97, 0x6c, 0xfe, 0xd4, 0xba, 0xda, 0xf8, 0x94, 0xc0, 0xa1,
+	0xd0, 0xd4, 0x21, 0xe1, 0x05, 0xd8, 0xcb, 0x5d, 0x22, 0xe0, 0x91, 0x28, 0xc0, 0x15, 0x7d, 0x9d,
+	0x15, 0x9d, 0x3c, 0x20, 0xa8, 0xab, 0x45, 0x47, 0xa1, 0xd7, 0x6d, 0xf1, 0x5a, 0xe9, 0x3e, 0xd3,
+	0x2b, 0xf7, 0x6d, 0xce, 0xda, 0x5e, 0xec, 0xc1, 0xd5, 0x2b, 0x7c, 0xd1, 0xe9, 0xcd, 0x96, 0xad,
+	0x56, 0x19, 0xcf, 0xdc, 0xfe, 0xa2, 0xfb, 0x20, 0x1b, 0x98, 0x37, 0x61, 0x73, 0x5a, 0x43, 0x15,
+	0xb1, 0x83, 0x72, 0x09, 0xab, 0x61, 0xa1, 0x5a, 0xf5, 0x97, 0xb0, 0xbf, 0x4e, 0xc9, 0x2b, 0xd7,
+	0xa9, 0x57, 0x0a, 0x82, 0x87, 0x90, 0x52, 0x68, 0xff, 0x4b, 0x4a, 0x61, 0x14, 0xfe, 0xd5, 0xa8,
+	0x84, 0xdb, 0x8d, 0x0f, 0xf4, 0xeb, 0x7c, 0x9b, 0x16, 0x9d, 0x42, 0x6e, 0xf4, 0x8d, 0x8f, 0x08,
+	0x1c, 0x8d, 0x97, 0xc3, 0x88, 0xca, 0xd0, 0x1f, 0xfe, 0xa1, 0xc7, 0x04, 0x1e, 0x0f, 0x89, 0x30,
+	0xcc, 0x20, 0xc6, 0xdb, 0xb7, 0x19, 0xf2, 0x4e, 0x96, 0x61, 0xa4, 0x01, 0xe3, 0x7e, 0x61, 0xf9,
+	0x85, 0xc5, 0xdf, 0xe9, 0xde, 0x83, 0x23, 0x31, 0x32, 0x48, 0x7b, 0x17, 0x0e, 0x86, 0x5c, 0xe1,
+	0x10, 0xf5, 0x68, 0x08, 0x6a, 0xc0, 0x14, 0x72, 0x1e, 0xd0, 0x77, 0xbf, 0x90, 0x87, 0xe1, 0x70,
+	0x08, 0xc0, 0x75, 0xab, 0xd2, 0x20, 0xb4, 0x20, 0x1f, 0x25, 0x80, 0x78, 0xb7, 0xe0, 0xef, 0xbb,
+	0xef, 0xa2, 0x31, 0xc5, 0xef, 0x37, 0x82, 0x60, 0xbd, 0xba, 0x6f, 0x55, 0x9e, 0xc4, 0xaf, 0xd7,
+	0x65, 0x66, 0xef, 0x06, 0x72, 0x0e, 0x64, 0x73, 0xab, 0x3a, 0x8a, 0xee, 0x83, 0xfc, 0x16, 0x5e,
+	0x88, 0xfc, 0x1a, 0x48, 0xb8, 0x00, 0x10, 0x60, 0x1b, 0x0a, 0x61, 0xdb, 0x8d, 0xd5, 0x65, 0x7a,
+	0x44, 0xab, 0x48, 0xb4, 0x50, 0xad, 0x06, 0x88, 0x5a, 0x75, 0x04, 0xbf, 0x26, 0x18, 0x84, 0xdf,
+	0x49, 0x44, 0x10, 0xed, 0x99, 0x83, 0x68, 0xdd, 0x79, 0x9c, 0x69, 0x76, 0xe6, 0x90, 0xaa, 0x8e,
+	0xd8, 0xa2, 0xb7, 0x61, 0x28, 0x5c, 0x09, 0x03, 0xbc, 0x0a, 0x3d, 0x61, 0x05, 0x3e, 0x1c, 0x19,
+	0xa3, 0xaf, 0xb6, 0xbb, 0x4d, 0xb1, 0xac, 0x19, 0x02, 0x7a, 0x99, 0xf4, 0x03, 0xb6, 0x6a, 0xc7,
+	0x9e, 0x12, 0x8c, 0x29, 0xe0, 0x27, 0x3a, 0xa6, 0xf6, 0x57, 0x8c, 0xa9, 0x75, 0xbb, 0xf7, 0x26,
+	0x0c, 0x73, 0x68, 0xaf, 0xa3, 0x15, 0xf9, 0x64, 0x24, 0x5e, 0xaa, 0x87, 0xa0, 0xcb, 0xeb, 0x69,
+	0x78, 0x47, 0x6d, 0x2e, 0xd0, 0x43, 0xd0, 0x75, 0x8f, 0x31, 0xcd, 0xb9, 0x40, 0x6a, 0xf8, 0x9d,
+	0xda, 0xef, 0x2e, 0x2c, 0x69, 0xf2, 0x03, 0x6c, 0x7b, 0xa1, 0xd6, 0x31, 0x2d, 0xd7, 0x21, 0x27,
+	0x4c, 0x63, 0xde, 0x06, 0xc4, 0x34, 0xdd, 0xa6, 0x11, 0xcc, 0x0d, 0x98, 0xde, 0x8a, 0xfc, 0x01,
+	0x89, 0xf6, 0x69, 0xa5, 0x0b, 0xa9, 0x55, 0xd7, 0xbd, 0x67, 0x04, 0x3b, 0x7a, 0x38, 0x0a, 0xc6,
+	0x7f, 0x13, 0xba, 0x85, 0xf8, 0x1b, 0x55, 0x91, 0x2d, 0x01, 0xb9, 0x66, 0x02, 0x5a, 0x58, 0x1b,
+	0x25, 0x90, 0xfd, 0xf8, 0xff, 0x63, 0x9b, 0x3a, 0x7f, 0xd5, 0xa2, 0xf2, 0xd8, 0xc6, 0x4f, 0x79,
+	0x94, 0x03, 0xcc, 0xd0, 0x6d, 0xe8, 0xd5, 0x1a, 0x2f, 0xc4, 0x22, 0x39, 0x11, 0x97, 0x23, 0x9f,
+	0x29, 0x4c, 0x53, 0x8f, 0x26, 0x2e, 0x4e, 0x3f, 0x19, 0x84, 0xbd, 0xdc, 0x3f, 0xfd, 0x82, 0x40,
+	0xb7, 0x38, 0x3e, 0xd3, 0x89, 0x10, 0xd3, 0x51, 0x03, 0xb8, 0x74, 0x32, 0x9d, 0xb0, 0x1b, 0x8d,
+	0x3c, 0xff, 0xfe, 0xcf, 0x7f, 0x7c, 0xb2, 0x67, 0x8a, 0x2a, 0xca, 0xff, 0x5d, 0xad, 0x1b, 0xcc,
+	0x7e, 0x68, 0x98, 0x6b, 0x4a, 0xf0, 0x0f, 0x10, 0xbe, 0xc9, 0x9d, 0x3e, 0x21, 0x00, 0xcd, 0x89,
+	0x98, 0x9e, 0x88, 0xf2, 0x1a, 0x18, 0xd3, 0xa5, 0xf1, 0x34, 0xa2, 0x88, 0x37, 0xc7, 0xf1, 0x14,
+	0x7a, 0x2a, 0x19, 0x4f, 0x18, 0xe2, 0xe9, 0x37, 0x04, 0x72, 0xc2, 0x7c, 0x49, 0x13, 0x5c, 0x8a,
+	0xa7, 0x51, 0x9a, 0x48, 0x25, 0x8b, 0x7c, 0x0b, 0x9c, 0xef, 0x3c, 0x3d, 0x9b, 0x9a, 0x8f, 0x1f,
+	0x2b, 0x65, 0xab, 0x31, 0x66, 0x6f, 0xd3, 0xa7, 0x5e, 0x22, 0x1d, 0xd3, 0x49, 0x89, 0x14, 0x6a,
+	0x5d, 0x1a, 0x4f, 0x23, 0x8a, 0xa0, 0x37, 0x38, 0xe8, 0x15, 0x7a, 0x29, 0x13, 0xa8, 0xc0, 0xa9,
+	0x6c, 0x09, 0x7f, 0xb0, 0xd8, 0xa6, 0x1f, 0x12, 0xe8, 0xc4, 0xee, 0x3f, 0x1a, 0x85, 0xe1, 0xfb,
+	0xb2, 0x49, 0xc7, 0x92, 0xc4, 0x90, 0x74, 0x92, 0x93, 0x8e, 0xd3, 0xb1, 0x64, 0x52, 0xf7, 0xcb,
+	0xe5, 0x94, 0x62, 0xa7, 0x7b, 0xcb, 0xa7, 0x63, 0x51, 0x4e, 0x76, 0x0f, 0xd8, 0xd2, 0x89, 0x14,
+	0x92, 0x48, 0x74, 0x9e, 0x13, 0xcd, 0xd1, 0x99, 0x14, 0x44, 0x5c, 0x53, 0xd9, 0x6a, 0x8c, 0xc1,
+	0xdb, 0xf4, 0x3b, 0x02, 0x39, 0x61, 0x46, 0xa4, 0xa7, 0x62, 0xfc, 0x06, 0xc7, 0x70, 0xa9, 0x90,
+	0x56, 0x3c, 0x7b, 0x41, 0x56, 0xb9, 0x7a, 0x89, 0x23, 0x8b, 0xc4, 0x9f, 0x13, 0xe8, 0xf2, 0xa6,
+	0xc7, 0xe8, 0x8c, 0xee, 0x9e, 0xfe, 0xa3, 0x33, 0x1a, 0x18, 0x45, 0xe5, 0xff, 0x72, 0xca, 0xb3,
+	0x74, 0x3e, 0x45, 0xd7, 0x79, 0xa8, 0xd6, 0x83, 0x8c, 0x3f, 0x11, 0xe8, 0x0b, 0x1b, 0x7b, 0xe8,
+	0xe9, 0x98, 0x7c, 0xc5, 0x0c, 0x68, 0xd2, 0x7c, 0x66, 0x3d, 0x0c, 0xe5, 0x22, 0x0f, 0xe5, 0x1c,
+	0x3d, 0x93, 0x1c, 0x4a, 0xf8, 0x60, 0x47, 0x9f, 0x11, 0x38, 0x10, 0x98, 0x8b, 0xe8, 0x4c, 0x0c,
+	0x50, 0xd4, 0xd0, 0x26, 0xcd, 0x66, 0x53, 0xc2, 0x10, 0x2e, 0xf0, 0x10, 0xe6, 0xe9, 0x5c, 0x72,
+	0x08, 0x21, 0xd3, 0x1e, 0xfd, 0x81, 0x40, 0xaf, 0x7f, 0x76, 0xa2, 0x93, 0xe9, 0x38, 0x9a, 0x93,
+	0x8a, 0x34, 0x95, 0x41, 0x03, 0xb1, 0xcf, 0x71, 0xec, 0x59, 0x3a, 0x9d, 0x11, 0x7b, 0xdd, 0xaa,
+	0xd0, 0x2f, 0x09, 0x74, 0x35, 0x71, 0x27, 0x62, 0x9c, 0x07, 0x48, 0x4f, 0xa6, 0x13, 0x46, 0xc8,
+	0x7f, 0x73, 0xc8, 0xd3, 0x74, 0x36, 0x19, 0xb2, 0x89, 0xa7, 0x6c, 0xf1, 0xca, 0xd8, 0xa6, 0x9f,
+	0x11, 0xe8, 0xf6, 0x6c, 0x2e, 0x54, 0xab, 0xd1, 0xa4, 0x21, 0xd3, 0x5f, 0x34, 0x69, 0xd8, 0x14,
+	0x27, 0xcf, 0x72, 0xd2, 0x02, 0x3d, 0x99, 0x85, 0x94, 0x7e, 0xef, 0x11, 0x62, 0xdd, 0x16, 0x12,
+	0xd3, 0xe3, 0x2f, 0x59, 0x25, 0xb5, 0x7c, 0xf6, 0xde, 0xe1, 0xab, 0x53, 0x2f, 0xa9, 0xdf, 0x12,
+	0xf8, 0x9b, 0x68, 0xd9, 0xc9, 0x6b, 0x21, 0x31, 0x55, 0x29, 0xa9, 0x23, 0xc6, 0xad, 0x2c, 0xf7,
+	0x2c, 0xff, 0xe9, 0xfa, 0x85, 0xc0, 0xc1, 0x90, 0xab, 0x36, 0x9d, 0x8e, 0x22, 0x88, 0x9e, 0x9d,
+	0xa4, 0x99, 0x4c, 0x3a, 0x48, 0x7e, 0x87, 0x93, 0xdf, 0xa2, 0x37, 0xb3, 0x34, 0x38, 0x61, 0x86,
+	0x50, 0xb6, 0xbc, 0xe5, 0x6d, 0x65, 0xcb, 0xbb, 0x7e, 0x6f, 0xd3, 0x1f, 0xc5, 0x1e, 0x2e, 0xcc,
+	0x22, 0x34, 0x0b, 0x66, 0x72, 0xeb, 0x8b, 0x1b, 0x77, 0x5e, 0xad, 0x7b, 0x8b, 0x03, 0x12, 0xdd,
+	0x21, 0xd0, 0x1f, 0x7e, 0xcd, 0xa7, 0x73, 0x89, 0x48, 0x61, 0x23, 0x8c, 0x74, 0x3a, 0xab, 0x1a,
+	0xc6, 0x72, 0x97, 0xc7, 0xb2, 0x42, 0x8b, 0x59, 0x62, 0xf1, 0x8f, 0x32, 0x51, 0x7b, 0xb5, 0x78,
+	0xf5, 0xf9, 0xcb, 0x3c, 0x79, 0xf1, 0x32, 0x4f, 0x7e, 0x7f, 0x99, 0x27, 0x1f, 0xef, 0xe4, 0xdb,
+	0x5e, 0xec, 0xe4, 0xdb, 0x7e, 0xdd, 0xc9, 0xb7, 0xdd, 0x9d, 0xac, 0xe8, 0xf6, 0xfd, 0x8d, 0xd5,
+	0x42, 0xd9, 0x58, 0x8f, 0xf2, 0xfb, 0xa8, 0xe1, 0xd9, 0x7e, 0x5c, 0x67, 0xd6, 0x6a, 0x27, 0xff,
+	0xf7, 0xe3, 0xcc, 0x9f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x8d, 0x9b, 0x59, 0xa2, 0x98, 0x1e, 0x00,
+	0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// TokenIndexes shows the map tells token and its index for further usage
+	TokenIndexes(ctx context.Context, in *QueryTokenIndexesRequest, opts ...grpc.CallOption) (*QueryTokenIndexesResponse, error)
+	// StakerList shows all stakers related to the specified asset under native-restaking context
+	StakerList(ctx context.Context, in *QueryStakerListRequest, opts ...grpc.CallOption) (*QueryStakerListResponse, error)
+	// StakerInfos shows the details for all stakers of the specified assets under native-restaking context
+	StakerInfos(ctx context.Context, in *QueryStakerInfosRequest, opts ...grpc.CallOption) (*QueryStakerInfosResponse, error)
+	// StakerInfo shows the details for one staker required by input parsms of the specified asset
+	StakerInfo(ctx context.Context, in *QueryStakerInfoRequest, opts ...grpc.CallOption) (*QueryStakerInfoResponse, error)
+	// Parameters queries the parameters of the module.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// Queries a list of Prices items.
+	Prices(ctx context.Context, in *QueryGetPricesRequest, opts ...grpc.CallOption) (*QueryGetPricesResponse, error)
+	// Queries the latest price of a specific token
+	LatestPrice(ctx context.Context, in *QueryGetLatestPriceRequest, opts ...grpc.CallOption) (*QueryGetLatestPriceResponse, error)
+	// Queries the time-weighted average price of a specific token over the latest rounds or blocks
+	TWAPPrice(ctx context.Context, in *QueryTWAPPriceRequest, opts ...grpc.CallOption) (*QueryTWAPPriceResponse, error)
+	// Queries a ValidatorUpdateBlock by index.
+	ValidatorUpdateBlock(ctx context.Context, in *QueryGetValidatorUpdateBlockRequest, opts ...grpc.CallOption) (*QueryGetValidatorUpdateBlockResponse, error)
+	// Queries a IndexRecentParams by index.
+	IndexRecentParams(ctx context.Context, in *QueryGetIndexRecentParamsRequest, opts ...grpc.CallOption) (*QueryGetIndexRecentParamsResponse, error)
+	// Queries a IndexRecentMsg by index.
+	IndexRecentMsg(ctx context.Context, in *QueryGetIndexRecentMsgRequest, opts ...grpc.CallOption) (*QueryGetIndexRecentMsgResponse, error)
+	// Queries a list of RecentMsg items.
+	RecentMsg(ctx context.Context, in *QueryGetRecentMsgRequest, opts ...grpc.CallOption) (*QueryGetRecentMsgResponse, error)
+	// RecentMsgAll all RecentMsg items.
+	RecentMsgAll(ctx context.Context, in *QueryAllRecentMsgRequest, opts ...grpc.CallOption) (*QueryAllRecentMsgResponse, error)
+	// Queries a list of RecentParams items.
+	RecentParams(ctx context.Context, in *QueryGetRecentParamsRequest, opts ...grpc.CallOption) (*QueryGetRecentParamsResponse, error)
+	// RecentParamsAll query all RecentParams.
+	RecentParamsAll(ctx context.Context, in *QueryAllRecentParamsRequest, opts ...grpc.CallOption) (*QueryAllRecentParamsResponse, error)
+	// ValidatorReportInfo queries the liveness of a validator as the price feeder of a feeder.
+	ValidatorReportInfo(ctx context.Context, in *QueryValidatorReportInfoRequest, opts ...grpc.CallOption) (*QueryValidatorReportInfoResponse, error)
+	// ValidatorReportInfos queries the liveness of the validators as price feeders, optionally
+	// filtered by the validator.
+	ValidatorReportInfos(ctx context.Context, in *QueryValidatorReportInfosRequest, opts ...grpc.CallOption) (*QueryValidatorReportInfosResponse, error)
+	// ValidatorDeviationInfo queries the deviation of the prices reported by a validator from the
+	// final prices of a feeder.
+	ValidatorDeviationInfo(ctx context.Context, in *QueryValidatorDeviationInfoRequest, opts ...grpc.CallOption) (*QueryValidatorDeviationInfoResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) TokenIndexes(ctx context.Context, in *QueryTokenIndexesRequest, opts ...grpc.CallOption) (*QueryTokenIndexesResponse, error) {
+	out := new(QueryTokenIndexesResponse)
+	err := c.cc.Invoke(ctx, "/exocore.oracle.v1.Query/TokenIndexes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) StakerList(ctx context.Context, in *QueryStakerListRequest, opts ...grpc.CallOption) (*QueryStakerListResponse, error) {
+	out := new(QueryStakerListResponse)
 	err := c.cc.Invoke(ctx, "/exocore.oracle.v1.Query/StakerList", in, out, opts...)
 	if err != nil {
 		return nil, err
@@ -1696,6 +2226,15 @@ func (c *queryClient) LatestPrice(ctx context.Context, in *QueryGetLatestPriceRe
 	return out, nil
 }
 
+func (c *queryClient) TWAPPrice(ctx context.Context, in *QueryTWAPPriceRequest, opts ...grpc.CallOption) (*QueryTWAPPriceResponse, error) {
+	out := new(QueryTWAPPriceResponse)
+	err := c.cc.Invoke(ctx, "/exocore.oracle.v1.Query/TWAPPrice", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) ValidatorUpdateBlock(ctx context.Context, in *QueryGetValidatorUpdateBlockRequest, opts ...grpc.CallOption) (*QueryGetValidatorUpdateBlockResponse, error) {
 	out := new(QueryGetValidatorUpdateBlockResponse)
 	err := c.cc.Invoke(ctx, "/exocore.oracle.v1.Query/ValidatorUpdateBlock", in, out, opts...)
@@ -1759,11 +2298,38 @@ func (c *queryClient) RecentParamsAll(ctx context.Context, in *QueryAllRecentPar
 	return out, nil
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// TokenIndexes shows the map tells token and its index for further usage
-	TokenIndexes(context.Context, *QueryTokenIndexesRequest) (*QueryTokenIndexesResponse, error)
-	// StakerList shows all stakers related to the specified asset under native-restaking context
+func (c *queryClient) ValidatorReportInfo(ctx context.Context, in *QueryValidatorReportInfoRequest, opts ...grpc.CallOption) (*QueryValidatorReportInfoResponse, error) {
+	out := new(QueryValidatorReportInfoResponse)
+	err := c.cc.Invoke(ctx, "/exocore.oracle.v1.Query/ValidatorReportInfo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ValidatorReportInfos(ctx context.Context, in *QueryValidatorReportInfosRequest, opts ...grpc.CallOption) (*QueryValidatorReportInfosResponse, error) {
+	out := new(QueryValidatorReportInfosResponse)
+	err := c.cc.Invoke(ctx, "/exocore.oracle.v1.Query/ValidatorReportInfos", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ValidatorDeviationInfo(ctx context.Context, in *QueryValidatorDeviationInfoRequest, opts ...grpc.CallOption) (*QueryValidatorDeviationInfoResponse, error) {
+	out := new(QueryValidatorDeviationInfoResponse)
+	err := c.cc.Invoke(ctx, "/exocore.oracle.v1.Query/ValidatorDeviationInfo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// TokenIndexes shows the map tells token and its index for further usage
+	TokenIndexes(context.Context, *QueryTokenIndexesRequest) (*QueryTokenIndexesResponse, error)
+	// StakerList shows all stakers related to the specified asset under native-restaking context
 	StakerList(context.Context, *QueryStakerListRequest) (*QueryStakerListResponse, error)
 	// StakerInfos shows the details for all stakers of the specified assets under native-restaking context
 	StakerInfos(context.Context, *QueryStakerInfosRequest) (*QueryStakerInfosResponse, error)
@@ -1775,6 +2341,8 @@ type QueryServer interface {
 	Prices(context.Context, *QueryGetPricesRequest) (*QueryGetPricesResponse, error)
 	// Queries the latest price of a specific token
 	LatestPrice(context.Context, *QueryGetLatestPriceRequest) (*QueryGetLatestPriceResponse, error)
+	// Queries the time-weighted average price of a specific token over the latest rounds or blocks
+	TWAPPrice(context.Context, *QueryTWAPPriceRequest) (*QueryTWAPPriceResponse, error)
 	// Queries a ValidatorUpdateBlock by index.
 	ValidatorUpdateBlock(context.Context, *QueryGetValidatorUpdateBlockRequest) (*QueryGetValidatorUpdateBlockResponse, error)
 	// Queries a IndexRecentParams by index.
@@ -1789,6 +2357,14 @@ type QueryServer interface {
 	RecentParams(context.Context, *QueryGetRecentParamsRequest) (*QueryGetRecentParamsResponse, error)
 	// RecentParamsAll query all RecentParams.
 	RecentParamsAll(context.Context, *QueryAllRecentParamsRequest) (*QueryAllRecentParamsResponse, error)
+	// ValidatorReportInfo queries the liveness of a validator as the price feeder of a feeder.
+	ValidatorReportInfo(context.Context, *QueryValidatorReportInfoRequest) (*QueryValidatorReportInfoResponse, error)
+	// ValidatorReportInfos queries the liveness of the validators as price feeders, optionally
+	// filtered by the validator.
+	ValidatorReportInfos(context.Context, *QueryValidatorReportInfosRequest) (*QueryValidatorReportInfosResponse, error)
+	// ValidatorDeviationInfo queries the deviation of the prices reported by a validator from the
+	// final prices of a feeder.
+	ValidatorDeviationInfo(context.Context, *QueryValidatorDeviationInfoRequest) (*QueryValidatorDeviationInfoResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -1816,6 +2392,9 @@ func (*UnimplementedQueryServer) Prices(ctx context.Context, req *QueryGetPrices
 func (*UnimplementedQueryServer) LatestPrice(ctx context.Context, req *QueryGetLatestPriceRequest) (*QueryGetLatestPriceResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method LatestPrice not implemented")
 }
+func (*UnimplementedQueryServer) TWAPPrice(ctx context.Context, req *QueryTWAPPriceRequest) (*QueryTWAPPriceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TWAPPrice not implemented")
+}
 func (*UnimplementedQueryServer) ValidatorUpdateBlock(ctx context.Context, req *QueryGetValidatorUpdateBlockRequest) (*QueryGetValidatorUpdateBlockResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ValidatorUpdateBlock not implemented")
 }
@@ -1837,6 +2416,15 @@ func (*UnimplementedQueryServer) RecentParams(ctx context.Context, req *QueryGet
 func (*UnimplementedQueryServer) RecentParamsAll(ctx context.Context, req *QueryAllRecentParamsRequest) (*QueryAllRecentParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RecentParamsAll not implemented")
 }
+func (*UnimplementedQueryServer) ValidatorReportInfo(ctx context.Context, req *QueryValidatorReportInfoRequest) (*QueryValidatorReportInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidatorReportInfo not implemented")
+}
+func (*UnimplementedQueryServer) ValidatorReportInfos(ctx context.Context, req *QueryValidatorReportInfosRequest) (*QueryValidatorReportInfosResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidatorReportInfos not implemented")
+}
+func (*UnimplementedQueryServer) ValidatorDeviationInfo(ctx context.Context, req *QueryValidatorDeviationInfoRequest) (*QueryValidatorDeviationInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidatorDeviationInfo not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -1968,6 +2556,24 @@ func _Query_LatestPrice_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_TWAPPrice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTWAPPriceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TWAPPrice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/exocore.oracle.v1.Query/TWAPPrice",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TWAPPrice(ctx, req.(*QueryTWAPPriceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_ValidatorUpdateBlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryGetValidatorUpdateBlockRequest)
 	if err := dec(in); err != nil {
@@ -2094,6 +2700,60 @@ func _Query_RecentParamsAll_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_ValidatorReportInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryValidatorReportInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ValidatorReportInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/exocore.oracle.v1.Query/ValidatorReportInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ValidatorReportInfo(ctx, req.(*QueryValidatorReportInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ValidatorReportInfos_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryValidatorReportInfosRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ValidatorReportInfos(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/exocore.oracle.v1.Query/ValidatorReportInfos",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ValidatorReportInfos(ctx, req.(*QueryValidatorReportInfosRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ValidatorDeviationInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryValidatorDeviationInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ValidatorDeviationInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/exocore.oracle.v1.Query/ValidatorDeviationInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ValidatorDeviationInfo(ctx, req.(*QueryValidatorDeviationInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "exocore.oracle.v1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -2126,6 +2786,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "LatestPrice",
 			Handler:    _Query_LatestPrice_Handler,
 		},
+		{
+			MethodName: "TWAPPrice",
+			Handler:    _Query_TWAPPrice_Handler,
+		},
 		{
 			MethodName: "ValidatorUpdateBlock",
 			Handler:    _Query_ValidatorUpdateBlock_Handler,
@@ -2154,6 +2818,18 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "RecentParamsAll",
 			Handler:    _Query_RecentParamsAll_Handler,
 		},
+		{
+			MethodName: "ValidatorReportInfo",
+			Handler:    _Query_ValidatorReportInfo_Handler,
+		},
+		{
+			MethodName: "ValidatorReportInfos",
+			Handler:    _Query_ValidatorReportInfos_Handler,
+		},
+		{
+			MethodName: "ValidatorDeviationInfo",
+			Handler:    _Query_ValidatorDeviationInfo_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "exocore/oracle/v1/query.proto",
@@ -2411,6 +3087,18 @@ func (m *QueryStakerInfosRequest) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	_ = i
 	var l int
 	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
 	if len(m.AssetId) > 0 {
 		i -= len(m.AssetId)
 		copy(dAtA[i:], m.AssetId)
@@ -2441,6 +3129,18 @@ func (m *QueryStakerInfosResponse) MarshalToSizedBuffer(dAtA []byte) (int, error
 	_ = i
 	var l int
 	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
 	if len(m.StakerInfos) > 0 {
 		for iNdEx := len(m.StakerInfos) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -2534,6 +3234,18 @@ func (m *QueryGetPricesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
 	if m.TokenId != 0 {
 		i = encodeVarintQuery(dAtA, i, uint64(m.TokenId))
 		i--
@@ -2570,6 +3282,44 @@ func (m *QueryGetLatestPriceRequest) MarshalToSizedBuffer(dAtA []byte) (int, err
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryTWAPPriceRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTWAPPriceRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTWAPPriceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Blocks != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Blocks))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Rounds != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Rounds))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.TokenId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.TokenId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *QueryGetPricesResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -2590,6 +3340,18 @@ func (m *QueryGetPricesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	_ = i
 	var l int
 	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
 	{
 		size, err := m.Prices.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -2623,6 +3385,59 @@ func (m *QueryGetLatestPriceResponse) MarshalToSizedBuffer(dAtA []byte) (int, er
 	_ = i
 	var l int
 	_ = l
+	if m.Stale {
+		i--
+		if m.Stale {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.UpdatedHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.UpdatedHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.Price.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTWAPPriceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTWAPPriceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTWAPPriceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Rounds != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Rounds))
+		i--
+		dAtA[i] = 0x10
+	}
 	{
 		size, err := m.Price.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -3178,44 +3993,271 @@ func (m *QueryAllRecentParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, e
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QueryValidatorReportInfoRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *TokenIndex) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *QueryValidatorReportInfoRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValidatorReportInfoRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Token)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if m.FeederId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.FeederId))
+		i--
+		dAtA[i] = 0x10
 	}
-	if m.Index != 0 {
-		n += 1 + sovQuery(uint64(m.Index))
+	if len(m.Validator) > 0 {
+		i -= len(m.Validator)
+		copy(dAtA[i:], m.Validator)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Validator)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryTokenIndexesRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryValidatorReportInfoResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryTokenIndexesResponse) Size() (n int) {
-	if m == nil {
+func (m *QueryValidatorReportInfoResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValidatorReportInfoResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.ReportInfo.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryValidatorReportInfosRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryValidatorReportInfosRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValidatorReportInfosRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Validator) > 0 {
+		i -= len(m.Validator)
+		copy(dAtA[i:], m.Validator)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Validator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryValidatorReportInfosResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryValidatorReportInfosResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValidatorReportInfosResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ReportInfos) > 0 {
+		for iNdEx := len(m.ReportInfos) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ReportInfos[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryValidatorDeviationInfoRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryValidatorDeviationInfoRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValidatorDeviationInfoRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.FeederId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.FeederId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Validator) > 0 {
+		i -= len(m.Validator)
+		copy(dAtA[i:], m.Validator)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Validator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryValidatorDeviationInfoResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryValidatorDeviationInfoResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValidatorDeviationInfoResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.DeviationInfo.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *TokenIndex) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Token)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Index != 0 {
+		n += 1 + sovQuery(uint64(m.Index))
+	}
+	return n
+}
+
+func (m *QueryTokenIndexesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryTokenIndexesResponse) Size() (n int) {
+	if m == nil {
 		return 0
 	}
 	var l int
@@ -3295,6 +4337,10 @@ func (m *QueryStakerInfosRequest) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovQuery(uint64(l))
 	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
 	return n
 }
 
@@ -3310,6 +4356,10 @@ func (m *QueryStakerInfosResponse) Size() (n int) {
 			n += 1 + l + sovQuery(uint64(l))
 		}
 	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
 	return n
 }
 
@@ -3342,6 +4392,10 @@ func (m *QueryGetPricesRequest) Size() (n int) {
 	if m.TokenId != 0 {
 		n += 1 + sovQuery(uint64(m.TokenId))
 	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
 	return n
 }
 
@@ -3357,6 +4411,24 @@ func (m *QueryGetLatestPriceRequest) Size() (n int) {
 	return n
 }
 
+func (m *QueryTWAPPriceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TokenId != 0 {
+		n += 1 + sovQuery(uint64(m.TokenId))
+	}
+	if m.Rounds != 0 {
+		n += 1 + sovQuery(uint64(m.Rounds))
+	}
+	if m.Blocks != 0 {
+		n += 1 + sovQuery(uint64(m.Blocks))
+	}
+	return n
+}
+
 func (m *QueryGetPricesResponse) Size() (n int) {
 	if m == nil {
 		return 0
@@ -3365,6 +4437,10 @@ func (m *QueryGetPricesResponse) Size() (n int) {
 	_ = l
 	l = m.Prices.Size()
 	n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
 	return n
 }
 
@@ -3376,6 +4452,26 @@ func (m *QueryGetLatestPriceResponse) Size() (n int) {
 	_ = l
 	l = m.Price.Size()
 	n += 1 + l + sovQuery(uint64(l))
+	if m.UpdatedHeight != 0 {
+		n += 1 + sovQuery(uint64(m.UpdatedHeight))
+	}
+	if m.Stale {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryTWAPPriceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Price.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.Rounds != 0 {
+		n += 1 + sovQuery(uint64(m.Rounds))
+	}
 	return n
 }
 
@@ -3574,20 +4670,1189 @@ func (m *QueryAllRecentParamsResponse) Size() (n int) {
 			n += 1 + l + sovQuery(uint64(l))
 		}
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
-}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryValidatorReportInfoRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Validator)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.FeederId != 0 {
+		n += 1 + sovQuery(uint64(m.FeederId))
+	}
+	return n
+}
+
+func (m *QueryValidatorReportInfoResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ReportInfo.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryValidatorReportInfosRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Validator)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryValidatorReportInfosResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ReportInfos) > 0 {
+		for _, e := range m.ReportInfos {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryValidatorDeviationInfoRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Validator)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.FeederId != 0 {
+		n += 1 + sovQuery(uint64(m.FeederId))
+	}
+	return n
+}
+
+func (m *QueryValidatorDeviationInfoResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.DeviationInfo.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *TokenIndex) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TokenIndex: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TokenIndex: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Token = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			m.Index = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Index |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTokenIndexesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTokenIndexesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTokenIndexesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTokenIndexesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTokenIndexesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTokenIndexesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenIndexes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenIndexes = append(m.TokenIndexes, &TokenIndex{})
+			if err := m.TokenIndexes[len(m.TokenIndexes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryStakerListRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryStakerListRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryStakerListRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AssetId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AssetId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryStakerListResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryStakerListResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryStakerListResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakerList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.StakerList == nil {
+				m.StakerList = &StakerList{}
+			}
+			if err := m.StakerList.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryStakerInfoRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryStakerInfoRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryStakerInfoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AssetId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AssetId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakerAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StakerAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryStakerInfoResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryStakerInfoResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryStakerInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakerInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.StakerInfo == nil {
+				m.StakerInfo = &StakerInfo{}
+			}
+			if err := m.StakerInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryStakerInfosRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryStakerInfosRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryStakerInfosRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AssetId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AssetId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryStakerInfosResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryStakerInfosResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryStakerInfosResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakerInfos", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StakerInfos = append(m.StakerInfos, &StakerInfo{})
+			if err := m.StakerInfos[len(m.StakerInfos)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetPricesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetPricesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetPricesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenId", wireType)
+			}
+			m.TokenId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TokenId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *TokenIndex) Unmarshal(dAtA []byte) error {
+func (m *QueryGetLatestPriceRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3610,49 +5875,17 @@ func (m *TokenIndex) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TokenIndex: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetLatestPriceRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TokenIndex: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetLatestPriceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Token = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenId", wireType)
 			}
-			m.Index = 0
+			m.TokenId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3662,7 +5895,7 @@ func (m *TokenIndex) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Index |= uint64(b&0x7F) << shift
+				m.TokenId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -3688,7 +5921,7 @@ func (m *TokenIndex) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryTokenIndexesRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryTWAPPriceRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3711,12 +5944,69 @@ func (m *QueryTokenIndexesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTokenIndexesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTWAPPriceRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTokenIndexesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTWAPPriceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenId", wireType)
+			}
+			m.TokenId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TokenId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rounds", wireType)
+			}
+			m.Rounds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Rounds |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Blocks", wireType)
+			}
+			m.Blocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Blocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3738,7 +6028,7 @@ func (m *QueryTokenIndexesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryTokenIndexesResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGetPricesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3761,15 +6051,15 @@ func (m *QueryTokenIndexesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTokenIndexesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetPricesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTokenIndexesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetPricesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenIndexes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Prices", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3796,8 +6086,43 @@ func (m *QueryTokenIndexesResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TokenIndexes = append(m.TokenIndexes, &TokenIndex{})
-			if err := m.TokenIndexes[len(m.TokenIndexes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Prices.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3822,7 +6147,7 @@ func (m *QueryTokenIndexesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryStakerListRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGetLatestPriceResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3845,17 +6170,69 @@ func (m *QueryStakerListRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryStakerListRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetLatestPriceResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryStakerListRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetLatestPriceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AssetId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
 			}
-			var stringLen uint64
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedHeight", wireType)
+			}
+			m.UpdatedHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UpdatedHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stale", wireType)
+			}
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3865,24 +6242,12 @@ func (m *QueryStakerListRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.AssetId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			m.Stale = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3904,7 +6269,7 @@ func (m *QueryStakerListRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryStakerListResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryTWAPPriceResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3927,15 +6292,15 @@ func (m *QueryStakerListResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryStakerListResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTWAPPriceResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryStakerListResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTWAPPriceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StakerList", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3962,13 +6327,29 @@ func (m *QueryStakerListResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.StakerList == nil {
-				m.StakerList = &StakerList{}
-			}
-			if err := m.StakerList.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rounds", wireType)
+			}
+			m.Rounds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Rounds |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3990,7 +6371,7 @@ func (m *QueryStakerListResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryStakerInfoRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAllPricesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4013,17 +6394,17 @@ func (m *QueryStakerInfoRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryStakerInfoRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllPricesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryStakerInfoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllPricesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AssetId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4033,55 +6414,27 @@ func (m *QueryStakerInfoRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AssetId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StakerAddr", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.StakerAddr = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4104,7 +6457,7 @@ func (m *QueryStakerInfoRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryStakerInfoResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAllPricesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4127,15 +6480,15 @@ func (m *QueryStakerInfoResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryStakerInfoResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllPricesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryStakerInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllPricesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StakerInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Prices", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4162,10 +6515,44 @@ func (m *QueryStakerInfoResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.StakerInfo == nil {
-				m.StakerInfo = &StakerInfo{}
+			m.Prices = append(m.Prices, Prices{})
+			if err := m.Prices[len(m.Prices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if err := m.StakerInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4190,7 +6577,7 @@ func (m *QueryStakerInfoResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryStakerInfosRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGetValidatorUpdateBlockRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4213,44 +6600,12 @@ func (m *QueryStakerInfosRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryStakerInfosRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetValidatorUpdateBlockRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryStakerInfosRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetValidatorUpdateBlockRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AssetId", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.AssetId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -4272,7 +6627,7 @@ func (m *QueryStakerInfosRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryStakerInfosResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGetValidatorUpdateBlockResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4295,15 +6650,15 @@ func (m *QueryStakerInfosResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryStakerInfosResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetValidatorUpdateBlockResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryStakerInfosResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetValidatorUpdateBlockResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StakerInfos", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorUpdateBlock", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4330,8 +6685,7 @@ func (m *QueryStakerInfosResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.StakerInfos = append(m.StakerInfos, &StakerInfo{})
-			if err := m.StakerInfos[len(m.StakerInfos)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ValidatorUpdateBlock.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4356,7 +6710,7 @@ func (m *QueryStakerInfosResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGetIndexRecentParamsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4379,10 +6733,10 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetIndexRecentParamsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetIndexRecentParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -4406,7 +6760,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGetIndexRecentParamsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4429,15 +6783,15 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetIndexRecentParamsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetIndexRecentParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IndexRecentParams", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4464,7 +6818,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.IndexRecentParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4489,7 +6843,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetPricesRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGetIndexRecentMsgRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4512,31 +6866,12 @@ func (m *QueryGetPricesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetPricesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetIndexRecentMsgRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetPricesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetIndexRecentMsgRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenId", wireType)
-			}
-			m.TokenId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.TokenId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -4558,7 +6893,7 @@ func (m *QueryGetPricesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetLatestPriceRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGetIndexRecentMsgResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4581,17 +6916,17 @@ func (m *QueryGetLatestPriceRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetLatestPriceRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetIndexRecentMsgResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetLatestPriceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetIndexRecentMsgResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IndexRecentMsg", wireType)
 			}
-			m.TokenId = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4601,11 +6936,25 @@ func (m *QueryGetLatestPriceRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TokenId |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.IndexRecentMsg.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -4627,7 +6976,7 @@ func (m *QueryGetLatestPriceRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetPricesResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGetRecentMsgRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4650,17 +6999,17 @@ func (m *QueryGetPricesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetPricesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetRecentMsgRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetPricesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetRecentMsgRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Prices", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Block", wireType)
 			}
-			var msglen int
+			m.Block = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4670,25 +7019,11 @@ func (m *QueryGetPricesResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Block |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Prices.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -4710,7 +7045,7 @@ func (m *QueryGetPricesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetLatestPriceResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGetRecentMsgResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4733,15 +7068,15 @@ func (m *QueryGetLatestPriceResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetLatestPriceResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetRecentMsgResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetLatestPriceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetRecentMsgResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RecentMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4768,7 +7103,7 @@ func (m *QueryGetLatestPriceResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.RecentMsg.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4793,7 +7128,7 @@ func (m *QueryGetLatestPriceResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllPricesRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAllRecentMsgRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4816,10 +7151,10 @@ func (m *QueryAllPricesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllPricesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllRecentMsgRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllPricesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllRecentMsgRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4879,7 +7214,7 @@ func (m *QueryAllPricesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllPricesResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAllRecentMsgResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4902,15 +7237,15 @@ func (m *QueryAllPricesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllPricesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllRecentMsgResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllPricesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllRecentMsgResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Prices", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RecentMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4937,8 +7272,8 @@ func (m *QueryAllPricesResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Prices = append(m.Prices, Prices{})
-			if err := m.Prices[len(m.Prices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.RecentMsg = append(m.RecentMsg, RecentMsg{})
+			if err := m.RecentMsg[len(m.RecentMsg)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4999,7 +7334,7 @@ func (m *QueryAllPricesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetValidatorUpdateBlockRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGetRecentParamsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5022,12 +7357,31 @@ func (m *QueryGetValidatorUpdateBlockRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetValidatorUpdateBlockRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetRecentParamsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetValidatorUpdateBlockRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetRecentParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Block", wireType)
+			}
+			m.Block = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Block |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -5049,7 +7403,7 @@ func (m *QueryGetValidatorUpdateBlockRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetValidatorUpdateBlockResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGetRecentParamsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5072,15 +7426,15 @@ func (m *QueryGetValidatorUpdateBlockResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetValidatorUpdateBlockResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetRecentParamsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetValidatorUpdateBlockResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetRecentParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorUpdateBlock", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RecentParams", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5107,7 +7461,7 @@ func (m *QueryGetValidatorUpdateBlockResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ValidatorUpdateBlock.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.RecentParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5132,57 +7486,7 @@ func (m *QueryGetValidatorUpdateBlockResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetIndexRecentParamsRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetIndexRecentParamsRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetIndexRecentParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryGetIndexRecentParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAllRecentParamsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5205,15 +7509,15 @@ func (m *QueryGetIndexRecentParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetIndexRecentParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllRecentParamsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetIndexRecentParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllRecentParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IndexRecentParams", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5240,60 +7544,13 @@ func (m *QueryGetIndexRecentParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.IndexRecentParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
 			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryGetIndexRecentMsgRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetIndexRecentMsgRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetIndexRecentMsgRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -5315,7 +7572,7 @@ func (m *QueryGetIndexRecentMsgRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetIndexRecentMsgResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAllRecentParamsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5338,15 +7595,15 @@ func (m *QueryGetIndexRecentMsgResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetIndexRecentMsgResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllRecentParamsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetIndexRecentMsgResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllRecentParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IndexRecentMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RecentParams", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5366,72 +7623,23 @@ func (m *QueryGetIndexRecentMsgResponse) Unmarshal(dAtA []byte) error {
 			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.IndexRecentMsg.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryGetRecentMsgRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.RecentParams = append(m.RecentParams, RecentParams{})
+			if err := m.RecentParams[len(m.RecentParams)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetRecentMsgRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetRecentMsgRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Block", wireType)
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			m.Block = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5441,11 +7649,28 @@ func (m *QueryGetRecentMsgRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Block |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -5467,7 +7692,7 @@ func (m *QueryGetRecentMsgRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetRecentMsgResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryValidatorReportInfoRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5490,17 +7715,17 @@ func (m *QueryGetRecentMsgResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetRecentMsgResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryValidatorReportInfoRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetRecentMsgResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryValidatorReportInfoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RecentMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5510,25 +7735,43 @@ func (m *QueryGetRecentMsgResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.RecentMsg.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Validator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeederId", wireType)
+			}
+			m.FeederId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FeederId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -5550,7 +7793,7 @@ func (m *QueryGetRecentMsgResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllRecentMsgRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryValidatorReportInfoResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5573,15 +7816,15 @@ func (m *QueryAllRecentMsgRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllRecentMsgRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryValidatorReportInfoResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllRecentMsgRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryValidatorReportInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ReportInfo", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5608,10 +7851,7 @@ func (m *QueryAllRecentMsgRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ReportInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5636,7 +7876,7 @@ func (m *QueryAllRecentMsgRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllRecentMsgResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryValidatorReportInfosRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5659,17 +7899,17 @@ func (m *QueryAllRecentMsgResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllRecentMsgResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryValidatorReportInfosRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllRecentMsgResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryValidatorReportInfosRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RecentMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5679,25 +7919,23 @@ func (m *QueryAllRecentMsgResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RecentMsg = append(m.RecentMsg, RecentMsg{})
-			if err := m.RecentMsg[len(m.RecentMsg)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Validator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
@@ -5729,7 +7967,7 @@ func (m *QueryAllRecentMsgResponse) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
+				m.Pagination = &query.PageRequest{}
 			}
 			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -5756,7 +7994,7 @@ func (m *QueryAllRecentMsgResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetRecentParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryValidatorReportInfosResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5779,17 +8017,17 @@ func (m *QueryGetRecentParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetRecentParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryValidatorReportInfosResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetRecentParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryValidatorReportInfosResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Block", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReportInfos", wireType)
 			}
-			m.Block = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5799,64 +8037,29 @@ func (m *QueryGetRecentParamsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Block |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryGetRecentParamsResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.ReportInfos = append(m.ReportInfos, ValidatorReportInfo{})
+			if err := m.ReportInfos[len(m.ReportInfos)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetRecentParamsResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetRecentParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RecentParams", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5883,7 +8086,10 @@ func (m *QueryGetRecentParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.RecentParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5908,7 +8114,7 @@ func (m *QueryGetRecentParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllRecentParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryValidatorDeviationInfoRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5931,17 +8137,17 @@ func (m *QueryAllRecentParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllRecentParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryValidatorDeviationInfoRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllRecentParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryValidatorDeviationInfoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5951,28 +8157,43 @@ func (m *QueryAllRecentParamsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
+			m.Validator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeederId", wireType)
 			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.FeederId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FeederId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -5994,7 +8215,7 @@ func (m *QueryAllRecentParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllRecentParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryValidatorDeviationInfoResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6017,49 +8238,15 @@ func (m *QueryAllRecentParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllRecentParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryValidatorDeviationInfoResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllRecentParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryValidatorDeviationInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RecentParams", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.RecentParams = append(m.RecentParams, RecentParams{})
-			if err := m.RecentParams[len(m.RecentParams)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DeviationInfo", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6086,10 +8273,7 @@ func (m *QueryAllRecentParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.DeviationInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -0,0 +1,1240 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: exocore/oracle/v1/validator_report_info.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// ValidatorReportInfo tracks the liveness of a validator as the price feeder of a token
+// feeder over a sliding window of rounds.
+type ValidatorReportInfo struct {
+	// validator is the consensus address of the validator
+	Validator string `protobuf:"bytes,1,opt,name=validator,proto3" json:"validator,omitempty"`
+	// feeder_id is the ID of the token feeder
+	FeederID uint64 `protobuf:"varint,2,opt,name=feeder_id,json=feederId,proto3" json:"feeder_id,omitempty"`
+	// start_height is the height at which the tracking of the validator started
+	StartHeight int64 `protobuf:"varint,3,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty"`
+	// index_offset is the number of rounds tracked since the tracking started, and its
+	// remainder by the window size is the index of the next round in the missed rounds bitmap
+	IndexOffset int64 `protobuf:"varint,4,opt,name=index_offset,json=indexOffset,proto3" json:"index_offset,omitempty"`
+	// missed_rounds_counter is the number of rounds missed within the window
+	MissedRoundsCounter int64 `protobuf:"varint,5,opt,name=missed_rounds_counter,json=missedRoundsCounter,proto3" json:"missed_rounds_counter,omitempty"`
+}
+
+func (m *ValidatorReportInfo) Reset()         { *m = ValidatorReportInfo{} }
+func (m *ValidatorReportInfo) String() string { return proto.CompactTextString(m) }
+func (*ValidatorReportInfo) ProtoMessage()    {}
+func (*ValidatorReportInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5b1b51c67c9b0312, []int{0}
+}
+func (m *ValidatorReportInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorReportInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorReportInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ValidatorReportInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorReportInfo.Merge(m, src)
+}
+func (m *ValidatorReportInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorReportInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorReportInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorReportInfo proto.InternalMessageInfo
+
+func (m *ValidatorReportInfo) GetValidator() string {
+	if m != nil {
+		return m.Validator
+	}
+	return ""
+}
+
+func (m *ValidatorReportInfo) GetFeederID() uint64 {
+	if m != nil {
+		return m.FeederID
+	}
+	return 0
+}
+
+func (m *ValidatorReportInfo) GetStartHeight() int64 {
+	if m != nil {
+		return m.StartHeight
+	}
+	return 0
+}
+
+func (m *ValidatorReportInfo) GetIndexOffset() int64 {
+	if m != nil {
+		return m.IndexOffset
+	}
+	return 0
+}
+
+func (m *ValidatorReportInfo) GetMissedRoundsCounter() int64 {
+	if m != nil {
+		return m.MissedRoundsCounter
+	}
+	return 0
+}
+
+// ValidatorMissedRounds is the missed rounds bitmap of a validator as the price feeder of a
+// token feeder, used to export and import the liveness of the validators.
+type ValidatorMissedRounds struct {
+	// validator is the consensus address of the validator
+	Validator string `protobuf:"bytes,1,opt,name=validator,proto3" json:"validator,omitempty"`
+	// feeder_id is the ID of the token feeder
+	FeederID uint64 `protobuf:"varint,2,opt,name=feeder_id,json=feederId,proto3" json:"feeder_id,omitempty"`
+	// missed_rounds are the indexes in the window of the rounds missed by the validator
+	MissedRounds []uint64 `protobuf:"varint,3,rep,packed,name=missed_rounds,json=missedRounds,proto3" json:"missed_rounds,omitempty"`
+}
+
+func (m *ValidatorMissedRounds) Reset()         { *m = ValidatorMissedRounds{} }
+func (m *ValidatorMissedRounds) String() string { return proto.CompactTextString(m) }
+func (*ValidatorMissedRounds) ProtoMessage()    {}
+func (*ValidatorMissedRounds) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5b1b51c67c9b0312, []int{1}
+}
+func (m *ValidatorMissedRounds) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorMissedRounds) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorMissedRounds.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ValidatorMissedRounds) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorMissedRounds.Merge(m, src)
+}
+func (m *ValidatorMissedRounds) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorMissedRounds) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorMissedRounds.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorMissedRounds proto.InternalMessageInfo
+
+func (m *ValidatorMissedRounds) GetValidator() string {
+	if m != nil {
+		return m.Validator
+	}
+	return ""
+}
+
+func (m *ValidatorMissedRounds) GetFeederID() uint64 {
+	if m != nil {
+		return m.FeederID
+	}
+	return 0
+}
+
+func (m *ValidatorMissedRounds) GetMissedRounds() []uint64 {
+	if m != nil {
+		return m.MissedRounds
+	}
+	return nil
+}
+
+// ValidatorDeviationInfo tracks the deviation of the prices reported by a validator from the
+// final prices of a token feeder.
+type ValidatorDeviationInfo struct {
+	// validator is the consensus address of the validator
+	Validator string `protobuf:"bytes,1,opt,name=validator,proto3" json:"validator,omitempty"`
+	// feeder_id is the ID of the token feeder
+	FeederID uint64 `protobuf:"varint,2,opt,name=feeder_id,json=feederId,proto3" json:"feeder_id,omitempty"`
+	// round_id is the latest round for which the validator reported a price
+	RoundID uint64 `protobuf:"varint,3,opt,name=round_id,json=roundId,proto3" json:"round_id,omitempty"`
+	// price is the price reported by the validator in the round
+	Price string `protobuf:"bytes,4,opt,name=price,proto3" json:"price,omitempty"`
+	// final_price is the final price of the round
+	FinalPrice string `protobuf:"bytes,5,opt,name=final_price,json=finalPrice,proto3" json:"final_price,omitempty"`
+	// deviation is the relative deviation of the price from the final price
+	Deviation github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=deviation,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"deviation"`
+	// consecutive_deviations is the number of consecutive reports out of the deviation band
+	ConsecutiveDeviations int64 `protobuf:"varint,7,opt,name=consecutive_deviations,json=consecutiveDeviations,proto3" json:"consecutive_deviations,omitempty"`
+}
+
+func (m *ValidatorDeviationInfo) Reset()         { *m = ValidatorDeviationInfo{} }
+func (m *ValidatorDeviationInfo) String() string { return proto.CompactTextString(m) }
+func (*ValidatorDeviationInfo) ProtoMessage()    {}
+func (*ValidatorDeviationInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5b1b51c67c9b0312, []int{2}
+}
+func (m *ValidatorDeviationInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorDeviationInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorDeviationInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ValidatorDeviationInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorDeviationInfo.Merge(m, src)
+}
+func (m *ValidatorDeviationInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorDeviationInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorDeviationInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorDeviationInfo proto.InternalMessageInfo
+
+func (m *ValidatorDeviationInfo) GetValidator() string {
+	if m != nil {
+		return m.Validator
+	}
+	return ""
+}
+
+func (m *ValidatorDeviationInfo) GetFeederID() uint64 {
+	if m != nil {
+		return m.FeederID
+	}
+	return 0
+}
+
+func (m *ValidatorDeviationInfo) GetRoundID() uint64 {
+	if m != nil {
+		return m.RoundID
+	}
+	return 0
+}
+
+func (m *ValidatorDeviationInfo) GetPrice() string {
+	if m != nil {
+		return m.Price
+	}
+	return ""
+}
+
+func (m *ValidatorDeviationInfo) GetFinalPrice() string {
+	if m != nil {
+		return m.FinalPrice
+	}
+	return ""
+}
+
+func (m *ValidatorDeviationInfo) GetConsecutiveDeviations() int64 {
+	if m != nil {
+		return m.ConsecutiveDeviations
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*ValidatorReportInfo)(nil), "exocore.oracle.v1.ValidatorReportInfo")
+	proto.RegisterType((*ValidatorMissedRounds)(nil), "exocore.oracle.v1.ValidatorMissedRounds")
+	proto.RegisterType((*ValidatorDeviationInfo)(nil), "exocore.oracle.v1.ValidatorDeviationInfo")
+}
+
+func init() {
+	proto.RegisterFile("exocore/oracle/v1/validator_report_info.proto", fileDescriptor_5b1b51c67c9b0312)
+}
+
+var fileDescriptor_5b1b51c67c9b0312 = []byte{
+	// 494 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x93, 0x4f, 0x6f, 0xda, 0x4c,
+	0x10, 0xc6, 0x71, 0x80, 0x00, 0x0b, 0xef, 0xe1, 0x75, 0x42, 0xe4, 0x46, 0x95, 0xa1, 0x54, 0x8a,
+	0xe8, 0x01, 0xdc, 0xb4, 0xea, 0xad, 0x27, 0x4a, 0xab, 0x52, 0xa9, 0x7f, 0xe4, 0x43, 0x0f, 0xb9,
+	0x58, 0xce, 0xee, 0x18, 0x56, 0x01, 0x0f, 0xda, 0x5d, 0x28, 0xfd, 0x00, 0xbd, 0xf7, 0xc3, 0xf4,
+	0x43, 0xe4, 0x18, 0xb5, 0x97, 0xa8, 0x07, 0x54, 0xc1, 0x17, 0xa9, 0x98, 0x05, 0x87, 0xde, 0x73,
+	0xb2, 0xf7, 0x79, 0x7e, 0xf2, 0x3c, 0x3b, 0x9e, 0x61, 0x1d, 0x58, 0x20, 0x47, 0x05, 0x01, 0xaa,
+	0x98, 0x8f, 0x21, 0x98, 0x9f, 0x07, 0xf3, 0x78, 0x2c, 0x45, 0x6c, 0x50, 0x45, 0x0a, 0xa6, 0xa8,
+	0x4c, 0x24, 0xd3, 0x04, 0xbb, 0x53, 0x85, 0x06, 0xdd, 0xff, 0xb7, 0x78, 0xd7, 0xe2, 0xdd, 0xf9,
+	0xf9, 0xe9, 0x03, 0x8e, 0x7a, 0x82, 0x3a, 0x22, 0x20, 0xb0, 0x07, 0x4b, 0x9f, 0x1e, 0x0f, 0x71,
+	0x88, 0x56, 0xdf, 0xbc, 0x59, 0xb5, 0x75, 0xeb, 0xb0, 0xa3, 0xcf, 0xbb, 0x1a, 0x21, 0x95, 0x18,
+	0xa4, 0x09, 0xba, 0x0f, 0x59, 0x25, 0x2b, 0xed, 0x39, 0x4d, 0xa7, 0x5d, 0x09, 0xef, 0x04, 0xf7,
+	0x09, 0xab, 0x24, 0x00, 0x02, 0x54, 0x24, 0x85, 0x77, 0xd0, 0x74, 0xda, 0x85, 0x5e, 0x6d, 0xb5,
+	0x6c, 0x94, 0xdf, 0x90, 0x38, 0xe8, 0x87, 0x65, 0x6b, 0x0f, 0x84, 0xfb, 0x88, 0xd5, 0xb4, 0x89,
+	0x95, 0x89, 0x46, 0x20, 0x87, 0x23, 0xe3, 0xe5, 0x9b, 0x4e, 0x3b, 0x1f, 0x56, 0x49, 0x7b, 0x4b,
+	0xd2, 0x06, 0x91, 0xa9, 0x80, 0x45, 0x84, 0x49, 0xa2, 0xc1, 0x78, 0x05, 0x8b, 0x90, 0xf6, 0x91,
+	0x24, 0xf7, 0x19, 0xab, 0x4f, 0xa4, 0xd6, 0x20, 0x22, 0x85, 0xb3, 0x54, 0xe8, 0x88, 0xe3, 0x2c,
+	0x35, 0xa0, 0xbc, 0x22, 0xb1, 0x47, 0xd6, 0x0c, 0xc9, 0x7b, 0x65, 0xad, 0xd6, 0x37, 0x87, 0xd5,
+	0xb3, 0xab, 0xbd, 0xdf, 0x03, 0xee, 0xef, 0x72, 0x8f, 0xd9, 0x7f, 0xff, 0xc4, 0xf2, 0xf2, 0xcd,
+	0x7c, 0xbb, 0x10, 0xd6, 0xf6, 0xe3, 0xb4, 0x7e, 0x1d, 0xb0, 0x93, 0x2c, 0x47, 0x1f, 0xe6, 0x32,
+	0x36, 0x12, 0xd3, 0xfb, 0xed, 0xf2, 0x19, 0x2b, 0x53, 0x82, 0x0d, 0x99, 0x27, 0xb2, 0xba, 0x5a,
+	0x36, 0x4a, 0x94, 0x60, 0xd0, 0x0f, 0x4b, 0x64, 0x0e, 0x84, 0x7b, 0xcc, 0x8a, 0x53, 0x25, 0x39,
+	0x50, 0x8f, 0x2b, 0xa1, 0x3d, 0xb8, 0x0d, 0x56, 0x4d, 0x64, 0x1a, 0x8f, 0x23, 0xeb, 0x15, 0xc9,
+	0x63, 0x24, 0x7d, 0x22, 0xe0, 0x82, 0x55, 0xc4, 0x2e, 0xb8, 0x77, 0xb8, 0xb1, 0x7b, 0x2f, 0xaf,
+	0x97, 0x8d, 0xdc, 0xef, 0x65, 0xe3, 0x6c, 0x28, 0xcd, 0x68, 0x76, 0xd9, 0xe5, 0x38, 0xd9, 0xce,
+	0xdb, 0xf6, 0xd1, 0xd1, 0xe2, 0x2a, 0x30, 0x5f, 0xa7, 0xa0, 0xbb, 0x7d, 0xe0, 0x3f, 0x7f, 0x74,
+	0xd8, 0x76, 0x1c, 0xfb, 0xc0, 0xc3, 0xbb, 0xcf, 0xb9, 0x2f, 0xd8, 0x09, 0xc7, 0x54, 0x03, 0x9f,
+	0x19, 0x39, 0x87, 0x28, 0x33, 0xb4, 0x57, 0xa2, 0x7f, 0x5b, 0xdf, 0x73, 0xb3, 0xee, 0xe9, 0xde,
+	0xbb, 0xeb, 0x95, 0xef, 0xdc, 0xac, 0x7c, 0xe7, 0xcf, 0xca, 0x77, 0xbe, 0xaf, 0xfd, 0xdc, 0xcd,
+	0xda, 0xcf, 0xdd, 0xae, 0xfd, 0xdc, 0xc5, 0xd3, 0xbd, 0x44, 0xaf, 0xed, 0x86, 0x7c, 0x00, 0xf3,
+	0x05, 0xd5, 0x55, 0xb0, 0xdb, 0xaf, 0xc5, 0x6e, 0xc3, 0x28, 0xdf, 0xe5, 0x21, 0xed, 0xc2, 0xf3,
+	0xbf, 0x01, 0x00, 0x00, 0xff, 0xff, 0x00, 0xd4, 0xfd, 0x94, 0x80, 0x03, 0x00, 0x00,
+}
+
+func (m *ValidatorReportInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorReportInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorReportInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MissedRoundsCounter != 0 {
+		i = encodeVarintValidatorReportInfo(dAtA, i, uint64(m.MissedRoundsCounter))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.IndexOffset != 0 {
+		i = encodeVarintValidatorReportInfo(dAtA, i, uint64(m.IndexOffset))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.StartHeight != 0 {
+		i = encodeVarintValidatorReportInfo(dAtA, i, uint64(m.StartHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.FeederID != 0 {
+		i = encodeVarintValidatorReportInfo(dAtA, i, uint64(m.FeederID))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Validator) > 0 {
+		i -= len(m.Validator)
+		copy(dAtA[i:], m.Validator)
+		i = encodeVarintValidatorReportInfo(dAtA, i, uint64(len(m.Validator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorMissedRounds) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorMissedRounds) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorMissedRounds) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MissedRounds) > 0 {
+		dAtA2 := make([]byte, len(m.MissedRounds)*10)
+		var j1 int
+		for _, num := range m.MissedRounds {
+			for num >= 1<<7 {
+				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j1++
+			}
+			dAtA2[j1] = uint8(num)
+			j1++
+		}
+		i -= j1
+		copy(dAtA[i:], dAtA2[:j1])
+		i = encodeVarintValidatorReportInfo(dAtA, i, uint64(j1))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.FeederID != 0 {
+		i = encodeVarintValidatorReportInfo(dAtA, i, uint64(m.FeederID))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Validator) > 0 {
+		i -= len(m.Validator)
+		copy(dAtA[i:], m.Validator)
+		i = encodeVarintValidatorReportInfo(dAtA, i, uint64(len(m.Validator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorDeviationInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorDeviationInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorDeviationInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ConsecutiveDeviations != 0 {
+		i = encodeVarintValidatorReportInfo(dAtA, i, uint64(m.ConsecutiveDeviations))
+		i--
+		dAtA[i] = 0x38
+	}
+	{
+		size := m.Deviation.Size()
+		i -= size
+		if _, err := m.Deviation.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintValidatorReportInfo(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if len(m.FinalPrice) > 0 {
+		i -= len(m.FinalPrice)
+		copy(dAtA[i:], m.FinalPrice)
+		i = encodeVarintValidatorReportInfo(dAtA, i, uint64(len(m.FinalPrice)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Price) > 0 {
+		i -= len(m.Price)
+		copy(dAtA[i:], m.Price)
+		i = encodeVarintValidatorReportInfo(dAtA, i, uint64(len(m.Price)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.RoundID != 0 {
+		i = encodeVarintValidatorReportInfo(dAtA, i, uint64(m.RoundID))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.FeederID != 0 {
+		i = encodeVarintValidatorReportInfo(dAtA, i, uint64(m.FeederID))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Validator) > 0 {
+		i -= len(m.Validator)
+		copy(dAtA[i:], m.Validator)
+		i = encodeVarintValidatorReportInfo(dAtA, i, uint64(len(m.Validator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintValidatorReportInfo(dAtA []byte, offset int, v uint64) int {
+	offset -= sovValidatorReportInfo(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ValidatorReportInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Validator)
+	if l > 0 {
+		n += 1 + l + sovValidatorReportInfo(uint64(l))
+	}
+	if m.FeederID != 0 {
+		n += 1 + sovValidatorReportInfo(uint64(m.FeederID))
+	}
+	if m.StartHeight != 0 {
+		n += 1 + sovValidatorReportInfo(uint64(m.StartHeight))
+	}
+	if m.IndexOffset != 0 {
+		n += 1 + sovValidatorReportInfo(uint64(m.IndexOffset))
+	}
+	if m.MissedRoundsCounter != 0 {
+		n += 1 + sovValidatorReportInfo(uint64(m.MissedRoundsCounter))
+	}
+	return n
+}
+
+func (m *ValidatorMissedRounds) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Validator)
+	if l > 0 {
+		n += 1 + l + sovValidatorReportInfo(uint64(l))
+	}
+	if m.FeederID != 0 {
+		n += 1 + sovValidatorReportInfo(uint64(m.FeederID))
+	}
+	if len(m.MissedRounds) > 0 {
+		l = 0
+		for _, e := range m.MissedRounds {
+			l += sovValidatorReportInfo(uint64(e))
+		}
+		n += 1 + sovValidatorReportInfo(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *ValidatorDeviationInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Validator)
+	if l > 0 {
+		n += 1 + l + sovValidatorReportInfo(uint64(l))
+	}
+	if m.FeederID != 0 {
+		n += 1 + sovValidatorReportInfo(uint64(m.FeederID))
+	}
+	if m.RoundID != 0 {
+		n += 1 + sovValidatorReportInfo(uint64(m.RoundID))
+	}
+	l = len(m.Price)
+	if l > 0 {
+		n += 1 + l + sovValidatorReportInfo(uint64(l))
+	}
+	l = len(m.FinalPrice)
+	if l > 0 {
+		n += 1 + l + sovValidatorReportInfo(uint64(l))
+	}
+	l = m.Deviation.Size()
+	n += 1 + l + sovValidatorReportInfo(uint64(l))
+	if m.ConsecutiveDeviations != 0 {
+		n += 1 + sovValidatorReportInfo(uint64(m.ConsecutiveDeviations))
+	}
+	return n
+}
+
+func sovValidatorReportInfo(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozValidatorReportInfo(x uint64) (n int) {
+	return sovValidatorReportInfo(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ValidatorReportInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowValidatorReportInfo
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorReportInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorReportInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValidatorReportInfo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthValidatorReportInfo
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthValidatorReportInfo
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeederID", wireType)
+			}
+			m.FeederID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValidatorReportInfo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FeederID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
+			}
+			m.StartHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValidatorReportInfo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IndexOffset", wireType)
+			}
+			m.IndexOffset = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValidatorReportInfo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.IndexOffset |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MissedRoundsCounter", wireType)
+			}
+			m.MissedRoundsCounter = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValidatorReportInfo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MissedRoundsCounter |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipValidatorReportInfo(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthValidatorReportInfo
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorMissedRounds) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowValidatorReportInfo
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorMissedRounds: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorMissedRounds: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValidatorReportInfo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthValidatorReportInfo
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthValidatorReportInfo
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeederID", wireType)
+			}
+			m.FeederID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValidatorReportInfo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FeederID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowValidatorReportInfo
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.MissedRounds = append(m.MissedRounds, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowValidatorReportInfo
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthValidatorReportInfo
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthValidatorReportInfo
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.MissedRounds) == 0 {
+					m.MissedRounds = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowValidatorReportInfo
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.MissedRounds = append(m.MissedRounds, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field MissedRounds", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipValidatorReportInfo(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthValidatorReportInfo
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorDeviationInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowValidatorReportInfo
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorDeviationInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorDeviationInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValidatorReportInfo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthValidatorReportInfo
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthValidatorReportInfo
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeederID", wireType)
+			}
+			m.FeederID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValidatorReportInfo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FeederID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RoundID", wireType)
+			}
+			m.RoundID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValidatorReportInfo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RoundID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValidatorReportInfo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthValidatorReportInfo
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthValidatorReportInfo
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Price = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FinalPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValidatorReportInfo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthValidatorReportInfo
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthValidatorReportInfo
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FinalPrice = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deviation", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValidatorReportInfo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthValidatorReportInfo
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthValidatorReportInfo
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Deviation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsecutiveDeviations", wireType)
+			}
+			m.ConsecutiveDeviations = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValidatorReportInfo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ConsecutiveDeviations |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipValidatorReportInfo(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthValidatorReportInfo
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipValidatorReportInfo(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowValidatorReportInfo
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowValidatorReportInfo
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowValidatorReportInfo
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthValidatorReportInfo
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupValidatorReportInfo
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthValidatorReportInfo
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthValidatorReportInfo        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowValidatorReportInfo          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupValidatorReportInfo = fmt.Errorf("proto: unexpected end of group")
+)
@@ -19,7 +19,11 @@ func GetQueryCmd(_ string) *cobra.Command {
 		RunE:                       client.ValidateCmd,
 	}
 
-	cmd.AddCommand(CmdQueryParams())
+	cmd.AddCommand(
+		CmdQueryParams(),
+		CmdQueryFrozenOperators(),
+		CmdQueryOperatorFrozenStatus(),
+	)
 	// this line is used by starport scaffolding # 1
 
 	return cmd
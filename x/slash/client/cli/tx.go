@@ -7,6 +7,13 @@ import (
 
 	"github.com/ExocoreNetwork/exocore/x/slash/types"
 	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+)
+
+const (
+	FlagAVSAddress = "avs-address"
+	FlagReason     = "reason"
 )
 
 // GetTxCmd returns the transaction commands for this module
@@ -19,7 +26,71 @@ func GetTxCmd() *cobra.Command {
 		RunE:                       client.ValidateCmd,
 	}
 
-	cmd.AddCommand()
+	cmd.AddCommand(
+		CmdFreezeOperator(),
+		CmdUnfreezeOperator(),
+	)
+
+	return cmd
+}
+
+// CmdFreezeOperator returns a CLI command handler for creating a MsgFreezeOperator transaction.
+func CmdFreezeOperator() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "freeze-operator <operator-address>",
+		Short: "freeze an operator, which must be sent by the governance or the slash contract of the AVS",
+		Example: "exocored tx exoslash freeze-operator exo1... " +
+			"--avs-address 0x598ACcB5e7F83cA6B19D70592Def9E5b25B978CA --reason \"malicious task result\"",
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			avsAddress, err := cmd.Flags().GetString(FlagAVSAddress)
+			if err != nil {
+				return err
+			}
+			reason, err := cmd.Flags().GetString(FlagReason)
+			if err != nil {
+				return err
+			}
+			msg := &types.MsgFreezeOperator{
+				FromAddress:     clientCtx.GetFromAddress().String(),
+				OperatorAddress: args[0],
+				AvsAddress:      avsAddress,
+				Reason:          reason,
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	cmd.Flags().String(
+		FlagAVSAddress, "", "The AVS on whose behalf the operator is frozen, "+
+			"required if the sender isn't the governance account",
+	)
+	cmd.Flags().String(FlagReason, "", "The reason for freezing the operator")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
 
+// CmdUnfreezeOperator returns a CLI command handler for creating a MsgUnfreezeOperator transaction.
+func CmdUnfreezeOperator() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "unfreeze-operator <operator-address>",
+		Short: "unfreeze a frozen operator, which must be sent by the governance or the slash contract of the AVS",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			msg := &types.MsgUnfreezeOperator{
+				FromAddress:     clientCtx.GetFromAddress().String(),
+				OperatorAddress: args[0],
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
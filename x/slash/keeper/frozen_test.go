@@ -0,0 +1,182 @@
+package keeper_test
+
+import (
+	sdkmath "cosmossdk.io/math"
+	avstypes "github.com/ExocoreNetwork/exocore/x/avs/types"
+	delegationtypes "github.com/ExocoreNetwork/exocore/x/delegation/types"
+	"github.com/ExocoreNetwork/exocore/x/slash/keeper"
+	"github.com/ExocoreNetwork/exocore/x/slash/types"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/ethereum/go-ethereum/common"
+	utiltx "github.com/evmos/evmos/v16/testutil/tx"
+)
+
+func (suite *SlashTestSuite) TestFreezeOperatorByGovernance() {
+	msgServer := keeper.NewMsgServerImpl(suite.App.ExoSlashKeeper)
+	operator := suite.Operators[0]
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	avsAddr := avstypes.GenerateAVSAddr(avstypes.ChainIDWithoutRevision(suite.Ctx.ChainID()))
+
+	// an arbitrary account can't freeze the operator
+	_, err := msgServer.FreezeOperator(suite.Ctx, &types.MsgFreezeOperator{
+		FromAddress:     suite.AccAddress.String(),
+		OperatorAddress: operator.String(),
+		AvsAddress:      avsAddr,
+	})
+	suite.ErrorIs(err, types.ErrUnauthorized)
+	suite.False(suite.App.ExoSlashKeeper.IsOperatorFrozen(suite.Ctx, operator))
+
+	_, err = msgServer.FreezeOperator(suite.Ctx, &types.MsgFreezeOperator{
+		FromAddress:     authority,
+		OperatorAddress: operator.String(),
+		Reason:          "test",
+	})
+	suite.NoError(err)
+	suite.True(suite.App.ExoSlashKeeper.IsOperatorFrozen(suite.Ctx, operator))
+	// the frozen operator can't opt out of the AVS
+	err = suite.App.OperatorKeeper.OptOut(suite.Ctx, operator, avsAddr)
+	suite.ErrorIs(err, delegationtypes.ErrOperatorIsFrozen)
+
+	res, err := suite.App.ExoSlashKeeper.OperatorFrozenStatus(suite.Ctx, &types.QueryOperatorFrozenStatusRequest{
+		OperatorAddress: operator.String(),
+	})
+	suite.NoError(err)
+	suite.True(res.Frozen)
+	suite.Equal(types.FrozenOperatorInfo{
+		OperatorAddress: operator.String(),
+		FrozenHeight:    suite.Ctx.BlockHeight(),
+		FrozenBy:        authority,
+		Reason:          "test",
+	}, *res.Info)
+
+	// the operator can't be frozen twice
+	_, err = msgServer.FreezeOperator(suite.Ctx, &types.MsgFreezeOperator{
+		FromAddress:     authority,
+		OperatorAddress: operator.String(),
+	})
+	suite.ErrorIs(err, types.ErrOperatorAlreadyFrozen)
+
+	_, err = msgServer.UnfreezeOperator(suite.Ctx, &types.MsgUnfreezeOperator{
+		FromAddress:     authority,
+		OperatorAddress: operator.String(),
+	})
+	suite.NoError(err)
+	suite.False(suite.App.ExoSlashKeeper.IsOperatorFrozen(suite.Ctx, operator))
+	_, err = msgServer.UnfreezeOperator(suite.Ctx, &types.MsgUnfreezeOperator{
+		FromAddress:     authority,
+		OperatorAddress: operator.String(),
+	})
+	suite.ErrorIs(err, types.ErrOperatorNotFrozen)
+}
+
+func (suite *SlashTestSuite) TestFreezeOperatorBySlashContract() {
+	msgServer := keeper.NewMsgServerImpl(suite.App.ExoSlashKeeper)
+	operator := suite.Operators[0]
+	avsAddr := avstypes.GenerateAVSAddr(avstypes.ChainIDWithoutRevision(suite.Ctx.ChainID()))
+	slashContract := utiltx.GenerateAddress()
+	avsInfo, err := suite.App.AVSManagerKeeper.GetAVSInfo(suite.Ctx, avsAddr)
+	suite.Require().NoError(err)
+	avsInfo.Info.SlashAddr = slashContract.String()
+	err = suite.App.AVSManagerKeeper.SetAVSInfo(suite.Ctx, avsInfo.Info)
+	suite.Require().NoError(err)
+
+	sender := sdk.AccAddress(slashContract.Bytes()).String()
+	_, err = msgServer.FreezeOperator(suite.Ctx, &types.MsgFreezeOperator{
+		FromAddress:     sender,
+		OperatorAddress: operator.String(),
+		AvsAddress:      avsAddr,
+		Reason:          "malicious task result",
+	})
+	suite.NoError(err)
+
+	res, err := suite.App.ExoSlashKeeper.FrozenOperators(suite.Ctx, &types.QueryFrozenOperatorsRequest{})
+	suite.NoError(err)
+	suite.Equal(1, len(res.FrozenOperators))
+	suite.Equal(avsAddr, res.FrozenOperators[0].AvsAddress)
+	suite.Equal(sender, res.FrozenOperators[0].FrozenBy)
+
+	// the slash contract of another AVS can't unfreeze the operator
+	otherInfo := *avsInfo.Info
+	otherInfo.AvsAddress = utiltx.GenerateAddress().String()
+	otherInfo.SlashAddr = utiltx.GenerateAddress().String()
+	err = suite.App.AVSManagerKeeper.SetAVSInfo(suite.Ctx, &otherInfo)
+	suite.Require().NoError(err)
+	_, err = msgServer.UnfreezeOperator(suite.Ctx, &types.MsgUnfreezeOperator{
+		FromAddress:     sdk.AccAddress(common.HexToAddress(otherInfo.SlashAddr).Bytes()).String(),
+		OperatorAddress: operator.String(),
+	})
+	suite.ErrorIs(err, types.ErrUnauthorized)
+
+	_, err = msgServer.UnfreezeOperator(suite.Ctx, &types.MsgUnfreezeOperator{
+		FromAddress:     sender,
+		OperatorAddress: operator.String(),
+	})
+	suite.NoError(err)
+	suite.False(suite.App.ExoSlashKeeper.IsOperatorFrozen(suite.Ctx, operator))
+}
+
+func (suite *SlashTestSuite) TestFreezeOperatorByUnrelatedAVS() {
+	msgServer := keeper.NewMsgServerImpl(suite.App.ExoSlashKeeper)
+	operator := suite.Operators[0]
+	avsAddr := avstypes.GenerateAVSAddr(avstypes.ChainIDWithoutRevision(suite.Ctx.ChainID()))
+	avsInfo, err := suite.App.AVSManagerKeeper.GetAVSInfo(suite.Ctx, avsAddr)
+	suite.Require().NoError(err)
+
+	// an AVS which names the sender as its slash contract, but which the operator has never
+	// opted into, can't freeze the operator
+	otherInfo := *avsInfo.Info
+	otherInfo.AvsAddress = utiltx.GenerateAddress().String()
+	slashContract := utiltx.GenerateAddress()
+	otherInfo.SlashAddr = slashContract.String()
+	err = suite.App.AVSManagerKeeper.SetAVSInfo(suite.Ctx, &otherInfo)
+	suite.Require().NoError(err)
+	_, err = msgServer.FreezeOperator(suite.Ctx, &types.MsgFreezeOperator{
+		FromAddress:     sdk.AccAddress(slashContract.Bytes()).String(),
+		OperatorAddress: operator.String(),
+		AvsAddress:      otherInfo.AvsAddress,
+	})
+	suite.ErrorIs(err, types.ErrOperatorNotOptedIn)
+	suite.False(suite.App.ExoSlashKeeper.IsOperatorFrozen(suite.Ctx, operator))
+}
+
+func (suite *SlashTestSuite) TestFreezeOperatorForSlash() {
+	operator := suite.Operators[0]
+	avsAddr := avstypes.GenerateAVSAddr(avstypes.ChainIDWithoutRevision(suite.Ctx.ChainID()))
+	params, err := suite.App.ExoSlashKeeper.GetParams(suite.Ctx)
+	suite.NoError(err)
+	suite.Equal(types.DefaultFreezeThreshold, params.FreezeThreshold)
+
+	// a slight slash doesn't freeze the operator
+	err = suite.App.ExoSlashKeeper.FreezeOperatorForSlash(suite.Ctx, operator, avsAddr, "slight", sdkmath.LegacyNewDecWithPrec(1, 2))
+	suite.NoError(err)
+	suite.False(suite.App.ExoSlashKeeper.IsOperatorFrozen(suite.Ctx, operator))
+
+	err = suite.App.ExoSlashKeeper.FreezeOperatorForSlash(suite.Ctx, operator, avsAddr, "severe", params.FreezeThreshold)
+	suite.NoError(err)
+	suite.True(suite.App.ExoSlashKeeper.IsOperatorFrozen(suite.Ctx, operator))
+	info, err := suite.App.ExoSlashKeeper.GetFrozenOperatorInfo(suite.Ctx, operator)
+	suite.NoError(err)
+	suite.Equal(types.ModuleName, info.FrozenBy)
+	suite.Equal(avsAddr, info.AvsAddress)
+
+	// the slashing keeps working on an upgraded chain before the params are migrated
+	suite.NoError(suite.App.ExoSlashKeeper.UnfreezeOperator(suite.Ctx, operator))
+	store := prefix.NewStore(
+		suite.Ctx.KVStore(suite.App.GetKey(types.StoreKey)), types.KeyPrefixParams,
+	)
+	store.Delete(types.ParamsKey)
+	err = suite.App.ExoSlashKeeper.FreezeOperatorForSlash(suite.Ctx, operator, avsAddr, "severe", params.FreezeThreshold)
+	suite.NoError(err)
+	suite.True(suite.App.ExoSlashKeeper.IsOperatorFrozen(suite.Ctx, operator))
+	info, err = suite.App.ExoSlashKeeper.GetFrozenOperatorInfo(suite.Ctx, operator)
+	suite.NoError(err)
+
+	// the frozen operators are exported and imported with the genesis state
+	suite.NoError(suite.App.ExoSlashKeeper.SetParams(suite.Ctx, params))
+	genesis := suite.App.ExoSlashKeeper.ExportGenesis(suite.Ctx)
+	suite.NoError(genesis.Validate())
+	suite.Equal([]types.FrozenOperatorInfo{*info}, genesis.FrozenOperators)
+}
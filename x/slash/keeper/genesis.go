@@ -0,0 +1,25 @@
+package keeper
+
+import (
+	"github.com/ExocoreNetwork/exocore/x/slash/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// InitGenesis initializes the module's state from a provided genesis state.
+func (k Keeper) InitGenesis(ctx sdk.Context, genState types.GenesisState) {
+	if err := k.SetParams(ctx, &genState.Params); err != nil {
+		panic(err)
+	}
+	if err := k.SetAllFrozenOperators(ctx, genState.FrozenOperators); err != nil {
+		panic(err)
+	}
+}
+
+// ExportGenesis returns the module's exported genesis
+func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return types.NewGenesis(*params, k.GetAllFrozenOperators(ctx))
+}
@@ -20,7 +20,9 @@ type Keeper struct {
 	storeKey storetypes.StoreKey
 
 	// other keepers
-	assetsKeeper keeper.Keeper
+	assetsKeeper   keeper.Keeper
+	avsKeeper      types.AVSKeeper
+	operatorKeeper types.OperatorKeeper
 
 	authority string
 }
@@ -29,6 +31,8 @@ func NewKeeper(
 	cdc codec.BinaryCodec,
 	storeKey storetypes.StoreKey,
 	assetsKeeper keeper.Keeper,
+	avsKeeper types.AVSKeeper,
+	operatorKeeper types.OperatorKeeper,
 	authority string,
 ) Keeper {
 	// ensure authority is a valid bech32 address
@@ -36,10 +40,12 @@ func NewKeeper(
 		panic(fmt.Sprintf("authority address %s is invalid: %s", authority, err))
 	}
 	return Keeper{
-		cdc:          cdc,
-		storeKey:     storeKey,
-		assetsKeeper: assetsKeeper,
-		authority:    authority,
+		cdc:            cdc,
+		storeKey:       storeKey,
+		assetsKeeper:   assetsKeeper,
+		avsKeeper:      avsKeeper,
+		operatorKeeper: operatorKeeper,
+		authority:      authority,
 	}
 }
 
@@ -51,9 +57,9 @@ type IEXOSlash interface {
 	PostTxProcessing(ctx sdk.Context, msg core.Message, receipt *ethtypes.Receipt) error
 	OptIntoSlashing(ctx sdk.Context, event *SlashParams) error
 	Slash(ctx sdk.Context, event *SlashParams) error
-	FreezeOperator(ctx sdk.Context, event *SlashParams) error
-	ResetFrozenStatus(ctx sdk.Context, event *SlashParams) error
-	IsOperatorFrozen(ctx sdk.Context, event *SlashParams) (bool, error)
+	FreezeOperator(ctx sdk.Context, info *types.FrozenOperatorInfo) error
+	UnfreezeOperator(ctx sdk.Context, operator sdk.AccAddress) error
+	IsOperatorFrozen(ctx sdk.Context, operator sdk.AccAddress) bool
 	SetParams(ctx sdk.Context, params *types.Params) error
 	GetParams(ctx sdk.Context) (*types.Params, error)
 	OperatorAssetSlashedProportion(ctx sdk.Context, opAddr sdk.AccAddress, assetID string, startHeight, endHeight uint64) sdkmath.LegacyDec
@@ -0,0 +1,35 @@
+package keeper
+
+import (
+	"github.com/ExocoreNetwork/exocore/x/slash/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{
+		keeper: keeper,
+	}
+}
+
+// Migrate1to2 migrates the store from consensus version 1 to 2. The genesis of the module
+// wasn't run before v2, so the chains running it have no params stored. The default params
+// are stored, unless valid params have already been set, so that the slashing, which reads
+// the freeze threshold, keeps working after the upgrade.
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	params, err := m.keeper.GetParams(ctx)
+	if err == nil && params.Validate() == nil {
+		return nil
+	}
+	defaultParams := types.DefaultParams()
+	m.keeper.Logger(ctx).Info(
+		"Migrate1to2: storing the default params",
+		"freezeThreshold", defaultParams.FreezeThreshold,
+	)
+	return m.keeper.SetParams(ctx, &defaultParams)
+}
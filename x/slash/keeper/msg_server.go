@@ -2,13 +2,28 @@ package keeper
 
 import (
 	"context"
+	"strings"
 
+	errorsmod "cosmossdk.io/errors"
 	"github.com/ExocoreNetwork/exocore/utils"
 	"github.com/ExocoreNetwork/exocore/x/slash/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/ethereum/go-ethereum/common"
 )
 
+type msgServer struct {
+	Keeper
+}
+
+// NewMsgServerImpl returns an implementation of the MsgServer interface
+// for the provided Keeper.
+func NewMsgServerImpl(keeper Keeper) types.MsgServer {
+	return &msgServer{Keeper: keeper}
+}
+
+var _ types.MsgServer = msgServer{}
+
 func (k Keeper) UpdateParams(ctx context.Context, params *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
 	c := sdk.UnwrapSDKContext(ctx)
 	if utils.IsMainnet(c.ChainID()) && k.authority != params.Authority {
@@ -24,9 +39,92 @@ func (k Keeper) UpdateParams(ctx context.Context, params *types.MsgUpdateParams)
 		"params.AUthority", params.Authority,
 	)
 
+	if err := params.Params.Validate(); err != nil {
+		return nil, err
+	}
 	err := k.SetParams(c, &params.Params)
 	if err != nil {
 		return nil, err
 	}
 	return nil, nil
 }
+
+// isAVSSlashContract returns true if the sender is the slash contract of the AVS.
+func (k Keeper) isAVSSlashContract(ctx sdk.Context, sender sdk.AccAddress, avsAddr string) bool {
+	if avsAddr == "" {
+		return false
+	}
+	slashContract, err := k.avsKeeper.GetAVSSlashContract(ctx, avsAddr)
+	if err != nil || !common.IsHexAddress(slashContract) {
+		return false
+	}
+	return common.HexToAddress(slashContract) == common.BytesToAddress(sender)
+}
+
+// hasOptedIn returns true if the operator is opted into the AVS, or was opted into it in the
+// past. The latter allows an AVS to freeze an operator for an infraction committed before the
+// operator opted out.
+func (k Keeper) hasOptedIn(ctx sdk.Context, operatorAddr, avsAddr string) bool {
+	_, err := k.operatorKeeper.GetOptedInfo(ctx, operatorAddr, avsAddr)
+	return err == nil
+}
+
+// FreezeOperator freezes an operator. The sender should be either the governance account or
+// the slash contract of the AVS on whose behalf the operator is frozen. In the latter case,
+// the operator must be, or have been, opted into that AVS.
+func (k msgServer) FreezeOperator(goCtx context.Context, msg *types.MsgFreezeOperator) (*types.MsgFreezeOperatorResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	sender, err := sdk.AccAddressFromBech32(msg.FromAddress)
+	if err != nil {
+		return nil, err
+	}
+	if msg.FromAddress != k.authority && !k.isAVSSlashContract(ctx, sender, msg.AvsAddress) {
+		return nil, errorsmod.Wrapf(
+			types.ErrUnauthorized, "FreezeOperator: sender %s, avs address %s", msg.FromAddress, msg.AvsAddress,
+		)
+	}
+	avsAddr := strings.ToLower(msg.AvsAddress)
+	if msg.FromAddress != k.authority && !k.hasOptedIn(ctx, msg.OperatorAddress, avsAddr) {
+		return nil, errorsmod.Wrapf(
+			types.ErrOperatorNotOptedIn, "FreezeOperator: operator %s, avs address %s", msg.OperatorAddress, msg.AvsAddress,
+		)
+	}
+	err = k.Keeper.FreezeOperator(ctx, &types.FrozenOperatorInfo{
+		OperatorAddress: msg.OperatorAddress,
+		AvsAddress:      avsAddr,
+		FrozenBy:        msg.FromAddress,
+		Reason:          msg.Reason,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &types.MsgFreezeOperatorResponse{}, nil
+}
+
+// UnfreezeOperator unfreezes a frozen operator. The governance account can unfreeze any
+// operator, while the slash contract of an AVS can only unfreeze the operators frozen on
+// behalf of that AVS.
+func (k msgServer) UnfreezeOperator(goCtx context.Context, msg *types.MsgUnfreezeOperator) (*types.MsgUnfreezeOperatorResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	sender, err := sdk.AccAddressFromBech32(msg.FromAddress)
+	if err != nil {
+		return nil, err
+	}
+	operator, err := sdk.AccAddressFromBech32(msg.OperatorAddress)
+	if err != nil {
+		return nil, err
+	}
+	info, err := k.GetFrozenOperatorInfo(ctx, operator)
+	if err != nil {
+		return nil, errorsmod.Wrap(types.ErrOperatorNotFrozen, err.Error())
+	}
+	if msg.FromAddress != k.authority && !k.isAVSSlashContract(ctx, sender, info.AvsAddress) {
+		return nil, errorsmod.Wrapf(
+			types.ErrUnauthorized, "UnfreezeOperator: sender %s, avs address %s", msg.FromAddress, info.AvsAddress,
+		)
+	}
+	if err := k.Keeper.UnfreezeOperator(ctx, operator); err != nil {
+		return nil, err
+	}
+	return &types.MsgUnfreezeOperatorResponse{}, nil
+}
@@ -1,11 +1,16 @@
 package keeper_test
 
 import (
+	sdkmath "cosmossdk.io/math"
+	"github.com/ExocoreNetwork/exocore/x/slash/keeper"
 	slashtype "github.com/ExocoreNetwork/exocore/x/slash/types"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 )
 
 func (suite *SlashTestSuite) TestParams() {
-	params := &slashtype.Params{}
+	params := &slashtype.Params{
+		FreezeThreshold: sdkmath.LegacyNewDecWithPrec(3, 1),
+	}
 	err := suite.App.ExoSlashKeeper.SetParams(suite.Ctx, params)
 	suite.NoError(err)
 
@@ -13,3 +18,27 @@ func (suite *SlashTestSuite) TestParams() {
 	suite.NoError(err)
 	suite.Equal(*params, *getParams)
 }
+
+func (suite *SlashTestSuite) TestMigrate1to2() {
+	// the chains running v1 have no params stored
+	store := prefix.NewStore(
+		suite.Ctx.KVStore(suite.App.GetKey(slashtype.StoreKey)), slashtype.KeyPrefixParams,
+	)
+	store.Delete(slashtype.ParamsKey)
+	_, err := suite.App.ExoSlashKeeper.GetParams(suite.Ctx)
+	suite.ErrorIs(err, slashtype.ErrNoParamsKey)
+
+	migrator := keeper.NewMigrator(suite.App.ExoSlashKeeper)
+	suite.NoError(migrator.Migrate1to2(suite.Ctx))
+	params, err := suite.App.ExoSlashKeeper.GetParams(suite.Ctx)
+	suite.NoError(err)
+	suite.Equal(slashtype.DefaultParams(), *params)
+
+	// the params already set aren't overwritten
+	custom := &slashtype.Params{FreezeThreshold: sdkmath.LegacyNewDecWithPrec(3, 1)}
+	suite.NoError(suite.App.ExoSlashKeeper.SetParams(suite.Ctx, custom))
+	suite.NoError(migrator.Migrate1to2(suite.Ctx))
+	params, err = suite.App.ExoSlashKeeper.GetParams(suite.Ctx)
+	suite.NoError(err)
+	suite.Equal(*custom, *params)
+}
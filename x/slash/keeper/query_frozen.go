@@ -0,0 +1,58 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/ExocoreNetwork/exocore/x/slash/types"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// FrozenOperators queries all the frozen operators.
+func (k Keeper) FrozenOperators(goCtx context.Context, req *types.QueryFrozenOperatorsRequest) (*types.QueryFrozenOperatorsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	res := make([]types.FrozenOperatorInfo, 0)
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixFrozenOperator)
+	pageRes, err := query.Paginate(store, req.Pagination, func(_ []byte, value []byte) error {
+		info := types.FrozenOperatorInfo{}
+		// don't use MustUnmarshal to not panic for queries
+		if err := k.cdc.Unmarshal(value, &info); err != nil {
+			return err
+		}
+		res = append(res, info)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &types.QueryFrozenOperatorsResponse{
+		FrozenOperators: res,
+		Pagination:      pageRes,
+	}, nil
+}
+
+// OperatorFrozenStatus queries whether the operator is frozen.
+func (k Keeper) OperatorFrozenStatus(goCtx context.Context, req *types.QueryOperatorFrozenStatusRequest) (*types.QueryOperatorFrozenStatusResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	opAccAddr, err := sdk.AccAddressFromBech32(req.OperatorAddress)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if !k.IsOperatorFrozen(ctx, opAccAddr) {
+		return &types.QueryOperatorFrozenStatusResponse{Frozen: false}, nil
+	}
+	info, err := k.GetFrozenOperatorInfo(ctx, opAccAddr)
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryOperatorFrozenStatusResponse{Frozen: true, Info: info}, nil
+}
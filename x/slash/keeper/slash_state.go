@@ -1,32 +1,151 @@
 package keeper
 
 import (
+	"errors"
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
 	"github.com/ExocoreNetwork/exocore/x/slash/types"
 	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
-func (k Keeper) SetFrozenStatus(ctx sdk.Context, operatorAddr string, status bool) (err error) {
-	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixOperatorInfo)
-	if status {
-		store.Set([]byte(operatorAddr), []byte("1"))
-		return nil
+// SetFrozenOperatorInfo stores the freezing information of the operator.
+func (k Keeper) SetFrozenOperatorInfo(ctx sdk.Context, info *types.FrozenOperatorInfo) error {
+	opAccAddr, err := sdk.AccAddressFromBech32(info.OperatorAddress)
+	if err != nil {
+		return err
 	}
-	store.Set([]byte(operatorAddr), []byte("0"))
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixFrozenOperator)
+	bz := k.cdc.MustMarshal(info)
+	store.Set(opAccAddr, bz)
 	return nil
 }
 
-func (k Keeper) GetFrozenStatus(ctx sdk.Context, operatorAddr string) (bool, error) {
-	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixOperatorInfo)
-	ifExist := store.Has(types.ParamsKey)
+// GetFrozenOperatorInfo returns the freezing information of the operator. It returns an
+// error if the operator isn't frozen.
+func (k Keeper) GetFrozenOperatorInfo(ctx sdk.Context, operator sdk.AccAddress) (*types.FrozenOperatorInfo, error) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixFrozenOperator)
+	value := store.Get(operator)
+	if value == nil {
+		return nil, errorsmod.Wrap(types.ErrNoOperatorStatusKey, fmt.Sprintf("GetFrozenOperatorInfo: operator is %s", operator))
+	}
+	ret := types.FrozenOperatorInfo{}
+	k.cdc.MustUnmarshal(value, &ret)
+	return &ret, nil
+}
+
+// IsOperatorFrozen returns true if the operator is frozen. It's used by the operator and
+// delegation modules to reject the opt-in, consensus key setting and delegation.
+func (k Keeper) IsOperatorFrozen(ctx sdk.Context, operator sdk.AccAddress) bool {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixFrozenOperator)
+	return store.Has(operator)
+}
 
-	if !ifExist {
-		return false, types.ErrNoOperatorStatusKey
+// FreezeOperator freezes the operator with the provided information.
+func (k Keeper) FreezeOperator(ctx sdk.Context, info *types.FrozenOperatorInfo) error {
+	opAccAddr, err := sdk.AccAddressFromBech32(info.OperatorAddress)
+	if err != nil {
+		return err
+	}
+	if k.IsOperatorFrozen(ctx, opAccAddr) {
+		return errorsmod.Wrap(types.ErrOperatorAlreadyFrozen, fmt.Sprintf("FreezeOperator: operator is %s", info.OperatorAddress))
+	}
+	info.FrozenHeight = ctx.BlockHeight()
+	if err := k.SetFrozenOperatorInfo(ctx, info); err != nil {
+		return err
 	}
-	value := store.Get([]byte(operatorAddr))
-	if string(value) == "1" {
-		return true, nil
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeOperatorFrozen,
+			sdk.NewAttribute(types.AttributeKeyOperator, info.OperatorAddress),
+			sdk.NewAttribute(types.AttributeKeyAVSAddress, info.AvsAddress),
+			sdk.NewAttribute(types.AttributeKeySender, info.FrozenBy),
+			sdk.NewAttribute(types.AttributeKeyReason, info.Reason),
+		),
+	)
+	return nil
+}
+
+// UnfreezeOperator removes the frozen state of the operator.
+func (k Keeper) UnfreezeOperator(ctx sdk.Context, operator sdk.AccAddress) error {
+	if !k.IsOperatorFrozen(ctx, operator) {
+		return errorsmod.Wrap(types.ErrOperatorNotFrozen, fmt.Sprintf("UnfreezeOperator: operator is %s", operator))
+	}
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixFrozenOperator)
+	store.Delete(operator)
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeOperatorUnfrozen,
+			sdk.NewAttribute(types.AttributeKeyOperator, operator.String()),
+		),
+	)
+	return nil
+}
+
+// FreezeOperatorForSlash is called by the operator module after an operator is slashed. The
+// operator is frozen if the slash proportion reaches the freeze threshold, so that it can't
+// keep attracting stake before the governance or the AVS reviews the misbehavior.
+func (k Keeper) FreezeOperatorForSlash(
+	ctx sdk.Context, operator sdk.AccAddress, avsAddr, slashID string, slashProportion sdkmath.LegacyDec,
+) error {
+	params, err := k.GetParams(ctx)
+	if errors.Is(err, types.ErrNoParamsKey) {
+		// the params are stored by the genesis or the v2 migration. until the migration runs
+		// on an upgraded chain, the slashing must not fail, so the defaults are used.
+		defaultParams := types.DefaultParams()
+		params, err = &defaultParams, nil
 	}
+	if err != nil {
+		return err
+	}
+	if params.FreezeThreshold.IsZero() || slashProportion.LT(params.FreezeThreshold) {
+		return nil
+	}
+	// the operator might have been frozen by an earlier slash or by an authorized party.
+	if k.IsOperatorFrozen(ctx, operator) {
+		return nil
+	}
+	return k.FreezeOperator(ctx, &types.FrozenOperatorInfo{
+		OperatorAddress: operator.String(),
+		AvsAddress:      avsAddr,
+		FrozenBy:        types.ModuleName,
+		Reason:          fmt.Sprintf("slashed by %s, slash ID: %s", slashProportion, slashID),
+	})
+}
+
+// IterateFrozenOperators iterates through all the frozen operators.
+func (k Keeper) IterateFrozenOperators(ctx sdk.Context, fn func(info types.FrozenOperatorInfo) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixFrozenOperator)
+	iterator := sdk.KVStorePrefixIterator(store, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		info := types.FrozenOperatorInfo{}
+		k.cdc.MustUnmarshal(iterator.Value(), &info)
+		if fn(info) {
+			break
+		}
+	}
+}
+
+// SetAllFrozenOperators is used to import the frozen operators from the genesis state.
+func (k Keeper) SetAllFrozenOperators(ctx sdk.Context, frozenOperators []types.FrozenOperatorInfo) error {
+	for i := range frozenOperators {
+		if err := k.SetFrozenOperatorInfo(ctx, &frozenOperators[i]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
 
-	return false, nil
+// GetAllFrozenOperators is used to export all the frozen operators.
+func (k Keeper) GetAllFrozenOperators(ctx sdk.Context) []types.FrozenOperatorInfo {
+	ret := make([]types.FrozenOperatorInfo, 0)
+	k.IterateFrozenOperators(ctx, func(info types.FrozenOperatorInfo) bool {
+		ret = append(ret, info)
+		return false
+	})
+	return ret
 }
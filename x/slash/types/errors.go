@@ -14,4 +14,10 @@ var (
 	ErrSlashAmountIsNegative    = errorsmod.Register(ModuleName, 5, "the slash amount is negative")
 	ErrSlashAssetNotExist       = errorsmod.Register(ModuleName, 6, "the slash asset doesn't exist")
 	ErrNoOperatorStatusKey      = errorsmod.Register(ModuleName, 7, "there is no stored key for slash OpratorStatus")
+	ErrOperatorAlreadyFrozen    = errorsmod.Register(ModuleName, 8, "the operator is already frozen")
+	ErrOperatorNotFrozen        = errorsmod.Register(ModuleName, 9, "the operator isn't frozen")
+	ErrUnauthorized             = errorsmod.Register(ModuleName, 10, "the sender isn't authorized to freeze or unfreeze the operator")
+	ErrInvalidFreezeThreshold   = errorsmod.Register(ModuleName, 11, "the freeze threshold should be in the range [0, 1]")
+	ErrInvalidGenesisData       = errorsmod.Register(ModuleName, 12, "the genesis data supplied is invalid")
+	ErrOperatorNotOptedIn       = errorsmod.Register(ModuleName, 13, "the operator has never opted into the AVS")
 )
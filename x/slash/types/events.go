@@ -8,4 +8,10 @@ const (
 	AttributeKeyReason  = "reason"
 
 	AttributeValueDoubleSign = "double_sign"
+
+	EventTypeOperatorFrozen   = "operator_frozen"
+	EventTypeOperatorUnfrozen = "operator_unfrozen"
+	AttributeKeyOperator      = "operator"
+	AttributeKeyAVSAddress    = "avs_address"
+	AttributeKeySender        = "sender"
 )
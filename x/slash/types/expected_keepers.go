@@ -1,6 +1,7 @@
 package types
 
 import (
+	operatortypes "github.com/ExocoreNetwork/exocore/x/operator/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/auth/types"
 )
@@ -16,3 +17,14 @@ type BankKeeper interface {
 	SpendableCoins(ctx sdk.Context, addr sdk.AccAddress) sdk.Coins
 	// Methods imported from bank should be defined here
 }
+
+// AVSKeeper defines the expected interface needed to authorize the AVS slash contracts.
+type AVSKeeper interface {
+	GetAVSSlashContract(ctx sdk.Context, avsAddr string) (string, error)
+}
+
+// OperatorKeeper defines the expected interface needed to check whether an operator is, or
+// was, opted into an AVS.
+type OperatorKeeper interface {
+	GetOptedInfo(ctx sdk.Context, operatorAddr, avsAddr string) (*operatortypes.OptedInfo, error)
+}
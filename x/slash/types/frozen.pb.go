@@ -0,0 +1,521 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: exocore/slash/v1/frozen.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// FrozenOperatorInfo records why and when an operator is frozen. A frozen operator can't
+// opt into AVSs, set consensus keys or accept new delegations until it is unfrozen.
+type FrozenOperatorInfo struct {
+	// operator_address is the address of the frozen operator.
+	OperatorAddress string `protobuf:"bytes,1,opt,name=operator_address,json=operatorAddress,proto3" json:"operator_address,omitempty"`
+	// frozen_height is the block height at which the operator is frozen.
+	FrozenHeight int64 `protobuf:"varint,2,opt,name=frozen_height,json=frozenHeight,proto3" json:"frozen_height,omitempty"`
+	// avs_address is the AVS on whose behalf the operator is frozen. It's empty if the
+	// operator is frozen by the governance.
+	AvsAddress string `protobuf:"bytes,3,opt,name=avs_address,json=avsAddress,proto3" json:"avs_address,omitempty"`
+	// frozen_by is the address which freezes the operator. It's the module name if the
+	// operator is frozen automatically by a slash.
+	FrozenBy string `protobuf:"bytes,4,opt,name=frozen_by,json=frozenBy,proto3" json:"frozen_by,omitempty"`
+	// reason is the reason for freezing the operator.
+	Reason string `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (m *FrozenOperatorInfo) Reset()         { *m = FrozenOperatorInfo{} }
+func (m *FrozenOperatorInfo) String() string { return proto.CompactTextString(m) }
+func (*FrozenOperatorInfo) ProtoMessage()    {}
+func (*FrozenOperatorInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6069edc6b4717c2c, []int{0}
+}
+func (m *FrozenOperatorInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FrozenOperatorInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FrozenOperatorInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FrozenOperatorInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FrozenOperatorInfo.Merge(m, src)
+}
+func (m *FrozenOperatorInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *FrozenOperatorInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_FrozenOperatorInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FrozenOperatorInfo proto.InternalMessageInfo
+
+func (m *FrozenOperatorInfo) GetOperatorAddress() string {
+	if m != nil {
+		return m.OperatorAddress
+	}
+	return ""
+}
+
+func (m *FrozenOperatorInfo) GetFrozenHeight() int64 {
+	if m != nil {
+		return m.FrozenHeight
+	}
+	return 0
+}
+
+func (m *FrozenOperatorInfo) GetAvsAddress() string {
+	if m != nil {
+		return m.AvsAddress
+	}
+	return ""
+}
+
+func (m *FrozenOperatorInfo) GetFrozenBy() string {
+	if m != nil {
+		return m.FrozenBy
+	}
+	return ""
+}
+
+func (m *FrozenOperatorInfo) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*FrozenOperatorInfo)(nil), "exocore.slash.v1.FrozenOperatorInfo")
+}
+
+func init() { proto.RegisterFile("exocore/slash/v1/frozen.proto", fileDescriptor_6069edc6b4717c2c) }
+
+var fileDescriptor_6069edc6b4717c2c = []byte{
+	// 289 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x3c, 0x90, 0x41, 0x4e, 0xeb, 0x30,
+	0x18, 0x84, 0xeb, 0xd7, 0x47, 0x45, 0x0d, 0x88, 0xca, 0x42, 0x28, 0x80, 0x30, 0x15, 0x6c, 0xba,
+	0x21, 0x56, 0xc5, 0x09, 0x28, 0x02, 0xd1, 0x0d, 0x48, 0x61, 0xc7, 0x26, 0x72, 0x52, 0x37, 0x89,
+	0xa0, 0xf9, 0x23, 0xdb, 0x84, 0x86, 0x53, 0x70, 0x18, 0x0e, 0xc1, 0xb2, 0x42, 0x2c, 0x58, 0xa2,
+	0xe4, 0x22, 0x08, 0xdb, 0x61, 0x39, 0xf3, 0xf9, 0x9f, 0xb1, 0x06, 0x1f, 0x8a, 0x25, 0xc4, 0x20,
+	0x05, 0x53, 0x8f, 0x5c, 0xa5, 0xac, 0x1c, 0xb3, 0xb9, 0x84, 0x17, 0x91, 0xfb, 0x85, 0x04, 0x0d,
+	0x64, 0xe0, 0xb0, 0x6f, 0xb0, 0x5f, 0x8e, 0xf7, 0xf7, 0x62, 0x50, 0x0b, 0x50, 0xa1, 0xe1, 0xcc,
+	0x0a, 0xfb, 0xf8, 0xf8, 0x13, 0x61, 0x72, 0x65, 0xae, 0x6f, 0x0b, 0x21, 0xb9, 0x06, 0x39, 0xcd,
+	0xe7, 0x40, 0x2e, 0xf0, 0x00, 0x9c, 0x0e, 0xf9, 0x6c, 0x26, 0x85, 0x52, 0x1e, 0x1a, 0xa2, 0x51,
+	0x7f, 0xe2, 0x7d, 0xbc, 0x9d, 0xee, 0xb8, 0x88, 0x73, 0x4b, 0xee, 0xb4, 0xcc, 0xf2, 0x24, 0xd8,
+	0x6e, 0x2f, 0x9c, 0x4d, 0x4e, 0xf0, 0x96, 0xfd, 0x58, 0x98, 0x8a, 0x2c, 0x49, 0xb5, 0xf7, 0x6f,
+	0x88, 0x46, 0xdd, 0x60, 0xd3, 0x9a, 0xd7, 0xc6, 0x23, 0x47, 0x78, 0x83, 0x97, 0xea, 0xaf, 0xa4,
+	0xfb, 0x5b, 0x12, 0x60, 0x5e, 0xaa, 0x36, 0xe5, 0x00, 0xf7, 0x5d, 0x4a, 0x54, 0x79, 0xff, 0x0d,
+	0x5e, 0xb7, 0xc6, 0xa4, 0x22, 0xbb, 0xb8, 0x27, 0x05, 0x57, 0x90, 0x7b, 0x6b, 0x86, 0x38, 0x35,
+	0x99, 0xbe, 0xd7, 0x14, 0xad, 0x6a, 0x8a, 0xbe, 0x6b, 0x8a, 0x5e, 0x1b, 0xda, 0x59, 0x35, 0xb4,
+	0xf3, 0xd5, 0xd0, 0xce, 0x3d, 0x4b, 0x32, 0x9d, 0x3e, 0x45, 0x7e, 0x0c, 0x0b, 0x76, 0x69, 0x87,
+	0xba, 0x11, 0xfa, 0x19, 0xe4, 0x03, 0x6b, 0x67, 0x5d, 0xba, 0x61, 0x75, 0x55, 0x08, 0x15, 0xf5,
+	0xcc, 0x50, 0x67, 0x3f, 0x01, 0x00, 0x00, 0xff, 0xff, 0xb3, 0x30, 0xc5, 0x31, 0x76, 0x01, 0x00,
+	0x00,
+}
+
+func (m *FrozenOperatorInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FrozenOperatorInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FrozenOperatorInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintFrozen(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.FrozenBy) > 0 {
+		i -= len(m.FrozenBy)
+		copy(dAtA[i:], m.FrozenBy)
+		i = encodeVarintFrozen(dAtA, i, uint64(len(m.FrozenBy)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.AvsAddress) > 0 {
+		i -= len(m.AvsAddress)
+		copy(dAtA[i:], m.AvsAddress)
+		i = encodeVarintFrozen(dAtA, i, uint64(len(m.AvsAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.FrozenHeight != 0 {
+		i = encodeVarintFrozen(dAtA, i, uint64(m.FrozenHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.OperatorAddress) > 0 {
+		i -= len(m.OperatorAddress)
+		copy(dAtA[i:], m.OperatorAddress)
+		i = encodeVarintFrozen(dAtA, i, uint64(len(m.OperatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintFrozen(dAtA []byte, offset int, v uint64) int {
+	offset -= sovFrozen(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *FrozenOperatorInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OperatorAddress)
+	if l > 0 {
+		n += 1 + l + sovFrozen(uint64(l))
+	}
+	if m.FrozenHeight != 0 {
+		n += 1 + sovFrozen(uint64(m.FrozenHeight))
+	}
+	l = len(m.AvsAddress)
+	if l > 0 {
+		n += 1 + l + sovFrozen(uint64(l))
+	}
+	l = len(m.FrozenBy)
+	if l > 0 {
+		n += 1 + l + sovFrozen(uint64(l))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovFrozen(uint64(l))
+	}
+	return n
+}
+
+func sovFrozen(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozFrozen(x uint64) (n int) {
+	return sovFrozen(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *FrozenOperatorInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFrozen
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FrozenOperatorInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FrozenOperatorInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OperatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFrozen
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFrozen
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFrozen
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OperatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FrozenHeight", wireType)
+			}
+			m.FrozenHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFrozen
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FrozenHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AvsAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFrozen
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFrozen
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFrozen
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AvsAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FrozenBy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFrozen
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFrozen
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFrozen
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FrozenBy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFrozen
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFrozen
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFrozen
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFrozen(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFrozen
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipFrozen(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowFrozen
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowFrozen
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowFrozen
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthFrozen
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupFrozen
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthFrozen
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthFrozen        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowFrozen          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupFrozen = fmt.Errorf("proto: unexpected end of group")
+)
@@ -1,24 +1,66 @@
 package types
 
 import (
-// this line is used by starport scaffolding # genesis/types/import
+	errorsmod "cosmossdk.io/errors"
+	"github.com/ExocoreNetwork/exocore/utils"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
 )
 
 // DefaultIndex is the default global index
 const DefaultIndex uint64 = 1
 
+// NewGenesis returns a new genesis state with the given inputs.
+func NewGenesis(params Params, frozenOperators []FrozenOperatorInfo) *GenesisState {
+	return &GenesisState{
+		Params:          params,
+		FrozenOperators: frozenOperators,
+	}
+}
+
 // DefaultGenesis returns the default genesis state
 func DefaultGenesis() *GenesisState {
-	return &GenesisState{
-		// this line is used by starport scaffolding # genesis/types/default
-		Params: DefaultParams(),
+	return NewGenesis(DefaultParams(), nil)
+}
+
+// ValidateFrozenOperators validates the frozen operators in the genesis state.
+func (gs GenesisState) ValidateFrozenOperators() error {
+	validationFunc := func(_ int, info FrozenOperatorInfo) error {
+		if _, err := sdk.AccAddressFromBech32(info.OperatorAddress); err != nil {
+			return errorsmod.Wrapf(
+				ErrInvalidGenesisData,
+				"invalid operator address %s: %s", info.OperatorAddress, err,
+			)
+		}
+		if info.FrozenHeight < 0 {
+			return errorsmod.Wrapf(
+				ErrInvalidGenesisData,
+				"negative frozen height, info: %+v", info,
+			)
+		}
+		if info.AvsAddress != "" && !common.IsHexAddress(info.AvsAddress) {
+			return errorsmod.Wrapf(
+				ErrInvalidGenesisData,
+				"the AVS address isn't an ethereum hex address, info: %+v", info,
+			)
+		}
+		return nil
+	}
+	seenFieldValueFunc := func(info FrozenOperatorInfo) (string, struct{}) {
+		return info.OperatorAddress, struct{}{}
+	}
+	_, err := utils.CommonValidation(gs.FrozenOperators, seenFieldValueFunc, validationFunc)
+	if err != nil {
+		return errorsmod.Wrap(ErrInvalidGenesisData, err.Error())
 	}
+	return nil
 }
 
 // Validate performs basic genesis state validation returning an error upon any
 // failure.
 func (gs GenesisState) Validate() error {
-	// this line is used by starport scaffolding # genesis/types/validate
-
-	return gs.Params.Validate()
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+	return gs.ValidateFrozenOperators()
 }
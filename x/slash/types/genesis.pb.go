@@ -27,6 +27,8 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 type GenesisState struct {
 	// params defines all the parameters of the module.
 	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	// frozen_operators is the list of the frozen operators.
+	FrozenOperators []FrozenOperatorInfo `protobuf:"bytes,2,rep,name=frozen_operators,json=frozenOperators,proto3" json:"frozen_operators"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -69,6 +71,13 @@ func (m *GenesisState) GetParams() Params {
 	return Params{}
 }
 
+func (m *GenesisState) GetFrozenOperators() []FrozenOperatorInfo {
+	if m != nil {
+		return m.FrozenOperators
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "exocore.slash.v1.GenesisState")
 }
@@ -76,20 +85,23 @@ func init() {
 func init() { proto.RegisterFile("exocore/slash/v1/genesis.proto", fileDescriptor_05962c99dc81cce2) }
 
 var fileDescriptor_05962c99dc81cce2 = []byte{
-	// 199 bytes of a gzipped FileDescriptorProto
+	// 255 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x4b, 0xad, 0xc8, 0x4f,
 	0xce, 0x2f, 0x4a, 0xd5, 0x2f, 0xce, 0x49, 0x2c, 0xce, 0xd0, 0x2f, 0x33, 0xd4, 0x4f, 0x4f, 0xcd,
 	0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x80, 0xca, 0xeb, 0x81,
-	0xe5, 0xf5, 0xca, 0x0c, 0xa5, 0x64, 0x31, 0x74, 0x14, 0x24, 0x16, 0x25, 0xe6, 0x42, 0x35, 0x48,
-	0x89, 0xa4, 0xe7, 0xa7, 0xe7, 0x83, 0x99, 0xfa, 0x20, 0x16, 0x44, 0x54, 0xc9, 0x8d, 0x8b, 0xc7,
-	0x1d, 0x62, 0x6e, 0x70, 0x49, 0x62, 0x49, 0xaa, 0x90, 0x19, 0x17, 0x1b, 0x44, 0x97, 0x04, 0xa3,
-	0x02, 0xa3, 0x06, 0xb7, 0x91, 0x84, 0x1e, 0xba, 0x3d, 0x7a, 0x01, 0x60, 0x79, 0x27, 0x96, 0x13,
-	0xf7, 0xe4, 0x19, 0x82, 0xa0, 0xaa, 0x9d, 0x3c, 0x4f, 0x3c, 0x92, 0x63, 0xbc, 0xf0, 0x48, 0x8e,
-	0xf1, 0xc1, 0x23, 0x39, 0xc6, 0x09, 0x8f, 0xe5, 0x18, 0x2e, 0x3c, 0x96, 0x63, 0xb8, 0xf1, 0x58,
-	0x8e, 0x21, 0x4a, 0x3f, 0x3d, 0xb3, 0x24, 0xa3, 0x34, 0x49, 0x2f, 0x39, 0x3f, 0x57, 0xdf, 0x15,
-	0x62, 0x96, 0x5f, 0x6a, 0x49, 0x79, 0x7e, 0x51, 0xb6, 0x3e, 0xcc, 0xc1, 0x15, 0x50, 0x27, 0x97,
-	0x54, 0x16, 0xa4, 0x16, 0x27, 0xb1, 0x81, 0x5d, 0x66, 0x0c, 0x08, 0x00, 0x00, 0xff, 0xff, 0x5c,
-	0x72, 0x18, 0x08, 0x02, 0x01, 0x00, 0x00,
+	0xe5, 0xf5, 0xca, 0x0c, 0xa5, 0x64, 0x31, 0x74, 0xa4, 0x15, 0xe5, 0x57, 0xa5, 0xe6, 0x41, 0x34,
+	0x60, 0x91, 0x2e, 0x48, 0x2c, 0x4a, 0xcc, 0x85, 0x9a, 0x27, 0x25, 0x92, 0x9e, 0x9f, 0x9e, 0x0f,
+	0x66, 0xea, 0x83, 0x58, 0x10, 0x51, 0xa5, 0xb9, 0x8c, 0x5c, 0x3c, 0xee, 0x10, 0x7b, 0x83, 0x4b,
+	0x12, 0x4b, 0x52, 0x85, 0xcc, 0xb8, 0xd8, 0x20, 0xda, 0x24, 0x18, 0x15, 0x18, 0x35, 0xb8, 0x8d,
+	0x24, 0xf4, 0xd0, 0xdd, 0xa1, 0x17, 0x00, 0x96, 0x77, 0x62, 0x39, 0x71, 0x4f, 0x9e, 0x21, 0x08,
+	0xaa, 0x5a, 0x28, 0x94, 0x4b, 0x00, 0xe2, 0x9a, 0xf8, 0xfc, 0x82, 0xd4, 0xa2, 0xc4, 0x92, 0xfc,
+	0xa2, 0x62, 0x09, 0x26, 0x05, 0x66, 0x0d, 0x6e, 0x23, 0x15, 0x4c, 0x13, 0xdc, 0xc0, 0x2a, 0xfd,
+	0xa1, 0x0a, 0x3d, 0xf3, 0xd2, 0xf2, 0xa1, 0xa6, 0xf1, 0xa7, 0xa1, 0xc8, 0x14, 0x3b, 0x79, 0x9e,
+	0x78, 0x24, 0xc7, 0x78, 0xe1, 0x91, 0x1c, 0xe3, 0x83, 0x47, 0x72, 0x8c, 0x13, 0x1e, 0xcb, 0x31,
+	0x5c, 0x78, 0x2c, 0xc7, 0x70, 0xe3, 0xb1, 0x1c, 0x43, 0x94, 0x7e, 0x7a, 0x66, 0x49, 0x46, 0x69,
+	0x92, 0x5e, 0x72, 0x7e, 0xae, 0xbe, 0x2b, 0xc4, 0x02, 0xbf, 0xd4, 0x92, 0xf2, 0xfc, 0xa2, 0x6c,
+	0x7d, 0x58, 0x40, 0x54, 0x40, 0x83, 0xa2, 0xa4, 0xb2, 0x20, 0xb5, 0x38, 0x89, 0x0d, 0xec, 0x63,
+	0x63, 0x40, 0x00, 0x00, 0x00, 0xff, 0xff, 0x03, 0xc2, 0x61, 0xd2, 0x79, 0x01, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -112,6 +124,20 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.FrozenOperators) > 0 {
+		for iNdEx := len(m.FrozenOperators) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FrozenOperators[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
 	{
 		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -144,6 +170,12 @@ func (m *GenesisState) Size() (n int) {
 	_ = l
 	l = m.Params.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.FrozenOperators) > 0 {
+		for _, e := range m.FrozenOperators {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -215,6 +247,40 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FrozenOperators", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FrozenOperators = append(m.FrozenOperators, FrozenOperatorInfo{})
+			if err := m.FrozenOperators[len(m.FrozenOperators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -3,11 +3,21 @@ package types_test
 import (
 	"testing"
 
+	sdkmath "cosmossdk.io/math"
 	"github.com/ExocoreNetwork/exocore/x/slash/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	utiltx "github.com/evmos/evmos/v16/testutil/tx"
 	"github.com/stretchr/testify/require"
 )
 
 func TestGenesisState_Validate(t *testing.T) {
+	operator := sdk.AccAddress(utiltx.GenerateAddress().Bytes()).String()
+	frozenOperator := types.FrozenOperatorInfo{
+		OperatorAddress: operator,
+		FrozenHeight:    10,
+		AvsAddress:      utiltx.GenerateAddress().String(),
+		FrozenBy:        types.ModuleName,
+	}
 	tests := []struct {
 		desc     string
 		genState *types.GenesisState
@@ -19,14 +29,37 @@ func TestGenesisState_Validate(t *testing.T) {
 			valid:    true,
 		},
 		{
-			desc:     "valid genesis state",
-			genState: &types.GenesisState{
-
-				// this line is used by starport scaffolding # types/genesis/validField
-			},
+			desc: "valid genesis state",
+			genState: types.NewGenesis(
+				types.DefaultParams(),
+				[]types.FrozenOperatorInfo{frozenOperator},
+			),
 			valid: true,
 		},
-		// this line is used by starport scaffolding # types/genesis/testcase
+		{
+			desc: "invalid freeze threshold",
+			genState: types.NewGenesis(
+				types.NewParams(sdkmath.LegacyNewDec(2)),
+				nil,
+			),
+			valid: false,
+		},
+		{
+			desc: "invalid frozen operator address",
+			genState: types.NewGenesis(
+				types.DefaultParams(),
+				[]types.FrozenOperatorInfo{{OperatorAddress: "invalid"}},
+			),
+			valid: false,
+		},
+		{
+			desc: "duplicate frozen operators",
+			genState: types.NewGenesis(
+				types.DefaultParams(),
+				[]types.FrozenOperatorInfo{frozenOperator, frozenOperator},
+			),
+			valid: false,
+		},
 	}
 	for _, tc := range tests {
 		t.Run(tc.desc, func(t *testing.T) {
@@ -19,13 +19,13 @@ func KeyPrefix(p string) []byte {
 }
 
 const (
-	prefixParams       = 1
-	prefixOperatorInfo = 2
+	prefixParams         = 1
+	prefixFrozenOperator = 2
 )
 
 var (
 	KeyPrefixParams = []byte{prefixParams}
-	// KeyPrefixOperatorInfo key-value: operatorAddr->operatorInfo
-	KeyPrefixOperatorInfo = []byte{prefixOperatorInfo}
-	ParamsKey             = []byte("Params")
+	// KeyPrefixFrozenOperator key-value: operatorAccAddr->FrozenOperatorInfo
+	KeyPrefixFrozenOperator = []byte{prefixFrozenOperator}
+	ParamsKey               = []byte("Params")
 )
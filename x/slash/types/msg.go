@@ -3,9 +3,14 @@ package types
 import (
 	errorsmod "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
 )
 
-var _ sdk.Msg = &MsgUpdateParams{}
+var (
+	_ sdk.Msg = &MsgUpdateParams{}
+	_ sdk.Msg = &MsgFreezeOperator{}
+	_ sdk.Msg = &MsgUnfreezeOperator{}
+)
 
 // GetSigners returns the expected signers for a MsgUpdateParams message.
 func (m *MsgUpdateParams) GetSigners() []sdk.AccAddress {
@@ -25,3 +30,50 @@ func (m *MsgUpdateParams) ValidateBasic() error {
 func (m *MsgUpdateParams) GetSignBytes() []byte {
 	return nil
 }
+
+// GetSigners returns the expected signers for a MsgFreezeOperator message.
+func (m *MsgFreezeOperator) GetSigners() []sdk.AccAddress {
+	addr := sdk.MustAccAddressFromBech32(m.FromAddress)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic does a sanity check of the provided data
+func (m *MsgFreezeOperator) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.FromAddress); err != nil {
+		return errorsmod.Wrap(err, "invalid from address")
+	}
+	if _, err := sdk.AccAddressFromBech32(m.OperatorAddress); err != nil {
+		return errorsmod.Wrap(err, "invalid operator address")
+	}
+	if m.AvsAddress != "" && !common.IsHexAddress(m.AvsAddress) {
+		return errorsmod.Wrapf(ErrInvalidEvmAddressFormat, "invalid AVS address %s", m.AvsAddress)
+	}
+	return nil
+}
+
+// GetSignBytes returns the bytes all expected signers must sign over.
+func (m *MsgFreezeOperator) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(m))
+}
+
+// GetSigners returns the expected signers for a MsgUnfreezeOperator message.
+func (m *MsgUnfreezeOperator) GetSigners() []sdk.AccAddress {
+	addr := sdk.MustAccAddressFromBech32(m.FromAddress)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic does a sanity check of the provided data
+func (m *MsgUnfreezeOperator) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.FromAddress); err != nil {
+		return errorsmod.Wrap(err, "invalid from address")
+	}
+	if _, err := sdk.AccAddressFromBech32(m.OperatorAddress); err != nil {
+		return errorsmod.Wrap(err, "invalid operator address")
+	}
+	return nil
+}
+
+// GetSignBytes returns the bytes all expected signers must sign over.
+func (m *MsgUnfreezeOperator) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(m))
+}
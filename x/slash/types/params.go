@@ -1,24 +1,32 @@
 package types
 
 import (
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 )
 
 var _ paramtypes.ParamSet = (*Params)(nil)
 
+// DefaultFreezeThreshold is the default slash proportion at or above which the slashed
+// operator is frozen automatically.
+var DefaultFreezeThreshold = sdkmath.LegacyNewDecWithPrec(5, 1)
+
 // ParamKeyTable the param key table for launch module
 func ParamKeyTable() paramtypes.KeyTable {
 	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
 }
 
 // NewParams creates a new Params instance
-func NewParams() Params {
-	return Params{}
+func NewParams(freezeThreshold sdkmath.LegacyDec) Params {
+	return Params{
+		FreezeThreshold: freezeThreshold,
+	}
 }
 
 // DefaultParams returns a default set of parameters
 func DefaultParams() Params {
-	return NewParams()
+	return NewParams(DefaultFreezeThreshold)
 }
 
 // ParamSetPairs get the params.ParamSet
@@ -28,5 +36,8 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 
 // Validate validates the set of params
 func (p Params) Validate() error {
+	if p.FreezeThreshold.IsNil() || p.FreezeThreshold.IsNegative() || p.FreezeThreshold.GT(sdkmath.LegacyOneDec()) {
+		return errorsmod.Wrapf(ErrInvalidFreezeThreshold, "freeze threshold: %s", p.FreezeThreshold)
+	}
 	return nil
 }
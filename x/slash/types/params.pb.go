@@ -5,6 +5,9 @@ package types
 
 import (
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	io "io"
 	math "math"
@@ -24,6 +27,9 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // Params defines the parameters for the module.
 type Params struct {
+	// freeze_threshold is the slash proportion at or above which the slashed operator is
+	// frozen automatically. A zero value disables the automatic freezing.
+	FreezeThreshold github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=freeze_threshold,json=freezeThreshold,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"freeze_threshold"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -66,16 +72,22 @@ func init() {
 func init() { proto.RegisterFile("exocore/slash/v1/params.proto", fileDescriptor_4046416786b2ccfd) }
 
 var fileDescriptor_4046416786b2ccfd = []byte{
-	// 139 bytes of a gzipped FileDescriptorProto
+	// 236 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x4d, 0xad, 0xc8, 0x4f,
 	0xce, 0x2f, 0x4a, 0xd5, 0x2f, 0xce, 0x49, 0x2c, 0xce, 0xd0, 0x2f, 0x33, 0xd4, 0x2f, 0x48, 0x2c,
 	0x4a, 0xcc, 0x2d, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x80, 0x4a, 0xeb, 0x81, 0xa5,
-	0xf5, 0xca, 0x0c, 0x95, 0x38, 0xb8, 0xd8, 0x02, 0xc0, 0x2a, 0x9c, 0x3c, 0x4f, 0x3c, 0x92, 0x63,
-	0xbc, 0xf0, 0x48, 0x8e, 0xf1, 0xc1, 0x23, 0x39, 0xc6, 0x09, 0x8f, 0xe5, 0x18, 0x2e, 0x3c, 0x96,
-	0x63, 0xb8, 0xf1, 0x58, 0x8e, 0x21, 0x4a, 0x3f, 0x3d, 0xb3, 0x24, 0xa3, 0x34, 0x49, 0x2f, 0x39,
-	0x3f, 0x57, 0xdf, 0x15, 0x62, 0x80, 0x5f, 0x6a, 0x49, 0x79, 0x7e, 0x51, 0xb6, 0x3e, 0xcc, 0xba,
-	0x0a, 0xa8, 0x85, 0x25, 0x95, 0x05, 0xa9, 0xc5, 0x49, 0x6c, 0x60, 0xdb, 0x8c, 0x01, 0x01, 0x00,
-	0x00, 0xff, 0xff, 0x46, 0xd4, 0x87, 0x59, 0x8e, 0x00, 0x00, 0x00,
+	0xf5, 0xca, 0x0c, 0xa5, 0x24, 0x93, 0xf3, 0x8b, 0x73, 0xf3, 0x8b, 0xe3, 0xc1, 0xf2, 0xfa, 0x10,
+	0x0e, 0x44, 0xb1, 0x94, 0x48, 0x7a, 0x7e, 0x7a, 0x3e, 0x44, 0x1c, 0xc4, 0x82, 0x88, 0x2a, 0x15,
+	0x72, 0xb1, 0x05, 0x80, 0x8d, 0x14, 0x4a, 0xe7, 0x12, 0x48, 0x2b, 0x4a, 0x4d, 0xad, 0x4a, 0x8d,
+	0x2f, 0xc9, 0x28, 0x4a, 0x2d, 0xce, 0xc8, 0xcf, 0x49, 0x91, 0x60, 0x54, 0x60, 0xd4, 0xe0, 0x74,
+	0xb2, 0x39, 0x71, 0x4f, 0x9e, 0xe1, 0xd6, 0x3d, 0x79, 0xb5, 0xf4, 0xcc, 0x92, 0x8c, 0xd2, 0x24,
+	0xbd, 0xe4, 0xfc, 0x5c, 0xa8, 0xd1, 0x50, 0x4a, 0xb7, 0x38, 0x25, 0x5b, 0xbf, 0xa4, 0xb2, 0x20,
+	0xb5, 0x58, 0xcf, 0x25, 0x35, 0xf9, 0xd2, 0x16, 0x5d, 0x2e, 0xa8, 0xcd, 0x2e, 0xa9, 0xc9, 0x41,
+	0xfc, 0x10, 0x53, 0x43, 0x60, 0x86, 0x3a, 0x79, 0x9e, 0x78, 0x24, 0xc7, 0x78, 0xe1, 0x91, 0x1c,
+	0xe3, 0x83, 0x47, 0x72, 0x8c, 0x13, 0x1e, 0xcb, 0x31, 0x5c, 0x78, 0x2c, 0xc7, 0x70, 0xe3, 0xb1,
+	0x1c, 0x43, 0x94, 0x3e, 0x92, 0x05, 0xae, 0x10, 0xaf, 0xf9, 0xa5, 0x96, 0x94, 0xe7, 0x17, 0x65,
+	0xeb, 0xc3, 0x02, 0xa2, 0x02, 0x1a, 0x14, 0x60, 0xdb, 0x92, 0xd8, 0xc0, 0x9e, 0x30, 0x06, 0x04,
+	0x00, 0x00, 0xff, 0xff, 0x4e, 0x0c, 0x73, 0x37, 0x28, 0x01, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -98,6 +110,16 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.FreezeThreshold.Size()
+		i -= size
+		if _, err := m.FreezeThreshold.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
@@ -118,6 +140,8 @@ func (m *Params) Size() (n int) {
 	}
 	var l int
 	_ = l
+	l = m.FreezeThreshold.Size()
+	n += 1 + l + sovParams(uint64(l))
 	return n
 }
 
@@ -156,6 +180,40 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FreezeThreshold", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FreezeThreshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
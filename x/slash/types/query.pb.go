@@ -6,6 +6,9 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	query "github.com/cosmos/cosmos-sdk/types/query"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
@@ -111,32 +114,257 @@ func (m *QueryParamsResponse) GetParams() *Params {
 	return nil
 }
 
+// QueryFrozenOperatorsRequest is the request to obtain all the frozen operators.
+type QueryFrozenOperatorsRequest struct {
+	// pagination related options.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryFrozenOperatorsRequest) Reset()         { *m = QueryFrozenOperatorsRequest{} }
+func (m *QueryFrozenOperatorsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryFrozenOperatorsRequest) ProtoMessage()    {}
+func (*QueryFrozenOperatorsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_07a035b9da23fe1a, []int{2}
+}
+func (m *QueryFrozenOperatorsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFrozenOperatorsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFrozenOperatorsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFrozenOperatorsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFrozenOperatorsRequest.Merge(m, src)
+}
+func (m *QueryFrozenOperatorsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFrozenOperatorsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFrozenOperatorsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFrozenOperatorsRequest proto.InternalMessageInfo
+
+func (m *QueryFrozenOperatorsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryFrozenOperatorsResponse is the response containing all the frozen operators.
+type QueryFrozenOperatorsResponse struct {
+	// frozen_operators is the list of the frozen operators.
+	FrozenOperators []FrozenOperatorInfo `protobuf:"bytes,1,rep,name=frozen_operators,json=frozenOperators,proto3" json:"frozen_operators"`
+	// pagination related response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryFrozenOperatorsResponse) Reset()         { *m = QueryFrozenOperatorsResponse{} }
+func (m *QueryFrozenOperatorsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryFrozenOperatorsResponse) ProtoMessage()    {}
+func (*QueryFrozenOperatorsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_07a035b9da23fe1a, []int{3}
+}
+func (m *QueryFrozenOperatorsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFrozenOperatorsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFrozenOperatorsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFrozenOperatorsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFrozenOperatorsResponse.Merge(m, src)
+}
+func (m *QueryFrozenOperatorsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFrozenOperatorsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFrozenOperatorsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFrozenOperatorsResponse proto.InternalMessageInfo
+
+func (m *QueryFrozenOperatorsResponse) GetFrozenOperators() []FrozenOperatorInfo {
+	if m != nil {
+		return m.FrozenOperators
+	}
+	return nil
+}
+
+func (m *QueryFrozenOperatorsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryOperatorFrozenStatusRequest is the request to obtain the frozen status of an operator.
+type QueryOperatorFrozenStatusRequest struct {
+	// operator_address is the address of the operator.
+	OperatorAddress string `protobuf:"bytes,1,opt,name=operator_address,json=operatorAddress,proto3" json:"operator_address,omitempty"`
+}
+
+func (m *QueryOperatorFrozenStatusRequest) Reset()         { *m = QueryOperatorFrozenStatusRequest{} }
+func (m *QueryOperatorFrozenStatusRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryOperatorFrozenStatusRequest) ProtoMessage()    {}
+func (*QueryOperatorFrozenStatusRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_07a035b9da23fe1a, []int{4}
+}
+func (m *QueryOperatorFrozenStatusRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryOperatorFrozenStatusRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryOperatorFrozenStatusRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryOperatorFrozenStatusRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryOperatorFrozenStatusRequest.Merge(m, src)
+}
+func (m *QueryOperatorFrozenStatusRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryOperatorFrozenStatusRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryOperatorFrozenStatusRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryOperatorFrozenStatusRequest proto.InternalMessageInfo
+
+func (m *QueryOperatorFrozenStatusRequest) GetOperatorAddress() string {
+	if m != nil {
+		return m.OperatorAddress
+	}
+	return ""
+}
+
+// QueryOperatorFrozenStatusResponse is the response of the frozen status of an operator.
+type QueryOperatorFrozenStatusResponse struct {
+	// frozen indicates whether the operator is frozen.
+	Frozen bool `protobuf:"varint,1,opt,name=frozen,proto3" json:"frozen,omitempty"`
+	// info is the freezing information, which is nil if the operator isn't frozen.
+	Info *FrozenOperatorInfo `protobuf:"bytes,2,opt,name=info,proto3" json:"info,omitempty"`
+}
+
+func (m *QueryOperatorFrozenStatusResponse) Reset()         { *m = QueryOperatorFrozenStatusResponse{} }
+func (m *QueryOperatorFrozenStatusResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryOperatorFrozenStatusResponse) ProtoMessage()    {}
+func (*QueryOperatorFrozenStatusResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_07a035b9da23fe1a, []int{5}
+}
+func (m *QueryOperatorFrozenStatusResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryOperatorFrozenStatusResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryOperatorFrozenStatusResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryOperatorFrozenStatusResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryOperatorFrozenStatusResponse.Merge(m, src)
+}
+func (m *QueryOperatorFrozenStatusResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryOperatorFrozenStatusResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryOperatorFrozenStatusResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryOperatorFrozenStatusResponse proto.InternalMessageInfo
+
+func (m *QueryOperatorFrozenStatusResponse) GetFrozen() bool {
+	if m != nil {
+		return m.Frozen
+	}
+	return false
+}
+
+func (m *QueryOperatorFrozenStatusResponse) GetInfo() *FrozenOperatorInfo {
+	if m != nil {
+		return m.Info
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "exocore.slash.v1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "exocore.slash.v1.QueryParamsResponse")
+	proto.RegisterType((*QueryFrozenOperatorsRequest)(nil), "exocore.slash.v1.QueryFrozenOperatorsRequest")
+	proto.RegisterType((*QueryFrozenOperatorsResponse)(nil), "exocore.slash.v1.QueryFrozenOperatorsResponse")
+	proto.RegisterType((*QueryOperatorFrozenStatusRequest)(nil), "exocore.slash.v1.QueryOperatorFrozenStatusRequest")
+	proto.RegisterType((*QueryOperatorFrozenStatusResponse)(nil), "exocore.slash.v1.QueryOperatorFrozenStatusResponse")
 }
 
 func init() { proto.RegisterFile("exocore/slash/v1/query.proto", fileDescriptor_07a035b9da23fe1a) }
 
 var fileDescriptor_07a035b9da23fe1a = []byte{
-	// 260 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x49, 0xad, 0xc8, 0x4f,
-	0xce, 0x2f, 0x4a, 0xd5, 0x2f, 0xce, 0x49, 0x2c, 0xce, 0xd0, 0x2f, 0x33, 0xd4, 0x2f, 0x2c, 0x4d,
-	0x2d, 0xaa, 0xd4, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x80, 0xca, 0xea, 0x81, 0x65, 0xf5,
-	0xca, 0x0c, 0xa5, 0x64, 0x31, 0xd4, 0x17, 0x24, 0x16, 0x25, 0xe6, 0x16, 0x43, 0x34, 0x48, 0xc9,
-	0xa4, 0xe7, 0xe7, 0xa7, 0xe7, 0xa4, 0xea, 0x27, 0x16, 0x64, 0xea, 0x27, 0xe6, 0xe5, 0xe5, 0x97,
-	0x24, 0x96, 0x64, 0xe6, 0xe7, 0x41, 0x65, 0x95, 0x44, 0xb8, 0x84, 0x02, 0x41, 0xa6, 0x07, 0x80,
-	0xb5, 0x04, 0xa5, 0x16, 0x96, 0xa6, 0x16, 0x97, 0x28, 0xb9, 0x73, 0x09, 0xa3, 0x88, 0x16, 0x17,
-	0xe4, 0xe7, 0x15, 0xa7, 0x0a, 0x19, 0x70, 0xb1, 0x41, 0x8c, 0x96, 0x60, 0x54, 0x60, 0xd4, 0xe0,
-	0x36, 0x92, 0xd0, 0x43, 0x77, 0x8c, 0x1e, 0x54, 0x07, 0x54, 0x9d, 0x51, 0x2d, 0x17, 0x2b, 0xd8,
-	0x20, 0xa1, 0x12, 0x2e, 0x36, 0x88, 0x94, 0x90, 0x0a, 0xa6, 0x26, 0x4c, 0x17, 0x48, 0xa9, 0x12,
-	0x50, 0x05, 0x71, 0x91, 0x92, 0x6c, 0xd3, 0xe5, 0x27, 0x93, 0x99, 0xc4, 0x85, 0x44, 0xf5, 0x51,
-	0x03, 0x01, 0x62, 0xbd, 0x93, 0xe7, 0x89, 0x47, 0x72, 0x8c, 0x17, 0x1e, 0xc9, 0x31, 0x3e, 0x78,
-	0x24, 0xc7, 0x38, 0xe1, 0xb1, 0x1c, 0xc3, 0x85, 0xc7, 0x72, 0x0c, 0x37, 0x1e, 0xcb, 0x31, 0x44,
-	0xe9, 0xa7, 0x67, 0x96, 0x64, 0x94, 0x26, 0xe9, 0x25, 0xe7, 0xe7, 0xea, 0xbb, 0x42, 0xb4, 0xfa,
-	0xa5, 0x96, 0x94, 0xe7, 0x17, 0x65, 0xc3, 0x4d, 0xaa, 0x80, 0x9a, 0x55, 0x52, 0x59, 0x90, 0x5a,
-	0x9c, 0xc4, 0x06, 0x0e, 0x2f, 0x63, 0x40, 0x00, 0x00, 0x00, 0xff, 0xff, 0xe3, 0xae, 0xe4, 0xb8,
-	0x9e, 0x01, 0x00, 0x00,
+	// 574 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0x3f, 0x6f, 0x13, 0x31,
+	0x14, 0xcf, 0xb5, 0x25, 0x02, 0x77, 0x48, 0x64, 0x02, 0x84, 0x90, 0x5e, 0xd3, 0x13, 0xd0, 0x0a,
+	0xa9, 0x36, 0x49, 0x07, 0x58, 0x09, 0xa2, 0x55, 0x17, 0x28, 0x57, 0xb1, 0xb0, 0x44, 0x4e, 0xea,
+	0x5c, 0x4f, 0x34, 0xf7, 0xae, 0xb6, 0x13, 0x5a, 0x10, 0x0b, 0x9f, 0x00, 0x89, 0x89, 0xef, 0xc1,
+	0xc0, 0xc4, 0xdc, 0xb1, 0x02, 0x06, 0x26, 0x84, 0x12, 0x3e, 0x08, 0x8a, 0xed, 0x2b, 0x5c, 0xfe,
+	0xf4, 0xcf, 0x16, 0xfb, 0xbd, 0xdf, 0x9f, 0xf7, 0xf3, 0xcb, 0xa1, 0x32, 0x3f, 0x80, 0x16, 0x08,
+	0x4e, 0xe5, 0x1e, 0x93, 0xbb, 0xb4, 0x57, 0xa5, 0xfb, 0x5d, 0x2e, 0x0e, 0x49, 0x2c, 0x40, 0x01,
+	0xce, 0xdb, 0x2a, 0xd1, 0x55, 0xd2, 0xab, 0x96, 0xee, 0xb5, 0x40, 0x76, 0x40, 0xd2, 0x26, 0x93,
+	0xdc, 0xb4, 0xd2, 0x5e, 0xb5, 0xc9, 0x15, 0xab, 0xd2, 0x98, 0x05, 0x61, 0xc4, 0x54, 0x08, 0x91,
+	0x41, 0x97, 0x6e, 0x9a, 0xde, 0x86, 0x3e, 0x51, 0x73, 0xb0, 0xa5, 0x85, 0x31, 0xd9, 0xb6, 0x80,
+	0x37, 0x3c, 0x9a, 0x5a, 0x8e, 0x99, 0x60, 0x9d, 0x04, 0x5d, 0x08, 0x20, 0x00, 0xc3, 0x3a, 0xfc,
+	0x65, 0x6f, 0xcb, 0x01, 0x40, 0xb0, 0xc7, 0x29, 0x8b, 0x43, 0xca, 0xa2, 0x08, 0x94, 0xf6, 0x62,
+	0x31, 0x5e, 0x01, 0xe1, 0xe7, 0x43, 0xbb, 0x5b, 0x9a, 0xc8, 0xe7, 0xfb, 0x5d, 0x2e, 0x95, 0xb7,
+	0x81, 0xae, 0xa6, 0x6e, 0x65, 0x0c, 0x91, 0xe4, 0xf8, 0x3e, 0xca, 0x1a, 0xc1, 0xa2, 0x53, 0x71,
+	0x56, 0xe6, 0x6b, 0x45, 0x32, 0x1a, 0x04, 0xb1, 0x08, 0xdb, 0xe7, 0x71, 0x74, 0x4b, 0x13, 0xad,
+	0xeb, 0x31, 0x9e, 0xc5, 0x5c, 0x30, 0x05, 0x22, 0xd1, 0xc1, 0xeb, 0x08, 0xfd, 0x8b, 0xc7, 0x92,
+	0xde, 0x25, 0x36, 0x92, 0x61, 0x96, 0xc4, 0xc4, 0x6e, 0xb3, 0x24, 0x5b, 0x2c, 0xe0, 0x16, 0xeb,
+	0xff, 0x87, 0xf4, 0xbe, 0x3a, 0xa8, 0x3c, 0x59, 0xc7, 0x3a, 0x7f, 0x81, 0xf2, 0x26, 0xc9, 0x06,
+	0x24, 0xb5, 0xa2, 0x53, 0x99, 0x5d, 0x99, 0xaf, 0xdd, 0x1e, 0x9f, 0x21, 0x4d, 0xb2, 0x19, 0xb5,
+	0xa1, 0x3e, 0x77, 0xf4, 0x6b, 0x31, 0xe3, 0xe7, 0xda, 0x69, 0x7a, 0xbc, 0x91, 0xf2, 0x3f, 0xa3,
+	0xfd, 0x2f, 0x9f, 0xe9, 0xdf, 0x78, 0x4a, 0x0d, 0x10, 0xa0, 0x8a, 0xf6, 0x9f, 0x50, 0x1b, 0x0b,
+	0xdb, 0x8a, 0xa9, 0xee, 0x49, 0x58, 0x8f, 0x51, 0x3e, 0x31, 0xdf, 0x60, 0x3b, 0x3b, 0x82, 0x4b,
+	0xf3, 0x0e, 0x57, 0xea, 0xc5, 0x6f, 0x9f, 0x57, 0x0b, 0x56, 0xf5, 0x91, 0xa9, 0x6c, 0x2b, 0x11,
+	0x46, 0x81, 0x9f, 0x4b, 0x10, 0xf6, 0xda, 0xeb, 0xa2, 0xa5, 0x53, 0x84, 0x6c, 0x5a, 0xd7, 0x51,
+	0xd6, 0x4c, 0xaa, 0xf9, 0x2f, 0xfb, 0xf6, 0x84, 0x1f, 0xa2, 0xb9, 0x30, 0x6a, 0x83, 0x1d, 0xf4,
+	0x5c, 0xc9, 0xf9, 0x1a, 0x51, 0xfb, 0x31, 0x8b, 0x2e, 0x69, 0x5d, 0xac, 0x50, 0xd6, 0xec, 0x08,
+	0x9e, 0x80, 0x1f, 0x5f, 0xc5, 0xd2, 0x9d, 0x33, 0xba, 0x8c, 0x65, 0x6f, 0xe1, 0xfd, 0xf7, 0x3f,
+	0x1f, 0x67, 0x6e, 0xe0, 0x6b, 0x34, 0xfd, 0x1f, 0x31, 0x7b, 0x88, 0x3f, 0x39, 0x28, 0x37, 0xb2,
+	0x1b, 0x78, 0x75, 0x0a, 0xf3, 0xe4, 0x5d, 0x2d, 0x91, 0xf3, 0xb6, 0x5b, 0x47, 0xcb, 0xda, 0xd1,
+	0x12, 0x5e, 0x1c, 0x71, 0x34, 0xba, 0x87, 0xf8, 0x8b, 0x83, 0x0a, 0x93, 0x9e, 0x03, 0xd7, 0xa6,
+	0x28, 0x9e, 0xb2, 0x24, 0xa5, 0xb5, 0x0b, 0x61, 0xac, 0xd5, 0x07, 0xda, 0x6a, 0x15, 0xd3, 0xc9,
+	0x56, 0xa5, 0xee, 0xa6, 0x6f, 0x47, 0xb7, 0xef, 0x5d, 0x7d, 0xf3, 0xa8, 0xef, 0x3a, 0xc7, 0x7d,
+	0xd7, 0xf9, 0xdd, 0x77, 0x9d, 0x0f, 0x03, 0x37, 0x73, 0x3c, 0x70, 0x33, 0x3f, 0x07, 0x6e, 0xe6,
+	0x25, 0x0d, 0x42, 0xb5, 0xdb, 0x6d, 0x92, 0x16, 0x74, 0xe8, 0x13, 0x43, 0xfa, 0x94, 0xab, 0xd7,
+	0x20, 0x5e, 0x9d, 0x68, 0x1c, 0x58, 0x15, 0x75, 0x18, 0x73, 0xd9, 0xcc, 0xea, 0xef, 0xd1, 0xda,
+	0xdf, 0x00, 0x00, 0x00, 0xff, 0xff, 0xde, 0x9b, 0xc9, 0x4f, 0x7a, 0x05, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -153,6 +381,10 @@ const _ = grpc.SupportPackageIsVersion4
 type QueryClient interface {
 	// Parameters queries the parameters of the module.
 	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// FrozenOperators queries all the frozen operators.
+	FrozenOperators(ctx context.Context, in *QueryFrozenOperatorsRequest, opts ...grpc.CallOption) (*QueryFrozenOperatorsResponse, error)
+	// OperatorFrozenStatus queries whether the operator is frozen.
+	OperatorFrozenStatus(ctx context.Context, in *QueryOperatorFrozenStatusRequest, opts ...grpc.CallOption) (*QueryOperatorFrozenStatusResponse, error)
 }
 
 type queryClient struct {
@@ -172,10 +404,32 @@ func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts .
 	return out, nil
 }
 
+func (c *queryClient) FrozenOperators(ctx context.Context, in *QueryFrozenOperatorsRequest, opts ...grpc.CallOption) (*QueryFrozenOperatorsResponse, error) {
+	out := new(QueryFrozenOperatorsResponse)
+	err := c.cc.Invoke(ctx, "/exocore.slash.v1.Query/FrozenOperators", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) OperatorFrozenStatus(ctx context.Context, in *QueryOperatorFrozenStatusRequest, opts ...grpc.CallOption) (*QueryOperatorFrozenStatusResponse, error) {
+	out := new(QueryOperatorFrozenStatusResponse)
+	err := c.cc.Invoke(ctx, "/exocore.slash.v1.Query/OperatorFrozenStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Parameters queries the parameters of the module.
 	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// FrozenOperators queries all the frozen operators.
+	FrozenOperators(context.Context, *QueryFrozenOperatorsRequest) (*QueryFrozenOperatorsResponse, error)
+	// OperatorFrozenStatus queries whether the operator is frozen.
+	OperatorFrozenStatus(context.Context, *QueryOperatorFrozenStatusRequest) (*QueryOperatorFrozenStatusResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -185,6 +439,12 @@ type UnimplementedQueryServer struct {
 func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
+func (*UnimplementedQueryServer) FrozenOperators(ctx context.Context, req *QueryFrozenOperatorsRequest) (*QueryFrozenOperatorsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FrozenOperators not implemented")
+}
+func (*UnimplementedQueryServer) OperatorFrozenStatus(ctx context.Context, req *QueryOperatorFrozenStatusRequest) (*QueryOperatorFrozenStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OperatorFrozenStatus not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -208,6 +468,42 @@ func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_FrozenOperators_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFrozenOperatorsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FrozenOperators(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/exocore.slash.v1.Query/FrozenOperators",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FrozenOperators(ctx, req.(*QueryFrozenOperatorsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_OperatorFrozenStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryOperatorFrozenStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).OperatorFrozenStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/exocore.slash.v1.Query/OperatorFrozenStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).OperatorFrozenStatus(ctx, req.(*QueryOperatorFrozenStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "exocore.slash.v1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -216,6 +512,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Params",
 			Handler:    _Query_Params_Handler,
 		},
+		{
+			MethodName: "FrozenOperators",
+			Handler:    _Query_FrozenOperators_Handler,
+		},
+		{
+			MethodName: "OperatorFrozenStatus",
+			Handler:    _Query_OperatorFrozenStatus_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "exocore/slash/v1/query.proto",
@@ -279,75 +583,295 @@ func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QueryFrozenOperatorsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+
+func (m *QueryFrozenOperatorsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryFrozenOperatorsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Params != nil {
-		l = m.Params.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *QueryFrozenOperatorsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
 }
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *QueryFrozenOperatorsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+
+func (m *QueryFrozenOperatorsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
 			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.FrozenOperators) > 0 {
+		for iNdEx := len(m.FrozenOperators) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FrozenOperators[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
 		}
-		switch fieldNum {
-		default:
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryOperatorFrozenStatusRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryOperatorFrozenStatusRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryOperatorFrozenStatusRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.OperatorAddress) > 0 {
+		i -= len(m.OperatorAddress)
+		copy(dAtA[i:], m.OperatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.OperatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryOperatorFrozenStatusResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryOperatorFrozenStatusResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryOperatorFrozenStatusResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Info != nil {
+		{
+			size, err := m.Info.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Frozen {
+		i--
+		if m.Frozen {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Params != nil {
+		l = m.Params.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFrozenOperatorsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFrozenOperatorsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.FrozenOperators) > 0 {
+		for _, e := range m.FrozenOperators {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryOperatorFrozenStatusRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OperatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryOperatorFrozenStatusResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Frozen {
+		n += 2
+	}
+	if m.Info != nil {
+		l = m.Info.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
 			if err != nil {
@@ -454,6 +978,400 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryFrozenOperatorsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFrozenOperatorsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFrozenOperatorsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFrozenOperatorsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFrozenOperatorsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFrozenOperatorsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FrozenOperators", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FrozenOperators = append(m.FrozenOperators, FrozenOperatorInfo{})
+			if err := m.FrozenOperators[len(m.FrozenOperators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryOperatorFrozenStatusRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryOperatorFrozenStatusRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryOperatorFrozenStatusRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OperatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OperatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryOperatorFrozenStatusResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryOperatorFrozenStatusResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryOperatorFrozenStatusResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Frozen", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Frozen = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Info", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Info == nil {
+				m.Info = &FrozenOperatorInfo{}
+			}
+			if err := m.Info.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -124,35 +124,256 @@ func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
 
+// MsgFreezeOperator is the Msg/FreezeOperator request type. The sender must be either the
+// governance account or the slash contract of the specified AVS.
+type MsgFreezeOperator struct {
+	// from_address is the address of the sender.
+	FromAddress string `protobuf:"bytes,1,opt,name=from_address,json=fromAddress,proto3" json:"from_address,omitempty"`
+	// operator_address is the address of the operator to be frozen.
+	OperatorAddress string `protobuf:"bytes,2,opt,name=operator_address,json=operatorAddress,proto3" json:"operator_address,omitempty"`
+	// avs_address is the AVS on whose behalf the operator is frozen. It's required if the
+	// sender isn't the governance account.
+	AvsAddress string `protobuf:"bytes,3,opt,name=avs_address,json=avsAddress,proto3" json:"avs_address,omitempty"`
+	// reason is the reason for freezing the operator.
+	Reason string `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (m *MsgFreezeOperator) Reset()         { *m = MsgFreezeOperator{} }
+func (m *MsgFreezeOperator) String() string { return proto.CompactTextString(m) }
+func (*MsgFreezeOperator) ProtoMessage()    {}
+func (*MsgFreezeOperator) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f47cd9dbfa5622e1, []int{2}
+}
+func (m *MsgFreezeOperator) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgFreezeOperator) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgFreezeOperator.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgFreezeOperator) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgFreezeOperator.Merge(m, src)
+}
+func (m *MsgFreezeOperator) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgFreezeOperator) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgFreezeOperator.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgFreezeOperator proto.InternalMessageInfo
+
+func (m *MsgFreezeOperator) GetFromAddress() string {
+	if m != nil {
+		return m.FromAddress
+	}
+	return ""
+}
+
+func (m *MsgFreezeOperator) GetOperatorAddress() string {
+	if m != nil {
+		return m.OperatorAddress
+	}
+	return ""
+}
+
+func (m *MsgFreezeOperator) GetAvsAddress() string {
+	if m != nil {
+		return m.AvsAddress
+	}
+	return ""
+}
+
+func (m *MsgFreezeOperator) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+// MsgFreezeOperatorResponse is the response to MsgFreezeOperator.
+type MsgFreezeOperatorResponse struct {
+}
+
+func (m *MsgFreezeOperatorResponse) Reset()         { *m = MsgFreezeOperatorResponse{} }
+func (m *MsgFreezeOperatorResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgFreezeOperatorResponse) ProtoMessage()    {}
+func (*MsgFreezeOperatorResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f47cd9dbfa5622e1, []int{3}
+}
+func (m *MsgFreezeOperatorResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgFreezeOperatorResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgFreezeOperatorResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgFreezeOperatorResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgFreezeOperatorResponse.Merge(m, src)
+}
+func (m *MsgFreezeOperatorResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgFreezeOperatorResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgFreezeOperatorResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgFreezeOperatorResponse proto.InternalMessageInfo
+
+// MsgUnfreezeOperator is the Msg/UnfreezeOperator request type. The governance account can
+// unfreeze any operator, while the slash contract of an AVS can only unfreeze the operators
+// frozen on behalf of that AVS.
+type MsgUnfreezeOperator struct {
+	// from_address is the address of the sender.
+	FromAddress string `protobuf:"bytes,1,opt,name=from_address,json=fromAddress,proto3" json:"from_address,omitempty"`
+	// operator_address is the address of the operator to be unfrozen.
+	OperatorAddress string `protobuf:"bytes,2,opt,name=operator_address,json=operatorAddress,proto3" json:"operator_address,omitempty"`
+}
+
+func (m *MsgUnfreezeOperator) Reset()         { *m = MsgUnfreezeOperator{} }
+func (m *MsgUnfreezeOperator) String() string { return proto.CompactTextString(m) }
+func (*MsgUnfreezeOperator) ProtoMessage()    {}
+func (*MsgUnfreezeOperator) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f47cd9dbfa5622e1, []int{4}
+}
+func (m *MsgUnfreezeOperator) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUnfreezeOperator) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUnfreezeOperator.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUnfreezeOperator) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUnfreezeOperator.Merge(m, src)
+}
+func (m *MsgUnfreezeOperator) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUnfreezeOperator) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUnfreezeOperator.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUnfreezeOperator proto.InternalMessageInfo
+
+func (m *MsgUnfreezeOperator) GetFromAddress() string {
+	if m != nil {
+		return m.FromAddress
+	}
+	return ""
+}
+
+func (m *MsgUnfreezeOperator) GetOperatorAddress() string {
+	if m != nil {
+		return m.OperatorAddress
+	}
+	return ""
+}
+
+// MsgUnfreezeOperatorResponse is the response to MsgUnfreezeOperator.
+type MsgUnfreezeOperatorResponse struct {
+}
+
+func (m *MsgUnfreezeOperatorResponse) Reset()         { *m = MsgUnfreezeOperatorResponse{} }
+func (m *MsgUnfreezeOperatorResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUnfreezeOperatorResponse) ProtoMessage()    {}
+func (*MsgUnfreezeOperatorResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f47cd9dbfa5622e1, []int{5}
+}
+func (m *MsgUnfreezeOperatorResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUnfreezeOperatorResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUnfreezeOperatorResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUnfreezeOperatorResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUnfreezeOperatorResponse.Merge(m, src)
+}
+func (m *MsgUnfreezeOperatorResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUnfreezeOperatorResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUnfreezeOperatorResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUnfreezeOperatorResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgUpdateParams)(nil), "exocore.slash.v1.MsgUpdateParams")
 	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "exocore.slash.v1.MsgUpdateParamsResponse")
+	proto.RegisterType((*MsgFreezeOperator)(nil), "exocore.slash.v1.MsgFreezeOperator")
+	proto.RegisterType((*MsgFreezeOperatorResponse)(nil), "exocore.slash.v1.MsgFreezeOperatorResponse")
+	proto.RegisterType((*MsgUnfreezeOperator)(nil), "exocore.slash.v1.MsgUnfreezeOperator")
+	proto.RegisterType((*MsgUnfreezeOperatorResponse)(nil), "exocore.slash.v1.MsgUnfreezeOperatorResponse")
 }
 
 func init() { proto.RegisterFile("exocore/slash/v1/tx.proto", fileDescriptor_f47cd9dbfa5622e1) }
 
 var fileDescriptor_f47cd9dbfa5622e1 = []byte{
-	// 318 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x4c, 0xad, 0xc8, 0x4f,
-	0xce, 0x2f, 0x4a, 0xd5, 0x2f, 0xce, 0x49, 0x2c, 0xce, 0xd0, 0x2f, 0x33, 0xd4, 0x2f, 0xa9, 0xd0,
-	0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x80, 0x4a, 0xe9, 0x81, 0xa5, 0xf4, 0xca, 0x0c, 0xa5,
-	0xc4, 0x93, 0xf3, 0x8b, 0x73, 0xf3, 0x8b, 0xf5, 0x73, 0x8b, 0xd3, 0x41, 0x2a, 0x73, 0x8b, 0xd3,
-	0x21, 0x4a, 0xa5, 0x24, 0x21, 0x12, 0xf1, 0x60, 0x9e, 0x3e, 0x84, 0x03, 0x95, 0x92, 0xc5, 0xb0,
-	0xa0, 0x20, 0xb1, 0x28, 0x31, 0x17, 0x26, 0x2d, 0x92, 0x9e, 0x9f, 0x9e, 0x0f, 0xd1, 0x06, 0x62,
-	0x41, 0x44, 0x95, 0x26, 0x32, 0x72, 0xf1, 0xfb, 0x16, 0xa7, 0x87, 0x16, 0xa4, 0x24, 0x96, 0xa4,
-	0x06, 0x80, 0xd5, 0x0b, 0x99, 0x71, 0x71, 0x26, 0x96, 0x96, 0x64, 0xe4, 0x17, 0x65, 0x96, 0x54,
-	0x4a, 0x30, 0x2a, 0x30, 0x6a, 0x70, 0x3a, 0x49, 0x5c, 0xda, 0xa2, 0x2b, 0x02, 0xb5, 0xcd, 0x31,
-	0x25, 0xa5, 0x28, 0xb5, 0xb8, 0x38, 0xb8, 0xa4, 0x28, 0x33, 0x2f, 0x3d, 0x08, 0xa1, 0x54, 0xc8,
-	0x8c, 0x8b, 0x0d, 0x62, 0xa3, 0x04, 0x93, 0x02, 0xa3, 0x06, 0xb7, 0x91, 0x84, 0x1e, 0xba, 0xbf,
-	0xf4, 0x20, 0x36, 0x38, 0xb1, 0x9c, 0xb8, 0x27, 0xcf, 0x10, 0x04, 0x55, 0x6d, 0xc5, 0xd7, 0xf4,
-	0x7c, 0x83, 0x16, 0xc2, 0x1c, 0x25, 0x49, 0x2e, 0x71, 0x34, 0x27, 0x05, 0xa5, 0x16, 0x17, 0xe4,
-	0xe7, 0x15, 0xa7, 0x1a, 0x25, 0x73, 0x31, 0xfb, 0x16, 0xa7, 0x0b, 0xc5, 0x70, 0xf1, 0xa0, 0xb8,
-	0x58, 0x11, 0xd3, 0x26, 0x34, 0x13, 0xa4, 0x34, 0x09, 0x2a, 0x81, 0x59, 0xe2, 0xe4, 0x79, 0xe2,
-	0x91, 0x1c, 0xe3, 0x85, 0x47, 0x72, 0x8c, 0x0f, 0x1e, 0xc9, 0x31, 0x4e, 0x78, 0x2c, 0xc7, 0x70,
-	0xe1, 0xb1, 0x1c, 0xc3, 0x8d, 0xc7, 0x72, 0x0c, 0x51, 0xfa, 0xe9, 0x99, 0x25, 0x19, 0xa5, 0x49,
-	0x7a, 0xc9, 0xf9, 0xb9, 0xfa, 0xae, 0x10, 0xe3, 0xfc, 0x52, 0x4b, 0xca, 0xf3, 0x8b, 0xb2, 0xf5,
-	0x61, 0x81, 0x5f, 0x01, 0x0d, 0xfe, 0x92, 0xca, 0x82, 0xd4, 0xe2, 0x24, 0x36, 0x70, 0x28, 0x1b,
-	0x03, 0x02, 0x00, 0x00, 0xff, 0xff, 0x3b, 0x37, 0x62, 0xe7, 0xfd, 0x01, 0x00, 0x00,
+	// 485 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x94, 0x41, 0x6b, 0x13, 0x41,
+	0x14, 0xc7, 0x33, 0x69, 0x09, 0xf4, 0xa5, 0xb4, 0xe9, 0x5a, 0xec, 0x66, 0x4b, 0xb7, 0x35, 0x22,
+	0x54, 0xa5, 0xbb, 0xb4, 0x42, 0x0f, 0xf5, 0x64, 0x44, 0xc1, 0x43, 0x54, 0x22, 0x5e, 0x44, 0x28,
+	0x93, 0x64, 0x3a, 0x09, 0xba, 0x79, 0xcb, 0xbc, 0x69, 0x4c, 0x3d, 0xfa, 0x09, 0xf4, 0x6b, 0x78,
+	0x52, 0xf0, 0x43, 0xf4, 0x58, 0x3c, 0x79, 0x12, 0x4d, 0x0e, 0x7e, 0x0d, 0xd9, 0x9d, 0xdd, 0x94,
+	0x6c, 0x16, 0xd2, 0x63, 0x6f, 0x3b, 0xf3, 0x7e, 0xef, 0xfd, 0xff, 0x6f, 0xf6, 0xf1, 0xa0, 0x2a,
+	0x86, 0xd8, 0x46, 0x25, 0x7c, 0x7a, 0xcf, 0xa9, 0xeb, 0x0f, 0xf6, 0x7d, 0x3d, 0xf4, 0x42, 0x85,
+	0x1a, 0xad, 0x4a, 0x12, 0xf2, 0xe2, 0x90, 0x37, 0xd8, 0x77, 0x36, 0xda, 0x48, 0x01, 0x92, 0x1f,
+	0x90, 0x8c, 0xc8, 0x80, 0xa4, 0x41, 0x9d, 0xaa, 0x09, 0x1c, 0xc7, 0x27, 0xdf, 0x1c, 0x92, 0xd0,
+	0xd6, 0x8c, 0x40, 0xc8, 0x15, 0x0f, 0xd2, 0xf0, 0xba, 0x44, 0x89, 0x26, 0x2d, 0xfa, 0x32, 0xb7,
+	0xb5, 0x2f, 0x0c, 0x56, 0x1b, 0x24, 0x5f, 0x87, 0x1d, 0xae, 0xc5, 0xcb, 0x98, 0xb7, 0x0e, 0x61,
+	0x89, 0x9f, 0xea, 0x2e, 0xaa, 0x9e, 0x3e, 0xb3, 0xd9, 0x0e, 0xdb, 0x5d, 0xaa, 0xdb, 0x3f, 0x7f,
+	0xec, 0xad, 0x27, 0x6a, 0x8f, 0x3a, 0x1d, 0x25, 0x88, 0x5e, 0x69, 0xd5, 0xeb, 0xcb, 0xe6, 0x25,
+	0x6a, 0x1d, 0x42, 0xc9, 0x28, 0xda, 0xc5, 0x1d, 0xb6, 0x5b, 0x3e, 0xb0, 0xbd, 0x6c, 0x5f, 0x9e,
+	0x51, 0xa8, 0x2f, 0x9e, 0xff, 0xde, 0x2e, 0x34, 0x13, 0xfa, 0x68, 0xe5, 0xd3, 0xbf, 0x6f, 0xf7,
+	0x2e, 0xeb, 0xd4, 0xaa, 0xb0, 0x91, 0xb1, 0xd4, 0x14, 0x14, 0x62, 0x9f, 0x44, 0xed, 0x2f, 0x83,
+	0xb5, 0x06, 0xc9, 0xa7, 0x4a, 0x88, 0x8f, 0xe2, 0x45, 0x28, 0x14, 0xd7, 0xa8, 0xac, 0x87, 0xb0,
+	0x7c, 0xa2, 0x30, 0x38, 0xe6, 0xc6, 0xd9, 0x5c, 0xcf, 0xe5, 0x88, 0x4e, 0xae, 0xac, 0xc7, 0x50,
+	0xc1, 0xa4, 0xd0, 0xa4, 0x40, 0x71, 0x4e, 0x81, 0xd5, 0x34, 0x23, 0x2d, 0xb2, 0x0d, 0x65, 0x3e,
+	0xa0, 0x49, 0xfe, 0x42, 0x94, 0xdf, 0x04, 0x3e, 0xa0, 0x14, 0xb8, 0x09, 0x25, 0x25, 0x38, 0x61,
+	0xdf, 0x5e, 0x8c, 0x63, 0xc9, 0xe9, 0x68, 0x2d, 0xea, 0x7d, 0xca, 0x7d, 0x6d, 0x13, 0xaa, 0x33,
+	0x2d, 0x4e, 0x1e, 0xe0, 0x2b, 0x83, 0x1b, 0xd1, 0xe3, 0xf4, 0x4f, 0xae, 0xd9, 0x13, 0xe4, 0x75,
+	0xb2, 0x05, 0x9b, 0x39, 0x5e, 0xd3, 0x5e, 0x0e, 0xbe, 0x17, 0x61, 0xa1, 0x41, 0xd2, 0x7a, 0x0b,
+	0xcb, 0x53, 0xf3, 0x77, 0x6b, 0x76, 0x6e, 0x32, 0xf3, 0xe0, 0xdc, 0x9d, 0x8b, 0xa4, 0x2a, 0x56,
+	0x0b, 0x56, 0x32, 0xe3, 0x72, 0x3b, 0x37, 0x79, 0x1a, 0x72, 0xee, 0x5f, 0x01, 0x9a, 0x68, 0x74,
+	0xa1, 0x32, 0xf3, 0x47, 0xee, 0xe4, 0x5b, 0xcc, 0x60, 0xce, 0xde, 0x95, 0xb0, 0x54, 0xa9, 0xfe,
+	0xec, 0x7c, 0xe4, 0xb2, 0x8b, 0x91, 0xcb, 0xfe, 0x8c, 0x5c, 0xf6, 0x79, 0xec, 0x16, 0x2e, 0xc6,
+	0x6e, 0xe1, 0xd7, 0xd8, 0x2d, 0xbc, 0xf1, 0x65, 0x4f, 0x77, 0x4f, 0x5b, 0x5e, 0x1b, 0x03, 0xff,
+	0x89, 0x29, 0xf9, 0x5c, 0xe8, 0x0f, 0xa8, 0xde, 0xf9, 0xe9, 0x62, 0x18, 0x26, 0xab, 0x41, 0x9f,
+	0x85, 0x82, 0x5a, 0xa5, 0x78, 0x03, 0x3c, 0xf8, 0x1f, 0x00, 0x00, 0xff, 0xff, 0x1f, 0x5a, 0x66,
+	0xd1, 0x99, 0x04, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -169,6 +390,10 @@ const _ = grpc.SupportPackageIsVersion4
 type MsgClient interface {
 	// UpdateParams updates the parameters of this module.
 	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	// FreezeOperator freezes an operator.
+	FreezeOperator(ctx context.Context, in *MsgFreezeOperator, opts ...grpc.CallOption) (*MsgFreezeOperatorResponse, error)
+	// UnfreezeOperator unfreezes a frozen operator.
+	UnfreezeOperator(ctx context.Context, in *MsgUnfreezeOperator, opts ...grpc.CallOption) (*MsgUnfreezeOperatorResponse, error)
 }
 
 type msgClient struct {
@@ -188,10 +413,32 @@ func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts
 	return out, nil
 }
 
+func (c *msgClient) FreezeOperator(ctx context.Context, in *MsgFreezeOperator, opts ...grpc.CallOption) (*MsgFreezeOperatorResponse, error) {
+	out := new(MsgFreezeOperatorResponse)
+	err := c.cc.Invoke(ctx, "/exocore.slash.v1.Msg/FreezeOperator", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UnfreezeOperator(ctx context.Context, in *MsgUnfreezeOperator, opts ...grpc.CallOption) (*MsgUnfreezeOperatorResponse, error) {
+	out := new(MsgUnfreezeOperatorResponse)
+	err := c.cc.Invoke(ctx, "/exocore.slash.v1.Msg/UnfreezeOperator", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// UpdateParams updates the parameters of this module.
 	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	// FreezeOperator freezes an operator.
+	FreezeOperator(context.Context, *MsgFreezeOperator) (*MsgFreezeOperatorResponse, error)
+	// UnfreezeOperator unfreezes a frozen operator.
+	UnfreezeOperator(context.Context, *MsgUnfreezeOperator) (*MsgUnfreezeOperatorResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -201,6 +448,12 @@ type UnimplementedMsgServer struct {
 func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
 }
+func (*UnimplementedMsgServer) FreezeOperator(ctx context.Context, req *MsgFreezeOperator) (*MsgFreezeOperatorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FreezeOperator not implemented")
+}
+func (*UnimplementedMsgServer) UnfreezeOperator(ctx context.Context, req *MsgUnfreezeOperator) (*MsgUnfreezeOperatorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnfreezeOperator not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -224,6 +477,42 @@ func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_FreezeOperator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgFreezeOperator)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).FreezeOperator(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/exocore.slash.v1.Msg/FreezeOperator",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).FreezeOperator(ctx, req.(*MsgFreezeOperator))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UnfreezeOperator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUnfreezeOperator)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UnfreezeOperator(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/exocore.slash.v1.Msg/UnfreezeOperator",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UnfreezeOperator(ctx, req.(*MsgUnfreezeOperator))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "exocore.slash.v1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -232,6 +521,14 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateParams",
 			Handler:    _Msg_UpdateParams_Handler,
 		},
+		{
+			MethodName: "FreezeOperator",
+			Handler:    _Msg_FreezeOperator_Handler,
+		},
+		{
+			MethodName: "UnfreezeOperator",
+			Handler:    _Msg_UnfreezeOperator_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "exocore/slash/v1/tx.proto",
@@ -300,6 +597,140 @@ func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgFreezeOperator) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgFreezeOperator) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgFreezeOperator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.AvsAddress) > 0 {
+		i -= len(m.AvsAddress)
+		copy(dAtA[i:], m.AvsAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.AvsAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.OperatorAddress) > 0 {
+		i -= len(m.OperatorAddress)
+		copy(dAtA[i:], m.OperatorAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.OperatorAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.FromAddress) > 0 {
+		i -= len(m.FromAddress)
+		copy(dAtA[i:], m.FromAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.FromAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgFreezeOperatorResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgFreezeOperatorResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgFreezeOperatorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUnfreezeOperator) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUnfreezeOperator) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUnfreezeOperator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.OperatorAddress) > 0 {
+		i -= len(m.OperatorAddress)
+		copy(dAtA[i:], m.OperatorAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.OperatorAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.FromAddress) > 0 {
+		i -= len(m.FromAddress)
+		copy(dAtA[i:], m.FromAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.FromAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUnfreezeOperatorResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUnfreezeOperatorResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUnfreezeOperatorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -335,10 +766,70 @@ func (m *MsgUpdateParamsResponse) Size() (n int) {
 	return n
 }
 
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
+func (m *MsgFreezeOperator) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FromAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.OperatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.AvsAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgFreezeOperatorResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUnfreezeOperator) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FromAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.OperatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUnfreezeOperatorResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
 	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
 }
 func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
@@ -506,6 +997,398 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgFreezeOperator) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgFreezeOperator: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgFreezeOperator: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FromAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OperatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OperatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AvsAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AvsAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgFreezeOperatorResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgFreezeOperatorResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgFreezeOperatorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUnfreezeOperator) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUnfreezeOperator: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUnfreezeOperator: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FromAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OperatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OperatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUnfreezeOperatorResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUnfreezeOperatorResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUnfreezeOperatorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0